@@ -13,10 +13,13 @@
 package service
 
 import (
+	"errors"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"k8s.io/klog/v2"
 
@@ -32,6 +35,7 @@ type repoService struct {
 	// authorizer auth related operations.
 	authorizer auth.Authorizer
 	provider   repository.Provider
+	settings   cc.Repository
 }
 
 // UploadFile upload to repo provider
@@ -78,6 +82,50 @@ func (s *repoService) DownloadFile(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// LocalDownloadFile download file from local provider repo by the signed download url, which is used to
+// download file without authentication, such as the sidecar downloads released config items
+func (s *repoService) LocalDownloadFile(w http.ResponseWriter, r *http.Request) {
+	if !strings.EqualFold(string(s.settings.StorageType), string(cc.Local)) {
+		render.Render(w, r, rest.BadRequest(errors.New("local repository is not enabled")))
+		return
+	}
+
+	bizID, err := strconv.ParseUint(chi.URLParam(r, "biz_id"), 10, 64)
+	if err != nil || bizID == 0 {
+		render.Render(w, r, rest.BadRequest(errors.New("invalid biz_id")))
+		return
+	}
+	sign := strings.ToLower(chi.URLParam(r, "sign"))
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(errors.New("invalid expires")))
+		return
+	}
+
+	err = repository.VerifyLocalDownloadToken(s.settings.Local, uint32(bizID), sign, expires,
+		r.URL.Query().Get("token"))
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	kt := kit.New()
+	kt.BizID = uint32(bizID)
+	body, contentLength, err := s.provider.Download(kt, sign)
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Length", strconv.FormatInt(contentLength, 10))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, err = io.Copy(w, body)
+	if err != nil {
+		klog.ErrorS(err, "download local file", "sign", sign)
+	}
+}
+
 // FileMetadata get repo head data
 func (s *repoService) FileMetadata(w http.ResponseWriter, r *http.Request) {
 	kt := kit.MustGetKit(r.Context())
@@ -106,6 +154,7 @@ func newRepoService(settings cc.Repository, authorizer auth.Authorizer) (*repoSe
 	repo := &repoService{
 		authorizer: authorizer,
 		provider:   provider,
+		settings:   settings,
 	}
 
 	return repo, nil
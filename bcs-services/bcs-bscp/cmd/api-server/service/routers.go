@@ -145,6 +145,12 @@ func (p *proxy) routers() http.Handler {
 		})
 	})
 
+	// 本地文件系统仓库的签名下载API, 通过下载链接中的签名鉴权
+	r.Route("/api/v1/repo/local/biz/{biz_id}/download/{sign}", func(r chi.Router) {
+		r.Use(p.HttpServerHandledTotal("", "LocalDownload"))
+		r.Get("/", p.repo.LocalDownloadFile)
+	})
+
 	// 导入模板压缩包
 	r.Route("/api/v1/config/biz/{biz_id}/template_spaces/{template_space_id}/templates/import", func(r chi.Router) {
 		r.Use(p.authorizer.UnifiedAuthentication)
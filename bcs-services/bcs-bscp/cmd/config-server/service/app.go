@@ -59,6 +59,8 @@ func (s *Service) CreateApp(ctx context.Context, req *pbcs.CreateAppReq) (*pbcs.
 			Memo:       req.Memo,
 			Alias:      req.Alias,
 			DataType:   req.DataType,
+			IsApprove:  req.IsApprove,
+			Approver:   req.Approver,
 		},
 	}
 	rp, err := s.client.DS.CreateApp(kt.RpcCtx(), r)
@@ -98,10 +100,12 @@ func (s *Service) UpdateApp(ctx context.Context, req *pbcs.UpdateAppReq) (*pbapp
 		Id:    req.Id,
 		BizId: req.BizId,
 		Spec: &pbapp.AppSpec{
-			Name:     req.Name,
-			Memo:     req.Memo,
-			Alias:    req.Alias,
-			DataType: req.DataType,
+			Name:      req.Name,
+			Memo:      req.Memo,
+			Alias:     req.Alias,
+			DataType:  req.DataType,
+			IsApprove: req.IsApprove,
+			Approver:  req.Approver,
 		},
 	}
 	app, err := s.client.DS.UpdateApp(grpcKit.RpcCtx(), r)
@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/config-server"
+	pbschema "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-schema"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+)
+
+// CreateConfigSchema create a config schema which validates the kv's value or the config file's content
+func (s *Service) CreateConfigSchema(ctx context.Context, req *pbcs.CreateConfigSchemaReq) (
+	*pbcs.CreateConfigSchemaResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.CreateConfigSchemaReq{
+		Attachment: &pbschema.ConfigSchemaAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbschema.ConfigSchemaSpec{
+			ResourceType: req.ResourceType,
+			ConfigKey:    req.ConfigKey,
+			SchemaType:   req.SchemaType,
+			Schema:       req.Schema,
+			Memo:         req.Memo,
+		},
+	}
+	rp, err := s.client.DS.CreateConfigSchema(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("create config schema failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.CreateConfigSchemaResp{
+		Id: rp.Id,
+	}
+	return resp, nil
+}
+
+// UpdateConfigSchema update the config schema, the resource type and config key can not be changed
+func (s *Service) UpdateConfigSchema(ctx context.Context, req *pbcs.UpdateConfigSchemaReq) (
+	*pbcs.UpdateConfigSchemaResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.UpdateConfigSchemaReq{
+		Id: req.Id,
+		Attachment: &pbschema.ConfigSchemaAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbschema.ConfigSchemaSpec{
+			SchemaType: req.SchemaType,
+			Schema:     req.Schema,
+			Memo:       req.Memo,
+		},
+	}
+	if _, err := s.client.DS.UpdateConfigSchema(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("update config schema failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateConfigSchemaResp{}, nil
+}
+
+// DeleteConfigSchema delete the config schema
+func (s *Service) DeleteConfigSchema(ctx context.Context, req *pbcs.DeleteConfigSchemaReq) (
+	*pbcs.DeleteConfigSchemaResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.DeleteConfigSchemaReq{
+		Id: req.Id,
+		Attachment: &pbschema.ConfigSchemaAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+	}
+	if _, err := s.client.DS.DeleteConfigSchema(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("delete config schema failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteConfigSchemaResp{}, nil
+}
+
+// ListConfigSchemas list config schemas of the app
+func (s *Service) ListConfigSchemas(ctx context.Context, req *pbcs.ListConfigSchemasReq) (
+	*pbcs.ListConfigSchemasResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListConfigSchemasReq{
+		BizId:        req.BizId,
+		AppId:        req.AppId,
+		ResourceType: req.ResourceType,
+		Start:        req.Start,
+		Limit:        req.Limit,
+		All:          req.All,
+	}
+	rp, err := s.client.DS.ListConfigSchemas(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list config schemas failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.ListConfigSchemasResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}
+	return resp, nil
+}
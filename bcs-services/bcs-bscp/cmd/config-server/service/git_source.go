@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/config-server"
+	pbgs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/git-source"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+)
+
+// CreateGitSource create the git source which the app's config items or kvs are synchronized from
+func (s *Service) CreateGitSource(ctx context.Context, req *pbcs.CreateGitSourceReq) (*pbcs.CreateGitSourceResp,
+	error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.CreateGitSourceReq{
+		Attachment: &pbgs.GitSourceAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbgs.GitSourceSpec{
+			Url:         req.Url,
+			Ref:         req.Ref,
+			Path:        req.Path,
+			Username:    req.Username,
+			Token:       req.Token,
+			AutoRelease: req.AutoRelease,
+			IntervalSec: req.IntervalSec,
+			Enabled:     req.Enabled,
+			Memo:        req.Memo,
+		},
+	}
+	rp, err := s.client.DS.CreateGitSource(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("create git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.CreateGitSourceResp{
+		Id: rp.Id,
+	}
+	return resp, nil
+}
+
+// UpdateGitSource update the git source, the token is kept unchanged when it is empty
+func (s *Service) UpdateGitSource(ctx context.Context, req *pbcs.UpdateGitSourceReq) (*pbcs.UpdateGitSourceResp,
+	error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.UpdateGitSourceReq{
+		Id: req.Id,
+		Attachment: &pbgs.GitSourceAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbgs.GitSourceSpec{
+			Url:         req.Url,
+			Ref:         req.Ref,
+			Path:        req.Path,
+			Username:    req.Username,
+			Token:       req.Token,
+			AutoRelease: req.AutoRelease,
+			IntervalSec: req.IntervalSec,
+			Enabled:     req.Enabled,
+			Memo:        req.Memo,
+		},
+	}
+	if _, err := s.client.DS.UpdateGitSource(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("update git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateGitSourceResp{}, nil
+}
+
+// DeleteGitSource delete the git source, the synchronized config items or kvs are kept
+func (s *Service) DeleteGitSource(ctx context.Context, req *pbcs.DeleteGitSourceReq) (*pbcs.DeleteGitSourceResp,
+	error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.DeleteGitSourceReq{
+		Id: req.Id,
+		Attachment: &pbgs.GitSourceAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+	}
+	if _, err := s.client.DS.DeleteGitSource(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("delete git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteGitSourceResp{}, nil
+}
+
+// GetGitSource get the git source of the app with its last synchronization status
+func (s *Service) GetGitSource(ctx context.Context, req *pbcs.GetGitSourceReq) (*pbgs.GitSource, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.GetGitSourceReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	}
+	rp, err := s.client.DS.GetGitSource(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("get git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return rp, nil
+}
+
+// SyncGitSource synchronize the git source immediately, force overwrites the conflicting manual changes
+func (s *Service) SyncGitSource(ctx context.Context, req *pbcs.SyncGitSourceReq) (*pbgs.GitSource, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.SyncGitSourceReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Force: req.Force,
+	}
+	rp, err := s.client.DS.SyncGitSource(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("sync git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return rp, nil
+}
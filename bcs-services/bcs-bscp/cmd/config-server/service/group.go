@@ -45,12 +45,13 @@ func (s *Service) CreateGroup(ctx context.Context, req *pbcs.CreateGroupReq) (*p
 			BizId: req.BizId,
 		},
 		Spec: &pbgroup.GroupSpec{
-			Name:     req.Name,
-			Public:   req.Public,
-			BindApps: req.BindApps,
-			Mode:     req.Mode,
-			Selector: req.Selector,
-			Uid:      req.Uid,
+			Name:      req.Name,
+			Public:    req.Public,
+			BindApps:  req.BindApps,
+			Mode:      req.Mode,
+			Selector:  req.Selector,
+			Uid:       req.Uid,
+			Variables: req.Variables,
 		},
 	}
 	rp, err := s.client.DS.CreateGroup(grpcKit.RpcCtx(), r)
@@ -176,12 +177,13 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbcs.UpdateGroupReq) (*p
 			BizId: req.BizId,
 		},
 		Spec: &pbgroup.GroupSpec{
-			Name:     req.Name,
-			Public:   req.Public,
-			BindApps: req.BindApps,
-			Mode:     req.Mode,
-			Selector: req.Selector,
-			Uid:      req.Uid,
+			Name:      req.Name,
+			Public:    req.Public,
+			BindApps:  req.BindApps,
+			Mode:      req.Mode,
+			Selector:  req.Selector,
+			Uid:       req.Uid,
+			Variables: req.Variables,
 		},
 	}
 	_, err = s.client.DS.UpdateGroup(grpcKit.RpcCtx(), r)
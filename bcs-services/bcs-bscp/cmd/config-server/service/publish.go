@@ -38,16 +38,18 @@ func (s *Service) Publish(ctx context.Context, req *pbcs.PublishReq) (
 	}
 
 	r := &pbds.PublishReq{
-		BizId:           req.BizId,
-		AppId:           req.AppId,
-		ReleaseId:       req.ReleaseId,
-		Memo:            req.Memo,
-		All:             req.All,
-		GrayPublishMode: req.GrayPublishMode,
-		Default:         req.Default,
-		Groups:          req.Groups,
-		Labels:          req.Labels,
-		GroupName:       req.GroupName,
+		BizId:               req.BizId,
+		AppId:               req.AppId,
+		ReleaseId:           req.ReleaseId,
+		Memo:                req.Memo,
+		All:                 req.All,
+		GrayPublishMode:     req.GrayPublishMode,
+		Default:             req.Default,
+		Groups:              req.Groups,
+		Labels:              req.Labels,
+		GroupName:           req.GroupName,
+		Approvers:           req.Approvers,
+		ApprovalExpireHours: req.ApprovalExpireHours,
 	}
 	rp, err := s.client.DS.Publish(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -56,8 +58,9 @@ func (s *Service) Publish(ctx context.Context, req *pbcs.PublishReq) (
 	}
 
 	resp := &pbcs.PublishResp{
-		Id:              rp.PublishedStrategyHistoryId,
-		HaveCredentials: rp.HaveCredentials,
+		Id:                rp.PublishedStrategyHistoryId,
+		HaveCredentials:   rp.HaveCredentials,
+		PublishApprovalId: rp.PublishApprovalId,
 	}
 	return resp, nil
 }
@@ -79,16 +82,18 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbcs.Gener
 	}
 
 	r := &pbds.GenerateReleaseAndPublishReq{
-		BizId:           req.BizId,
-		AppId:           req.AppId,
-		ReleaseName:     req.ReleaseName,
-		ReleaseMemo:     req.ReleaseMemo,
-		Variables:       req.Variables,
-		All:             req.All,
-		GrayPublishMode: req.GrayPublishMode,
-		Groups:          req.Groups,
-		Labels:          req.Labels,
-		GroupName:       req.GroupName,
+		BizId:               req.BizId,
+		AppId:               req.AppId,
+		ReleaseName:         req.ReleaseName,
+		ReleaseMemo:         req.ReleaseMemo,
+		Variables:           req.Variables,
+		All:                 req.All,
+		GrayPublishMode:     req.GrayPublishMode,
+		Groups:              req.Groups,
+		Labels:              req.Labels,
+		GroupName:           req.GroupName,
+		Approvers:           req.Approvers,
+		ApprovalExpireHours: req.ApprovalExpireHours,
 	}
 	rp, err := s.client.DS.GenerateReleaseAndPublish(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -97,7 +102,100 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbcs.Gener
 	}
 
 	resp := &pbcs.PublishResp{
-		Id: rp.PublishedStrategyHistoryId,
+		Id:                rp.PublishedStrategyHistoryId,
+		PublishApprovalId: rp.PublishApprovalId,
+	}
+	return resp, nil
+}
+
+// ApprovePublish approve the publish approval and publish the release
+func (s *Service) ApprovePublish(ctx context.Context, req *pbcs.ReviewPublishReq) (*pbcs.PublishResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ReviewPublishReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Id:     req.Id,
+		Reason: req.Reason,
+	}
+	rp, err := s.client.DS.ApprovePublish(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("approve publish failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.PublishResp{
+		Id:                rp.PublishedStrategyHistoryId,
+		HaveCredentials:   rp.HaveCredentials,
+		PublishApprovalId: rp.PublishApprovalId,
+	}
+	return resp, nil
+}
+
+// RejectPublish reject the publish approval
+func (s *Service) RejectPublish(ctx context.Context, req *pbcs.ReviewPublishReq) (*pbcs.RejectPublishResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ReviewPublishReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Id:     req.Id,
+		Reason: req.Reason,
+	}
+	if _, err := s.client.DS.RejectPublish(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("reject publish failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RejectPublishResp{}, nil
+}
+
+// ListPublishApprovals list publish approvals of the app
+func (s *Service) ListPublishApprovals(ctx context.Context, req *pbcs.ListPublishApprovalsReq) (
+	*pbcs.ListPublishApprovalsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListPublishApprovalsReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Status: req.Status,
+		Start:  req.Start,
+		Limit:  req.Limit,
+		All:    req.All,
+	}
+	rp, err := s.client.DS.ListPublishApprovals(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list publish approvals failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.ListPublishApprovalsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
 	}
 	return resp, nil
 }
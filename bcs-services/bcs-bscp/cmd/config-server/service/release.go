@@ -20,6 +20,7 @@ import (
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/config-server"
 	pbrelease "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release"
+	pbrd "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release-diff"
 	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
 )
 
@@ -234,3 +235,82 @@ func (s *Service) DeleteRelease(ctx context.Context, req *pbcs.DeleteReleaseReq)
 	resp := &pbcs.DeleteReleaseResp{}
 	return resp, nil
 }
+
+// CompareReleases compare two releases, or compare a release with the current editing state
+func (s *Service) CompareReleases(ctx context.Context, req *pbcs.CompareReleasesReq) (*pbrd.ReleaseDiff, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	err := s.authorizer.Authorize(kt, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &pbds.CompareReleasesReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		BaseReleaseId:   req.BaseReleaseId,
+		TargetReleaseId: req.TargetReleaseId,
+	}
+	rp, err := s.client.DS.CompareReleases(kt.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("compare release %d with %d failed, err: %v, rid: %s", req.BaseReleaseId, req.TargetReleaseId,
+			err, kt.Rid)
+		return nil, err
+	}
+
+	return rp, nil
+}
+
+// PromoteRelease promote a release to the target app as its editing state
+func (s *Service) PromoteRelease(ctx context.Context, req *pbcs.PromoteReleaseReq) (*pbcs.PromoteReleaseResp,
+	error) {
+	return s.promoteRelease(ctx, req, false)
+}
+
+// PreviewPromoteRelease preview the diff between the target app's editing state and the release to promote
+func (s *Service) PreviewPromoteRelease(ctx context.Context, req *pbcs.PromoteReleaseReq) (
+	*pbcs.PromoteReleaseResp, error) {
+	return s.promoteRelease(ctx, req, true)
+}
+
+func (s *Service) promoteRelease(ctx context.Context, req *pbcs.PromoteReleaseReq, preview bool) (
+	*pbcs.PromoteReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	targetAction := meta.Update
+	if preview {
+		targetAction = meta.View
+	}
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.TargetBizId},
+		{Basic: meta.Basic{Type: meta.App, Action: targetAction, ResourceID: req.TargetAppId}, BizID: req.TargetBizId},
+	}
+	err := s.authorizer.Authorize(kt, res...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &pbds.PromoteReleaseReq{
+		BizId:       req.BizId,
+		AppId:       req.AppId,
+		ReleaseId:   req.ReleaseId,
+		TargetBizId: req.TargetBizId,
+		TargetAppId: req.TargetAppId,
+		Variables:   req.Variables,
+		Preview:     preview,
+	}
+	rp, err := s.client.DS.PromoteRelease(kt.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("promote release %d to app %d failed, err: %v, rid: %s", req.ReleaseId, req.TargetAppId,
+			err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PromoteReleaseResp{Diff: rp.Diff, Skipped: rp.Skipped}, nil
+}
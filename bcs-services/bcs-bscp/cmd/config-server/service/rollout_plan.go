@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+)
+
+// CreateRolloutPlan create a rollout plan which publishes the release to the instances stage by stage
+func (s *Service) CreateRolloutPlan(ctx context.Context, req *pbcs.CreateRolloutPlanReq) (
+	*pbcs.CreateRolloutPlanResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.CreateRolloutPlanReq{
+		BizId:            req.BizId,
+		AppId:            req.AppId,
+		ReleaseId:        req.ReleaseId,
+		Stages:           req.Stages,
+		ObserveMinutes:   req.ObserveMinutes,
+		MinClients:       req.MinClients,
+		FailureThreshold: req.FailureThreshold,
+		OnFailure:        req.OnFailure,
+		Memo:             req.Memo,
+	}
+	rp, err := s.client.DS.CreateRolloutPlan(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("create rollout plan failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.CreateRolloutPlanResp{
+		Id: rp.Id,
+	}
+	return resp, nil
+}
+
+// ListRolloutPlans list rollout plans of the app
+func (s *Service) ListRolloutPlans(ctx context.Context, req *pbcs.ListRolloutPlansReq) (
+	*pbcs.ListRolloutPlansResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListRolloutPlansReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Status: req.Status,
+		Start:  req.Start,
+		Limit:  req.Limit,
+		All:    req.All,
+	}
+	rp, err := s.client.DS.ListRolloutPlans(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list rollout plans failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.ListRolloutPlansResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}
+	return resp, nil
+}
+
+// PauseRolloutPlan pause the running rollout plan
+func (s *Service) PauseRolloutPlan(ctx context.Context, req *pbcs.OperateRolloutPlanReq) (
+	*pbcs.OperateRolloutPlanResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.OperateRolloutPlanReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Id:     req.Id,
+		Reason: req.Reason,
+	}
+	if _, err := s.client.DS.PauseRolloutPlan(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("pause rollout plan failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.OperateRolloutPlanResp{}, nil
+}
+
+// ResumeRolloutPlan resume the paused rollout plan and promote it to the next stage
+func (s *Service) ResumeRolloutPlan(ctx context.Context, req *pbcs.OperateRolloutPlanReq) (
+	*pbcs.OperateRolloutPlanResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.OperateRolloutPlanReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Id:     req.Id,
+		Reason: req.Reason,
+	}
+	if _, err := s.client.DS.ResumeRolloutPlan(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("resume rollout plan failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.OperateRolloutPlanResp{}, nil
+}
+
+// RollbackRolloutPlan roll the instances of the rollout plan back to the previous release
+func (s *Service) RollbackRolloutPlan(ctx context.Context, req *pbcs.OperateRolloutPlanReq) (
+	*pbcs.OperateRolloutPlanResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.OperateRolloutPlanReq{
+		BizId:  req.BizId,
+		AppId:  req.AppId,
+		Id:     req.Id,
+		Reason: req.Reason,
+	}
+	if _, err := s.client.DS.RollbackRolloutPlan(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("rollback rollout plan failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.OperateRolloutPlanResp{}, nil
+}
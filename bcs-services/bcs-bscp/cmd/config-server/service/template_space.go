@@ -48,8 +48,9 @@ func (s *Service) CreateTemplateSpace(ctx context.Context, req *pbcs.CreateTempl
 			BizId: grpcKit.BizID,
 		},
 		Spec: &pbts.TemplateSpaceSpec{
-			Name: req.Name,
-			Memo: req.Memo,
+			Name:           req.Name,
+			Memo:           req.Memo,
+			TemplateEngine: req.TemplateEngine,
 		},
 	}
 	rp, err := s.client.DS.CreateTemplateSpace(grpcKit.RpcCtx(), r)
@@ -108,7 +109,8 @@ func (s *Service) UpdateTemplateSpace(ctx context.Context, req *pbcs.UpdateTempl
 			BizId: grpcKit.BizID,
 		},
 		Spec: &pbts.TemplateSpaceSpec{
-			Memo: req.Memo,
+			Memo:           req.Memo,
+			TemplateEngine: req.TemplateEngine,
 		},
 	}
 	if _, err := s.client.DS.UpdateTemplateSpace(grpcKit.RpcCtx(), r); err != nil {
@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/config-server"
+	pbwh "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/webhook"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+)
+
+// CreateWebhook create a webhook which is notified when the app's release is published, deprecated or rolled back
+func (s *Service) CreateWebhook(ctx context.Context, req *pbcs.CreateWebhookReq) (*pbcs.CreateWebhookResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.CreateWebhookReq{
+		Attachment: &pbwh.WebhookAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbwh.WebhookSpec{
+			Name:    req.Name,
+			Url:     req.Url,
+			Secret:  req.Secret,
+			Events:  req.Events,
+			Enabled: req.Enabled,
+			Memo:    req.Memo,
+		},
+	}
+	rp, err := s.client.DS.CreateWebhook(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("create webhook failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.CreateWebhookResp{
+		Id: rp.Id,
+	}
+	return resp, nil
+}
+
+// UpdateWebhook update the webhook, the secret is kept unchanged when it is empty
+func (s *Service) UpdateWebhook(ctx context.Context, req *pbcs.UpdateWebhookReq) (*pbcs.UpdateWebhookResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.UpdateWebhookReq{
+		Id: req.Id,
+		Attachment: &pbwh.WebhookAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbwh.WebhookSpec{
+			Name:    req.Name,
+			Url:     req.Url,
+			Secret:  req.Secret,
+			Events:  req.Events,
+			Enabled: req.Enabled,
+			Memo:    req.Memo,
+		},
+	}
+	if _, err := s.client.DS.UpdateWebhook(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("update webhook failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateWebhookResp{}, nil
+}
+
+// DeleteWebhook delete the webhook
+func (s *Service) DeleteWebhook(ctx context.Context, req *pbcs.DeleteWebhookReq) (*pbcs.DeleteWebhookResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.DeleteWebhookReq{
+		Id: req.Id,
+		Attachment: &pbwh.WebhookAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+	}
+	if _, err := s.client.DS.DeleteWebhook(grpcKit.RpcCtx(), r); err != nil {
+		logs.Errorf("delete webhook failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteWebhookResp{}, nil
+}
+
+// ListWebhooks list webhooks of the app
+func (s *Service) ListWebhooks(ctx context.Context, req *pbcs.ListWebhooksReq) (*pbcs.ListWebhooksResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListWebhooksReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	}
+	rp, err := s.client.DS.ListWebhooks(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list webhooks failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.ListWebhooksResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}
+	return resp, nil
+}
+
+// ListWebhookDeliveries list the delivery records of the webhook
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *pbcs.ListWebhookDeliveriesReq) (
+	*pbcs.ListWebhookDeliveriesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListWebhookDeliveriesReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		WebhookId: req.WebhookId,
+		Status:    req.Status,
+		Start:     req.Start,
+		Limit:     req.Limit,
+		All:       req.All,
+	}
+	rp, err := s.client.DS.ListWebhookDeliveries(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list webhook deliveries failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.ListWebhookDeliveriesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}
+	return resp, nil
+}
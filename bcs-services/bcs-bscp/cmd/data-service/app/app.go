@@ -139,6 +139,21 @@ func (ds *dataService) prepare(opt *options.Option) error {
 	state := crontab.NewSyncClientOnlineState(ds.daoSet, ds.sd)
 	state.Run()
 
+	// 过期未审批的上线审批
+	expireApproval := crontab.NewExpirePublishApproval(ds.daoSet, ds.sd)
+	expireApproval.Run()
+
+	// 渐进式发布计划的阶段推进与失败回滚
+	rolloutPlan := crontab.NewRolloutPlan(ds.daoSet, ds.sd)
+	rolloutPlan.Run()
+
+	// 发布事件通知到消息队列和回调地址
+	releaseNotice, err := crontab.NewReleaseNotice(ds.daoSet, ds.sd, cc.DataService().Notice)
+	if err != nil {
+		return err
+	}
+	releaseNotice.Run()
+
 	// initial Vault set
 	vaultSet, err := vault.NewSet(cc.DataService().Vault)
 	if err != nil {
@@ -213,6 +228,10 @@ func (ds *dataService) listenAndServe() error {
 	ds.service = svc
 	ds.serve = serve
 
+	// git 配置源的定时同步
+	gitSync := crontab.NewGitSync(ds.daoSet, ds.sd, svc)
+	gitSync.Run()
+
 	go func() {
 		notifier := shutdown.AddNotifier()
 		<-notifier.Signal
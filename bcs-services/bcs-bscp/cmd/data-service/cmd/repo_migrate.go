@@ -0,0 +1,200 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/repository"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+)
+
+// contentRef is a content referenced by the config items or templates
+type contentRef struct {
+	BizID           uint32
+	AppID           uint32
+	TemplateSpaceID uint32
+	Signature       string
+}
+
+// contentRefQueries the queries of all the contents saved in the repository
+var contentRefQueries = []string{
+	"SELECT DISTINCT biz_id, app_id, 0 AS template_space_id, signature FROM contents",
+	"SELECT DISTINCT biz_id, app_id, 0 AS template_space_id, signature FROM released_config_items",
+	"SELECT DISTINCT biz_id, app_id, 0 AS template_space_id, origin_signature AS signature " +
+		"FROM released_config_items",
+	"SELECT DISTINCT biz_id, 0 AS app_id, template_space_id, signature FROM template_revisions",
+	"SELECT DISTINCT biz_id, app_id, 0 AS template_space_id, signature FROM released_app_templates",
+	"SELECT DISTINCT biz_id, 0 AS app_id, template_space_id, origin_signature AS signature " +
+		"FROM released_app_templates",
+}
+
+// cmd for repository migration
+var repoMigrateCmd = &cobra.Command{
+	Use:   "repo-migrate",
+	Short: "migrate contents from the current repository to the target repository",
+	Long: "migrate all the contents referenced by config items and templates from the repository of data service " +
+		"config to the target repository, the contents which already exist in the target repository are skipped",
+	Run: func(cmd *cobra.Command, args []string) {
+		target, err := cmd.Flags().GetString("target")
+		if err != nil {
+			fmt.Println("Unable to read flag `target`, err:", err)
+			return
+		}
+		bizID, err := cmd.Flags().GetUint32("biz-id")
+		if err != nil {
+			fmt.Println("Unable to read flag `biz-id`, err:", err)
+			return
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			fmt.Println("Unable to read flag `dry-run`, err:", err)
+			return
+		}
+
+		if err = cc.LoadSettings(SysOpt); err != nil {
+			fmt.Println("load settings from config files failed, err:", err)
+			return
+		}
+
+		logs.InitLogger(cc.DataService().Log.Logs())
+
+		if err = runRepoMigrate(target, bizID, dryRun); err != nil {
+			fmt.Println("Unable to migrate repository, err:", err)
+			return
+		}
+	},
+}
+
+// runRepoMigrate copy the contents which are not exist in the target repository from the source repository
+func runRepoMigrate(target string, bizID uint32, dryRun bool) error {
+	if target == "" {
+		return errors.New("target repository config file is required")
+	}
+
+	targetConf, err := cc.LoadRepository(target)
+	if err != nil {
+		return err
+	}
+	src, err := repository.NewBaseProvider(cc.DataService().Repo)
+	if err != nil {
+		return fmt.Errorf("init source repository failed, err: %v", err)
+	}
+	dst, err := repository.NewBaseProvider(targetConf)
+	if err != nil {
+		return fmt.Errorf("init target repository failed, err: %v", err)
+	}
+
+	db, err := migrator.NewDB(false)
+	if err != nil {
+		return err
+	}
+	refs, err := listContentRefs(db, bizID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("migrate %d contents from %s repository to %s repository\n", len(refs),
+		cc.DataService().Repo.StorageType, targetConf.StorageType)
+
+	var copied, skipped int
+	failed := make([]string, 0)
+	for _, ref := range refs {
+		kt := kit.New()
+		kt.BizID, kt.AppID, kt.TmplSpaceID = ref.BizID, ref.AppID, ref.TemplateSpaceID
+
+		if _, err = dst.Metadata(kt, ref.Signature); err == nil {
+			skipped++
+			continue
+		} else if !errors.Is(err, errf.ErrFileContentNotFound) {
+			fmt.Printf("get content %s metadata from target repository failed, err: %v\n", ref.Signature, err)
+			failed = append(failed, ref.Signature)
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("content %s of biz %d will be migrated\n", ref.Signature, ref.BizID)
+			copied++
+			continue
+		}
+
+		if err = copyContent(kt, src, dst, ref.Signature); err != nil {
+			fmt.Printf("migrate content %s of biz %d failed, err: %v\n", ref.Signature, ref.BizID, err)
+			failed = append(failed, ref.Signature)
+			continue
+		}
+		copied++
+	}
+
+	fmt.Printf("repository migration done, dry run: %v, copied count: %d, skipped count: %d, failed count: %d\n",
+		dryRun, copied, skipped, len(failed))
+	if len(failed) > 0 {
+		fmt.Printf("failed contents: %v\n", failed)
+	}
+	return nil
+}
+
+// listContentRefs list the distinct contents of all the bizs, or of the given biz
+func listContentRefs(db *gorm.DB, bizID uint32) ([]*contentRef, error) {
+	exists := make(map[string]bool)
+	refs := make([]*contentRef, 0)
+	for _, query := range contentRefQueries {
+		rows := make([]*contentRef, 0)
+		q := db.Raw(query)
+		if bizID != 0 {
+			q = db.Raw(query+" WHERE biz_id = ?", bizID)
+		}
+		if err := q.Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			key := fmt.Sprintf("%d/%s", row.BizID, row.Signature)
+			if row.Signature == "" || exists[key] {
+				continue
+			}
+			exists[key] = true
+			refs = append(refs, row)
+		}
+	}
+
+	return refs, nil
+}
+
+// copyContent download the content from the source repository and upload it to the target repository
+func copyContent(kt *kit.Kit, src, dst repository.BaseProvider, sign string) error {
+	body, _, err := src.Download(kt, sign)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = dst.Upload(kt, sign, body)
+	return err
+}
+
+func init() {
+	repoMigrateCmd.Flags().StringP("target", "t", "", "config file which contains the target repository settings")
+	repoMigrateCmd.Flags().Uint32P("biz-id", "b", 0, "only migrate the contents of the biz, default is all bizs")
+	repoMigrateCmd.Flags().Bool("dry-run", false, "only print the contents to be migrated")
+
+	// Add "repo-migrate" command to the root command
+	rootCmd.AddCommand(repoMigrateCmd)
+}
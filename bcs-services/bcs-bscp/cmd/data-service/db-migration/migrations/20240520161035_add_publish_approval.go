@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240520161035",
+		Name:    "20240520161035_add_publish_approval",
+		Mode:    migrator.GormMode,
+		Up:      mig20240520161035Up,
+		Down:    mig20240520161035Down,
+	})
+}
+
+// Applications20240520161035 服务
+type Applications20240520161035 struct {
+	ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+	IsApprove bool   `gorm:"column:is_approve;type:tinyint(1);default:0;NOT NULL"`
+	Approver  string `gorm:"column:approver;type:varchar(1024);default:'';NOT NULL"`
+}
+
+// TableName gorm table name
+func (Applications20240520161035) TableName() string {
+	return "applications"
+}
+
+// mig20240520161035Up for up migration
+func mig20240520161035Up(tx *gorm.DB) error {
+	// PublishApprovals : 上线审批
+	type PublishApprovals struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID_status,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID_status,priority:2"`
+
+		ReleaseID  uint      `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL"`
+		PublishAll bool      `gorm:"column:publish_all;type:tinyint(1);default:0;NOT NULL"`
+		AsDefault  bool      `gorm:"column:as_default;type:tinyint(1);default:0;NOT NULL"`
+		Memo       string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		GroupIDs   string    `gorm:"column:group_ids;type:json;default:NULL"`
+		Approvers  string    `gorm:"column:approvers;type:json;default:NULL"`
+		Diff       string    `gorm:"column:diff;type:json;default:NULL"`
+		Status     string    `gorm:"column:status;type:varchar(20);NOT NULL;index:idx_bizID_appID_status,priority:3;index:idx_status_expireAt,priority:1"` // nolint
+		StrategyID uint      `gorm:"column:strategy_id;type:bigint(1) unsigned;default:0;NOT NULL"`
+		ExpireAt   time.Time `gorm:"column:expire_at;type:datetime(6);NOT NULL;index:idx_status_expireAt,priority:2"`
+		Reason     string    `gorm:"column:reason;type:varchar(256);default:'';NOT NULL"`
+		Creator    string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser    string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt  time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt  time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&PublishApprovals{}); err != nil {
+		return err
+	}
+
+	for _, column := range []string{"IsApprove", "Approver"} {
+		if !tx.Migrator().HasColumn(&Applications20240520161035{}, column) {
+			if err := tx.Migrator().AddColumn(&Applications20240520161035{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "publish_approvals", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20240520161035Down for down migration
+func mig20240520161035Down(tx *gorm.DB) error {
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Migrator().DropTable("publish_approvals"); err != nil {
+		return err
+	}
+
+	for _, column := range []string{"IsApprove", "Approver"} {
+		if tx.Migrator().HasColumn(&Applications20240520161035{}, column) {
+			if err := tx.Migrator().DropColumn(&Applications20240520161035{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	var resources = []string{
+		"publish_approvals",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240527103014",
+		Name:    "20240527103014_add_rollout_plan",
+		Mode:    migrator.GormMode,
+		Up:      mig20240527103014Up,
+		Down:    mig20240527103014Down,
+	})
+}
+
+// mig20240527103014Up for up migration
+func mig20240527103014Up(tx *gorm.DB) error {
+	// RolloutPlans : 渐进式发布计划
+	type RolloutPlans struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID_status,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID_status,priority:2"`
+
+		ReleaseID         uint      `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL"`
+		PreviousReleaseID uint      `gorm:"column:previous_release_id;type:bigint(1) unsigned;NOT NULL"`
+		GroupID           uint      `gorm:"column:group_id;type:bigint(1) unsigned;default:0;NOT NULL"`
+		Stages            string    `gorm:"column:stages;type:json;default:NULL"`
+		CurrentStage      uint      `gorm:"column:current_stage;type:int(10) unsigned;default:0;NOT NULL"`
+		ObserveMinutes    uint      `gorm:"column:observe_minutes;type:int(10) unsigned;NOT NULL"`
+		MinClients        uint      `gorm:"column:min_clients;type:int(10) unsigned;default:0;NOT NULL"`
+		FailureThreshold  float64   `gorm:"column:failure_threshold;type:double;default:0;NOT NULL"`
+		OnFailure         string    `gorm:"column:on_failure;type:varchar(20);NOT NULL"`
+		Status            string    `gorm:"column:status;type:varchar(20);NOT NULL;index:idx_bizID_appID_status,priority:3;index:idx_status"` // nolint
+		StageStartedAt    time.Time `gorm:"column:stage_started_at;type:datetime(6);NOT NULL"`
+		Memo              string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Reason            string    `gorm:"column:reason;type:varchar(256);default:'';NOT NULL"`
+		Creator           string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser           string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt         time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt         time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&RolloutPlans{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "rollout_plans", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20240527103014Down for down migration
+func mig20240527103014Down(tx *gorm.DB) error {
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Migrator().DropTable("rollout_plans"); err != nil {
+		return err
+	}
+
+	var resources = []string{
+		"rollout_plans",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
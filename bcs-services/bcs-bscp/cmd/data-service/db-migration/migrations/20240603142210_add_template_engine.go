@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240603142210",
+		Name:    "20240603142210_add_template_engine",
+		Mode:    migrator.GormMode,
+		Up:      mig20240603142210Up,
+		Down:    mig20240603142210Down,
+	})
+}
+
+// TemplateSpaces20240603142210 模版空间
+type TemplateSpaces20240603142210 struct {
+	ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+	TemplateEngine string `gorm:"column:template_engine;type:varchar(20);default:'simple';NOT NULL"`
+}
+
+// TableName gorm table name
+func (TemplateSpaces20240603142210) TableName() string {
+	return "template_spaces"
+}
+
+// mig20240603142210Up for up migration
+func mig20240603142210Up(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn(&TemplateSpaces20240603142210{}, "TemplateEngine") {
+		if err := tx.Migrator().AddColumn(&TemplateSpaces20240603142210{}, "TemplateEngine"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20240603142210Down for down migration
+func mig20240603142210Down(tx *gorm.DB) error {
+	if tx.Migrator().HasColumn(&TemplateSpaces20240603142210{}, "TemplateEngine") {
+		if err := tx.Migrator().DropColumn(&TemplateSpaces20240603142210{}, "TemplateEngine"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240610153020",
+		Name:    "20240610153020_add_config_schema",
+		Mode:    migrator.GormMode,
+		Up:      mig20240610153020Up,
+		Down:    mig20240610153020Down,
+	})
+}
+
+// mig20240610153020Up for up migration
+func mig20240610153020Up(tx *gorm.DB) error {
+	// ConfigSchemas : 配置校验规则
+	type ConfigSchemas struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_resType_key,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_resType_key,priority:2"`
+
+		ResourceType string    `gorm:"column:resource_type;type:varchar(20);NOT NULL;uniqueIndex:idx_bizID_appID_resType_key,priority:3"` // nolint
+		ConfigKey    string    `gorm:"column:config_key;type:varchar(255);NOT NULL;uniqueIndex:idx_bizID_appID_resType_key,priority:4"`   // nolint
+		SchemaType   string    `gorm:"column:schema_type;type:varchar(20);NOT NULL"`
+		Schema       string    `gorm:"column:schema;type:longtext;NOT NULL"`
+		Memo         string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Creator      string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser      string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt    time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt    time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ConfigSchemas{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "config_schemas", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20240610153020Down for down migration
+func mig20240610153020Down(tx *gorm.DB) error {
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Migrator().DropTable("config_schemas"); err != nil {
+		return err
+	}
+
+	var resources = []string{
+		"config_schemas",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240617104532",
+		Name:    "20240617104532_add_release_webhook",
+		Mode:    migrator.GormMode,
+		Up:      mig20240617104532Up,
+		Down:    mig20240617104532Down,
+	})
+}
+
+// mig20240617104532Up for up migration
+func mig20240617104532Up(tx *gorm.DB) error {
+	// Webhooks : 发布事件回调订阅
+	type Webhooks struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_name,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_name,priority:2"`
+
+		Name      string    `gorm:"column:name;type:varchar(255);NOT NULL;uniqueIndex:idx_bizID_appID_name,priority:3"`
+		URL       string    `gorm:"column:url;type:varchar(1024);NOT NULL"`
+		Secret    string    `gorm:"column:secret;type:varchar(128);default:'';NOT NULL"`
+		Events    string    `gorm:"column:events;type:json;default:NULL"`
+		Enabled   bool      `gorm:"column:enabled;type:tinyint(1);default:1;NOT NULL"`
+		Memo      string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser   string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// ReleaseNotices : 发布事件通知
+	type ReleaseNotices struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+
+		Event         string    `gorm:"column:event;type:varchar(20);NOT NULL"`
+		ReleaseID     uint      `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL"`
+		FromReleaseID uint      `gorm:"column:from_release_id;type:bigint(1) unsigned;default:0;NOT NULL"`
+		PublishAll    bool      `gorm:"column:publish_all;type:tinyint(1);default:0;NOT NULL"`
+		GroupIDs      string    `gorm:"column:group_ids;type:json;default:NULL"`
+		Memo          string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		State         string    `gorm:"column:state;type:varchar(20);NOT NULL;index:idx_state"`
+		Attempts      uint      `gorm:"column:attempts;type:int(10) unsigned;default:0;NOT NULL"`
+		Creator       string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		CreatedAt     time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// WebhookDeliveries : 回调投递记录
+	type WebhookDeliveries struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+
+		NoticeID      uint      `gorm:"column:notice_id;type:bigint(1) unsigned;NOT NULL"`
+		WebhookID     uint      `gorm:"column:webhook_id;type:bigint(1) unsigned;NOT NULL;index:idx_webhookID"`
+		Event         string    `gorm:"column:event;type:varchar(20);NOT NULL"`
+		Payload       string    `gorm:"column:payload;type:longtext;NOT NULL"`
+		Status        string    `gorm:"column:status;type:varchar(20);NOT NULL;index:idx_status_nextAttemptAt,priority:1"`
+		Attempts      uint      `gorm:"column:attempts;type:int(10) unsigned;default:0;NOT NULL"`
+		NextAttemptAt time.Time `gorm:"column:next_attempt_at;type:datetime(6);NOT NULL;index:idx_status_nextAttemptAt,priority:2"` // nolint
+		LastError     string    `gorm:"column:last_error;type:varchar(1024);default:'';NOT NULL"`
+		Creator       string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser       string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt     time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt     time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Webhooks{}, &ReleaseNotices{}, &WebhookDeliveries{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "webhooks", MaxID: 0, UpdatedAt: now},
+		{Resource: "release_notices", MaxID: 0, UpdatedAt: now},
+		{Resource: "webhook_deliveries", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20240617104532Down for down migration
+func mig20240617104532Down(tx *gorm.DB) error {
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Migrator().DropTable("webhooks", "release_notices", "webhook_deliveries"); err != nil {
+		return err
+	}
+
+	var resources = []string{
+		"webhooks",
+		"release_notices",
+		"webhook_deliveries",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
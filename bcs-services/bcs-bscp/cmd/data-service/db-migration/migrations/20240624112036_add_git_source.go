@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240624112036",
+		Name:    "20240624112036_add_git_source",
+		Mode:    migrator.GormMode,
+		Up:      mig20240624112036Up,
+		Down:    mig20240624112036Down,
+	})
+}
+
+// Releases20240624112036 版本
+type Releases20240624112036 struct {
+	ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+	SourceCommit string `gorm:"column:source_commit;type:varchar(64);default:'';NOT NULL"`
+}
+
+// TableName gorm table name
+func (Releases20240624112036) TableName() string {
+	return "releases"
+}
+
+// mig20240624112036Up for up migration
+func mig20240624112036Up(tx *gorm.DB) error {
+	// GitSources : git 配置源
+	type GitSources struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key;autoIncrement:false"`
+
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID,priority:2"`
+
+		URL           string     `gorm:"column:url;type:varchar(1024);NOT NULL"`
+		Ref           string     `gorm:"column:ref;type:varchar(255);default:'';NOT NULL"`
+		Path          string     `gorm:"column:path;type:varchar(1024);default:'';NOT NULL"`
+		Username      string     `gorm:"column:username;type:varchar(255);default:'';NOT NULL"`
+		Token         string     `gorm:"column:token;type:varchar(256);default:'';NOT NULL"`
+		AutoRelease   bool       `gorm:"column:auto_release;type:tinyint(1);default:0;NOT NULL"`
+		IntervalSec   uint       `gorm:"column:interval_sec;type:int(10) unsigned;default:0;NOT NULL"`
+		Enabled       bool       `gorm:"column:enabled;type:tinyint(1);default:1;NOT NULL"`
+		Memo          string     `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		LastCommit    string     `gorm:"column:last_commit;type:varchar(64);default:'';NOT NULL"`
+		LastReleaseID uint       `gorm:"column:last_release_id;type:bigint(1) unsigned;default:0;NOT NULL"`
+		SyncState     string     `gorm:"column:sync_state;type:varchar(20);default:'';NOT NULL"`
+		SyncMessage   string     `gorm:"column:sync_message;type:varchar(1024);default:'';NOT NULL"`
+		SyncedAt      *time.Time `gorm:"column:synced_at;type:datetime(6);default:NULL"`
+		Fingerprint   string     `gorm:"column:fingerprint;type:varchar(64);default:'';NOT NULL"`
+		Creator       string     `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser       string     `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt     time.Time  `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt     time.Time  `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&GitSources{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "git_sources", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	if !tx.Migrator().HasColumn(&Releases20240624112036{}, "SourceCommit") {
+		if err := tx.Migrator().AddColumn(&Releases20240624112036{}, "SourceCommit"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20240624112036Down for down migration
+func mig20240624112036Down(tx *gorm.DB) error {
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if tx.Migrator().HasColumn(&Releases20240624112036{}, "SourceCommit") {
+		if err := tx.Migrator().DropColumn(&Releases20240624112036{}, "SourceCommit"); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Migrator().DropTable("git_sources"); err != nil {
+		return err
+	}
+
+	if result := tx.Where("resource IN ?", []string{"git_sources"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
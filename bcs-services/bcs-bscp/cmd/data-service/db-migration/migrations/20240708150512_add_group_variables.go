@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20240708150512",
+		Name:    "20240708150512_add_group_variables",
+		Mode:    migrator.GormMode,
+		Up:      mig20240708150512Up,
+		Down:    mig20240708150512Down,
+	})
+}
+
+// Groups20240708150512 版本
+type Groups20240708150512 struct {
+	ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+	// Variables 分组变量，发布到分组时覆盖版本中的同名变量
+	Variables string `gorm:"column:variables;type:json;default:NULL"`
+}
+
+// TableName gorm table name
+func (Groups20240708150512) TableName() string {
+	return "groups"
+}
+
+// ReleasedGroups20240708150512 版本
+type ReleasedGroups20240708150512 struct {
+	ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+	// RenderedContents 使用分组变量渲染后的配置文件内容
+	RenderedContents string `gorm:"column:rendered_contents;type:json;default:NULL"`
+}
+
+// TableName gorm table name
+func (ReleasedGroups20240708150512) TableName() string {
+	return "released_groups"
+}
+
+// mig20240708150512Up for up migration
+func mig20240708150512Up(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn(&Groups20240708150512{}, "Variables") {
+		if err := tx.Migrator().AddColumn(&Groups20240708150512{}, "Variables"); err != nil {
+			return err
+		}
+	}
+
+	if !tx.Migrator().HasColumn(&ReleasedGroups20240708150512{}, "RenderedContents") {
+		if err := tx.Migrator().AddColumn(&ReleasedGroups20240708150512{}, "RenderedContents"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20240708150512Down for down migration
+func mig20240708150512Down(tx *gorm.DB) error {
+	if tx.Migrator().HasColumn(&Groups20240708150512{}, "Variables") {
+		if err := tx.Migrator().DropColumn(&Groups20240708150512{}, "Variables"); err != nil {
+			return err
+		}
+	}
+
+	if tx.Migrator().HasColumn(&ReleasedGroups20240708150512{}, "RenderedContents") {
+		if err := tx.Migrator().DropColumn(&ReleasedGroups20240708150512{}, "RenderedContents"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -136,6 +136,25 @@ func (s *Service) CreateConfigItem(ctx context.Context, req *pbds.CreateConfigIt
 func (s *Service) BatchUpsertConfigItems(ctx context.Context, req *pbds.BatchUpsertConfigItemsReq) (
 	*pbds.BatchUpsertConfigItemsResp, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
+	tx := s.dao.GenQuery().Begin()
+	ids, err := s.batchUpsertConfigItemsWithTx(grpcKit, tx, req)
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, grpcKit.Rid)
+		return nil, e
+	}
+	return &pbds.BatchUpsertConfigItemsResp{Ids: ids}, nil
+}
+
+// batchUpsertConfigItemsWithTx batch upsert config items with the transaction, returns the created and updated
+// config item ids, the transaction is committed or rolled back by the caller.
+func (s *Service) batchUpsertConfigItemsWithTx(grpcKit *kit.Kit, tx *gen.QueryTx,
+	req *pbds.BatchUpsertConfigItemsReq) ([]uint32, error) {
 	// 1. list all editing config items.
 	cis, err := s.dao.ConfigItem().ListAllByAppID(grpcKit, req.AppId, req.BizId)
 	if err != nil {
@@ -157,53 +176,33 @@ func (s *Service) BatchUpsertConfigItems(ctx context.Context, req *pbds.BatchUps
 		return nil, err
 	}
 	now := time.Now().UTC()
-	tx := s.dao.GenQuery().Begin()
 	createId, e := s.doBatchCreateConfigItems(grpcKit, tx, toCreate, now, req.BizId, req.AppId)
 	if e != nil {
-		if rErr := tx.Rollback(); rErr != nil {
-			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
-		}
 		return nil, e
 	}
 	updateId, e := s.doBatchUpdateConfigItemSpec(grpcKit, tx, toUpdateSpec, now,
 		req.BizId, req.AppId, editingCIMap)
 	if e != nil {
-		if rErr := tx.Rollback(); rErr != nil {
-			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
-		}
 		return nil, e
 	}
 	if e := s.doBatchUpdateConfigItemContent(grpcKit, tx, toUpdateContent, now,
 		req.BizId, req.AppId, editingCIMap); e != nil {
-		if rErr := tx.Rollback(); rErr != nil {
-			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
-		}
 		return nil, e
 	}
 	if req.ReplaceAll {
 		// if replace all,delete config items not in batch upsert request.
 		if e := s.doBatchDeleteConfigItems(grpcKit, tx, toDelete, req.BizId, req.AppId); e != nil {
-			if rErr := tx.Rollback(); rErr != nil {
-				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
-			}
 			return nil, e
 		}
 	}
 	// validate config items count.
 	if e := s.dao.ConfigItem().ValidateAppCINumber(grpcKit, tx, req.BizId, req.AppId); e != nil {
 		logs.Errorf("validate config items count failed, err: %v, rid: %s", e, grpcKit.Rid)
-		if rErr := tx.Rollback(); rErr != nil {
-			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
-		}
-		return nil, e
-	}
-	if e := tx.Commit(); e != nil {
-		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, grpcKit.Rid)
 		return nil, e
 	}
 	// 返回创建和更新的ID
 	mergedID := append(createId, updateId...) // nolint
-	return &pbds.BatchUpsertConfigItemsResp{Ids: mergedID}, nil
+	return mergedID, nil
 }
 
 func (s *Service) checkConfigItems(kt *kit.Kit, req *pbds.BatchUpsertConfigItemsReq,
@@ -0,0 +1,251 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
+	pbschema "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-schema"
+	pbkv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/kv"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// CreateConfigSchema create config schema.
+func (s *Service) CreateConfigSchema(ctx context.Context, req *pbds.CreateConfigSchemaReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.Attachment.BizId, req.Attachment.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	spec := req.Spec.ConfigSchemaSpec()
+	if (app.Spec.ConfigType == table.KV) != (spec.ResourceType == table.KvSchemaResource) {
+		return nil, fmt.Errorf("%s schema can not be attached to %s type app", spec.ResourceType, app.Spec.ConfigType)
+	}
+
+	_, err = s.dao.ConfigSchema().GetByUniqueKey(kt, req.Attachment.BizId, req.Attachment.AppId, spec.ResourceType,
+		spec.ConfigKey)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get config schema failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err == nil {
+		return nil, fmt.Errorf("config schema of %s %s already exists", spec.ResourceType, spec.ConfigKey)
+	}
+
+	schema := &table.ConfigSchema{
+		Spec:       spec,
+		Attachment: req.Attachment.ConfigSchemaAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.ConfigSchema().Create(kt, schema)
+	if err != nil {
+		logs.Errorf("create config schema failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateConfigSchema update config schema.
+func (s *Service) UpdateConfigSchema(ctx context.Context, req *pbds.UpdateConfigSchemaReq) (*pbbase.EmptyResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	schema := &table.ConfigSchema{
+		ID:         req.Id,
+		Spec:       req.Spec.ConfigSchemaSpec(),
+		Attachment: req.Attachment.ConfigSchemaAttachment(),
+		Revision: &table.Revision{
+			Reviser: kt.User,
+		},
+	}
+	if err := s.dao.ConfigSchema().Update(kt, schema); err != nil {
+		logs.Errorf("update config schema failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteConfigSchema delete config schema.
+func (s *Service) DeleteConfigSchema(ctx context.Context, req *pbds.DeleteConfigSchemaReq) (*pbbase.EmptyResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	schema := &table.ConfigSchema{
+		ID:         req.Id,
+		Attachment: req.Attachment.ConfigSchemaAttachment(),
+	}
+	if err := s.dao.ConfigSchema().Delete(kt, schema); err != nil {
+		logs.Errorf("delete config schema failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListConfigSchemas list config schemas.
+func (s *Service) ListConfigSchemas(ctx context.Context, req *pbds.ListConfigSchemasReq) (
+	*pbds.ListConfigSchemasResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.ResourceType != "" {
+		if err := table.ConfigSchemaResource(req.ResourceType).Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	details, count, err := s.dao.ConfigSchema().List(kt, req.BizId, req.AppId, req.ResourceType,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list config schemas failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListConfigSchemasResp{
+		Count:   uint32(count),
+		Details: pbschema.PbConfigSchemas(details),
+	}, nil
+}
+
+// checkKvSchemas checks the kvs' values satisfy the config schemas attached to them,
+// the errors of all the kvs are returned together so that the user can fix them at once.
+func (s *Service) checkKvSchemas(kt *kit.Kit, bizID, appID uint32, kvs []*pbkv.KvSpec) error {
+	schemas, err := s.listConfigSchemas(kt, bizID, appID, table.KvSchemaResource)
+	if err != nil {
+		return err
+	}
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	errs := make([]string, 0)
+	for _, kv := range kvs {
+		schema, ok := schemas[kv.Key]
+		if !ok {
+			continue
+		}
+		if e := schema.CheckKv(table.DataType(kv.KvType), kv.Value); e != nil {
+			errs = append(errs, fmt.Sprintf("kv %s: %v", kv.Key, e))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("kv values do not satisfy the config schemas, %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// checkCISchemas checks the config files' contents which will be released satisfy the config schemas attached to
+// them, the rendered contents are checked for the rendered config files, the key of rendered content maps are the
+// template revision id and the config item id.
+func (s *Service) checkCISchemas(kt *kit.Kit, tmplRevisions []*table.TemplateRevision, cis []*pbci.ConfigItem,
+	renderedContentMap, ciRenderedContentMap map[uint32][]byte) error {
+	schemas, err := s.listConfigSchemas(kt, kt.BizID, kt.AppID, table.CISchemaResource)
+	if err != nil {
+		return err
+	}
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	errs := make([]string, 0)
+	for _, r := range tmplRevisions {
+		p := path.Join(r.Spec.Path, r.Spec.Name)
+		schema, ok := schemas[p]
+		if !ok || r.Spec.FileType == table.Binary {
+			continue
+		}
+		content, ok := renderedContentMap[r.ID]
+		if !ok {
+			content, err = s.downloadSchemaContent(kt.GetKitForRepoTmpl(r.Attachment.TemplateSpaceID),
+				r.Spec.ContentSpec.Signature)
+			if err != nil {
+				return err
+			}
+		}
+		if e := schema.CheckContent(content); e != nil {
+			errs = append(errs, fmt.Sprintf("config file %s: %v", p, e))
+		}
+	}
+
+	for _, ci := range cis {
+		p := path.Join(ci.Spec.Path, ci.Spec.Name)
+		schema, ok := schemas[p]
+		if !ok || ci.Spec.FileType == string(table.Binary) {
+			continue
+		}
+		content, ok := ciRenderedContentMap[ci.Id]
+		if !ok {
+			content, err = s.downloadSchemaContent(kt.GetKitForRepoCfg(), ci.CommitSpec.Content.Signature)
+			if err != nil {
+				return err
+			}
+		}
+		if e := schema.CheckContent(content); e != nil {
+			errs = append(errs, fmt.Sprintf("config file %s: %v", p, e))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config files do not satisfy the config schemas, %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// listConfigSchemas list the config schemas of the app's resource type, the key of the result is the config key.
+func (s *Service) listConfigSchemas(kt *kit.Kit, bizID, appID uint32, resourceType table.ConfigSchemaResource) (
+	map[string]*table.ConfigSchemaSpec, error) {
+	schemas, err := s.dao.ConfigSchema().ListAll(kt, bizID, appID, resourceType)
+	if err != nil {
+		logs.Errorf("list config schemas failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	result := make(map[string]*table.ConfigSchemaSpec, len(schemas))
+	for _, one := range schemas {
+		result[one.Spec.ConfigKey] = one.Spec
+	}
+	return result, nil
+}
+
+// downloadSchemaContent download the config file's content from repo to check with the config schema.
+func (s *Service) downloadSchemaContent(kt *kit.Kit, signature string) ([]byte, error) {
+	body, _, err := s.repo.Download(kt, signature)
+	if err != nil {
+		logs.Errorf("download config content %s failed, err: %v, rid: %s", signature, err, kt.Rid)
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
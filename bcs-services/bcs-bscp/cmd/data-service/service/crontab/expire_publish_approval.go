@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/serviced"
+)
+
+const (
+	defaultExpirePublishApprovalInterval = 5 * time.Minute
+	expirePublishApprovalBatch           = 100
+	expiredPublishApprovalReason         = "not reviewed before expired"
+)
+
+// NewExpirePublishApproval init expire publish approval task
+func NewExpirePublishApproval(set dao.Set, sd serviced.Service) ExpirePublishApproval {
+	return ExpirePublishApproval{
+		set:   set,
+		state: sd,
+	}
+}
+
+// ExpirePublishApproval mark the pending publish approvals which are not reviewed in time as expired
+type ExpirePublishApproval struct {
+	set   dao.Set
+	state serviced.Service
+}
+
+// Run the expire publish approval task
+func (e *ExpirePublishApproval) Run() {
+	logs.Infof("start expire publish approval task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(defaultExpirePublishApprovalInterval)
+		defer ticker.Stop()
+		for {
+			kt := kit.New()
+			ctx, cancel := context.WithCancel(kt.Ctx)
+			kt.Ctx = ctx
+			kt.User = constant.BKSystemUser
+
+			select {
+			case <-notifier.Signal:
+				logs.Infof("stop expire publish approval task success")
+				cancel()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !e.state.IsMaster() {
+					logs.Infof("current service instance is slave, skip expire publish approval")
+					cancel()
+					continue
+				}
+				e.expirePublishApprovals(kt)
+				cancel()
+			}
+		}
+	}()
+}
+
+// expirePublishApprovals mark the expired pending publish approvals as expired
+func (e *ExpirePublishApproval) expirePublishApprovals(kt *kit.Kit) {
+	list, err := e.set.PublishApproval().ListExpired(kt, time.Now(), expirePublishApprovalBatch)
+	if err != nil {
+		logs.Errorf("list expired publish approvals failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	for _, pa := range list {
+		pa.Spec.Status = table.ApprovalExpired
+		pa.Spec.Reason = expiredPublishApprovalReason
+		pa.Revision.Reviser = kt.User
+
+		tx := e.set.GenQuery().Begin()
+		if err := e.set.PublishApproval().UpdateStatusWithTx(kt, tx, pa); err != nil {
+			logs.Errorf("expire publish approval %d failed, err: %v, rid: %s", pa.ID, err, kt.Rid)
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+			continue
+		}
+		if err := tx.Commit(); err != nil {
+			logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+			continue
+		}
+		logs.Infof("publish approval %d of app %d is expired, rid: %s", pa.ID, pa.Attachment.AppID, kt.Rid)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/serviced"
+)
+
+const (
+	defaultGitSyncInterval = 30 * time.Second
+)
+
+// GitSyncer synchronize the git source to the app.
+type GitSyncer interface {
+	SyncAppGitSource(kt *kit.Kit, gs *table.GitSource, force bool) error
+}
+
+// NewGitSync init git sync task
+func NewGitSync(set dao.Set, sd serviced.Service, syncer GitSyncer) GitSync {
+	return GitSync{
+		set:    set,
+		state:  sd,
+		syncer: syncer,
+	}
+}
+
+// GitSync synchronize the enabled git sources whose interval is elapsed, the git sources in conflict state
+// are skipped until they are synchronized forcibly.
+type GitSync struct {
+	set    dao.Set
+	state  serviced.Service
+	syncer GitSyncer
+}
+
+// Run the git sync task
+func (g *GitSync) Run() {
+	logs.Infof("start git sync task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(defaultGitSyncInterval)
+		defer ticker.Stop()
+		for {
+			kt := kit.New()
+			ctx, cancel := context.WithCancel(kt.Ctx)
+			kt.Ctx = ctx
+			kt.User = constant.BKSystemUser
+
+			select {
+			case <-notifier.Signal:
+				logs.Infof("stop git sync task success")
+				cancel()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !g.state.IsMaster() {
+					logs.Infof("current service instance is slave, skip git sync")
+					cancel()
+					continue
+				}
+				g.syncGitSources(kt)
+				cancel()
+			}
+		}
+	}()
+}
+
+// syncGitSources synchronize the git sources which are due one by one
+func (g *GitSync) syncGitSources(kt *kit.Kit) {
+	list, err := g.set.GitSource().ListEnabled(kt)
+	if err != nil {
+		logs.Errorf("list enabled git sources failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	now := time.Now()
+	for _, gs := range list {
+		if !gs.SyncDue(now) {
+			continue
+		}
+		if err := g.syncer.SyncAppGitSource(kt, gs, false); err != nil {
+			logs.Errorf("sync git source %d of app %d failed, err: %v, rid: %s", gs.ID, gs.Attachment.AppID, err,
+				kt.Rid)
+		}
+	}
+}
@@ -0,0 +1,521 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/msgqueue"
+	"go-micro.dev/v4/broker"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/serviced"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+const (
+	defaultReleaseNoticeInterval = 10 * time.Second
+	releaseNoticeBatch           = 100
+	webhookDeliveryBatch         = 100
+	// webhookRetryBackoff is the backoff of the first retry, it is doubled for each retry.
+	webhookRetryBackoff = 10 * time.Second
+	// maxWebhookRetryBackoff is the max backoff between the retries.
+	maxWebhookRetryBackoff = time.Hour
+	maxDeliveryErrorLength = 1024
+)
+
+// NewReleaseNotice init release notice task
+func NewReleaseNotice(set dao.Set, sd serviced.Service, opt cc.ReleaseNotice) (ReleaseNotice, error) {
+	n := ReleaseNotice{
+		set:         set,
+		state:       sd,
+		maxAttempts: uint32(opt.Webhook.MaxAttempts),
+		topic:       opt.MsgQueue.Topic,
+		client:      newWebhookClient(opt.Webhook),
+	}
+
+	if opt.MsgQueue.Enabled {
+		mq, err := newNoticeMsgQueue(opt.MsgQueue)
+		if err != nil {
+			return ReleaseNotice{}, fmt.Errorf("init release notice message queue failed, err: %v", err)
+		}
+		n.mq = mq
+	}
+
+	return n, nil
+}
+
+// newWebhookClient new the http client to post the webhooks, it refuses to connect to the loopback, private or
+// link-local addresses unless they are allowed by the config. the address is checked when dialing, so that the
+// redirect and dns rebinding can not bypass the check.
+func newWebhookClient(opt cc.WebhookNotice) *http.Client {
+	dialer := &net.Dialer{Timeout: time.Duration(opt.TimeoutSec) * time.Second}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// the proxy would connect to the webhook instead, which can not be checked
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := tools.LookupPublicIPs(ctx, host, opt.AllowInternal)
+		if err != nil {
+			return nil, err
+		}
+		// dial the checked ip rather than resolving the host again
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+	}
+
+	return &http.Client{
+		Timeout:   time.Duration(opt.TimeoutSec) * time.Second,
+		Transport: transport,
+	}
+}
+
+// newNoticeMsgQueue init the message queue which the release events are published to.
+func newNoticeMsgQueue(opt cc.MsgQueueNotice) (msgqueue.MessageQueue, error) {
+	hostname, _ := os.Hostname()
+	return msgqueue.NewMsgQueue(
+		msgqueue.CommonOpts(&msgqueue.CommonOptions{
+			QueueFlag:       true,
+			QueueKind:       msgqueue.QueueKind(opt.QueueKind),
+			ResourceToQueue: map[string]string{opt.Topic: opt.Topic},
+			Address:         opt.Address,
+		}),
+		msgqueue.Exchange(&msgqueue.ExchangeOptions{
+			Name:    opt.Exchange,
+			Durable: true,
+		}),
+		msgqueue.NatsOpts(&msgqueue.NatsOptions{
+			ClusterID:    opt.ClusterID,
+			ClientID:     "bscp-data-service-" + hostname,
+			ConnectRetry: true,
+		}),
+		msgqueue.PublishOpts(&msgqueue.PublishOptions{
+			TopicName:    opt.Topic,
+			DeliveryMode: 2,
+		}),
+		msgqueue.SubscribeOpts(&msgqueue.SubscribeOptions{
+			TopicName: opt.Topic,
+			QueueName: opt.Topic,
+		}),
+	)
+}
+
+// ReleaseNotice dispatch the release notices recorded by the publish, deprecate and rollback operations,
+// each notice is published to the message queue if it is enabled, and posted to the app's webhooks which
+// subscribe the event. the notices are delivered at least once, the receivers can deduplicate them with
+// the notice id.
+type ReleaseNotice struct {
+	set         dao.Set
+	state       serviced.Service
+	mq          msgqueue.MessageQueue
+	topic       string
+	maxAttempts uint32
+	client      *http.Client
+}
+
+// Run the release notice task
+func (r *ReleaseNotice) Run() {
+	logs.Infof("start release notice task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(defaultReleaseNoticeInterval)
+		defer ticker.Stop()
+		for {
+			kt := kit.New()
+			ctx, cancel := context.WithCancel(kt.Ctx)
+			kt.Ctx = ctx
+			kt.User = constant.BKSystemUser
+
+			select {
+			case <-notifier.Signal:
+				logs.Infof("stop release notice task success")
+				if r.mq != nil {
+					r.mq.Stop()
+				}
+				cancel()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !r.state.IsMaster() {
+					cancel()
+					continue
+				}
+				r.dispatchNotices(kt)
+				r.deliverWebhooks(kt)
+				cancel()
+			}
+		}
+	}()
+}
+
+// dispatchNotices publish the pending release notices to the message queue and create the webhook deliveries.
+func (r *ReleaseNotice) dispatchNotices(kt *kit.Kit) {
+	notices, err := r.set.ReleaseNotice().ListPending(kt, releaseNoticeBatch)
+	if err != nil {
+		logs.Errorf("list pending release notices failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	for _, notice := range notices {
+		if err := r.dispatch(kt, notice); err != nil {
+			logs.Errorf("dispatch release notice %d failed, err: %v, rid: %s", notice.ID, err, kt.Rid)
+
+			notice.Spec.Attempts++
+			state := table.NoticePending
+			if notice.Spec.Attempts >= r.maxAttempts {
+				state = table.NoticeFailed
+			}
+			if e := r.set.ReleaseNotice().UpdateState(kt, notice.ID, state, notice.Spec.Attempts); e != nil {
+				logs.Errorf("update release notice %d state failed, err: %v, rid: %s", notice.ID, e, kt.Rid)
+			}
+		}
+	}
+}
+
+// dispatch one release notice.
+func (r *ReleaseNotice) dispatch(kt *kit.Kit, notice *table.ReleaseNotice) error {
+	msg, err := r.buildMessage(kt, notice)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if r.mq != nil {
+		err = r.mq.Publish(&broker.Message{
+			Header: map[string]string{
+				"resourceType": r.topic,
+				"id":           strconv.Itoa(int(notice.ID)),
+				"event":        string(notice.Spec.Event),
+				"bizID":        strconv.Itoa(int(notice.Attachment.BizID)),
+				"appID":        strconv.Itoa(int(notice.Attachment.AppID)),
+			},
+			Body: body,
+		})
+		if err != nil {
+			return fmt.Errorf("publish to message queue failed, err: %v", err)
+		}
+	}
+
+	webhooks, err := r.set.Webhook().ListAll(kt, notice.Attachment.BizID, notice.Attachment.AppID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]*table.WebhookDelivery, 0)
+	for _, webhook := range webhooks {
+		if !webhook.Subscribed(notice.Spec.Event) {
+			continue
+		}
+		deliveries = append(deliveries, &table.WebhookDelivery{
+			Spec: &table.WebhookDeliverySpec{
+				NoticeID:      notice.ID,
+				WebhookID:     webhook.ID,
+				Event:         notice.Spec.Event,
+				Payload:       string(body),
+				Status:        table.DeliveryPending,
+				NextAttemptAt: now,
+			},
+			Attachment: &table.WebhookDeliveryAttachment{
+				BizID: notice.Attachment.BizID,
+				AppID: notice.Attachment.AppID,
+			},
+			Revision: &table.Revision{
+				Creator: kt.User,
+				Reviser: kt.User,
+			},
+		})
+	}
+
+	tx := r.set.GenQuery().Begin()
+	if err := r.set.ReleaseNotice().DispatchWithTx(kt, tx, notice, deliveries); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// buildMessage build the release event message with the release, groups and the changed items summary.
+func (r *ReleaseNotice) buildMessage(kt *kit.Kit, notice *table.ReleaseNotice) (*types.ReleaseEventMessage,
+	error) {
+	bizID, appID := notice.Attachment.BizID, notice.Attachment.AppID
+
+	app, err := r.set.App().Get(kt, bizID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("get app failed, err: %v", err)
+	}
+
+	release, err := r.set.Release().Get(kt, bizID, appID, notice.Spec.ReleaseID)
+	if err != nil {
+		return nil, fmt.Errorf("get release %d failed, err: %v", notice.Spec.ReleaseID, err)
+	}
+
+	msg := &types.ReleaseEventMessage{
+		NoticeID:    notice.ID,
+		Event:       string(notice.Spec.Event),
+		BizID:       bizID,
+		AppID:       appID,
+		AppName:     app.Spec.Name,
+		ReleaseID:   release.ID,
+		ReleaseName: release.Spec.Name,
+		All:         notice.Spec.All,
+		Groups:      make([]*types.ReleaseEventGroup, 0, len(notice.Spec.Groups)),
+		Operator:    notice.Revision.Creator,
+		Memo:        notice.Spec.Memo,
+		OccurredAt:  notice.Revision.CreatedAt,
+	}
+
+	for _, id := range notice.Spec.Groups {
+		group := &types.ReleaseEventGroup{ID: id}
+		if g, e := r.set.Group().Get(kt, id, bizID); e == nil {
+			group.Name = g.Spec.Name
+		} else if !errors.Is(e, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get group %d failed, err: %v", id, e)
+		}
+		msg.Groups = append(msg.Groups, group)
+	}
+
+	var from *table.Release
+	if notice.Spec.FromReleaseID > 0 {
+		from, err = r.set.Release().Get(kt, bizID, appID, notice.Spec.FromReleaseID)
+	} else {
+		from, err = r.set.Release().GetPrevious(kt, bizID, appID, release.ID)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("get the release compared with failed, err: %v", err)
+	}
+
+	var fromID uint32
+	if from != nil {
+		fromID = from.ID
+		msg.FromReleaseID = from.ID
+		msg.FromReleaseName = from.Spec.Name
+	}
+
+	msg.Summary, err = r.summarize(kt, app, fromID, release.ID)
+	if err != nil {
+		return nil, fmt.Errorf("summarize the changed items failed, err: %v", err)
+	}
+
+	return msg, nil
+}
+
+// summarize the changed items of the release compared with the from release, all the items of the
+// release are added when the from release is not exist.
+func (r *ReleaseNotice) summarize(kt *kit.Kit, app *table.App, fromID, releaseID uint32) (
+	*types.ReleaseChangeSummary, error) {
+
+	listItems := r.listReleasedCIs
+	if app.Spec.ConfigType == table.KV {
+		listItems = r.listReleasedKvs
+	}
+
+	items, err := listItems(kt, app.BizID, app.ID, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromItems := make(map[string]string)
+	if fromID > 0 {
+		if fromItems, err = listItems(kt, app.BizID, app.ID, fromID); err != nil {
+			return nil, err
+		}
+	}
+
+	summary := &types.ReleaseChangeSummary{Added: []string{}, Updated: []string{}, Deleted: []string{}}
+	for key, sign := range items {
+		fromSign, ok := fromItems[key]
+		switch {
+		case !ok:
+			summary.Added = append(summary.Added, key)
+		case fromSign != sign:
+			summary.Updated = append(summary.Updated, key)
+		}
+	}
+	for key := range fromItems {
+		if _, ok := items[key]; !ok {
+			summary.Deleted = append(summary.Deleted, key)
+		}
+	}
+	sort.Strings(summary.Added)
+	sort.Strings(summary.Updated)
+	sort.Strings(summary.Deleted)
+
+	return summary, nil
+}
+
+// listReleasedCIs list the released config files and templates of the release, the key of the result is the
+// absolute path of the config file, and the value is the signature of its content.
+func (r *ReleaseNotice) listReleasedCIs(kt *kit.Kit, bizID, appID, releaseID uint32) (map[string]string,
+	error) {
+	rcis, err := r.set.ReleasedCI().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]string, len(rcis))
+	for _, rci := range rcis {
+		items[path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name)] = rci.CommitSpec.Content.Signature
+	}
+
+	tmpls, _, err := r.set.ReleasedAppTemplate().List(kt, bizID, appID, releaseID, nil, &types.BasePage{All: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, tmpl := range tmpls {
+		items[path.Join(tmpl.Spec.Path, tmpl.Spec.Name)] = tmpl.Spec.Signature
+	}
+
+	return items, nil
+}
+
+// listReleasedKvs list the released kvs of the release, the key of the result is the kv key, and the value is
+// the signature of its type and value.
+func (r *ReleaseNotice) listReleasedKvs(kt *kit.Kit, bizID, _, releaseID uint32) (map[string]string, error) {
+	rkvs, err := r.set.ReleasedKv().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]string, len(rkvs))
+	for _, rkv := range rkvs {
+		items[rkv.Spec.Key] = string(rkv.Spec.KvType) + ":" + rkv.ContentSpec.Signature
+	}
+
+	return items, nil
+}
+
+// deliverWebhooks post the due webhook deliveries, the failed delivery is retried with exponential backoff.
+func (r *ReleaseNotice) deliverWebhooks(kt *kit.Kit) {
+	deliveries, err := r.set.WebhookDelivery().ListDue(kt, webhookDeliveryBatch)
+	if err != nil {
+		logs.Errorf("list due webhook deliveries failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		err := r.deliver(kt, delivery)
+
+		delivery.Spec.Attempts++
+		delivery.Revision.Reviser = kt.User
+		switch {
+		case err == nil:
+			delivery.Spec.Status = table.DeliverySucceed
+			delivery.Spec.LastError = ""
+		case delivery.Spec.Attempts >= r.maxAttempts:
+			delivery.Spec.Status = table.DeliveryFailed
+			delivery.Spec.LastError = truncateError(err)
+		default:
+			delivery.Spec.NextAttemptAt = time.Now().Add(retryBackoff(delivery.Spec.Attempts))
+			delivery.Spec.LastError = truncateError(err)
+		}
+
+		if err != nil {
+			logs.Warnf("deliver release notice %d to webhook %d failed, attempts: %d, err: %v, rid: %s",
+				delivery.Spec.NoticeID, delivery.Spec.WebhookID, delivery.Spec.Attempts, err, kt.Rid)
+		}
+
+		if e := r.set.WebhookDelivery().UpdateResult(kt, delivery); e != nil {
+			logs.Errorf("update webhook delivery %d failed, err: %v, rid: %s", delivery.ID, e, kt.Rid)
+		}
+	}
+}
+
+// deliver post the release event to the webhook, the webhook is regarded as received when it responds 2xx.
+func (r *ReleaseNotice) deliver(kt *kit.Kit, delivery *table.WebhookDelivery) error {
+	webhook, err := r.set.Webhook().Get(kt, delivery.Attachment.BizID, delivery.Attachment.AppID,
+		delivery.Spec.WebhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// the webhook is deleted, no need to retry.
+			delivery.Spec.Attempts = r.maxAttempts
+			return errors.New("webhook is deleted")
+		}
+		return err
+	}
+
+	body := []byte(delivery.Spec.Payload)
+	req, err := http.NewRequestWithContext(kt.Ctx, http.MethodPost, webhook.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "bscp-webhook")
+	req.Header.Set(types.WebhookEventHeader, string(delivery.Spec.Event))
+	req.Header.Set(types.WebhookDeliveryHeader, strconv.Itoa(int(delivery.ID)))
+	if len(webhook.Spec.Secret) != 0 {
+		req.Header.Set(types.WebhookSignatureHeader, "sha256="+tools.HmacSHA256(webhook.Spec.Secret, body))
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		return fmt.Errorf("webhook responds status code %d, body: %s", resp.StatusCode, msg)
+	}
+
+	return nil
+}
+
+// retryBackoff returns the backoff before the next attempt, it is doubled for each attempt.
+func retryBackoff(attempts uint32) time.Duration {
+	backoff := webhookRetryBackoff
+	for i := uint32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxWebhookRetryBackoff {
+			return maxWebhookRetryBackoff
+		}
+	}
+	return backoff
+}
+
+func truncateError(err error) string {
+	msg := err.Error()
+	if len(msg) > maxDeliveryErrorLength {
+		return msg[:maxDeliveryErrorLength]
+	}
+	return msg
+}
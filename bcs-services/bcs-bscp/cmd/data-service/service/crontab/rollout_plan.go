@@ -0,0 +1,198 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/serviced"
+)
+
+const (
+	defaultRolloutPlanInterval = time.Minute
+	rolloutPlanBatch           = 100
+)
+
+// NewRolloutPlan init rollout plan task
+func NewRolloutPlan(set dao.Set, sd serviced.Service) RolloutPlan {
+	return RolloutPlan{
+		set:   set,
+		state: sd,
+	}
+}
+
+// RolloutPlan watch the release change failure rate of the running rollout plans, promote the plan
+// to the next stage when the stage is observed long enough, or pause and roll back the plan when
+// the failure rate exceeds the threshold.
+type RolloutPlan struct {
+	set   dao.Set
+	state serviced.Service
+}
+
+// Run the rollout plan task
+func (r *RolloutPlan) Run() {
+	logs.Infof("start rollout plan task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(defaultRolloutPlanInterval)
+		defer ticker.Stop()
+		for {
+			kt := kit.New()
+			ctx, cancel := context.WithCancel(kt.Ctx)
+			kt.Ctx = ctx
+			kt.User = constant.BKSystemUser
+
+			select {
+			case <-notifier.Signal:
+				logs.Infof("stop rollout plan task success")
+				cancel()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !r.state.IsMaster() {
+					logs.Infof("current service instance is slave, skip rollout plan")
+					cancel()
+					continue
+				}
+				r.runRolloutPlans(kt)
+				cancel()
+			}
+		}
+	}()
+}
+
+// runRolloutPlans evaluate all the running rollout plans
+func (r *RolloutPlan) runRolloutPlans(kt *kit.Kit) {
+	list, err := r.set.RolloutPlan().ListRunning(kt, rolloutPlanBatch)
+	if err != nil {
+		logs.Errorf("list running rollout plans failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	for _, plan := range list {
+		if err := r.evaluate(kt, plan); err != nil {
+			logs.Errorf("evaluate rollout plan %d failed, err: %v, rid: %s", plan.ID, err, kt.Rid)
+		}
+	}
+}
+
+// evaluate the failure rate of the current stage, the failure rate is calculated with the
+// instances which have changed to the release since the stage is started.
+func (r *RolloutPlan) evaluate(kt *kit.Kit, plan *table.RolloutPlan) error {
+	charts, err := r.set.Client().CountReleaseChangeStatus(kt, plan.Attachment.BizID, plan.Attachment.AppID,
+		plan.Spec.ReleaseID, plan.Spec.StageStartedAt)
+	if err != nil {
+		return err
+	}
+
+	var success, failed int
+	for _, one := range charts {
+		switch table.Status(one.ReleaseChangeStatus) {
+		case table.Success:
+			success += one.Count
+		case table.Failed:
+			failed += one.Count
+		}
+	}
+	total := success + failed
+
+	if total > 0 && uint32(total) >= plan.Spec.MinClients {
+		rate := float64(failed) * 100 / float64(total)
+		if rate > plan.Spec.FailureThreshold {
+			reason := fmt.Sprintf("stage %d(%d%%) failure rate %.2f%% of %d clients exceeds threshold %.2f%%",
+				plan.Spec.CurrentStage+1, plan.StagePercent(), rate, total, plan.Spec.FailureThreshold)
+			return r.stop(kt, plan, reason)
+		}
+	}
+
+	observe := time.Duration(plan.Spec.ObserveMinutes) * time.Minute
+	if time.Since(plan.Spec.StageStartedAt) < observe || uint32(total) < plan.Spec.MinClients {
+		return nil
+	}
+
+	return r.promote(kt, plan)
+}
+
+// stop pause or roll back the rollout plan according to its failure policy.
+func (r *RolloutPlan) stop(kt *kit.Kit, plan *table.RolloutPlan, reason string) error {
+	plan.Spec.Reason = reason
+	plan.Revision.Reviser = kt.User
+
+	tx := r.set.GenQuery().Begin()
+	if plan.Spec.OnFailure == table.RolloutRollbackOnFailure {
+		plan.Spec.Status = table.RolloutRolledBack
+		if _, err := r.set.RolloutPlan().RollbackWithTx(kt, tx, plan); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+			return err
+		}
+	} else {
+		plan.Spec.Status = table.RolloutPaused
+	}
+
+	if err := r.set.RolloutPlan().UpdateWithTx(kt, tx, plan, table.RolloutRunning); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	logs.Infof("rollout plan %d of app %d is %s, %s, rid: %s", plan.ID, plan.Attachment.AppID,
+		plan.Spec.Status, reason, kt.Rid)
+	return nil
+}
+
+// promote the rollout plan to the next stage, or complete it when it is at the last stage.
+func (r *RolloutPlan) promote(kt *kit.Kit, plan *table.RolloutPlan) error {
+	plan.Revision.Reviser = kt.User
+
+	tx := r.set.GenQuery().Begin()
+	if plan.IsLastStage() {
+		plan.Spec.Status = table.RolloutCompleted
+	} else {
+		plan.Spec.CurrentStage++
+		plan.Spec.StageStartedAt = time.Now()
+		if _, err := r.set.RolloutPlan().PublishStageWithTx(kt, tx, plan); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+			return err
+		}
+	}
+
+	if err := r.set.RolloutPlan().UpdateWithTx(kt, tx, plan, table.RolloutRunning); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	logs.Infof("rollout plan %d of app %d is promoted to stage %d, status: %s, rid: %s", plan.ID,
+		plan.Attachment.AppID, plan.Spec.CurrentStage+1, plan.Spec.Status, kt.Rid)
+	return nil
+}
@@ -0,0 +1,586 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/content"
+	pbgs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/git-source"
+	pbkv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/kv"
+	pbrelease "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release"
+	pbtv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/template-variable"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/thirdparty/gitsource"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+)
+
+const (
+	// gitSyncTimeout is the timeout of fetching the git repository.
+	gitSyncTimeout = 5 * time.Minute
+	// maxGitSyncMessageLength is the max length of the git source's sync message.
+	maxGitSyncMessageLength = 1024
+	// maxGitSyncSize is the max size of the git repository downloaded and the files synchronized.
+	maxGitSyncSize = 200 * 1024 * 1024
+	// gitSyncStaleTimeout is the time after which the unfinished synchronization is considered as stale, in case
+	// the instance exits during the synchronization.
+	gitSyncStaleTimeout = 2 * gitSyncTimeout
+)
+
+// errGitSyncConflict means the editing state is modified manually since the last synchronization.
+var errGitSyncConflict = errors.New("the unreleased configs are modified manually since the last synchronization, " +
+	"force the synchronization to overwrite them")
+
+// errGitSyncing means the git source is being synchronized by the crontab or another request.
+var errGitSyncing = errors.New("the git source is being synchronized, please try again later")
+
+// CreateGitSource create the git source of the app, an app has one git source at most.
+func (s *Service) CreateGitSource(ctx context.Context, req *pbds.CreateGitSourceReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.App().Get(kt, req.Attachment.BizId, req.Attachment.AppId); err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err := validateGitURL(kt, req.Spec.GetUrl()); err != nil {
+		return nil, err
+	}
+
+	_, err := s.dao.GitSource().GetByAppID(kt, req.Attachment.BizId, req.Attachment.AppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err == nil {
+		return nil, fmt.Errorf("git source of app %d already exists", req.Attachment.AppId)
+	}
+
+	gs := &table.GitSource{
+		Spec:       req.Spec.GitSourceSpec(),
+		Attachment: req.Attachment.GitSourceAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.GitSource().Create(kt, gs)
+	if err != nil {
+		logs.Errorf("create git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateGitSource update git source, the token of the git source is kept when the token is not set.
+func (s *Service) UpdateGitSource(ctx context.Context, req *pbds.UpdateGitSourceReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.GitSource().GetByAppID(kt, req.Attachment.BizId, req.Attachment.AppId)
+	if err != nil {
+		logs.Errorf("get git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if old.ID != req.Id {
+		return nil, fmt.Errorf("git source %d not found in app %d", req.Id, req.Attachment.AppId)
+	}
+	if err = validateGitURL(kt, req.Spec.GetUrl()); err != nil {
+		return nil, err
+	}
+
+	gs := &table.GitSource{
+		ID:         req.Id,
+		Spec:       req.Spec.GitSourceSpec(),
+		Attachment: req.Attachment.GitSourceAttachment(),
+		Revision: &table.Revision{
+			Reviser: kt.User,
+		},
+	}
+	if len(gs.Spec.Token) == 0 {
+		gs.Spec.Token = old.Spec.Token
+	}
+	if err = s.dao.GitSource().Update(kt, gs); err != nil {
+		logs.Errorf("update git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// validateGitURL rejects the git url whose host resolves to a loopback, private or link-local address,
+// unless the address is allowed by the git source config.
+func validateGitURL(kt *kit.Kit, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid git url, err: %v", err)
+	}
+	allow := cc.DataService().GitSource.AllowInternal
+	if _, err = tools.LookupPublicIPs(kt.Ctx, u.Hostname(), allow); err != nil {
+		logs.Errorf("validate git url %s failed, err: %v, rid: %s", rawURL, err, kt.Rid)
+		return fmt.Errorf("invalid git url, err: %v", err)
+	}
+	return nil
+}
+
+// DeleteGitSource delete git source, the synchronized configs are kept.
+func (s *Service) DeleteGitSource(ctx context.Context, req *pbds.DeleteGitSourceReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	gs := &table.GitSource{
+		ID:         req.Id,
+		Attachment: req.Attachment.GitSourceAttachment(),
+	}
+	if err := s.dao.GitSource().Delete(kt, gs); err != nil {
+		logs.Errorf("delete git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetGitSource get the git source of the app.
+func (s *Service) GetGitSource(ctx context.Context, req *pbds.GetGitSourceReq) (*pbgs.GitSource, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	gs, err := s.dao.GitSource().GetByAppID(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get git source failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return pbgs.PbGitSource(gs), nil
+}
+
+// SyncGitSource synchronize the git source of the app immediately, and return the git source with the
+// synchronization status.
+func (s *Service) SyncGitSource(ctx context.Context, req *pbds.SyncGitSourceReq) (*pbgs.GitSource, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	gs, err := s.dao.GitSource().GetByAppID(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get git source failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	kt := grpcKit.Clone()
+	kt.BizID, kt.AppID = req.BizId, req.AppId
+	if err = s.SyncAppGitSource(kt, gs, req.Force); err != nil {
+		return nil, err
+	}
+
+	return pbgs.PbGitSource(gs), nil
+}
+
+// SyncAppGitSource import the files of the git source's latest commit into the app's editing state, and
+// generate a release if the git source is auto release. the synchronization stops with conflict state when the
+// editing state is modified manually since the last synchronization, unless it is forced. a git source is
+// synchronized by one of the crontab and the requests at the same time.
+func (s *Service) SyncAppGitSource(kt *kit.Kit, gs *table.GitSource, force bool) error {
+	kt = kt.Clone()
+	kt.BizID, kt.AppID = gs.Attachment.BizID, gs.Attachment.AppID
+
+	started, err := s.dao.GitSource().StartSync(kt, gs, time.Now().UTC().Add(-gitSyncStaleTimeout))
+	if err != nil {
+		logs.Errorf("start git source %d synchronization failed, err: %v, rid: %s", gs.ID, err, kt.Rid)
+		return err
+	}
+	if !started {
+		return errGitSyncing
+	}
+
+	status := *gs.Status
+	err = s.syncGitSource(kt, gs, &status, force)
+	switch {
+	case err == nil:
+		status.SyncState = table.GitSyncSucceed
+		status.SyncMessage = ""
+	case errors.Is(err, errGitSyncConflict):
+		status.SyncState = table.GitSyncConflict
+		status.SyncMessage = err.Error()
+	default:
+		logs.Errorf("sync git source %d of app %d failed, err: %v, rid: %s", gs.ID, gs.Attachment.AppID, err,
+			kt.Rid)
+		status.SyncState = table.GitSyncFailed
+		status.SyncMessage = err.Error()
+	}
+	if len(status.SyncMessage) > maxGitSyncMessageLength {
+		status.SyncMessage = status.SyncMessage[:maxGitSyncMessageLength]
+	}
+	now := time.Now().UTC()
+	status.SyncedAt = &now
+
+	gs.Status = &status
+	if e := s.dao.GitSource().UpdateStatus(kt, gs); e != nil {
+		logs.Errorf("update git source %d status failed, err: %v, rid: %s", gs.ID, e, kt.Rid)
+		return e
+	}
+
+	return err
+}
+
+// syncGitSource do the synchronization, and record the commit, release and editing state's fingerprint to the
+// status.
+func (s *Service) syncGitSource(kt *kit.Kit, gs *table.GitSource, status *table.GitSourceStatus,
+	force bool) error {
+	app, err := s.dao.App().Get(kt, kt.BizID, kt.AppID)
+	if err != nil {
+		return fmt.Errorf("get app failed, err: %v", err)
+	}
+
+	opt := &gitsource.Options{
+		URL:          gs.Spec.URL,
+		Ref:          gs.Spec.RefOrDefault(),
+		Path:         gs.Spec.CleanPath(),
+		Username:     gs.Spec.Username,
+		Token:        gs.Spec.Token,
+		MaxFileSize:  constant.MaxUploadContentLength,
+		MaxTotalSize: maxGitSyncSize,
+		// the address is checked again when dialing, the dns may be changed after the git source is saved.
+		AllowInternal: cc.DataService().GitSource.AllowInternal,
+	}
+	ctx, cancel := context.WithTimeout(kt.Ctx, gitSyncTimeout)
+	defer cancel()
+
+	_, commit, err := gitsource.ResolveRef(ctx, opt)
+	if err != nil {
+		return err
+	}
+
+	fingerprint, err := s.editingFingerprint(kt, app.Spec.ConfigType)
+	if err != nil {
+		return err
+	}
+	modified := status.Fingerprint != "" && status.Fingerprint != fingerprint
+	if commit == status.LastCommit && !force {
+		// nothing new in git repository, the manual changes are kept until the next commit.
+		return nil
+	}
+	if modified && !force {
+		return errGitSyncConflict
+	}
+
+	snapshot, err := gitsource.Fetch(ctx, opt)
+	if err != nil {
+		return err
+	}
+
+	// the in-process calls read the user and the request id from the incoming metadata.
+	rpcCtx := metadata.NewIncomingContext(kt.Ctx, kt.RPCMetaData())
+	switch app.Spec.ConfigType {
+	case table.File:
+		err = s.syncGitConfigItems(rpcCtx, kt, snapshot)
+	case table.KV:
+		err = s.syncGitKvs(kt, app.Spec.DataType, snapshot)
+	default:
+		err = fmt.Errorf("unsupported config type %s", app.Spec.ConfigType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if status.Fingerprint, err = s.editingFingerprint(kt, app.Spec.ConfigType); err != nil {
+		return err
+	}
+
+	// the release reads the committed editing state, so it can not be in the same transaction with the editing
+	// state. the commit is recorded after it is released, so the failed release is retried by the next
+	// synchronization, which finds the editing state unchanged and only generates the release.
+	if gs.Spec.AutoRelease {
+		releaseID, e := s.releaseGitCommit(rpcCtx, kt, snapshot.Commit)
+		if e != nil {
+			return fmt.Errorf("create release of commit %s failed, err: %v", snapshot.Commit, e)
+		}
+		status.LastReleaseID = releaseID
+	}
+	status.LastCommit = snapshot.Commit
+
+	return nil
+}
+
+// editingFingerprint calculate the fingerprint of the app's editing config items or kvs, which is used to find
+// out the manual modification. the permissions and memos are kept when synchronizing, so they are not included.
+func (s *Service) editingFingerprint(kt *kit.Kit, configType table.ConfigType) (string, error) {
+	lines := make([]string, 0)
+	switch configType {
+	case table.File:
+		cis, err := s.dao.ConfigItem().ListAllByAppID(kt, kt.AppID, kt.BizID)
+		if err != nil {
+			return "", err
+		}
+		ids := make([]uint32, 0, len(cis))
+		for _, ci := range cis {
+			ids = append(ids, ci.ID)
+		}
+		commits, err := s.dao.Commit().BatchListLatestCommits(kt, kt.BizID, kt.AppID, ids)
+		if err != nil {
+			return "", err
+		}
+		signs := make(map[uint32]string, len(commits))
+		for _, c := range commits {
+			signs[c.Attachment.ConfigItemID] = c.Spec.Content.Signature
+		}
+		for _, ci := range cis {
+			lines = append(lines, fmt.Sprintf("%s|%s|%s", path.Join(ci.Spec.Path, ci.Spec.Name), ci.Spec.FileType,
+				signs[ci.ID]))
+		}
+	case table.KV:
+		kvState := []string{
+			string(table.KvStateAdd),
+			string(table.KvStateRevise),
+			string(table.KvStateUnchange),
+		}
+		kvs, err := s.dao.Kv().ListAllByAppID(kt, kt.AppID, kt.BizID, kvState)
+		if err != nil {
+			return "", err
+		}
+		for _, kv := range kvs {
+			lines = append(lines, fmt.Sprintf("%s|%s", kv.Spec.Key, kv.ContentSpec.Signature))
+		}
+	}
+
+	sort.Strings(lines)
+	return tools.SHA256(strings.Join(lines, "\n")), nil
+}
+
+// syncGitConfigItems replace the app's editing config items with the files in the git repository, the directory
+// of the file is the config item's path, and the existing config items keep their permissions and memos.
+func (s *Service) syncGitConfigItems(ctx context.Context, kt *kit.Kit, snapshot *gitsource.Snapshot) error {
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, kt.AppID, kt.BizID)
+	if err != nil {
+		return err
+	}
+	editing := make(map[string]*table.ConfigItem, len(cis))
+	for _, ci := range cis {
+		editing[path.Join(ci.Spec.Path, ci.Spec.Name)] = ci
+	}
+
+	items := make([]*pbds.BatchUpsertConfigItemsReq_ConfigItem, 0, len(snapshot.Files))
+	for _, f := range snapshot.Files {
+		sign := tools.ByteSHA256(f.Content)
+		if err = s.uploadGitContent(kt, sign, f.Content); err != nil {
+			return fmt.Errorf("upload content of %s failed, err: %v", f.Name, err)
+		}
+
+		fileType := table.Text
+		if len(f.Content) > constant.MaxUploadTextFileSize || !utf8.Valid(f.Content) {
+			fileType = table.Binary
+		}
+		spec := &pbci.ConfigItemSpec{
+			Name:     path.Base(f.Name),
+			Path:     path.Join("/", path.Dir(f.Name)),
+			FileType: string(fileType),
+			FileMode: string(table.Unix),
+			Permission: &pbci.FilePermission{
+				User:      "root",
+				UserGroup: "root",
+				Privilege: "644",
+			},
+		}
+		if f.Executable {
+			spec.Permission.Privilege = "755"
+		}
+		if ci, ok := editing[path.Join(spec.Path, spec.Name)]; ok {
+			spec.Memo = ci.Spec.Memo
+			spec.Permission = pbci.PbFilePermission(ci.Spec.Permission)
+		}
+
+		items = append(items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{BizId: kt.BizID, AppId: kt.AppID},
+			ConfigItemSpec:       spec,
+			ContentSpec: &pbcontent.ContentSpec{
+				Signature: sign,
+				ByteSize:  uint64(len(f.Content)),
+				Md5:       tools.ByteMD5(f.Content),
+			},
+		})
+	}
+
+	_, err = s.BatchUpsertConfigItems(ctx, &pbds.BatchUpsertConfigItemsReq{
+		BizId:      kt.BizID,
+		AppId:      kt.AppID,
+		Items:      items,
+		ReplaceAll: true,
+	})
+	return err
+}
+
+// uploadGitContent upload the content to the biz's repository if it does not exist yet.
+func (s *Service) uploadGitContent(kt *kit.Kit, sign string, content []byte) error {
+	_, err := s.repo.Metadata(kt.GetKitForRepoCfg(), sign)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errf.ErrFileContentNotFound) {
+		return err
+	}
+
+	_, err = s.repo.Upload(kt.GetKitForRepoCfg(), sign, bytes.NewReader(content))
+	return err
+}
+
+// syncGitKvs replace the app's editing kvs with the files in the git repository, the file name without
+// extension is the key. the kv type is the app's data type, or is decided by the file extension for the app
+// of any data type. the existing kvs keep their memos, and the kvs not in the git repository are deleted.
+func (s *Service) syncGitKvs(kt *kit.Kit, dataType table.DataType, snapshot *gitsource.Snapshot) error {
+	kvState := []string{
+		string(table.KvStateAdd),
+		string(table.KvStateRevise),
+		string(table.KvStateUnchange),
+	}
+	kvs, err := s.dao.Kv().ListAllByAppID(kt, kt.AppID, kt.BizID, kvState)
+	if err != nil {
+		return err
+	}
+	editing := make(map[string]*table.Kv, len(kvs))
+	for _, kv := range kvs {
+		editing[kv.Spec.Key] = kv
+	}
+
+	keys := make(map[string]bool, len(snapshot.Files))
+	changed := make([]*pbds.BatchUpsertKvsReq_Kv, 0)
+	for _, f := range snapshot.Files {
+		if strings.Contains(f.Name, "/") {
+			return fmt.Errorf("file %s is in sub directory, kv app only supports the files in the directory itself",
+				f.Name)
+		}
+		key := strings.TrimSuffix(f.Name, path.Ext(f.Name))
+		if keys[key] {
+			return fmt.Errorf("file %s has the same key %s with another file", f.Name, key)
+		}
+		keys[key] = true
+
+		kvType, value := gitKvTypeValue(dataType, f)
+		spec := &pbkv.KvSpec{Key: key, KvType: string(kvType), Value: value}
+		if kv, ok := editing[key]; ok {
+			if kv.ContentSpec.Signature == tools.SHA256(value) {
+				continue
+			}
+			spec.Memo = kv.Spec.Memo
+		}
+		changed = append(changed, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{BizId: kt.BizID, AppId: kt.AppID},
+			KvSpec:       spec,
+		})
+	}
+
+	toDelete := make([]*table.Kv, 0)
+	for _, kv := range kvs {
+		if !keys[kv.Spec.Key] {
+			toDelete = append(toDelete, kv)
+		}
+	}
+
+	var toUpdate, toCreate []*table.Kv
+	if len(changed) > 0 {
+		toUpdate, toCreate, err = s.prepareBatchUpsertKvs(kt, &pbds.BatchUpsertKvsReq{
+			BizId:      kt.BizID,
+			AppId:      kt.AppID,
+			Kvs:        changed,
+			ReplaceAll: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// the upserted and deleted kvs are written in one transaction, so the editing state is either the git
+	// repository's or the previous one.
+	tx := s.dao.GenQuery().Begin()
+	if err = s.batchUpsertKvsWithTx(kt, tx, true, toUpdate, toCreate); err == nil {
+		err = s.deleteKvsWithTx(kt, tx, toDelete)
+	}
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// gitKvTypeValue decide the kv type and value of the file, the single line value of string and number type is
+// trimmed the trailing line break.
+func gitKvTypeValue(dataType table.DataType, f *gitsource.File) (table.DataType, string) {
+	value := string(f.Content)
+	kvType := dataType
+	if kvType == table.KvAny {
+		switch strings.ToLower(path.Ext(f.Name)) {
+		case ".json":
+			kvType = table.KvJson
+		case ".yaml", ".yml":
+			kvType = table.KvYAML
+		case ".xml":
+			kvType = table.KvXml
+		case ".txt":
+			kvType = table.KvText
+		default:
+			kvType = table.KvStr
+			if strings.Contains(strings.TrimRight(value, "\r\n"), "\n") {
+				kvType = table.KvText
+			}
+		}
+	}
+
+	if kvType == table.KvStr || kvType == table.KvNumber {
+		value = strings.TrimRight(value, "\r\n")
+	}
+	return kvType, value
+}
+
+// releaseGitCommit generate a release with the app's editing state for the git commit.
+func (s *Service) releaseGitCommit(ctx context.Context, kt *kit.Kit, commit string) (uint32, error) {
+	vars, err := s.dao.AppTemplateVariable().ListVariables(kt, kt.BizID, kt.AppID)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := s.CreateRelease(ctx, &pbds.CreateReleaseReq{
+		Attachment: &pbrelease.ReleaseAttachment{BizId: kt.BizID, AppId: kt.AppID},
+		Spec: &pbrelease.ReleaseSpec{
+			Name:         fmt.Sprintf("git-%s-%s", commit[:8], time.Now().Format("20060102150405")),
+			Memo:         fmt.Sprintf("synchronized from git commit %s", commit),
+			SourceCommit: commit,
+		},
+		Variables: pbtv.PbTemplateVariableSpecs(vars),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
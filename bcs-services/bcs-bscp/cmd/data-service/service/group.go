@@ -40,6 +40,10 @@ func (s *Service) CreateGroup(ctx context.Context, req *pbds.CreateGroupReq) (*p
 		logs.Errorf("get group spec from pb failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
 	}
+	if err = spec.ValidateVariables(kt); err != nil {
+		logs.Errorf("validate group variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 
 	if !req.Spec.Public && len(req.Spec.BindApps) == 0 {
 		logs.Errorf("group must bind apps when public is set to false, rid: %s", kt.Rid)
@@ -248,6 +252,10 @@ func (s *Service) UpdateGroup(ctx context.Context, req *pbds.UpdateGroupReq) (*p
 		logs.Errorf("get group spec from pb failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
 	}
+	if err = spec.ValidateVariables(kt); err != nil {
+		logs.Errorf("validate group variables failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 
 	if !req.Spec.Public && len(req.Spec.BindApps) == 0 {
 		logs.Errorf("group must bind apps when public is set to false, rid: %s", kt.Rid)
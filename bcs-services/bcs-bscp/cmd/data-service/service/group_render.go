@@ -0,0 +1,214 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tmplprocess"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// groupRenderItem is a released config item which is rendered with variables in the release.
+type groupRenderItem struct {
+	rci       *table.ReleasedConfigItem
+	processor tmplprocess.TmplProcessor
+	// origin is the content before rendered.
+	origin []byte
+}
+
+// renderGroupContents renders the release's config items which have variables with the variables of the
+// published groups, and sets the rendered contents to the publish option. the group variables override
+// the release variables with the same name, groups without variables use the release's rendered contents.
+func (s *Service) renderGroupContents(kt *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) error {
+	if opt.All || opt.Default {
+		return nil
+	}
+
+	groupIDs := make([]uint32, 0, len(opt.Groups))
+	for _, id := range opt.Groups {
+		if id != 0 {
+			groupIDs = append(groupIDs, id)
+		}
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	groups, err := s.dao.Group().ListByIDsWithTx(kt, tx, opt.BizID, groupIDs)
+	if err != nil {
+		logs.Errorf("list publish groups failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	varGroups := make([]*table.Group, 0, len(groups))
+	for _, g := range groups {
+		if len(g.Spec.Variables) > 0 {
+			varGroups = append(varGroups, g)
+		}
+	}
+	if len(varGroups) == 0 {
+		return nil
+	}
+
+	// the kit of publish may not carry the app, which is needed by the repo
+	kt = kt.Clone()
+	kt.BizID, kt.AppID = opt.BizID, opt.AppID
+
+	items, err := s.listGroupRenderItems(kt, tx, opt)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	releaseVars, err := s.dao.ReleasedAppTemplateVariable().ListVariablesWithTx(kt, tx, opt.BizID, opt.AppID,
+		opt.ReleaseID)
+	if err != nil {
+		logs.Errorf("list released template variables failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	schemas, err := s.listConfigSchemas(kt, opt.BizID, opt.AppID, table.CISchemaResource)
+	if err != nil {
+		return err
+	}
+
+	opt.GroupRenderedContents, err = s.renderGroupItems(kt, varGroups, items, releaseVars, schemas)
+	return err
+}
+
+// listGroupRenderItems list the config items rendered with variables in the release and download their
+// origin contents, the items whose rendered content is same with the origin content have no variables.
+func (s *Service) listGroupRenderItems(kt *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) (
+	[]*groupRenderItem, error) {
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDWithTx(kt, tx, opt.BizID, opt.AppID, opt.ReleaseID)
+	if err != nil {
+		logs.Errorf("list released config items failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	rendered := make([]*table.ReleasedConfigItem, 0)
+	for _, rci := range rcis {
+		content := rci.CommitSpec.Content
+		if content.OriginSignature != "" && content.Signature != content.OriginSignature {
+			rendered = append(rendered, rci)
+		}
+	}
+	if len(rendered) == 0 {
+		return nil, nil
+	}
+
+	// template config items are released with config item id 0, get their template spaces by path
+	tmpls, err := s.dao.ReleasedAppTemplate().ListAllByReleaseIDWithTx(kt, tx, opt.BizID, opt.AppID, opt.ReleaseID)
+	if err != nil {
+		logs.Errorf("list released app templates failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	tmplSpaces := make(map[string]uint32, len(tmpls))
+	spaceIDs := make([]uint32, 0)
+	for _, t := range tmpls {
+		tmplSpaces[path.Join(t.Spec.Path, t.Spec.Name)] = t.Spec.TemplateSpaceID
+		spaceIDs = append(spaceIDs, t.Spec.TemplateSpaceID)
+	}
+	engines, err := s.getSpaceEngines(kt, spaceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*groupRenderItem, 0, len(rendered))
+	for _, rci := range rendered {
+		item := &groupRenderItem{rci: rci, processor: s.tmplProc}
+		k := kt.GetKitForRepoCfg()
+		if rci.ConfigItemID == 0 {
+			spaceID, ok := tmplSpaces[path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name)]
+			if !ok {
+				return nil, fmt.Errorf("template space of released template %s not found",
+					path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name))
+			}
+			item.processor = engines[spaceID].Processor()
+			k = kt.GetKitForRepoTmpl(spaceID)
+		}
+		item.origin, err = s.downloadSchemaContent(k, rci.CommitSpec.Content.OriginSignature)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// renderGroupItems renders the items with the variables of each group, returns group id => rendered contents.
+// only the contents which are different from the release's rendered contents are returned and uploaded.
+func (s *Service) renderGroupItems(kt *kit.Kit, groups []*table.Group, items []*groupRenderItem,
+	releaseVars []*table.TemplateVariableSpec, schemas map[string]*table.ConfigSchemaSpec) (
+	map[uint32]table.GroupRenderedContents, error) {
+	result := make(map[uint32]table.GroupRenderedContents, len(groups))
+	uploaded := make(map[string]bool)
+	for _, g := range groups {
+		renderKV := groupRenderKV(releaseVars, g.Spec.Variables)
+		contents := make(table.GroupRenderedContents, 0, len(items))
+		for _, item := range items {
+			p := path.Join(item.rci.ConfigItemSpec.Path, item.rci.ConfigItemSpec.Name)
+			rendered, err := item.processor.Render(item.origin, renderKV)
+			if err != nil {
+				logs.Errorf("render %s with group %s variables failed, err: %v, rid: %s", p, g.Spec.Name, err, kt.Rid)
+				return nil, fmt.Errorf("render config file %s with group %s variables failed, err: %v",
+					p, g.Spec.Name, err)
+			}
+			signature := tools.ByteSHA256(rendered)
+			if signature == item.rci.CommitSpec.Content.Signature {
+				continue
+			}
+			if schema, ok := schemas[p]; ok && item.rci.ConfigItemSpec.FileType != table.Binary {
+				if e := schema.CheckContent(rendered); e != nil {
+					return nil, fmt.Errorf("config file %s rendered with group %s variables: %v", p, g.Spec.Name, e)
+				}
+			}
+			if !uploaded[signature] {
+				if _, err := s.repo.Upload(kt.GetKitForRepoCfg(), signature, bytes.NewReader(rendered)); err != nil {
+					logs.Errorf("upload %s rendered with group %s variables failed, err: %v, rid: %s",
+						p, g.Spec.Name, err, kt.Rid)
+					return nil, err
+				}
+				uploaded[signature] = true
+			}
+			contents = append(contents, &table.GroupRenderedContent{
+				ReleasedCIID: item.rci.ID,
+				Signature:    signature,
+				ByteSize:     uint64(len(rendered)),
+				Md5:          tools.ByteMD5(rendered),
+			})
+		}
+		result[g.ID] = contents
+	}
+
+	return result, nil
+}
+
+// groupRenderKV returns the variables to render with, the group variables override the release variables.
+func groupRenderKV(releaseVars, groupVars []*table.TemplateVariableSpec) map[string]interface{} {
+	kv := make(map[string]interface{}, len(releaseVars)+len(groupVars))
+	for _, v := range releaseVars {
+		kv[v.Name] = v.DefaultVal
+	}
+	for _, v := range groupVars {
+		kv[v.Name] = v.DefaultVal
+	}
+	return kv
+}
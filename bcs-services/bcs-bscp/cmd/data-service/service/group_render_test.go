@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"io"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/repository"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tmplprocess"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+)
+
+// uploadRepo is a repo which records the uploaded contents.
+type uploadRepo struct {
+	repository.Provider
+	uploaded map[string]string
+}
+
+func (r *uploadRepo) Upload(_ *kit.Kit, sign string, body io.Reader) (*repository.ObjectMetadata, error) {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	r.uploaded[sign] = string(content)
+	return &repository.ObjectMetadata{Sha256: sign, ByteSize: int64(len(content))}, nil
+}
+
+func TestRenderGroupItems(t *testing.T) {
+	repo := &uploadRepo{uploaded: make(map[string]string)}
+	s := &Service{repo: repo, tmplProc: tmplprocess.NewTmplProcessor()}
+
+	origin := []byte("env={{ .bk_bscp_env }}, zone={{ .bk_bscp_zone }}")
+	releaseRendered := []byte("env=prod, zone=sh")
+	items := []*groupRenderItem{{
+		rci: &table.ReleasedConfigItem{
+			ID:             7,
+			ConfigItemSpec: &table.ConfigItemSpec{Name: "app.conf", Path: "/etc", FileType: table.Text},
+			CommitSpec: &table.ReleasedCommitSpec{Content: &table.ReleasedContentSpec{
+				Signature: tools.ByteSHA256(releaseRendered), OriginSignature: tools.ByteSHA256(origin)}},
+		},
+		processor: s.tmplProc,
+		origin:    origin,
+	}}
+	releaseVars := []*table.TemplateVariableSpec{
+		{Name: "bk_bscp_env", DefaultVal: "prod"},
+		{Name: "bk_bscp_zone", DefaultVal: "sh"},
+	}
+	groups := []*table.Group{
+		// overrides one of the release variables
+		{ID: 1, Spec: &table.GroupSpec{Name: "gz", Variables: table.AppVariables{
+			{Name: "bk_bscp_zone", DefaultVal: "gz"},
+		}}},
+		// has the same values with the release
+		{ID: 2, Spec: &table.GroupSpec{Name: "sh", Variables: table.AppVariables{
+			{Name: "bk_bscp_zone", DefaultVal: "sh"},
+		}}},
+	}
+
+	result, err := s.renderGroupItems(kit.New(), groups, items, releaseVars, nil)
+	if err != nil {
+		t.Fatalf("render group items failed, err: %v", err)
+	}
+
+	want := "env=prod, zone=gz"
+	if len(result[1]) != 1 {
+		t.Fatalf("group gz should have 1 rendered content, got %d", len(result[1]))
+	}
+	got := result[1][0]
+	if got.ReleasedCIID != 7 || got.Signature != tools.ByteSHA256([]byte(want)) ||
+		got.ByteSize != uint64(len(want)) || got.Md5 != tools.ByteMD5([]byte(want)) {
+		t.Errorf("group gz rendered content is %+v, want content %q", got, want)
+	}
+	if repo.uploaded[got.Signature] != want {
+		t.Errorf("uploaded content is %q, want %q", repo.uploaded[got.Signature], want)
+	}
+
+	// rendered same with the release, use the release's content
+	if contents, ok := result[2]; !ok || len(contents) != 0 {
+		t.Errorf("group sh should have no rendered content, got %v", contents)
+	}
+	if len(repo.uploaded) != 1 {
+		t.Errorf("only the content of group gz should be uploaded, got %d uploaded", len(repo.uploaded))
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
@@ -54,6 +55,9 @@ func (s *Service) CreateKv(ctx context.Context, req *pbds.CreateKvReq) (*pbds.Cr
 	if !checkKVTypeMatch(table.DataType(req.Spec.KvType), app.Spec.DataType) {
 		return nil, fmt.Errorf("kv type does not match the data type defined in the application")
 	}
+	if err = s.checkKvSchemas(kt, req.Attachment.BizId, req.Attachment.AppId, []*pbkv.KvSpec{req.Spec}); err != nil {
+		return nil, err
+	}
 
 	opt := &types.UpsertKvOption{
 		BizID:  req.Attachment.BizId,
@@ -116,6 +120,13 @@ func (s *Service) UpdateKv(ctx context.Context, req *pbds.UpdateKvReq) (*pbbase.
 		logs.Errorf("get kv (%d) failed, err: %v, rid: %s", req.Spec.Key, err, kt.Rid)
 		return nil, err
 	}
+	if err = s.checkKvSchemas(kt, req.Attachment.BizId, req.Attachment.AppId, []*pbkv.KvSpec{{
+		Key:    kv.Spec.Key,
+		KvType: string(kv.Spec.KvType),
+		Value:  req.Spec.Value,
+	}}); err != nil {
+		return nil, err
+	}
 
 	opt := &types.UpsertKvOption{
 		BizID:  req.Attachment.BizId,
@@ -260,15 +271,51 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 	// FromGrpcContext used only to obtain Kit through grpc context.
 	kt := kit.FromGrpcContext(ctx)
 
+	toUpdate, toCreate, err := s.prepareBatchUpsertKvs(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+
+	if err = s.batchUpsertKvsWithTx(kt, tx, req.ReplaceAll, toUpdate, toCreate); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+	createId := []uint32{}
+	updateId := []uint32{}
+	for _, item := range toCreate {
+		createId = append(createId, item.ID)
+	}
+	for _, item := range toUpdate {
+		updateId = append(updateId, item.ID)
+	}
+	mergedID := append(createId, updateId...) // nolint
+	return &pbds.BatchUpsertKvsResp{
+		Ids: mergedID,
+	}, nil
+}
+
+// prepareBatchUpsertKvs validate the kvs to upsert and write their values to vault, returns the kvs to update
+// and create, the new vault versions are not visible until the kvs are written to db.
+func (s *Service) prepareBatchUpsertKvs(kt *kit.Kit, req *pbds.BatchUpsertKvsReq) (
+	toUpdate, toCreate []*table.Kv, err error) {
 	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
 	if err != nil {
-		return nil, fmt.Errorf("get app fail,err : %v", err)
+		return nil, nil, fmt.Errorf("get app fail,err : %v", err)
 	}
 
 	var editingKeyArr []string
 	for _, kv := range req.Kvs {
 		if !checkKVTypeMatch(table.DataType(kv.KvSpec.KvType), app.Spec.DataType) {
-			return nil, fmt.Errorf("kv type does not match the data type defined in the application")
+			return nil, nil, fmt.Errorf("kv type does not match the data type defined in the application")
 		}
 		editingKeyArr = append(editingKeyArr, kv.KvSpec.Key)
 	}
@@ -280,12 +327,7 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 	editingKv, err := s.dao.Kv().ListAllKvByKey(kt, req.AppId, req.BizId, editingKeyArr, kvStateArr)
 	if err != nil {
 		logs.Errorf("list editing kv failed, err: %v, rid: %s", err, kt.Rid)
-		return nil, err
-	}
-
-	newKvMap := make(map[string]*pbds.BatchUpsertKvsReq_Kv)
-	for _, kv := range req.Kvs {
-		newKvMap[kv.KvSpec.Key] = kv
+		return nil, nil, err
 	}
 
 	editingKvMap := make(map[string]*table.Kv)
@@ -293,53 +335,45 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 		editingKvMap[kv.Spec.Key] = kv
 	}
 
-	// 在vault中执行更新
-	versionMap, err := s.doBatchUpsertVault(kt, req, editingKvMap)
-	if err != nil {
-		return nil, err
+	// the existing kvs keep their kv type, so check them with the editing kv type
+	kvSpecs := make([]*pbkv.KvSpec, 0, len(req.Kvs))
+	for _, kv := range req.Kvs {
+		kvType := kv.KvSpec.KvType
+		if editing, exists := editingKvMap[kv.KvSpec.Key]; exists {
+			kvType = string(editing.Spec.KvType)
+		}
+		kvSpecs = append(kvSpecs, &pbkv.KvSpec{Key: kv.KvSpec.Key, KvType: kvType, Value: kv.KvSpec.Value})
+	}
+	if err = s.checkKvSchemas(kt, req.BizId, req.AppId, kvSpecs); err != nil {
+		return nil, nil, err
 	}
 
-	toUpdate, toCreate, err := s.checkKvs(kt, req, editingKvMap, versionMap)
+	// 在vault中执行更新
+	versionMap, err := s.doBatchUpsertVault(kt, req, editingKvMap)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	tx := s.dao.GenQuery().Begin()
+	return s.checkKvs(kt, req, editingKvMap, versionMap)
+}
 
+// batchUpsertKvsWithTx write the prepared kvs to db with the transaction, the transaction is committed or rolled
+// back by the caller.
+func (s *Service) batchUpsertKvsWithTx(kt *kit.Kit, tx *gen.QueryTx, replaceAll bool,
+	toUpdate, toCreate []*table.Kv) error {
 	if len(toCreate) > 0 {
-		if err = s.dao.Kv().BatchCreateWithTx(kt, tx, toCreate); err != nil {
-			if rErr := tx.Rollback(); rErr != nil {
-				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
-			}
-			return nil, err
+		if err := s.dao.Kv().BatchCreateWithTx(kt, tx, toCreate); err != nil {
+			return err
 		}
 	}
 
-	if len(toUpdate) > 0 && req.ReplaceAll {
-		if err = s.dao.Kv().BatchUpdateWithTx(kt, tx, toUpdate); err != nil {
-			if rErr := tx.Rollback(); rErr != nil {
-				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
-			}
-			return nil, err
+	if len(toUpdate) > 0 && replaceAll {
+		if err := s.dao.Kv().BatchUpdateWithTx(kt, tx, toUpdate); err != nil {
+			return err
 		}
 	}
 
-	if e := tx.Commit(); e != nil {
-		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
-		return nil, e
-	}
-	createId := []uint32{}
-	updateId := []uint32{}
-	for _, item := range toCreate {
-		createId = append(createId, item.ID)
-	}
-	for _, item := range toUpdate {
-		updateId = append(updateId, item.ID)
-	}
-	mergedID := append(createId, updateId...) // nolint
-	return &pbds.BatchUpsertKvsResp{
-		Ids: mergedID,
-	}, nil
+	return nil
 }
 
 func (s *Service) getKv(kt *kit.Kit, bizID, appID, version uint32, key string) (table.DataType, string, error) {
@@ -103,6 +103,24 @@ func (s *Service) Publish(ctx context.Context, req *pbds.PublishReq) (*pbds.Publ
 		},
 	}
 
+	app, err := s.dao.App().Get(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if needPublishApproval(app, req.Approvers) {
+		return s.publishWithApproval(grpcKit, tx, app, opt, req.Approvers, req.ApprovalExpireHours)
+	}
+
+	if err = s.renderGroupContents(grpcKit, tx, opt); err != nil {
+		logs.Errorf("render config items with group variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
 	pshID, err := s.dao.Publish().PublishWithTx(grpcKit, tx, opt)
 	if err != nil {
 		logs.Errorf("publish strategy failed, err: %v, rid: %s", err, grpcKit.Rid)
@@ -130,6 +148,25 @@ func (s *Service) Publish(ctx context.Context, req *pbds.PublishReq) (*pbds.Publ
 	return resp, nil
 }
 
+// publishWithApproval submit a publish approval with the transaction, the release will be
+// published after the publish approval is approved.
+func (s *Service) publishWithApproval(kt *kit.Kit, tx *gen.QueryTx, app *table.App, opt *types.PublishOption,
+	approvers []string, expireHours uint32) (*pbds.PublishResp, error) {
+	paID, err := s.submitPublishApproval(kt, tx, app, opt, approvers, expireHours)
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.PublishResp{PublishApprovalId: paID}, nil
+}
+
 // checkAppHaveCredentials check if there is available credential for app.
 // 1. credential scope can match app name.
 // 2. credential is enabled.
@@ -277,6 +314,17 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 			Creator: kt.User,
 		},
 	}
+	if needPublishApproval(app, req.Approvers) {
+		return s.publishWithApproval(kt, tx, app, opt, req.Approvers, req.ApprovalExpireHours)
+	}
+
+	if err = s.renderGroupContents(kt, tx, opt); err != nil {
+		logs.Errorf("render config items with group variables failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
 	pshID, err := s.dao.Publish().PublishWithTx(kt, tx, opt)
 	if err != nil {
 		logs.Errorf("publish strategy failed, err: %v, rid: %s", err, kt.Rid)
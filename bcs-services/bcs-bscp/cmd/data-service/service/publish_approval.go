@@ -0,0 +1,264 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbpa "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/publish-approval"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// ApprovePublish approve the publish approval, and publish the release with the options recorded
+// in the publish approval, the release is invisible to the clients until it is approved.
+func (s *Service) ApprovePublish(ctx context.Context, req *pbds.ReviewPublishReq) (*pbds.PublishResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	pa, err := s.dao.PublishApproval().Get(grpcKit, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get publish approval %d failed, err: %v, rid: %s", req.Id, err, grpcKit.Rid)
+		return nil, err
+	}
+	if err = pa.ValidateReview(grpcKit.User); err != nil {
+		return nil, err
+	}
+
+	release, err := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, pa.Spec.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+	if release.Spec.Deprecated {
+		return nil, fmt.Errorf("release %s is deprecated, can not be published", release.Spec.Name)
+	}
+
+	opt := &types.PublishOption{
+		BizID:     req.BizId,
+		AppID:     req.AppId,
+		ReleaseID: pa.Spec.ReleaseID,
+		All:       pa.Spec.All,
+		Default:   pa.Spec.AsDefault,
+		Memo:      pa.Spec.Memo,
+		Groups:    pa.Spec.Groups,
+		Revision: &table.CreatedRevision{
+			Creator: grpcKit.User,
+		},
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.renderGroupContents(grpcKit, tx, opt); err != nil {
+		logs.Errorf("render config items with group variables failed, err: %v, rid: %s", err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	pshID, err := s.dao.Publish().PublishWithTx(grpcKit, tx, opt)
+	if err != nil {
+		logs.Errorf("publish strategy failed, err: %v, rid: %s", err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	pa.Spec.Status = table.ApprovalApproved
+	pa.Spec.StrategyID = pshID
+	pa.Spec.Reason = req.Reason
+	pa.Revision.Reviser = grpcKit.User
+	if err = s.dao.PublishApproval().UpdateStatusWithTx(grpcKit, tx, pa); err != nil {
+		logs.Errorf("update publish approval %d status failed, err: %v, rid: %s", pa.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	haveCredentials, err := s.checkAppHaveCredentials(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbds.PublishResp{
+		PublishedStrategyHistoryId: pshID,
+		HaveCredentials:            haveCredentials,
+		PublishApprovalId:          pa.ID,
+	}, nil
+}
+
+// RejectPublish reject the publish approval, the release will not be published.
+func (s *Service) RejectPublish(ctx context.Context, req *pbds.ReviewPublishReq) (*pbbase.EmptyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	pa, err := s.dao.PublishApproval().Get(grpcKit, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get publish approval %d failed, err: %v, rid: %s", req.Id, err, grpcKit.Rid)
+		return nil, err
+	}
+	if err = pa.ValidateReview(grpcKit.User); err != nil {
+		return nil, err
+	}
+
+	pa.Spec.Status = table.ApprovalRejected
+	pa.Spec.Reason = req.Reason
+	pa.Revision.Reviser = grpcKit.User
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.PublishApproval().UpdateStatusWithTx(grpcKit, tx, pa); err != nil {
+		logs.Errorf("update publish approval %d status failed, err: %v, rid: %s", pa.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListPublishApprovals list publish approvals of the app.
+func (s *Service) ListPublishApprovals(ctx context.Context, req *pbds.ListPublishApprovalsReq) (
+	*pbds.ListPublishApprovalsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if req.Status != "" {
+		if err := table.ApprovalStatus(req.Status).Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	details, count, err := s.dao.PublishApproval().List(grpcKit, req.BizId, req.AppId, req.Status,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list publish approvals failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListPublishApprovalsResp{
+		Count:   uint32(count),
+		Details: pbpa.PbPublishApprovals(details),
+	}, nil
+}
+
+// needPublishApproval returns whether the publish of the app needs to be approved,
+// the publish with approvers also needs to be approved even if the app does not enable it.
+func needPublishApproval(app *table.App, approvers []string) bool {
+	return app.Spec.IsApprove || len(approvers) > 0
+}
+
+// submitPublishApproval create a publish approval instead of publishing the release directly,
+// the release is published after the publish approval is approved.
+func (s *Service) submitPublishApproval(kt *kit.Kit, tx *gen.QueryTx, app *table.App, opt *types.PublishOption,
+	approvers []string, expireHours uint32) (uint32, error) {
+	if err := opt.Validate(); err != nil {
+		return 0, err
+	}
+
+	// only one pending publish approval is allowed for one app, to avoid the releases overwrite each other.
+	pending, err := s.dao.PublishApproval().GetPending(kt, opt.BizID, opt.AppID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+	if err == nil && !pending.IsExpired(time.Now()) {
+		return 0, fmt.Errorf("app %s already has a pending publish approval %d", app.Spec.Name, pending.ID)
+	}
+
+	// the approvers configured by the app can not be bypassed by the approvers specified in the request.
+	if app.Spec.IsApprove {
+		if len(approvers) > 0 {
+			logs.Warnf("app %d enabled publish approval, ignore the approvers %v in request, rid: %s",
+				app.ID, approvers, kt.Rid)
+		}
+		approvers = app.Spec.Approvers()
+	}
+	if len(uniqueApprovers(approvers)) == 0 {
+		return 0, errors.New("publish approval has no approvers")
+	}
+	if expireHours == 0 {
+		expireHours = table.DefaultApprovalExpireHours
+	}
+	if expireHours > table.MaxApprovalExpireHours {
+		return 0, fmt.Errorf("approval expire hours should be less than %d", table.MaxApprovalExpireHours)
+	}
+
+	diff, err := s.dao.PublishApproval().DiffWithTx(kt, tx, opt)
+	if err != nil {
+		logs.Errorf("diff release %d with published releases failed, err: %v, rid: %s", opt.ReleaseID, err, kt.Rid)
+		return 0, err
+	}
+
+	pa := &table.PublishApproval{
+		Spec: &table.PublishApprovalSpec{
+			ReleaseID: opt.ReleaseID,
+			All:       opt.All,
+			AsDefault: opt.Default,
+			Memo:      opt.Memo,
+			Groups:    opt.Groups,
+			Approvers: uniqueApprovers(approvers),
+			Diff:      diff,
+			Status:    table.ApprovalPending,
+			ExpireAt:  time.Now().Add(time.Duration(expireHours) * time.Hour),
+		},
+		Attachment: &table.PublishApprovalAttachment{
+			BizID: opt.BizID,
+			AppID: opt.AppID,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.PublishApproval().CreateWithTx(kt, tx, pa)
+	if err != nil {
+		logs.Errorf("create publish approval failed, err: %v, rid: %s", err, kt.Rid)
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// uniqueApprovers trim and remove the duplicated approvers.
+func uniqueApprovers(approvers []string) []string {
+	exists := make(map[string]bool, len(approvers))
+	result := make([]string, 0, len(approvers))
+	for _, one := range approvers {
+		one = strings.TrimSpace(one)
+		if one == "" || exists[one] {
+			continue
+		}
+		exists[one] = true
+		result = append(result, one)
+	}
+	return result
+}
@@ -16,6 +16,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"time"
 
 	pbstruct "github.com/golang/protobuf/ptypes/struct"
@@ -192,7 +193,11 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 		logs.Errorf("get variables failed, err: %v, rid: %s", err, kt.Rid)
 		return err
 	}
-	tmplsNeedRender = filterVarsForTmplRevisions(tmplsNeedRender, vars)
+	engines, err := s.getTmplSpaceEngines(kt, tmplsNeedRender)
+	if err != nil {
+		return err
+	}
+	tmplsNeedRender = filterVarsForTmplRevisions(tmplsNeedRender, vars, engines)
 	cisNeedRender = filterVarsForConfigItems(cisNeedRender, ciVars)
 
 	contents, err := s.downloadTmplContent(kt, tmplsNeedRender)
@@ -218,10 +223,16 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 	md5Map := make(map[uint32]string, len(tmplRevisions))
 	byteSizeMap := make(map[uint32]uint64, len(tmplRevisions))
 	revisionMap := make(map[uint32]*table.TemplateRevision, len(tmplRevisions))
-	// data which need render
+	// data which need render, the contents are rendered with the release variables here, and rendered
+	// again with the group variables when the release is published to the groups which have variables.
 	for idx, r := range tmplsNeedRender {
 		revisionMap[r.ID] = r
-		renderedContentMap[r.ID] = s.tmplProc.Render(contents[idx], renderKV)
+		rendered, e := engines[r.Attachment.TemplateSpaceID].Processor().Render(contents[idx], renderKV)
+		if e != nil {
+			logs.Errorf("render template %s failed, err: %v, rid: %s", path.Join(r.Spec.Path, r.Spec.Name), e, kt.Rid)
+			return fmt.Errorf("render template %s failed, err: %v", path.Join(r.Spec.Path, r.Spec.Name), e)
+		}
+		renderedContentMap[r.ID] = rendered
 		signatureMap[r.ID] = tools.ByteSHA256(renderedContentMap[r.ID])
 		md5Map[r.ID] = tools.ByteMD5(renderedContentMap[r.ID])
 		byteSizeMap[r.ID] = uint64(len(renderedContentMap[r.ID]))
@@ -246,7 +257,12 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 	// data which need render
 	for idx, ci := range cisNeedRender {
 		ciMap[ci.Id] = ci
-		ciRenderedContentMap[ci.Id] = s.tmplProc.Render(ciContents[idx], renderKV)
+		rendered, e := s.tmplProc.Render(ciContents[idx], renderKV)
+		if e != nil {
+			logs.Errorf("render config item %s failed, err: %v, rid: %s", path.Join(ci.Spec.Path, ci.Spec.Name), e, kt.Rid)
+			return fmt.Errorf("render config item %s failed, err: %v", path.Join(ci.Spec.Path, ci.Spec.Name), e)
+		}
+		ciRenderedContentMap[ci.Id] = rendered
 		ciSignatureMap[ci.Id] = tools.ByteSHA256(ciRenderedContentMap[ci.Id])
 		ciMd5Map[ci.Id] = tools.ByteMD5(ciRenderedContentMap[ci.Id])
 		ciByteSizeMap[ci.Id] = uint64(len(ciRenderedContentMap[ci.Id]))
@@ -262,6 +278,11 @@ func (s *Service) doConfigItemOperations(kt *kit.Kit, variables []*pbtv.Template
 		ciByteSizeMap[ci.Id] = ci.CommitSpec.Content.ByteSize
 	}
 
+	// re-check the config files with the config schemas in case the schemas changed after editing
+	if e := s.checkCISchemas(kt, tmplRevisions, cis, renderedContentMap, ciRenderedContentMap); e != nil {
+		return e
+	}
+
 	// upload rendered template content
 	if e := s.uploadRenderedTmplContent(kt, renderedContentMap, signatureMap, revisionMap); e != nil {
 		logs.Errorf("upload rendered template failed, err: %v, rid: %s", e, kt.Rid)
@@ -832,6 +853,15 @@ func (s *Service) doKvOperations(kt *kit.Kit, tx *gen.QueryTx, appID, bizID, rel
 		return err
 	}
 
+	// re-check the kvs with the config schemas in case the schemas changed after editing
+	kvSpecs := make([]*pbkv.KvSpec, 0, len(kvs))
+	for _, kv := range kvs {
+		kvSpecs = append(kvSpecs, kv.Spec)
+	}
+	if err = s.checkKvSchemas(kt, bizID, appID, kvSpecs); err != nil {
+		return err
+	}
+
 	versionMap, err := s.doBatchReleasedVault(kt, kvs, releaseID)
 	if err != nil {
 		return err
@@ -0,0 +1,690 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbrd "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release-diff"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+const (
+	diffTypeAdd    = "add"
+	diffTypeDelete = "delete"
+	diffTypeModify = "modify"
+
+	// editingStateName is the name of the current editing state in the unified diff header
+	editingStateName = "editing"
+
+	// diffContextLines is the number of context lines of unified diff
+	diffContextLines = 3
+)
+
+// compareCI is a config item to compare, released config item and editing config item are converted to it.
+type compareCI struct {
+	name      string
+	path      string
+	fileType  string
+	user      string
+	userGroup string
+	privilege string
+	signature string
+	byteSize  uint64
+}
+
+// compareKv is a kv to compare, released kv and editing kv are converted to it.
+type compareKv struct {
+	key       string
+	kvType    string
+	version   uint32
+	signature string
+}
+
+// compareTmpl is a template revision to compare.
+type compareTmpl struct {
+	templateID   uint32
+	name         string
+	path         string
+	spaceName    string
+	setName      string
+	revisionID   uint32
+	revisionName string
+	signature    string
+}
+
+// CompareReleases compare two releases of an app, or compare a release with the app's current editing state.
+func (s *Service) CompareReleases(ctx context.Context, req *pbds.CompareReleasesReq) (*pbrd.ReleaseDiff, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+	// the caller may not carry the app in the metadata, the contents are downloaded with the kit
+	grpcKit.BizID, grpcKit.AppID = req.BizId, req.AppId
+
+	if req.BaseReleaseId == 0 {
+		return nil, errors.New("base release id is required")
+	}
+	if req.BaseReleaseId == req.TargetReleaseId {
+		return nil, errors.New("base release and target release can not be the same")
+	}
+
+	app, err := s.dao.App().GetByID(grpcKit, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	base, err := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.BaseReleaseId)
+	if err != nil {
+		logs.Errorf("get base release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, fmt.Errorf("query release %d failed", req.BaseReleaseId)
+	}
+	resp := &pbrd.ReleaseDiff{
+		BaseReleaseId:     base.ID,
+		BaseReleaseName:   base.Spec.Name,
+		TargetReleaseName: editingStateName,
+	}
+	if req.TargetReleaseId != 0 {
+		target, e := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.TargetReleaseId)
+		if e != nil {
+			logs.Errorf("get target release failed, err: %v, rid: %s", e, grpcKit.Rid)
+			return nil, fmt.Errorf("query release %d failed", req.TargetReleaseId)
+		}
+		resp.TargetReleaseId = target.ID
+		resp.TargetReleaseName = target.Spec.Name
+	}
+
+	if resp.Hooks, err = s.compareHooks(grpcKit, req); err != nil {
+		return nil, err
+	}
+
+	switch app.Spec.ConfigType {
+	case table.File:
+		if resp.ConfigItems, err = s.compareConfigItems(grpcKit, req, resp.BaseReleaseName,
+			resp.TargetReleaseName); err != nil {
+			return nil, err
+		}
+		if resp.TemplateRevisions, err = s.compareTmplRevisions(grpcKit, req); err != nil {
+			return nil, err
+		}
+	case table.KV:
+		if resp.Kvs, err = s.compareKvs(grpcKit, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// compareConfigItems compare the non-template config items, which are matched by path and name.
+func (s *Service) compareConfigItems(kt *kit.Kit, req *pbds.CompareReleasesReq, baseName, targetName string) (
+	[]*pbrd.ConfigItemDiff, error) {
+
+	// the editing content is not rendered, so compare with the origin content of the base release in this case
+	useOrigin := req.TargetReleaseId == 0
+	base, err := s.listReleasedCompareCIs(kt, req.BizId, req.BaseReleaseId, useOrigin)
+	if err != nil {
+		return nil, err
+	}
+	var target map[string]*compareCI
+	if req.TargetReleaseId == 0 {
+		target, err = s.listEditingCompareCIs(kt, req.BizId, req.AppId)
+	} else {
+		target, err = s.listReleasedCompareCIs(kt, req.BizId, req.TargetReleaseId, useOrigin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.diffCompareCIs(kt, kt, base, target, baseName, targetName)
+}
+
+// diffCompareCIs diff the config items to compare, the contents are downloaded with the kit of each side.
+func (s *Service) diffCompareCIs(baseKt, targetKt *kit.Kit, base, target map[string]*compareCI, baseName,
+	targetName string) ([]*pbrd.ConfigItemDiff, error) {
+
+	var err error
+	diffs := make([]*pbrd.ConfigItemDiff, 0)
+	for _, key := range unionKeys(base, target) {
+		b, t := base[key], target[key]
+		diff := &pbrd.ConfigItemDiff{}
+		switch {
+		case b == nil:
+			diff.DiffType = diffTypeAdd
+		case t == nil:
+			diff.DiffType = diffTypeDelete
+		default:
+			diff.ChangedFields = b.changedFields(t)
+			if len(diff.ChangedFields) == 0 {
+				continue
+			}
+			diff.DiffType = diffTypeModify
+		}
+
+		ci := t
+		if ci == nil {
+			ci = b
+		}
+		diff.Name, diff.Path, diff.FileType = ci.name, ci.path, ci.fileType
+		if b != nil {
+			diff.BaseSignature, diff.BaseByteSize = b.signature, b.byteSize
+		}
+		if t != nil {
+			diff.TargetSignature, diff.TargetByteSize = t.signature, t.byteSize
+		}
+
+		if diff.BaseSignature != diff.TargetSignature {
+			diff.ContentDiff, err = s.diffCIContent(baseKt, targetKt, b, t, baseName, targetName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// changedFields returns the fields changed from c to target.
+func (c *compareCI) changedFields(target *compareCI) []string {
+	fields := make([]string, 0)
+	if c.signature != target.signature {
+		fields = append(fields, "content")
+	}
+	if c.fileType != target.fileType {
+		fields = append(fields, "file_type")
+	}
+	if c.user != target.user {
+		fields = append(fields, "user")
+	}
+	if c.userGroup != target.userGroup {
+		fields = append(fields, "user_group")
+	}
+	if c.privilege != target.privilege {
+		fields = append(fields, "privilege")
+	}
+	return fields
+}
+
+// isDiffable returns whether the unified diff of config item's content can be generated.
+func (c *compareCI) isDiffable() bool {
+	return c == nil || (c.fileType == string(table.Text) && c.byteSize <= constant.MaxDiffBytes)
+}
+
+// diffCIContent generate the unified diff of config item's content, only text content which is not too large
+// is supported, otherwise only the signatures are compared.
+func (s *Service) diffCIContent(baseKt, targetKt *kit.Kit, base, target *compareCI, baseName,
+	targetName string) (string, error) {
+
+	if !base.isDiffable() || !target.isDiffable() {
+		return "", nil
+	}
+
+	var baseContent, targetContent string
+	var err error
+	if base != nil {
+		if baseContent, err = s.downloadCompareContent(baseKt, base.signature); err != nil {
+			logs.Errorf("download config item %s content failed, err: %v, rid: %s",
+				path.Join(base.path, base.name), err, baseKt.Rid)
+			return "", err
+		}
+	}
+	if target != nil {
+		if targetContent, err = s.downloadCompareContent(targetKt, target.signature); err != nil {
+			logs.Errorf("download config item %s content failed, err: %v, rid: %s",
+				path.Join(target.path, target.name), err, targetKt.Rid)
+			return "", err
+		}
+	}
+
+	return tools.UnifiedDiff(baseName, targetName, baseContent, targetContent, diffContextLines), nil
+}
+
+// downloadCompareContent download the config item's content from repo.
+func (s *Service) downloadCompareContent(kt *kit.Kit, signature string) (string, error) {
+	body, _, err := s.repo.Download(kt.GetKitForRepoCfg(), signature)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// listReleasedCompareCIs list the released config items of a release, the key of the result is the absolute path.
+func (s *Service) listReleasedCompareCIs(kt *kit.Kit, bizID, releaseID uint32, useOrigin bool) (
+	map[string]*compareCI, error) {
+
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		logs.Errorf("list released config items failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	cis := make(map[string]*compareCI, len(rcis))
+	for _, rci := range rcis {
+		ci := &compareCI{
+			name:      rci.ConfigItemSpec.Name,
+			path:      rci.ConfigItemSpec.Path,
+			fileType:  string(rci.ConfigItemSpec.FileType),
+			signature: rci.CommitSpec.Content.Signature,
+			byteSize:  rci.CommitSpec.Content.ByteSize,
+		}
+		if useOrigin && rci.CommitSpec.Content.OriginSignature != "" {
+			ci.signature = rci.CommitSpec.Content.OriginSignature
+			ci.byteSize = rci.CommitSpec.Content.OriginByteSize
+		}
+		if p := rci.ConfigItemSpec.Permission; p != nil {
+			ci.user, ci.userGroup, ci.privilege = p.User, p.UserGroup, p.Privilege
+		}
+		cis[path.Join(ci.path, ci.name)] = ci
+	}
+
+	return cis, nil
+}
+
+// listEditingCompareCIs list the app's current editing config items, the key of the result is the absolute path.
+func (s *Service) listEditingCompareCIs(kt *kit.Kit, bizID, appID uint32) (map[string]*compareCI, error) {
+	details, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		logs.Errorf("list editing config items failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	ids := make([]uint32, len(details))
+	for i, detail := range details {
+		ids[i] = detail.ID
+	}
+	commits, err := s.dao.Commit().BatchListLatestCommits(kt, bizID, appID, ids)
+	if err != nil {
+		logs.Errorf("batch list latest commits failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	commitMap := make(map[uint32]*table.CommitSpec, len(commits))
+	for _, c := range commits {
+		commitMap[c.Attachment.ConfigItemID] = c.Spec
+	}
+
+	cis := make(map[string]*compareCI, len(details))
+	for _, detail := range details {
+		ci := &compareCI{
+			name:     detail.Spec.Name,
+			path:     detail.Spec.Path,
+			fileType: string(detail.Spec.FileType),
+		}
+		if spec := commitMap[detail.ID]; spec != nil && spec.Content != nil {
+			ci.signature, ci.byteSize = spec.Content.Signature, spec.Content.ByteSize
+		}
+		if p := detail.Spec.Permission; p != nil {
+			ci.user, ci.userGroup, ci.privilege = p.User, p.UserGroup, p.Privilege
+		}
+		cis[path.Join(ci.path, ci.name)] = ci
+	}
+
+	return cis, nil
+}
+
+// compareKvs compare the kvs, which are matched by key.
+func (s *Service) compareKvs(kt *kit.Kit, req *pbds.CompareReleasesReq) ([]*pbrd.KvDiff, error) {
+	base, err := s.listReleasedCompareKvs(kt, req.BizId, req.BaseReleaseId)
+	if err != nil {
+		return nil, err
+	}
+	var target map[string]*compareKv
+	if req.TargetReleaseId == 0 {
+		target, err = s.listEditingCompareKvs(kt, req.BizId, req.AppId)
+	} else {
+		target, err = s.listReleasedCompareKvs(kt, req.BizId, req.TargetReleaseId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	baseValue := func(kv *compareKv) (string, error) {
+		_, value, e := s.getReleasedKv(kt, req.BizId, req.AppId, kv.version, req.BaseReleaseId, kv.key)
+		return value, e
+	}
+	targetValue := func(kv *compareKv) (string, error) {
+		var value string
+		var e error
+		if req.TargetReleaseId == 0 {
+			_, value, e = s.getKv(kt, req.BizId, req.AppId, kv.version, kv.key)
+		} else {
+			_, value, e = s.getReleasedKv(kt, req.BizId, req.AppId, kv.version, req.TargetReleaseId, kv.key)
+		}
+		return value, e
+	}
+
+	return diffCompareKvs(kt, base, target, baseValue, targetValue)
+}
+
+// diffCompareKvs diff the kvs to compare, the values of each side are got by the value functions.
+func diffCompareKvs(kt *kit.Kit, base, target map[string]*compareKv, baseValue,
+	targetValue func(kv *compareKv) (string, error)) ([]*pbrd.KvDiff, error) {
+
+	var err error
+	diffs := make([]*pbrd.KvDiff, 0)
+	for _, key := range unionKeys(base, target) {
+		b, t := base[key], target[key]
+		diff := &pbrd.KvDiff{Key: key}
+		if b != nil {
+			diff.BaseKvType = b.kvType
+			if diff.BaseValue, err = baseValue(b); err != nil {
+				logs.Errorf("get base kv %s failed, err: %v, rid: %s", key, err, kt.Rid)
+				return nil, err
+			}
+		}
+		if t != nil {
+			diff.TargetKvType = t.kvType
+			if diff.TargetValue, err = targetValue(t); err != nil {
+				logs.Errorf("get target kv %s failed, err: %v, rid: %s", key, err, kt.Rid)
+				return nil, err
+			}
+		}
+
+		switch {
+		case b == nil:
+			diff.DiffType = diffTypeAdd
+		case t == nil:
+			diff.DiffType = diffTypeDelete
+		case diff.BaseKvType == diff.TargetKvType && diff.BaseValue == diff.TargetValue:
+			continue
+		default:
+			diff.DiffType = diffTypeModify
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// listReleasedCompareKvs list the released kvs of a release.
+func (s *Service) listReleasedCompareKvs(kt *kit.Kit, bizID, releaseID uint32) (map[string]*compareKv, error) {
+	rkvs, err := s.dao.ReleasedKv().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		logs.Errorf("list released kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	kvs := make(map[string]*compareKv, len(rkvs))
+	for _, rkv := range rkvs {
+		kvs[rkv.Spec.Key] = &compareKv{key: rkv.Spec.Key, kvType: string(rkv.Spec.KvType), version: rkv.Spec.Version}
+	}
+	return kvs, nil
+}
+
+// listEditingCompareKvs list the app's current editing kvs, the deleted kvs are excluded.
+func (s *Service) listEditingCompareKvs(kt *kit.Kit, bizID, appID uint32) (map[string]*compareKv, error) {
+	kvState := []string{
+		string(table.KvStateAdd),
+		string(table.KvStateRevise),
+		string(table.KvStateUnchange),
+	}
+	details, err := s.dao.Kv().ListAllByAppID(kt, appID, bizID, kvState)
+	if err != nil {
+		logs.Errorf("list kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	kvs := make(map[string]*compareKv, len(details))
+	for _, detail := range details {
+		kvs[detail.Spec.Key] = &compareKv{key: detail.Spec.Key, kvType: string(detail.Spec.KvType),
+			version: detail.Spec.Version}
+	}
+	return kvs, nil
+}
+
+// compareHooks compare the pre hook and post hook, the release id 0 of released hook is the editing one.
+func (s *Service) compareHooks(kt *kit.Kit, req *pbds.CompareReleasesReq) ([]*pbrd.HookDiff, error) {
+	diffs := make([]*pbrd.HookDiff, 0)
+	for _, hookType := range []table.HookType{table.PreHook, table.PostHook} {
+		base, err := s.getCompareHook(kt, req.BizId, req.AppId, req.BaseReleaseId, hookType)
+		if err != nil {
+			return nil, err
+		}
+		target, err := s.getCompareHook(kt, req.BizId, req.AppId, req.TargetReleaseId, hookType)
+		if err != nil {
+			return nil, err
+		}
+
+		if diff := diffCompareHook(hookType, base, target); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs, nil
+}
+
+// diffCompareHook diff the hook of the hook type, returns nil if the hook is not changed.
+func diffCompareHook(hookType table.HookType, base, target *table.ReleasedHook) *pbrd.HookDiff {
+	diff := &pbrd.HookDiff{HookType: string(hookType)}
+	var baseContent, targetContent string
+	switch {
+	case base == nil && target == nil:
+		return nil
+	case base == nil:
+		diff.DiffType = diffTypeAdd
+	case target == nil:
+		diff.DiffType = diffTypeDelete
+	case base.HookRevisionID == target.HookRevisionID && base.Content == target.Content:
+		return nil
+	default:
+		diff.DiffType = diffTypeModify
+	}
+
+	if base != nil {
+		diff.BaseHookName, diff.BaseHookRevisionName = base.HookName, base.HookRevisionName
+		baseContent = base.Content
+	}
+	if target != nil {
+		diff.TargetHookName, diff.TargetHookRevisionName = target.HookName, target.HookRevisionName
+		targetContent = target.Content
+	}
+	diff.ContentDiff = tools.UnifiedDiff(diff.BaseHookName, diff.TargetHookName, baseContent, targetContent,
+		diffContextLines)
+	return diff
+}
+
+// getCompareHook get the released hook, returns nil if the hook is not set.
+func (s *Service) getCompareHook(kt *kit.Kit, bizID, appID, releaseID uint32, hookType table.HookType) (
+	*table.ReleasedHook, error) {
+
+	hook, err := s.dao.ReleasedHook().Get(kt, bizID, appID, releaseID, hookType)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		logs.Errorf("get released %s failed, err: %v, rid: %s", hookType, err, kt.Rid)
+		return nil, err
+	}
+	return hook, nil
+}
+
+// compareTmplRevisions compare the template revisions bound by the app, which are matched by template id.
+func (s *Service) compareTmplRevisions(kt *kit.Kit, req *pbds.CompareReleasesReq) (
+	[]*pbrd.TemplateRevisionDiff, error) {
+
+	base, err := s.listReleasedCompareTmpls(kt, req.BizId, req.AppId, req.BaseReleaseId)
+	if err != nil {
+		return nil, err
+	}
+	var target map[uint32]*compareTmpl
+	if req.TargetReleaseId == 0 {
+		target, err = s.listEditingCompareTmpls(kt, req.BizId, req.AppId)
+	} else {
+		target, err = s.listReleasedCompareTmpls(kt, req.BizId, req.AppId, req.TargetReleaseId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return diffCompareTmpls(base, target), nil
+}
+
+// diffCompareTmpls diff the template revisions to compare.
+func diffCompareTmpls(base, target map[uint32]*compareTmpl) []*pbrd.TemplateRevisionDiff {
+	diffs := make([]*pbrd.TemplateRevisionDiff, 0)
+	for _, id := range unionKeys(base, target) {
+		b, t := base[id], target[id]
+		diff := &pbrd.TemplateRevisionDiff{TemplateId: id}
+		switch {
+		case b == nil:
+			diff.DiffType = diffTypeAdd
+		case t == nil:
+			diff.DiffType = diffTypeDelete
+		case b.revisionID == t.revisionID:
+			continue
+		default:
+			diff.DiffType = diffTypeModify
+		}
+
+		tmpl := t
+		if tmpl == nil {
+			tmpl = b
+		}
+		diff.Name, diff.Path = tmpl.name, tmpl.path
+		diff.TemplateSpaceName, diff.TemplateSetName = tmpl.spaceName, tmpl.setName
+		if b != nil {
+			diff.BaseTemplateRevisionId, diff.BaseTemplateRevisionName = b.revisionID, b.revisionName
+			diff.BaseSignature = b.signature
+		}
+		if t != nil {
+			diff.TargetTemplateRevisionId, diff.TargetTemplateRevisionName = t.revisionID, t.revisionName
+			diff.TargetSignature = t.signature
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// listReleasedCompareTmpls list the released template revisions of a release, the key of the result is template id.
+func (s *Service) listReleasedCompareTmpls(kt *kit.Kit, bizID, appID, releaseID uint32) (
+	map[uint32]*compareTmpl, error) {
+
+	details, _, err := s.dao.ReleasedAppTemplate().List(kt, bizID, appID, releaseID, nil, &types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list released app templates failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tmpls := make(map[uint32]*compareTmpl, len(details))
+	for _, d := range details {
+		tmpls[d.Spec.TemplateID] = &compareTmpl{
+			templateID:   d.Spec.TemplateID,
+			name:         d.Spec.Name,
+			path:         d.Spec.Path,
+			spaceName:    d.Spec.TemplateSpaceName,
+			setName:      d.Spec.TemplateSetName,
+			revisionID:   d.Spec.TemplateRevisionID,
+			revisionName: d.Spec.TemplateRevisionName,
+			signature:    d.Spec.OriginSignature,
+		}
+	}
+	return tmpls, nil
+}
+
+// listEditingCompareTmpls list the template revisions currently bound by the app, the key of the result is
+// template id.
+func (s *Service) listEditingCompareTmpls(kt *kit.Kit, bizID, appID uint32) (map[uint32]*compareTmpl, error) {
+	bindings, _, err := s.dao.AppTemplateBinding().List(kt, bizID, appID, &types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list app template bindings failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	// so far, no any template config item exists for the app
+	if len(bindings) == 0 {
+		return map[uint32]*compareTmpl{}, nil
+	}
+
+	revisions, err := s.dao.TemplateRevision().ListByIDs(kt, bindings[0].Spec.TemplateRevisionIDs)
+	if err != nil {
+		logs.Errorf("list template revisions failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	setIDs := make(map[uint32]uint32)
+	for _, b := range bindings[0].Spec.Bindings {
+		for _, r := range b.TemplateRevisions {
+			setIDs[r.TemplateID] = b.TemplateSetID
+		}
+	}
+	sets, err := s.dao.TemplateSet().ListByIDs(kt, bindings[0].Spec.TemplateSetIDs)
+	if err != nil {
+		logs.Errorf("list template sets failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	setNames := make(map[uint32]string, len(sets))
+	for _, set := range sets {
+		setNames[set.ID] = set.Spec.Name
+	}
+
+	spaceIDs := make([]uint32, 0)
+	for _, r := range revisions {
+		spaceIDs = append(spaceIDs, r.Attachment.TemplateSpaceID)
+	}
+	spaces, err := s.dao.TemplateSpace().ListByIDs(kt, tools.RemoveDuplicates(spaceIDs))
+	if err != nil {
+		logs.Errorf("list template spaces failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	spaceNames := make(map[uint32]string, len(spaces))
+	for _, space := range spaces {
+		spaceNames[space.ID] = space.Spec.Name
+	}
+
+	tmpls := make(map[uint32]*compareTmpl, len(revisions))
+	for _, r := range revisions {
+		tmpls[r.Attachment.TemplateID] = &compareTmpl{
+			templateID:   r.Attachment.TemplateID,
+			name:         r.Spec.Name,
+			path:         r.Spec.Path,
+			spaceName:    spaceNames[r.Attachment.TemplateSpaceID],
+			setName:      setNames[setIDs[r.Attachment.TemplateID]],
+			revisionID:   r.ID,
+			revisionName: r.Spec.RevisionName,
+			signature:    r.Spec.ContentSpec.Signature,
+		}
+	}
+	return tmpls, nil
+}
+
+// unionKeys returns the sorted union keys of the two maps.
+func unionKeys[K string | uint32, V any](a, b map[K]V) []K {
+	keys := make([]K, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+)
+
+const (
+	compareBizID     = 2
+	compareAppID     = 10
+	compareReleaseID = 100
+)
+
+// compareDaoSet is a dao set which only holds the config items of the compare app.
+type compareDaoSet struct {
+	dao.Set
+}
+
+func (d *compareDaoSet) ConfigItem() dao.ConfigItem { return &compareCIDao{} }
+func (d *compareDaoSet) Commit() dao.Commit         { return &compareCommitDao{} }
+func (d *compareDaoSet) ReleasedCI() dao.ReleasedCI { return &compareReleasedCIDao{} }
+
+type compareCIDao struct {
+	dao.ConfigItem
+}
+
+func (d *compareCIDao) ListAllByAppID(_ *kit.Kit, appID uint32, bizID uint32) ([]*table.ConfigItem, error) {
+	if bizID != compareBizID || appID != compareAppID {
+		return nil, nil
+	}
+	return []*table.ConfigItem{
+		{ID: 1, Spec: &table.ConfigItemSpec{Name: "a.bin", Path: "/etc", FileType: table.Binary,
+			Permission: &table.FilePermission{User: "root", UserGroup: "root", Privilege: "644"}}},
+		{ID: 2, Spec: &table.ConfigItemSpec{Name: "c.bin", Path: "/etc", FileType: table.Binary,
+			Permission: &table.FilePermission{User: "root", UserGroup: "root", Privilege: "644"}}},
+	}, nil
+}
+
+type compareCommitDao struct {
+	dao.Commit
+}
+
+func (d *compareCommitDao) BatchListLatestCommits(_ *kit.Kit, bizID, appID uint32, ids []uint32) (
+	[]*table.Commit, error) {
+	if bizID != compareBizID || appID != compareAppID {
+		return nil, nil
+	}
+	commits := make([]*table.Commit, 0, len(ids))
+	for _, id := range ids {
+		commits = append(commits, &table.Commit{
+			Spec:       &table.CommitSpec{Content: &table.ContentSpec{Signature: "editing", ByteSize: 1}},
+			Attachment: &table.CommitAttachment{BizID: bizID, AppID: appID, ConfigItemID: id},
+		})
+	}
+	return commits, nil
+}
+
+type compareReleasedCIDao struct {
+	dao.ReleasedCI
+}
+
+func (d *compareReleasedCIDao) ListAllByReleaseIDs(_ *kit.Kit, releasedIDs []uint32, bizID uint32) (
+	[]*table.ReleasedConfigItem, error) {
+	if bizID != compareBizID || !reflect.DeepEqual(releasedIDs, []uint32{compareReleaseID}) {
+		return nil, nil
+	}
+	return []*table.ReleasedConfigItem{
+		{
+			ConfigItemSpec: &table.ConfigItemSpec{Name: "a.bin", Path: "/etc", FileType: table.Binary,
+				Permission: &table.FilePermission{User: "root", UserGroup: "root", Privilege: "644"}},
+			CommitSpec: &table.ReleasedCommitSpec{Content: &table.ReleasedContentSpec{
+				Signature: "rendered", ByteSize: 2, OriginSignature: "released", OriginByteSize: 1}},
+		},
+		{
+			ConfigItemSpec: &table.ConfigItemSpec{Name: "b.bin", Path: "/etc", FileType: table.Binary,
+				Permission: &table.FilePermission{User: "root", UserGroup: "root", Privilege: "644"}},
+			CommitSpec: &table.ReleasedCommitSpec{Content: &table.ReleasedContentSpec{
+				Signature: "released", ByteSize: 1}},
+		},
+	}, nil
+}
+
+func TestCompareConfigItemsWithEditing(t *testing.T) {
+	s := &Service{dao: &compareDaoSet{}}
+	// the kit of config-server does not carry the app
+	kt := kit.New()
+	req := &pbds.CompareReleasesReq{BizId: compareBizID, AppId: compareAppID, BaseReleaseId: compareReleaseID}
+
+	diffs, err := s.compareConfigItems(kt, req, "v1", editingStateName)
+	if err != nil {
+		t.Fatalf("compare config items failed, err: %v", err)
+	}
+
+	want := map[string]string{"a.bin": diffTypeModify, "b.bin": diffTypeDelete, "c.bin": diffTypeAdd}
+	if len(diffs) != len(want) {
+		t.Fatalf("got %d diffs %v, want %v", len(diffs), diffs, want)
+	}
+	for _, diff := range diffs {
+		if diff.DiffType != want[diff.Name] {
+			t.Fatalf("config item %s diff type %s, want %s", diff.Name, diff.DiffType, want[diff.Name])
+		}
+	}
+	// editing content compared with the origin content of base release
+	if diffs[0].BaseSignature != "released" || diffs[0].TargetSignature != "editing" {
+		t.Fatalf("config item a.bin signatures %s -> %s", diffs[0].BaseSignature, diffs[0].TargetSignature)
+	}
+}
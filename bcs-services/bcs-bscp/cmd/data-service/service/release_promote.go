@@ -0,0 +1,595 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/content"
+	pbkv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/kv"
+	pbrd "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release-diff"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// promotion is the context of promoting a release to the target app, the source kit and the target kit
+// carry the biz and app of each side.
+type promotion struct {
+	req      *pbds.PromoteReleaseReq
+	release  *table.Release
+	srcKit   *kit.Kit
+	dstKit   *kit.Kit
+	hooks    map[table.HookType]*table.ReleasedHook
+	skipped  []string
+	crossBiz bool
+
+	// the prepared editing state of the target app, which is written in one transaction.
+	items       []*pbds.BatchUpsertConfigItemsReq_ConfigItem
+	atb         *table.AppTemplateBinding
+	atbToDelete *table.AppTemplateBinding
+	appVar      *table.AppTemplateVariable
+	kvsToUpdate []*table.Kv
+	kvsToCreate []*table.Kv
+	kvsToDelete []*table.Kv
+}
+
+// PromoteRelease promote a release's config items, kvs, hooks and template bindings to the target app as its
+// editing state, the target app's editing state is replaced and needs to be published as usual.
+func (s *Service) PromoteRelease(ctx context.Context, req *pbds.PromoteReleaseReq) (*pbds.PromoteReleaseResp,
+	error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if req.BizId == req.TargetBizId && req.AppId == req.TargetAppId {
+		return nil, errors.New("can not promote a release to the app itself")
+	}
+
+	app, err := s.dao.App().Get(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	targetApp, err := s.dao.App().Get(grpcKit, req.TargetBizId, req.TargetAppId)
+	if err != nil {
+		logs.Errorf("get target app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	if app.Spec.ConfigType != targetApp.Spec.ConfigType {
+		return nil, fmt.Errorf("can not promote a release of %s app to %s app", app.Spec.ConfigType,
+			targetApp.Spec.ConfigType)
+	}
+
+	release, err := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		logs.Errorf("get release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, fmt.Errorf("query release %d failed", req.ReleaseId)
+	}
+
+	p := &promotion{
+		req:      req,
+		release:  release,
+		srcKit:   grpcKit.Clone(),
+		dstKit:   grpcKit.Clone(),
+		crossBiz: req.BizId != req.TargetBizId,
+	}
+	p.srcKit.BizID, p.srcKit.AppID = req.BizId, req.AppId
+	p.dstKit.BizID, p.dstKit.AppID = req.TargetBizId, req.TargetAppId
+
+	if err = s.resolvePromotedHooks(p); err != nil {
+		return nil, err
+	}
+	if app.Spec.ConfigType == table.File && p.crossBiz {
+		p.skipped = append(p.skipped, "template config items can not be promoted across businesses")
+	}
+
+	diff, err := s.diffPromotion(p, app.Spec.ConfigType)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbds.PromoteReleaseResp{Diff: diff, Skipped: p.skipped}
+	if req.Preview {
+		return resp, nil
+	}
+
+	// prepare and validate all the editing state before the first write, then write them in one transaction,
+	// so that the target app's editing state is never partially replaced.
+	if err = s.preparePromotion(p, app.Spec.ConfigType); err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.promoteWithTx(tx, p); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, p.dstKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, p.dstKit.Rid)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// preparePromotion prepare the editing state of the target app without writing db.
+func (s *Service) preparePromotion(p *promotion, configType table.ConfigType) error {
+	switch configType {
+	case table.File:
+		if err := s.prepareConfigItems(p); err != nil {
+			return err
+		}
+		if !p.crossBiz {
+			if err := s.prepareTmplBindings(p); err != nil {
+				return err
+			}
+		}
+		return s.prepareTmplVariables(p)
+	case table.KV:
+		return s.prepareKvs(p)
+	}
+	return nil
+}
+
+// promoteWithTx write the prepared editing state to the target app with the transaction.
+func (s *Service) promoteWithTx(tx *gen.QueryTx, p *promotion) error {
+	kt := p.dstKit
+	if p.items != nil {
+		if _, err := s.batchUpsertConfigItemsWithTx(kt, tx, &pbds.BatchUpsertConfigItemsReq{
+			BizId:      p.req.TargetBizId,
+			AppId:      p.req.TargetAppId,
+			Items:      p.items,
+			ReplaceAll: true,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.promoteTmplBindingsWithTx(tx, p); err != nil {
+		return err
+	}
+
+	if p.appVar != nil {
+		if err := s.dao.AppTemplateVariable().UpsertWithTx(kt, tx, p.appVar); err != nil {
+			logs.Errorf("update app template variables failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+	}
+
+	if err := s.promoteKvsWithTx(tx, p); err != nil {
+		return err
+	}
+
+	return s.promoteHooksWithTx(tx, p)
+}
+
+// diffPromotion diff the target app's editing state with the release to promote.
+func (s *Service) diffPromotion(p *promotion, configType table.ConfigType) (*pbrd.ReleaseDiff, error) {
+	req := p.req
+	diff := &pbrd.ReleaseDiff{
+		BaseReleaseName:   editingStateName,
+		TargetReleaseId:   p.release.ID,
+		TargetReleaseName: p.release.Spec.Name,
+		Hooks:             make([]*pbrd.HookDiff, 0),
+	}
+
+	for _, hookType := range []table.HookType{table.PreHook, table.PostHook} {
+		base, err := s.getCompareHook(p.dstKit, req.TargetBizId, req.TargetAppId, 0, hookType)
+		if err != nil {
+			return nil, err
+		}
+		if d := diffCompareHook(hookType, base, p.hooks[hookType]); d != nil {
+			diff.Hooks = append(diff.Hooks, d)
+		}
+	}
+
+	var err error
+	switch configType {
+	case table.File:
+		// the editing content is not rendered, so compare with the origin content of the release
+		base, e := s.listEditingCompareCIs(p.dstKit, req.TargetBizId, req.TargetAppId)
+		if e != nil {
+			return nil, e
+		}
+		target, e := s.listReleasedCompareCIs(p.srcKit, req.BizId, req.ReleaseId, true)
+		if e != nil {
+			return nil, e
+		}
+		if diff.ConfigItems, err = s.diffCompareCIs(p.dstKit, p.srcKit, base, target, diff.BaseReleaseName,
+			diff.TargetReleaseName); err != nil {
+			return nil, err
+		}
+
+		if p.crossBiz {
+			break
+		}
+		baseTmpls, e := s.listEditingCompareTmpls(p.dstKit, req.TargetBizId, req.TargetAppId)
+		if e != nil {
+			return nil, e
+		}
+		targetTmpls, e := s.listReleasedCompareTmpls(p.srcKit, req.BizId, req.AppId, req.ReleaseId)
+		if e != nil {
+			return nil, e
+		}
+		diff.TemplateRevisions = diffCompareTmpls(baseTmpls, targetTmpls)
+	case table.KV:
+		base, e := s.listEditingCompareKvs(p.dstKit, req.TargetBizId, req.TargetAppId)
+		if e != nil {
+			return nil, e
+		}
+		target, e := s.listReleasedCompareKvs(p.srcKit, req.BizId, req.ReleaseId)
+		if e != nil {
+			return nil, e
+		}
+		baseValue := func(kv *compareKv) (string, error) {
+			_, value, e := s.getKv(p.dstKit, req.TargetBizId, req.TargetAppId, kv.version, kv.key)
+			return value, e
+		}
+		targetValue := func(kv *compareKv) (string, error) {
+			_, value, e := s.getReleasedKv(p.srcKit, req.BizId, req.AppId, kv.version, req.ReleaseId, kv.key)
+			return value, e
+		}
+		if diff.Kvs, err = diffCompareKvs(p.dstKit, base, target, baseValue, targetValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
+
+// resolvePromotedHooks resolve the hooks to be bound by the target app, the released hooks are bound as they are
+// in the same biz, otherwise the deployed revision of the hook with the same name in the target biz is bound.
+func (s *Service) resolvePromotedHooks(p *promotion) error {
+	req := p.req
+	p.hooks = make(map[table.HookType]*table.ReleasedHook)
+	for _, hookType := range []table.HookType{table.PreHook, table.PostHook} {
+		rh, err := s.getCompareHook(p.srcKit, req.BizId, req.AppId, req.ReleaseId, hookType)
+		if err != nil {
+			return err
+		}
+		if rh == nil {
+			p.hooks[hookType] = nil
+			continue
+		}
+
+		if !p.crossBiz {
+			hook := *rh
+			hook.ID, hook.AppID, hook.ReleaseID, hook.Reviser = 0, req.TargetAppId, 0, p.dstKit.User
+			p.hooks[hookType] = &hook
+			continue
+		}
+
+		h, err := s.dao.Hook().GetByName(p.dstKit, req.TargetBizId, rh.HookName)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				logs.Errorf("get hook %s failed, err: %v, rid: %s", rh.HookName, err, p.dstKit.Rid)
+				return err
+			}
+			p.skipped = append(p.skipped, fmt.Sprintf("%s %s does not exist in the target business",
+				hookType, rh.HookName))
+			continue
+		}
+		hook, err := s.getReleasedHook(p.dstKit, &table.ReleasedHook{
+			BizID:    req.TargetBizId,
+			AppID:    req.TargetAppId,
+			HookID:   h.ID,
+			HookType: hookType,
+		})
+		if err != nil {
+			p.skipped = append(p.skipped, fmt.Sprintf("%s %s has no deployed revision in the target business",
+				hookType, rh.HookName))
+			continue
+		}
+		p.hooks[hookType] = hook
+	}
+
+	return nil
+}
+
+// promoteHooksWithTx bind the resolved hooks to the target app, the hook which is not bound by the release is
+// unbound, and the skipped hook keeps unchanged.
+func (s *Service) promoteHooksWithTx(tx *gen.QueryTx, p *promotion) error {
+	kt := p.dstKit
+	for _, hookType := range []table.HookType{table.PreHook, table.PostHook} {
+		hook, exists := p.hooks[hookType]
+		if !exists {
+			continue
+		}
+
+		var err error
+		if hook == nil {
+			err = s.dao.ReleasedHook().DeleteByUniqueKeyWithTx(kt, tx, &table.ReleasedHook{
+				BizID:    p.req.TargetBizId,
+				AppID:    p.req.TargetAppId,
+				HookType: hookType,
+			})
+		} else {
+			err = s.dao.ReleasedHook().UpsertWithTx(kt, tx, hook)
+		}
+		if err != nil {
+			logs.Errorf("promote %s failed, err: %v, rid: %s", hookType, err, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareConfigItems prepare the target app's editing config items with the origin content of the release's
+// config items, the contents are copied to the target biz's repository when promoting across businesses.
+func (s *Service) prepareConfigItems(p *promotion) error {
+	req := p.req
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(p.srcKit, []uint32{req.ReleaseId}, req.BizId)
+	if err != nil {
+		logs.Errorf("list released config items failed, err: %v, rid: %s", err, p.srcKit.Rid)
+		return err
+	}
+
+	items := make([]*pbds.BatchUpsertConfigItemsReq_ConfigItem, 0, len(rcis))
+	for _, rci := range rcis {
+		content, e := s.dao.Content().Get(p.srcKit, rci.CommitSpec.ContentID, req.BizId)
+		if e != nil {
+			logs.Errorf("get content %d failed, err: %v, rid: %s", rci.CommitSpec.ContentID, e, p.srcKit.Rid)
+			return e
+		}
+		if p.crossBiz {
+			if e = s.copyPromotedContent(p, content.Spec.Signature); e != nil {
+				logs.Errorf("copy content %s failed, err: %v, rid: %s", content.Spec.Signature, e, p.srcKit.Rid)
+				return e
+			}
+		}
+
+		items = append(items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{BizId: req.TargetBizId, AppId: req.TargetAppId},
+			ConfigItemSpec:       pbci.PbConfigItemSpec(rci.ConfigItemSpec),
+			ContentSpec:          pbcontent.PbContentSpec(content.Spec),
+		})
+	}
+
+	p.items = items
+	return nil
+}
+
+// copyPromotedContent copy the content from the source biz's repository to the target biz's repository if it
+// does not exist yet.
+func (s *Service) copyPromotedContent(p *promotion, sign string) error {
+	_, err := s.repo.Metadata(p.dstKit.GetKitForRepoCfg(), sign)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errf.ErrFileContentNotFound) {
+		return err
+	}
+
+	body, _, err := s.repo.Download(p.srcKit.GetKitForRepoCfg(), sign)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = s.repo.Upload(p.dstKit.GetKitForRepoCfg(), sign, body)
+	return err
+}
+
+// prepareTmplBindings prepare the binding of the release's template revisions for the target app, the revisions
+// are pinned rather than following the latest ones.
+func (s *Service) prepareTmplBindings(p *promotion) error {
+	req, kt := p.req, p.dstKit
+	rtmpls, _, err := s.dao.ReleasedAppTemplate().List(p.srcKit, req.BizId, req.AppId, req.ReleaseId, nil,
+		&types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list released app templates failed, err: %v, rid: %s", err, p.srcKit.Rid)
+		return err
+	}
+	existing, _, err := s.dao.AppTemplateBinding().List(kt, req.TargetBizId, req.TargetAppId,
+		&types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list app template bindings failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	attachment := &table.AppTemplateBindingAttachment{BizID: req.TargetBizId, AppID: req.TargetAppId}
+	if len(rtmpls) == 0 {
+		if len(existing) != 0 {
+			p.atbToDelete = &table.AppTemplateBinding{ID: existing[0].ID, Attachment: attachment}
+		}
+		return nil
+	}
+
+	bindings := make([]*table.TemplateBinding, 0)
+	setBindings := make(map[uint32]*table.TemplateBinding)
+	for _, t := range rtmpls {
+		b, ok := setBindings[t.Spec.TemplateSetID]
+		if !ok {
+			b = &table.TemplateBinding{TemplateSetID: t.Spec.TemplateSetID}
+			setBindings[t.Spec.TemplateSetID] = b
+			bindings = append(bindings, b)
+		}
+		b.TemplateRevisions = append(b.TemplateRevisions, &table.TemplateRevisionBinding{
+			TemplateID:         t.Spec.TemplateID,
+			TemplateRevisionID: t.Spec.TemplateRevisionID,
+		})
+	}
+
+	atb := &table.AppTemplateBinding{
+		Spec:       &table.AppTemplateBindingSpec{Bindings: bindings},
+		Attachment: attachment,
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	if err = s.genFinalATB(kt, atb); err != nil {
+		logs.Errorf("promote app template binding failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(existing) != 0 {
+		atb.ID = existing[0].ID
+	}
+
+	p.atb = atb
+	return nil
+}
+
+// promoteTmplBindingsWithTx write the prepared template binding of the target app with the transaction.
+func (s *Service) promoteTmplBindingsWithTx(tx *gen.QueryTx, p *promotion) error {
+	kt := p.dstKit
+	var err error
+	switch {
+	case p.atbToDelete != nil:
+		err = s.dao.AppTemplateBinding().DeleteWithTx(kt, tx, p.atbToDelete)
+	case p.atb != nil && p.atb.ID == 0:
+		_, err = s.dao.AppTemplateBinding().CreateWithTx(kt, tx, p.atb)
+	case p.atb != nil:
+		err = s.dao.AppTemplateBinding().UpdateWithTx(kt, tx, p.atb)
+	}
+	if err != nil {
+		logs.Errorf("promote app template binding failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	return nil
+}
+
+// prepareTmplVariables prepare the target app's template variables with the released ones, which are overridden
+// by the variables in request.
+func (s *Service) prepareTmplVariables(p *promotion) error {
+	req, kt := p.req, p.dstKit
+	vars, err := s.dao.ReleasedAppTemplateVariable().ListVariables(p.srcKit, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		logs.Errorf("list released app template variables failed, err: %v, rid: %s", err, p.srcKit.Rid)
+		return err
+	}
+	if len(vars) == 0 && len(req.Variables) == 0 {
+		return nil
+	}
+
+	index := make(map[string]int, len(vars))
+	for i, v := range vars {
+		index[v.Name] = i
+	}
+	for _, v := range req.Variables {
+		spec := v.TemplateVariableSpec()
+		if i, ok := index[spec.Name]; ok {
+			vars[i] = spec
+			continue
+		}
+		index[spec.Name] = len(vars)
+		vars = append(vars, spec)
+	}
+
+	p.appVar = &table.AppTemplateVariable{
+		Spec:       &table.AppTemplateVariableSpec{Variables: vars},
+		Attachment: &table.AppTemplateVariableAttachment{BizID: req.TargetBizId, AppID: req.TargetAppId},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	return nil
+}
+
+// prepareKvs prepare the target app's editing kvs with the release's kvs, the existing kvs keep their kv type,
+// and the editing kvs which are not in the release are deleted. the values are written to vault after all the kvs
+// are validated, and they are not visible until the kvs are written to db.
+func (s *Service) prepareKvs(p *promotion) error {
+	req, kt := p.req, p.dstKit
+	rkvs, err := s.dao.ReleasedKv().ListAllByReleaseIDs(p.srcKit, []uint32{req.ReleaseId}, req.BizId)
+	if err != nil {
+		logs.Errorf("list released kvs failed, err: %v, rid: %s", err, p.srcKit.Rid)
+		return err
+	}
+
+	keys := make(map[string]bool, len(rkvs))
+	kvs := make([]*pbds.BatchUpsertKvsReq_Kv, 0, len(rkvs))
+	for _, rkv := range rkvs {
+		kvType, value, e := s.getReleasedKv(p.srcKit, req.BizId, req.AppId, rkv.Spec.Version, req.ReleaseId,
+			rkv.Spec.Key)
+		if e != nil {
+			logs.Errorf("get released kv %s failed, err: %v, rid: %s", rkv.Spec.Key, e, p.srcKit.Rid)
+			return e
+		}
+		keys[rkv.Spec.Key] = true
+		kvs = append(kvs, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{BizId: req.TargetBizId, AppId: req.TargetAppId},
+			KvSpec: &pbkv.KvSpec{
+				Key:    rkv.Spec.Key,
+				KvType: string(kvType),
+				Value:  value,
+				Memo:   rkv.Spec.Memo,
+			},
+		})
+	}
+
+	kvState := []string{
+		string(table.KvStateAdd),
+		string(table.KvStateRevise),
+		string(table.KvStateUnchange),
+	}
+	editing, err := s.dao.Kv().ListAllByAppID(kt, req.TargetAppId, req.TargetBizId, kvState)
+	if err != nil {
+		logs.Errorf("list kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	for _, kv := range editing {
+		if !keys[kv.Spec.Key] {
+			p.kvsToDelete = append(p.kvsToDelete, kv)
+		}
+	}
+
+	if len(kvs) == 0 {
+		return nil
+	}
+	p.kvsToUpdate, p.kvsToCreate, err = s.prepareBatchUpsertKvs(kt, &pbds.BatchUpsertKvsReq{
+		BizId:      req.TargetBizId,
+		AppId:      req.TargetAppId,
+		Kvs:        kvs,
+		ReplaceAll: true,
+	})
+	return err
+}
+
+// promoteKvsWithTx write the prepared kvs of the target app with the transaction, the deleted kv which is newly
+// added is removed, otherwise it is marked as deleted.
+func (s *Service) promoteKvsWithTx(tx *gen.QueryTx, p *promotion) error {
+	kt := p.dstKit
+	if err := s.batchUpsertKvsWithTx(kt, tx, true, p.kvsToUpdate, p.kvsToCreate); err != nil {
+		logs.Errorf("promote kvs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return s.deleteKvsWithTx(kt, tx, p.kvsToDelete)
+}
+
+// deleteKvsWithTx delete the editing kvs with the transaction, the kv which is newly added is removed, otherwise
+// it is marked as deleted.
+func (s *Service) deleteKvsWithTx(kt *kit.Kit, tx *gen.QueryTx, kvs []*table.Kv) error {
+	for _, kv := range kvs {
+		var err error
+		if kv.KvState == table.KvStateAdd {
+			err = s.dao.Kv().DeleteWithTx(kt, tx, kv)
+		} else {
+			kv.KvState = table.KvStateDelete
+			kv.Revision.Reviser = kt.User
+			err = s.dao.Kv().UpdateWithTx(kt, tx, kv)
+		}
+		if err != nil {
+			logs.Errorf("delete kv %s failed, err: %v, rid: %s", kv.Spec.Key, err, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/dao"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/vault"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+const (
+	promoteBizID       = 2
+	promoteAppID       = 20
+	promoteTargetAppID = 21
+	promoteFileAppID   = 22
+	promoteReleaseID   = 200
+)
+
+// promoteTxPool is a connection pool whose transaction stages the writes of the fake daos, the staged writes
+// are applied when the transaction is committed and dropped when it is rolled back.
+type promoteTxPool struct {
+	gorm.ConnPool
+	began      bool
+	committed  bool
+	rolledBack bool
+	staged     []string
+	applied    []string
+}
+
+func (p *promoteTxPool) BeginTx(_ context.Context, _ *sql.TxOptions) (gorm.ConnPool, error) {
+	p.began = true
+	return p, nil
+}
+
+func (p *promoteTxPool) Commit() error {
+	p.committed = true
+	p.applied = append(p.applied, p.staged...)
+	p.staged = nil
+	return nil
+}
+
+func (p *promoteTxPool) Rollback() error {
+	p.rolledBack = true
+	p.staged = nil
+	return nil
+}
+
+// promoteDaoSet is a dao set which holds a released kv app and the kv apps to promote to.
+type promoteDaoSet struct {
+	dao.Set
+	pool    *promoteTxPool
+	query   *gen.Query
+	schemas []*table.ConfigSchema
+	// hookErr is the error of writing the target app's hooks, which is the last write of promotion.
+	hookErr error
+}
+
+func newPromoteDaoSet(t *testing.T) *promoteDaoSet {
+	pool := &promoteTxPool{}
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: pool, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open gorm db failed, err: %v", err)
+	}
+	return &promoteDaoSet{pool: pool, query: gen.Use(db)}
+}
+
+func (d *promoteDaoSet) GenQuery() *gen.Query           { return d.query }
+func (d *promoteDaoSet) App() dao.App                   { return &promoteAppDao{} }
+func (d *promoteDaoSet) Release() dao.Release           { return &promoteReleaseDao{} }
+func (d *promoteDaoSet) ReleasedHook() dao.ReleasedHook { return &promoteReleasedHookDao{set: d} }
+func (d *promoteDaoSet) ReleasedKv() dao.ReleasedKv     { return &promoteReleasedKvDao{} }
+func (d *promoteDaoSet) Kv() dao.Kv                     { return &promoteKvDao{set: d} }
+func (d *promoteDaoSet) ConfigSchema() dao.ConfigSchema { return &promoteSchemaDao{set: d} }
+
+// stage records the kv writes in the transaction, eg: "create replicas".
+func (d *promoteDaoSet) stage(action string, kvs []*table.Kv) {
+	for _, kv := range kvs {
+		d.pool.staged = append(d.pool.staged, action+" "+kv.Spec.Key)
+	}
+}
+
+type promoteAppDao struct {
+	dao.App
+}
+
+func (d *promoteAppDao) Get(_ *kit.Kit, bizID, appID uint32) (*table.App, error) {
+	spec := &table.AppSpec{ConfigType: table.KV, DataType: table.KvAny}
+	if appID == promoteFileAppID {
+		spec = &table.AppSpec{ConfigType: table.File}
+	}
+	return &table.App{ID: appID, BizID: bizID, Spec: spec}, nil
+}
+
+type promoteReleaseDao struct {
+	dao.Release
+}
+
+func (d *promoteReleaseDao) Get(_ *kit.Kit, bizID, appID, releaseID uint32) (*table.Release, error) {
+	return &table.Release{ID: releaseID, Spec: &table.ReleaseSpec{Name: "v1"},
+		Attachment: &table.ReleaseAttachment{BizID: bizID, AppID: appID}}, nil
+}
+
+type promoteReleasedHookDao struct {
+	dao.ReleasedHook
+	set *promoteDaoSet
+}
+
+func (d *promoteReleasedHookDao) Get(_ *kit.Kit, _, _, _ uint32, _ table.HookType) (*table.ReleasedHook, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *promoteReleasedHookDao) DeleteByUniqueKeyWithTx(_ *kit.Kit, _ *gen.QueryTx, rh *table.ReleasedHook) error {
+	if d.set.hookErr != nil {
+		return d.set.hookErr
+	}
+	d.set.pool.staged = append(d.set.pool.staged, "unbind "+string(rh.HookType))
+	return nil
+}
+
+type promoteReleasedKvDao struct {
+	dao.ReleasedKv
+}
+
+func (d *promoteReleasedKvDao) ListAllByReleaseIDs(_ *kit.Kit, _ []uint32, bizID uint32) ([]*table.ReleasedKv,
+	error) {
+	return []*table.ReleasedKv{{
+		ReleaseID:  promoteReleaseID,
+		Spec:       &table.KvSpec{Key: "replicas", KvType: table.KvNumber, Version: 1},
+		Attachment: &table.KvAttachment{BizID: bizID, AppID: promoteAppID},
+	}}, nil
+}
+
+// promoteKvDao holds the target app's editing kv "legacy", which is not in the release.
+type promoteKvDao struct {
+	dao.Kv
+	set *promoteDaoSet
+}
+
+func (d *promoteKvDao) ListAllByAppID(_ *kit.Kit, appID uint32, bizID uint32, _ []string) ([]*table.Kv, error) {
+	return []*table.Kv{{
+		ID:         1,
+		KvState:    table.KvStateUnchange,
+		Spec:       &table.KvSpec{Key: "legacy", KvType: table.KvStr, Version: 1},
+		Attachment: &table.KvAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.Revision{},
+	}}, nil
+}
+
+func (d *promoteKvDao) ListAllKvByKey(_ *kit.Kit, _, _ uint32, _ []string, _ []string) ([]*table.Kv, error) {
+	return nil, nil
+}
+
+func (d *promoteKvDao) BatchCreateWithTx(_ *kit.Kit, _ *gen.QueryTx, kvs []*table.Kv) error {
+	d.set.stage("create", kvs)
+	return nil
+}
+
+func (d *promoteKvDao) BatchUpdateWithTx(_ *kit.Kit, _ *gen.QueryTx, kvs []*table.Kv) error {
+	d.set.stage("update", kvs)
+	return nil
+}
+
+func (d *promoteKvDao) UpdateWithTx(_ *kit.Kit, _ *gen.QueryTx, kv *table.Kv) error {
+	d.set.stage("mark "+string(kv.KvState), []*table.Kv{kv})
+	return nil
+}
+
+type promoteSchemaDao struct {
+	dao.ConfigSchema
+	set *promoteDaoSet
+}
+
+func (d *promoteSchemaDao) ListAll(_ *kit.Kit, _, appID uint32, resourceType table.ConfigSchemaResource) (
+	[]*table.ConfigSchema, error) {
+	if appID != promoteTargetAppID || resourceType != table.KvSchemaResource {
+		return nil, nil
+	}
+	return d.set.schemas, nil
+}
+
+// promoteVault holds the released kv "replicas" with value 500.
+type promoteVault struct {
+	vault.Set
+	upserted []string
+}
+
+func (v *promoteVault) GetRKv(_ *kit.Kit, opt *types.GetRKvOption) (table.DataType, string, error) {
+	return table.KvNumber, "500", nil
+}
+
+func (v *promoteVault) GetKvByVersion(_ *kit.Kit, opt *types.GetKvByVersion) (table.DataType, string, error) {
+	return table.KvStr, "old", nil
+}
+
+func (v *promoteVault) UpsertKv(_ *kit.Kit, opt *types.UpsertKvOption) (int, error) {
+	v.upserted = append(v.upserted, opt.Key)
+	return 2, nil
+}
+
+func TestPromoteRelease(t *testing.T) {
+	rangeSchema := &table.ConfigSchema{Spec: &table.ConfigSchemaSpec{ResourceType: table.KvSchemaResource,
+		ConfigKey: "replicas", SchemaType: table.RangeSchema, Schema: "1,100"}}
+
+	tests := []struct {
+		name        string
+		targetAppID uint32
+		schemas     []*table.ConfigSchema
+		hookErr     error
+		wantErr     string
+		// wantUpserted is whether the kv values are written to vault
+		wantUpserted bool
+		// wantApplied is the writes which are committed to the target app
+		wantApplied []string
+	}{
+		{
+			name:         "promote kvs replaces the editing kvs",
+			targetAppID:  promoteTargetAppID,
+			wantUpserted: true,
+			wantApplied: []string{"create replicas", "mark DELETE legacy", "unbind pre_hook",
+				"unbind post_hook"},
+		},
+		{
+			name:        "promote to the app itself",
+			targetAppID: promoteAppID,
+			wantErr:     "can not promote a release to the app itself",
+		},
+		{
+			name:        "promote to an app of different config type",
+			targetAppID: promoteFileAppID,
+			wantErr:     "can not promote a release of kv app to file app",
+		},
+		{
+			name:        "kv value is rejected by the target app's schema",
+			targetAppID: promoteTargetAppID,
+			schemas:     []*table.ConfigSchema{rangeSchema},
+			wantErr:     "kv values do not satisfy the config schemas",
+		},
+		{
+			name:         "write fails partway is rolled back",
+			targetAppID:  promoteTargetAppID,
+			hookErr:      errors.New("write hook failed"),
+			wantErr:      "write hook failed",
+			wantUpserted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := newPromoteDaoSet(t)
+			set.schemas, set.hookErr = tt.schemas, tt.hookErr
+			vs := &promoteVault{}
+			s := &Service{dao: set, vault: vs}
+
+			_, err := s.PromoteRelease(context.Background(), &pbds.PromoteReleaseReq{
+				BizId:       promoteBizID,
+				AppId:       promoteAppID,
+				ReleaseId:   promoteReleaseID,
+				TargetBizId: promoteBizID,
+				TargetAppId: tt.targetAppID,
+			})
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("promote release failed, err: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("promote release err: %v, want %q", err, tt.wantErr)
+			}
+
+			if upserted := len(vs.upserted) > 0; upserted != tt.wantUpserted {
+				t.Errorf("kv values written to vault: %v, want %v", upserted, tt.wantUpserted)
+			}
+			if strings.Join(set.pool.applied, ",") != strings.Join(tt.wantApplied, ",") {
+				t.Errorf("applied writes %v, want %v", set.pool.applied, tt.wantApplied)
+			}
+			// the failed write rolls back the writes before it, the rejected promotion never begins to write
+			if tt.hookErr != nil && (!set.pool.rolledBack || set.pool.committed) {
+				t.Errorf("transaction is not rolled back, committed: %v", set.pool.committed)
+			}
+			if tt.wantErr != "" && tt.hookErr == nil && set.pool.began {
+				t.Errorf("transaction began before the promotion is validated")
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbrp "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/rollout-plan"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// CreateRolloutPlan create a rollout plan and publish the release to the instances of the first stage,
+// the following stages are promoted by the crontab when the failure rate of the stage is acceptable.
+func (s *Service) CreateRolloutPlan(ctx context.Context, req *pbds.CreateRolloutPlanReq) (*pbds.CreateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	if needPublishApproval(app, nil) {
+		return nil, fmt.Errorf("publish of app %s needs to be approved, rollout plan is not supported", app.Spec.Name)
+	}
+
+	release, err := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		return nil, err
+	}
+	if release.Spec.Deprecated {
+		return nil, fmt.Errorf("release %s is deprecated, can not be published", release.Spec.Name)
+	}
+
+	// only one active rollout plan is allowed for one app, to avoid the plans overwrite each other.
+	active, err := s.dao.RolloutPlan().GetActive(grpcKit, req.BizId, req.AppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		return nil, fmt.Errorf("app %s already has a %s rollout plan %d", app.Spec.Name, active.Spec.Status, active.ID)
+	}
+
+	previous, err := s.getFullyReleasedID(grpcKit, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &table.RolloutPlan{
+		Spec: &table.RolloutPlanSpec{
+			ReleaseID:         req.ReleaseId,
+			PreviousReleaseID: previous,
+			Stages:            req.Stages,
+			ObserveMinutes:    req.ObserveMinutes,
+			MinClients:        req.MinClients,
+			FailureThreshold:  req.FailureThreshold,
+			OnFailure:         table.RolloutFailurePolicy(req.OnFailure),
+			Status:            table.RolloutRunning,
+			StageStartedAt:    time.Now(),
+			Memo:              req.Memo,
+		},
+		Attachment: &table.RolloutPlanAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.Revision{
+			Creator: grpcKit.User,
+			Reviser: grpcKit.User,
+		},
+	}
+	if len(plan.Spec.Stages) == 0 {
+		plan.Spec.Stages = table.DefaultRolloutStages
+	}
+	if plan.Spec.ObserveMinutes == 0 {
+		plan.Spec.ObserveMinutes = table.DefaultRolloutObserveMinutes
+	}
+	if plan.Spec.FailureThreshold == 0 {
+		plan.Spec.FailureThreshold = table.DefaultRolloutFailureThreshold
+	}
+	if plan.Spec.OnFailure == "" {
+		plan.Spec.OnFailure = table.RolloutPauseOnFailure
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	id, err := s.dao.RolloutPlan().CreateWithTx(grpcKit, tx, plan)
+	if err != nil {
+		logs.Errorf("create rollout plan failed, err: %v, rid: %s", err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	if _, err = s.dao.RolloutPlan().PublishStageWithTx(grpcKit, tx, plan); err != nil {
+		logs.Errorf("publish rollout plan %d stage failed, err: %v, rid: %s", id, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	// save the group which is created by the first stage.
+	if err = s.dao.RolloutPlan().UpdateWithTx(grpcKit, tx, plan, table.RolloutRunning); err != nil {
+		logs.Errorf("update rollout plan %d failed, err: %v, rid: %s", id, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListRolloutPlans list rollout plans of the app.
+func (s *Service) ListRolloutPlans(ctx context.Context, req *pbds.ListRolloutPlansReq) (
+	*pbds.ListRolloutPlansResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if req.Status != "" {
+		if err := table.RolloutStatus(req.Status).Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	details, count, err := s.dao.RolloutPlan().List(grpcKit, req.BizId, req.AppId, req.Status,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list rollout plans failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListRolloutPlansResp{
+		Count:   uint32(count),
+		Details: pbrp.PbRolloutPlans(details),
+	}, nil
+}
+
+// PauseRolloutPlan pause the running rollout plan, the instances keep the release of the current stage.
+func (s *Service) PauseRolloutPlan(ctx context.Context, req *pbds.OperateRolloutPlanReq) (*pbbase.EmptyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	plan, err := s.dao.RolloutPlan().Get(grpcKit, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get rollout plan %d failed, err: %v, rid: %s", req.Id, err, grpcKit.Rid)
+		return nil, err
+	}
+	if plan.Spec.Status != table.RolloutRunning {
+		return nil, fmt.Errorf("rollout plan %d is %s, can not be paused", plan.ID, plan.Spec.Status)
+	}
+
+	plan.Spec.Status = table.RolloutPaused
+	plan.Spec.Reason = req.Reason
+	plan.Revision.Reviser = grpcKit.User
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.RolloutPlan().UpdateWithTx(grpcKit, tx, plan, table.RolloutRunning); err != nil {
+		logs.Errorf("pause rollout plan %d failed, err: %v, rid: %s", plan.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ResumeRolloutPlan resume the paused rollout plan, the operator confirms the current stage
+// by resuming it, so the plan is promoted to the next stage directly.
+func (s *Service) ResumeRolloutPlan(ctx context.Context, req *pbds.OperateRolloutPlanReq) (
+	*pbbase.EmptyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	plan, err := s.dao.RolloutPlan().Get(grpcKit, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get rollout plan %d failed, err: %v, rid: %s", req.Id, err, grpcKit.Rid)
+		return nil, err
+	}
+	if plan.Spec.Status != table.RolloutPaused {
+		return nil, fmt.Errorf("rollout plan %d is %s, can not be resumed", plan.ID, plan.Spec.Status)
+	}
+
+	plan.Spec.Reason = req.Reason
+	plan.Revision.Reviser = grpcKit.User
+
+	tx := s.dao.GenQuery().Begin()
+	if plan.IsLastStage() {
+		plan.Spec.Status = table.RolloutCompleted
+	} else {
+		plan.Spec.Status = table.RolloutRunning
+		plan.Spec.CurrentStage++
+		plan.Spec.StageStartedAt = time.Now()
+		if _, err = s.dao.RolloutPlan().PublishStageWithTx(grpcKit, tx, plan); err != nil {
+			logs.Errorf("publish rollout plan %d stage failed, err: %v, rid: %s", plan.ID, err, grpcKit.Rid)
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+			}
+			return nil, err
+		}
+	}
+
+	if err = s.dao.RolloutPlan().UpdateWithTx(grpcKit, tx, plan, table.RolloutPaused); err != nil {
+		logs.Errorf("resume rollout plan %d failed, err: %v, rid: %s", plan.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// RollbackRolloutPlan roll the instances of the running or paused rollout plan back to the previous release.
+func (s *Service) RollbackRolloutPlan(ctx context.Context, req *pbds.OperateRolloutPlanReq) (
+	*pbbase.EmptyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	plan, err := s.dao.RolloutPlan().Get(grpcKit, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get rollout plan %d failed, err: %v, rid: %s", req.Id, err, grpcKit.Rid)
+		return nil, err
+	}
+	if plan.Spec.Status.IsFinished() {
+		return nil, fmt.Errorf("rollout plan %d is %s, can not be rolled back", plan.ID, plan.Spec.Status)
+	}
+
+	from := plan.Spec.Status
+	plan.Spec.Status = table.RolloutRolledBack
+	plan.Spec.Reason = req.Reason
+	plan.Revision.Reviser = grpcKit.User
+
+	tx := s.dao.GenQuery().Begin()
+	if _, err = s.dao.RolloutPlan().RollbackWithTx(grpcKit, tx, plan); err != nil {
+		logs.Errorf("roll back rollout plan %d failed, err: %v, rid: %s", plan.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = s.dao.RolloutPlan().UpdateWithTx(grpcKit, tx, plan, from); err != nil {
+		logs.Errorf("update rollout plan %d failed, err: %v, rid: %s", plan.ID, err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// getFullyReleasedID get the release which is published to the default group of the app.
+func (s *Service) getFullyReleasedID(kt *kit.Kit, bizID, appID uint32) (uint32, error) {
+	groups, err := s.dao.ReleasedGroup().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, one := range groups {
+		if one.Mode == table.Default {
+			return one.ReleaseID, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -51,6 +51,17 @@ func (s *Service) CreateTemplate(ctx context.Context, req *pbds.CreateTemplateRe
 		}
 	}
 
+	// validate the template content with the template engine of template space
+	if err := s.validateTmplRevisionsContent(kt, []*table.TemplateRevision{{
+		Spec: req.TrSpec.TemplateRevisionSpec(),
+		Attachment: &table.TemplateRevisionAttachment{
+			BizID:           req.Attachment.BizId,
+			TemplateSpaceID: req.Attachment.TemplateSpaceId,
+		},
+	}}); err != nil {
+		return nil, err
+	}
+
 	tx := s.dao.GenQuery().Begin()
 
 	// 1. create template
@@ -15,7 +15,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
+	"path"
 
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
@@ -45,9 +48,6 @@ func (s *Service) CreateTemplateRevision(ctx context.Context,
 		return nil, err
 	}
 
-	tx := s.dao.GenQuery().Begin()
-
-	// 1. create template revision
 	spec := req.Spec.TemplateRevisionSpec()
 	// if no revision name is specified, generate it by system
 	if spec.RevisionName == "" {
@@ -64,6 +64,15 @@ func (s *Service) CreateTemplateRevision(ctx context.Context,
 			Creator: kt.User,
 		},
 	}
+
+	// validate the template content with the template engine of template space
+	if err = s.validateTmplRevisionsContent(kt, []*table.TemplateRevision{templateRevision}); err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+
+	// 1. create template revision
 	id, err := s.dao.TemplateRevision().CreateWithTx(kt, tx, templateRevision)
 	if err != nil {
 		logs.Errorf("create template revision failed, err: %v, rid: %s", err, kt.Rid)
@@ -242,6 +251,10 @@ func getLatestTmplRevisions(tmplRevisions []*table.TemplateRevision) map[uint32]
 }
 
 func (s *Service) doCreateTemplateRevisions(kt *kit.Kit, tx *gen.QueryTx, data []*table.TemplateRevision) error {
+	if err := s.validateTmplRevisionsContent(kt, data); err != nil {
+		return err
+	}
+
 	for i := range data {
 		// 生成 RevisionName
 		data[i].Spec.RevisionName = tools.GenerateRevisionName()
@@ -253,3 +266,50 @@ func (s *Service) doCreateTemplateRevisions(kt *kit.Kit, tx *gen.QueryTx, data [
 	}
 	return nil
 }
+
+// validateTmplRevisionsContent validates the text template contents with the template engine of their template
+// spaces, the simple engine accepts any content, so only the contents of go template engine need to validate
+func (s *Service) validateTmplRevisionsContent(kt *kit.Kit, revisions []*table.TemplateRevision) error {
+	engines, err := s.getTmplSpaceEngines(kt, revisions)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range revisions {
+		if err := s.validateTmplContent(kt, engines[r.Attachment.TemplateSpaceID], r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTmplContent validates the template revision's content with the template engine
+func (s *Service) validateTmplContent(kt *kit.Kit, engine table.TemplateEngine, r *table.TemplateRevision) error {
+	if engine != table.GoTemplateEngine || r.Spec.FileType != table.Text || r.Spec.ContentSpec == nil {
+		return nil
+	}
+
+	if r.Spec.ContentSpec.ByteSize > constant.MaxRenderBytes {
+		return fmt.Errorf("template %s content size exceeds the max size %d bytes of go template engine",
+			path.Join(r.Spec.Path, r.Spec.Name), constant.MaxRenderBytes)
+	}
+
+	body, _, err := s.repo.Download(kt.GetKitForRepoTmpl(r.Attachment.TemplateSpaceID), r.Spec.ContentSpec.Signature)
+	if err != nil {
+		logs.Errorf("download template content failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	content, err := io.ReadAll(body)
+	_ = body.Close()
+	if err != nil {
+		logs.Errorf("read template content failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	if err := engine.Processor().Validate(content); err != nil {
+		return fmt.Errorf("template %s is invalid, %v", path.Join(r.Spec.Path, r.Spec.Name), err)
+	}
+
+	return nil
+}
@@ -95,6 +95,14 @@ func (s *Service) UpdateTemplateSpace(ctx context.Context,
 			Reviser: kt.User,
 		},
 	}
+	// the existing templates should be valid for the new template engine
+	if templateSpace.Spec != nil && templateSpace.Spec.TemplateEngine == table.GoTemplateEngine {
+		if err := s.validateTmplSpaceTemplates(kt, req.Attachment.BizId, req.Id,
+			templateSpace.Spec.TemplateEngine); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.dao.TemplateSpace().Update(kt, templateSpace); err != nil {
 		logs.Errorf("update template space failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
@@ -175,3 +183,30 @@ func (s *Service) ListTmplSpacesByIDs(ctx context.Context, req *pbds.ListTmplSpa
 	}
 	return resp, nil
 }
+
+// validateTmplSpaceTemplates validates the latest revisions of all templates in the template space with the engine
+func (s *Service) validateTmplSpaceTemplates(kt *kit.Kit, bizID, tmplSpaceID uint32,
+	engine table.TemplateEngine) error {
+	tmplIDs, err := s.dao.Template().ListAllIDs(kt, bizID, tmplSpaceID)
+	if err != nil {
+		logs.Errorf("list template ids of template space failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(tmplIDs) == 0 {
+		return nil
+	}
+
+	revisions, err := s.dao.TemplateRevision().ListLatestRevisionsGroupByTemplateIds(kt, tmplIDs)
+	if err != nil {
+		logs.Errorf("list latest template revisions failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, r := range revisions {
+		if err := s.validateTmplContent(kt, engine, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
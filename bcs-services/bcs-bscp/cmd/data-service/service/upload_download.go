@@ -24,6 +24,7 @@ import (
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
 	pbci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
 	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tmplprocess"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
 )
@@ -114,11 +115,13 @@ func filterSizeForConfigItems(cis []*pbci.ConfigItem) []*pbci.ConfigItem {
 	return rs
 }
 
-// filterVarsForTmplRevisions get template config items which have variables so that need to render
-func filterVarsForTmplRevisions(tmpls []*table.TemplateRevision, vars [][]string) []*table.TemplateRevision {
+// filterVarsForTmplRevisions get template config items which have variables so that need to render,
+// the templates of go template engine always need to render because of the control structures
+func filterVarsForTmplRevisions(tmpls []*table.TemplateRevision, vars [][]string,
+	engines map[uint32]table.TemplateEngine) []*table.TemplateRevision {
 	rs := make([]*table.TemplateRevision, 0)
 	for idx, v := range vars {
-		if len(v) > 0 {
+		if len(v) > 0 || engines[tmpls[idx].Attachment.TemplateSpaceID] == table.GoTemplateEngine {
 			rs = append(rs, tmpls[idx])
 		}
 	}
@@ -168,6 +171,11 @@ func (s *Service) getTmplVariables(kt *kit.Kit, tmplRevisions []*table.TemplateR
 		return [][]string{}, nil
 	}
 
+	engines, err := s.getTmplSpaceEngines(kt, tmplRevisions)
+	if err != nil {
+		return nil, err
+	}
+
 	variables := make([][]string, len(tmplRevisions))
 	var hitError error
 	pipe := make(chan struct{}, 10)
@@ -185,7 +193,8 @@ func (s *Service) getTmplVariables(kt *kit.Kit, tmplRevisions []*table.TemplateR
 
 			k := kt.GetKitForRepoTmpl(r.Attachment.TemplateSpaceID)
 			// all the files have filtered by content size, so no need to check size again in repo
-			vars, err := s.repo.GetVariables(k, r.Spec.ContentSpec.Signature, false)
+			engine := string(engines[r.Attachment.TemplateSpaceID])
+			vars, err := s.repo.GetVariables(k, r.Spec.ContentSpec.Signature, engine, false)
 			if err != nil {
 				hitError = fmt.Errorf("get template config variables from repo failed, "+
 					"template id: %d, name: %s, path: %s, error: %v", // nolint goconst
@@ -205,6 +214,46 @@ func (s *Service) getTmplVariables(kt *kit.Kit, tmplRevisions []*table.TemplateR
 	return variables, nil
 }
 
+// getTmplSpaceEngines get the template engines of the template revisions' template spaces,
+// the key of the result is template space id
+func (s *Service) getTmplSpaceEngines(kt *kit.Kit, tmplRevisions []*table.TemplateRevision) (
+	map[uint32]table.TemplateEngine, error) {
+	spaceIDs := make([]uint32, 0, len(tmplRevisions))
+	for _, r := range tmplRevisions {
+		spaceIDs = append(spaceIDs, r.Attachment.TemplateSpaceID)
+	}
+
+	return s.getSpaceEngines(kt, spaceIDs)
+}
+
+// getSpaceEngines get the template engines of the template spaces, which is template space id => engine
+func (s *Service) getSpaceEngines(kt *kit.Kit, ids []uint32) (map[uint32]table.TemplateEngine, error) {
+	spaceIDs := make([]uint32, 0)
+	exists := make(map[uint32]bool)
+	for _, id := range ids {
+		if !exists[id] {
+			exists[id] = true
+			spaceIDs = append(spaceIDs, id)
+		}
+	}
+
+	engines := make(map[uint32]table.TemplateEngine, len(spaceIDs))
+	if len(spaceIDs) == 0 {
+		return engines, nil
+	}
+
+	spaces, err := s.dao.TemplateSpace().ListByIDs(kt, spaceIDs)
+	if err != nil {
+		logs.Errorf("list template spaces by ids failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, sp := range spaces {
+		engines[sp.ID] = sp.Spec.TemplateEngine
+	}
+
+	return engines, nil
+}
+
 // getCIVariables get variables of normal config items from repo
 // the order of elements in slice variables and slice tmplRevisions is consistent
 func (s *Service) getCIVariables(kt *kit.Kit, cis []*pbci.ConfigItem) ([][]string, error) {
@@ -229,7 +278,7 @@ func (s *Service) getCIVariables(kt *kit.Kit, cis []*pbci.ConfigItem) ([][]strin
 
 			k := kt.GetKitForRepoCfg()
 			// all the files have filtered by content size, so no need to check size again in repo
-			vars, err := s.repo.GetVariables(k, c.CommitSpec.Content.Signature, false)
+			vars, err := s.repo.GetVariables(k, c.CommitSpec.Content.Signature, tmplprocess.SimpleEngine, false)
 			if err != nil {
 				hitError = fmt.Errorf("get config item variables from repo failed, "+
 					"config item id: %d, name: %s, path: %s, error: %v", // nolint goconst
@@ -0,0 +1,176 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbwh "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/webhook"
+	pbds "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// CreateWebhook create webhook.
+func (s *Service) CreateWebhook(ctx context.Context, req *pbds.CreateWebhookReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	_, err := s.dao.Webhook().GetByName(kt, req.Attachment.BizId, req.Attachment.AppId, req.Spec.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err == nil {
+		return nil, fmt.Errorf("webhook's same name %s already exists", req.Spec.Name)
+	}
+
+	if err = validateWebhookURL(kt, req.Spec.Url); err != nil {
+		return nil, err
+	}
+
+	webhook := &table.Webhook{
+		Spec:       req.Spec.WebhookSpec(),
+		Attachment: req.Attachment.WebhookAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.Webhook().Create(kt, webhook)
+	if err != nil {
+		logs.Errorf("create webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateWebhook update webhook, the secret of the webhook is kept when the secret is not set.
+func (s *Service) UpdateWebhook(ctx context.Context, req *pbds.UpdateWebhookReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.Webhook().Get(kt, req.Attachment.BizId, req.Attachment.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if old.Spec.Name != req.Spec.Name {
+		_, err = s.dao.Webhook().GetByName(kt, req.Attachment.BizId, req.Attachment.AppId, req.Spec.Name)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Errorf("get webhook failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		if err == nil {
+			return nil, fmt.Errorf("webhook's same name %s already exists", req.Spec.Name)
+		}
+	}
+
+	if err = validateWebhookURL(kt, req.Spec.Url); err != nil {
+		return nil, err
+	}
+
+	webhook := &table.Webhook{
+		ID:         req.Id,
+		Spec:       req.Spec.WebhookSpec(),
+		Attachment: req.Attachment.WebhookAttachment(),
+		Revision: &table.Revision{
+			Reviser: kt.User,
+		},
+	}
+	if len(webhook.Spec.Secret) == 0 {
+		webhook.Spec.Secret = old.Spec.Secret
+	}
+	if err = s.dao.Webhook().Update(kt, webhook); err != nil {
+		logs.Errorf("update webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// validateWebhookURL rejects the webhook url whose host resolves to a loopback, private or link-local address,
+// unless the address is allowed by the webhook notice config.
+func validateWebhookURL(kt *kit.Kit, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url, err: %v", err)
+	}
+	allow := cc.DataService().Notice.Webhook.AllowInternal
+	if _, err = tools.LookupPublicIPs(kt.Ctx, u.Hostname(), allow); err != nil {
+		logs.Errorf("validate webhook url %s failed, err: %v, rid: %s", rawURL, err, kt.Rid)
+		return fmt.Errorf("invalid webhook url, err: %v", err)
+	}
+	return nil
+}
+
+// DeleteWebhook delete webhook.
+func (s *Service) DeleteWebhook(ctx context.Context, req *pbds.DeleteWebhookReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	webhook := &table.Webhook{
+		ID:         req.Id,
+		Attachment: req.Attachment.WebhookAttachment(),
+	}
+	if err := s.dao.Webhook().Delete(kt, webhook); err != nil {
+		logs.Errorf("delete webhook failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListWebhooks list webhooks.
+func (s *Service) ListWebhooks(ctx context.Context, req *pbds.ListWebhooksReq) (*pbds.ListWebhooksResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	details, count, err := s.dao.Webhook().List(kt, req.BizId, req.AppId,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list webhooks failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListWebhooksResp{
+		Count:   uint32(count),
+		Details: pbwh.PbWebhooks(details),
+	}, nil
+}
+
+// ListWebhookDeliveries list the deliveries of the webhook.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *pbds.ListWebhookDeliveriesReq) (
+	*pbds.ListWebhookDeliveriesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	details, count, err := s.dao.WebhookDelivery().List(kt, req.BizId, req.AppId, req.WebhookId, req.Status,
+		&types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All})
+	if err != nil {
+		logs.Errorf("list webhook deliveries failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListWebhookDeliveriesResp{
+		Count:   uint32(count),
+		Details: pbwh.PbWebhookDeliveries(details),
+	}, nil
+}
@@ -63,7 +63,8 @@ func New(sd serviced.Discover, authorizer iamauth.Authorizer, name string) (*BLL
 	}
 
 	handler := &eventc.Handler{
-		GetMatchedRelease: rs.GetMatchedRelease,
+		GetMatchedRelease:         rs.GetMatchedRelease,
+		GetMatchedReleaseContents: rs.GetMatchedReleaseContents,
 	}
 	if e := sch.Run(handler); e != nil {
 		return nil, fmt.Errorf("run scheduler faield, err: %v", e)
@@ -52,6 +52,10 @@ type Handler struct {
 	// GetMatchedRelease get the specified app instance's current release id.
 	// Note: this function's match pipeline should not use cache data,
 	GetMatchedRelease func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, error)
+	// GetMatchedReleaseContents get the specified app instance's current release id, and the config item
+	// contents rendered with the matched group's variables.
+	GetMatchedReleaseContents func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, table.GroupRenderedContents,
+		error)
 }
 
 // NewScheduler create a new scheduler instance.
@@ -264,7 +268,7 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 		Uid:    inst.Uid,
 		Labels: inst.Labels,
 	}
-	releaseID, e := sch.handler.GetMatchedRelease(kt, meta)
+	releaseID, contents, e := sch.handler.GetMatchedReleaseContents(kt, meta)
 	if e != nil {
 		sch.retry.Add(cursorID, one)
 		logs.Errorf("get %s [sn: %d] matched strategy failed, err: %v, rid: %s", inst.Format(), one.sn, e, kt.Rid)
@@ -305,6 +309,7 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 		if len(ciList) == 0 {
 			return
 		}
+		ciList = types.OverrideRenderedContents(ciList, contents)
 		event = sch.buildEvent(inst, ciList, preHook, postHook, releaseID, cursorID)
 
 	default:
@@ -21,42 +21,51 @@ import (
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/selector"
 	ptypes "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
 )
 
 // GetMatchedRelease get the app instance's matched release id.
 func (rs *ReleasedService) GetMatchedRelease(kt *kit.Kit, meta *types.AppInstanceMeta) (uint32, error) {
+	releaseID, _, err := rs.GetMatchedReleaseContents(kt, meta)
+	return releaseID, err
+}
+
+// GetMatchedReleaseContents get the app instance's matched release id, and the config item contents
+// rendered with the matched group's variables, which override the release's contents.
+func (rs *ReleasedService) GetMatchedReleaseContents(kt *kit.Kit, meta *types.AppInstanceMeta) (
+	uint32, table.GroupRenderedContents, error) {
 
 	ctx, cancel := context.WithTimeout(context.TODO(), rs.matchReleaseWaitTime)
 	defer cancel()
 
 	if err := rs.limiter.Wait(ctx); err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	am, err := rs.cache.App.GetMeta(kt, meta.BizID, meta.AppID)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	switch am.ConfigType {
 	case table.File:
 	case table.KV:
 	default:
-		return 0, errf.New(errf.InvalidParameter, "only supports File and KV configuration types.")
+		return 0, nil, errf.New(errf.InvalidParameter, "only supports File and KV configuration types.")
 	}
 
 	groups, err := rs.listReleasedGroups(kt, meta)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	matched, err := rs.matchReleasedGroupWithLabels(kt, groups, meta)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
-	return matched.ReleaseID, nil
+	return matched.ReleaseID, matched.RenderedContents, nil
 }
 
 // listReleasedGroups list released groups
@@ -74,6 +83,8 @@ type matchedMeta struct {
 	StrategyID uint32
 	ReleaseID  uint32
 	GroupID    uint32
+	// RenderedContents are the contents rendered with the group variables.
+	RenderedContents table.GroupRenderedContents
 }
 
 // matchOneStrategyWithLabels match at most only one strategy with app instance labels.
@@ -85,7 +96,12 @@ func (rs *ReleasedService) matchReleasedGroupWithLabels(
 	sort.Slice(groups, func(i, j int) bool {
 		return groups[i].UpdatedAt.After(groups[j].UpdatedAt)
 	})
-	// 2. match groups with labels
+	// 2. match groups with labels, the built-in uid label is used to select instances by percentage.
+	labels := make(map[string]string, len(meta.Labels)+1)
+	for k, v := range meta.Labels {
+		labels[k] = v
+	}
+	labels[selector.UIDLabelKey] = meta.Uid
 	matchedList := []*matchedMeta{}
 	var def *matchedMeta
 	for _, group := range groups {
@@ -93,31 +109,34 @@ func (rs *ReleasedService) matchReleasedGroupWithLabels(
 		case table.Debug:
 			if group.UID == meta.Uid {
 				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:  group.ReleaseID,
-					GroupID:    group.GroupID,
-					StrategyID: group.StrategyID,
+					ReleaseID:        group.ReleaseID,
+					GroupID:          group.GroupID,
+					StrategyID:       group.StrategyID,
+					RenderedContents: group.RenderedContents,
 				})
 			}
 		case table.Custom:
 			if group.Selector == nil {
 				return nil, errf.New(errf.InvalidParameter, "custom group must have selector")
 			}
-			matched, err := group.Selector.MatchLabels(meta.Labels)
+			matched, err := group.Selector.MatchLabels(labels)
 			if err != nil {
 				return nil, err
 			}
 			if matched {
 				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:  group.ReleaseID,
-					GroupID:    group.GroupID,
-					StrategyID: group.StrategyID,
+					ReleaseID:        group.ReleaseID,
+					GroupID:          group.GroupID,
+					StrategyID:       group.StrategyID,
+					RenderedContents: group.RenderedContents,
 				})
 			}
 		case table.Default:
 			def = &matchedMeta{
-				ReleaseID:  group.ReleaseID,
-				GroupID:    group.GroupID,
-				StrategyID: group.StrategyID,
+				ReleaseID:        group.ReleaseID,
+				GroupID:          group.GroupID,
+				StrategyID:       group.StrategyID,
+				RenderedContents: group.RenderedContents,
 			}
 		}
 	}
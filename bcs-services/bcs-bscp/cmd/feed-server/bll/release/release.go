@@ -32,6 +32,7 @@ import (
 	pbcontent "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/content"
 	pbhook "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/hook"
 	pbkv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/kv"
+	ptypes "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
 )
 
 // New initialize the release service instance.
@@ -68,7 +69,7 @@ type ReleasedService struct {
 func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.AppInstanceMeta) (
 	*types.AppLatestReleaseMeta, error) {
 
-	releaseID, err := rs.GetMatchedRelease(kt, opts)
+	releaseID, contents, err := rs.GetMatchedReleaseContents(kt, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +78,7 @@ func (rs *ReleasedService) ListAppLatestReleaseMeta(kt *kit.Kit, opts *types.App
 	if err != nil {
 		return nil, err
 	}
+	rci = ptypes.OverrideRenderedContents(rci, contents)
 
 	pre, post, err := rs.cache.ReleasedHook.Get(kt, opts.BizID, releaseID)
 	if err != nil {
@@ -25,9 +25,15 @@ import (
 	sfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/sf-share"
 )
 
+// WatchStream is the stream which the release change messages are sent back to, it is implemented
+// by the sidecar's grpc watch stream and the http watch stream of the feed server.
+type WatchStream interface {
+	Context() context.Context
+	Send(*pbfs.FeedWatchMessage) error
+}
+
 // Watch handle watch messages delivered from sidecar.
-func (rs *ReleasedService) Watch(im *sfs.IncomingMeta, payload *sfs.SideWatchPayload,
-	fws pbfs.Upstream_WatchServer) error {
+func (rs *ReleasedService) Watch(im *sfs.IncomingMeta, payload *sfs.SideWatchPayload, fws WatchStream) error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wh := &watchHandler{
@@ -63,7 +69,7 @@ type watchHandler struct {
 	counter *atomic.Int32
 	// snList stores the sidecar's registered app's SN returned by watcher's register.
 	snList      map[uint64]*appReminder
-	stream      pbfs.Upstream_WatchServer
+	stream      WatchStream
 	im          *sfs.IncomingMeta
 	cache       *lcache.Cache
 	watcher     eventc.Watcher
@@ -24,6 +24,7 @@ import (
 	pbcontent "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/content"
 	pbhook "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/hook"
 	pbkv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/kv"
+	sfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/sf-share"
 )
 
 // AppInstanceMeta defines an app instance's metadata information.
@@ -71,6 +72,55 @@ func (op *ListFileAppLatestReleaseMetaReq) Validate() error {
 	return nil
 }
 
+// WatchAppReleaseReq defines options for the http client to watch an app's release change event.
+type WatchAppReleaseReq struct {
+	BizId  uint32            `json:"biz_id,omitempty"`
+	App    string            `json:"app,omitempty"`
+	Uid    string            `json:"uid,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// CurrentReleaseId is the client's current effected release id, the release change event is
+	// sent immediately when it is not the release matched by the client's labels.
+	CurrentReleaseId uint32 `json:"current_release_id,omitempty"`
+	CurrentCursorId  uint32 `json:"current_cursor_id,omitempty"`
+	// TimeoutSec is the max seconds to hold the long-poll request when no event happens.
+	TimeoutSec uint32 `json:"timeout_sec,omitempty"`
+}
+
+// Validate options is valid or not.
+func (op *WatchAppReleaseReq) Validate() error {
+	if op.BizId <= 0 {
+		return errf.New(errf.InvalidParameter, "invalid biz id, should be > 0")
+	}
+
+	if op.App == "" {
+		return errf.New(errf.InvalidParameter, "app is required")
+	}
+
+	if err := validator.ValidateUid(op.Uid); err != nil {
+		return errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	if err := validator.ValidateLabel(op.Labels); err != nil {
+		return errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	return nil
+}
+
+// WatchMessage defines the message sent to the http watch client, the type is PublishRelease or
+// Bounce, the client should reconnect to the feed server when it receives the Bounce message.
+type WatchMessage struct {
+	Rid     string                    `json:"rid"`
+	Type    string                    `json:"type"`
+	Payload *sfs.ReleaseChangePayload `json:"payload,omitempty"`
+}
+
+// WatchAppReleasePollResp defines the long-poll watch response, messages is empty when no release
+// change event happens before timeout.
+type WatchAppReleasePollResp struct {
+	Messages []*WatchMessage `json:"messages"`
+}
+
 // ReleasedCIMeta defines a release's released config item metadata
 type ReleasedCIMeta struct {
 	RciId                uint32                     `json:"rci_id,omitempty"`
@@ -14,16 +14,23 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/components"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/rest"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
 )
 
@@ -60,7 +67,10 @@ func getBearerToken(md metadata.MD) (string, error) {
 		return "", fmt.Errorf("missing authorization header")
 	}
 
-	authorizationHeader := values[0]
+	return parseBearerToken(values[0])
+}
+
+func parseBearerToken(authorizationHeader string) (string, error) {
 	authHeaderParts := strings.Split(authorizationHeader, " ")
 	if len(authHeaderParts) != 2 || strings.ToLower(authHeaderParts[0]) != "bearer" {
 		return "", fmt.Errorf("invalid authorization header format")
@@ -148,3 +158,47 @@ func FeedStreamAuthInterceptor(
 	w := &wrappedStream{ServerStream: ss, ctx: ctx}
 	return handler(srv, w)
 }
+
+// CredentialVerified http 凭证鉴权中间件, 与 grpc 接口使用相同的客户端密钥, url 需要添加 {biz_id} 变量
+func (s *Service) CredentialVerified(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		kt := &kit.Kit{
+			Ctx: r.Context(),
+			Rid: components.RequestIDValue(r.Context()),
+		}
+
+		bizID, err := strconv.ParseUint(chi.URLParam(r, "biz_id"), 10, 32)
+		if err != nil || bizID == 0 {
+			render.Render(w, r, rest.BadRequest(errors.New("invalid biz_id in url params")))
+			return
+		}
+		kt.BizID = uint32(bizID)
+
+		authorizationHeader := r.Header.Get("Authorization")
+		if authorizationHeader == "" {
+			render.Render(w, r, rest.UnauthorizedErr(errors.New("missing authorization header"), "", ""))
+			return
+		}
+
+		token, err := parseBearerToken(authorizationHeader)
+		if err != nil {
+			render.Render(w, r, rest.UnauthorizedErr(err, "", ""))
+			return
+		}
+
+		cred, err := s.bll.Auth().GetCred(kt, kt.BizID, token)
+		if err != nil {
+			render.Render(w, r, rest.UnauthorizedErr(err, "", ""))
+			return
+		}
+		if !cred.Enabled {
+			render.Render(w, r, rest.PermissionDenied(errors.New("credential is disabled"), nil))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), constant.BizIDKey, kt.BizID) //nolint:staticcheck
+		ctx = withCredential(kit.WithKit(ctx, kt), cred)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+	return http.HandlerFunc(fn)
+}
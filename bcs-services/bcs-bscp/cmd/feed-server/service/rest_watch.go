@@ -0,0 +1,400 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/feed-server/bll/types"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
+	pbfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/rest"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/jsoni"
+	sfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	pkgtypes "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+const (
+	// defaultWatchPollTimeoutSec is the default seconds to hold the long-poll watch request.
+	defaultWatchPollTimeoutSec = 30
+	// maxWatchPollTimeoutSec is the max seconds to hold the long-poll watch request.
+	maxWatchPollTimeoutSec = 120
+	// sseHeartbeatInterval is the interval to send the comment line to keep the sse connection alive,
+	// so that the proxies between the client and the feed server do not close the idle connection.
+	sseHeartbeatInterval = 15 * time.Second
+)
+
+// WatchAppReleaseSSE watch the app's release change event with server-sent events, each message is sent
+// as an event whose name is the message type, the stream is kept until the client closes it or the feed
+// server sends the Bounce event to tell the client reconnect to another feed server.
+func (s *Service) WatchAppReleaseSSE(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		render.Render(w, r, rest.BadRequest(errors.New("streaming is not supported")))
+		return
+	}
+
+	req, err := parseWatchAppReleaseReq(r)
+	if err != nil {
+		renderWatchErr(w, r, err)
+		return
+	}
+
+	cred := getCredential(r.Context())
+	im, payload, err := s.prepareHTTPWatch(kt, cred, req)
+	if err != nil {
+		renderWatchErr(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream := &httpWatchStream{ctx: ctx, app: req.App, cred: cred}
+	// response header is written with the first message or heartbeat, so that the error can
+	// still be returned as a normal response when the subscription is failed.
+	stream.write = func(msg []byte) error {
+		if !stream.started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("Connection", "keep-alive")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+			stream.started = true
+		}
+
+		if _, err := w.Write(msg); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	stream.encode = func(msg *types.WatchMessage) ([]byte, error) {
+		data, err := jsoni.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", msg.Rid, msg.Type, data)), nil
+	}
+
+	go stream.keepalive(sseHeartbeatInterval, []byte(": heartbeat\n\n"))
+
+	err = s.doHTTPWatch(im, payload, stream)
+	if started := stream.close(); err != nil && !started {
+		renderWatchErr(w, r, err)
+	}
+}
+
+// WatchAppReleasePoll watch the app's release change event with long-poll, the request is held until
+// the release change event happens or timeout, the client should watch again with the returned cursor
+// and release id after it handles the response.
+func (s *Service) WatchAppReleasePoll(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+
+	req, err := parseWatchAppReleaseReq(r)
+	if err != nil {
+		renderWatchErr(w, r, err)
+		return
+	}
+
+	cred := getCredential(r.Context())
+	im, payload, err := s.prepareHTTPWatch(kt, cred, req)
+	if err != nil {
+		renderWatchErr(w, r, err)
+		return
+	}
+
+	timeout := req.TimeoutSec
+	if timeout == 0 {
+		timeout = defaultWatchPollTimeoutSec
+	}
+	if timeout > maxWatchPollTimeoutSec {
+		timeout = maxWatchPollTimeoutSec
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	resp := &types.WatchAppReleasePollResp{Messages: make([]*types.WatchMessage, 0)}
+	stream := &httpWatchStream{ctx: ctx, app: req.App, cred: cred}
+	// finish the watch job as soon as the first message is received.
+	stream.collect = func(msg *types.WatchMessage) {
+		resp.Messages = append(resp.Messages, msg)
+		cancel()
+	}
+
+	err = s.doHTTPWatch(im, payload, stream)
+	stream.close()
+	if err != nil {
+		renderWatchErr(w, r, err)
+		return
+	}
+
+	render.Render(w, r, rest.OKRender(resp))
+}
+
+// prepareHTTPWatch check the credential's permission of the app, and build the watch payload
+// in the same way as the sidecar's watch request.
+func (s *Service) prepareHTTPWatch(kt *kit.Kit, cred *pkgtypes.CredentialCache, req *types.WatchAppReleaseReq) (
+	*sfs.IncomingMeta, *sfs.SideWatchPayload, error) {
+
+	if !cred.MatchApp(req.App) {
+		return nil, nil, fmt.Errorf("%w, not have app %s permission", errf.ErrPermissionDenied, req.App)
+	}
+
+	appID, err := s.bll.AppCache().GetAppID(kt, req.BizId, req.App)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get app id failed, %s", err.Error())
+	}
+
+	payload := &sfs.SideWatchPayload{
+		BizID: req.BizId,
+		Applications: []sfs.SideAppMeta{{
+			AppID:            appID,
+			App:              req.App,
+			Uid:              req.Uid,
+			Labels:           req.Labels,
+			CurrentReleaseID: req.CurrentReleaseId,
+			CurrentCursorID:  req.CurrentCursorId,
+		}},
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid payload, err: %s", err.Error())
+	}
+
+	im := &sfs.IncomingMeta{
+		Kit: kt,
+		Meta: &sfs.SidecarMetaHeader{
+			BizID:       req.BizId,
+			Fingerprint: "http-" + req.Uid,
+		},
+	}
+
+	return im, payload, nil
+}
+
+// doHTTPWatch do the watch job with the http watch stream, it's blocked until the stream is done.
+func (s *Service) doHTTPWatch(im *sfs.IncomingMeta, payload *sfs.SideWatchPayload, stream *httpWatchStream) error {
+	app := payload.Applications[0]
+	logs.Infof("received http watch request, biz: %d, app: %s, uid: %s, labels: %s, rid: %s.", payload.BizID,
+		app.App, app.Uid, app.Labels, im.Kit.Rid)
+
+	s.mc.watchTotal.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Inc()
+	defer s.mc.watchTotal.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Dec()
+	s.mc.watchCounter.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Inc()
+
+	if err := s.bll.Release().Watch(im, payload, stream); err != nil {
+		logs.Errorf("http watch failed, err: %v, rid: %s.", err, im.Kit.Rid)
+		return fmt.Errorf("do watch job failed, err: %v", err)
+	}
+
+	logs.Infof("finished http watch job, rid: %s", im.Kit.Rid)
+
+	return nil
+}
+
+// httpWatchStream implements the release.WatchStream interface for the http watch, the release change
+// messages are filtered with the credential's scope, then written to the sse stream or collected for
+// the long-poll response.
+type httpWatchStream struct {
+	ctx  context.Context
+	app  string
+	cred *pkgtypes.CredentialCache
+
+	lock sync.Mutex
+	// started is whether the response has been written to the client.
+	started bool
+	// closed is set after the watch job is done, the messages can not be sent any more.
+	closed bool
+	// encode and write are used by the sse watch to send the message.
+	encode func(msg *types.WatchMessage) ([]byte, error)
+	write  func(data []byte) error
+	// collect is used by the long-poll watch to collect the message.
+	collect func(msg *types.WatchMessage)
+}
+
+// Context returns the context of the watch stream.
+func (hs *httpWatchStream) Context() context.Context {
+	return hs.ctx
+}
+
+// Send the feed watch message to the http client.
+func (hs *httpWatchStream) Send(wm *pbfs.FeedWatchMessage) error {
+	msg := &types.WatchMessage{
+		Rid:  wm.Rid,
+		Type: sfs.FeedMessageType(wm.Type).String(),
+	}
+
+	if sfs.FeedMessageType(wm.Type) == sfs.PublishRelease {
+		payload := new(sfs.ReleaseChangePayload)
+		if err := jsoni.Unmarshal(wm.Payload, payload); err != nil {
+			return fmt.Errorf("decode release change payload failed, err: %v", err)
+		}
+		// the event of the app which is not watched by this stream is never sent to the client.
+		if payload.ReleaseMeta != nil && payload.ReleaseMeta.App != hs.app {
+			return nil
+		}
+		hs.filter(payload)
+		msg.Payload = payload
+	}
+
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	if hs.closed {
+		return errors.New("http watch stream is closed")
+	}
+
+	if hs.collect != nil {
+		hs.collect(msg)
+		return nil
+	}
+
+	data, err := hs.encode(msg)
+	if err != nil {
+		return fmt.Errorf("encode watch message failed, err: %v", err)
+	}
+
+	return hs.write(data)
+}
+
+// filter removes the config items and kvs which the credential has no permission.
+func (hs *httpWatchStream) filter(payload *sfs.ReleaseChangePayload) {
+	if payload.ReleaseMeta == nil {
+		return
+	}
+
+	cis := make([]*sfs.ConfigItemMetaV1, 0, len(payload.ReleaseMeta.CIMetas))
+	for _, ci := range payload.ReleaseMeta.CIMetas {
+		if ci.ConfigItemSpec == nil {
+			continue
+		}
+
+		if hs.cred.MatchConfigItem(hs.app, ci.ConfigItemSpec.Path, ci.ConfigItemSpec.Name) {
+			cis = append(cis, ci)
+		}
+	}
+	payload.ReleaseMeta.CIMetas = cis
+
+	kvs := make([]*sfs.KvMetaV1, 0, len(payload.ReleaseMeta.KvMetas))
+	for _, kv := range payload.ReleaseMeta.KvMetas {
+		if hs.cred.MatchKv(hs.app, kv.Key) {
+			kvs = append(kvs, kv)
+		}
+	}
+	payload.ReleaseMeta.KvMetas = kvs
+}
+
+// keepalive writes the heartbeat data to the client periodically until the stream is done.
+func (hs *httpWatchStream) keepalive(interval time.Duration, heartbeat []byte) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-hs.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		hs.lock.Lock()
+		if hs.closed {
+			hs.lock.Unlock()
+			return
+		}
+		err := hs.write(heartbeat)
+		hs.lock.Unlock()
+
+		if err != nil {
+			logs.Errorf("write heartbeat to http watch stream failed, err: %v", err)
+			return
+		}
+	}
+}
+
+// close the stream, and returns whether the response has been written to the client.
+func (hs *httpWatchStream) close() bool {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	hs.closed = true
+	return hs.started
+}
+
+// parseWatchAppReleaseReq parse the watch options from the url params and query, the labels
+// is a json object, such as: labels={"region":"sz"}.
+func parseWatchAppReleaseReq(r *http.Request) (*types.WatchAppReleaseReq, error) {
+	kt := kit.MustGetKit(r.Context())
+	query := r.URL.Query()
+
+	req := &types.WatchAppReleaseReq{
+		BizId: kt.BizID,
+		App:   chi.URLParam(r, "app"),
+		Uid:   query.Get("uid"),
+	}
+
+	if labels := query.Get("labels"); labels != "" {
+		if err := jsoni.UnmarshalFromString(labels, &req.Labels); err != nil {
+			return nil, errf.New(errf.InvalidParameter, fmt.Sprintf("invalid labels, err: %v", err))
+		}
+	}
+
+	params := map[string]*uint32{
+		"current_release_id": &req.CurrentReleaseId,
+		"current_cursor_id":  &req.CurrentCursorId,
+		"timeout_sec":        &req.TimeoutSec,
+	}
+	for name, value := range params {
+		str := query.Get(name)
+		if str == "" {
+			continue
+		}
+
+		v, err := strconv.ParseUint(str, 10, 32)
+		if err != nil {
+			return nil, errf.New(errf.InvalidParameter, fmt.Sprintf("invalid %s, err: %v", name, err))
+		}
+		*value = uint32(v)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// renderWatchErr render the http watch's error response.
+func renderWatchErr(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errf.ErrPermissionDenied) {
+		render.Render(w, r, rest.PermissionDenied(err, nil))
+		return
+	}
+
+	render.Render(w, r, rest.BadRequest(err))
+}
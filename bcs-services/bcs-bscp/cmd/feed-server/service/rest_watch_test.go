@@ -0,0 +1,209 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/cmd/feed-server/bll/types"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	pbci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
+	pbfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/jsoni"
+	sfs "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/sf-share"
+	pkgtypes "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+const watchTestPath = "/api/v1/feed/biz/{biz_id}/app/{app}/watch"
+
+func TestCredentialVerified(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		authorization string
+		wantStatus    int
+	}{
+		{
+			name:          "invalid biz id",
+			url:           "/api/v1/feed/biz/0/app/demo/watch",
+			authorization: "Bearer token",
+			wantStatus:    http.StatusBadRequest,
+		},
+		{
+			name:       "missing authorization header",
+			url:        "/api/v1/feed/biz/2/app/demo/watch",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "not bearer token",
+			url:           "/api/v1/feed/biz/2/app/demo/watch",
+			authorization: "Basic token",
+			wantStatus:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			called := false
+			r := chi.NewRouter()
+			r.With(s.CredentialVerified).Get(watchTestPath, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status code %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called {
+				t.Errorf("watch handler is called without a verified credential")
+			}
+		})
+	}
+}
+
+// withTestCredential sets the kit and credential to the request context as CredentialVerified does.
+func withTestCredential(cred *pkgtypes.CredentialCache) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := kit.WithKit(r.Context(), &kit.Kit{Ctx: r.Context(), BizID: 2, Rid: "rid"})
+			next.ServeHTTP(w, r.WithContext(withCredential(ctx, cred)))
+		})
+	}
+}
+
+func TestWatchAppReleaseAppNotCovered(t *testing.T) {
+	s := &Service{}
+	cred := &pkgtypes.CredentialCache{Enabled: true, Scope: []string{"other/**"}}
+
+	r := chi.NewRouter()
+	r.Route(watchTestPath, func(r chi.Router) {
+		r.Use(withTestCredential(cred))
+		r.Get("/sse", s.WatchAppReleaseSSE)
+		r.Get("/poll", s.WatchAppReleasePoll)
+	})
+
+	for _, mode := range []string{"sse", "poll"} {
+		t.Run(mode, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/feed/biz/2/app/demo/watch/"+mode+"?uid=client1", nil)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Errorf("status code %d, want %d, body: %s", rec.Code, http.StatusForbidden, rec.Body.String())
+			}
+			if strings.Contains(rec.Body.String(), "PublishRelease") {
+				t.Errorf("release event is sent to the client without app permission: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+// releaseWatchMessage builds the release change message of the app with the config items and kvs.
+func releaseWatchMessage(t *testing.T, app string, cis []string, kvs []string) *pbfs.FeedWatchMessage {
+	meta := &sfs.ReleaseEventMetaV1{App: app, ReleaseID: 1}
+	for _, ci := range cis {
+		idx := strings.LastIndex(ci, "/")
+		meta.CIMetas = append(meta.CIMetas, &sfs.ConfigItemMetaV1{
+			ConfigItemSpec: &pbci.ConfigItemSpec{Path: ci[:idx], Name: ci[idx+1:]}})
+	}
+	for _, kv := range kvs {
+		meta.KvMetas = append(meta.KvMetas, &sfs.KvMetaV1{Key: kv})
+	}
+
+	payload, err := jsoni.Marshal(&sfs.ReleaseChangePayload{ReleaseMeta: meta})
+	if err != nil {
+		t.Fatalf("marshal release change payload failed, err: %v", err)
+	}
+	return &pbfs.FeedWatchMessage{Rid: "rid", Type: uint32(sfs.PublishRelease), Payload: payload}
+}
+
+func TestHTTPWatchStreamFilter(t *testing.T) {
+	cred := &pkgtypes.CredentialCache{Enabled: true, Scope: []string{"demo/etc/allowed/**", "demo/log_*", "other/**"}}
+	// the app permission is checked before the stream is created, as prepareHTTPWatch does.
+	cred.MatchApp("demo")
+
+	t.Run("poll", func(t *testing.T) {
+		msgs := make([]*types.WatchMessage, 0)
+		stream := &httpWatchStream{ctx: context.Background(), app: "demo", cred: cred}
+		stream.collect = func(msg *types.WatchMessage) {
+			msgs = append(msgs, msg)
+		}
+
+		// the event of another app is never sent, even the credential covers it.
+		if err := stream.Send(releaseWatchMessage(t, "other", []string{"/etc/allowed/a.yaml"}, nil)); err != nil {
+			t.Fatalf("send watch message failed, err: %v", err)
+		}
+		if len(msgs) != 0 {
+			t.Fatalf("the event of the app not watched is sent to the client")
+		}
+
+		err := stream.Send(releaseWatchMessage(t, "demo", []string{"/etc/allowed/a.yaml", "/etc/secret/b.yaml"},
+			[]string{"log_level", "db_password"}))
+		if err != nil {
+			t.Fatalf("send watch message failed, err: %v", err)
+		}
+		if len(msgs) != 1 {
+			t.Fatalf("got %d messages, want 1", len(msgs))
+		}
+
+		meta := msgs[0].Payload.ReleaseMeta
+		if len(meta.CIMetas) != 1 || meta.CIMetas[0].ConfigItemSpec.Name != "a.yaml" {
+			t.Errorf("config items %v, want only a.yaml", meta.CIMetas)
+		}
+		if len(meta.KvMetas) != 1 || meta.KvMetas[0].Key != "log_level" {
+			t.Errorf("kvs %v, want only log_level", meta.KvMetas)
+		}
+	})
+
+	t.Run("sse", func(t *testing.T) {
+		var sent strings.Builder
+		stream := &httpWatchStream{ctx: context.Background(), app: "demo", cred: cred}
+		stream.write = func(data []byte) error {
+			sent.Write(data)
+			return nil
+		}
+		stream.encode = func(msg *types.WatchMessage) ([]byte, error) {
+			return jsoni.Marshal(msg)
+		}
+
+		err := stream.Send(releaseWatchMessage(t, "demo", []string{"/etc/secret/b.yaml"}, []string{"db_password"}))
+		if err != nil {
+			t.Fatalf("send watch message failed, err: %v", err)
+		}
+		if sent.Len() == 0 {
+			t.Fatalf("release event is not sent")
+		}
+		if strings.Contains(sent.String(), "b.yaml") || strings.Contains(sent.String(), "db_password") {
+			t.Errorf("config not covered by the credential is sent: %s", sent.String())
+		}
+
+		// the closed stream does not send any message.
+		stream.close()
+		if err := stream.Send(releaseWatchMessage(t, "demo", []string{"/etc/allowed/a.yaml"}, nil)); err == nil {
+			t.Errorf("send message to the closed stream without error")
+		}
+	})
+}
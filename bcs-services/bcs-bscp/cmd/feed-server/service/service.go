@@ -152,6 +152,13 @@ func (s *Service) handler() http.Handler {
 	r.Get("/-/ready", s.ReadyHandler)
 	r.Get("/healthz", s.Healthz)
 
+	// 客户端 http watch 接口, 使用客户端密钥鉴权, 支持 SSE 和 long-poll 两种方式
+	r.Route("/api/v1/feed/biz/{biz_id}/app/{app}/watch", func(r chi.Router) {
+		r.Use(s.CredentialVerified)
+		r.Get("/sse", s.WatchAppReleaseSSE)
+		r.Get("/poll", s.WatchAppReleasePoll)
+	})
+
 	r.Mount("/", handler.RegisterCommonToolHandler())
 	return r
 }
@@ -262,6 +262,21 @@ var auditGrpcMap = map[string]func() (audit.Resource, audit.Action){
 		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
 			audit.Action{ActionID: "delete_release"}
 	},
+	"/pbcs.Config/CompareReleases": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "compare_releases"}
+	},
+	"/pbcs.Config/PromoteRelease": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "promote_release"}
+	},
+	"/pbcs.Config/PreviewPromoteRelease": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "preview_promote_release"}
+	},
 	"/pbcs.Config/CreateHook": func() (audit.Resource, audit.Action) {
 		// return the resource to be recorded.
 		return audit.Resource{ResourceType: audit.ResourceType(sys.Business)},
@@ -697,6 +712,116 @@ var auditGrpcMap = map[string]func() (audit.Resource, audit.Action){
 		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
 			audit.Action{ActionID: "generate_release_and_publish"}
 	},
+	"/pbcs.Config/ApprovePublish": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "approve_publish"}
+	},
+	"/pbcs.Config/RejectPublish": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "reject_publish"}
+	},
+	"/pbcs.Config/ListPublishApprovals": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "list_publish_approvals"}
+	},
+	"/pbcs.Config/CreateRolloutPlan": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "create_rollout_plan"}
+	},
+	"/pbcs.Config/ListRolloutPlans": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "list_rollout_plans"}
+	},
+	"/pbcs.Config/PauseRolloutPlan": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "pause_rollout_plan"}
+	},
+	"/pbcs.Config/ResumeRolloutPlan": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "resume_rollout_plan"}
+	},
+	"/pbcs.Config/RollbackRolloutPlan": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "rollback_rollout_plan"}
+	},
+	"/pbcs.Config/CreateConfigSchema": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "create_config_schema"}
+	},
+	"/pbcs.Config/UpdateConfigSchema": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "update_config_schema"}
+	},
+	"/pbcs.Config/DeleteConfigSchema": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "delete_config_schema"}
+	},
+	"/pbcs.Config/ListConfigSchemas": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "list_config_schemas"}
+	},
+	"/pbcs.Config/CreateWebhook": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "create_webhook"}
+	},
+	"/pbcs.Config/UpdateWebhook": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "update_webhook"}
+	},
+	"/pbcs.Config/DeleteWebhook": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "delete_webhook"}
+	},
+	"/pbcs.Config/ListWebhooks": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "list_webhooks"}
+	},
+	"/pbcs.Config/ListWebhookDeliveries": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "list_webhook_deliveries"}
+	},
+	"/pbcs.Config/CreateGitSource": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "create_git_source"}
+	},
+	"/pbcs.Config/UpdateGitSource": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "update_git_source"}
+	},
+	"/pbcs.Config/DeleteGitSource": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "delete_git_source"}
+	},
+	"/pbcs.Config/GetGitSource": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "get_git_source"}
+	},
+	"/pbcs.Config/SyncGitSource": func() (audit.Resource, audit.Action) {
+		// return the resource to be recorded.
+		return audit.Resource{ResourceType: audit.ResourceType(sys.Application)},
+			audit.Action{ActionID: "sync_git_source"}
+	},
 	"/pbcs.Config/CreateCredentials": func() (audit.Resource, audit.Action) {
 		// return the resource to be recorded.
 		return audit.Resource{ResourceType: audit.ResourceType(sys.AppCredential)},
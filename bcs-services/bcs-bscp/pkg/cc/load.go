@@ -137,3 +137,26 @@ func initGlobalConf(filenames []string) error {
 
 	return config.G.ReadFromViper(v)
 }
+
+// LoadRepository load the repository settings from the "repository" section of a config file,
+// which is used by the tools that need to access another repository besides the service's one.
+func LoadRepository(filename string) (Repository, error) {
+	file, err := os.ReadFile(filename)
+	if err != nil {
+		return Repository{}, fmt.Errorf("load repository from file: %s failed, err: %v", filename, err)
+	}
+
+	conf := struct {
+		Repository Repository `yaml:"repository"`
+	}{}
+	if err := yaml.Unmarshal(file, &conf); err != nil {
+		return Repository{}, fmt.Errorf("unmarshal repository yaml from file: %s failed, err: %v", filename, err)
+	}
+
+	conf.Repository.trySetDefault()
+	if err := conf.Repository.validate(); err != nil {
+		return Repository{}, err
+	}
+
+	return conf.Repository, nil
+}
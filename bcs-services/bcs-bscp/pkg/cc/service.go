@@ -281,6 +281,10 @@ type DataServiceSetting struct {
 	Esb        Esb        `yaml:"esb"`
 	Repo       Repository `yaml:"repository"`
 	Vault      Vault      `yaml:"vault"`
+	// Notice defines how the release events are notified to the message queue and webhooks.
+	Notice ReleaseNotice `yaml:"releaseNotice"`
+	// GitSource defines how the git repositories are synchronized to the apps.
+	GitSource GitSource `yaml:"gitSource"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -301,6 +305,7 @@ func (s *DataServiceSetting) trySetDefault() {
 	s.Sharding.trySetDefault()
 	s.Repo.trySetDefault()
 	s.Vault.getConfigFromEnv()
+	s.Notice.trySetDefault()
 }
 
 // Validate DataServiceSetting option.
@@ -330,6 +335,14 @@ func (s DataServiceSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Notice.validate(); err != nil {
+		return err
+	}
+
+	if err := s.GitSource.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
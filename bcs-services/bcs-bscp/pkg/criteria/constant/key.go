@@ -67,6 +67,9 @@ const (
 
 	// MaxRenderBytes is the max bytes to render for template config which is 2MB
 	MaxRenderBytes = 2 * 1024 * 1024
+
+	// MaxDiffBytes is the max bytes to generate unified diff for text config content which is 1MB
+	MaxDiffBytes = 1024 * 1024
 )
 
 // default resource
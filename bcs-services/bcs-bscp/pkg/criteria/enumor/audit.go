@@ -47,6 +47,12 @@ const (
 	Credential AuditResourceType = "credential"
 	// CredentialScope 凭据规则资源
 	CredentialScope AuditResourceType = "credential_scope" //nolint:gosec
+	// PublishApproval 上线审批资源
+	PublishApproval AuditResourceType = "publish_approval"
+	// RolloutPlan 渐进式发布计划资源
+	RolloutPlan AuditResourceType = "rollout_plan"
+	// ConfigSchema 配置校验规则资源
+	ConfigSchema AuditResourceType = "config_schema"
 )
 
 // AuditResourceTypeEnums resource type map.
@@ -63,6 +69,9 @@ var AuditResourceTypeEnums = map[AuditResourceType]bool{
 	Group:           true,
 	Credential:      true,
 	CredentialScope: true,
+	PublishApproval: true,
+	RolloutPlan:     true,
+	ConfigSchema:    true,
 }
 
 // Exist judge enum value exist.
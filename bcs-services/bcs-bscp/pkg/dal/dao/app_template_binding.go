@@ -28,6 +28,8 @@ import (
 type AppTemplateBinding interface {
 	// Create one app template binding instance.
 	Create(kit *kit.Kit, atb *table.AppTemplateBinding) (uint32, error)
+	// CreateWithTx create one app template binding instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, atb *table.AppTemplateBinding) (uint32, error)
 	// Update one app template binding's info.
 	Update(kit *kit.Kit, atb *table.AppTemplateBinding) error
 	// UpdateWithTx Update one app template binding's info with transaction.
@@ -36,6 +38,8 @@ type AppTemplateBinding interface {
 	List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.AppTemplateBinding, int64, error)
 	// Delete one app template binding instance.
 	Delete(kit *kit.Kit, atb *table.AppTemplateBinding) error
+	// DeleteWithTx delete one app template binding instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, atb *table.AppTemplateBinding) error
 	// DeleteByAppIDWithTx delete one app template binding instance by app id with transaction.
 	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID uint32) error
 }
@@ -86,6 +90,37 @@ func (dao *appTemplateBindingDao) Create(kit *kit.Kit, g *table.AppTemplateBindi
 	return g.ID, nil
 }
 
+// CreateWithTx create one app template binding instance with transaction.
+func (dao *appTemplateBindingDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	g *table.AppTemplateBinding) (uint32, error) {
+	if err := g.ValidateCreate(); err != nil {
+		return 0, err
+	}
+	if err := dao.validateAttachmentExist(kit, g.Attachment); err != nil {
+		return 0, err
+	}
+
+	// generate a app template binding id and update to app template binding.
+	id, err := dao.idGen.One(kit, table.Name(g.TableName()))
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareCreate(g)
+
+	q := tx.AppTemplateBinding.WithContext(kit.Ctx)
+	if err = q.Create(g); err != nil {
+		return 0, err
+	}
+
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, err
+	}
+
+	return g.ID, nil
+}
+
 // Update one app template binding instance.
 func (dao *appTemplateBindingDao) Update(kit *kit.Kit, g *table.AppTemplateBinding) error {
 	if err := g.ValidateUpdate(); err != nil {
@@ -212,6 +247,33 @@ func (dao *appTemplateBindingDao) Delete(kit *kit.Kit, g *table.AppTemplateBindi
 	return nil
 }
 
+// DeleteWithTx delete one app template binding instance with transaction.
+func (dao *appTemplateBindingDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.AppTemplateBinding) error {
+	// 参数校验
+	if err := g.ValidateDelete(); err != nil {
+		return err
+	}
+
+	// 删除操作, 获取当前记录做审计
+	m := tx.AppTemplateBinding
+	q := tx.AppTemplateBinding.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareDelete(oldOne)
+	if err = ad.Do(tx.Query); err != nil {
+		return err
+	}
+
+	q = tx.AppTemplateBinding.WithContext(kit.Ctx)
+	if _, err = q.Where(m.BizID.Eq(g.Attachment.BizID)).Delete(g); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteByAppIDWithTx delete one app template binding instance by app id with transaction.
 func (dao *appTemplateBindingDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID uint32) error {
 	m := tx.AppTemplateBinding
@@ -66,6 +66,9 @@ type Client interface {
 	UpsertHeartbeat(kit *kit.Kit, tx *gen.QueryTx, data []*table.Client) error
 	// UpsertVersionChange 更新插入版本更改
 	UpsertVersionChange(kit *kit.Kit, tx *gen.QueryTx, data []*table.Client) error
+	// CountReleaseChangeStatus 统计切换到目标版本的客户端变更状态
+	CountReleaseChangeStatus(kit *kit.Kit, bizID, appID, releaseID uint32, heartbeatTime time.Time) (
+		[]types.ChangeStatusChart, error)
 }
 
 var _ Client = new(clientDao)
@@ -196,6 +199,26 @@ func (dao *clientDao) ListClientGroupByChangeStatus(kit *kit.Kit, bizID uint32,
 	return items, nil
 }
 
+// CountReleaseChangeStatus 统计切换到目标版本的客户端变更状态, only the clients which
+// have heartbeat after the given time are counted.
+func (dao *clientDao) CountReleaseChangeStatus(kit *kit.Kit, bizID, appID, releaseID uint32,
+	heartbeatTime time.Time) ([]types.ChangeStatusChart, error) {
+
+	m := dao.genQ.Client
+	var items []types.ChangeStatusChart
+	err := dao.genQ.Client.WithContext(kit.Ctx).
+		Select(m.ReleaseChangeStatus, m.ID.Count().As("count")).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.TargetReleaseID.Eq(releaseID),
+			m.ReleaseChangeStatus.In(string(table.Failed), string(table.Success)),
+			m.LastHeartbeatTime.Gte(heartbeatTime)).
+		Group(m.ReleaseChangeStatus).
+		Scan(&items)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // ListClientGroupByCurrentReleaseID 通过当前版本ID统计数量
 func (dao *clientDao) ListClientGroupByCurrentReleaseID(kit *kit.Kit, bizID uint32, appID uint32, heartbeatTime int64,
 	search *pbclient.ClientQueryCondition) ([]types.ClientConfigVersionChart, error) {
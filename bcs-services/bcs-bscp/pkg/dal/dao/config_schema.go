@@ -0,0 +1,204 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// ConfigSchema supplies all the config schema related operations.
+type ConfigSchema interface {
+	// Create one config schema instance.
+	Create(kit *kit.Kit, schema *table.ConfigSchema) (uint32, error)
+	// Update one config schema's info.
+	Update(kit *kit.Kit, schema *table.ConfigSchema) error
+	// Delete one config schema instance.
+	Delete(kit *kit.Kit, schema *table.ConfigSchema) error
+	// List config schemas with options.
+	List(kit *kit.Kit, bizID, appID uint32, resourceType string, opt *types.BasePage) (
+		[]*table.ConfigSchema, int64, error)
+	// ListAll list all the config schemas of the app's resource type.
+	ListAll(kit *kit.Kit, bizID, appID uint32, resourceType table.ConfigSchemaResource) ([]*table.ConfigSchema, error)
+	// GetByUniqueKey get config schema by unique key.
+	GetByUniqueKey(kit *kit.Kit, bizID, appID uint32, resourceType table.ConfigSchemaResource, configKey string) (
+		*table.ConfigSchema, error)
+}
+
+var _ ConfigSchema = new(configSchemaDao)
+
+type configSchemaDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one config schema instance.
+func (dao *configSchemaDao) Create(kit *kit.Kit, g *table.ConfigSchema) (uint32, error) {
+	if g == nil {
+		return 0, errors.New("config schema is nil")
+	}
+
+	if err := g.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ConfigSchemaTable)
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareCreate(g)
+
+	// 多个使用事务处理
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ConfigSchema.WithContext(kit.Ctx).Create(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return g.ID, nil
+}
+
+// Update one config schema instance.
+func (dao *configSchemaDao) Update(kit *kit.Kit, g *table.ConfigSchema) error {
+	if g == nil {
+		return errors.New("config schema is nil")
+	}
+
+	if err := g.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	// 更新操作, 获取当前记录做审计
+	m := dao.genQ.ConfigSchema
+	q := dao.genQ.ConfigSchema.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+
+	// the resource type and key can not be changed, the schema is validated with them
+	g.Spec.ResourceType = oldOne.Spec.ResourceType
+	g.Spec.ConfigKey = oldOne.Spec.ConfigKey
+	if err = g.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareUpdate(g, oldOne)
+
+	// 多个使用事务处理
+	updateTx := func(tx *gen.Query) error {
+		q = tx.ConfigSchema.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID), m.ID.Eq(g.ID)).
+			Select(m.SchemaType, m.Schema, m.Memo, m.Reviser).
+			Updates(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one config schema instance.
+func (dao *configSchemaDao) Delete(kit *kit.Kit, g *table.ConfigSchema) error {
+	if g == nil {
+		return errors.New("config schema is nil")
+	}
+
+	if err := g.ValidateDelete(); err != nil {
+		return err
+	}
+
+	// 删除操作, 获取当前记录做审计
+	m := dao.genQ.ConfigSchema
+	q := dao.genQ.ConfigSchema.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareDelete(oldOne)
+
+	// 多个使用事务处理
+	deleteTx := func(tx *gen.Query) error {
+		q = tx.ConfigSchema.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Delete(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// List config schemas with options.
+func (dao *configSchemaDao) List(kit *kit.Kit, bizID, appID uint32, resourceType string, opt *types.BasePage) (
+	[]*table.ConfigSchema, int64, error) {
+
+	m := dao.genQ.ConfigSchema
+	q := dao.genQ.ConfigSchema.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+
+	if len(resourceType) != 0 {
+		q = q.Where(m.ResourceType.Eq(resourceType))
+	}
+
+	d := q.Order(m.ConfigKey)
+	if opt.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), err
+	}
+	return d.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListAll list all the config schemas of the app's resource type.
+func (dao *configSchemaDao) ListAll(kit *kit.Kit, bizID, appID uint32, resourceType table.ConfigSchemaResource) (
+	[]*table.ConfigSchema, error) {
+	m := dao.genQ.ConfigSchema
+
+	return dao.genQ.ConfigSchema.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ResourceType.Eq(string(resourceType))).Find()
+}
+
+// GetByUniqueKey get config schema by unique key.
+func (dao *configSchemaDao) GetByUniqueKey(kit *kit.Kit, bizID, appID uint32, resourceType table.ConfigSchemaResource,
+	configKey string) (*table.ConfigSchema, error) {
+	m := dao.genQ.ConfigSchema
+
+	return dao.genQ.ConfigSchema.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ResourceType.Eq(string(resourceType)),
+			m.ConfigKey.Eq(configKey)).Take()
+}
@@ -70,6 +70,13 @@ type Set interface {
 	Client() Client
 	ClientEvent() ClientEvent
 	ClientQuery() ClientQuery
+	PublishApproval() PublishApproval
+	RolloutPlan() RolloutPlan
+	ConfigSchema() ConfigSchema
+	Webhook() Webhook
+	ReleaseNotice() ReleaseNotice
+	WebhookDelivery() WebhookDelivery
+	GitSource() GitSource
 }
 
 // NewDaoSet create the DAO set instance.
@@ -211,6 +218,7 @@ func (s *set) Release() Release {
 		sd:       s.sd,
 		idGen:    s.idGen,
 		auditDao: s.auditDao,
+		notice:   s.ReleaseNotice(),
 	}
 }
 
@@ -386,6 +394,7 @@ func (s *set) Publish() Publish {
 		auditDao: s.auditDao,
 		genQ:     s.genQ,
 		event:    s.event,
+		notice:   s.ReleaseNotice(),
 	}
 }
 
@@ -489,3 +498,66 @@ func (s *set) ClientQuery() ClientQuery {
 		genQ:     s.genQ,
 	}
 }
+
+// PublishApproval returns the PublishApproval scope's DAO
+func (s *set) PublishApproval() PublishApproval {
+	return &publishApprovalDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// RolloutPlan returns the RolloutPlan scope's DAO
+func (s *set) RolloutPlan() RolloutPlan {
+	return &rolloutPlanDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+		group:    s.Group(),
+		groupApp: s.GroupAppBind(),
+		publish:  s.Publish(),
+	}
+}
+
+// ConfigSchema returns the ConfigSchema scope's DAO
+func (s *set) ConfigSchema() ConfigSchema {
+	return &configSchemaDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Webhook returns the Webhook scope's DAO
+func (s *set) Webhook() Webhook {
+	return &webhookDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ReleaseNotice returns the ReleaseNotice scope's DAO
+func (s *set) ReleaseNotice() ReleaseNotice {
+	return &releaseNoticeDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// WebhookDelivery returns the WebhookDelivery scope's DAO
+func (s *set) WebhookDelivery() WebhookDelivery {
+	return &webhookDeliveryDao{
+		genQ: s.genQ,
+	}
+}
+
+// GitSource returns the GitSource scope's DAO
+func (s *set) GitSource() GitSource {
+	return &gitSourceDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+)
+
+// GitSource supplies all the git source related operations.
+type GitSource interface {
+	// Create one git source instance.
+	Create(kit *kit.Kit, gs *table.GitSource) (uint32, error)
+	// Update one git source's spec.
+	Update(kit *kit.Kit, gs *table.GitSource) error
+	// UpdateStatus update the git source's synchronization status.
+	UpdateStatus(kit *kit.Kit, gs *table.GitSource) error
+	// StartSync mark the git source as syncing, returns false if it is being synchronized since staleBefore.
+	StartSync(kit *kit.Kit, gs *table.GitSource, staleBefore time.Time) (bool, error)
+	// Delete one git source instance.
+	Delete(kit *kit.Kit, gs *table.GitSource) error
+	// GetByAppID get the git source of the app.
+	GetByAppID(kit *kit.Kit, bizID, appID uint32) (*table.GitSource, error)
+	// ListEnabled list all the enabled git sources.
+	ListEnabled(kit *kit.Kit) ([]*table.GitSource, error)
+}
+
+var _ GitSource = new(gitSourceDao)
+
+type gitSourceDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one git source instance.
+func (dao *gitSourceDao) Create(kit *kit.Kit, g *table.GitSource) (uint32, error) {
+	if g == nil {
+		return 0, errors.New("git source is nil")
+	}
+
+	if err := g.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.GitSourceTable)
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+	if g.Status == nil {
+		g.Status = new(table.GitSourceStatus)
+	}
+
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareCreate(g)
+
+	// 多个使用事务处理
+	createTx := func(tx *gen.Query) error {
+		if err := tx.GitSource.WithContext(kit.Ctx).Create(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return g.ID, nil
+}
+
+// Update one git source's spec.
+func (dao *gitSourceDao) Update(kit *kit.Kit, g *table.GitSource) error {
+	if g == nil {
+		return errors.New("git source is nil")
+	}
+
+	if err := g.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	// 更新操作, 获取当前记录做审计
+	m := dao.genQ.GitSource
+	q := dao.genQ.GitSource.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareUpdate(g, oldOne)
+
+	// 多个使用事务处理
+	updateTx := func(tx *gen.Query) error {
+		q = tx.GitSource.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID), m.ID.Eq(g.ID)).
+			Select(m.URL, m.Ref, m.Path, m.Username, m.Token, m.AutoRelease, m.IntervalSec, m.Enabled, m.Memo,
+				m.Reviser).
+			Updates(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// UpdateStatus update the git source's synchronization status.
+func (dao *gitSourceDao) UpdateStatus(kit *kit.Kit, g *table.GitSource) error {
+	if g == nil || g.Status == nil {
+		return errors.New("git source status is nil")
+	}
+
+	m := dao.genQ.GitSource
+	_, err := dao.genQ.GitSource.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID), m.ID.Eq(g.ID)).
+		Select(m.LastCommit, m.LastReleaseID, m.SyncState, m.SyncMessage, m.SyncedAt, m.Fingerprint).
+		Updates(g)
+	return err
+}
+
+// StartSync mark the git source as syncing, it works as the lock of the git source's synchronization among the
+// instances, and is released by UpdateStatus with the synchronization result. it returns false if the git source
+// is being synchronized and the synchronization starts after staleBefore, the stale one is taken over in case the
+// instance exits during the synchronization.
+func (dao *gitSourceDao) StartSync(kit *kit.Kit, g *table.GitSource, staleBefore time.Time) (bool, error) {
+	if g == nil {
+		return false, errors.New("git source is nil")
+	}
+
+	m := dao.genQ.GitSource
+	q := dao.genQ.GitSource.WithContext(kit.Ctx)
+	result, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID), m.ID.Eq(g.ID)).
+		Where(q.Where(m.SyncState.Neq(string(table.GitSyncing))).Or(m.SyncedAt.Lt(staleBefore))).
+		UpdateSimple(m.SyncState.Value(string(table.GitSyncing)), m.SyncedAt.Value(time.Now().UTC()))
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Delete one git source instance.
+func (dao *gitSourceDao) Delete(kit *kit.Kit, g *table.GitSource) error {
+	if g == nil {
+		return errors.New("git source is nil")
+	}
+
+	if err := g.ValidateDelete(); err != nil {
+		return err
+	}
+
+	// 删除操作, 获取当前记录做审计
+	m := dao.genQ.GitSource
+	q := dao.genQ.GitSource.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareDelete(oldOne)
+
+	// 多个使用事务处理
+	deleteTx := func(tx *gen.Query) error {
+		q = tx.GitSource.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Delete(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// GetByAppID get the git source of the app.
+func (dao *gitSourceDao) GetByAppID(kit *kit.Kit, bizID, appID uint32) (*table.GitSource, error) {
+	m := dao.genQ.GitSource
+
+	return dao.genQ.GitSource.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+}
+
+// ListEnabled list all the enabled git sources.
+func (dao *gitSourceDao) ListEnabled(kit *kit.Kit) ([]*table.GitSource, error) {
+	m := dao.genQ.GitSource
+
+	return dao.genQ.GitSource.WithContext(kit.Ctx).Where(m.Enabled.Is(true)).Order(m.ID).Find()
+}
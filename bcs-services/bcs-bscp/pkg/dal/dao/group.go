@@ -32,6 +32,8 @@ type Group interface {
 	UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, group *table.Group) error
 	// Get group by id.
 	Get(kit *kit.Kit, id, bizID uint32) (*table.Group, error)
+	// ListByIDsWithTx list groups by ids with transaction.
+	ListByIDsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32, ids []uint32) ([]*table.Group, error)
 	// GetByName get group by name.
 	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Group, error)
 	// ListAll list all the groups in biz.
@@ -120,7 +122,7 @@ func (dao *groupDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.Group)
 
 	_, err = m.WithContext(kit.Ctx).
 		Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID)).
-		Select(m.Name, m.Public, m.Selector, m.UID, m.Reviser).
+		Select(m.Name, m.Public, m.Selector, m.UID, m.Variables, m.Reviser).
 		Updates(g)
 	if err != nil {
 		return err
@@ -146,6 +148,13 @@ func (dao *groupDao) Get(kit *kit.Kit, id, bizID uint32) (*table.Group, error) {
 	return m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID)).Take()
 }
 
+// ListByIDsWithTx list groups by ids with transaction.
+func (dao *groupDao) ListByIDsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32, ids []uint32) (
+	[]*table.Group, error) {
+	m := tx.Group
+	return m.WithContext(kit.Ctx).Where(m.ID.In(ids...), m.BizID.Eq(bizID)).Find()
+}
+
 // GetByName get group by name.
 func (dao *groupDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Group, error) {
 
@@ -45,6 +45,7 @@ type pubDao struct {
 	idGen    IDGenInterface
 	auditDao AuditDao
 	event    Event
+	notice   ReleaseNotice
 }
 
 // Publish publish an app's release with its strategy.
@@ -241,6 +242,28 @@ func (dao *pubDao) publish(kit *kit.Kit, tx *gen.QueryTx, opt *types.PublishOpti
 		return 0, errors.New("fire event failed, " + err.Error())
 	}
 
+	// record the release notice with txn, it is dispatched to the message queue and webhooks later.
+	notice := &table.ReleaseNotice{
+		Spec: &table.ReleaseNoticeSpec{
+			Event:     table.ReleasePublished,
+			ReleaseID: opt.ReleaseID,
+			All:       opt.All || opt.Default,
+			Groups:    opt.Groups,
+			Memo:      opt.Memo,
+			State:     table.NoticePending,
+		},
+		Attachment: &table.ReleaseNoticeAttachment{BizID: opt.BizID, AppID: opt.AppID},
+		Revision:   &table.CreatedRevision{Creator: kit.User},
+	}
+	if opt.RollbackFrom > 0 {
+		notice.Spec.Event = table.ReleaseRolledBack
+		notice.Spec.FromReleaseID = opt.RollbackFrom
+	}
+	if _, err := dao.notice.CreateWithTx(kit, tx, notice); err != nil {
+		logs.Errorf("create release notice failed, err: %v, rid: %s", err, kit.Rid)
+		return 0, err
+	}
+
 	return stgID, nil
 }
 
@@ -346,6 +369,11 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 			Edited:     false,
 			BizID:      opt.BizID,
 			Reviser:    kit.User,
+			// always set the rendered contents, so that the contents rendered in last publish are cleared.
+			RenderedContents: table.GroupRenderedContents{},
+		}
+		if contents, ok := opt.GroupRenderedContents[group.ID]; ok {
+			rg.RenderedContents = contents
 		}
 
 		m := tx.ReleasedGroup
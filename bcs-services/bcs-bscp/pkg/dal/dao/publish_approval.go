@@ -0,0 +1,306 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// PublishApproval supplies all the publish approval related operations.
+type PublishApproval interface {
+	// CreateWithTx create one publish approval instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, pa *table.PublishApproval) (uint32, error)
+	// Get publish approval by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.PublishApproval, error)
+	// GetPending get the pending publish approval of the app.
+	GetPending(kit *kit.Kit, bizID, appID uint32) (*table.PublishApproval, error)
+	// List publish approvals with options.
+	List(kit *kit.Kit, bizID, appID uint32, status string, opt *types.BasePage) (
+		[]*table.PublishApproval, int64, error)
+	// ListExpired list the pending publish approvals which are expired before the given time.
+	ListExpired(kit *kit.Kit, expireAt time.Time, limit int) ([]*table.PublishApproval, error)
+	// UpdateStatusWithTx update the status of a pending publish approval with transaction.
+	UpdateStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, pa *table.PublishApproval) error
+	// DiffWithTx compare the release to be published with the releases currently
+	// published to the target groups.
+	DiffWithTx(kit *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) (table.PublishDiff, error)
+}
+
+var _ PublishApproval = new(publishApprovalDao)
+
+type publishApprovalDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// CreateWithTx create one publish approval instance with transaction.
+func (dao *publishApprovalDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, pa *table.PublishApproval) (
+	uint32, error) {
+	if pa == nil {
+		return 0, errors.New("publish approval is nil")
+	}
+
+	if err := pa.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.PublishApprovalTable)
+	if err != nil {
+		return 0, err
+	}
+	pa.ID = id
+
+	ad := dao.auditDao.DecoratorV2(kit, pa.Attachment.BizID).PrepareCreate(pa)
+	if err = tx.PublishApproval.WithContext(kit.Ctx).Create(pa); err != nil {
+		return 0, err
+	}
+
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get publish approval by id.
+func (dao *publishApprovalDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.PublishApproval, error) {
+	m := dao.genQ.PublishApproval
+
+	return dao.genQ.PublishApproval.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetPending get the pending publish approval of the app.
+func (dao *publishApprovalDao) GetPending(kit *kit.Kit, bizID, appID uint32) (*table.PublishApproval, error) {
+	m := dao.genQ.PublishApproval
+
+	return dao.genQ.PublishApproval.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Status.Eq(table.ApprovalPending.String())).
+		Order(m.ID.Desc()).Take()
+}
+
+// List publish approvals with options.
+func (dao *publishApprovalDao) List(kit *kit.Kit, bizID, appID uint32, status string, opt *types.BasePage) (
+	[]*table.PublishApproval, int64, error) {
+
+	m := dao.genQ.PublishApproval
+	q := dao.genQ.PublishApproval.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+
+	if len(status) != 0 {
+		q = q.Where(m.Status.Eq(status))
+	}
+
+	d := q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), err
+	}
+	return d.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListExpired list the pending publish approvals which are expired before the given time.
+func (dao *publishApprovalDao) ListExpired(kit *kit.Kit, expireAt time.Time, limit int) (
+	[]*table.PublishApproval, error) {
+	m := dao.genQ.PublishApproval
+
+	return dao.genQ.PublishApproval.WithContext(kit.Ctx).
+		Where(m.Status.Eq(table.ApprovalPending.String()), m.ExpireAt.Lt(expireAt)).
+		Order(m.ID).Limit(limit).Find()
+}
+
+// UpdateStatusWithTx update the status of a pending publish approval with transaction.
+// only the pending publish approval can be updated, so that one publish approval
+// can not be reviewed twice by concurrent requests.
+func (dao *publishApprovalDao) UpdateStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, pa *table.PublishApproval) error {
+	if pa == nil || pa.Spec == nil || pa.Attachment == nil || pa.Revision == nil {
+		return errors.New("publish approval is nil")
+	}
+
+	if err := pa.Spec.Status.Validate(); err != nil {
+		return err
+	}
+
+	if len(pa.Revision.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	m := tx.PublishApproval
+	q := tx.PublishApproval.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.BizID.Eq(pa.Attachment.BizID), m.AppID.Eq(pa.Attachment.AppID), m.ID.Eq(pa.ID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, pa.Attachment.BizID).PrepareUpdate(pa, oldOne)
+
+	result, err := q.Where(m.BizID.Eq(pa.Attachment.BizID), m.ID.Eq(pa.ID),
+		m.Status.Eq(table.ApprovalPending.String())).
+		Select(m.Status, m.StrategyID, m.Reason, m.Reviser, m.UpdatedAt).Updates(pa)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("publish approval %d is not pending", pa.ID)
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// DiffWithTx compare the release to be published with the releases currently
+// published to the target groups, the target groups without published release
+// are compared with the default group's release.
+func (dao *publishApprovalDao) DiffWithTx(kit *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) (
+	table.PublishDiff, error) {
+	if opt == nil {
+		return nil, errors.New("publish option is nil")
+	}
+
+	m := tx.ReleasedGroup
+	rgs, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	groupReleases := make(map[uint32]uint32, len(rgs))
+	for _, rg := range rgs {
+		groupReleases[rg.GroupID] = rg.ReleaseID
+	}
+
+	// groupID 0 means the default group
+	targets := opt.Groups
+	if opt.All {
+		targets = []uint32{0}
+		for _, rg := range rgs {
+			if rg.GroupID != 0 {
+				targets = append(targets, rg.GroupID)
+			}
+		}
+	} else if opt.Default {
+		targets = append([]uint32{0}, targets...)
+	}
+
+	bases := make(map[uint32][]uint32)
+	for _, groupID := range targets {
+		base, exists := groupReleases[groupID]
+		if !exists {
+			base = groupReleases[0]
+		}
+		if base == opt.ReleaseID {
+			continue
+		}
+		if !tools.Contains(bases[base], groupID) {
+			bases[base] = append(bases[base], groupID)
+		}
+	}
+
+	target, err := dao.releasedFingerprints(kit, tx, opt.BizID, opt.AppID, opt.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := make(table.PublishDiff, 0, len(bases))
+	for base, groupIDs := range bases {
+		current := make(map[string]string)
+		if base != 0 {
+			if current, err = dao.releasedFingerprints(kit, tx, opt.BizID, opt.AppID, base); err != nil {
+				return nil, err
+			}
+		}
+
+		one := &table.ReleaseDiff{
+			BaseReleaseID: base,
+			GroupIDs:      groupIDs,
+			Added:         make([]string, 0),
+			Modified:      make([]string, 0),
+			Deleted:       make([]string, 0),
+		}
+		for key, fp := range target {
+			old, exists := current[key]
+			switch {
+			case !exists:
+				one.Added = append(one.Added, key)
+			case old != fp:
+				one.Modified = append(one.Modified, key)
+			}
+		}
+		for key := range current {
+			if _, exists := target[key]; !exists {
+				one.Deleted = append(one.Deleted, key)
+			}
+		}
+		sort.Strings(one.Added)
+		sort.Strings(one.Modified)
+		sort.Strings(one.Deleted)
+		diff = append(diff, one)
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].BaseReleaseID < diff[j].BaseReleaseID
+	})
+
+	return diff, nil
+}
+
+// releasedFingerprints returns the released config items, template config items and kvs of the release,
+// the key is the file's absolute path or kv's key, the value is the fingerprint of content and permission.
+func (dao *publishApprovalDao) releasedFingerprints(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) (
+	map[string]string, error) {
+	fingerprints := make(map[string]string)
+
+	rci := tx.ReleasedConfigItem
+	cis, err := rci.WithContext(kit.Ctx).
+		Where(rci.BizID.Eq(bizID), rci.AppID.Eq(appID), rci.ReleaseID.Eq(releaseID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, ci := range cis {
+		fingerprints[path.Join(ci.ConfigItemSpec.Path, ci.ConfigItemSpec.Name)] = fmt.Sprintf("%s/%s/%s/%s",
+			ci.CommitSpec.Content.Signature, ci.ConfigItemSpec.Permission.User,
+			ci.ConfigItemSpec.Permission.UserGroup, ci.ConfigItemSpec.Permission.Privilege)
+	}
+
+	rat := tx.ReleasedAppTemplate
+	tmpls, err := rat.WithContext(kit.Ctx).
+		Where(rat.BizID.Eq(bizID), rat.AppID.Eq(appID), rat.ReleaseID.Eq(releaseID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, tmpl := range tmpls {
+		fingerprints[path.Join(tmpl.Spec.Path, tmpl.Spec.Name)] = fmt.Sprintf("%s/%s/%s/%s",
+			tmpl.Spec.Signature, tmpl.Spec.User, tmpl.Spec.UserGroup, tmpl.Spec.Privilege)
+	}
+
+	rkv := tx.ReleasedKv
+	kvs, err := rkv.WithContext(kit.Ctx).
+		Where(rkv.BizID.Eq(bizID), rkv.AppID.Eq(appID), rkv.ReleaseID.Eq(releaseID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range kvs {
+		fingerprints[kv.Spec.Key] = fmt.Sprintf("%s/%s", kv.ContentSpec.Signature, kv.Spec.KvType)
+	}
+
+	return fingerprints, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/sharding"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/logs"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
 )
 
@@ -44,6 +45,8 @@ type Release interface {
 	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error
 	// GetReleaseLately get release lately info
 	GetReleaseLately(kit *kit.Kit, bizID uint32, appID uint32) (*table.Release, error)
+	// GetPrevious get the release created just before the release of the app.
+	GetPrevious(kit *kit.Kit, bizID, appID, releaseID uint32) (*table.Release, error)
 }
 
 var _ Release = new(releaseDao)
@@ -53,6 +56,7 @@ type releaseDao struct {
 	sd       *sharding.Sharding
 	idGen    IDGenInterface
 	auditDao AuditDao
+	notice   ReleaseNotice
 }
 
 // GetReleaseLately get release lately info
@@ -61,6 +65,13 @@ func (dao *releaseDao) GetReleaseLately(kit *kit.Kit, bizID uint32, appID uint32
 	return m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.BizID.Eq(bizID)).Order(m.ID.Desc()).Take()
 }
 
+// GetPrevious get the release created just before the release of the app.
+func (dao *releaseDao) GetPrevious(kit *kit.Kit, bizID, appID, releaseID uint32) (*table.Release, error) {
+	m := dao.genQ.Release
+	return m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.BizID.Eq(bizID), m.ID.Lt(releaseID)).
+		Order(m.ID.Desc()).Take()
+}
+
 // CreateWithTx create one release instance with tx.
 func (dao *releaseDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.Release) (uint32, error) {
 	if g == nil {
@@ -178,11 +189,37 @@ func (dao *releaseDao) validateAttachmentResExist(kit *kit.Kit, am *table.Releas
 }
 
 func (dao *releaseDao) UpdateDeprecated(kit *kit.Kit, bizID, appID, releaseID uint32, deprecated bool) error {
-	m := dao.genQ.Release
-	_, err := m.WithContext(kit.Ctx).
+	tx := dao.genQ.Begin()
+	m := tx.Release
+	if _, err := m.WithContext(kit.Ctx).
 		Where(m.ID.Eq(releaseID), m.AppID.Eq(appID), m.BizID.Eq(bizID)).
-		Update(m.Deprecated, deprecated)
-	return err
+		Update(m.Deprecated, deprecated); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kit.Rid)
+		}
+		return err
+	}
+
+	// record the release notice with txn when the release is deprecated.
+	if deprecated {
+		notice := &table.ReleaseNotice{
+			Spec: &table.ReleaseNoticeSpec{
+				Event:     table.ReleaseDeprecated,
+				ReleaseID: releaseID,
+				State:     table.NoticePending,
+			},
+			Attachment: &table.ReleaseNoticeAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.CreatedRevision{Creator: kit.User},
+		}
+		if _, err := dao.notice.CreateWithTx(kit, tx, notice); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kit.Rid)
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 // DeleteWithTx delete release with tx.
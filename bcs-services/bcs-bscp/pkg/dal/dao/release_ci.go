@@ -44,6 +44,9 @@ type ReleasedCI interface {
 	ListAllByAppIDs(kit *kit.Kit, appIDs []uint32, bizID uint32) ([]*table.ReleasedConfigItem, error)
 	// ListAllByReleaseIDs batch list released config items by releaseIDs.
 	ListAllByReleaseIDs(kit *kit.Kit, releasedIDs []uint32, bizID uint32) ([]*table.ReleasedConfigItem, error)
+	// ListAllByReleaseIDWithTx list all released config items of the release with transaction.
+	ListAllByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) (
+		[]*table.ReleasedConfigItem, error)
 	// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
 	BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error
 }
@@ -179,6 +182,14 @@ func (dao *releasedCIDao) ListAllByReleaseIDs(kit *kit.Kit,
 	return m.WithContext(kit.Ctx).Where(m.ReleaseID.In(releaseIDs...), m.BizID.Eq(bizID)).Find()
 }
 
+// ListAllByReleaseIDWithTx list all released config items of the release with transaction.
+func (dao *releasedCIDao) ListAllByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) (
+	[]*table.ReleasedConfigItem, error) {
+	m := tx.ReleasedConfigItem
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID)).Find()
+}
+
 // GetReleasedLately get released config items lately.
 func (dao *releasedCIDao) GetReleasedLately(kit *kit.Kit, bizID, appId uint32) ([]*table.ReleasedConfigItem, error) {
 	if bizID == 0 {
@@ -0,0 +1,106 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+)
+
+// ReleaseNotice supplies all the release notice related operations.
+type ReleaseNotice interface {
+	// CreateWithTx create one release notice instance with the transaction of the release operation.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, notice *table.ReleaseNotice) (uint32, error)
+	// ListPending list the pending release notices in the order of creation.
+	ListPending(kit *kit.Kit, limit int) ([]*table.ReleaseNotice, error)
+	// UpdateState update the release notice's dispatch state and attempts.
+	UpdateState(kit *kit.Kit, id uint32, state table.NoticeState, attempts uint32) error
+	// DispatchWithTx mark the release notice dispatched and create its webhook deliveries with transaction.
+	DispatchWithTx(kit *kit.Kit, tx *gen.QueryTx, notice *table.ReleaseNotice,
+		deliveries []*table.WebhookDelivery) error
+}
+
+var _ ReleaseNotice = new(releaseNoticeDao)
+
+type releaseNoticeDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// CreateWithTx create one release notice instance with the transaction of the release operation.
+func (dao *releaseNoticeDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.ReleaseNotice) (uint32,
+	error) {
+	if g == nil {
+		return 0, errors.New("release notice is nil")
+	}
+
+	if err := g.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ReleaseNoticeTable)
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+
+	if err = tx.ReleaseNotice.WithContext(kit.Ctx).Create(g); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListPending list the pending release notices in the order of creation.
+func (dao *releaseNoticeDao) ListPending(kit *kit.Kit, limit int) ([]*table.ReleaseNotice, error) {
+	m := dao.genQ.ReleaseNotice
+
+	return dao.genQ.ReleaseNotice.WithContext(kit.Ctx).
+		Where(m.State.Eq(table.NoticePending.String())).Order(m.ID).Limit(limit).Find()
+}
+
+// UpdateState update the release notice's dispatch state and attempts.
+func (dao *releaseNoticeDao) UpdateState(kit *kit.Kit, id uint32, state table.NoticeState, attempts uint32) error {
+	m := dao.genQ.ReleaseNotice
+
+	_, err := dao.genQ.ReleaseNotice.WithContext(kit.Ctx).Where(m.ID.Eq(id)).
+		UpdateSimple(m.State.Value(state.String()), m.Attempts.Value(attempts))
+	return err
+}
+
+// DispatchWithTx mark the release notice dispatched and create its webhook deliveries with transaction.
+func (dao *releaseNoticeDao) DispatchWithTx(kit *kit.Kit, tx *gen.QueryTx, notice *table.ReleaseNotice,
+	deliveries []*table.WebhookDelivery) error {
+
+	if len(deliveries) != 0 {
+		ids, err := dao.idGen.Batch(kit, table.WebhookDeliveryTable, len(deliveries))
+		if err != nil {
+			return err
+		}
+		for i, one := range deliveries {
+			one.ID = ids[i]
+		}
+
+		if err = tx.WebhookDelivery.WithContext(kit.Ctx).CreateInBatches(deliveries, 200); err != nil {
+			return err
+		}
+	}
+
+	m := tx.ReleaseNotice
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(notice.ID), m.State.Eq(table.NoticePending.String())).
+		UpdateSimple(m.State.Value(table.NoticeDispatched.String()), m.Attempts.Value(notice.Spec.Attempts))
+	return err
+}
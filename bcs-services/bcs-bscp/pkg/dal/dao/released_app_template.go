@@ -37,6 +37,9 @@ type ReleasedAppTemplate interface {
 		[]*table.ReleasedAppTemplate, int64, error)
 	// GetReleasedLately get released templates lately
 	GetReleasedLately(kit *kit.Kit, bizID, appID uint32) ([]*table.ReleasedAppTemplate, error)
+	// ListAllByReleaseIDWithTx list all released app templates of the release with transaction.
+	ListAllByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) (
+		[]*table.ReleasedAppTemplate, error)
 	// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 	BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error
 	// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
@@ -150,6 +153,14 @@ func (dao *releasedAppTemplateDao) GetReleasedLately(kit *kit.Kit, bizID, appId
 	return query.Where(q.Columns(m.ReleaseID).Eq(subQuery)).Find()
 }
 
+// ListAllByReleaseIDWithTx list all released app templates of the release with transaction.
+func (dao *releasedAppTemplateDao) ListAllByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	bizID, appID, releaseID uint32) ([]*table.ReleasedAppTemplate, error) {
+	m := tx.ReleasedAppTemplate
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID)).Find()
+}
+
 // BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 func (dao *releasedAppTemplateDao) BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error {
 
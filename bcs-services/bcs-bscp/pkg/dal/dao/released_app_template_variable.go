@@ -27,6 +27,9 @@ type ReleasedAppTemplateVariable interface {
 	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, variable *table.ReleasedAppTemplateVariable) (uint32, error)
 	// ListVariables lists all variables in released app template variable
 	ListVariables(kit *kit.Kit, bizID, appID, releaseID uint32) ([]*table.TemplateVariableSpec, error)
+	// ListVariablesWithTx lists all variables in released app template variable with transaction.
+	ListVariablesWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) (
+		[]*table.TemplateVariableSpec, error)
 	// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 	BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error
 	// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
@@ -92,6 +95,22 @@ func (dao *releasedAppTemplateVariableDao) ListVariables(kit *kit.Kit, bizID, ap
 	return vars, nil
 }
 
+// ListVariablesWithTx lists all variables in released app template variable with transaction.
+func (dao *releasedAppTemplateVariableDao) ListVariablesWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	bizID, appID, releaseID uint32) ([]*table.TemplateVariableSpec, error) {
+	m := tx.ReleasedAppTemplateVariable
+	appVars, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(appVars) == 0 {
+		return []*table.TemplateVariableSpec{}, nil
+	}
+
+	return appVars[0].Spec.Variables, nil
+}
+
 // BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 func (dao *releasedAppTemplateVariableDao) BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx,
 	appID, bizID uint32) error {
@@ -0,0 +1,285 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/selector"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// RolloutPlan supplies all the rollout plan related operations.
+type RolloutPlan interface {
+	// CreateWithTx create one rollout plan instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (uint32, error)
+	// Get rollout plan by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.RolloutPlan, error)
+	// GetActive get the running or paused rollout plan of the app.
+	GetActive(kit *kit.Kit, bizID, appID uint32) (*table.RolloutPlan, error)
+	// List rollout plans with options.
+	List(kit *kit.Kit, bizID, appID uint32, status string, opt *types.BasePage) (
+		[]*table.RolloutPlan, int64, error)
+	// ListRunning list the running rollout plans of all the apps.
+	ListRunning(kit *kit.Kit, limit int) ([]*table.RolloutPlan, error)
+	// UpdateWithTx update the progress of a rollout plan with transaction.
+	UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan, from table.RolloutStatus) error
+	// PublishStageWithTx publish the plan's release to the instances of the current stage.
+	PublishStageWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (uint32, error)
+	// RollbackWithTx publish the previous release to the instances which are rolled out.
+	RollbackWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (uint32, error)
+}
+
+var _ RolloutPlan = new(rolloutPlanDao)
+
+type rolloutPlanDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+	group    Group
+	groupApp GroupAppBind
+	publish  Publish
+}
+
+// CreateWithTx create one rollout plan instance with transaction.
+func (dao *rolloutPlanDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (uint32, error) {
+	if plan == nil {
+		return 0, errors.New("rollout plan is nil")
+	}
+
+	if err := plan.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.RolloutPlanTable)
+	if err != nil {
+		return 0, err
+	}
+	plan.ID = id
+
+	ad := dao.auditDao.DecoratorV2(kit, plan.Attachment.BizID).PrepareCreate(plan)
+	if err = tx.RolloutPlan.WithContext(kit.Ctx).Create(plan); err != nil {
+		return 0, err
+	}
+
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get rollout plan by id.
+func (dao *rolloutPlanDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.RolloutPlan, error) {
+	m := dao.genQ.RolloutPlan
+
+	return dao.genQ.RolloutPlan.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetActive get the running or paused rollout plan of the app.
+func (dao *rolloutPlanDao) GetActive(kit *kit.Kit, bizID, appID uint32) (*table.RolloutPlan, error) {
+	m := dao.genQ.RolloutPlan
+
+	return dao.genQ.RolloutPlan.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID),
+			m.Status.In(table.RolloutRunning.String(), table.RolloutPaused.String())).
+		Order(m.ID.Desc()).Take()
+}
+
+// List rollout plans with options.
+func (dao *rolloutPlanDao) List(kit *kit.Kit, bizID, appID uint32, status string, opt *types.BasePage) (
+	[]*table.RolloutPlan, int64, error) {
+
+	m := dao.genQ.RolloutPlan
+	q := dao.genQ.RolloutPlan.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+
+	if len(status) != 0 {
+		q = q.Where(m.Status.Eq(status))
+	}
+
+	d := q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), err
+	}
+	return d.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListRunning list the running rollout plans of all the apps.
+func (dao *rolloutPlanDao) ListRunning(kit *kit.Kit, limit int) ([]*table.RolloutPlan, error) {
+	m := dao.genQ.RolloutPlan
+
+	return dao.genQ.RolloutPlan.WithContext(kit.Ctx).
+		Where(m.Status.Eq(table.RolloutRunning.String())).
+		Order(m.ID).Limit(limit).Find()
+}
+
+// UpdateWithTx update the progress of a rollout plan with transaction.
+// only the rollout plan which is still in the from status can be updated, so that
+// the plan can not be changed by the crontab and the operator at the same time.
+func (dao *rolloutPlanDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan,
+	from table.RolloutStatus) error {
+	if plan == nil || plan.Spec == nil || plan.Attachment == nil || plan.Revision == nil {
+		return errors.New("rollout plan is nil")
+	}
+
+	if err := plan.Spec.Status.Validate(); err != nil {
+		return err
+	}
+
+	if len(plan.Revision.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	m := tx.RolloutPlan
+	q := tx.RolloutPlan.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.BizID.Eq(plan.Attachment.BizID), m.AppID.Eq(plan.Attachment.AppID),
+		m.ID.Eq(plan.ID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, plan.Attachment.BizID).PrepareUpdate(plan, oldOne)
+
+	result, err := q.Where(m.BizID.Eq(plan.Attachment.BizID), m.ID.Eq(plan.ID), m.Status.Eq(from.String())).
+		Select(m.GroupID, m.CurrentStage, m.Status, m.StageStartedAt, m.Reason, m.Reviser, m.UpdatedAt).
+		Updates(plan)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("rollout plan %d is not %s", plan.ID, from)
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// PublishStageWithTx publish the plan's release to the instances of the current stage.
+// the instances are selected by the hash of their uid, the group of the plan is created
+// at the first stage and its selector is enlarged at the following stages. the last stage
+// publishes the release to all the instances.
+func (dao *rolloutPlanDao) PublishStageWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (
+	uint32, error) {
+	percent := plan.StagePercent()
+	opt := &types.PublishOption{
+		BizID:     plan.Attachment.BizID,
+		AppID:     plan.Attachment.AppID,
+		ReleaseID: plan.Spec.ReleaseID,
+		Memo:      fmt.Sprintf("rollout plan %d stage %d, %d%% instances", plan.ID, plan.Spec.CurrentStage+1, percent),
+		Revision: &table.CreatedRevision{
+			Creator: kit.User,
+		},
+	}
+
+	if percent == 100 {
+		opt.All = true
+		return dao.publish.PublishWithTx(kit, tx, opt)
+	}
+
+	if err := dao.upsertRolloutGroup(kit, tx, plan, percent); err != nil {
+		return 0, err
+	}
+	opt.Groups = []uint32{plan.Spec.GroupID}
+
+	return dao.publish.PublishWithTx(kit, tx, opt)
+}
+
+// RollbackWithTx publish the previous release to the instances which are rolled out.
+func (dao *rolloutPlanDao) RollbackWithTx(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan) (
+	uint32, error) {
+	opt := &types.PublishOption{
+		BizID:     plan.Attachment.BizID,
+		AppID:     plan.Attachment.AppID,
+		ReleaseID: plan.Spec.PreviousReleaseID,
+		Memo:      fmt.Sprintf("rollout plan %d rolled back", plan.ID),
+		Revision: &table.CreatedRevision{
+			Creator: kit.User,
+		},
+		RollbackFrom: plan.Spec.ReleaseID,
+	}
+
+	if plan.StagePercent() == 100 || plan.Spec.GroupID == 0 {
+		opt.All = true
+	} else {
+		opt.Groups = []uint32{plan.Spec.GroupID}
+	}
+
+	return dao.publish.PublishWithTx(kit, tx, opt)
+}
+
+// upsertRolloutGroup create the rollout group of the plan or update its selector to the percentage.
+func (dao *rolloutPlanDao) upsertRolloutGroup(kit *kit.Kit, tx *gen.QueryTx, plan *table.RolloutPlan,
+	percent uint32) error {
+	sel := &selector.Selector{
+		LabelsAnd: selector.Label{
+			{Key: selector.UIDLabelKey, Op: &selector.PercentOperator, Value: percent},
+		},
+	}
+	name := fmt.Sprintf("rollout_%d", plan.ID)
+
+	if plan.Spec.GroupID != 0 {
+		return dao.group.UpdateWithTx(kit, tx, &table.Group{
+			ID: plan.Spec.GroupID,
+			Spec: &table.GroupSpec{
+				Name:     name,
+				Public:   false,
+				Selector: sel,
+			},
+			Attachment: &table.GroupAttachment{
+				BizID: plan.Attachment.BizID,
+			},
+			Revision: &table.Revision{
+				Reviser: kit.User,
+			},
+		})
+	}
+
+	groupID, err := dao.group.CreateWithTx(kit, tx, &table.Group{
+		Spec: &table.GroupSpec{
+			Name:     name,
+			Public:   false,
+			Mode:     table.Custom,
+			Selector: sel,
+		},
+		Attachment: &table.GroupAttachment{
+			BizID: plan.Attachment.BizID,
+		},
+		Revision: &table.Revision{
+			Creator: kit.User,
+			Reviser: kit.User,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := dao.groupApp.BatchCreateWithTx(kit, tx, []*table.GroupAppBind{
+		{
+			GroupID: groupID,
+			AppID:   plan.Attachment.AppID,
+			BizID:   plan.Attachment.BizID,
+		},
+	}); err != nil {
+		return err
+	}
+	plan.Spec.GroupID = groupID
+
+	return nil
+}
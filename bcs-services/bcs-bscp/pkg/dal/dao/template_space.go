@@ -16,6 +16,7 @@ import (
 	"fmt"
 
 	rawgen "gorm.io/gen"
+	"gorm.io/gen/field"
 
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
@@ -138,7 +139,12 @@ func (dao *templateSpaceDao) Update(kit *kit.Kit, g *table.TemplateSpace) error
 	// 多个使用事务处理
 	updateTx := func(tx *gen.Query) error {
 		q = tx.TemplateSpace.WithContext(kit.Ctx)
-		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).Select(m.Memo, m.Reviser).Updates(g); err != nil {
+		fields := []field.Expr{m.Memo, m.Reviser}
+		// template engine is only updated when it is specified
+		if g.Spec.TemplateEngine != "" {
+			fields = append(fields, m.TemplateEngine)
+		}
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.ID.Eq(g.ID)).Select(fields...).Updates(g); err != nil {
 			return err
 		}
 
@@ -252,8 +258,9 @@ func (dao *templateSpaceDao) CreateDefault(kit *kit.Kit, bizID uint32) (uint32,
 	g := &table.TemplateSpace{
 		ID: 0,
 		Spec: &table.TemplateSpaceSpec{
-			Name: constant.DefaultTmplSpaceCNName,
-			Memo: constant.DefaultTmplSpaceMemo,
+			Name:           constant.DefaultTmplSpaceCNName,
+			Memo:           constant.DefaultTmplSpaceMemo,
+			TemplateEngine: table.SimpleTemplateEngine,
 		},
 		Attachment: &table.TemplateSpaceAttachment{
 			BizID: kit.BizID,
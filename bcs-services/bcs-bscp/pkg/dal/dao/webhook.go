@@ -0,0 +1,195 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// Webhook supplies all the webhook related operations.
+type Webhook interface {
+	// Create one webhook instance.
+	Create(kit *kit.Kit, webhook *table.Webhook) (uint32, error)
+	// Update one webhook's info.
+	Update(kit *kit.Kit, webhook *table.Webhook) error
+	// Delete one webhook instance.
+	Delete(kit *kit.Kit, webhook *table.Webhook) error
+	// Get webhook by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.Webhook, error)
+	// GetByName get webhook by name.
+	GetByName(kit *kit.Kit, bizID, appID uint32, name string) (*table.Webhook, error)
+	// List webhooks with options.
+	List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.Webhook, int64, error)
+	// ListAll list all the webhooks of the app.
+	ListAll(kit *kit.Kit, bizID, appID uint32) ([]*table.Webhook, error)
+}
+
+var _ Webhook = new(webhookDao)
+
+type webhookDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one webhook instance.
+func (dao *webhookDao) Create(kit *kit.Kit, g *table.Webhook) (uint32, error) {
+	if g == nil {
+		return 0, errors.New("webhook is nil")
+	}
+
+	if err := g.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.WebhookTable)
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareCreate(g)
+
+	// 多个使用事务处理
+	createTx := func(tx *gen.Query) error {
+		if err := tx.Webhook.WithContext(kit.Ctx).Create(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return g.ID, nil
+}
+
+// Update one webhook instance.
+func (dao *webhookDao) Update(kit *kit.Kit, g *table.Webhook) error {
+	if g == nil {
+		return errors.New("webhook is nil")
+	}
+
+	if err := g.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	// 更新操作, 获取当前记录做审计
+	m := dao.genQ.Webhook
+	q := dao.genQ.Webhook.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareUpdate(g, oldOne)
+
+	// 多个使用事务处理
+	updateTx := func(tx *gen.Query) error {
+		q = tx.Webhook.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID), m.ID.Eq(g.ID)).
+			Select(m.Name, m.URL, m.Secret, m.Events, m.Enabled, m.Memo, m.Reviser).
+			Updates(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one webhook instance.
+func (dao *webhookDao) Delete(kit *kit.Kit, g *table.Webhook) error {
+	if g == nil {
+		return errors.New("webhook is nil")
+	}
+
+	if err := g.ValidateDelete(); err != nil {
+		return err
+	}
+
+	// 删除操作, 获取当前记录做审计
+	m := dao.genQ.Webhook
+	q := dao.genQ.Webhook.WithContext(kit.Ctx)
+	oldOne, err := q.Where(m.ID.Eq(g.ID), m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Take()
+	if err != nil {
+		return err
+	}
+	ad := dao.auditDao.DecoratorV2(kit, g.Attachment.BizID).PrepareDelete(oldOne)
+
+	// 多个使用事务处理
+	deleteTx := func(tx *gen.Query) error {
+		q = tx.Webhook.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(g.Attachment.BizID), m.AppID.Eq(g.Attachment.AppID)).Delete(g); err != nil {
+			return err
+		}
+
+		if err := ad.Do(tx); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get webhook by id.
+func (dao *webhookDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.Webhook, error) {
+	m := dao.genQ.Webhook
+
+	return dao.genQ.Webhook.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get webhook by name.
+func (dao *webhookDao) GetByName(kit *kit.Kit, bizID, appID uint32, name string) (*table.Webhook, error) {
+	m := dao.genQ.Webhook
+
+	return dao.genQ.Webhook.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Name.Eq(name)).Take()
+}
+
+// List webhooks with options.
+func (dao *webhookDao) List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.Webhook, int64,
+	error) {
+	m := dao.genQ.Webhook
+
+	d := dao.genQ.Webhook.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Order(m.ID.Desc())
+	if opt.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), err
+	}
+	return d.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListAll list all the webhooks of the app.
+func (dao *webhookDao) ListAll(kit *kit.Kit, bizID, appID uint32) ([]*table.Webhook, error) {
+	m := dao.genQ.Webhook
+
+	return dao.genQ.Webhook.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Find()
+}
@@ -0,0 +1,80 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/gen"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/types"
+)
+
+// WebhookDelivery supplies all the webhook delivery related operations.
+type WebhookDelivery interface {
+	// ListDue list the pending webhook deliveries which reach the next attempt time.
+	ListDue(kit *kit.Kit, limit int) ([]*table.WebhookDelivery, error)
+	// UpdateResult update the webhook delivery's status, attempts, next attempt time and last error.
+	UpdateResult(kit *kit.Kit, delivery *table.WebhookDelivery) error
+	// List webhook deliveries of the webhook with options.
+	List(kit *kit.Kit, bizID, appID, webhookID uint32, status string, opt *types.BasePage) (
+		[]*table.WebhookDelivery, int64, error)
+}
+
+var _ WebhookDelivery = new(webhookDeliveryDao)
+
+type webhookDeliveryDao struct {
+	genQ *gen.Query
+}
+
+// ListDue list the pending webhook deliveries which reach the next attempt time.
+func (dao *webhookDeliveryDao) ListDue(kit *kit.Kit, limit int) ([]*table.WebhookDelivery, error) {
+	m := dao.genQ.WebhookDelivery
+
+	return dao.genQ.WebhookDelivery.WithContext(kit.Ctx).
+		Where(m.Status.Eq(table.DeliveryPending.String()), m.NextAttemptAt.Lte(time.Now())).
+		Order(m.NextAttemptAt).Limit(limit).Find()
+}
+
+// UpdateResult update the webhook delivery's status, attempts, next attempt time and last error.
+func (dao *webhookDeliveryDao) UpdateResult(kit *kit.Kit, g *table.WebhookDelivery) error {
+	m := dao.genQ.WebhookDelivery
+
+	_, err := dao.genQ.WebhookDelivery.WithContext(kit.Ctx).Where(m.ID.Eq(g.ID)).
+		Select(m.Status, m.Attempts, m.NextAttemptAt, m.LastError, m.Reviser).Updates(g)
+	return err
+}
+
+// List webhook deliveries of the webhook with options.
+func (dao *webhookDeliveryDao) List(kit *kit.Kit, bizID, appID, webhookID uint32, status string,
+	opt *types.BasePage) ([]*table.WebhookDelivery, int64, error) {
+
+	m := dao.genQ.WebhookDelivery
+	q := dao.genQ.WebhookDelivery.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.WebhookID.Eq(webhookID))
+
+	if len(status) != 0 {
+		q = q.Where(m.Status.Eq(status))
+	}
+
+	d := q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), err
+	}
+	return d.FindByPage(opt.Offset(), opt.LimitInt())
+}
@@ -34,6 +34,8 @@ func newApp(db *gorm.DB, opts ...gen.DOOption) app {
 	_app.Memo = field.NewString(tableName, "memo")
 	_app.Alias_ = field.NewString(tableName, "alias")
 	_app.DataType = field.NewString(tableName, "data_type")
+	_app.IsApprove = field.NewBool(tableName, "is_approve")
+	_app.Approver = field.NewString(tableName, "approver")
 	_app.Creator = field.NewString(tableName, "creator")
 	_app.Reviser = field.NewString(tableName, "reviser")
 	_app.CreatedAt = field.NewTime(tableName, "created_at")
@@ -55,6 +57,8 @@ type app struct {
 	Memo       field.String
 	Alias_     field.String
 	DataType   field.String
+	IsApprove  field.Bool
+	Approver   field.String
 	Creator    field.String
 	Reviser    field.String
 	CreatedAt  field.Time
@@ -82,6 +86,8 @@ func (a *app) updateTableName(table string) *app {
 	a.Memo = field.NewString(table, "memo")
 	a.Alias_ = field.NewString(table, "alias")
 	a.DataType = field.NewString(table, "data_type")
+	a.IsApprove = field.NewBool(table, "is_approve")
+	a.Approver = field.NewString(table, "approver")
 	a.Creator = field.NewString(table, "creator")
 	a.Reviser = field.NewString(table, "reviser")
 	a.CreatedAt = field.NewTime(table, "created_at")
@@ -110,7 +116,7 @@ func (a *app) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (a *app) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 11)
+	a.fieldMap = make(map[string]field.Expr, 13)
 	a.fieldMap["id"] = a.ID
 	a.fieldMap["biz_id"] = a.BizID
 	a.fieldMap["name"] = a.Name
@@ -118,6 +124,8 @@ func (a *app) fillFieldMap() {
 	a.fieldMap["memo"] = a.Memo
 	a.fieldMap["alias"] = a.Alias_
 	a.fieldMap["data_type"] = a.DataType
+	a.fieldMap["is_approve"] = a.IsApprove
+	a.fieldMap["approver"] = a.Approver
 	a.fieldMap["creator"] = a.Creator
 	a.fieldMap["reviser"] = a.Reviser
 	a.fieldMap["created_at"] = a.CreatedAt
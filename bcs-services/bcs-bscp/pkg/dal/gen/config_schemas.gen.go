@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func newConfigSchema(db *gorm.DB, opts ...gen.DOOption) configSchema {
+	_configSchema := configSchema{}
+
+	_configSchema.configSchemaDo.UseDB(db, opts...)
+	_configSchema.configSchemaDo.UseModel(&table.ConfigSchema{})
+
+	tableName := _configSchema.configSchemaDo.TableName()
+	_configSchema.ALL = field.NewAsterisk(tableName)
+	_configSchema.ID = field.NewUint32(tableName, "id")
+	_configSchema.ResourceType = field.NewString(tableName, "resource_type")
+	_configSchema.ConfigKey = field.NewString(tableName, "config_key")
+	_configSchema.SchemaType = field.NewString(tableName, "schema_type")
+	_configSchema.Schema = field.NewString(tableName, "schema")
+	_configSchema.Memo = field.NewString(tableName, "memo")
+	_configSchema.BizID = field.NewUint32(tableName, "biz_id")
+	_configSchema.AppID = field.NewUint32(tableName, "app_id")
+	_configSchema.Creator = field.NewString(tableName, "creator")
+	_configSchema.Reviser = field.NewString(tableName, "reviser")
+	_configSchema.CreatedAt = field.NewTime(tableName, "created_at")
+	_configSchema.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_configSchema.fillFieldMap()
+
+	return _configSchema
+}
+
+type configSchema struct {
+	configSchemaDo configSchemaDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	ResourceType field.String
+	ConfigKey    field.String
+	SchemaType   field.String
+	Schema       field.String
+	Memo         field.String
+	BizID        field.Uint32
+	AppID        field.Uint32
+	Creator      field.String
+	Reviser      field.String
+	CreatedAt    field.Time
+	UpdatedAt    field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c configSchema) Table(newTableName string) *configSchema {
+	c.configSchemaDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c configSchema) As(alias string) *configSchema {
+	c.configSchemaDo.DO = *(c.configSchemaDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *configSchema) updateTableName(table string) *configSchema {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.ResourceType = field.NewString(table, "resource_type")
+	c.ConfigKey = field.NewString(table, "config_key")
+	c.SchemaType = field.NewString(table, "schema_type")
+	c.Schema = field.NewString(table, "schema")
+	c.Memo = field.NewString(table, "memo")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *configSchema) WithContext(ctx context.Context) IConfigSchemaDo {
+	return c.configSchemaDo.WithContext(ctx)
+}
+
+func (c configSchema) TableName() string { return c.configSchemaDo.TableName() }
+
+func (c configSchema) Alias() string { return c.configSchemaDo.Alias() }
+
+func (c configSchema) Columns(cols ...field.Expr) gen.Columns {
+	return c.configSchemaDo.Columns(cols...)
+}
+
+func (c *configSchema) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *configSchema) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 12)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["resource_type"] = c.ResourceType
+	c.fieldMap["config_key"] = c.ConfigKey
+	c.fieldMap["schema_type"] = c.SchemaType
+	c.fieldMap["schema"] = c.Schema
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c configSchema) clone(db *gorm.DB) configSchema {
+	c.configSchemaDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c configSchema) replaceDB(db *gorm.DB) configSchema {
+	c.configSchemaDo.ReplaceDB(db)
+	return c
+}
+
+type configSchemaDo struct{ gen.DO }
+
+type IConfigSchemaDo interface {
+	gen.SubQuery
+	Debug() IConfigSchemaDo
+	WithContext(ctx context.Context) IConfigSchemaDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IConfigSchemaDo
+	WriteDB() IConfigSchemaDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IConfigSchemaDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IConfigSchemaDo
+	Not(conds ...gen.Condition) IConfigSchemaDo
+	Or(conds ...gen.Condition) IConfigSchemaDo
+	Select(conds ...field.Expr) IConfigSchemaDo
+	Where(conds ...gen.Condition) IConfigSchemaDo
+	Order(conds ...field.Expr) IConfigSchemaDo
+	Distinct(cols ...field.Expr) IConfigSchemaDo
+	Omit(cols ...field.Expr) IConfigSchemaDo
+	Join(table schema.Tabler, on ...field.Expr) IConfigSchemaDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IConfigSchemaDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IConfigSchemaDo
+	Group(cols ...field.Expr) IConfigSchemaDo
+	Having(conds ...gen.Condition) IConfigSchemaDo
+	Limit(limit int) IConfigSchemaDo
+	Offset(offset int) IConfigSchemaDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigSchemaDo
+	Unscoped() IConfigSchemaDo
+	Create(values ...*table.ConfigSchema) error
+	CreateInBatches(values []*table.ConfigSchema, batchSize int) error
+	Save(values ...*table.ConfigSchema) error
+	First() (*table.ConfigSchema, error)
+	Take() (*table.ConfigSchema, error)
+	Last() (*table.ConfigSchema, error)
+	Find() ([]*table.ConfigSchema, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigSchema, err error)
+	FindInBatches(result *[]*table.ConfigSchema, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ConfigSchema) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IConfigSchemaDo
+	Assign(attrs ...field.AssignExpr) IConfigSchemaDo
+	Joins(fields ...field.RelationField) IConfigSchemaDo
+	Preload(fields ...field.RelationField) IConfigSchemaDo
+	FirstOrInit() (*table.ConfigSchema, error)
+	FirstOrCreate() (*table.ConfigSchema, error)
+	FindByPage(offset int, limit int) (result []*table.ConfigSchema, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IConfigSchemaDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c configSchemaDo) Debug() IConfigSchemaDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c configSchemaDo) WithContext(ctx context.Context) IConfigSchemaDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c configSchemaDo) ReadDB() IConfigSchemaDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c configSchemaDo) WriteDB() IConfigSchemaDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c configSchemaDo) Session(config *gorm.Session) IConfigSchemaDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c configSchemaDo) Clauses(conds ...clause.Expression) IConfigSchemaDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c configSchemaDo) Returning(value interface{}, columns ...string) IConfigSchemaDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c configSchemaDo) Not(conds ...gen.Condition) IConfigSchemaDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c configSchemaDo) Or(conds ...gen.Condition) IConfigSchemaDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c configSchemaDo) Select(conds ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c configSchemaDo) Where(conds ...gen.Condition) IConfigSchemaDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c configSchemaDo) Order(conds ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c configSchemaDo) Distinct(cols ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c configSchemaDo) Omit(cols ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c configSchemaDo) Join(table schema.Tabler, on ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c configSchemaDo) LeftJoin(table schema.Tabler, on ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c configSchemaDo) RightJoin(table schema.Tabler, on ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c configSchemaDo) Group(cols ...field.Expr) IConfigSchemaDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c configSchemaDo) Having(conds ...gen.Condition) IConfigSchemaDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c configSchemaDo) Limit(limit int) IConfigSchemaDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c configSchemaDo) Offset(offset int) IConfigSchemaDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c configSchemaDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigSchemaDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c configSchemaDo) Unscoped() IConfigSchemaDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c configSchemaDo) Create(values ...*table.ConfigSchema) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c configSchemaDo) CreateInBatches(values []*table.ConfigSchema, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c configSchemaDo) Save(values ...*table.ConfigSchema) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c configSchemaDo) First() (*table.ConfigSchema, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigSchema), nil
+	}
+}
+
+func (c configSchemaDo) Take() (*table.ConfigSchema, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigSchema), nil
+	}
+}
+
+func (c configSchemaDo) Last() (*table.ConfigSchema, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigSchema), nil
+	}
+}
+
+func (c configSchemaDo) Find() ([]*table.ConfigSchema, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ConfigSchema), err
+}
+
+func (c configSchemaDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigSchema, err error) {
+	buf := make([]*table.ConfigSchema, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c configSchemaDo) FindInBatches(result *[]*table.ConfigSchema, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c configSchemaDo) Attrs(attrs ...field.AssignExpr) IConfigSchemaDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c configSchemaDo) Assign(attrs ...field.AssignExpr) IConfigSchemaDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c configSchemaDo) Joins(fields ...field.RelationField) IConfigSchemaDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c configSchemaDo) Preload(fields ...field.RelationField) IConfigSchemaDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c configSchemaDo) FirstOrInit() (*table.ConfigSchema, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigSchema), nil
+	}
+}
+
+func (c configSchemaDo) FirstOrCreate() (*table.ConfigSchema, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigSchema), nil
+	}
+}
+
+func (c configSchemaDo) FindByPage(offset int, limit int) (result []*table.ConfigSchema, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c configSchemaDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c configSchemaDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c configSchemaDo) Delete(models ...*table.ConfigSchema) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *configSchemaDo) withDO(do gen.Dao) *configSchemaDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -27,17 +27,21 @@ var (
 	ClientQuery                 *clientQuery
 	Commit                      *commit
 	ConfigItem                  *configItem
+	ConfigSchema                *configSchema
 	Content                     *content
 	Credential                  *credential
 	CredentialScope             *credentialScope
 	Event                       *event
+	GitSource                   *gitSource
 	Group                       *group
 	GroupAppBind                *groupAppBind
 	Hook                        *hook
 	HookRevision                *hookRevision
 	IDGenerator                 *iDGenerator
 	Kv                          *kv
+	PublishApproval             *publishApproval
 	Release                     *release
+	ReleaseNotice               *releaseNotice
 	ReleasedAppTemplate         *releasedAppTemplate
 	ReleasedAppTemplateVariable *releasedAppTemplateVariable
 	ReleasedConfigItem          *releasedConfigItem
@@ -45,12 +49,15 @@ var (
 	ReleasedHook                *releasedHook
 	ReleasedKv                  *releasedKv
 	ResourceLock                *resourceLock
+	RolloutPlan                 *rolloutPlan
 	Strategy                    *strategy
 	Template                    *template
 	TemplateRevision            *templateRevision
 	TemplateSet                 *templateSet
 	TemplateSpace               *templateSpace
 	TemplateVariable            *templateVariable
+	Webhook                     *webhook
+	WebhookDelivery             *webhookDelivery
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
@@ -65,17 +72,21 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ClientQuery = &Q.ClientQuery
 	Commit = &Q.Commit
 	ConfigItem = &Q.ConfigItem
+	ConfigSchema = &Q.ConfigSchema
 	Content = &Q.Content
 	Credential = &Q.Credential
 	CredentialScope = &Q.CredentialScope
 	Event = &Q.Event
+	GitSource = &Q.GitSource
 	Group = &Q.Group
 	GroupAppBind = &Q.GroupAppBind
 	Hook = &Q.Hook
 	HookRevision = &Q.HookRevision
 	IDGenerator = &Q.IDGenerator
 	Kv = &Q.Kv
+	PublishApproval = &Q.PublishApproval
 	Release = &Q.Release
+	ReleaseNotice = &Q.ReleaseNotice
 	ReleasedAppTemplate = &Q.ReleasedAppTemplate
 	ReleasedAppTemplateVariable = &Q.ReleasedAppTemplateVariable
 	ReleasedConfigItem = &Q.ReleasedConfigItem
@@ -83,12 +94,15 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ReleasedHook = &Q.ReleasedHook
 	ReleasedKv = &Q.ReleasedKv
 	ResourceLock = &Q.ResourceLock
+	RolloutPlan = &Q.RolloutPlan
 	Strategy = &Q.Strategy
 	Template = &Q.Template
 	TemplateRevision = &Q.TemplateRevision
 	TemplateSet = &Q.TemplateSet
 	TemplateSpace = &Q.TemplateSpace
 	TemplateVariable = &Q.TemplateVariable
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
@@ -104,17 +118,21 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ClientQuery:                 newClientQuery(db, opts...),
 		Commit:                      newCommit(db, opts...),
 		ConfigItem:                  newConfigItem(db, opts...),
+		ConfigSchema:                newConfigSchema(db, opts...),
 		Content:                     newContent(db, opts...),
 		Credential:                  newCredential(db, opts...),
 		CredentialScope:             newCredentialScope(db, opts...),
 		Event:                       newEvent(db, opts...),
+		GitSource:                   newGitSource(db, opts...),
 		Group:                       newGroup(db, opts...),
 		GroupAppBind:                newGroupAppBind(db, opts...),
 		Hook:                        newHook(db, opts...),
 		HookRevision:                newHookRevision(db, opts...),
 		IDGenerator:                 newIDGenerator(db, opts...),
 		Kv:                          newKv(db, opts...),
+		PublishApproval:             newPublishApproval(db, opts...),
 		Release:                     newRelease(db, opts...),
+		ReleaseNotice:               newReleaseNotice(db, opts...),
 		ReleasedAppTemplate:         newReleasedAppTemplate(db, opts...),
 		ReleasedAppTemplateVariable: newReleasedAppTemplateVariable(db, opts...),
 		ReleasedConfigItem:          newReleasedConfigItem(db, opts...),
@@ -122,12 +140,15 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ReleasedHook:                newReleasedHook(db, opts...),
 		ReleasedKv:                  newReleasedKv(db, opts...),
 		ResourceLock:                newResourceLock(db, opts...),
+		RolloutPlan:                 newRolloutPlan(db, opts...),
 		Strategy:                    newStrategy(db, opts...),
 		Template:                    newTemplate(db, opts...),
 		TemplateRevision:            newTemplateRevision(db, opts...),
 		TemplateSet:                 newTemplateSet(db, opts...),
 		TemplateSpace:               newTemplateSpace(db, opts...),
 		TemplateVariable:            newTemplateVariable(db, opts...),
+		Webhook:                     newWebhook(db, opts...),
+		WebhookDelivery:             newWebhookDelivery(db, opts...),
 	}
 }
 
@@ -144,17 +165,21 @@ type Query struct {
 	ClientQuery                 clientQuery
 	Commit                      commit
 	ConfigItem                  configItem
+	ConfigSchema                configSchema
 	Content                     content
 	Credential                  credential
 	CredentialScope             credentialScope
 	Event                       event
+	GitSource                   gitSource
 	Group                       group
 	GroupAppBind                groupAppBind
 	Hook                        hook
 	HookRevision                hookRevision
 	IDGenerator                 iDGenerator
 	Kv                          kv
+	PublishApproval             publishApproval
 	Release                     release
+	ReleaseNotice               releaseNotice
 	ReleasedAppTemplate         releasedAppTemplate
 	ReleasedAppTemplateVariable releasedAppTemplateVariable
 	ReleasedConfigItem          releasedConfigItem
@@ -162,12 +187,15 @@ type Query struct {
 	ReleasedHook                releasedHook
 	ReleasedKv                  releasedKv
 	ResourceLock                resourceLock
+	RolloutPlan                 rolloutPlan
 	Strategy                    strategy
 	Template                    template
 	TemplateRevision            templateRevision
 	TemplateSet                 templateSet
 	TemplateSpace               templateSpace
 	TemplateVariable            templateVariable
+	Webhook                     webhook
+	WebhookDelivery             webhookDelivery
 }
 
 func (q *Query) Available() bool { return q.db != nil }
@@ -185,17 +213,21 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ClientQuery:                 q.ClientQuery.clone(db),
 		Commit:                      q.Commit.clone(db),
 		ConfigItem:                  q.ConfigItem.clone(db),
+		ConfigSchema:                q.ConfigSchema.clone(db),
 		Content:                     q.Content.clone(db),
 		Credential:                  q.Credential.clone(db),
 		CredentialScope:             q.CredentialScope.clone(db),
 		Event:                       q.Event.clone(db),
+		GitSource:                   q.GitSource.clone(db),
 		Group:                       q.Group.clone(db),
 		GroupAppBind:                q.GroupAppBind.clone(db),
 		Hook:                        q.Hook.clone(db),
 		HookRevision:                q.HookRevision.clone(db),
 		IDGenerator:                 q.IDGenerator.clone(db),
 		Kv:                          q.Kv.clone(db),
+		PublishApproval:             q.PublishApproval.clone(db),
 		Release:                     q.Release.clone(db),
+		ReleaseNotice:               q.ReleaseNotice.clone(db),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.clone(db),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.clone(db),
 		ReleasedConfigItem:          q.ReleasedConfigItem.clone(db),
@@ -203,12 +235,15 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ReleasedHook:                q.ReleasedHook.clone(db),
 		ReleasedKv:                  q.ReleasedKv.clone(db),
 		ResourceLock:                q.ResourceLock.clone(db),
+		RolloutPlan:                 q.RolloutPlan.clone(db),
 		Strategy:                    q.Strategy.clone(db),
 		Template:                    q.Template.clone(db),
 		TemplateRevision:            q.TemplateRevision.clone(db),
 		TemplateSet:                 q.TemplateSet.clone(db),
 		TemplateSpace:               q.TemplateSpace.clone(db),
 		TemplateVariable:            q.TemplateVariable.clone(db),
+		Webhook:                     q.Webhook.clone(db),
+		WebhookDelivery:             q.WebhookDelivery.clone(db),
 	}
 }
 
@@ -233,17 +268,21 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ClientQuery:                 q.ClientQuery.replaceDB(db),
 		Commit:                      q.Commit.replaceDB(db),
 		ConfigItem:                  q.ConfigItem.replaceDB(db),
+		ConfigSchema:                q.ConfigSchema.replaceDB(db),
 		Content:                     q.Content.replaceDB(db),
 		Credential:                  q.Credential.replaceDB(db),
 		CredentialScope:             q.CredentialScope.replaceDB(db),
 		Event:                       q.Event.replaceDB(db),
+		GitSource:                   q.GitSource.replaceDB(db),
 		Group:                       q.Group.replaceDB(db),
 		GroupAppBind:                q.GroupAppBind.replaceDB(db),
 		Hook:                        q.Hook.replaceDB(db),
 		HookRevision:                q.HookRevision.replaceDB(db),
 		IDGenerator:                 q.IDGenerator.replaceDB(db),
 		Kv:                          q.Kv.replaceDB(db),
+		PublishApproval:             q.PublishApproval.replaceDB(db),
 		Release:                     q.Release.replaceDB(db),
+		ReleaseNotice:               q.ReleaseNotice.replaceDB(db),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.replaceDB(db),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.replaceDB(db),
 		ReleasedConfigItem:          q.ReleasedConfigItem.replaceDB(db),
@@ -251,12 +290,15 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ReleasedHook:                q.ReleasedHook.replaceDB(db),
 		ReleasedKv:                  q.ReleasedKv.replaceDB(db),
 		ResourceLock:                q.ResourceLock.replaceDB(db),
+		RolloutPlan:                 q.RolloutPlan.replaceDB(db),
 		Strategy:                    q.Strategy.replaceDB(db),
 		Template:                    q.Template.replaceDB(db),
 		TemplateRevision:            q.TemplateRevision.replaceDB(db),
 		TemplateSet:                 q.TemplateSet.replaceDB(db),
 		TemplateSpace:               q.TemplateSpace.replaceDB(db),
 		TemplateVariable:            q.TemplateVariable.replaceDB(db),
+		Webhook:                     q.Webhook.replaceDB(db),
+		WebhookDelivery:             q.WebhookDelivery.replaceDB(db),
 	}
 }
 
@@ -271,17 +313,21 @@ type queryCtx struct {
 	ClientQuery                 IClientQueryDo
 	Commit                      ICommitDo
 	ConfigItem                  IConfigItemDo
+	ConfigSchema                IConfigSchemaDo
 	Content                     IContentDo
 	Credential                  ICredentialDo
 	CredentialScope             ICredentialScopeDo
 	Event                       IEventDo
+	GitSource                   IGitSourceDo
 	Group                       IGroupDo
 	GroupAppBind                IGroupAppBindDo
 	Hook                        IHookDo
 	HookRevision                IHookRevisionDo
 	IDGenerator                 IIDGeneratorDo
 	Kv                          IKvDo
+	PublishApproval             IPublishApprovalDo
 	Release                     IReleaseDo
+	ReleaseNotice               IReleaseNoticeDo
 	ReleasedAppTemplate         IReleasedAppTemplateDo
 	ReleasedAppTemplateVariable IReleasedAppTemplateVariableDo
 	ReleasedConfigItem          IReleasedConfigItemDo
@@ -289,12 +335,15 @@ type queryCtx struct {
 	ReleasedHook                IReleasedHookDo
 	ReleasedKv                  IReleasedKvDo
 	ResourceLock                IResourceLockDo
+	RolloutPlan                 IRolloutPlanDo
 	Strategy                    IStrategyDo
 	Template                    ITemplateDo
 	TemplateRevision            ITemplateRevisionDo
 	TemplateSet                 ITemplateSetDo
 	TemplateSpace               ITemplateSpaceDo
 	TemplateVariable            ITemplateVariableDo
+	Webhook                     IWebhookDo
+	WebhookDelivery             IWebhookDeliveryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
@@ -309,17 +358,21 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ClientQuery:                 q.ClientQuery.WithContext(ctx),
 		Commit:                      q.Commit.WithContext(ctx),
 		ConfigItem:                  q.ConfigItem.WithContext(ctx),
+		ConfigSchema:                q.ConfigSchema.WithContext(ctx),
 		Content:                     q.Content.WithContext(ctx),
 		Credential:                  q.Credential.WithContext(ctx),
 		CredentialScope:             q.CredentialScope.WithContext(ctx),
 		Event:                       q.Event.WithContext(ctx),
+		GitSource:                   q.GitSource.WithContext(ctx),
 		Group:                       q.Group.WithContext(ctx),
 		GroupAppBind:                q.GroupAppBind.WithContext(ctx),
 		Hook:                        q.Hook.WithContext(ctx),
 		HookRevision:                q.HookRevision.WithContext(ctx),
 		IDGenerator:                 q.IDGenerator.WithContext(ctx),
 		Kv:                          q.Kv.WithContext(ctx),
+		PublishApproval:             q.PublishApproval.WithContext(ctx),
 		Release:                     q.Release.WithContext(ctx),
+		ReleaseNotice:               q.ReleaseNotice.WithContext(ctx),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.WithContext(ctx),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.WithContext(ctx),
 		ReleasedConfigItem:          q.ReleasedConfigItem.WithContext(ctx),
@@ -327,12 +380,15 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ReleasedHook:                q.ReleasedHook.WithContext(ctx),
 		ReleasedKv:                  q.ReleasedKv.WithContext(ctx),
 		ResourceLock:                q.ResourceLock.WithContext(ctx),
+		RolloutPlan:                 q.RolloutPlan.WithContext(ctx),
 		Strategy:                    q.Strategy.WithContext(ctx),
 		Template:                    q.Template.WithContext(ctx),
 		TemplateRevision:            q.TemplateRevision.WithContext(ctx),
 		TemplateSet:                 q.TemplateSet.WithContext(ctx),
 		TemplateSpace:               q.TemplateSpace.WithContext(ctx),
 		TemplateVariable:            q.TemplateVariable.WithContext(ctx),
+		Webhook:                     q.Webhook.WithContext(ctx),
+		WebhookDelivery:             q.WebhookDelivery.WithContext(ctx),
 	}
 }
 
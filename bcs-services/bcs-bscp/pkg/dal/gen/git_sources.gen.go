@@ -0,0 +1,474 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func newGitSource(db *gorm.DB, opts ...gen.DOOption) gitSource {
+	_gitSource := gitSource{}
+
+	_gitSource.gitSourceDo.UseDB(db, opts...)
+	_gitSource.gitSourceDo.UseModel(&table.GitSource{})
+
+	tableName := _gitSource.gitSourceDo.TableName()
+	_gitSource.ALL = field.NewAsterisk(tableName)
+	_gitSource.ID = field.NewUint32(tableName, "id")
+	_gitSource.URL = field.NewString(tableName, "url")
+	_gitSource.Ref = field.NewString(tableName, "ref")
+	_gitSource.Path = field.NewString(tableName, "path")
+	_gitSource.Username = field.NewString(tableName, "username")
+	_gitSource.Token = field.NewString(tableName, "token")
+	_gitSource.AutoRelease = field.NewBool(tableName, "auto_release")
+	_gitSource.IntervalSec = field.NewUint32(tableName, "interval_sec")
+	_gitSource.Enabled = field.NewBool(tableName, "enabled")
+	_gitSource.Memo = field.NewString(tableName, "memo")
+	_gitSource.LastCommit = field.NewString(tableName, "last_commit")
+	_gitSource.LastReleaseID = field.NewUint32(tableName, "last_release_id")
+	_gitSource.SyncState = field.NewString(tableName, "sync_state")
+	_gitSource.SyncMessage = field.NewString(tableName, "sync_message")
+	_gitSource.SyncedAt = field.NewTime(tableName, "synced_at")
+	_gitSource.Fingerprint = field.NewString(tableName, "fingerprint")
+	_gitSource.BizID = field.NewUint32(tableName, "biz_id")
+	_gitSource.AppID = field.NewUint32(tableName, "app_id")
+	_gitSource.Creator = field.NewString(tableName, "creator")
+	_gitSource.Reviser = field.NewString(tableName, "reviser")
+	_gitSource.CreatedAt = field.NewTime(tableName, "created_at")
+	_gitSource.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_gitSource.fillFieldMap()
+
+	return _gitSource
+}
+
+type gitSource struct {
+	gitSourceDo gitSourceDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	URL           field.String
+	Ref           field.String
+	Path          field.String
+	Username      field.String
+	Token         field.String
+	AutoRelease   field.Bool
+	IntervalSec   field.Uint32
+	Enabled       field.Bool
+	Memo          field.String
+	LastCommit    field.String
+	LastReleaseID field.Uint32
+	SyncState     field.String
+	SyncMessage   field.String
+	SyncedAt      field.Time
+	Fingerprint   field.String
+	BizID         field.Uint32
+	AppID         field.Uint32
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (g gitSource) Table(newTableName string) *gitSource {
+	g.gitSourceDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+func (g gitSource) As(alias string) *gitSource {
+	g.gitSourceDo.DO = *(g.gitSourceDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *gitSource) updateTableName(table string) *gitSource {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewUint32(table, "id")
+	g.URL = field.NewString(table, "url")
+	g.Ref = field.NewString(table, "ref")
+	g.Path = field.NewString(table, "path")
+	g.Username = field.NewString(table, "username")
+	g.Token = field.NewString(table, "token")
+	g.AutoRelease = field.NewBool(table, "auto_release")
+	g.IntervalSec = field.NewUint32(table, "interval_sec")
+	g.Enabled = field.NewBool(table, "enabled")
+	g.Memo = field.NewString(table, "memo")
+	g.LastCommit = field.NewString(table, "last_commit")
+	g.LastReleaseID = field.NewUint32(table, "last_release_id")
+	g.SyncState = field.NewString(table, "sync_state")
+	g.SyncMessage = field.NewString(table, "sync_message")
+	g.SyncedAt = field.NewTime(table, "synced_at")
+	g.Fingerprint = field.NewString(table, "fingerprint")
+	g.BizID = field.NewUint32(table, "biz_id")
+	g.AppID = field.NewUint32(table, "app_id")
+	g.Creator = field.NewString(table, "creator")
+	g.Reviser = field.NewString(table, "reviser")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+func (g *gitSource) WithContext(ctx context.Context) IGitSourceDo {
+	return g.gitSourceDo.WithContext(ctx)
+}
+
+func (g gitSource) TableName() string { return g.gitSourceDo.TableName() }
+
+func (g gitSource) Alias() string { return g.gitSourceDo.Alias() }
+
+func (g gitSource) Columns(cols ...field.Expr) gen.Columns { return g.gitSourceDo.Columns(cols...) }
+
+func (g *gitSource) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *gitSource) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 22)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["url"] = g.URL
+	g.fieldMap["ref"] = g.Ref
+	g.fieldMap["path"] = g.Path
+	g.fieldMap["username"] = g.Username
+	g.fieldMap["token"] = g.Token
+	g.fieldMap["auto_release"] = g.AutoRelease
+	g.fieldMap["interval_sec"] = g.IntervalSec
+	g.fieldMap["enabled"] = g.Enabled
+	g.fieldMap["memo"] = g.Memo
+	g.fieldMap["last_commit"] = g.LastCommit
+	g.fieldMap["last_release_id"] = g.LastReleaseID
+	g.fieldMap["sync_state"] = g.SyncState
+	g.fieldMap["sync_message"] = g.SyncMessage
+	g.fieldMap["synced_at"] = g.SyncedAt
+	g.fieldMap["fingerprint"] = g.Fingerprint
+	g.fieldMap["biz_id"] = g.BizID
+	g.fieldMap["app_id"] = g.AppID
+	g.fieldMap["creator"] = g.Creator
+	g.fieldMap["reviser"] = g.Reviser
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g gitSource) clone(db *gorm.DB) gitSource {
+	g.gitSourceDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g gitSource) replaceDB(db *gorm.DB) gitSource {
+	g.gitSourceDo.ReplaceDB(db)
+	return g
+}
+
+type gitSourceDo struct{ gen.DO }
+
+type IGitSourceDo interface {
+	gen.SubQuery
+	Debug() IGitSourceDo
+	WithContext(ctx context.Context) IGitSourceDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGitSourceDo
+	WriteDB() IGitSourceDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGitSourceDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGitSourceDo
+	Not(conds ...gen.Condition) IGitSourceDo
+	Or(conds ...gen.Condition) IGitSourceDo
+	Select(conds ...field.Expr) IGitSourceDo
+	Where(conds ...gen.Condition) IGitSourceDo
+	Order(conds ...field.Expr) IGitSourceDo
+	Distinct(cols ...field.Expr) IGitSourceDo
+	Omit(cols ...field.Expr) IGitSourceDo
+	Join(table schema.Tabler, on ...field.Expr) IGitSourceDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGitSourceDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGitSourceDo
+	Group(cols ...field.Expr) IGitSourceDo
+	Having(conds ...gen.Condition) IGitSourceDo
+	Limit(limit int) IGitSourceDo
+	Offset(offset int) IGitSourceDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGitSourceDo
+	Unscoped() IGitSourceDo
+	Create(values ...*table.GitSource) error
+	CreateInBatches(values []*table.GitSource, batchSize int) error
+	Save(values ...*table.GitSource) error
+	First() (*table.GitSource, error)
+	Take() (*table.GitSource, error)
+	Last() (*table.GitSource, error)
+	Find() ([]*table.GitSource, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GitSource, err error)
+	FindInBatches(result *[]*table.GitSource, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.GitSource) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGitSourceDo
+	Assign(attrs ...field.AssignExpr) IGitSourceDo
+	Joins(fields ...field.RelationField) IGitSourceDo
+	Preload(fields ...field.RelationField) IGitSourceDo
+	FirstOrInit() (*table.GitSource, error)
+	FirstOrCreate() (*table.GitSource, error)
+	FindByPage(offset int, limit int) (result []*table.GitSource, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGitSourceDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (g gitSourceDo) Debug() IGitSourceDo {
+	return g.withDO(g.DO.Debug())
+}
+
+func (g gitSourceDo) WithContext(ctx context.Context) IGitSourceDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+func (g gitSourceDo) ReadDB() IGitSourceDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+func (g gitSourceDo) WriteDB() IGitSourceDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+func (g gitSourceDo) Session(config *gorm.Session) IGitSourceDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+func (g gitSourceDo) Clauses(conds ...clause.Expression) IGitSourceDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+func (g gitSourceDo) Returning(value interface{}, columns ...string) IGitSourceDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+func (g gitSourceDo) Not(conds ...gen.Condition) IGitSourceDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+func (g gitSourceDo) Or(conds ...gen.Condition) IGitSourceDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+func (g gitSourceDo) Select(conds ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+func (g gitSourceDo) Where(conds ...gen.Condition) IGitSourceDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+func (g gitSourceDo) Order(conds ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+func (g gitSourceDo) Distinct(cols ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+func (g gitSourceDo) Omit(cols ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+func (g gitSourceDo) Join(table schema.Tabler, on ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+func (g gitSourceDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+func (g gitSourceDo) RightJoin(table schema.Tabler, on ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+func (g gitSourceDo) Group(cols ...field.Expr) IGitSourceDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+func (g gitSourceDo) Having(conds ...gen.Condition) IGitSourceDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+func (g gitSourceDo) Limit(limit int) IGitSourceDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+func (g gitSourceDo) Offset(offset int) IGitSourceDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+func (g gitSourceDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGitSourceDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+func (g gitSourceDo) Unscoped() IGitSourceDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+func (g gitSourceDo) Create(values ...*table.GitSource) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+func (g gitSourceDo) CreateInBatches(values []*table.GitSource, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g gitSourceDo) Save(values ...*table.GitSource) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+func (g gitSourceDo) First() (*table.GitSource, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitSource), nil
+	}
+}
+
+func (g gitSourceDo) Take() (*table.GitSource, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitSource), nil
+	}
+}
+
+func (g gitSourceDo) Last() (*table.GitSource, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitSource), nil
+	}
+}
+
+func (g gitSourceDo) Find() ([]*table.GitSource, error) {
+	result, err := g.DO.Find()
+	return result.([]*table.GitSource), err
+}
+
+func (g gitSourceDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.GitSource, err error) {
+	buf := make([]*table.GitSource, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (g gitSourceDo) FindInBatches(result *[]*table.GitSource, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (g gitSourceDo) Attrs(attrs ...field.AssignExpr) IGitSourceDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+func (g gitSourceDo) Assign(attrs ...field.AssignExpr) IGitSourceDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+func (g gitSourceDo) Joins(fields ...field.RelationField) IGitSourceDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+func (g gitSourceDo) Preload(fields ...field.RelationField) IGitSourceDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+func (g gitSourceDo) FirstOrInit() (*table.GitSource, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitSource), nil
+	}
+}
+
+func (g gitSourceDo) FirstOrCreate() (*table.GitSource, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.GitSource), nil
+	}
+}
+
+func (g gitSourceDo) FindByPage(offset int, limit int) (result []*table.GitSource, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (g gitSourceDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (g gitSourceDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+func (g gitSourceDo) Delete(models ...*table.GitSource) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *gitSourceDo) withDO(do gen.Dao) *gitSourceDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
@@ -33,6 +33,7 @@ func newGroup(db *gorm.DB, opts ...gen.DOOption) group {
 	_group.Mode = field.NewString(tableName, "mode")
 	_group.Selector = field.NewField(tableName, "selector")
 	_group.UID = field.NewString(tableName, "uid")
+	_group.Variables = field.NewField(tableName, "variables")
 	_group.BizID = field.NewUint32(tableName, "biz_id")
 	_group.Creator = field.NewString(tableName, "creator")
 	_group.Reviser = field.NewString(tableName, "reviser")
@@ -54,6 +55,7 @@ type group struct {
 	Mode      field.String
 	Selector  field.Field
 	UID       field.String
+	Variables field.Field
 	BizID     field.Uint32
 	Creator   field.String
 	Reviser   field.String
@@ -81,6 +83,7 @@ func (g *group) updateTableName(table string) *group {
 	g.Mode = field.NewString(table, "mode")
 	g.Selector = field.NewField(table, "selector")
 	g.UID = field.NewString(table, "uid")
+	g.Variables = field.NewField(table, "variables")
 	g.BizID = field.NewUint32(table, "biz_id")
 	g.Creator = field.NewString(table, "creator")
 	g.Reviser = field.NewString(table, "reviser")
@@ -110,13 +113,14 @@ func (g *group) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *group) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 11)
+	g.fieldMap = make(map[string]field.Expr, 12)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["public"] = g.Public
 	g.fieldMap["mode"] = g.Mode
 	g.fieldMap["selector"] = g.Selector
 	g.fieldMap["uid"] = g.UID
+	g.fieldMap["variables"] = g.Variables
 	g.fieldMap["biz_id"] = g.BizID
 	g.fieldMap["creator"] = g.Creator
 	g.fieldMap["reviser"] = g.Reviser
@@ -0,0 +1,460 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func newPublishApproval(db *gorm.DB, opts ...gen.DOOption) publishApproval {
+	_publishApproval := publishApproval{}
+
+	_publishApproval.publishApprovalDo.UseDB(db, opts...)
+	_publishApproval.publishApprovalDo.UseModel(&table.PublishApproval{})
+
+	tableName := _publishApproval.publishApprovalDo.TableName()
+	_publishApproval.ALL = field.NewAsterisk(tableName)
+	_publishApproval.ID = field.NewUint32(tableName, "id")
+	_publishApproval.ReleaseID = field.NewUint32(tableName, "release_id")
+	_publishApproval.All = field.NewBool(tableName, "publish_all")
+	_publishApproval.AsDefault = field.NewBool(tableName, "as_default")
+	_publishApproval.Memo = field.NewString(tableName, "memo")
+	_publishApproval.Groups = field.NewField(tableName, "group_ids")
+	_publishApproval.Approvers = field.NewField(tableName, "approvers")
+	_publishApproval.Diff = field.NewField(tableName, "diff")
+	_publishApproval.Status = field.NewString(tableName, "status")
+	_publishApproval.StrategyID = field.NewUint32(tableName, "strategy_id")
+	_publishApproval.ExpireAt = field.NewTime(tableName, "expire_at")
+	_publishApproval.Reason = field.NewString(tableName, "reason")
+	_publishApproval.BizID = field.NewUint32(tableName, "biz_id")
+	_publishApproval.AppID = field.NewUint32(tableName, "app_id")
+	_publishApproval.Creator = field.NewString(tableName, "creator")
+	_publishApproval.Reviser = field.NewString(tableName, "reviser")
+	_publishApproval.CreatedAt = field.NewTime(tableName, "created_at")
+	_publishApproval.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_publishApproval.fillFieldMap()
+
+	return _publishApproval
+}
+
+type publishApproval struct {
+	publishApprovalDo publishApprovalDo
+
+	ALL        field.Asterisk
+	ID         field.Uint32
+	ReleaseID  field.Uint32
+	All        field.Bool
+	AsDefault  field.Bool
+	Memo       field.String
+	Groups     field.Field
+	Approvers  field.Field
+	Diff       field.Field
+	Status     field.String
+	StrategyID field.Uint32
+	ExpireAt   field.Time
+	Reason     field.String
+	BizID      field.Uint32
+	AppID      field.Uint32
+	Creator    field.String
+	Reviser    field.String
+	CreatedAt  field.Time
+	UpdatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (p publishApproval) Table(newTableName string) *publishApproval {
+	p.publishApprovalDo.UseTable(newTableName)
+	return p.updateTableName(newTableName)
+}
+
+func (p publishApproval) As(alias string) *publishApproval {
+	p.publishApprovalDo.DO = *(p.publishApprovalDo.As(alias).(*gen.DO))
+	return p.updateTableName(alias)
+}
+
+func (p *publishApproval) updateTableName(table string) *publishApproval {
+	p.ALL = field.NewAsterisk(table)
+	p.ID = field.NewUint32(table, "id")
+	p.ReleaseID = field.NewUint32(table, "release_id")
+	p.All = field.NewBool(table, "publish_all")
+	p.AsDefault = field.NewBool(table, "as_default")
+	p.Memo = field.NewString(table, "memo")
+	p.Groups = field.NewField(table, "group_ids")
+	p.Approvers = field.NewField(table, "approvers")
+	p.Diff = field.NewField(table, "diff")
+	p.Status = field.NewString(table, "status")
+	p.StrategyID = field.NewUint32(table, "strategy_id")
+	p.ExpireAt = field.NewTime(table, "expire_at")
+	p.Reason = field.NewString(table, "reason")
+	p.BizID = field.NewUint32(table, "biz_id")
+	p.AppID = field.NewUint32(table, "app_id")
+	p.Creator = field.NewString(table, "creator")
+	p.Reviser = field.NewString(table, "reviser")
+	p.CreatedAt = field.NewTime(table, "created_at")
+	p.UpdatedAt = field.NewTime(table, "updated_at")
+
+	p.fillFieldMap()
+
+	return p
+}
+
+func (p *publishApproval) WithContext(ctx context.Context) IPublishApprovalDo {
+	return p.publishApprovalDo.WithContext(ctx)
+}
+
+func (p publishApproval) TableName() string { return p.publishApprovalDo.TableName() }
+
+func (p publishApproval) Alias() string { return p.publishApprovalDo.Alias() }
+
+func (p publishApproval) Columns(cols ...field.Expr) gen.Columns {
+	return p.publishApprovalDo.Columns(cols...)
+}
+
+func (p *publishApproval) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := p.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (p *publishApproval) fillFieldMap() {
+	p.fieldMap = make(map[string]field.Expr, 18)
+	p.fieldMap["id"] = p.ID
+	p.fieldMap["release_id"] = p.ReleaseID
+	p.fieldMap["publish_all"] = p.All
+	p.fieldMap["as_default"] = p.AsDefault
+	p.fieldMap["memo"] = p.Memo
+	p.fieldMap["group_ids"] = p.Groups
+	p.fieldMap["approvers"] = p.Approvers
+	p.fieldMap["diff"] = p.Diff
+	p.fieldMap["status"] = p.Status
+	p.fieldMap["strategy_id"] = p.StrategyID
+	p.fieldMap["expire_at"] = p.ExpireAt
+	p.fieldMap["reason"] = p.Reason
+	p.fieldMap["biz_id"] = p.BizID
+	p.fieldMap["app_id"] = p.AppID
+	p.fieldMap["creator"] = p.Creator
+	p.fieldMap["reviser"] = p.Reviser
+	p.fieldMap["created_at"] = p.CreatedAt
+	p.fieldMap["updated_at"] = p.UpdatedAt
+}
+
+func (p publishApproval) clone(db *gorm.DB) publishApproval {
+	p.publishApprovalDo.ReplaceConnPool(db.Statement.ConnPool)
+	return p
+}
+
+func (p publishApproval) replaceDB(db *gorm.DB) publishApproval {
+	p.publishApprovalDo.ReplaceDB(db)
+	return p
+}
+
+type publishApprovalDo struct{ gen.DO }
+
+type IPublishApprovalDo interface {
+	gen.SubQuery
+	Debug() IPublishApprovalDo
+	WithContext(ctx context.Context) IPublishApprovalDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IPublishApprovalDo
+	WriteDB() IPublishApprovalDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IPublishApprovalDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IPublishApprovalDo
+	Not(conds ...gen.Condition) IPublishApprovalDo
+	Or(conds ...gen.Condition) IPublishApprovalDo
+	Select(conds ...field.Expr) IPublishApprovalDo
+	Where(conds ...gen.Condition) IPublishApprovalDo
+	Order(conds ...field.Expr) IPublishApprovalDo
+	Distinct(cols ...field.Expr) IPublishApprovalDo
+	Omit(cols ...field.Expr) IPublishApprovalDo
+	Join(table schema.Tabler, on ...field.Expr) IPublishApprovalDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IPublishApprovalDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IPublishApprovalDo
+	Group(cols ...field.Expr) IPublishApprovalDo
+	Having(conds ...gen.Condition) IPublishApprovalDo
+	Limit(limit int) IPublishApprovalDo
+	Offset(offset int) IPublishApprovalDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IPublishApprovalDo
+	Unscoped() IPublishApprovalDo
+	Create(values ...*table.PublishApproval) error
+	CreateInBatches(values []*table.PublishApproval, batchSize int) error
+	Save(values ...*table.PublishApproval) error
+	First() (*table.PublishApproval, error)
+	Take() (*table.PublishApproval, error)
+	Last() (*table.PublishApproval, error)
+	Find() ([]*table.PublishApproval, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.PublishApproval, err error)
+	FindInBatches(result *[]*table.PublishApproval, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.PublishApproval) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IPublishApprovalDo
+	Assign(attrs ...field.AssignExpr) IPublishApprovalDo
+	Joins(fields ...field.RelationField) IPublishApprovalDo
+	Preload(fields ...field.RelationField) IPublishApprovalDo
+	FirstOrInit() (*table.PublishApproval, error)
+	FirstOrCreate() (*table.PublishApproval, error)
+	FindByPage(offset int, limit int) (result []*table.PublishApproval, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IPublishApprovalDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (p publishApprovalDo) Debug() IPublishApprovalDo {
+	return p.withDO(p.DO.Debug())
+}
+
+func (p publishApprovalDo) WithContext(ctx context.Context) IPublishApprovalDo {
+	return p.withDO(p.DO.WithContext(ctx))
+}
+
+func (p publishApprovalDo) ReadDB() IPublishApprovalDo {
+	return p.Clauses(dbresolver.Read)
+}
+
+func (p publishApprovalDo) WriteDB() IPublishApprovalDo {
+	return p.Clauses(dbresolver.Write)
+}
+
+func (p publishApprovalDo) Session(config *gorm.Session) IPublishApprovalDo {
+	return p.withDO(p.DO.Session(config))
+}
+
+func (p publishApprovalDo) Clauses(conds ...clause.Expression) IPublishApprovalDo {
+	return p.withDO(p.DO.Clauses(conds...))
+}
+
+func (p publishApprovalDo) Returning(value interface{}, columns ...string) IPublishApprovalDo {
+	return p.withDO(p.DO.Returning(value, columns...))
+}
+
+func (p publishApprovalDo) Not(conds ...gen.Condition) IPublishApprovalDo {
+	return p.withDO(p.DO.Not(conds...))
+}
+
+func (p publishApprovalDo) Or(conds ...gen.Condition) IPublishApprovalDo {
+	return p.withDO(p.DO.Or(conds...))
+}
+
+func (p publishApprovalDo) Select(conds ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Select(conds...))
+}
+
+func (p publishApprovalDo) Where(conds ...gen.Condition) IPublishApprovalDo {
+	return p.withDO(p.DO.Where(conds...))
+}
+
+func (p publishApprovalDo) Order(conds ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Order(conds...))
+}
+
+func (p publishApprovalDo) Distinct(cols ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Distinct(cols...))
+}
+
+func (p publishApprovalDo) Omit(cols ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Omit(cols...))
+}
+
+func (p publishApprovalDo) Join(table schema.Tabler, on ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Join(table, on...))
+}
+
+func (p publishApprovalDo) LeftJoin(table schema.Tabler, on ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.LeftJoin(table, on...))
+}
+
+func (p publishApprovalDo) RightJoin(table schema.Tabler, on ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.RightJoin(table, on...))
+}
+
+func (p publishApprovalDo) Group(cols ...field.Expr) IPublishApprovalDo {
+	return p.withDO(p.DO.Group(cols...))
+}
+
+func (p publishApprovalDo) Having(conds ...gen.Condition) IPublishApprovalDo {
+	return p.withDO(p.DO.Having(conds...))
+}
+
+func (p publishApprovalDo) Limit(limit int) IPublishApprovalDo {
+	return p.withDO(p.DO.Limit(limit))
+}
+
+func (p publishApprovalDo) Offset(offset int) IPublishApprovalDo {
+	return p.withDO(p.DO.Offset(offset))
+}
+
+func (p publishApprovalDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IPublishApprovalDo {
+	return p.withDO(p.DO.Scopes(funcs...))
+}
+
+func (p publishApprovalDo) Unscoped() IPublishApprovalDo {
+	return p.withDO(p.DO.Unscoped())
+}
+
+func (p publishApprovalDo) Create(values ...*table.PublishApproval) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Create(values)
+}
+
+func (p publishApprovalDo) CreateInBatches(values []*table.PublishApproval, batchSize int) error {
+	return p.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (p publishApprovalDo) Save(values ...*table.PublishApproval) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Save(values)
+}
+
+func (p publishApprovalDo) First() (*table.PublishApproval, error) {
+	if result, err := p.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.PublishApproval), nil
+	}
+}
+
+func (p publishApprovalDo) Take() (*table.PublishApproval, error) {
+	if result, err := p.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.PublishApproval), nil
+	}
+}
+
+func (p publishApprovalDo) Last() (*table.PublishApproval, error) {
+	if result, err := p.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.PublishApproval), nil
+	}
+}
+
+func (p publishApprovalDo) Find() ([]*table.PublishApproval, error) {
+	result, err := p.DO.Find()
+	return result.([]*table.PublishApproval), err
+}
+
+func (p publishApprovalDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.PublishApproval, err error) {
+	buf := make([]*table.PublishApproval, 0, batchSize)
+	err = p.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (p publishApprovalDo) FindInBatches(result *[]*table.PublishApproval, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return p.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (p publishApprovalDo) Attrs(attrs ...field.AssignExpr) IPublishApprovalDo {
+	return p.withDO(p.DO.Attrs(attrs...))
+}
+
+func (p publishApprovalDo) Assign(attrs ...field.AssignExpr) IPublishApprovalDo {
+	return p.withDO(p.DO.Assign(attrs...))
+}
+
+func (p publishApprovalDo) Joins(fields ...field.RelationField) IPublishApprovalDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Joins(_f))
+	}
+	return &p
+}
+
+func (p publishApprovalDo) Preload(fields ...field.RelationField) IPublishApprovalDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Preload(_f))
+	}
+	return &p
+}
+
+func (p publishApprovalDo) FirstOrInit() (*table.PublishApproval, error) {
+	if result, err := p.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.PublishApproval), nil
+	}
+}
+
+func (p publishApprovalDo) FirstOrCreate() (*table.PublishApproval, error) {
+	if result, err := p.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.PublishApproval), nil
+	}
+}
+
+func (p publishApprovalDo) FindByPage(offset int, limit int) (result []*table.PublishApproval, count int64, err error) {
+	result, err = p.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = p.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (p publishApprovalDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = p.Count()
+	if err != nil {
+		return
+	}
+
+	err = p.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (p publishApprovalDo) Scan(result interface{}) (err error) {
+	return p.DO.Scan(result)
+}
+
+func (p publishApprovalDo) Delete(models ...*table.PublishApproval) (result gen.ResultInfo, err error) {
+	return p.DO.Delete(models)
+}
+
+func (p *publishApprovalDo) withDO(do gen.Dao) *publishApprovalDo {
+	p.DO = *do.(*gen.DO)
+	return p
+}
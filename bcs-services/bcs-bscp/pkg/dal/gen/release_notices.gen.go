@@ -0,0 +1,440 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func newReleaseNotice(db *gorm.DB, opts ...gen.DOOption) releaseNotice {
+	_releaseNotice := releaseNotice{}
+
+	_releaseNotice.releaseNoticeDo.UseDB(db, opts...)
+	_releaseNotice.releaseNoticeDo.UseModel(&table.ReleaseNotice{})
+
+	tableName := _releaseNotice.releaseNoticeDo.TableName()
+	_releaseNotice.ALL = field.NewAsterisk(tableName)
+	_releaseNotice.ID = field.NewUint32(tableName, "id")
+	_releaseNotice.Event = field.NewString(tableName, "event")
+	_releaseNotice.ReleaseID = field.NewUint32(tableName, "release_id")
+	_releaseNotice.FromReleaseID = field.NewUint32(tableName, "from_release_id")
+	_releaseNotice.All = field.NewBool(tableName, "publish_all")
+	_releaseNotice.Groups = field.NewField(tableName, "group_ids")
+	_releaseNotice.Memo = field.NewString(tableName, "memo")
+	_releaseNotice.State = field.NewString(tableName, "state")
+	_releaseNotice.Attempts = field.NewUint32(tableName, "attempts")
+	_releaseNotice.BizID = field.NewUint32(tableName, "biz_id")
+	_releaseNotice.AppID = field.NewUint32(tableName, "app_id")
+	_releaseNotice.Creator = field.NewString(tableName, "creator")
+	_releaseNotice.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_releaseNotice.fillFieldMap()
+
+	return _releaseNotice
+}
+
+type releaseNotice struct {
+	releaseNoticeDo releaseNoticeDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	Event         field.String
+	ReleaseID     field.Uint32
+	FromReleaseID field.Uint32
+	All           field.Bool
+	Groups        field.Field
+	Memo          field.String
+	State         field.String
+	Attempts      field.Uint32
+	BizID         field.Uint32
+	AppID         field.Uint32
+	Creator       field.String
+	CreatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r releaseNotice) Table(newTableName string) *releaseNotice {
+	r.releaseNoticeDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r releaseNotice) As(alias string) *releaseNotice {
+	r.releaseNoticeDo.DO = *(r.releaseNoticeDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *releaseNotice) updateTableName(table string) *releaseNotice {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.Event = field.NewString(table, "event")
+	r.ReleaseID = field.NewUint32(table, "release_id")
+	r.FromReleaseID = field.NewUint32(table, "from_release_id")
+	r.All = field.NewBool(table, "publish_all")
+	r.Groups = field.NewField(table, "group_ids")
+	r.Memo = field.NewString(table, "memo")
+	r.State = field.NewString(table, "state")
+	r.Attempts = field.NewUint32(table, "attempts")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.AppID = field.NewUint32(table, "app_id")
+	r.Creator = field.NewString(table, "creator")
+	r.CreatedAt = field.NewTime(table, "created_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *releaseNotice) WithContext(ctx context.Context) IReleaseNoticeDo {
+	return r.releaseNoticeDo.WithContext(ctx)
+}
+
+func (r releaseNotice) TableName() string { return r.releaseNoticeDo.TableName() }
+
+func (r releaseNotice) Alias() string { return r.releaseNoticeDo.Alias() }
+
+func (r releaseNotice) Columns(cols ...field.Expr) gen.Columns {
+	return r.releaseNoticeDo.Columns(cols...)
+}
+
+func (r *releaseNotice) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *releaseNotice) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 13)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["event"] = r.Event
+	r.fieldMap["release_id"] = r.ReleaseID
+	r.fieldMap["from_release_id"] = r.FromReleaseID
+	r.fieldMap["publish_all"] = r.All
+	r.fieldMap["group_ids"] = r.Groups
+	r.fieldMap["memo"] = r.Memo
+	r.fieldMap["state"] = r.State
+	r.fieldMap["attempts"] = r.Attempts
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["app_id"] = r.AppID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["created_at"] = r.CreatedAt
+}
+
+func (r releaseNotice) clone(db *gorm.DB) releaseNotice {
+	r.releaseNoticeDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r releaseNotice) replaceDB(db *gorm.DB) releaseNotice {
+	r.releaseNoticeDo.ReplaceDB(db)
+	return r
+}
+
+type releaseNoticeDo struct{ gen.DO }
+
+type IReleaseNoticeDo interface {
+	gen.SubQuery
+	Debug() IReleaseNoticeDo
+	WithContext(ctx context.Context) IReleaseNoticeDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IReleaseNoticeDo
+	WriteDB() IReleaseNoticeDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IReleaseNoticeDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IReleaseNoticeDo
+	Not(conds ...gen.Condition) IReleaseNoticeDo
+	Or(conds ...gen.Condition) IReleaseNoticeDo
+	Select(conds ...field.Expr) IReleaseNoticeDo
+	Where(conds ...gen.Condition) IReleaseNoticeDo
+	Order(conds ...field.Expr) IReleaseNoticeDo
+	Distinct(cols ...field.Expr) IReleaseNoticeDo
+	Omit(cols ...field.Expr) IReleaseNoticeDo
+	Join(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo
+	Group(cols ...field.Expr) IReleaseNoticeDo
+	Having(conds ...gen.Condition) IReleaseNoticeDo
+	Limit(limit int) IReleaseNoticeDo
+	Offset(offset int) IReleaseNoticeDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IReleaseNoticeDo
+	Unscoped() IReleaseNoticeDo
+	Create(values ...*table.ReleaseNotice) error
+	CreateInBatches(values []*table.ReleaseNotice, batchSize int) error
+	Save(values ...*table.ReleaseNotice) error
+	First() (*table.ReleaseNotice, error)
+	Take() (*table.ReleaseNotice, error)
+	Last() (*table.ReleaseNotice, error)
+	Find() ([]*table.ReleaseNotice, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleaseNotice, err error)
+	FindInBatches(result *[]*table.ReleaseNotice, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ReleaseNotice) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IReleaseNoticeDo
+	Assign(attrs ...field.AssignExpr) IReleaseNoticeDo
+	Joins(fields ...field.RelationField) IReleaseNoticeDo
+	Preload(fields ...field.RelationField) IReleaseNoticeDo
+	FirstOrInit() (*table.ReleaseNotice, error)
+	FirstOrCreate() (*table.ReleaseNotice, error)
+	FindByPage(offset int, limit int) (result []*table.ReleaseNotice, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IReleaseNoticeDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r releaseNoticeDo) Debug() IReleaseNoticeDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r releaseNoticeDo) WithContext(ctx context.Context) IReleaseNoticeDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r releaseNoticeDo) ReadDB() IReleaseNoticeDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r releaseNoticeDo) WriteDB() IReleaseNoticeDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r releaseNoticeDo) Session(config *gorm.Session) IReleaseNoticeDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r releaseNoticeDo) Clauses(conds ...clause.Expression) IReleaseNoticeDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r releaseNoticeDo) Returning(value interface{}, columns ...string) IReleaseNoticeDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r releaseNoticeDo) Not(conds ...gen.Condition) IReleaseNoticeDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r releaseNoticeDo) Or(conds ...gen.Condition) IReleaseNoticeDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r releaseNoticeDo) Select(conds ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r releaseNoticeDo) Where(conds ...gen.Condition) IReleaseNoticeDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r releaseNoticeDo) Order(conds ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r releaseNoticeDo) Distinct(cols ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r releaseNoticeDo) Omit(cols ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r releaseNoticeDo) Join(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r releaseNoticeDo) LeftJoin(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r releaseNoticeDo) RightJoin(table schema.Tabler, on ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r releaseNoticeDo) Group(cols ...field.Expr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r releaseNoticeDo) Having(conds ...gen.Condition) IReleaseNoticeDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r releaseNoticeDo) Limit(limit int) IReleaseNoticeDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r releaseNoticeDo) Offset(offset int) IReleaseNoticeDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r releaseNoticeDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IReleaseNoticeDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r releaseNoticeDo) Unscoped() IReleaseNoticeDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r releaseNoticeDo) Create(values ...*table.ReleaseNotice) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r releaseNoticeDo) CreateInBatches(values []*table.ReleaseNotice, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r releaseNoticeDo) Save(values ...*table.ReleaseNotice) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r releaseNoticeDo) First() (*table.ReleaseNotice, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleaseNotice), nil
+	}
+}
+
+func (r releaseNoticeDo) Take() (*table.ReleaseNotice, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleaseNotice), nil
+	}
+}
+
+func (r releaseNoticeDo) Last() (*table.ReleaseNotice, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleaseNotice), nil
+	}
+}
+
+func (r releaseNoticeDo) Find() ([]*table.ReleaseNotice, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.ReleaseNotice), err
+}
+
+func (r releaseNoticeDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleaseNotice, err error) {
+	buf := make([]*table.ReleaseNotice, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r releaseNoticeDo) FindInBatches(result *[]*table.ReleaseNotice, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r releaseNoticeDo) Attrs(attrs ...field.AssignExpr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r releaseNoticeDo) Assign(attrs ...field.AssignExpr) IReleaseNoticeDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r releaseNoticeDo) Joins(fields ...field.RelationField) IReleaseNoticeDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r releaseNoticeDo) Preload(fields ...field.RelationField) IReleaseNoticeDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r releaseNoticeDo) FirstOrInit() (*table.ReleaseNotice, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleaseNotice), nil
+	}
+}
+
+func (r releaseNoticeDo) FirstOrCreate() (*table.ReleaseNotice, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleaseNotice), nil
+	}
+}
+
+func (r releaseNoticeDo) FindByPage(offset int, limit int) (result []*table.ReleaseNotice, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r releaseNoticeDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r releaseNoticeDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r releaseNoticeDo) Delete(models ...*table.ReleaseNotice) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *releaseNoticeDo) withDO(do gen.Dao) *releaseNoticeDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
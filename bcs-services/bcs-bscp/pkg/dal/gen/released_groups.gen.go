@@ -35,6 +35,7 @@ func newReleasedGroup(db *gorm.DB, opts ...gen.DOOption) releasedGroup {
 	_releasedGroup.Mode = field.NewString(tableName, "mode")
 	_releasedGroup.Selector = field.NewField(tableName, "selector")
 	_releasedGroup.UID = field.NewString(tableName, "uid")
+	_releasedGroup.RenderedContents = field.NewField(tableName, "rendered_contents")
 	_releasedGroup.Edited = field.NewBool(tableName, "edited")
 	_releasedGroup.BizID = field.NewUint32(tableName, "biz_id")
 	_releasedGroup.Reviser = field.NewString(tableName, "reviser")
@@ -48,19 +49,20 @@ func newReleasedGroup(db *gorm.DB, opts ...gen.DOOption) releasedGroup {
 type releasedGroup struct {
 	releasedGroupDo releasedGroupDo
 
-	ALL        field.Asterisk
-	ID         field.Uint32
-	GroupID    field.Uint32
-	AppID      field.Uint32
-	ReleaseID  field.Uint32
-	StrategyID field.Uint32
-	Mode       field.String
-	Selector   field.Field
-	UID        field.String
-	Edited     field.Bool
-	BizID      field.Uint32
-	Reviser    field.String
-	UpdatedAt  field.Time
+	ALL              field.Asterisk
+	ID               field.Uint32
+	GroupID          field.Uint32
+	AppID            field.Uint32
+	ReleaseID        field.Uint32
+	StrategyID       field.Uint32
+	Mode             field.String
+	Selector         field.Field
+	UID              field.String
+	RenderedContents field.Field
+	Edited           field.Bool
+	BizID            field.Uint32
+	Reviser          field.String
+	UpdatedAt        field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -85,6 +87,7 @@ func (r *releasedGroup) updateTableName(table string) *releasedGroup {
 	r.Mode = field.NewString(table, "mode")
 	r.Selector = field.NewField(table, "selector")
 	r.UID = field.NewString(table, "uid")
+	r.RenderedContents = field.NewField(table, "rendered_contents")
 	r.Edited = field.NewBool(table, "edited")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.Reviser = field.NewString(table, "reviser")
@@ -117,7 +120,7 @@ func (r *releasedGroup) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (r *releasedGroup) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 12)
+	r.fieldMap = make(map[string]field.Expr, 13)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["group_id"] = r.GroupID
 	r.fieldMap["app_id"] = r.AppID
@@ -126,6 +129,7 @@ func (r *releasedGroup) fillFieldMap() {
 	r.fieldMap["mode"] = r.Mode
 	r.fieldMap["selector"] = r.Selector
 	r.fieldMap["uid"] = r.UID
+	r.fieldMap["rendered_contents"] = r.RenderedContents
 	r.fieldMap["edited"] = r.Edited
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["reviser"] = r.Reviser
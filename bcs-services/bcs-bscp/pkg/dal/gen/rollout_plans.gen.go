@@ -0,0 +1,466 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func newRolloutPlan(db *gorm.DB, opts ...gen.DOOption) rolloutPlan {
+	_rolloutPlan := rolloutPlan{}
+
+	_rolloutPlan.rolloutPlanDo.UseDB(db, opts...)
+	_rolloutPlan.rolloutPlanDo.UseModel(&table.RolloutPlan{})
+
+	tableName := _rolloutPlan.rolloutPlanDo.TableName()
+	_rolloutPlan.ALL = field.NewAsterisk(tableName)
+	_rolloutPlan.ID = field.NewUint32(tableName, "id")
+	_rolloutPlan.ReleaseID = field.NewUint32(tableName, "release_id")
+	_rolloutPlan.PreviousReleaseID = field.NewUint32(tableName, "previous_release_id")
+	_rolloutPlan.GroupID = field.NewUint32(tableName, "group_id")
+	_rolloutPlan.Stages = field.NewField(tableName, "stages")
+	_rolloutPlan.CurrentStage = field.NewUint32(tableName, "current_stage")
+	_rolloutPlan.ObserveMinutes = field.NewUint32(tableName, "observe_minutes")
+	_rolloutPlan.MinClients = field.NewUint32(tableName, "min_clients")
+	_rolloutPlan.FailureThreshold = field.NewFloat64(tableName, "failure_threshold")
+	_rolloutPlan.OnFailure = field.NewString(tableName, "on_failure")
+	_rolloutPlan.Status = field.NewString(tableName, "status")
+	_rolloutPlan.StageStartedAt = field.NewTime(tableName, "stage_started_at")
+	_rolloutPlan.Memo = field.NewString(tableName, "memo")
+	_rolloutPlan.Reason = field.NewString(tableName, "reason")
+	_rolloutPlan.BizID = field.NewUint32(tableName, "biz_id")
+	_rolloutPlan.AppID = field.NewUint32(tableName, "app_id")
+	_rolloutPlan.Creator = field.NewString(tableName, "creator")
+	_rolloutPlan.Reviser = field.NewString(tableName, "reviser")
+	_rolloutPlan.CreatedAt = field.NewTime(tableName, "created_at")
+	_rolloutPlan.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_rolloutPlan.fillFieldMap()
+
+	return _rolloutPlan
+}
+
+type rolloutPlan struct {
+	rolloutPlanDo rolloutPlanDo
+
+	ALL               field.Asterisk
+	ID                field.Uint32
+	ReleaseID         field.Uint32
+	PreviousReleaseID field.Uint32
+	GroupID           field.Uint32
+	Stages            field.Field
+	CurrentStage      field.Uint32
+	ObserveMinutes    field.Uint32
+	MinClients        field.Uint32
+	FailureThreshold  field.Float64
+	OnFailure         field.String
+	Status            field.String
+	StageStartedAt    field.Time
+	Memo              field.String
+	Reason            field.String
+	BizID             field.Uint32
+	AppID             field.Uint32
+	Creator           field.String
+	Reviser           field.String
+	CreatedAt         field.Time
+	UpdatedAt         field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r rolloutPlan) Table(newTableName string) *rolloutPlan {
+	r.rolloutPlanDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r rolloutPlan) As(alias string) *rolloutPlan {
+	r.rolloutPlanDo.DO = *(r.rolloutPlanDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *rolloutPlan) updateTableName(table string) *rolloutPlan {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.ReleaseID = field.NewUint32(table, "release_id")
+	r.PreviousReleaseID = field.NewUint32(table, "previous_release_id")
+	r.GroupID = field.NewUint32(table, "group_id")
+	r.Stages = field.NewField(table, "stages")
+	r.CurrentStage = field.NewUint32(table, "current_stage")
+	r.ObserveMinutes = field.NewUint32(table, "observe_minutes")
+	r.MinClients = field.NewUint32(table, "min_clients")
+	r.FailureThreshold = field.NewFloat64(table, "failure_threshold")
+	r.OnFailure = field.NewString(table, "on_failure")
+	r.Status = field.NewString(table, "status")
+	r.StageStartedAt = field.NewTime(table, "stage_started_at")
+	r.Memo = field.NewString(table, "memo")
+	r.Reason = field.NewString(table, "reason")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.AppID = field.NewUint32(table, "app_id")
+	r.Creator = field.NewString(table, "creator")
+	r.Reviser = field.NewString(table, "reviser")
+	r.CreatedAt = field.NewTime(table, "created_at")
+	r.UpdatedAt = field.NewTime(table, "updated_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *rolloutPlan) WithContext(ctx context.Context) IRolloutPlanDo {
+	return r.rolloutPlanDo.WithContext(ctx)
+}
+
+func (r rolloutPlan) TableName() string { return r.rolloutPlanDo.TableName() }
+
+func (r rolloutPlan) Alias() string { return r.rolloutPlanDo.Alias() }
+
+func (r rolloutPlan) Columns(cols ...field.Expr) gen.Columns { return r.rolloutPlanDo.Columns(cols...) }
+
+func (r *rolloutPlan) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *rolloutPlan) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 20)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["release_id"] = r.ReleaseID
+	r.fieldMap["previous_release_id"] = r.PreviousReleaseID
+	r.fieldMap["group_id"] = r.GroupID
+	r.fieldMap["stages"] = r.Stages
+	r.fieldMap["current_stage"] = r.CurrentStage
+	r.fieldMap["observe_minutes"] = r.ObserveMinutes
+	r.fieldMap["min_clients"] = r.MinClients
+	r.fieldMap["failure_threshold"] = r.FailureThreshold
+	r.fieldMap["on_failure"] = r.OnFailure
+	r.fieldMap["status"] = r.Status
+	r.fieldMap["stage_started_at"] = r.StageStartedAt
+	r.fieldMap["memo"] = r.Memo
+	r.fieldMap["reason"] = r.Reason
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["app_id"] = r.AppID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["reviser"] = r.Reviser
+	r.fieldMap["created_at"] = r.CreatedAt
+	r.fieldMap["updated_at"] = r.UpdatedAt
+}
+
+func (r rolloutPlan) clone(db *gorm.DB) rolloutPlan {
+	r.rolloutPlanDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r rolloutPlan) replaceDB(db *gorm.DB) rolloutPlan {
+	r.rolloutPlanDo.ReplaceDB(db)
+	return r
+}
+
+type rolloutPlanDo struct{ gen.DO }
+
+type IRolloutPlanDo interface {
+	gen.SubQuery
+	Debug() IRolloutPlanDo
+	WithContext(ctx context.Context) IRolloutPlanDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRolloutPlanDo
+	WriteDB() IRolloutPlanDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRolloutPlanDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRolloutPlanDo
+	Not(conds ...gen.Condition) IRolloutPlanDo
+	Or(conds ...gen.Condition) IRolloutPlanDo
+	Select(conds ...field.Expr) IRolloutPlanDo
+	Where(conds ...gen.Condition) IRolloutPlanDo
+	Order(conds ...field.Expr) IRolloutPlanDo
+	Distinct(cols ...field.Expr) IRolloutPlanDo
+	Omit(cols ...field.Expr) IRolloutPlanDo
+	Join(table schema.Tabler, on ...field.Expr) IRolloutPlanDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRolloutPlanDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRolloutPlanDo
+	Group(cols ...field.Expr) IRolloutPlanDo
+	Having(conds ...gen.Condition) IRolloutPlanDo
+	Limit(limit int) IRolloutPlanDo
+	Offset(offset int) IRolloutPlanDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRolloutPlanDo
+	Unscoped() IRolloutPlanDo
+	Create(values ...*table.RolloutPlan) error
+	CreateInBatches(values []*table.RolloutPlan, batchSize int) error
+	Save(values ...*table.RolloutPlan) error
+	First() (*table.RolloutPlan, error)
+	Take() (*table.RolloutPlan, error)
+	Last() (*table.RolloutPlan, error)
+	Find() ([]*table.RolloutPlan, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RolloutPlan, err error)
+	FindInBatches(result *[]*table.RolloutPlan, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.RolloutPlan) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRolloutPlanDo
+	Assign(attrs ...field.AssignExpr) IRolloutPlanDo
+	Joins(fields ...field.RelationField) IRolloutPlanDo
+	Preload(fields ...field.RelationField) IRolloutPlanDo
+	FirstOrInit() (*table.RolloutPlan, error)
+	FirstOrCreate() (*table.RolloutPlan, error)
+	FindByPage(offset int, limit int) (result []*table.RolloutPlan, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRolloutPlanDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r rolloutPlanDo) Debug() IRolloutPlanDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r rolloutPlanDo) WithContext(ctx context.Context) IRolloutPlanDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r rolloutPlanDo) ReadDB() IRolloutPlanDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r rolloutPlanDo) WriteDB() IRolloutPlanDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r rolloutPlanDo) Session(config *gorm.Session) IRolloutPlanDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r rolloutPlanDo) Clauses(conds ...clause.Expression) IRolloutPlanDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r rolloutPlanDo) Returning(value interface{}, columns ...string) IRolloutPlanDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r rolloutPlanDo) Not(conds ...gen.Condition) IRolloutPlanDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r rolloutPlanDo) Or(conds ...gen.Condition) IRolloutPlanDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r rolloutPlanDo) Select(conds ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r rolloutPlanDo) Where(conds ...gen.Condition) IRolloutPlanDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r rolloutPlanDo) Order(conds ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r rolloutPlanDo) Distinct(cols ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r rolloutPlanDo) Omit(cols ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r rolloutPlanDo) Join(table schema.Tabler, on ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r rolloutPlanDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r rolloutPlanDo) RightJoin(table schema.Tabler, on ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r rolloutPlanDo) Group(cols ...field.Expr) IRolloutPlanDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r rolloutPlanDo) Having(conds ...gen.Condition) IRolloutPlanDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r rolloutPlanDo) Limit(limit int) IRolloutPlanDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r rolloutPlanDo) Offset(offset int) IRolloutPlanDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r rolloutPlanDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRolloutPlanDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r rolloutPlanDo) Unscoped() IRolloutPlanDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r rolloutPlanDo) Create(values ...*table.RolloutPlan) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r rolloutPlanDo) CreateInBatches(values []*table.RolloutPlan, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r rolloutPlanDo) Save(values ...*table.RolloutPlan) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r rolloutPlanDo) First() (*table.RolloutPlan, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RolloutPlan), nil
+	}
+}
+
+func (r rolloutPlanDo) Take() (*table.RolloutPlan, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RolloutPlan), nil
+	}
+}
+
+func (r rolloutPlanDo) Last() (*table.RolloutPlan, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RolloutPlan), nil
+	}
+}
+
+func (r rolloutPlanDo) Find() ([]*table.RolloutPlan, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.RolloutPlan), err
+}
+
+func (r rolloutPlanDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RolloutPlan, err error) {
+	buf := make([]*table.RolloutPlan, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r rolloutPlanDo) FindInBatches(result *[]*table.RolloutPlan, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r rolloutPlanDo) Attrs(attrs ...field.AssignExpr) IRolloutPlanDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r rolloutPlanDo) Assign(attrs ...field.AssignExpr) IRolloutPlanDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r rolloutPlanDo) Joins(fields ...field.RelationField) IRolloutPlanDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r rolloutPlanDo) Preload(fields ...field.RelationField) IRolloutPlanDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r rolloutPlanDo) FirstOrInit() (*table.RolloutPlan, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RolloutPlan), nil
+	}
+}
+
+func (r rolloutPlanDo) FirstOrCreate() (*table.RolloutPlan, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RolloutPlan), nil
+	}
+}
+
+func (r rolloutPlanDo) FindByPage(offset int, limit int) (result []*table.RolloutPlan, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r rolloutPlanDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r rolloutPlanDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r rolloutPlanDo) Delete(models ...*table.RolloutPlan) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *rolloutPlanDo) withDO(do gen.Dao) *rolloutPlanDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
@@ -30,6 +30,7 @@ func newTemplateSpace(db *gorm.DB, opts ...gen.DOOption) templateSpace {
 	_templateSpace.ID = field.NewUint32(tableName, "id")
 	_templateSpace.Name = field.NewString(tableName, "name")
 	_templateSpace.Memo = field.NewString(tableName, "memo")
+	_templateSpace.TemplateEngine = field.NewString(tableName, "template_engine")
 	_templateSpace.BizID = field.NewUint32(tableName, "biz_id")
 	_templateSpace.Creator = field.NewString(tableName, "creator")
 	_templateSpace.Reviser = field.NewString(tableName, "reviser")
@@ -44,15 +45,16 @@ func newTemplateSpace(db *gorm.DB, opts ...gen.DOOption) templateSpace {
 type templateSpace struct {
 	templateSpaceDo templateSpaceDo
 
-	ALL       field.Asterisk
-	ID        field.Uint32
-	Name      field.String
-	Memo      field.String
-	BizID     field.Uint32
-	Creator   field.String
-	Reviser   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
+	ALL            field.Asterisk
+	ID             field.Uint32
+	Name           field.String
+	Memo           field.String
+	TemplateEngine field.String
+	BizID          field.Uint32
+	Creator        field.String
+	Reviser        field.String
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -72,6 +74,7 @@ func (t *templateSpace) updateTableName(table string) *templateSpace {
 	t.ID = field.NewUint32(table, "id")
 	t.Name = field.NewString(table, "name")
 	t.Memo = field.NewString(table, "memo")
+	t.TemplateEngine = field.NewString(table, "template_engine")
 	t.BizID = field.NewUint32(table, "biz_id")
 	t.Creator = field.NewString(table, "creator")
 	t.Reviser = field.NewString(table, "reviser")
@@ -105,10 +108,11 @@ func (t *templateSpace) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (t *templateSpace) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 8)
+	t.fieldMap = make(map[string]field.Expr, 9)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["name"] = t.Name
 	t.fieldMap["memo"] = t.Memo
+	t.fieldMap["template_engine"] = t.TemplateEngine
 	t.fieldMap["biz_id"] = t.BizID
 	t.fieldMap["creator"] = t.Creator
 	t.fieldMap["reviser"] = t.Reviser
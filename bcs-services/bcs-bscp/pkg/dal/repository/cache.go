@@ -34,15 +34,15 @@ var errContentSizeExceedsLimit = fmt.Errorf("content size exceeds maximum limit
 // VariableCacher is used to set/get template variables with cache
 type VariableCacher interface {
 	// SetVariables sets template variables into cache, the variables extracted from repository file content
-	SetVariables(kt *kit.Kit, sign string, checkSize bool) ([]string, error)
+	// with the processor of the template engine
+	SetVariables(kt *kit.Kit, sign, engine string, checkSize bool) ([]string, error)
 	// GetVariables gets template variables from the cache firstly; if not found, then get from the repository
-	GetVariables(kt *kit.Kit, sign string, checkSize bool) ([]string, error)
+	GetVariables(kt *kit.Kit, sign, engine string, checkSize bool) ([]string, error)
 }
 
 type varCacher struct {
-	p        BaseProvider
-	bds      bedis.Client
-	tmplProc tmplprocess.TmplProcessor
+	p   BaseProvider
+	bds bedis.Client
 }
 
 // newVariableCacher new a variable cacher
@@ -54,14 +54,13 @@ func newVariableCacher(redisConf cc.RedisCluster, p BaseProvider) (VariableCache
 	}
 
 	return &varCacher{
-		p:        p,
-		bds:      bds,
-		tmplProc: tmplprocess.NewTmplProcessor(),
+		p:   p,
+		bds: bds,
 	}, nil
 }
 
 // SetVariables sets template variables into cache, the variables extracted from repository file content
-func (c *varCacher) SetVariables(kt *kit.Kit, sign string, checkSize bool) ([]string, error) {
+func (c *varCacher) SetVariables(kt *kit.Kit, sign, engine string, checkSize bool) ([]string, error) {
 	if checkSize {
 		// check content byte size
 		m, err := c.p.Metadata(kt, sign)
@@ -74,7 +73,7 @@ func (c *varCacher) SetVariables(kt *kit.Kit, sign string, checkSize bool) ([]st
 	}
 
 	// get template variables from repository
-	vars, err := c.getVarsFromRepo(kt, sign)
+	vars, err := c.getVarsFromRepo(kt, sign, engine)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +85,7 @@ func (c *varCacher) SetVariables(kt *kit.Kit, sign string, checkSize bool) ([]st
 	}
 
 	// set variables into cache
-	err = c.bds.Set(kt.Ctx, variableCacheKey(sign), string(varsBytes), variableCacheTTLSeconds)
+	err = c.bds.Set(kt.Ctx, variableCacheKey(sign, engine), string(varsBytes), variableCacheTTLSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +94,7 @@ func (c *varCacher) SetVariables(kt *kit.Kit, sign string, checkSize bool) ([]st
 }
 
 // GetVariables gets template variables from the cache firstly; if not found, then get from the repository
-func (c *varCacher) GetVariables(kt *kit.Kit, sign string, checkSize bool) ([]string, error) {
+func (c *varCacher) GetVariables(kt *kit.Kit, sign, engine string, checkSize bool) ([]string, error) {
 	if checkSize {
 		// check content byte size
 		m, err := c.p.Metadata(kt, sign)
@@ -108,7 +107,7 @@ func (c *varCacher) GetVariables(kt *kit.Kit, sign string, checkSize bool) ([]st
 	}
 
 	// get variables from cache
-	val, err := c.bds.Get(kt.Ctx, variableCacheKey(sign))
+	val, err := c.bds.Get(kt.Ctx, variableCacheKey(sign, engine))
 	if err != nil {
 		return nil, err
 	}
@@ -122,11 +121,11 @@ func (c *varCacher) GetVariables(kt *kit.Kit, sign string, checkSize bool) ([]st
 	}
 
 	// if no variables in cache, get them from the repository and set them into cache
-	return c.SetVariables(kt, sign, false)
+	return c.SetVariables(kt, sign, engine, false)
 }
 
 // getVarsFromRepo get template variables from repository
-func (c *varCacher) getVarsFromRepo(kt *kit.Kit, sign string) ([]string, error) {
+func (c *varCacher) getVarsFromRepo(kt *kit.Kit, sign, engine string) ([]string, error) {
 	// download content and extract variables from it
 	body, _, err := c.p.Download(kt, sign)
 	if err != nil {
@@ -139,9 +138,14 @@ func (c *varCacher) getVarsFromRepo(kt *kit.Kit, sign string) ([]string, error)
 		return nil, err
 	}
 
-	return c.tmplProc.ExtractVariables(content), nil
+	return tmplprocess.NewTmplProcessorByEngine(engine).ExtractVariables(content), nil
 }
 
-func variableCacheKey(sign string) string {
-	return "vars_" + sign
+// variableCacheKey returns the cache key of the content's variables, the same content may have
+// different variables for different template engines, so the non default engine is a part of the key
+func variableCacheKey(sign, engine string) string {
+	if engine == "" || engine == tmplprocess.SimpleEngine {
+		return "vars_" + sign
+	}
+	return "vars_" + engine + "_" + sign
 }
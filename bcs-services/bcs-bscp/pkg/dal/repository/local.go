@@ -0,0 +1,242 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"crypto/hmac"
+	"crypto/md5" // nolint
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/thirdparty/repo"
+)
+
+const (
+	// LocalDownloadPath is the api-server path which serves the signed download url of local repository.
+	LocalDownloadPath = "/api/v1/repo/local/biz/%d/download/%s"
+)
+
+// localClient local filesystem client struct, the contents are saved as <root>/<repo name>/file/<sha256>
+type localClient struct {
+	conf *cc.LocalStorage
+}
+
+// objectPath returns the absolute file path of the content
+func (c *localClient) objectPath(bizID uint32, sign string) (string, error) {
+	if _, err := hex.DecodeString(sign); err != nil {
+		return "", errors.New("file sha256 is not standard format")
+	}
+
+	node, err := repo.GenS3NodeFullPath(bizID, sign)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(c.conf.Root, filepath.FromSlash(node)), nil
+}
+
+// Upload upload file to local filesystem
+func (c *localClient) Upload(kt *kit.Kit, sign string, body io.Reader) (*ObjectMetadata, error) {
+	p, err := c.objectPath(kt.BizID, sign)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(p)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	// write to a temp file in the same directory first, then rename it, so that a content is always complete
+	f, metadata, err := spoolObject(dir, sign, body)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err = os.Rename(f.Name(), p); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// Download download file from local filesystem
+func (c *localClient) Download(kt *kit.Kit, sign string) (io.ReadCloser, int64, error) {
+	p, err := c.objectPath(kt.BizID, sign)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, errf.ErrFileContentNotFound
+		}
+		return nil, 0, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	return f, info.Size(), nil
+}
+
+// Metadata local file metadata
+func (c *localClient) Metadata(kt *kit.Kit, sign string) (*ObjectMetadata, error) {
+	body, size, err := c.Download(kt, sign)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	h := md5.New() // nolint
+	if _, err = io.Copy(h, body); err != nil {
+		return nil, err
+	}
+
+	metadata := &ObjectMetadata{
+		ByteSize: size,
+		Sha256:   sign,
+		Md5:      hex.EncodeToString(h.Sum(nil)),
+	}
+
+	return metadata, nil
+}
+
+// URIDecorator ..
+func (c *localClient) URIDecorator(bizID uint32) DecoratorInter {
+	return newUriDecoratorInter(bizID)
+}
+
+// DownloadLink local file download link, which is served by api-server and signed with the sign key
+func (c *localClient) DownloadLink(kt *kit.Kit, sign string, fetchLimit uint32) (string, error) {
+	if _, err := c.objectPath(kt.BizID, sign); err != nil {
+		return "", err
+	}
+
+	expires := time.Now().Add(tempDownloadURLExpireSeconds * time.Second).Unix()
+	query := url.Values{}
+	query.Set("expires", fmt.Sprintf("%d", expires))
+	query.Set("token", localDownloadToken(c.conf.SignKey, kt.BizID, sign, expires))
+
+	return strings.TrimRight(c.conf.DownloadHost, "/") + fmt.Sprintf(LocalDownloadPath, kt.BizID, sign) + "?" +
+		query.Encode(), nil
+}
+
+// AsyncDownload local
+func (c *localClient) AsyncDownload(kt *kit.Kit, sign string) (string, error) {
+	return "", errNotImplemented
+}
+
+// AsyncDownloadStatus local
+func (c *localClient) AsyncDownloadStatus(kt *kit.Kit, sign string, taskID string) (bool, error) {
+	return false, errNotImplemented
+}
+
+// VerifyLocalDownloadToken verify the token of the download url generated by local repository
+func VerifyLocalDownloadToken(conf cc.LocalStorage, bizID uint32, sign string, expires int64, token string) error {
+	if time.Now().Unix() > expires {
+		return errors.New("download url is expired")
+	}
+
+	expected := localDownloadToken(conf.SignKey, bizID, sign, expires)
+	if !hmac.Equal([]byte(expected), []byte(token)) {
+		return errors.New("download url token is invalid")
+	}
+
+	return nil
+}
+
+// localDownloadToken generate the token of download url
+func localDownloadToken(key string, bizID uint32, sign string, expires int64) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write([]byte(fmt.Sprintf("%d/%s/%d", bizID, sign, expires)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// spoolObject writes the body to a temp file in the dir and checks its sha256 is the sign, the returned file
+// is seeked to the beginning, the caller should close and remove it.
+func spoolObject(dir, sign string, body io.Reader) (*os.File, *ObjectMetadata, error) {
+	f, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sha := sha256.New()
+	md := md5.New() // nolint
+	size, err := io.Copy(io.MultiWriter(f, sha, md), body)
+	if err == nil && hex.EncodeToString(sha.Sum(nil)) != strings.ToLower(sign) {
+		err = errors.Errorf("file sha256 %s not match the sign %s", hex.EncodeToString(sha.Sum(nil)), sign)
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, nil, err
+	}
+
+	metadata := &ObjectMetadata{
+		ByteSize: size,
+		Sha256:   sign,
+		Md5:      hex.EncodeToString(md.Sum(nil)),
+	}
+
+	return f, metadata, nil
+}
+
+// newLocalClient new local filesystem client
+func newLocalClient(conf cc.LocalStorage) (BaseProvider, error) {
+	if err := os.MkdirAll(conf.Root, 0755); err != nil {
+		return nil, fmt.Errorf("create local repository root %s failed, err: %v", conf.Root, err)
+	}
+
+	return &localClient{conf: &conf}, nil
+}
+
+// newLocalProvider new local filesystem provider
+func newLocalProvider(settings cc.Repository) (Provider, error) {
+	p, err := newLocalClient(settings.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	var c VariableCacher
+	c, err = newVariableCacher(settings.RedisCluster, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repoProvider{
+		BaseProvider:   p,
+		VariableCacher: c,
+	}, nil
+}
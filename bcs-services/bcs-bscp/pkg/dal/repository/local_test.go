@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+)
+
+func TestLocalClient(t *testing.T) {
+	conf := cc.LocalStorage{Root: t.TempDir(), DownloadHost: "http://127.0.0.1:8080/", SignKey: "key"}
+	p, err := newLocalClient(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kt := kit.New()
+	kt.BizID = 2
+	content := "hello bscp"
+	hash := sha256.Sum256([]byte(content))
+	sign := hex.EncodeToString(hash[:])
+
+	if _, _, err = p.Download(kt, sign); !errors.Is(err, errf.ErrFileContentNotFound) {
+		t.Errorf("download not exist content, err = %v, want %v", err, errf.ErrFileContentNotFound)
+	}
+
+	// the content not match the sign should be rejected
+	if _, err = p.Upload(kt, sign, strings.NewReader("other")); err == nil {
+		t.Errorf("upload content not match the sign, want error")
+	}
+
+	if _, err = p.Upload(kt, sign, strings.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+
+	body, size, err := p.Download(kt, sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(body)
+	body.Close()
+	if string(got) != content || size != int64(len(content)) {
+		t.Errorf("download content = %q, size = %d, want %q", got, size, content)
+	}
+
+	metadata, err := p.Metadata(kt, sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.ByteSize != int64(len(content)) || metadata.Md5 != "e91b6823107edb3cee5263feeed89331" {
+		t.Errorf("metadata = %+v", metadata)
+	}
+
+	link, err := p.DownloadLink(kt, sign, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/api/v1/repo/local/biz/2/download/"+sign {
+		t.Errorf("download link path = %s", u.Path)
+	}
+	expires, _ := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	if err = VerifyLocalDownloadToken(conf, 2, sign, expires, u.Query().Get("token")); err != nil {
+		t.Errorf("verify download token failed, err: %v", err)
+	}
+	if err = VerifyLocalDownloadToken(conf, 3, sign, expires, u.Query().Get("token")); err == nil {
+		t.Errorf("verify download token of other biz, want error")
+	}
+}
@@ -184,6 +184,26 @@ func NewProvider(conf cc.Repository) (Provider, error) {
 		return newCosProvider(conf)
 	case string(cc.BkRepo):
 		return newBKRepoProvider(conf)
+	case string(cc.MinIO):
+		return newS3Provider(conf)
+	case string(cc.Local):
+		return newLocalProvider(conf)
+	}
+	return nil, fmt.Errorf("store with type %s is not supported", conf.StorageType)
+}
+
+// NewBaseProvider init base provider by storage type, which does not depend on the variable cache,
+// it is used by the tools which only need to upload and download contents.
+func NewBaseProvider(conf cc.Repository) (BaseProvider, error) {
+	switch strings.ToUpper(string(conf.StorageType)) {
+	case string(cc.S3):
+		return newCosClient(conf.S3)
+	case string(cc.BkRepo):
+		return newBKRepoClient(conf)
+	case string(cc.MinIO):
+		return newS3Client(conf.S3)
+	case string(cc.Local):
+		return newLocalClient(conf.Local)
 	}
 	return nil, fmt.Errorf("store with type %s is not supported", conf.StorageType)
 }
@@ -0,0 +1,370 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/thirdparty/repo"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+)
+
+const (
+	// s3SignAlgorithm aws signature version 4 algorithm
+	s3SignAlgorithm = "AWS4-HMAC-SHA256"
+	// s3UnsignedPayload the payload is not signed, the content is verified by sha256 sign of bscp itself
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	// s3TimeFormat the time format of aws signature version 4
+	s3TimeFormat = "20060102T150405Z"
+)
+
+// s3Client generic s3 compatible storage client struct, such as minio, which uses path-style requests
+type s3Client struct {
+	conf   *cc.S3Storage
+	host   string
+	client *http.Client
+}
+
+// objectURI returns the path-style uri of the content
+func (c *s3Client) objectURI(bizID uint32, sign string) (string, error) {
+	node, err := repo.GenS3NodeFullPath(bizID, sign)
+	if err != nil {
+		return "", err
+	}
+
+	return "/" + c.conf.BucketName + node, nil
+}
+
+// newRequest new a request which is signed with aws signature version 4
+func (c *s3Client) newRequest(kt *kit.Kit, method, uri string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(kt.Ctx, method, c.host+uri, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(constant.RidKey, kt.Rid)
+
+	s3SignRequest(req, c.conf.AccessKeyID, c.conf.SecretAccessKey, c.conf.Region, time.Now())
+	return req, nil
+}
+
+// Upload upload file to s3
+func (c *s3Client) Upload(kt *kit.Kit, sign string, body io.Reader) (*ObjectMetadata, error) {
+	uri, err := c.objectURI(kt.BizID, sign)
+	if err != nil {
+		return nil, err
+	}
+
+	// s3 put object requires content length, so spool the body to get it, and verify the content at the same time
+	f, metadata, err := spoolObject("", sign, body)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	req, err := c.newRequest(kt, http.MethodPut, uri, f)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = metadata.ByteSize
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("upload status %d != 200", resp.StatusCode)
+	}
+
+	return metadata, nil
+}
+
+// Download download file from s3
+func (c *s3Client) Download(kt *kit.Kit, sign string) (io.ReadCloser, int64, error) {
+	uri, err := c.objectURI(kt.BizID, sign)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := c.newRequest(kt, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, 0, errf.ErrFileContentNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, 0, errors.Errorf("download status %d != 200", resp.StatusCode)
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+// Metadata s3 file metadata
+func (c *s3Client) Metadata(kt *kit.Kit, sign string) (*ObjectMetadata, error) {
+	uri, err := c.objectURI(kt.BizID, sign)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(kt, http.MethodHead, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errf.ErrFileContentNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("metadata status %d != 200", resp.StatusCode)
+	}
+
+	// etag is the md5 of the content which is uploaded by single put object request
+	md5 := strings.Trim(resp.Header.Get("Etag"), "\"")
+
+	metadata := &ObjectMetadata{
+		ByteSize: resp.ContentLength,
+		Sha256:   sign,
+		Md5:      md5,
+	}
+
+	return metadata, nil
+}
+
+// URIDecorator ..
+func (c *s3Client) URIDecorator(bizID uint32) DecoratorInter {
+	return newUriDecoratorInter(bizID)
+}
+
+// DownloadLink s3 file presigned download link
+func (c *s3Client) DownloadLink(kt *kit.Kit, sign string, fetchLimit uint32) (string, error) {
+	uri, err := c.objectURI(kt.BizID, sign)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(c.host + uri)
+	if err != nil {
+		return "", err
+	}
+
+	return s3PresignURL(u, c.conf.AccessKeyID, c.conf.SecretAccessKey, c.conf.Region, time.Now(),
+		tempDownloadURLExpireSeconds), nil
+}
+
+// AsyncDownload s3
+func (c *s3Client) AsyncDownload(kt *kit.Kit, sign string) (string, error) {
+	return "", errNotImplemented
+}
+
+// AsyncDownloadStatus s3
+func (c *s3Client) AsyncDownloadStatus(kt *kit.Kit, sign string, taskID string) (bool, error) {
+	return false, errNotImplemented
+}
+
+// s3SignRequest sign the request with aws signature version 4 in the authorization header
+func s3SignRequest(req *http.Request, accessKey, secretKey, region string, now time.Time) {
+	amzDate := now.UTC().Format(s3TimeFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": s3UnsignedPayload,
+		"x-amz-date":           amzDate,
+	}
+	signedHeaders, canonicalHeaders := s3CanonicalHeaders(headers)
+	canonicalRequest := strings.Join([]string{req.Method, s3EscapePath(req.URL.Path), "", canonicalHeaders,
+		signedHeaders, s3UnsignedPayload}, "\n")
+
+	scope := s3Scope(amzDate, region)
+	signature := s3Signature(secretKey, amzDate, region, scope, canonicalRequest)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SignAlgorithm, accessKey, scope, signedHeaders, signature))
+}
+
+// s3PresignURL presign the get object url with aws signature version 4 in the query
+func s3PresignURL(u *url.URL, accessKey, secretKey, region string, now time.Time, expireSeconds int) string {
+	amzDate := now.UTC().Format(s3TimeFormat)
+	scope := s3Scope(amzDate, region)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", s3SignAlgorithm)
+	query.Set("X-Amz-Credential", accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", expireSeconds))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	signedHeaders, canonicalHeaders := s3CanonicalHeaders(map[string]string{"host": u.Host})
+	canonicalQuery := s3CanonicalQuery(query)
+	canonicalRequest := strings.Join([]string{http.MethodGet, s3EscapePath(u.Path), canonicalQuery,
+		canonicalHeaders, signedHeaders, s3UnsignedPayload}, "\n")
+
+	signature := s3Signature(secretKey, amzDate, region, scope, canonicalRequest)
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s", u.Scheme, u.Host, s3EscapePath(u.Path), canonicalQuery,
+		signature)
+}
+
+// s3Scope returns the credential scope
+func s3Scope(amzDate, region string) string {
+	return fmt.Sprintf("%s/%s/s3/aws4_request", amzDate[:8], region)
+}
+
+// s3Signature calculate the signature of the canonical request
+func s3Signature(secretKey, amzDate, region, scope, canonicalRequest string) string {
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3SignAlgorithm, amzDate, scope, hex.EncodeToString(hashed[:])}, "\n")
+
+	key := s3HMAC([]byte("AWS4"+secretKey), amzDate[:8])
+	key = s3HMAC(key, region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	return hex.EncodeToString(s3HMAC(key, stringToSign))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3CanonicalHeaders returns the signed headers and canonical headers, the header names should be lower case
+func s3CanonicalHeaders(headers map[string]string) (string, string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b := new(strings.Builder)
+	for _, name := range names {
+		b.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	return strings.Join(names, ";"), b.String()
+}
+
+// s3CanonicalQuery returns the canonical query string which is sorted by key and uri encoded
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			pairs = append(pairs, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3EscapePath uri encode the path except the slash
+func s3EscapePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return s3Escape(p, false)
+}
+
+// s3Escape uri encode the string, only the unreserved characters are not encoded
+func s3Escape(s string, encodeSlash bool) string {
+	b := new(strings.Builder)
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' || (ch == '/' && !encodeSlash) {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(b, "%%%02X", ch)
+	}
+	return b.String()
+}
+
+// newS3Client new generic s3 compatible storage client
+func newS3Client(conf cc.S3Storage) (BaseProvider, error) {
+	schema := "http"
+	if conf.UseSSL {
+		schema = "https"
+	}
+
+	// endpoint may be configured with schema
+	host := conf.Endpoint
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = schema + "://" + host
+	}
+
+	p := &s3Client{
+		host:   strings.TrimRight(host, "/"),
+		conf:   &conf,
+		client: &http.Client{Transport: tools.NewCurlLogTransport(defaultTransport)},
+	}
+
+	return p, nil
+}
+
+// newS3Provider new generic s3 compatible storage provider
+func newS3Provider(settings cc.Repository) (Provider, error) {
+	p, err := newS3Client(settings.S3)
+	if err != nil {
+		return nil, err
+	}
+
+	var c VariableCacher
+	c, err = newVariableCacher(settings.RedisCluster, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repoProvider{
+		BaseProvider:   p,
+		VariableCacher: c,
+	}, nil
+}
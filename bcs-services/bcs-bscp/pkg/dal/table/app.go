@@ -15,6 +15,7 @@ package table
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
@@ -134,6 +135,30 @@ type AppSpec struct {
 	Memo       string     `json:"memo" gorm:"column:memo"`
 	Alias      string     `json:"alias" gorm:"alias"`
 	DataType   DataType   `json:"data_type" gorm:"data_type"`
+	// IsApprove defines whether the publish of this app needs to be approved.
+	IsApprove bool `json:"is_approve" gorm:"column:is_approve"`
+	// Approver is the default approvers of this app's publish approval, separated by comma.
+	Approver string `json:"approver" gorm:"column:approver"`
+}
+
+// Approvers returns the default approvers of this app's publish approval.
+func (as *AppSpec) Approvers() []string {
+	approvers := make([]string, 0)
+	for _, one := range strings.Split(as.Approver, ",") {
+		if one = strings.TrimSpace(one); one != "" {
+			approvers = append(approvers, one)
+		}
+	}
+	return approvers
+}
+
+// validateApprove validate the publish approval setting.
+func (as *AppSpec) validateApprove() error {
+	if as.IsApprove && len(as.Approvers()) == 0 {
+		return errors.New("approver should be set when publish approval is enabled")
+	}
+
+	return nil
 }
 
 // ValidateCreate validate spec when created.
@@ -158,6 +183,10 @@ func (as *AppSpec) ValidateCreate() error {
 		return err
 	}
 
+	if err := as.validateApprove(); err != nil {
+		return err
+	}
+
 	switch as.ConfigType {
 	case File:
 	case KV:
@@ -190,6 +219,10 @@ func (as *AppSpec) ValidateUpdate(configType ConfigType) error {
 		return err
 	}
 
+	if err := as.validateApprove(); err != nil {
+		return err
+	}
+
 	switch configType {
 	case File:
 	case KV:
@@ -0,0 +1,454 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
+)
+
+// maxSchemaLength is the max length of a config schema.
+const maxSchemaLength = 64 * 1024
+
+// ConfigSchema 配置校验规则, attached to a kv or a config file of an app, the kv value or the config
+// file content must satisfy the schema before it is saved or released.
+type ConfigSchema struct {
+	ID         uint32                  `json:"id" gorm:"primaryKey"`
+	Spec       *ConfigSchemaSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ConfigSchemaAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision               `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the config schema's database table name.
+func (c *ConfigSchema) TableName() string {
+	return "config_schemas"
+}
+
+// AppID AuditRes interface
+func (c *ConfigSchema) AppID() uint32 {
+	return c.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (c *ConfigSchema) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ConfigSchema) ResType() string {
+	return "config_schema"
+}
+
+// ValidateCreate validate config schema is valid or not when create it.
+func (c *ConfigSchema) ValidateCreate() error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := c.Revision.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate config schema is valid or not when update it.
+func (c *ConfigSchema) ValidateUpdate() error {
+	if c.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := c.Revision.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the config schema's info when delete it.
+func (c *ConfigSchema) ValidateDelete() error {
+	if c.ID <= 0 {
+		return errors.New("config schema id should be set")
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	return c.Attachment.Validate()
+}
+
+// ConfigSchemaSpec defines all the specifics for config schema set by user.
+type ConfigSchemaSpec struct {
+	ResourceType ConfigSchemaResource `json:"resource_type" gorm:"column:resource_type"`
+	// ConfigKey is the kv's key or the config file's absolute path, eg: /etc/app/config.json
+	ConfigKey  string           `json:"config_key" gorm:"column:config_key"`
+	SchemaType ConfigSchemaType `json:"schema_type" gorm:"column:schema_type"`
+	// Schema is the json schema document, the regular expression, or the range which is
+	// formatted as "min,max" and either side can be empty, eg: "1,100", "0,", ",1.5"
+	Schema string `json:"schema" gorm:"column:schema"`
+	Memo   string `json:"memo" gorm:"column:memo"`
+}
+
+// ValidateCreate validate config schema spec when it is created.
+func (c *ConfigSchemaSpec) ValidateCreate() error {
+	if err := c.ResourceType.Validate(); err != nil {
+		return err
+	}
+
+	switch c.ResourceType {
+	case KvSchemaResource:
+		if err := validator.ValidateName(c.ConfigKey); err != nil {
+			return fmt.Errorf("invalid kv key, %v", err)
+		}
+	case CISchemaResource:
+		if !path.IsAbs(c.ConfigKey) {
+			return fmt.Errorf("config file path %s should be an absolute path", c.ConfigKey)
+		}
+		if err := validator.ValidateFileName(path.Base(c.ConfigKey)); err != nil {
+			return err
+		}
+		if c.SchemaType != JSONSchema {
+			return fmt.Errorf("only %s schema is supported for config file", JSONSchema)
+		}
+	}
+
+	return c.ValidateUpdate()
+}
+
+// ValidateUpdate validate config schema spec when it is updated.
+func (c *ConfigSchemaSpec) ValidateUpdate() error {
+	if err := c.SchemaType.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.Schema) == 0 {
+		return errors.New("schema is required")
+	}
+
+	if len(c.Schema) > maxSchemaLength {
+		return fmt.Errorf("schema length should not exceed %d", maxSchemaLength)
+	}
+
+	if err := c.compile(); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(c.Memo, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// compile checks the schema is well-formed.
+func (c *ConfigSchemaSpec) compile() error {
+	switch c.SchemaType {
+	case JSONSchema:
+		_, err := loadJSONSchema(c.Schema)
+		return err
+	case RegexSchema:
+		if _, err := regexp.Compile(c.Schema); err != nil {
+			return fmt.Errorf("invalid regular expression, %v", err)
+		}
+	case RangeSchema:
+		if _, _, err := parseRange(c.Schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckKv checks the kv value satisfies the schema.
+func (c *ConfigSchemaSpec) CheckKv(kvType DataType, value string) error {
+	switch c.SchemaType {
+	case JSONSchema:
+		if kvType != KvJson && kvType != KvYAML {
+			return fmt.Errorf("%s schema is not applicable to %s kv", c.SchemaType, kvType)
+		}
+		return c.CheckContent([]byte(value))
+	case RegexSchema:
+		if kvType != KvStr && kvType != KvText {
+			return fmt.Errorf("%s schema is not applicable to %s kv", c.SchemaType, kvType)
+		}
+		re, err := regexp.Compile(c.Schema)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression, %v", err)
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("value does not match the regular expression %s", c.Schema)
+		}
+	case RangeSchema:
+		if kvType != KvNumber {
+			return fmt.Errorf("%s schema is not applicable to %s kv", c.SchemaType, kvType)
+		}
+		return checkRange(c.Schema, value)
+	default:
+		return fmt.Errorf("unsupported schema type: %s", c.SchemaType)
+	}
+
+	return nil
+}
+
+// CheckContent checks the json or yaml content satisfies the json schema.
+func (c *ConfigSchemaSpec) CheckContent(content []byte) error {
+	if c.SchemaType != JSONSchema {
+		return fmt.Errorf("%s schema is not applicable to config file", c.SchemaType)
+	}
+
+	schema, err := loadJSONSchema(c.Schema)
+	if err != nil {
+		return err
+	}
+
+	var doc gojsonschema.JSONLoader
+	if json.Valid(content) {
+		doc = gojsonschema.NewBytesLoader(content)
+	} else {
+		// yaml is the superset of json, the content is treated as yaml if it is not a json
+		var data interface{}
+		if err = yaml.Unmarshal(content, &data); err != nil {
+			return fmt.Errorf("content is neither a json nor a yaml, err: %v", err)
+		}
+		doc = gojsonschema.NewGoLoader(data)
+	}
+
+	result, err := schema.Validate(doc)
+	if err != nil {
+		return fmt.Errorf("validate content with json schema failed, err: %v", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		msgs = append(msgs, e.String())
+	}
+	return fmt.Errorf("content does not match the json schema: %s", strings.Join(msgs, "; "))
+}
+
+// loadJSONSchema loads the json schema, the references to external documents are not allowed, so that
+// validating a schema never fetches anything from the network or the file system.
+func loadJSONSchema(schema string) (*gojsonschema.Schema, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return nil, fmt.Errorf("json schema is not a json, err: %v", err)
+	}
+
+	if err := checkLocalRefs(doc); err != nil {
+		return nil, err
+	}
+
+	s, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc))
+	if err != nil {
+		return nil, fmt.Errorf("invalid json schema, err: %v", err)
+	}
+
+	return s, nil
+}
+
+// checkLocalRefs checks all the $ref in the json schema refer to the schema itself.
+func checkLocalRefs(doc interface{}) error {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if key == "$ref" {
+				ref, ok := val.(string)
+				if !ok || !strings.HasPrefix(ref, "#") {
+					return fmt.Errorf("json schema $ref %v is not allowed, only local reference is supported", val)
+				}
+			}
+			if err := checkLocalRefs(val); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if err := checkLocalRefs(val); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseRange parses the range formatted as "min,max", nil means the side is unbounded.
+func parseRange(r string) (lower, upper *float64, err error) {
+	parts := strings.Split(r, ",")
+	if len(parts) != 2 {
+		return nil, nil, fmt.Errorf("invalid range %s, it should be formatted as min,max", r)
+	}
+
+	bounds := make([]*float64, 2)
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		f, e := strconv.ParseFloat(p, 64)
+		if e != nil {
+			return nil, nil, fmt.Errorf("invalid range %s, %s is not a number", r, p)
+		}
+		bounds[i] = &f
+	}
+
+	if bounds[0] == nil && bounds[1] == nil {
+		return nil, nil, fmt.Errorf("invalid range %s, at least one side should be set", r)
+	}
+	if bounds[0] != nil && bounds[1] != nil && *bounds[0] > *bounds[1] {
+		return nil, nil, fmt.Errorf("invalid range %s, min is greater than max", r)
+	}
+
+	return bounds[0], bounds[1], nil
+}
+
+// checkRange checks the number value is in the range.
+func checkRange(r, value string) error {
+	lower, upper, err := parseRange(r)
+	if err != nil {
+		return err
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return fmt.Errorf("value %s is not a number", value)
+	}
+
+	if lower != nil && f < *lower {
+		return fmt.Errorf("value %s is less than the min %v", value, *lower)
+	}
+	if upper != nil && f > *upper {
+		return fmt.Errorf("value %s is greater than the max %v", value, *upper)
+	}
+
+	return nil
+}
+
+const (
+	// KvSchemaResource is the schema for kv.
+	KvSchemaResource ConfigSchemaResource = "kv"
+	// CISchemaResource is the schema for config file.
+	CISchemaResource ConfigSchemaResource = "config_item"
+)
+
+// ConfigSchemaResource is the resource type which the schema is attached to.
+type ConfigSchemaResource string
+
+// Validate the config schema resource type is supported or not.
+func (r ConfigSchemaResource) Validate() error {
+	switch r {
+	case KvSchemaResource:
+	case CISchemaResource:
+	default:
+		return fmt.Errorf("unsupported config schema resource type: %s", r)
+	}
+
+	return nil
+}
+
+const (
+	// JSONSchema validates the json/yaml kv or config file with json schema.
+	JSONSchema ConfigSchemaType = "json_schema"
+	// RegexSchema validates the string/text kv with regular expression.
+	RegexSchema ConfigSchemaType = "regex"
+	// RangeSchema validates the number kv with range.
+	RangeSchema ConfigSchemaType = "range"
+)
+
+// ConfigSchemaType is the type of config schema.
+type ConfigSchemaType string
+
+// Validate the config schema type is supported or not.
+func (t ConfigSchemaType) Validate() error {
+	switch t {
+	case JSONSchema:
+	case RegexSchema:
+	case RangeSchema:
+	default:
+		return fmt.Errorf("unsupported config schema type: %s", t)
+	}
+
+	return nil
+}
+
+// ConfigSchemaAttachment defines the config schema attachments.
+type ConfigSchemaAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether config schema attachment is valid or not.
+func (c *ConfigSchemaAttachment) Validate() error {
+	if c.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if c.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
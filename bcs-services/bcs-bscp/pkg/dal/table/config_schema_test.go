@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestConfigSchemaCheckKv(t *testing.T) {
+	jsonSchema := `{"type": "object", "required": ["port"],
+		"properties": {"port": {"type": "integer", "minimum": 1, "maximum": 65535}}}`
+
+	tests := []struct {
+		name    string
+		spec    *ConfigSchemaSpec
+		kvType  DataType
+		value   string
+		wantErr bool
+	}{
+		{name: "json matched", spec: &ConfigSchemaSpec{SchemaType: JSONSchema, Schema: jsonSchema},
+			kvType: KvJson, value: `{"port": 8080}`},
+		{name: "json out of range", spec: &ConfigSchemaSpec{SchemaType: JSONSchema, Schema: jsonSchema},
+			kvType: KvJson, value: `{"port": 0}`, wantErr: true},
+		{name: "yaml matched", spec: &ConfigSchemaSpec{SchemaType: JSONSchema, Schema: jsonSchema},
+			kvType: KvYAML, value: "port: 80\n"},
+		{name: "yaml missing required", spec: &ConfigSchemaSpec{SchemaType: JSONSchema, Schema: jsonSchema},
+			kvType: KvYAML, value: "host: a\n", wantErr: true},
+		{name: "json schema for string kv", spec: &ConfigSchemaSpec{SchemaType: JSONSchema, Schema: jsonSchema},
+			kvType: KvStr, value: "a", wantErr: true},
+		{name: "regex matched", spec: &ConfigSchemaSpec{SchemaType: RegexSchema, Schema: `^v\d+$`},
+			kvType: KvStr, value: "v1"},
+		{name: "regex not matched", spec: &ConfigSchemaSpec{SchemaType: RegexSchema, Schema: `^v\d+$`},
+			kvType: KvStr, value: "x1", wantErr: true},
+		{name: "range matched", spec: &ConfigSchemaSpec{SchemaType: RangeSchema, Schema: "1,100"},
+			kvType: KvNumber, value: "100"},
+		{name: "range lower only", spec: &ConfigSchemaSpec{SchemaType: RangeSchema, Schema: "0,"},
+			kvType: KvNumber, value: "-1", wantErr: true},
+		{name: "range upper only", spec: &ConfigSchemaSpec{SchemaType: RangeSchema, Schema: ",1.5"},
+			kvType: KvNumber, value: "1.6", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.spec.CheckKv(tt.kvType, tt.value); (err != nil) != tt.wantErr {
+			t.Errorf("%s: check kv err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConfigSchemaValidateCreate(t *testing.T) {
+	invalids := []*ConfigSchemaSpec{
+		{ResourceType: KvSchemaResource, ConfigKey: "port", SchemaType: RangeSchema, Schema: "10,1"},
+		{ResourceType: KvSchemaResource, ConfigKey: "port", SchemaType: RangeSchema, Schema: ","},
+		{ResourceType: KvSchemaResource, ConfigKey: "name", SchemaType: RegexSchema, Schema: "("},
+		{ResourceType: CISchemaResource, ConfigKey: "app.json", SchemaType: JSONSchema, Schema: "{}"},
+		{ResourceType: CISchemaResource, ConfigKey: "/etc/app.json", SchemaType: RegexSchema, Schema: ".*"},
+		{ResourceType: CISchemaResource, ConfigKey: "/etc/app.json", SchemaType: JSONSchema,
+			Schema: `{"$ref": "http://example.com/schema.json"}`},
+	}
+	for _, spec := range invalids {
+		if err := spec.ValidateCreate(); err == nil {
+			t.Errorf("config schema %+v should be invalid", spec)
+		}
+	}
+
+	valid := &ConfigSchemaSpec{ResourceType: CISchemaResource, ConfigKey: "/etc/app.json", SchemaType: JSONSchema,
+		Schema: `{"definitions": {"p": {"type": "integer"}}, "properties": {"port": {"$ref": "#/definitions/p"}}}`}
+	if err := valid.ValidateCreate(); err != nil {
+		t.Errorf("config schema should be valid, err: %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
+)
+
+const (
+	// maxGitURLLength is the max length of the git repository url.
+	maxGitURLLength = 1024
+	// maxGitRefLength is the max length of the git reference.
+	maxGitRefLength = 255
+	// maxGitPathLength is the max length of the path in git repository.
+	maxGitPathLength = 1024
+	// maxGitTokenLength is the max length of the git access token.
+	maxGitTokenLength = 256
+	// DefaultGitSyncIntervalSec is the default interval of the git source synchronization.
+	DefaultGitSyncIntervalSec = 300
+	// minGitSyncIntervalSec is the min interval of the git source synchronization.
+	minGitSyncIntervalSec = 60
+	// DefaultGitRef is the default git reference to be synchronized.
+	DefaultGitRef = "master"
+)
+
+// GitSyncState is the state of the git source's last synchronization.
+type GitSyncState string
+
+const (
+	// GitSyncSucceed means the last synchronization is succeed.
+	GitSyncSucceed GitSyncState = "succeed"
+	// GitSyncFailed means the last synchronization is failed.
+	GitSyncFailed GitSyncState = "failed"
+	// GitSyncConflict means the editing state is modified manually since the last synchronization, the
+	// synchronization is paused until it is forced.
+	GitSyncConflict GitSyncState = "conflict"
+	// GitSyncing means the git source is being synchronized, the other synchronizations of the git source are
+	// refused until it is finished or stale.
+	GitSyncing GitSyncState = "syncing"
+)
+
+// GitSource git 配置源, defines the git repository which an app's config items or kvs are synchronized from.
+// for file app, the files under the path are synchronized as the config items, the path of the config item is
+// the file's relative directory to the path. for kv app, the files under the path are synchronized as the kvs,
+// the file name is the key, and the file extension decides the kv type.
+type GitSource struct {
+	ID         uint32               `json:"id" gorm:"primaryKey"`
+	Spec       *GitSourceSpec       `json:"spec" gorm:"embedded"`
+	Status     *GitSourceStatus     `json:"status" gorm:"embedded"`
+	Attachment *GitSourceAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision            `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the git source's database table name.
+func (g *GitSource) TableName() string {
+	return "git_sources"
+}
+
+// AppID AuditRes interface
+func (g *GitSource) AppID() uint32 {
+	return g.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (g *GitSource) ResID() uint32 {
+	return g.ID
+}
+
+// ResType AuditRes interface
+func (g *GitSource) ResType() string {
+	return "git_source"
+}
+
+// ValidateCreate validate git source is valid or not when create it.
+func (g *GitSource) ValidateCreate() error {
+	if g.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if g.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := g.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if g.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := g.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if g.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := g.Revision.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate git source is valid or not when update it.
+func (g *GitSource) ValidateUpdate() error {
+	if g.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if g.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := g.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if g.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := g.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if g.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := g.Revision.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the git source's info when delete it.
+func (g *GitSource) ValidateDelete() error {
+	if g.ID <= 0 {
+		return errors.New("git source id should be set")
+	}
+
+	if g.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	return g.Attachment.Validate()
+}
+
+// SyncDue returns whether the git source should be synchronized at the time.
+func (g *GitSource) SyncDue(now time.Time) bool {
+	if !g.Spec.Enabled {
+		return false
+	}
+
+	if g.Status == nil || g.Status.SyncedAt == nil {
+		return true
+	}
+
+	// the conflict is resolved by forcing the synchronization manually.
+	if g.Status.SyncState == GitSyncConflict {
+		return false
+	}
+
+	interval := g.Spec.IntervalSec
+	if interval == 0 {
+		interval = DefaultGitSyncIntervalSec
+	}
+	return !now.Before(g.Status.SyncedAt.Add(time.Duration(interval) * time.Second))
+}
+
+// GitSourceSpec defines all the specifics for git source.
+type GitSourceSpec struct {
+	// URL is the http or https url of the git repository.
+	URL string `json:"url" gorm:"column:url"`
+	// Ref is the branch or tag to be synchronized, default is master.
+	Ref string `json:"ref" gorm:"column:ref"`
+	// Path is the directory in the git repository to be synchronized, default is the root directory.
+	Path string `json:"path" gorm:"column:path"`
+	// Username and Token are used to access the private git repository with http basic auth.
+	Username string `json:"username" gorm:"column:username"`
+	Token    string `json:"token" gorm:"column:token"`
+	// AutoRelease means generating a release after the changes are imported.
+	AutoRelease bool `json:"auto_release" gorm:"column:auto_release"`
+	// IntervalSec is the interval of the synchronization in seconds.
+	IntervalSec uint32 `json:"interval_sec" gorm:"column:interval_sec"`
+	Enabled     bool   `json:"enabled" gorm:"column:enabled"`
+	Memo        string `json:"memo" gorm:"column:memo"`
+}
+
+// Validate git source spec.
+func (g *GitSourceSpec) Validate() error {
+	if len(g.URL) > maxGitURLLength {
+		return fmt.Errorf("git url should <= %d", maxGitURLLength)
+	}
+
+	u, err := url.Parse(g.URL)
+	if err != nil {
+		return fmt.Errorf("invalid git url, err: %v", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("git url should be a http or https url")
+	}
+
+	if len(g.Ref) > maxGitRefLength {
+		return fmt.Errorf("git ref should <= %d", maxGitRefLength)
+	}
+
+	if len(g.Path) > maxGitPathLength {
+		return fmt.Errorf("git path should <= %d", maxGitPathLength)
+	}
+	for _, one := range strings.Split(g.Path, "/") {
+		if one == ".." {
+			return errors.New("git path should not contain '..'")
+		}
+	}
+
+	if len(g.Token) > maxGitTokenLength {
+		return fmt.Errorf("git token should <= %d", maxGitTokenLength)
+	}
+
+	if g.IntervalSec != 0 && g.IntervalSec < minGitSyncIntervalSec {
+		return fmt.Errorf("git sync interval should >= %d seconds", minGitSyncIntervalSec)
+	}
+
+	return validator.ValidateMemo(g.Memo, false)
+}
+
+// RefOrDefault returns the git reference to be synchronized.
+func (g *GitSourceSpec) RefOrDefault() string {
+	if g.Ref == "" {
+		return DefaultGitRef
+	}
+	return g.Ref
+}
+
+// CleanPath returns the cleaned directory in the git repository, the root directory is returned as empty.
+func (g *GitSourceSpec) CleanPath() string {
+	return strings.Trim(path.Clean("/"+g.Path), "/")
+}
+
+// GitSourceStatus defines the status of the git source's last synchronization.
+type GitSourceStatus struct {
+	// LastCommit is the commit sha which is synchronized last time.
+	LastCommit string `json:"last_commit" gorm:"column:last_commit"`
+	// LastReleaseID is the release generated by the last synchronization.
+	LastReleaseID uint32       `json:"last_release_id" gorm:"column:last_release_id"`
+	SyncState     GitSyncState `json:"sync_state" gorm:"column:sync_state"`
+	SyncMessage   string       `json:"sync_message" gorm:"column:sync_message"`
+	SyncedAt      *time.Time   `json:"synced_at" gorm:"column:synced_at"`
+	// Fingerprint is the digest of the editing state after the last synchronization, it is used to detect
+	// the manual edits since then.
+	Fingerprint string `json:"fingerprint" gorm:"column:fingerprint"`
+}
+
+// GitSourceAttachment defines the git source attachments.
+type GitSourceAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether git source attachment is valid or not.
+func (g *GitSourceAttachment) Validate() error {
+	if g.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if g.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
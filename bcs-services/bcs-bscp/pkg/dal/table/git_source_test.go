@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGitSourceSpecValidate(t *testing.T) {
+	valid := &GitSourceSpec{
+		URL:         "https://git.example.com/bscp/configs.git",
+		Ref:         "release",
+		Path:        "prod/app",
+		IntervalSec: 120,
+		Enabled:     true,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("validate git source spec failed, err: %v", err)
+	}
+
+	invalids := []*GitSourceSpec{
+		{URL: "git@git.example.com:bscp/configs.git"},
+		{URL: "ssh://git.example.com/bscp/configs.git"},
+		{URL: "https://git.example.com/bscp/configs.git", Path: "../etc"},
+		{URL: "https://git.example.com/bscp/configs.git", IntervalSec: 10},
+	}
+	for _, spec := range invalids {
+		if err := spec.Validate(); err == nil {
+			t.Errorf("git source spec %+v should be invalid", spec)
+		}
+	}
+}
+
+func TestGitSourceSpecCleanPath(t *testing.T) {
+	cases := map[string]string{
+		"":           "",
+		"/":          "",
+		"./":         "",
+		"prod/app/":  "prod/app",
+		"/prod//app": "prod/app",
+	}
+	for p, expected := range cases {
+		spec := &GitSourceSpec{Path: p}
+		if got := spec.CleanPath(); got != expected {
+			t.Errorf("clean path of %q should be %q, but got %q", p, expected, got)
+		}
+	}
+}
+
+func TestGitSourceSyncDue(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-time.Minute)
+	past := now.Add(-time.Hour)
+
+	never := &GitSource{Spec: &GitSourceSpec{Enabled: true}, Status: &GitSourceStatus{}}
+	if !never.SyncDue(now) {
+		t.Errorf("git source never synchronized should be due")
+	}
+
+	notYet := &GitSource{Spec: &GitSourceSpec{Enabled: true},
+		Status: &GitSourceStatus{SyncState: GitSyncSucceed, SyncedAt: &recent}}
+	if notYet.SyncDue(now) {
+		t.Errorf("git source synchronized within the default interval should not be due")
+	}
+
+	elapsed := &GitSource{Spec: &GitSourceSpec{Enabled: true},
+		Status: &GitSourceStatus{SyncState: GitSyncFailed, SyncedAt: &past}}
+	if !elapsed.SyncDue(now) {
+		t.Errorf("git source whose interval is elapsed should be due")
+	}
+
+	conflict := &GitSource{Spec: &GitSourceSpec{Enabled: true},
+		Status: &GitSourceStatus{SyncState: GitSyncConflict, SyncedAt: &past}}
+	if conflict.SyncDue(now) {
+		t.Errorf("git source in conflict state should not be due")
+	}
+
+	disabled := &GitSource{Spec: &GitSourceSpec{}, Status: &GitSourceStatus{}}
+	if disabled.SyncDue(now) {
+		t.Errorf("disabled git source should not be due")
+	}
+}
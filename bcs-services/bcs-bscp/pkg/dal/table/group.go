@@ -18,6 +18,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/selector"
 )
 
@@ -156,6 +157,7 @@ var GroupSpecColumnDescriptor = ColumnDescriptors{
 	{Column: "mode", NamedC: "mode", Type: enumor.String},
 	{Column: "selector", NamedC: "selector", Type: enumor.String},
 	{Column: "uid", NamedC: "uid", Type: enumor.String},
+	{Column: "variables", NamedC: "variables", Type: enumor.String},
 }
 
 // GroupSpec defines all the specifics for group set by user.
@@ -167,6 +169,9 @@ type GroupSpec struct {
 	Mode     GroupMode          `db:"mode" json:"mode" gorm:"column:mode"`
 	Selector *selector.Selector `db:"selector" json:"selector" gorm:"column:selector;type:json"`
 	UID      string             `db:"uid" json:"uid" gorm:"column:uid"`
+	// Variables are the group level template variables, they override the release variables with
+	// the same name when the release is published to the group.
+	Variables AppVariables `db:"variables" json:"variables" gorm:"column:variables;type:json"`
 }
 
 const (
@@ -229,6 +234,25 @@ func (g GroupSpec) ValidateCreate() error {
 	return nil
 }
 
+// ValidateVariables validate the group level template variables.
+func (g GroupSpec) ValidateVariables(kit *kit.Kit) error {
+	exists := make(map[string]bool, len(g.Variables))
+	for _, v := range g.Variables {
+		if v == nil {
+			return errors.New("group variable can not be nil")
+		}
+		if err := v.ValidateCreate(kit); err != nil {
+			return err
+		}
+		if exists[v.Name] {
+			return fmt.Errorf("group variable %s is duplicated", v.Name)
+		}
+		exists[v.Name] = true
+	}
+
+	return nil
+}
+
 // ValidateUpdate validate group spec when it is updated.
 func (g GroupSpec) ValidateUpdate() error {
 	if err := validator.ValidateName(g.Name); err != nil {
@@ -0,0 +1,267 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/types"
+)
+
+const (
+	// DefaultApprovalExpireHours is the default expire hours of a publish approval.
+	DefaultApprovalExpireHours = 24
+	// MaxApprovalExpireHours is the max expire hours of a publish approval.
+	MaxApprovalExpireHours = 7 * 24
+)
+
+// PublishApproval 上线审批, a publish request which only takes effect after it is approved.
+type PublishApproval struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *PublishApprovalSpec       `json:"spec" gorm:"embedded"`
+	Attachment *PublishApprovalAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the publish approval's database table name.
+func (p *PublishApproval) TableName() string {
+	return "publish_approvals"
+}
+
+// AppID AuditRes interface
+func (p *PublishApproval) AppID() uint32 {
+	return p.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (p *PublishApproval) ResID() uint32 {
+	return p.ID
+}
+
+// ResType AuditRes interface
+func (p *PublishApproval) ResType() string {
+	return "publish_approval"
+}
+
+// ValidateCreate validate publish approval is valid or not when create it.
+func (p *PublishApproval) ValidateCreate() error {
+	if p.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if p.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := p.Spec.ValidateCreate(p.Revision); err != nil {
+		return err
+	}
+
+	if p.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := p.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if p.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := p.Revision.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsExpired returns whether the publish approval is expired at the given time.
+func (p *PublishApproval) IsExpired(now time.Time) bool {
+	return p.Spec.Status == ApprovalPending && now.After(p.Spec.ExpireAt)
+}
+
+// ValidateReview validate whether the user can approve or reject the publish approval.
+// the publish approval can not be reviewed by its submitter, which makes sure that
+// every publish is confirmed by two people.
+func (p *PublishApproval) ValidateReview(user string) error {
+	if p.Spec.Status != ApprovalPending {
+		return fmt.Errorf("publish approval %d is %s, can not be reviewed", p.ID, p.Spec.Status)
+	}
+
+	if p.IsExpired(time.Now()) {
+		return fmt.Errorf("publish approval %d is expired at %s", p.ID, p.Spec.ExpireAt.Format(time.RFC3339))
+	}
+
+	if p.Revision != nil && p.Revision.Creator == user {
+		return fmt.Errorf("publish approval %d can not be reviewed by its submitter", p.ID)
+	}
+
+	for _, one := range p.Spec.Approvers {
+		if one == user {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not the approver of publish approval %d", user, p.ID)
+}
+
+// PublishApprovalSpec defines all the specifics for publish approval.
+type PublishApprovalSpec struct {
+	ReleaseID uint32            `json:"release_id" gorm:"column:release_id"`
+	All       bool              `json:"all" gorm:"column:publish_all"`
+	AsDefault bool              `json:"as_default" gorm:"column:as_default"`
+	Memo      string            `json:"memo" gorm:"column:memo"`
+	Groups    types.Uint32Slice `json:"groups" gorm:"column:group_ids;type:json;default:'[]'"`
+	Approvers types.StringSlice `json:"approvers" gorm:"column:approvers;type:json;default:'[]'"`
+	Diff      PublishDiff       `json:"diff" gorm:"column:diff;type:json;default:'[]'"`
+	Status    ApprovalStatus    `json:"status" gorm:"column:status"`
+	// StrategyID is the published strategy id after the publish approval is approved.
+	StrategyID uint32    `json:"strategy_id" gorm:"column:strategy_id"`
+	ExpireAt   time.Time `json:"expire_at" gorm:"column:expire_at"`
+	// Reason is the reason why the publish approval is rejected or expired.
+	Reason string `json:"reason" gorm:"column:reason"`
+}
+
+// ValidateCreate validate publish approval spec when it is created.
+func (p *PublishApprovalSpec) ValidateCreate(revision *Revision) error {
+	if p.ReleaseID <= 0 {
+		return errors.New("invalid release id")
+	}
+
+	if !p.All && len(p.Groups) == 0 {
+		return errors.New("publish groups not set")
+	}
+
+	if p.Status != ApprovalPending {
+		return fmt.Errorf("publish approval status should be %s", ApprovalPending)
+	}
+
+	if p.ExpireAt.IsZero() {
+		return errors.New("expire time not set")
+	}
+
+	approvers := 0
+	for _, one := range p.Approvers {
+		if revision != nil && one == revision.Creator {
+			continue
+		}
+		approvers++
+	}
+	if approvers == 0 {
+		return errors.New("publish approval needs at least one approver other than the submitter")
+	}
+
+	return nil
+}
+
+// PublishApprovalAttachment defines the publish approval attachments.
+type PublishApprovalAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether publish approval attachment is valid or not.
+func (p *PublishApprovalAttachment) Validate() error {
+	if p.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if p.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// ApprovalStatus is the status of publish approval.
+type ApprovalStatus string
+
+const (
+	// ApprovalPending waiting for the approvers to approve.
+	ApprovalPending ApprovalStatus = "pending"
+	// ApprovalApproved approved and the release is published.
+	ApprovalApproved ApprovalStatus = "approved"
+	// ApprovalRejected rejected by the approver.
+	ApprovalRejected ApprovalStatus = "rejected"
+	// ApprovalExpired not reviewed before it is expired.
+	ApprovalExpired ApprovalStatus = "expired"
+)
+
+// String returns approval status string.
+func (s ApprovalStatus) String() string {
+	return string(s)
+}
+
+// Validate the approval status is valid or not.
+func (s ApprovalStatus) Validate() error {
+	switch s {
+	case ApprovalPending:
+	case ApprovalApproved:
+	case ApprovalRejected:
+	case ApprovalExpired:
+	default:
+		return fmt.Errorf("unknown %s approval status", s)
+	}
+
+	return nil
+}
+
+// PublishDiff is the difference between the release to be published and
+// the releases which are currently published to the target groups.
+type PublishDiff []*ReleaseDiff
+
+// ReleaseDiff is the difference between the release to be published and one base release.
+type ReleaseDiff struct {
+	// BaseReleaseID is the release currently published to the groups, 0 means never published.
+	BaseReleaseID uint32   `json:"base_release_id"`
+	GroupIDs      []uint32 `json:"group_ids"`
+	Added         []string `json:"added"`
+	Modified      []string `json:"modified"`
+	Deleted       []string `json:"deleted"`
+}
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (d PublishDiff) Value() (driver.Value, error) {
+	if d == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (d *PublishDiff) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, d)
+	case string:
+		return json.Unmarshal([]byte(v), d)
+	default:
+		return errors.New("unsupported Scan type for PublishDiff")
+	}
+}
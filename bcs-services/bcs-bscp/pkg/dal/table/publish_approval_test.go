@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestPublishApproval() *PublishApproval {
+	return &PublishApproval{
+		Spec: &PublishApprovalSpec{
+			ReleaseID: 1,
+			Groups:    []uint32{1},
+			Approvers: []string{"alice", "bob"},
+			Status:    ApprovalPending,
+			ExpireAt:  time.Now().Add(time.Hour),
+		},
+		Attachment: &PublishApprovalAttachment{BizID: 1, AppID: 1},
+		Revision:   &Revision{Creator: "alice", Reviser: "alice"},
+	}
+}
+
+func TestPublishApprovalValidateCreate(t *testing.T) {
+	pa := newTestPublishApproval()
+	if err := pa.ValidateCreate(); err != nil {
+		t.Errorf("validate publish approval failed, err: %v", err)
+	}
+
+	// the submitter can not be the only approver
+	pa.Spec.Approvers = []string{"alice"}
+	if err := pa.ValidateCreate(); err == nil {
+		t.Errorf("publish approval without other approvers should be invalid")
+	}
+}
+
+func TestPublishApprovalValidateReview(t *testing.T) {
+	pa := newTestPublishApproval()
+	pa.ID = 1
+
+	if err := pa.ValidateReview("bob"); err != nil {
+		t.Errorf("approver should be able to review, err: %v", err)
+	}
+
+	if err := pa.ValidateReview("alice"); err == nil {
+		t.Errorf("submitter should not be able to review")
+	}
+
+	if err := pa.ValidateReview("carol"); err == nil {
+		t.Errorf("non approver should not be able to review")
+	}
+
+	pa.Spec.ExpireAt = time.Now().Add(-time.Minute)
+	if err := pa.ValidateReview("bob"); err == nil {
+		t.Errorf("expired publish approval should not be able to review")
+	}
+
+	pa.Spec.ExpireAt = time.Now().Add(time.Hour)
+	pa.Spec.Status = ApprovalRejected
+	if err := pa.ValidateReview("bob"); err == nil {
+		t.Errorf("rejected publish approval should not be able to review")
+	}
+}
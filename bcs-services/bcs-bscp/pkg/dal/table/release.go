@@ -123,6 +123,8 @@ type ReleaseSpec struct {
 	PublishNum uint32 `db:"publish_num" json:"publish_num"`
 	// 是否全量发布过
 	FullyReleased bool `db:"fully_released" json:"fully_released"`
+	// SourceCommit is the commit sha of the git source which the release is generated from.
+	SourceCommit string `db:"source_commit" json:"source_commit"`
 }
 
 // Validate a release specifics when it is created.
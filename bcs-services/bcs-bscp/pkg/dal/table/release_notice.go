@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/types"
+)
+
+// ReleaseNotice 发布事件通知, records a release event with the same transaction of the release operation,
+// it is dispatched to the message queue and the subscribed webhooks asynchronously.
+type ReleaseNotice struct {
+	ID         uint32                   `json:"id" gorm:"primaryKey"`
+	Spec       *ReleaseNoticeSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ReleaseNoticeAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision         `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the release notice's database table name.
+func (r *ReleaseNotice) TableName() string {
+	return "release_notices"
+}
+
+// ValidateCreate validate release notice is valid or not when create it.
+func (r *ReleaseNotice) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := r.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := r.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return r.Revision.Validate()
+}
+
+// ReleaseNoticeSpec defines all the specifics for release notice.
+type ReleaseNoticeSpec struct {
+	Event     ReleaseEvent `json:"event" gorm:"column:event"`
+	ReleaseID uint32       `json:"release_id" gorm:"column:release_id"`
+	// FromReleaseID is the release compared with to summarize the changed items, it is the release rolled
+	// back from for the rollback event, and the previous release of the app for the other events.
+	FromReleaseID uint32 `json:"from_release_id" gorm:"column:from_release_id"`
+	// All means the release is published to all the instances, otherwise it is published to the groups.
+	All    bool              `json:"all" gorm:"column:publish_all"`
+	Groups types.Uint32Slice `json:"groups" gorm:"column:group_ids;type:json;default:'[]'"`
+	Memo   string            `json:"memo" gorm:"column:memo"`
+	State  NoticeState       `json:"state" gorm:"column:state"`
+	// Attempts is the times to publish the notice to the message queue.
+	Attempts uint32 `json:"attempts" gorm:"column:attempts"`
+}
+
+// ValidateCreate validate release notice spec when it is created.
+func (r *ReleaseNoticeSpec) ValidateCreate() error {
+	if err := r.Event.Validate(); err != nil {
+		return err
+	}
+
+	if r.ReleaseID <= 0 {
+		return errors.New("invalid release id")
+	}
+
+	if r.State != NoticePending {
+		return fmt.Errorf("release notice state should be %s", NoticePending)
+	}
+
+	return nil
+}
+
+// ReleaseNoticeAttachment defines the release notice attachments.
+type ReleaseNoticeAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether release notice attachment is valid or not.
+func (r *ReleaseNoticeAttachment) Validate() error {
+	if r.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if r.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// ReleaseEvent is the event happened to a release.
+type ReleaseEvent string
+
+const (
+	// ReleasePublished the release is published to the instances.
+	ReleasePublished ReleaseEvent = "publish"
+	// ReleaseDeprecated the release is deprecated.
+	ReleaseDeprecated ReleaseEvent = "deprecate"
+	// ReleaseRolledBack the instances are rolled back to the release.
+	ReleaseRolledBack ReleaseEvent = "rollback"
+)
+
+// Validate the release event is valid or not.
+func (e ReleaseEvent) Validate() error {
+	switch e {
+	case ReleasePublished:
+	case ReleaseDeprecated:
+	case ReleaseRolledBack:
+	default:
+		return fmt.Errorf("unknown %s release event", e)
+	}
+
+	return nil
+}
+
+// NoticeState is the dispatch state of the release notice.
+type NoticeState string
+
+const (
+	// NoticePending the notice is waiting to be dispatched.
+	NoticePending NoticeState = "pending"
+	// NoticeDispatched the notice is published to the message queue and the webhook deliveries are created.
+	NoticeDispatched NoticeState = "dispatched"
+	// NoticeFailed the notice is failed to be dispatched after the max attempts.
+	NoticeFailed NoticeState = "failed"
+)
+
+// String returns notice state string.
+func (s NoticeState) String() string {
+	return string(s)
+}
@@ -13,6 +13,8 @@
 package table
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -35,6 +37,7 @@ var ReleasedGroupColumnDescriptor = mergeColumnDescriptors("",
 		{Column: "selector", NamedC: "selector", Type: enumor.String},
 		{Column: "uid", NamedC: "uid", Type: enumor.String},
 		{Column: "edited", NamedC: "edited", Type: enumor.Boolean},
+		{Column: "rendered_contents", NamedC: "rendered_contents", Type: enumor.String},
 		{Column: "biz_id", NamedC: "biz_id", Type: enumor.Numeric},
 		{Column: "reviser", NamedC: "reviser", Type: enumor.String},
 		{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
@@ -56,6 +59,10 @@ type ReleasedGroup struct {
 	BizID      uint32             `db:"biz_id" json:"biz_id" gorm:"column:biz_id"`
 	Reviser    string             `db:"reviser" json:"reviser" gorm:"column:reviser"`
 	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
+
+	// RenderedContents are the config item contents rendered with the group variables,
+	// which override the release's rendered contents for the instances matched this group.
+	RenderedContents GroupRenderedContents `db:"rendered_contents" json:"rendered_contents" gorm:"column:rendered_contents;type:json"`
 }
 
 // TableName is the released group's database table name.
@@ -114,3 +121,44 @@ func (c ReleasedGroup) ValidateDelete() error {
 
 	return nil
 }
+
+// GroupRenderedContent is a released config item's content rendered with the group variables.
+type GroupRenderedContent struct {
+	ReleasedCIID uint32 `json:"released_ci_id"`
+	Signature    string `json:"signature"`
+	ByteSize     uint64 `json:"byte_size"`
+	Md5          string `json:"md5"`
+}
+
+// GroupRenderedContents is []*GroupRenderedContent
+type GroupRenderedContents []*GroupRenderedContent
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (g GroupRenderedContents) Value() (driver.Value, error) {
+	if g == nil {
+		g = GroupRenderedContents{}
+	}
+	data, err := json.Marshal(g)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (g *GroupRenderedContents) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, g)
+	case string:
+		return json.Unmarshal([]byte(v), g)
+	default:
+		return errors.New("unsupported Scan type for GroupRenderedContents")
+	}
+}
@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/types"
+)
+
+const (
+	// MaxRolloutStages is the max stages of a rollout plan.
+	MaxRolloutStages = 10
+	// DefaultRolloutObserveMinutes is the default observation window of each rollout stage.
+	DefaultRolloutObserveMinutes = 10
+	// DefaultRolloutFailureThreshold is the default failure rate threshold(percent) of each rollout stage.
+	DefaultRolloutFailureThreshold = 5
+)
+
+// DefaultRolloutStages is the default percentage of instances that each rollout stage publishes to.
+var DefaultRolloutStages = []uint32{1, 10, 50, 100}
+
+// RolloutPlan 渐进式发布计划, publish a release to a growing percentage of instances stage by stage,
+// and watch the instances' release change failure rate between stages.
+type RolloutPlan struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *RolloutPlanSpec       `json:"spec" gorm:"embedded"`
+	Attachment *RolloutPlanAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision              `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the rollout plan's database table name.
+func (r *RolloutPlan) TableName() string {
+	return "rollout_plans"
+}
+
+// AppID AuditRes interface
+func (r *RolloutPlan) AppID() uint32 {
+	return r.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (r *RolloutPlan) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *RolloutPlan) ResType() string {
+	return "rollout_plan"
+}
+
+// ValidateCreate validate rollout plan is valid or not when create it.
+func (r *RolloutPlan) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := r.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := r.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := r.Revision.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StagePercent returns the percentage of instances of the current stage.
+func (r *RolloutPlan) StagePercent() uint32 {
+	return r.Spec.Stages[r.Spec.CurrentStage]
+}
+
+// IsLastStage returns whether the plan is at its last stage, which publishes to all the instances.
+func (r *RolloutPlan) IsLastStage() bool {
+	return int(r.Spec.CurrentStage) == len(r.Spec.Stages)-1
+}
+
+// RolloutPlanSpec defines all the specifics for rollout plan.
+type RolloutPlanSpec struct {
+	ReleaseID uint32 `json:"release_id" gorm:"column:release_id"`
+	// PreviousReleaseID is the release fully published before the rollout, it is used to roll back.
+	PreviousReleaseID uint32 `json:"previous_release_id" gorm:"column:previous_release_id"`
+	// GroupID is the group which selects the instances by the percentage of the current stage.
+	GroupID uint32 `json:"group_id" gorm:"column:group_id"`
+	// Stages is the percentage of instances that each stage publishes to, the last one must be 100.
+	Stages       types.Uint32Slice `json:"stages" gorm:"column:stages;type:json;default:'[]'"`
+	CurrentStage uint32            `json:"current_stage" gorm:"column:current_stage"`
+	// ObserveMinutes is the minutes to watch the instances before promoting to the next stage.
+	ObserveMinutes uint32 `json:"observe_minutes" gorm:"column:observe_minutes"`
+	// MinClients is the minimum instances which have pulled the release before the stage can be promoted.
+	MinClients uint32 `json:"min_clients" gorm:"column:min_clients"`
+	// FailureThreshold is the max release change failure rate(percent) that a stage is allowed.
+	FailureThreshold float64              `json:"failure_threshold" gorm:"column:failure_threshold"`
+	OnFailure        RolloutFailurePolicy `json:"on_failure" gorm:"column:on_failure"`
+	Status           RolloutStatus        `json:"status" gorm:"column:status"`
+	StageStartedAt   time.Time            `json:"stage_started_at" gorm:"column:stage_started_at"`
+	Memo             string               `json:"memo" gorm:"column:memo"`
+	// Reason is the reason why the rollout plan is paused or rolled back.
+	Reason string `json:"reason" gorm:"column:reason"`
+}
+
+// ValidateCreate validate rollout plan spec when it is created.
+func (r *RolloutPlanSpec) ValidateCreate() error {
+	if r.ReleaseID <= 0 {
+		return errors.New("invalid release id")
+	}
+
+	if r.PreviousReleaseID <= 0 {
+		return errors.New("the app has no fully published release to roll back to")
+	}
+
+	if r.ReleaseID == r.PreviousReleaseID {
+		return errors.New("release is already fully published")
+	}
+
+	if err := ValidateRolloutStages(r.Stages); err != nil {
+		return err
+	}
+
+	if r.ObserveMinutes <= 0 {
+		return errors.New("observe minutes should > 0")
+	}
+
+	if r.FailureThreshold < 0 || r.FailureThreshold > 100 {
+		return errors.New("failure threshold should be in [0, 100]")
+	}
+
+	if err := r.OnFailure.Validate(); err != nil {
+		return err
+	}
+
+	if r.Status != RolloutRunning {
+		return fmt.Errorf("rollout plan status should be %s", RolloutRunning)
+	}
+
+	return nil
+}
+
+// ValidateRolloutStages validate the rollout stages, the percentages should be ascending and end with 100.
+func ValidateRolloutStages(stages []uint32) error {
+	if len(stages) == 0 {
+		return errors.New("rollout stages not set")
+	}
+
+	if len(stages) > MaxRolloutStages {
+		return fmt.Errorf("rollout stages should <= %d", MaxRolloutStages)
+	}
+
+	for i, one := range stages {
+		if one <= 0 || one > 100 {
+			return fmt.Errorf("invalid rollout stage percent %d, should be in (0, 100]", one)
+		}
+
+		if i > 0 && one <= stages[i-1] {
+			return errors.New("rollout stage percents should be ascending")
+		}
+	}
+
+	if stages[len(stages)-1] != 100 {
+		return errors.New("the last rollout stage percent should be 100")
+	}
+
+	return nil
+}
+
+// RolloutPlanAttachment defines the rollout plan attachments.
+type RolloutPlanAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether rollout plan attachment is valid or not.
+func (r *RolloutPlanAttachment) Validate() error {
+	if r.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if r.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// RolloutStatus is the status of rollout plan.
+type RolloutStatus string
+
+const (
+	// RolloutRunning the plan is publishing and watching the instances stage by stage.
+	RolloutRunning RolloutStatus = "running"
+	// RolloutPaused the plan is paused manually or by the failure rate, it waits to be resumed or rolled back.
+	RolloutPaused RolloutStatus = "paused"
+	// RolloutCompleted the release is published to all the instances.
+	RolloutCompleted RolloutStatus = "completed"
+	// RolloutRolledBack the instances are rolled back to the previous release.
+	RolloutRolledBack RolloutStatus = "rolled_back"
+)
+
+// String returns rollout status string.
+func (s RolloutStatus) String() string {
+	return string(s)
+}
+
+// Validate the rollout status is valid or not.
+func (s RolloutStatus) Validate() error {
+	switch s {
+	case RolloutRunning:
+	case RolloutPaused:
+	case RolloutCompleted:
+	case RolloutRolledBack:
+	default:
+		return fmt.Errorf("unknown %s rollout status", s)
+	}
+
+	return nil
+}
+
+// IsFinished returns whether the rollout plan is finished.
+func (s RolloutStatus) IsFinished() bool {
+	return s == RolloutCompleted || s == RolloutRolledBack
+}
+
+// RolloutFailurePolicy is the action to take when the failure rate of a stage exceeds the threshold.
+type RolloutFailurePolicy string
+
+const (
+	// RolloutPauseOnFailure pause the rollout plan and wait for the operator.
+	RolloutPauseOnFailure RolloutFailurePolicy = "pause"
+	// RolloutRollbackOnFailure roll the instances back to the previous release automatically.
+	RolloutRollbackOnFailure RolloutFailurePolicy = "rollback"
+)
+
+// String returns rollout failure policy string.
+func (p RolloutFailurePolicy) String() string {
+	return string(p)
+}
+
+// Validate the rollout failure policy is valid or not.
+func (p RolloutFailurePolicy) Validate() error {
+	switch p {
+	case RolloutPauseOnFailure:
+	case RolloutRollbackOnFailure:
+	default:
+		return fmt.Errorf("unknown %s rollout failure policy", p)
+	}
+
+	return nil
+}
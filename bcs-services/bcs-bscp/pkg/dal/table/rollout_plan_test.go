@@ -0,0 +1,35 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestValidateRolloutStages(t *testing.T) {
+	if err := ValidateRolloutStages(DefaultRolloutStages); err != nil {
+		t.Errorf("validate default rollout stages failed, err: %v", err)
+	}
+
+	invalids := [][]uint32{
+		nil,
+		{1, 10, 50},
+		{10, 1, 100},
+		{10, 10, 100},
+		{0, 100},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 100},
+	}
+	for _, stages := range invalids {
+		if err := ValidateRolloutStages(stages); err == nil {
+			t.Errorf("rollout stages %v should be invalid", stages)
+		}
+	}
+}
@@ -251,6 +251,20 @@ const (
 	ClientTable Name = "clients"
 	// ClientEventTable is client_events table's name
 	ClientEventTable Name = "client_events"
+	// PublishApprovalTable is publish_approvals table's name
+	PublishApprovalTable Name = "publish_approvals"
+	// RolloutPlanTable is rollout_plans table's name
+	RolloutPlanTable Name = "rollout_plans"
+	// ConfigSchemaTable is config_schemas table's name
+	ConfigSchemaTable Name = "config_schemas"
+	// WebhookTable is webhooks table's name
+	WebhookTable Name = "webhooks"
+	// ReleaseNoticeTable is release_notices table's name
+	ReleaseNoticeTable Name = "release_notices"
+	// WebhookDeliveryTable is webhook_deliveries table's name
+	WebhookDeliveryTable Name = "webhook_deliveries"
+	// GitSourceTable is git_sources table's name
+	GitSourceTable Name = "git_sources"
 )
 
 // RevisionColumns defines all the Revision table's columns.
@@ -14,8 +14,10 @@ package table
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tmplprocess"
 )
 
 // TemplateSpace 模版空间
@@ -130,8 +132,9 @@ func (t *TemplateSpace) ValidateDelete() error {
 
 // TemplateSpaceSpec defines all the specifics for template space set by user.
 type TemplateSpaceSpec struct {
-	Name string `json:"name" gorm:"column:name"`
-	Memo string `json:"memo" gorm:"column:memo"`
+	Name           string         `json:"name" gorm:"column:name"`
+	Memo           string         `json:"memo" gorm:"column:memo"`
+	TemplateEngine TemplateEngine `json:"template_engine" gorm:"column:template_engine"`
 }
 
 // ValidateCreate validate template space spec when it is created.
@@ -140,6 +143,13 @@ func (t *TemplateSpaceSpec) ValidateCreate() error {
 		return err
 	}
 
+	if t.TemplateEngine == "" {
+		t.TemplateEngine = SimpleTemplateEngine
+	}
+	if err := t.TemplateEngine.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -149,9 +159,42 @@ func (t *TemplateSpaceSpec) ValidateUpdate() error {
 		return err
 	}
 
+	if t.TemplateEngine != "" {
+		if err := t.TemplateEngine.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	// SimpleTemplateEngine only substitutes the {{ .bk_bscp_xxx }} style variables
+	SimpleTemplateEngine TemplateEngine = tmplprocess.SimpleEngine
+	// GoTemplateEngine renders with go text/template and a sandboxed function set
+	GoTemplateEngine TemplateEngine = tmplprocess.GoTemplateEngine
+)
+
+// TemplateEngine is the engine used to render the templates in template space
+type TemplateEngine string
+
+// Validate the template engine is supported or not.
+func (e TemplateEngine) Validate() error {
+	switch e {
+	case SimpleTemplateEngine:
+	case GoTemplateEngine:
+	default:
+		return fmt.Errorf("unsupported template engine: %s", e)
+	}
+
 	return nil
 }
 
+// Processor returns the template processor of the engine, empty engine means the simple one.
+func (e TemplateEngine) Processor() tmplprocess.TmplProcessor {
+	return tmplprocess.NewTmplProcessorByEngine(string(e))
+}
+
 // TemplateSpaceAttachment defines the template space attachments.
 type TemplateSpaceAttachment struct {
 	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
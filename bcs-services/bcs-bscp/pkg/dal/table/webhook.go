@@ -0,0 +1,219 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/types"
+)
+
+const (
+	// maxWebhookURLLength is the max length of the webhook url.
+	maxWebhookURLLength = 1024
+	// maxWebhookSecretLength is the max length of the webhook secret.
+	maxWebhookSecretLength = 128
+)
+
+// Webhook 发布事件回调订阅, defines an app's subscription of the release events, the release event
+// is posted to the url with a HMAC-SHA256 signature of the secret when it happens.
+type Webhook struct {
+	ID         uint32             `json:"id" gorm:"primaryKey"`
+	Spec       *WebhookSpec       `json:"spec" gorm:"embedded"`
+	Attachment *WebhookAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision          `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the webhook's database table name.
+func (w *Webhook) TableName() string {
+	return "webhooks"
+}
+
+// AppID AuditRes interface
+func (w *Webhook) AppID() uint32 {
+	return w.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (w *Webhook) ResID() uint32 {
+	return w.ID
+}
+
+// ResType AuditRes interface
+func (w *Webhook) ResType() string {
+	return "webhook"
+}
+
+// ValidateCreate validate webhook is valid or not when create it.
+func (w *Webhook) ValidateCreate() error {
+	if w.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if w.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := w.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if w.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := w.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if w.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := w.Revision.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate webhook is valid or not when update it.
+func (w *Webhook) ValidateUpdate() error {
+	if w.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if w.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := w.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if w.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := w.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if w.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	if err := w.Revision.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDelete validate the webhook's info when delete it.
+func (w *Webhook) ValidateDelete() error {
+	if w.ID <= 0 {
+		return errors.New("webhook id should be set")
+	}
+
+	if w.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	return w.Attachment.Validate()
+}
+
+// Subscribed returns whether the webhook subscribes the release event.
+func (w *Webhook) Subscribed(event ReleaseEvent) bool {
+	if !w.Spec.Enabled {
+		return false
+	}
+
+	// subscribe all the release events when the events is not set.
+	if len(w.Spec.Events) == 0 {
+		return true
+	}
+
+	for _, one := range w.Spec.Events {
+		if one == string(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookSpec defines all the specifics for webhook.
+type WebhookSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	URL  string `json:"url" gorm:"column:url"`
+	// Secret is used to sign the request body, the signature is set in the X-Bscp-Signature header.
+	Secret string `json:"secret" gorm:"column:secret"`
+	// Events is the release events subscribed, all the release events are subscribed when it is empty.
+	Events  types.StringSlice `json:"events" gorm:"column:events;type:json;default:'[]'"`
+	Enabled bool              `json:"enabled" gorm:"column:enabled"`
+	Memo    string            `json:"memo" gorm:"column:memo"`
+}
+
+// Validate webhook spec.
+func (w *WebhookSpec) Validate() error {
+	if err := validator.ValidateName(w.Name); err != nil {
+		return err
+	}
+
+	if len(w.URL) > maxWebhookURLLength {
+		return fmt.Errorf("webhook url should <= %d", maxWebhookURLLength)
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url, err: %v", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook url should be a http or https url")
+	}
+
+	if len(w.Secret) > maxWebhookSecretLength {
+		return fmt.Errorf("webhook secret should <= %d", maxWebhookSecretLength)
+	}
+
+	for _, one := range w.Events {
+		if err := ReleaseEvent(one).Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validator.ValidateMemo(w.Memo, false)
+}
+
+// WebhookAttachment defines the webhook attachments.
+type WebhookAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether webhook attachment is valid or not.
+func (w *WebhookAttachment) Validate() error {
+	if w.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if w.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
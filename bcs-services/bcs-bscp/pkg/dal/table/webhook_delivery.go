@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"time"
+)
+
+// WebhookDelivery 回调投递记录, records the delivery of a release notice to a webhook, the failed
+// delivery is retried with backoff until it succeeds or reaches the max attempts.
+type WebhookDelivery struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *WebhookDeliverySpec       `json:"spec" gorm:"embedded"`
+	Attachment *WebhookDeliveryAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the webhook delivery's database table name.
+func (w *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliverySpec defines all the specifics for webhook delivery.
+type WebhookDeliverySpec struct {
+	NoticeID  uint32       `json:"notice_id" gorm:"column:notice_id"`
+	WebhookID uint32       `json:"webhook_id" gorm:"column:webhook_id"`
+	Event     ReleaseEvent `json:"event" gorm:"column:event"`
+	// Payload is the json request body posted to the webhook.
+	Payload       string         `json:"payload" gorm:"column:payload"`
+	Status        DeliveryStatus `json:"status" gorm:"column:status"`
+	Attempts      uint32         `json:"attempts" gorm:"column:attempts"`
+	NextAttemptAt time.Time      `json:"next_attempt_at" gorm:"column:next_attempt_at"`
+	// LastError is the error of the last failed attempt.
+	LastError string `json:"last_error" gorm:"column:last_error"`
+}
+
+// WebhookDeliveryAttachment defines the webhook delivery attachments.
+type WebhookDeliveryAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// DeliveryStatus is the status of the webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending the delivery is waiting to be posted or retried.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceed the webhook responds 2xx status code.
+	DeliverySucceed DeliveryStatus = "succeed"
+	// DeliveryFailed the delivery is failed after the max attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// String returns delivery status string.
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
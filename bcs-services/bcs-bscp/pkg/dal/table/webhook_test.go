@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestWebhookSpecValidate(t *testing.T) {
+	valid := &WebhookSpec{
+		Name:    "notify",
+		URL:     "https://example.com/bscp/hook",
+		Events:  []string{string(ReleasePublished), string(ReleaseRolledBack)},
+		Enabled: true,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("validate webhook spec failed, err: %v", err)
+	}
+
+	invalids := []*WebhookSpec{
+		{Name: "notify", URL: "ftp://example.com/hook"},
+		{Name: "notify", URL: "example.com/hook"},
+		{Name: "notify", URL: "https://example.com/hook", Events: []string{"update"}},
+	}
+	for _, spec := range invalids {
+		if err := spec.Validate(); err == nil {
+			t.Errorf("webhook spec %+v should be invalid", spec)
+		}
+	}
+}
+
+func TestWebhookSubscribed(t *testing.T) {
+	all := &Webhook{Spec: &WebhookSpec{Enabled: true}}
+	if !all.Subscribed(ReleaseDeprecated) {
+		t.Errorf("webhook without events should subscribe all the release events")
+	}
+
+	some := &Webhook{Spec: &WebhookSpec{Enabled: true, Events: []string{string(ReleasePublished)}}}
+	if !some.Subscribed(ReleasePublished) || some.Subscribed(ReleaseRolledBack) {
+		t.Errorf("webhook should only subscribe the publish event")
+	}
+
+	disabled := &Webhook{Spec: &WebhookSpec{}}
+	if disabled.Subscribed(ReleasePublished) {
+		t.Errorf("disabled webhook should not subscribe any release event")
+	}
+}
@@ -11,9 +11,11 @@ import (
 	app "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/app"
 	base "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
 	client "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/client"
+	git_source "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/git-source"
 	group "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/group"
 	hook_revision "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/hook-revision"
 	release "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release"
+	release_diff "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release-diff"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -56,6 +58,9 @@ const (
 	Config_DeprecateRelease_FullMethodName                  = "/pbcs.Config/DeprecateRelease"
 	Config_UnDeprecateRelease_FullMethodName                = "/pbcs.Config/UnDeprecateRelease"
 	Config_DeleteRelease_FullMethodName                     = "/pbcs.Config/DeleteRelease"
+	Config_CompareReleases_FullMethodName                   = "/pbcs.Config/CompareReleases"
+	Config_PromoteRelease_FullMethodName                    = "/pbcs.Config/PromoteRelease"
+	Config_PreviewPromoteRelease_FullMethodName             = "/pbcs.Config/PreviewPromoteRelease"
 	Config_CreateHook_FullMethodName                        = "/pbcs.Config/CreateHook"
 	Config_DeleteHook_FullMethodName                        = "/pbcs.Config/DeleteHook"
 	Config_BatchDeleteHook_FullMethodName                   = "/pbcs.Config/BatchDeleteHook"
@@ -147,6 +152,28 @@ const (
 	Config_GetGroupByName_FullMethodName                    = "/pbcs.Config/GetGroupByName"
 	Config_Publish_FullMethodName                           = "/pbcs.Config/Publish"
 	Config_GenerateReleaseAndPublish_FullMethodName         = "/pbcs.Config/GenerateReleaseAndPublish"
+	Config_ApprovePublish_FullMethodName                    = "/pbcs.Config/ApprovePublish"
+	Config_RejectPublish_FullMethodName                     = "/pbcs.Config/RejectPublish"
+	Config_ListPublishApprovals_FullMethodName              = "/pbcs.Config/ListPublishApprovals"
+	Config_CreateRolloutPlan_FullMethodName                 = "/pbcs.Config/CreateRolloutPlan"
+	Config_ListRolloutPlans_FullMethodName                  = "/pbcs.Config/ListRolloutPlans"
+	Config_PauseRolloutPlan_FullMethodName                  = "/pbcs.Config/PauseRolloutPlan"
+	Config_ResumeRolloutPlan_FullMethodName                 = "/pbcs.Config/ResumeRolloutPlan"
+	Config_RollbackRolloutPlan_FullMethodName               = "/pbcs.Config/RollbackRolloutPlan"
+	Config_CreateConfigSchema_FullMethodName                = "/pbcs.Config/CreateConfigSchema"
+	Config_UpdateConfigSchema_FullMethodName                = "/pbcs.Config/UpdateConfigSchema"
+	Config_DeleteConfigSchema_FullMethodName                = "/pbcs.Config/DeleteConfigSchema"
+	Config_ListConfigSchemas_FullMethodName                 = "/pbcs.Config/ListConfigSchemas"
+	Config_CreateWebhook_FullMethodName                     = "/pbcs.Config/CreateWebhook"
+	Config_UpdateWebhook_FullMethodName                     = "/pbcs.Config/UpdateWebhook"
+	Config_DeleteWebhook_FullMethodName                     = "/pbcs.Config/DeleteWebhook"
+	Config_ListWebhooks_FullMethodName                      = "/pbcs.Config/ListWebhooks"
+	Config_ListWebhookDeliveries_FullMethodName             = "/pbcs.Config/ListWebhookDeliveries"
+	Config_CreateGitSource_FullMethodName                   = "/pbcs.Config/CreateGitSource"
+	Config_UpdateGitSource_FullMethodName                   = "/pbcs.Config/UpdateGitSource"
+	Config_DeleteGitSource_FullMethodName                   = "/pbcs.Config/DeleteGitSource"
+	Config_GetGitSource_FullMethodName                      = "/pbcs.Config/GetGitSource"
+	Config_SyncGitSource_FullMethodName                     = "/pbcs.Config/SyncGitSource"
 	Config_CreateCredentials_FullMethodName                 = "/pbcs.Config/CreateCredentials"
 	Config_ListCredentials_FullMethodName                   = "/pbcs.Config/ListCredentials"
 	Config_DeleteCredential_FullMethodName                  = "/pbcs.Config/DeleteCredential"
@@ -216,6 +243,9 @@ type ConfigClient interface {
 	DeprecateRelease(ctx context.Context, in *DeprecateReleaseReq, opts ...grpc.CallOption) (*DeprecateReleaseResp, error)
 	UnDeprecateRelease(ctx context.Context, in *UnDeprecateReleaseReq, opts ...grpc.CallOption) (*UnDeprecateReleaseResp, error)
 	DeleteRelease(ctx context.Context, in *DeleteReleaseReq, opts ...grpc.CallOption) (*DeleteReleaseResp, error)
+	CompareReleases(ctx context.Context, in *CompareReleasesReq, opts ...grpc.CallOption) (*release_diff.ReleaseDiff, error)
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
+	PreviewPromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
 	CreateHook(ctx context.Context, in *CreateHookReq, opts ...grpc.CallOption) (*CreateHookResp, error)
 	DeleteHook(ctx context.Context, in *DeleteHookReq, opts ...grpc.CallOption) (*DeleteHookResp, error)
 	BatchDeleteHook(ctx context.Context, in *BatchDeleteHookReq, opts ...grpc.CallOption) (*BatchDeleteResp, error)
@@ -316,6 +346,28 @@ type ConfigClient interface {
 	GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error)
 	Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error)
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	ApprovePublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	RejectPublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*RejectPublishResp, error)
+	ListPublishApprovals(ctx context.Context, in *ListPublishApprovalsReq, opts ...grpc.CallOption) (*ListPublishApprovalsResp, error)
+	CreateRolloutPlan(ctx context.Context, in *CreateRolloutPlanReq, opts ...grpc.CallOption) (*CreateRolloutPlanResp, error)
+	ListRolloutPlans(ctx context.Context, in *ListRolloutPlansReq, opts ...grpc.CallOption) (*ListRolloutPlansResp, error)
+	PauseRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error)
+	ResumeRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error)
+	RollbackRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error)
+	CreateConfigSchema(ctx context.Context, in *CreateConfigSchemaReq, opts ...grpc.CallOption) (*CreateConfigSchemaResp, error)
+	UpdateConfigSchema(ctx context.Context, in *UpdateConfigSchemaReq, opts ...grpc.CallOption) (*UpdateConfigSchemaResp, error)
+	DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaReq, opts ...grpc.CallOption) (*DeleteConfigSchemaResp, error)
+	ListConfigSchemas(ctx context.Context, in *ListConfigSchemasReq, opts ...grpc.CallOption) (*ListConfigSchemasResp, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*UpdateWebhookResp, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error)
+	CreateGitSource(ctx context.Context, in *CreateGitSourceReq, opts ...grpc.CallOption) (*CreateGitSourceResp, error)
+	UpdateGitSource(ctx context.Context, in *UpdateGitSourceReq, opts ...grpc.CallOption) (*UpdateGitSourceResp, error)
+	DeleteGitSource(ctx context.Context, in *DeleteGitSourceReq, opts ...grpc.CallOption) (*DeleteGitSourceResp, error)
+	GetGitSource(ctx context.Context, in *GetGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error)
+	SyncGitSource(ctx context.Context, in *SyncGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error)
 	CreateCredentials(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateCredentialResp, error)
 	ListCredentials(ctx context.Context, in *ListCredentialsReq, opts ...grpc.CallOption) (*ListCredentialsResp, error)
 	DeleteCredential(ctx context.Context, in *DeleteCredentialsReq, opts ...grpc.CallOption) (*DeleteCredentialsResp, error)
@@ -628,6 +680,33 @@ func (c *configClient) DeleteRelease(ctx context.Context, in *DeleteReleaseReq,
 	return out, nil
 }
 
+func (c *configClient) CompareReleases(ctx context.Context, in *CompareReleasesReq, opts ...grpc.CallOption) (*release_diff.ReleaseDiff, error) {
+	out := new(release_diff.ReleaseDiff)
+	err := c.cc.Invoke(ctx, Config_CompareReleases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Config_PromoteRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PreviewPromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Config_PreviewPromoteRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) CreateHook(ctx context.Context, in *CreateHookReq, opts ...grpc.CallOption) (*CreateHookResp, error) {
 	out := new(CreateHookResp)
 	err := c.cc.Invoke(ctx, Config_CreateHook_FullMethodName, in, out, opts...)
@@ -1447,6 +1526,204 @@ func (c *configClient) GenerateReleaseAndPublish(ctx context.Context, in *Genera
 	return out, nil
 }
 
+func (c *configClient) ApprovePublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Config_ApprovePublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RejectPublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*RejectPublishResp, error) {
+	out := new(RejectPublishResp)
+	err := c.cc.Invoke(ctx, Config_RejectPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListPublishApprovals(ctx context.Context, in *ListPublishApprovalsReq, opts ...grpc.CallOption) (*ListPublishApprovalsResp, error) {
+	out := new(ListPublishApprovalsResp)
+	err := c.cc.Invoke(ctx, Config_ListPublishApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateRolloutPlan(ctx context.Context, in *CreateRolloutPlanReq, opts ...grpc.CallOption) (*CreateRolloutPlanResp, error) {
+	out := new(CreateRolloutPlanResp)
+	err := c.cc.Invoke(ctx, Config_CreateRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListRolloutPlans(ctx context.Context, in *ListRolloutPlansReq, opts ...grpc.CallOption) (*ListRolloutPlansResp, error) {
+	out := new(ListRolloutPlansResp)
+	err := c.cc.Invoke(ctx, Config_ListRolloutPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PauseRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error) {
+	out := new(OperateRolloutPlanResp)
+	err := c.cc.Invoke(ctx, Config_PauseRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ResumeRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error) {
+	out := new(OperateRolloutPlanResp)
+	err := c.cc.Invoke(ctx, Config_ResumeRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RollbackRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*OperateRolloutPlanResp, error) {
+	out := new(OperateRolloutPlanResp)
+	err := c.cc.Invoke(ctx, Config_RollbackRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateConfigSchema(ctx context.Context, in *CreateConfigSchemaReq, opts ...grpc.CallOption) (*CreateConfigSchemaResp, error) {
+	out := new(CreateConfigSchemaResp)
+	err := c.cc.Invoke(ctx, Config_CreateConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateConfigSchema(ctx context.Context, in *UpdateConfigSchemaReq, opts ...grpc.CallOption) (*UpdateConfigSchemaResp, error) {
+	out := new(UpdateConfigSchemaResp)
+	err := c.cc.Invoke(ctx, Config_UpdateConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaReq, opts ...grpc.CallOption) (*DeleteConfigSchemaResp, error) {
+	out := new(DeleteConfigSchemaResp)
+	err := c.cc.Invoke(ctx, Config_DeleteConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListConfigSchemas(ctx context.Context, in *ListConfigSchemasReq, opts ...grpc.CallOption) (*ListConfigSchemasResp, error) {
+	out := new(ListConfigSchemasResp)
+	err := c.cc.Invoke(ctx, Config_ListConfigSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookResp, error) {
+	out := new(CreateWebhookResp)
+	err := c.cc.Invoke(ctx, Config_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*UpdateWebhookResp, error) {
+	out := new(UpdateWebhookResp)
+	err := c.cc.Invoke(ctx, Config_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*DeleteWebhookResp, error) {
+	out := new(DeleteWebhookResp)
+	err := c.cc.Invoke(ctx, Config_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error) {
+	out := new(ListWebhooksResp)
+	err := c.cc.Invoke(ctx, Config_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error) {
+	out := new(ListWebhookDeliveriesResp)
+	err := c.cc.Invoke(ctx, Config_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateGitSource(ctx context.Context, in *CreateGitSourceReq, opts ...grpc.CallOption) (*CreateGitSourceResp, error) {
+	out := new(CreateGitSourceResp)
+	err := c.cc.Invoke(ctx, Config_CreateGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateGitSource(ctx context.Context, in *UpdateGitSourceReq, opts ...grpc.CallOption) (*UpdateGitSourceResp, error) {
+	out := new(UpdateGitSourceResp)
+	err := c.cc.Invoke(ctx, Config_UpdateGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteGitSource(ctx context.Context, in *DeleteGitSourceReq, opts ...grpc.CallOption) (*DeleteGitSourceResp, error) {
+	out := new(DeleteGitSourceResp)
+	err := c.cc.Invoke(ctx, Config_DeleteGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetGitSource(ctx context.Context, in *GetGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error) {
+	out := new(git_source.GitSource)
+	err := c.cc.Invoke(ctx, Config_GetGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) SyncGitSource(ctx context.Context, in *SyncGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error) {
+	out := new(git_source.GitSource)
+	err := c.cc.Invoke(ctx, Config_SyncGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) CreateCredentials(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateCredentialResp, error) {
 	out := new(CreateCredentialResp)
 	err := c.cc.Invoke(ctx, Config_CreateCredentials_FullMethodName, in, out, opts...)
@@ -1754,6 +2031,9 @@ type ConfigServer interface {
 	DeprecateRelease(context.Context, *DeprecateReleaseReq) (*DeprecateReleaseResp, error)
 	UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*UnDeprecateReleaseResp, error)
 	DeleteRelease(context.Context, *DeleteReleaseReq) (*DeleteReleaseResp, error)
+	CompareReleases(context.Context, *CompareReleasesReq) (*release_diff.ReleaseDiff, error)
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
+	PreviewPromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
 	CreateHook(context.Context, *CreateHookReq) (*CreateHookResp, error)
 	DeleteHook(context.Context, *DeleteHookReq) (*DeleteHookResp, error)
 	BatchDeleteHook(context.Context, *BatchDeleteHookReq) (*BatchDeleteResp, error)
@@ -1854,6 +2134,28 @@ type ConfigServer interface {
 	GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error)
 	Publish(context.Context, *PublishReq) (*PublishResp, error)
 	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
+	ApprovePublish(context.Context, *ReviewPublishReq) (*PublishResp, error)
+	RejectPublish(context.Context, *ReviewPublishReq) (*RejectPublishResp, error)
+	ListPublishApprovals(context.Context, *ListPublishApprovalsReq) (*ListPublishApprovalsResp, error)
+	CreateRolloutPlan(context.Context, *CreateRolloutPlanReq) (*CreateRolloutPlanResp, error)
+	ListRolloutPlans(context.Context, *ListRolloutPlansReq) (*ListRolloutPlansResp, error)
+	PauseRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error)
+	ResumeRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error)
+	RollbackRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error)
+	CreateConfigSchema(context.Context, *CreateConfigSchemaReq) (*CreateConfigSchemaResp, error)
+	UpdateConfigSchema(context.Context, *UpdateConfigSchemaReq) (*UpdateConfigSchemaResp, error)
+	DeleteConfigSchema(context.Context, *DeleteConfigSchemaReq) (*DeleteConfigSchemaResp, error)
+	ListConfigSchemas(context.Context, *ListConfigSchemasReq) (*ListConfigSchemasResp, error)
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error)
+	UpdateWebhook(context.Context, *UpdateWebhookReq) (*UpdateWebhookResp, error)
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error)
+	ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error)
+	CreateGitSource(context.Context, *CreateGitSourceReq) (*CreateGitSourceResp, error)
+	UpdateGitSource(context.Context, *UpdateGitSourceReq) (*UpdateGitSourceResp, error)
+	DeleteGitSource(context.Context, *DeleteGitSourceReq) (*DeleteGitSourceResp, error)
+	GetGitSource(context.Context, *GetGitSourceReq) (*git_source.GitSource, error)
+	SyncGitSource(context.Context, *SyncGitSourceReq) (*git_source.GitSource, error)
 	CreateCredentials(context.Context, *CreateCredentialReq) (*CreateCredentialResp, error)
 	ListCredentials(context.Context, *ListCredentialsReq) (*ListCredentialsResp, error)
 	DeleteCredential(context.Context, *DeleteCredentialsReq) (*DeleteCredentialsResp, error)
@@ -1982,6 +2284,15 @@ func (UnimplementedConfigServer) UnDeprecateRelease(context.Context, *UnDeprecat
 func (UnimplementedConfigServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*DeleteReleaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
 }
+func (UnimplementedConfigServer) CompareReleases(context.Context, *CompareReleasesReq) (*release_diff.ReleaseDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareReleases not implemented")
+}
+func (UnimplementedConfigServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
+func (UnimplementedConfigServer) PreviewPromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPromoteRelease not implemented")
+}
 func (UnimplementedConfigServer) CreateHook(context.Context, *CreateHookReq) (*CreateHookResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
 }
@@ -2255,6 +2566,72 @@ func (UnimplementedConfigServer) Publish(context.Context, *PublishReq) (*Publish
 func (UnimplementedConfigServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
 }
+func (UnimplementedConfigServer) ApprovePublish(context.Context, *ReviewPublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePublish not implemented")
+}
+func (UnimplementedConfigServer) RejectPublish(context.Context, *ReviewPublishReq) (*RejectPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPublish not implemented")
+}
+func (UnimplementedConfigServer) ListPublishApprovals(context.Context, *ListPublishApprovalsReq) (*ListPublishApprovalsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublishApprovals not implemented")
+}
+func (UnimplementedConfigServer) CreateRolloutPlan(context.Context, *CreateRolloutPlanReq) (*CreateRolloutPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRolloutPlan not implemented")
+}
+func (UnimplementedConfigServer) ListRolloutPlans(context.Context, *ListRolloutPlansReq) (*ListRolloutPlansResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRolloutPlans not implemented")
+}
+func (UnimplementedConfigServer) PauseRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRolloutPlan not implemented")
+}
+func (UnimplementedConfigServer) ResumeRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRolloutPlan not implemented")
+}
+func (UnimplementedConfigServer) RollbackRolloutPlan(context.Context, *OperateRolloutPlanReq) (*OperateRolloutPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRolloutPlan not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigSchema(context.Context, *CreateConfigSchemaReq) (*CreateConfigSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigSchema not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfigSchema(context.Context, *UpdateConfigSchemaReq) (*UpdateConfigSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigSchema not implemented")
+}
+func (UnimplementedConfigServer) DeleteConfigSchema(context.Context, *DeleteConfigSchemaReq) (*DeleteConfigSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigSchema not implemented")
+}
+func (UnimplementedConfigServer) ListConfigSchemas(context.Context, *ListConfigSchemasReq) (*ListConfigSchemasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigSchemas not implemented")
+}
+func (UnimplementedConfigServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedConfigServer) UpdateWebhook(context.Context, *UpdateWebhookReq) (*UpdateWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedConfigServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*DeleteWebhookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedConfigServer) ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedConfigServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedConfigServer) CreateGitSource(context.Context, *CreateGitSourceReq) (*CreateGitSourceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGitSource not implemented")
+}
+func (UnimplementedConfigServer) UpdateGitSource(context.Context, *UpdateGitSourceReq) (*UpdateGitSourceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGitSource not implemented")
+}
+func (UnimplementedConfigServer) DeleteGitSource(context.Context, *DeleteGitSourceReq) (*DeleteGitSourceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGitSource not implemented")
+}
+func (UnimplementedConfigServer) GetGitSource(context.Context, *GetGitSourceReq) (*git_source.GitSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGitSource not implemented")
+}
+func (UnimplementedConfigServer) SyncGitSource(context.Context, *SyncGitSourceReq) (*git_source.GitSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGitSource not implemented")
+}
 func (UnimplementedConfigServer) CreateCredentials(context.Context, *CreateCredentialReq) (*CreateCredentialResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredentials not implemented")
 }
@@ -2897,6 +3274,60 @@ func _Config_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_CompareReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CompareReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CompareReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CompareReleases(ctx, req.(*CompareReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_PreviewPromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PreviewPromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PreviewPromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PreviewPromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateHookReq)
 	if err := dec(in); err != nil {
@@ -4535,151 +4966,547 @@ func _Config_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCredentialReq)
+func _Config_ApprovePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateCredentials(ctx, in)
+		return srv.(ConfigServer).ApprovePublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateCredentials_FullMethodName,
+		FullMethod: Config_ApprovePublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateCredentials(ctx, req.(*CreateCredentialReq))
+		return srv.(ConfigServer).ApprovePublish(ctx, req.(*ReviewPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialsReq)
+func _Config_RejectPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListCredentials(ctx, in)
+		return srv.(ConfigServer).RejectPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListCredentials_FullMethodName,
+		FullMethod: Config_RejectPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListCredentials(ctx, req.(*ListCredentialsReq))
+		return srv.(ConfigServer).RejectPublish(ctx, req.(*ReviewPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCredentialsReq)
+func _Config_ListPublishApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublishApprovalsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteCredential(ctx, in)
+		return srv.(ConfigServer).ListPublishApprovals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteCredential_FullMethodName,
+		FullMethod: Config_ListPublishApprovals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteCredential(ctx, req.(*DeleteCredentialsReq))
+		return srv.(ConfigServer).ListPublishApprovals(ctx, req.(*ListPublishApprovalsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialsReq)
+func _Config_CreateRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateCredential(ctx, in)
+		return srv.(ConfigServer).CreateRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateCredential_FullMethodName,
+		FullMethod: Config_CreateRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateCredential(ctx, req.(*UpdateCredentialsReq))
+		return srv.(ConfigServer).CreateRolloutPlan(ctx, req.(*CreateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCredentialNameReq)
+func _Config_ListRolloutPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolloutPlansReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckCredentialName(ctx, in)
+		return srv.(ConfigServer).ListRolloutPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckCredentialName_FullMethodName,
+		FullMethod: Config_ListRolloutPlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+		return srv.(ConfigServer).ListRolloutPlans(ctx, req.(*ListRolloutPlansReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialScopesReq)
+func _Config_PauseRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListCredentialScopes(ctx, in)
+		return srv.(ConfigServer).PauseRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListCredentialScopes_FullMethodName,
+		FullMethod: Config_PauseRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+		return srv.(ConfigServer).PauseRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateCredentialScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialScopeReq)
+func _Config_ResumeRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateCredentialScope(ctx, in)
+		return srv.(ConfigServer).ResumeRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateCredentialScope_FullMethodName,
+		FullMethod: Config_ResumeRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateCredentialScope(ctx, req.(*UpdateCredentialScopeReq))
+		return srv.(ConfigServer).ResumeRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CredentialScopePreviewReq)
+func _Config_RollbackRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CredentialScopePreview(ctx, in)
+		return srv.(ConfigServer).RollbackRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CredentialScopePreview_FullMethodName,
+		FullMethod: Config_RollbackRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+		return srv.(ConfigServer).RollbackRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Config_CreateConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigSchema(ctx, req.(*CreateConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigSchema(ctx, req.(*UpdateConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_DeleteConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigSchema(ctx, req.(*DeleteConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSchemasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigSchemas(ctx, req.(*ListConfigSchemasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateWebhook(ctx, req.(*UpdateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListWebhooks(ctx, req.(*ListWebhooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CreateGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateGitSource(ctx, req.(*CreateGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateGitSource(ctx, req.(*UpdateGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_DeleteGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteGitSource(ctx, req.(*DeleteGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_GetGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetGitSource(ctx, req.(*GetGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_SyncGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).SyncGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_SyncGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).SyncGitSource(ctx, req.(*SyncGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateCredentials(ctx, req.(*CreateCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListCredentials(ctx, req.(*ListCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteCredential(ctx, req.(*DeleteCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateCredential(ctx, req.(*UpdateCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCredentialNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CheckCredentialName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CheckCredentialName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialScopesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListCredentialScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListCredentialScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UpdateCredentialScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialScopeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateCredentialScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateCredentialScope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateCredentialScope(ctx, req.(*UpdateCredentialScopeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialScopePreviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CredentialScopePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CredentialScopePreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateKvReq)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -5202,6 +6029,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRelease",
 			Handler:    _Config_DeleteRelease_Handler,
 		},
+		{
+			MethodName: "CompareReleases",
+			Handler:    _Config_CompareReleases_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Config_PromoteRelease_Handler,
+		},
+		{
+			MethodName: "PreviewPromoteRelease",
+			Handler:    _Config_PreviewPromoteRelease_Handler,
+		},
 		{
 			MethodName: "CreateHook",
 			Handler:    _Config_CreateHook_Handler,
@@ -5566,6 +6405,94 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateReleaseAndPublish",
 			Handler:    _Config_GenerateReleaseAndPublish_Handler,
 		},
+		{
+			MethodName: "ApprovePublish",
+			Handler:    _Config_ApprovePublish_Handler,
+		},
+		{
+			MethodName: "RejectPublish",
+			Handler:    _Config_RejectPublish_Handler,
+		},
+		{
+			MethodName: "ListPublishApprovals",
+			Handler:    _Config_ListPublishApprovals_Handler,
+		},
+		{
+			MethodName: "CreateRolloutPlan",
+			Handler:    _Config_CreateRolloutPlan_Handler,
+		},
+		{
+			MethodName: "ListRolloutPlans",
+			Handler:    _Config_ListRolloutPlans_Handler,
+		},
+		{
+			MethodName: "PauseRolloutPlan",
+			Handler:    _Config_PauseRolloutPlan_Handler,
+		},
+		{
+			MethodName: "ResumeRolloutPlan",
+			Handler:    _Config_ResumeRolloutPlan_Handler,
+		},
+		{
+			MethodName: "RollbackRolloutPlan",
+			Handler:    _Config_RollbackRolloutPlan_Handler,
+		},
+		{
+			MethodName: "CreateConfigSchema",
+			Handler:    _Config_CreateConfigSchema_Handler,
+		},
+		{
+			MethodName: "UpdateConfigSchema",
+			Handler:    _Config_UpdateConfigSchema_Handler,
+		},
+		{
+			MethodName: "DeleteConfigSchema",
+			Handler:    _Config_DeleteConfigSchema_Handler,
+		},
+		{
+			MethodName: "ListConfigSchemas",
+			Handler:    _Config_ListConfigSchemas_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Config_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Config_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Config_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Config_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Config_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateGitSource",
+			Handler:    _Config_CreateGitSource_Handler,
+		},
+		{
+			MethodName: "UpdateGitSource",
+			Handler:    _Config_UpdateGitSource_Handler,
+		},
+		{
+			MethodName: "DeleteGitSource",
+			Handler:    _Config_DeleteGitSource_Handler,
+		},
+		{
+			MethodName: "GetGitSource",
+			Handler:    _Config_GetGitSource_Handler,
+		},
+		{
+			MethodName: "SyncGitSource",
+			Handler:    _Config_SyncGitSource_Handler,
+		},
 		{
 			MethodName: "CreateCredentials",
 			Handler:    _Config_CreateCredentials_Handler,
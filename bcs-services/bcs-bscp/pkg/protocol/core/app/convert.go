@@ -30,6 +30,8 @@ func (m *AppSpec) AppSpec() *table.AppSpec {
 		Memo:       m.Memo,
 		Alias:      m.Alias,
 		DataType:   table.DataType(m.DataType),
+		IsApprove:  m.IsApprove,
+		Approver:   m.Approver,
 	}
 }
 
@@ -45,6 +47,8 @@ func PbAppSpec(spec *table.AppSpec) *AppSpec { //nolint:revive
 		Memo:       spec.Memo,
 		Alias:      spec.Alias,
 		DataType:   string(spec.DataType),
+		IsApprove:  spec.IsApprove,
+		Approver:   spec.Approver,
 	}
 }
 
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbschema provides config schema core protocol struct and convert functions.
+package pbschema
+
+import (
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+)
+
+// ConfigSchemaSpec convert pb ConfigSchemaSpec to table ConfigSchemaSpec
+func (m *ConfigSchemaSpec) ConfigSchemaSpec() *table.ConfigSchemaSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.ConfigSchemaSpec{
+		ResourceType: table.ConfigSchemaResource(m.ResourceType),
+		ConfigKey:    m.ConfigKey,
+		SchemaType:   table.ConfigSchemaType(m.SchemaType),
+		Schema:       m.Schema,
+		Memo:         m.Memo,
+	}
+}
+
+// ConfigSchemaAttachment convert pb ConfigSchemaAttachment to table ConfigSchemaAttachment
+func (m *ConfigSchemaAttachment) ConfigSchemaAttachment() *table.ConfigSchemaAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.ConfigSchemaAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbConfigSchemas convert table ConfigSchemas to pb ConfigSchemas
+func PbConfigSchemas(s []*table.ConfigSchema) []*ConfigSchema {
+	if s == nil {
+		return make([]*ConfigSchema, 0)
+	}
+
+	result := make([]*ConfigSchema, 0)
+	for _, one := range s {
+		result = append(result, PbConfigSchema(one))
+	}
+
+	return result
+}
+
+// PbConfigSchema convert table ConfigSchema to pb ConfigSchema
+func PbConfigSchema(s *table.ConfigSchema) *ConfigSchema {
+	if s == nil {
+		return nil
+	}
+
+	return &ConfigSchema{
+		Id:         s.ID,
+		Spec:       PbConfigSchemaSpec(s.Spec),
+		Attachment: PbConfigSchemaAttachment(s.Attachment),
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbConfigSchemaSpec convert table ConfigSchemaSpec to pb ConfigSchemaSpec
+func PbConfigSchemaSpec(spec *table.ConfigSchemaSpec) *ConfigSchemaSpec { //nolint:revive
+	if spec == nil {
+		return nil
+	}
+
+	return &ConfigSchemaSpec{
+		ResourceType: string(spec.ResourceType),
+		ConfigKey:    spec.ConfigKey,
+		SchemaType:   string(spec.SchemaType),
+		Schema:       spec.Schema,
+		Memo:         spec.Memo,
+	}
+}
+
+// PbConfigSchemaAttachment convert table ConfigSchemaAttachment to pb ConfigSchemaAttachment
+func PbConfigSchemaAttachment(at *table.ConfigSchemaAttachment) *ConfigSchemaAttachment { //nolint:revive
+	if at == nil {
+		return nil
+	}
+
+	return &ConfigSchemaAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
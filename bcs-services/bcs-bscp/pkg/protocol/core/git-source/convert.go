@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbgs provides git source core protocol struct and convert functions.
+package pbgs
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+)
+
+// GitSourceSpec convert pb GitSourceSpec to table GitSourceSpec
+func (m *GitSourceSpec) GitSourceSpec() *table.GitSourceSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.GitSourceSpec{
+		URL:         m.Url,
+		Ref:         m.Ref,
+		Path:        m.Path,
+		Username:    m.Username,
+		Token:       m.Token,
+		AutoRelease: m.AutoRelease,
+		IntervalSec: m.IntervalSec,
+		Enabled:     m.Enabled,
+		Memo:        m.Memo,
+	}
+}
+
+// GitSourceAttachment convert pb GitSourceAttachment to table GitSourceAttachment
+func (m *GitSourceAttachment) GitSourceAttachment() *table.GitSourceAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.GitSourceAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbGitSource convert table GitSource to pb GitSource
+func PbGitSource(s *table.GitSource) *GitSource {
+	if s == nil {
+		return nil
+	}
+
+	return &GitSource{
+		Id:         s.ID,
+		Spec:       PbGitSourceSpec(s.Spec),
+		Status:     PbGitSourceStatus(s.Status),
+		Attachment: PbGitSourceAttachment(s.Attachment),
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbGitSourceSpec convert table GitSourceSpec to pb GitSourceSpec, the token is not returned.
+func PbGitSourceSpec(spec *table.GitSourceSpec) *GitSourceSpec { //nolint:revive
+	if spec == nil {
+		return nil
+	}
+
+	return &GitSourceSpec{
+		Url:         spec.URL,
+		Ref:         spec.Ref,
+		Path:        spec.Path,
+		Username:    spec.Username,
+		AutoRelease: spec.AutoRelease,
+		IntervalSec: spec.IntervalSec,
+		Enabled:     spec.Enabled,
+		Memo:        spec.Memo,
+		HasToken:    len(spec.Token) != 0,
+	}
+}
+
+// PbGitSourceStatus convert table GitSourceStatus to pb GitSourceStatus
+func PbGitSourceStatus(status *table.GitSourceStatus) *GitSourceStatus { //nolint:revive
+	if status == nil {
+		return nil
+	}
+
+	result := &GitSourceStatus{
+		LastCommit:    status.LastCommit,
+		LastReleaseId: status.LastReleaseID,
+		SyncState:     string(status.SyncState),
+		SyncMessage:   status.SyncMessage,
+	}
+	if status.SyncedAt != nil {
+		result.SyncedAt = timestamppb.New(*status.SyncedAt)
+	}
+
+	return result
+}
+
+// PbGitSourceAttachment convert table GitSourceAttachment to pb GitSourceAttachment
+func PbGitSourceAttachment(at *table.GitSourceAttachment) *GitSourceAttachment { //nolint:revive
+	if at == nil {
+		return nil
+	}
+
+	return &GitSourceAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+	pbtv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/template-variable"
 	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/runtime/selector"
 )
 
@@ -54,12 +55,18 @@ func (m *GroupSpec) GroupSpec() (*table.GroupSpec, error) {
 		selector = s
 	}
 
+	variables := make(table.AppVariables, 0, len(m.Variables))
+	for _, v := range m.Variables {
+		variables = append(variables, v.TemplateVariableSpec())
+	}
+
 	return &table.GroupSpec{
-		Name:     m.Name,
-		Public:   m.Public,
-		Mode:     table.GroupMode(m.Mode),
-		Selector: selector,
-		UID:      m.Uid,
+		Name:      m.Name,
+		Public:    m.Public,
+		Mode:      table.GroupMode(m.Mode),
+		Selector:  selector,
+		UID:       m.Uid,
+		Variables: variables,
 	}, nil
 }
 
@@ -79,11 +86,12 @@ func PbGroupSpec(spec *table.GroupSpec) (*GroupSpec, error) { //nolint:revive
 	}
 
 	return &GroupSpec{
-		Name:     spec.Name,
-		Public:   spec.Public,
-		Mode:     string(spec.Mode),
-		Selector: sel,
-		Uid:      spec.UID,
+		Name:      spec.Name,
+		Public:    spec.Public,
+		Mode:      string(spec.Mode),
+		Selector:  sel,
+		Uid:       spec.UID,
+		Variables: pbtv.PbTemplateVariableSpecs(spec.Variables),
 	}, nil
 }
 
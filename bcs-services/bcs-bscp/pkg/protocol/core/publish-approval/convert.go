@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbpa provides publish approval core protocol struct and convert functions.
+package pbpa
+
+import (
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+)
+
+// PbPublishApprovals convert table PublishApprovals to pb PublishApprovals
+func PbPublishApprovals(s []*table.PublishApproval) []*PublishApproval {
+	if s == nil {
+		return make([]*PublishApproval, 0)
+	}
+
+	result := make([]*PublishApproval, 0)
+	for _, one := range s {
+		result = append(result, PbPublishApproval(one))
+	}
+
+	return result
+}
+
+// PbPublishApproval convert table PublishApproval to pb PublishApproval
+func PbPublishApproval(s *table.PublishApproval) *PublishApproval {
+	if s == nil {
+		return nil
+	}
+
+	return &PublishApproval{
+		Id:         s.ID,
+		Spec:       PbPublishApprovalSpec(s.Spec),
+		Attachment: PbPublishApprovalAttachment(s.Attachment),
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbPublishApprovalSpec convert table PublishApprovalSpec to pb PublishApprovalSpec
+func PbPublishApprovalSpec(spec *table.PublishApprovalSpec) *PublishApprovalSpec { //nolint:revive
+	if spec == nil {
+		return nil
+	}
+
+	return &PublishApprovalSpec{
+		ReleaseId:  spec.ReleaseID,
+		All:        spec.All,
+		Default:    spec.AsDefault,
+		Memo:       spec.Memo,
+		Groups:     spec.Groups,
+		Approvers:  spec.Approvers,
+		Diff:       PbReleaseDiffs(spec.Diff),
+		Status:     spec.Status.String(),
+		StrategyId: spec.StrategyID,
+		ExpireAt:   timestamppb.New(spec.ExpireAt),
+		Reason:     spec.Reason,
+	}
+}
+
+// PbReleaseDiffs convert table PublishDiff to pb ReleaseDiffs
+func PbReleaseDiffs(diff table.PublishDiff) []*ReleaseDiff {
+	result := make([]*ReleaseDiff, 0, len(diff))
+	for _, one := range diff {
+		result = append(result, &ReleaseDiff{
+			BaseReleaseId: one.BaseReleaseID,
+			GroupIds:      one.GroupIDs,
+			Added:         one.Added,
+			Modified:      one.Modified,
+			Deleted:       one.Deleted,
+		})
+	}
+
+	return result
+}
+
+// PbPublishApprovalAttachment convert table PublishApprovalAttachment to pb PublishApprovalAttachment
+func PbPublishApprovalAttachment(at *table.PublishApprovalAttachment) *PublishApprovalAttachment { //nolint:revive
+	if at == nil {
+		return nil
+	}
+
+	return &PublishApprovalAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
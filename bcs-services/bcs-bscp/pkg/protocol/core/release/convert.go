@@ -25,10 +25,11 @@ func (m *ReleaseSpec) ReleaseSpec() *table.ReleaseSpec {
 	}
 
 	return &table.ReleaseSpec{
-		Name:       m.Name,
-		Memo:       m.Memo,
-		Deprecated: m.Deprecated,
-		PublishNum: m.PublishNum,
+		Name:         m.Name,
+		Memo:         m.Memo,
+		Deprecated:   m.Deprecated,
+		PublishNum:   m.PublishNum,
+		SourceCommit: m.SourceCommit,
 	}
 }
 
@@ -41,10 +42,11 @@ func PbReleaseSpec(spec *table.ReleaseSpec) *ReleaseSpec {
 	}
 
 	return &ReleaseSpec{
-		Name:       spec.Name,
-		Memo:       spec.Memo,
-		Deprecated: spec.Deprecated,
-		PublishNum: spec.PublishNum,
+		Name:         spec.Name,
+		Memo:         spec.Memo,
+		Deprecated:   spec.Deprecated,
+		PublishNum:   spec.PublishNum,
+		SourceCommit: spec.SourceCommit,
 	}
 }
 
@@ -91,15 +91,16 @@ func CacheReleasedGroup(s *table.ReleasedGroup) *types.ReleasedGroupCache {
 	}
 
 	return &types.ReleasedGroupCache{
-		ID:         s.ID,
-		GroupID:    s.GroupID,
-		AppID:      s.AppID,
-		ReleaseID:  s.ReleaseID,
-		StrategyID: s.StrategyID,
-		Mode:       s.Mode,
-		Selector:   s.Selector,
-		UID:        s.UID,
-		BizID:      s.BizID,
-		UpdatedAt:  s.UpdatedAt,
+		ID:               s.ID,
+		GroupID:          s.GroupID,
+		AppID:            s.AppID,
+		ReleaseID:        s.ReleaseID,
+		StrategyID:       s.StrategyID,
+		Mode:             s.Mode,
+		Selector:         s.Selector,
+		UID:              s.UID,
+		BizID:            s.BizID,
+		UpdatedAt:        s.UpdatedAt,
+		RenderedContents: s.RenderedContents,
 	}
 }
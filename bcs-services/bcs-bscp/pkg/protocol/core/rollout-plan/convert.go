@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbrp provides rollout plan core protocol struct and convert functions.
+package pbrp
+
+import (
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+)
+
+// PbRolloutPlans convert table RolloutPlans to pb RolloutPlans
+func PbRolloutPlans(s []*table.RolloutPlan) []*RolloutPlan {
+	if s == nil {
+		return make([]*RolloutPlan, 0)
+	}
+
+	result := make([]*RolloutPlan, 0)
+	for _, one := range s {
+		result = append(result, PbRolloutPlan(one))
+	}
+
+	return result
+}
+
+// PbRolloutPlan convert table RolloutPlan to pb RolloutPlan
+func PbRolloutPlan(s *table.RolloutPlan) *RolloutPlan {
+	if s == nil {
+		return nil
+	}
+
+	return &RolloutPlan{
+		Id:         s.ID,
+		Spec:       PbRolloutPlanSpec(s.Spec),
+		Attachment: PbRolloutPlanAttachment(s.Attachment),
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbRolloutPlanSpec convert table RolloutPlanSpec to pb RolloutPlanSpec
+func PbRolloutPlanSpec(spec *table.RolloutPlanSpec) *RolloutPlanSpec { //nolint:revive
+	if spec == nil {
+		return nil
+	}
+
+	return &RolloutPlanSpec{
+		ReleaseId:         spec.ReleaseID,
+		PreviousReleaseId: spec.PreviousReleaseID,
+		GroupId:           spec.GroupID,
+		Stages:            spec.Stages,
+		CurrentStage:      spec.CurrentStage,
+		ObserveMinutes:    spec.ObserveMinutes,
+		MinClients:        spec.MinClients,
+		FailureThreshold:  spec.FailureThreshold,
+		OnFailure:         spec.OnFailure.String(),
+		Status:            spec.Status.String(),
+		StageStartedAt:    timestamppb.New(spec.StageStartedAt),
+		Memo:              spec.Memo,
+		Reason:            spec.Reason,
+	}
+}
+
+// PbRolloutPlanAttachment convert table RolloutPlanAttachment to pb RolloutPlanAttachment
+func PbRolloutPlanAttachment(at *table.RolloutPlanAttachment) *RolloutPlanAttachment { //nolint:revive
+	if at == nil {
+		return nil
+	}
+
+	return &RolloutPlanAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
@@ -38,8 +38,9 @@ func (m *TemplateSpaceSpec) TemplateSpaceSpec() *table.TemplateSpaceSpec {
 	}
 
 	return &table.TemplateSpaceSpec{
-		Name: m.Name,
-		Memo: m.Memo,
+		Name:           m.Name,
+		Memo:           m.Memo,
+		TemplateEngine: table.TemplateEngine(m.TemplateEngine),
 	}
 }
 
@@ -50,8 +51,9 @@ func PbTemplateSpaceSpec(spec *table.TemplateSpaceSpec) *TemplateSpaceSpec {
 	}
 
 	return &TemplateSpaceSpec{
-		Name: spec.Name,
-		Memo: spec.Memo,
+		Name:           spec.Name,
+		Memo:           spec.Memo,
+		TemplateEngine: string(spec.TemplateEngine),
 	}
 }
 
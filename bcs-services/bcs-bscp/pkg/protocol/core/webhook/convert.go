@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbwh provides webhook core protocol struct and convert functions.
+package pbwh
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/base"
+)
+
+// WebhookSpec convert pb WebhookSpec to table WebhookSpec
+func (m *WebhookSpec) WebhookSpec() *table.WebhookSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.WebhookSpec{
+		Name:    m.Name,
+		URL:     m.Url,
+		Secret:  m.Secret,
+		Events:  m.Events,
+		Enabled: m.Enabled,
+		Memo:    m.Memo,
+	}
+}
+
+// WebhookAttachment convert pb WebhookAttachment to table WebhookAttachment
+func (m *WebhookAttachment) WebhookAttachment() *table.WebhookAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.WebhookAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbWebhooks convert table Webhooks to pb Webhooks
+func PbWebhooks(s []*table.Webhook) []*Webhook {
+	if s == nil {
+		return make([]*Webhook, 0)
+	}
+
+	result := make([]*Webhook, 0)
+	for _, one := range s {
+		result = append(result, PbWebhook(one))
+	}
+
+	return result
+}
+
+// PbWebhook convert table Webhook to pb Webhook
+func PbWebhook(s *table.Webhook) *Webhook {
+	if s == nil {
+		return nil
+	}
+
+	return &Webhook{
+		Id:         s.ID,
+		Spec:       PbWebhookSpec(s.Spec),
+		Attachment: PbWebhookAttachment(s.Attachment),
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbWebhookSpec convert table WebhookSpec to pb WebhookSpec, the secret is not returned.
+func PbWebhookSpec(spec *table.WebhookSpec) *WebhookSpec { //nolint:revive
+	if spec == nil {
+		return nil
+	}
+
+	events := spec.Events
+	if events == nil {
+		events = make([]string, 0)
+	}
+
+	return &WebhookSpec{
+		Name:      spec.Name,
+		Url:       spec.URL,
+		Events:    events,
+		Enabled:   spec.Enabled,
+		Memo:      spec.Memo,
+		HasSecret: len(spec.Secret) != 0,
+	}
+}
+
+// PbWebhookAttachment convert table WebhookAttachment to pb WebhookAttachment
+func PbWebhookAttachment(at *table.WebhookAttachment) *WebhookAttachment { //nolint:revive
+	if at == nil {
+		return nil
+	}
+
+	return &WebhookAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// PbWebhookDeliveries convert table WebhookDeliveries to pb WebhookDeliveries
+func PbWebhookDeliveries(s []*table.WebhookDelivery) []*WebhookDelivery {
+	if s == nil {
+		return make([]*WebhookDelivery, 0)
+	}
+
+	result := make([]*WebhookDelivery, 0)
+	for _, one := range s {
+		result = append(result, PbWebhookDelivery(one))
+	}
+
+	return result
+}
+
+// PbWebhookDelivery convert table WebhookDelivery to pb WebhookDelivery
+func PbWebhookDelivery(s *table.WebhookDelivery) *WebhookDelivery {
+	if s == nil {
+		return nil
+	}
+
+	result := &WebhookDelivery{
+		Id:       s.ID,
+		Revision: pbbase.PbRevision(s.Revision),
+	}
+	if s.Spec != nil {
+		result.Spec = &WebhookDeliverySpec{
+			NoticeId:      s.Spec.NoticeID,
+			WebhookId:     s.Spec.WebhookID,
+			Event:         string(s.Spec.Event),
+			Payload:       s.Spec.Payload,
+			Status:        s.Spec.Status.String(),
+			Attempts:      s.Spec.Attempts,
+			NextAttemptAt: timestamppb.New(s.Spec.NextAttemptAt),
+			LastError:     s.Spec.LastError,
+		}
+	}
+	if s.Attachment != nil {
+		result.Attachment = &WebhookAttachment{
+			BizId: s.Attachment.BizID,
+			AppId: s.Attachment.AppID,
+		}
+	}
+
+	return result
+}
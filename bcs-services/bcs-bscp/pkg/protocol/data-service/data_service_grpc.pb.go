@@ -14,9 +14,11 @@ import (
 	commit "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/commit"
 	config_item "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/config-item"
 	content "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/content"
+	git_source "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/git-source"
 	group "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/group"
 	hook_revision "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/hook-revision"
 	release "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release"
+	release_diff "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/release-diff"
 	released_ci "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/released-ci"
 	released_kv "github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/protocol/core/released-kv"
 	grpc "google.golang.org/grpc"
@@ -62,6 +64,8 @@ const (
 	Data_DeprecateRelease_FullMethodName                  = "/pbds.Data/DeprecateRelease"
 	Data_UnDeprecateRelease_FullMethodName                = "/pbds.Data/UnDeprecateRelease"
 	Data_DeleteRelease_FullMethodName                     = "/pbds.Data/DeleteRelease"
+	Data_CompareReleases_FullMethodName                   = "/pbds.Data/CompareReleases"
+	Data_PromoteRelease_FullMethodName                    = "/pbds.Data/PromoteRelease"
 	Data_GetReleasedConfigItem_FullMethodName             = "/pbds.Data/GetReleasedConfigItem"
 	Data_GetReleasedKv_FullMethodName                     = "/pbds.Data/GetReleasedKv"
 	Data_ListReleasedKvs_FullMethodName                   = "/pbds.Data/ListReleasedKvs"
@@ -155,6 +159,14 @@ const (
 	Data_ListGroupReleasedApps_FullMethodName             = "/pbds.Data/ListGroupReleasedApps"
 	Data_Publish_FullMethodName                           = "/pbds.Data/Publish"
 	Data_GenerateReleaseAndPublish_FullMethodName         = "/pbds.Data/GenerateReleaseAndPublish"
+	Data_ApprovePublish_FullMethodName                    = "/pbds.Data/ApprovePublish"
+	Data_RejectPublish_FullMethodName                     = "/pbds.Data/RejectPublish"
+	Data_ListPublishApprovals_FullMethodName              = "/pbds.Data/ListPublishApprovals"
+	Data_CreateRolloutPlan_FullMethodName                 = "/pbds.Data/CreateRolloutPlan"
+	Data_ListRolloutPlans_FullMethodName                  = "/pbds.Data/ListRolloutPlans"
+	Data_PauseRolloutPlan_FullMethodName                  = "/pbds.Data/PauseRolloutPlan"
+	Data_ResumeRolloutPlan_FullMethodName                 = "/pbds.Data/ResumeRolloutPlan"
+	Data_RollbackRolloutPlan_FullMethodName               = "/pbds.Data/RollbackRolloutPlan"
 	Data_CreateCredential_FullMethodName                  = "/pbds.Data/CreateCredential"
 	Data_ListCredentials_FullMethodName                   = "/pbds.Data/ListCredentials"
 	Data_DeleteCredential_FullMethodName                  = "/pbds.Data/DeleteCredential"
@@ -170,6 +182,20 @@ const (
 	Data_BatchUpsertKvs_FullMethodName                    = "/pbds.Data/BatchUpsertKvs"
 	Data_UnDeleteKv_FullMethodName                        = "/pbds.Data/UnDeleteKv"
 	Data_UndoKv_FullMethodName                            = "/pbds.Data/UndoKv"
+	Data_CreateConfigSchema_FullMethodName                = "/pbds.Data/CreateConfigSchema"
+	Data_UpdateConfigSchema_FullMethodName                = "/pbds.Data/UpdateConfigSchema"
+	Data_DeleteConfigSchema_FullMethodName                = "/pbds.Data/DeleteConfigSchema"
+	Data_ListConfigSchemas_FullMethodName                 = "/pbds.Data/ListConfigSchemas"
+	Data_CreateWebhook_FullMethodName                     = "/pbds.Data/CreateWebhook"
+	Data_UpdateWebhook_FullMethodName                     = "/pbds.Data/UpdateWebhook"
+	Data_DeleteWebhook_FullMethodName                     = "/pbds.Data/DeleteWebhook"
+	Data_ListWebhooks_FullMethodName                      = "/pbds.Data/ListWebhooks"
+	Data_ListWebhookDeliveries_FullMethodName             = "/pbds.Data/ListWebhookDeliveries"
+	Data_CreateGitSource_FullMethodName                   = "/pbds.Data/CreateGitSource"
+	Data_UpdateGitSource_FullMethodName                   = "/pbds.Data/UpdateGitSource"
+	Data_DeleteGitSource_FullMethodName                   = "/pbds.Data/DeleteGitSource"
+	Data_GetGitSource_FullMethodName                      = "/pbds.Data/GetGitSource"
+	Data_SyncGitSource_FullMethodName                     = "/pbds.Data/SyncGitSource"
 	Data_ListClients_FullMethodName                       = "/pbds.Data/ListClients"
 	Data_ListClientEvents_FullMethodName                  = "/pbds.Data/ListClientEvents"
 	Data_ListClientQuerys_FullMethodName                  = "/pbds.Data/ListClientQuerys"
@@ -231,6 +257,8 @@ type DataClient interface {
 	DeprecateRelease(ctx context.Context, in *DeprecateReleaseReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	UnDeprecateRelease(ctx context.Context, in *UnDeprecateReleaseReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	DeleteRelease(ctx context.Context, in *DeleteReleaseReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	CompareReleases(ctx context.Context, in *CompareReleasesReq, opts ...grpc.CallOption) (*release_diff.ReleaseDiff, error)
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
 	// released config item related interface.
 	GetReleasedConfigItem(ctx context.Context, in *GetReleasedCIReq, opts ...grpc.CallOption) (*released_ci.ReleasedConfigItem, error)
 	// released kv related interface.
@@ -339,6 +367,14 @@ type DataClient interface {
 	// publish related interface.
 	Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error)
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	ApprovePublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	RejectPublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListPublishApprovals(ctx context.Context, in *ListPublishApprovalsReq, opts ...grpc.CallOption) (*ListPublishApprovalsResp, error)
+	CreateRolloutPlan(ctx context.Context, in *CreateRolloutPlanReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListRolloutPlans(ctx context.Context, in *ListRolloutPlansReq, opts ...grpc.CallOption) (*ListRolloutPlansResp, error)
+	PauseRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ResumeRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	RollbackRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	// credential related interface
 	CreateCredential(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateResp, error)
 	ListCredentials(ctx context.Context, in *ListCredentialReq, opts ...grpc.CallOption) (*ListCredentialResp, error)
@@ -357,6 +393,23 @@ type DataClient interface {
 	BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error)
 	UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// config schema related interface
+	CreateConfigSchema(ctx context.Context, in *CreateConfigSchemaReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateConfigSchema(ctx context.Context, in *UpdateConfigSchemaReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListConfigSchemas(ctx context.Context, in *ListConfigSchemasReq, opts ...grpc.CallOption) (*ListConfigSchemasResp, error)
+	// webhook related interface
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error)
+	// git source related interface
+	CreateGitSource(ctx context.Context, in *CreateGitSourceReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateGitSource(ctx context.Context, in *UpdateGitSourceReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteGitSource(ctx context.Context, in *DeleteGitSourceReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetGitSource(ctx context.Context, in *GetGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error)
+	SyncGitSource(ctx context.Context, in *SyncGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error)
 	// client related interface
 	ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error)
 	// client event related interface
@@ -671,6 +724,24 @@ func (c *dataClient) DeleteRelease(ctx context.Context, in *DeleteReleaseReq, op
 	return out, nil
 }
 
+func (c *dataClient) CompareReleases(ctx context.Context, in *CompareReleasesReq, opts ...grpc.CallOption) (*release_diff.ReleaseDiff, error) {
+	out := new(release_diff.ReleaseDiff)
+	err := c.cc.Invoke(ctx, Data_CompareReleases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Data_PromoteRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) GetReleasedConfigItem(ctx context.Context, in *GetReleasedCIReq, opts ...grpc.CallOption) (*released_ci.ReleasedConfigItem, error) {
 	out := new(released_ci.ReleasedConfigItem)
 	err := c.cc.Invoke(ctx, Data_GetReleasedConfigItem_FullMethodName, in, out, opts...)
@@ -1508,6 +1579,78 @@ func (c *dataClient) GenerateReleaseAndPublish(ctx context.Context, in *Generate
 	return out, nil
 }
 
+func (c *dataClient) ApprovePublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Data_ApprovePublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RejectPublish(ctx context.Context, in *ReviewPublishReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RejectPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListPublishApprovals(ctx context.Context, in *ListPublishApprovalsReq, opts ...grpc.CallOption) (*ListPublishApprovalsResp, error) {
+	out := new(ListPublishApprovalsResp)
+	err := c.cc.Invoke(ctx, Data_ListPublishApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CreateRolloutPlan(ctx context.Context, in *CreateRolloutPlanReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListRolloutPlans(ctx context.Context, in *ListRolloutPlansReq, opts ...grpc.CallOption) (*ListRolloutPlansResp, error) {
+	out := new(ListRolloutPlansResp)
+	err := c.cc.Invoke(ctx, Data_ListRolloutPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PauseRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_PauseRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ResumeRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_ResumeRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RollbackRolloutPlan(ctx context.Context, in *OperateRolloutPlanReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RollbackRolloutPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) CreateCredential(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateResp, error) {
 	out := new(CreateResp)
 	err := c.cc.Invoke(ctx, Data_CreateCredential_FullMethodName, in, out, opts...)
@@ -1643,6 +1786,132 @@ func (c *dataClient) UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *dataClient) CreateConfigSchema(ctx context.Context, in *CreateConfigSchemaReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) UpdateConfigSchema(ctx context.Context, in *UpdateConfigSchemaReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListConfigSchemas(ctx context.Context, in *ListConfigSchemasReq, opts ...grpc.CallOption) (*ListConfigSchemasResp, error) {
+	out := new(ListConfigSchemasResp)
+	err := c.cc.Invoke(ctx, Data_ListConfigSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (*ListWebhooksResp, error) {
+	out := new(ListWebhooksResp)
+	err := c.cc.Invoke(ctx, Data_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesResp, error) {
+	out := new(ListWebhookDeliveriesResp)
+	err := c.cc.Invoke(ctx, Data_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CreateGitSource(ctx context.Context, in *CreateGitSourceReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) UpdateGitSource(ctx context.Context, in *UpdateGitSourceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) DeleteGitSource(ctx context.Context, in *DeleteGitSourceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetGitSource(ctx context.Context, in *GetGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error) {
+	out := new(git_source.GitSource)
+	err := c.cc.Invoke(ctx, Data_GetGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) SyncGitSource(ctx context.Context, in *SyncGitSourceReq, opts ...grpc.CallOption) (*git_source.GitSource, error) {
+	out := new(git_source.GitSource)
+	err := c.cc.Invoke(ctx, Data_SyncGitSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error) {
 	out := new(ListClientsResp)
 	err := c.cc.Invoke(ctx, Data_ListClients_FullMethodName, in, out, opts...)
@@ -1846,6 +2115,8 @@ type DataServer interface {
 	DeprecateRelease(context.Context, *DeprecateReleaseReq) (*base.EmptyResp, error)
 	UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error)
 	DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error)
+	CompareReleases(context.Context, *CompareReleasesReq) (*release_diff.ReleaseDiff, error)
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
 	// released config item related interface.
 	GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error)
 	// released kv related interface.
@@ -1954,6 +2225,14 @@ type DataServer interface {
 	// publish related interface.
 	Publish(context.Context, *PublishReq) (*PublishResp, error)
 	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
+	ApprovePublish(context.Context, *ReviewPublishReq) (*PublishResp, error)
+	RejectPublish(context.Context, *ReviewPublishReq) (*base.EmptyResp, error)
+	ListPublishApprovals(context.Context, *ListPublishApprovalsReq) (*ListPublishApprovalsResp, error)
+	CreateRolloutPlan(context.Context, *CreateRolloutPlanReq) (*CreateResp, error)
+	ListRolloutPlans(context.Context, *ListRolloutPlansReq) (*ListRolloutPlansResp, error)
+	PauseRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error)
+	ResumeRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error)
+	RollbackRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error)
 	// credential related interface
 	CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error)
 	ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error)
@@ -1972,6 +2251,23 @@ type DataServer interface {
 	BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error)
 	UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error)
 	UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error)
+	// config schema related interface
+	CreateConfigSchema(context.Context, *CreateConfigSchemaReq) (*CreateResp, error)
+	UpdateConfigSchema(context.Context, *UpdateConfigSchemaReq) (*base.EmptyResp, error)
+	DeleteConfigSchema(context.Context, *DeleteConfigSchemaReq) (*base.EmptyResp, error)
+	ListConfigSchemas(context.Context, *ListConfigSchemasReq) (*ListConfigSchemasResp, error)
+	// webhook related interface
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateResp, error)
+	UpdateWebhook(context.Context, *UpdateWebhookReq) (*base.EmptyResp, error)
+	DeleteWebhook(context.Context, *DeleteWebhookReq) (*base.EmptyResp, error)
+	ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error)
+	// git source related interface
+	CreateGitSource(context.Context, *CreateGitSourceReq) (*CreateResp, error)
+	UpdateGitSource(context.Context, *UpdateGitSourceReq) (*base.EmptyResp, error)
+	DeleteGitSource(context.Context, *DeleteGitSourceReq) (*base.EmptyResp, error)
+	GetGitSource(context.Context, *GetGitSourceReq) (*git_source.GitSource, error)
+	SyncGitSource(context.Context, *SyncGitSourceReq) (*git_source.GitSource, error)
 	// client related interface
 	ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error)
 	// client event related interface
@@ -2096,6 +2392,12 @@ func (UnimplementedDataServer) UnDeprecateRelease(context.Context, *UnDeprecateR
 func (UnimplementedDataServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
 }
+func (UnimplementedDataServer) CompareReleases(context.Context, *CompareReleasesReq) (*release_diff.ReleaseDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareReleases not implemented")
+}
+func (UnimplementedDataServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
 func (UnimplementedDataServer) GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
 }
@@ -2375,6 +2677,30 @@ func (UnimplementedDataServer) Publish(context.Context, *PublishReq) (*PublishRe
 func (UnimplementedDataServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
 }
+func (UnimplementedDataServer) ApprovePublish(context.Context, *ReviewPublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePublish not implemented")
+}
+func (UnimplementedDataServer) RejectPublish(context.Context, *ReviewPublishReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPublish not implemented")
+}
+func (UnimplementedDataServer) ListPublishApprovals(context.Context, *ListPublishApprovalsReq) (*ListPublishApprovalsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublishApprovals not implemented")
+}
+func (UnimplementedDataServer) CreateRolloutPlan(context.Context, *CreateRolloutPlanReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRolloutPlan not implemented")
+}
+func (UnimplementedDataServer) ListRolloutPlans(context.Context, *ListRolloutPlansReq) (*ListRolloutPlansResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRolloutPlans not implemented")
+}
+func (UnimplementedDataServer) PauseRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRolloutPlan not implemented")
+}
+func (UnimplementedDataServer) ResumeRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRolloutPlan not implemented")
+}
+func (UnimplementedDataServer) RollbackRolloutPlan(context.Context, *OperateRolloutPlanReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRolloutPlan not implemented")
+}
 func (UnimplementedDataServer) CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
 }
@@ -2420,6 +2746,48 @@ func (UnimplementedDataServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*bas
 func (UnimplementedDataServer) UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
 }
+func (UnimplementedDataServer) CreateConfigSchema(context.Context, *CreateConfigSchemaReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigSchema not implemented")
+}
+func (UnimplementedDataServer) UpdateConfigSchema(context.Context, *UpdateConfigSchemaReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigSchema not implemented")
+}
+func (UnimplementedDataServer) DeleteConfigSchema(context.Context, *DeleteConfigSchemaReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigSchema not implemented")
+}
+func (UnimplementedDataServer) ListConfigSchemas(context.Context, *ListConfigSchemasReq) (*ListConfigSchemasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigSchemas not implemented")
+}
+func (UnimplementedDataServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedDataServer) UpdateWebhook(context.Context, *UpdateWebhookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedDataServer) DeleteWebhook(context.Context, *DeleteWebhookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedDataServer) ListWebhooks(context.Context, *ListWebhooksReq) (*ListWebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedDataServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedDataServer) CreateGitSource(context.Context, *CreateGitSourceReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGitSource not implemented")
+}
+func (UnimplementedDataServer) UpdateGitSource(context.Context, *UpdateGitSourceReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGitSource not implemented")
+}
+func (UnimplementedDataServer) DeleteGitSource(context.Context, *DeleteGitSourceReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGitSource not implemented")
+}
+func (UnimplementedDataServer) GetGitSource(context.Context, *GetGitSourceReq) (*git_source.GitSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGitSource not implemented")
+}
+func (UnimplementedDataServer) SyncGitSource(context.Context, *SyncGitSourceReq) (*git_source.GitSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGitSource not implemented")
+}
 func (UnimplementedDataServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
 }
@@ -3044,6 +3412,42 @@ func _Data_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_CompareReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CompareReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CompareReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CompareReleases(ctx, req.(*CompareReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReleasedCIReq)
 	if err := dec(in); err != nil {
@@ -4718,161 +5122,305 @@ func _Data_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCredentialReq)
+func _Data_ApprovePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateCredential(ctx, in)
+		return srv.(DataServer).ApprovePublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateCredential_FullMethodName,
+		FullMethod: Data_ApprovePublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateCredential(ctx, req.(*CreateCredentialReq))
+		return srv.(DataServer).ApprovePublish(ctx, req.(*ReviewPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialReq)
+func _Data_RejectPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPublishReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListCredentials(ctx, in)
+		return srv.(DataServer).RejectPublish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListCredentials_FullMethodName,
+		FullMethod: Data_RejectPublish_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListCredentials(ctx, req.(*ListCredentialReq))
+		return srv.(DataServer).RejectPublish(ctx, req.(*ReviewPublishReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCredentialReq)
+func _Data_ListPublishApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublishApprovalsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteCredential(ctx, in)
+		return srv.(DataServer).ListPublishApprovals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteCredential_FullMethodName,
+		FullMethod: Data_ListPublishApprovals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteCredential(ctx, req.(*DeleteCredentialReq))
+		return srv.(DataServer).ListPublishApprovals(ctx, req.(*ListPublishApprovalsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialReq)
+func _Data_CreateRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateCredential(ctx, in)
+		return srv.(DataServer).CreateRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateCredential_FullMethodName,
+		FullMethod: Data_CreateRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateCredential(ctx, req.(*UpdateCredentialReq))
+		return srv.(DataServer).CreateRolloutPlan(ctx, req.(*CreateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckCredentialNameReq)
+func _Data_ListRolloutPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolloutPlansReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckCredentialName(ctx, in)
+		return srv.(DataServer).ListRolloutPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckCredentialName_FullMethodName,
+		FullMethod: Data_ListRolloutPlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+		return srv.(DataServer).ListRolloutPlans(ctx, req.(*ListRolloutPlansReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCredentialScopesReq)
+func _Data_PauseRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListCredentialScopes(ctx, in)
+		return srv.(DataServer).PauseRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListCredentialScopes_FullMethodName,
+		FullMethod: Data_PauseRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+		return srv.(DataServer).PauseRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCredentialScopesReq)
+func _Data_ResumeRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateCredentialScopes(ctx, in)
+		return srv.(DataServer).ResumeRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateCredentialScopes_FullMethodName,
+		FullMethod: Data_ResumeRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateCredentialScopes(ctx, req.(*UpdateCredentialScopesReq))
+		return srv.(DataServer).ResumeRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CredentialScopePreviewReq)
+func _Data_RollbackRolloutPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRolloutPlanReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CredentialScopePreview(ctx, in)
+		return srv.(DataServer).RollbackRolloutPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CredentialScopePreview_FullMethodName,
+		FullMethod: Data_RollbackRolloutPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+		return srv.(DataServer).RollbackRolloutPlan(ctx, req.(*OperateRolloutPlanReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateKvReq)
+func _Data_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateKv(ctx, in)
+		return srv.(DataServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateKv_FullMethodName,
+		FullMethod: Data_CreateCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateCredential(ctx, req.(*CreateCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListCredentials(ctx, req.(*ListCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteCredential(ctx, req.(*DeleteCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateCredential(ctx, req.(*UpdateCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CheckCredentialName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCredentialNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CheckCredentialName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CheckCredentialName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CheckCredentialName(ctx, req.(*CheckCredentialNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialScopesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListCredentialScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListCredentialScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListCredentialScopes(ctx, req.(*ListCredentialScopesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateCredentialScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialScopesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateCredentialScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateCredentialScopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateCredentialScopes(ctx, req.(*UpdateCredentialScopesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialScopePreviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CredentialScopePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CredentialScopePreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CredentialScopePreview(ctx, req.(*CredentialScopePreviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CreateKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateKv_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(DataServer).CreateKv(ctx, req.(*CreateKvReq))
@@ -4988,6 +5536,258 @@ func _Data_UndoKv_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_CreateConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigSchema(ctx, req.(*CreateConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigSchema(ctx, req.(*UpdateConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteConfigSchema(ctx, req.(*DeleteConfigSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSchemasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigSchemas(ctx, req.(*ListConfigSchemasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateWebhook(ctx, req.(*UpdateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteWebhook(ctx, req.(*DeleteWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListWebhooks(ctx, req.(*ListWebhooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CreateGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateGitSource(ctx, req.(*CreateGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateGitSource(ctx, req.(*UpdateGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteGitSource(ctx, req.(*DeleteGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetGitSource(ctx, req.(*GetGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_SyncGitSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncGitSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).SyncGitSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_SyncGitSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).SyncGitSource(ctx, req.(*SyncGitSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListClientsReq)
 	if err := dec(in); err != nil {
@@ -5443,6 +6243,14 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRelease",
 			Handler:    _Data_DeleteRelease_Handler,
 		},
+		{
+			MethodName: "CompareReleases",
+			Handler:    _Data_CompareReleases_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Data_PromoteRelease_Handler,
+		},
 		{
 			MethodName: "GetReleasedConfigItem",
 			Handler:    _Data_GetReleasedConfigItem_Handler,
@@ -5815,6 +6623,38 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateReleaseAndPublish",
 			Handler:    _Data_GenerateReleaseAndPublish_Handler,
 		},
+		{
+			MethodName: "ApprovePublish",
+			Handler:    _Data_ApprovePublish_Handler,
+		},
+		{
+			MethodName: "RejectPublish",
+			Handler:    _Data_RejectPublish_Handler,
+		},
+		{
+			MethodName: "ListPublishApprovals",
+			Handler:    _Data_ListPublishApprovals_Handler,
+		},
+		{
+			MethodName: "CreateRolloutPlan",
+			Handler:    _Data_CreateRolloutPlan_Handler,
+		},
+		{
+			MethodName: "ListRolloutPlans",
+			Handler:    _Data_ListRolloutPlans_Handler,
+		},
+		{
+			MethodName: "PauseRolloutPlan",
+			Handler:    _Data_PauseRolloutPlan_Handler,
+		},
+		{
+			MethodName: "ResumeRolloutPlan",
+			Handler:    _Data_ResumeRolloutPlan_Handler,
+		},
+		{
+			MethodName: "RollbackRolloutPlan",
+			Handler:    _Data_RollbackRolloutPlan_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _Data_CreateCredential_Handler,
@@ -5875,6 +6715,62 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndoKv",
 			Handler:    _Data_UndoKv_Handler,
 		},
+		{
+			MethodName: "CreateConfigSchema",
+			Handler:    _Data_CreateConfigSchema_Handler,
+		},
+		{
+			MethodName: "UpdateConfigSchema",
+			Handler:    _Data_UpdateConfigSchema_Handler,
+		},
+		{
+			MethodName: "DeleteConfigSchema",
+			Handler:    _Data_DeleteConfigSchema_Handler,
+		},
+		{
+			MethodName: "ListConfigSchemas",
+			Handler:    _Data_ListConfigSchemas_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Data_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Data_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Data_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Data_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Data_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateGitSource",
+			Handler:    _Data_CreateGitSource_Handler,
+		},
+		{
+			MethodName: "UpdateGitSource",
+			Handler:    _Data_UpdateGitSource_Handler,
+		},
+		{
+			MethodName: "DeleteGitSource",
+			Handler:    _Data_DeleteGitSource_Handler,
+		},
+		{
+			MethodName: "GetGitSource",
+			Handler:    _Data_GetGitSource_Handler,
+		},
+		{
+			MethodName: "SyncGitSource",
+			Handler:    _Data_SyncGitSource_Handler,
+		},
 		{
 			MethodName: "ListClients",
 			Handler:    _Data_ListClients_Handler,
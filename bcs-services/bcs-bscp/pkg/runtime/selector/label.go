@@ -57,6 +57,15 @@ func (lb Label) Equal(other Label) bool {
 	return true
 }
 
+// UIDLabelKey is the built-in label key which is filled with the instance's uid when matching,
+// it can not be reported by the instance itself as it uses the system reserved prefix.
+const UIDLabelKey = "bscp.uid"
+
+// IsBuiltInLabelKey returns whether the label key is a built-in one.
+func IsBuiltInLabelKey(key string) bool {
+	return key == UIDLabelKey
+}
+
 // Element defines the basic element of a label
 type Element struct {
 	Key   string      `json:"key"`
@@ -75,8 +84,10 @@ func (e *Element) Validate() error {
 		return errors.New("empty element")
 	}
 
-	if err := validator.ValidateLabelKey(e.Key); err != nil {
-		return fmt.Errorf("invalid label key: %s, %v", e.Key, err)
+	if !IsBuiltInLabelKey(e.Key) {
+		if err := validator.ValidateLabelKey(e.Key); err != nil {
+			return fmt.Errorf("invalid label key: %s, %v", e.Key, err)
+		}
 	}
 
 	// validate operator
@@ -103,7 +114,7 @@ func (e *Element) Equal(other *Element) bool {
 	}
 
 	switch e.Op.Name() {
-	case Equal, NotEqual, GreaterThan, GreaterThanEqual, LessThan, LessThanEqual, Regex, NotRegex:
+	case Equal, NotEqual, GreaterThan, GreaterThanEqual, LessThan, LessThanEqual, Regex, NotRegex, Percent:
 		if e.Value != other.Value {
 			return false
 		}
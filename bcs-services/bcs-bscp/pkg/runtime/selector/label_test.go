@@ -17,6 +17,7 @@ package selector
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	pbstruct "github.com/golang/protobuf/ptypes/struct"
@@ -563,6 +564,87 @@ func TestUnmarshalNotRegexElement(t *testing.T) {
 
 }
 
+func TestUnmarshalPercentElement(t *testing.T) {
+
+	const pctJSON = `
+	{
+		"key": "bscp.uid",
+		"op": "pct",
+		"value": 10
+	}`
+
+	pctElement := new(Element)
+	if err := json.Unmarshal([]byte(pctJSON), pctElement); err != nil {
+		t.Errorf("test pct operator, failed, err: %v", err)
+		return
+	}
+
+	if pctElement.Key != UIDLabelKey {
+		t.Errorf("test pct operator, invalid key: %v", pctElement.Key)
+		return
+	}
+
+	if pctElement.Op != &PercentOperator {
+		t.Errorf("test pct operator, invalid op: %v", pctElement.Op)
+		return
+	}
+
+	if err := pctElement.Validate(); err != nil {
+		t.Errorf("test pct operator, validate failed, err: %v", err)
+		return
+	}
+
+	matched, err := pctElement.Match(map[string]string{"bscp.modules": "sidecar"})
+	if err != nil {
+		t.Errorf("test pct operator, match failed, err: %v", err)
+		return
+	}
+
+	if matched {
+		t.Error("test pct operator without uid, but matched")
+		return
+	}
+
+	// the instance matched with a smaller percentage should always be matched with a larger one.
+	larger := &Element{Key: UIDLabelKey, Op: &PercentOperator, Value: 50}
+	count := 0
+	for i := 0; i < 1000; i++ {
+		labels := map[string]string{UIDLabelKey: fmt.Sprintf("uid-%d", i)}
+		matched, err = pctElement.Match(labels)
+		if err != nil {
+			t.Errorf("test pct operator, match failed, err: %v", err)
+			return
+		}
+
+		if !matched {
+			continue
+		}
+		count++
+
+		matched, err = larger.Match(labels)
+		if err != nil {
+			t.Errorf("test pct operator, match failed, err: %v", err)
+			return
+		}
+
+		if !matched {
+			t.Errorf("test pct operator, %s matched with 10 percent, but not matched with 50", labels[UIDLabelKey])
+			return
+		}
+	}
+
+	if count == 0 || count > 200 {
+		t.Errorf("test pct operator, matched %d of 1000 instances with 10 percent", count)
+		return
+	}
+
+	invalid := &Element{Key: UIDLabelKey, Op: &PercentOperator, Value: 101}
+	if err := invalid.Validate(); err == nil {
+		t.Error("test pct operator with value 101, but validated")
+		return
+	}
+}
+
 func TestUnmarshalLabelOr(t *testing.T) {
 
 	const labelOrJSON = `
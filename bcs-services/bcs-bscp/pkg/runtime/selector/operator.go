@@ -15,6 +15,7 @@ package selector
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"regexp"
 	"strconv"
 
@@ -54,6 +55,7 @@ const (
 	NotIn            OperatorType = "nin"
 	Regex            OperatorType = "re"
 	NotRegex         OperatorType = "nre"
+	Percent          OperatorType = "pct"
 )
 
 // supported default operators
@@ -68,6 +70,7 @@ var (
 	NotInOperator            = NotInType(NotIn)
 	RegexOperator            = RegexType(Regex)
 	NotRegexOperator         = NotRegexType(NotRegex)
+	PercentOperator          = PercentType(Percent)
 )
 
 // OperatorEnums enum all the supported operators.
@@ -82,6 +85,7 @@ var OperatorEnums = map[OperatorType]Operator{
 	NotIn:            &NotInOperator,
 	Regex:            &RegexOperator,
 	NotRegex:         &NotRegexOperator,
+	Percent:          &PercentOperator,
 }
 
 var _ Operator = new(EqualType)
@@ -522,6 +526,53 @@ func (nre *NotRegexType) Match(match *Element, labels map[string]string) (bool,
 	return !matched, nil
 }
 
+var _ Operator = new(PercentType)
+
+// PercentType is a percent operator, it hashes the label value into one of
+// the 10000 buckets, so that a stable percentage of instances can be selected.
+type PercentType OperatorType
+
+// Name is the name of percent operator
+func (pct *PercentType) Name() OperatorType {
+	return Percent
+}
+
+// Validate valid the match element is valid to percent operator or not
+func (pct *PercentType) Validate(match *Element) error {
+	if !isNumeric(match.Value) {
+		return fmt.Errorf("invalid pct oper with value: %v, should be number", match.Value)
+	}
+
+	v := mustFloat64(match.Value)
+	if v <= 0 || v > 100 {
+		return fmt.Errorf("invalid pct oper with value: %v, should be in (0, 100]", match.Value)
+	}
+	return nil
+}
+
+// Match matched only when the match key is exist and the label value's hash bucket falls into
+// the target percentage. an instance matched with a smaller percentage is always matched with a
+// larger one, which makes it suitable for progressive rollout.
+func (pct *PercentType) Match(match *Element, labels map[string]string) (bool, error) {
+	if !isNumeric(match.Value) {
+		return false, fmt.Errorf("invalid pct oper with value: %v, should be number", match.Value)
+	}
+
+	to, exists := labels[match.Key]
+	if !exists {
+		return false, nil
+	}
+
+	return float64(PercentBucket(to)) < mustFloat64(match.Value)*100, nil
+}
+
+// PercentBucket returns the hash bucket of the value which is in [0, 10000).
+func PercentBucket(value string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(value))
+	return h.Sum32() % 10000
+}
+
 func isNumeric(val interface{}) bool {
 	switch val.(type) {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
@@ -0,0 +1,296 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gitsource fetches the files of a git repository's branch or tag, which are synchronized to bscp apps.
+package gitsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	nethttp "net/http"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/tools"
+)
+
+// defaultTokenUser is the user of the http basic auth when only the token is set.
+const defaultTokenUser = "oauth2"
+
+// ErrRefNotFound is returned when the branch or tag does not exist in the git repository.
+var ErrRefNotFound = errors.New("git reference not found")
+
+// ErrTooLarge is returned when the data downloaded or the files read exceed the max total size.
+var ErrTooLarge = errors.New("git repository exceeds the max size")
+
+// allowInternalKey is the context key of the function which allows the internal addresses to be dialed.
+type allowInternalKey struct{}
+
+// sizeBudgetKey is the context key of the size budget shared by the requests of one fetching.
+type sizeBudgetKey struct{}
+
+// sizeBudget is the bytes left to be downloaded.
+type sizeBudget struct {
+	left int64
+}
+
+// budgetTransport limits the response bodies with the size budget of the request context.
+type budgetTransport struct {
+	base nethttp.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *budgetTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if budget, ok := req.Context().Value(sizeBudgetKey{}).(*sizeBudget); ok && budget != nil {
+		resp.Body = &budgetReader{ReadCloser: resp.Body, budget: budget}
+	}
+	return resp, nil
+}
+
+// budgetReader fails the reading when the size budget is used up.
+type budgetReader struct {
+	io.ReadCloser
+	budget *sizeBudget
+}
+
+// Read implements io.Reader
+func (r *budgetReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if atomic.AddInt64(&r.budget.left, -int64(n)) < 0 {
+		return n, ErrTooLarge
+	}
+	return n, err
+}
+
+func init() {
+	// go-git only supports the global http client, the client checks the address when dialing with the allow
+	// function of the request context, so that the redirect and dns rebinding can not bypass the check.
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	// the proxy would connect to the git repository instead, which can not be checked
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		allow, _ := ctx.Value(allowInternalKey{}).(func(host string, ip net.IP) bool)
+		ips, err := tools.LookupPublicIPs(ctx, host, allow)
+		if err != nil {
+			return nil, err
+		}
+		// dial the checked ip rather than resolving the host again
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+	}
+
+	gitClient := http.NewClient(&nethttp.Client{Transport: &budgetTransport{base: transport}})
+	client.InstallProtocol("http", gitClient)
+	client.InstallProtocol("https", gitClient)
+}
+
+// Options is the options to fetch a git repository.
+type Options struct {
+	URL string
+	// Ref is the branch or tag, or the full reference name such as refs/heads/master.
+	Ref string
+	// Path is the slash separated directory in the git repository, the root directory is empty.
+	Path     string
+	Username string
+	Token    string
+	// MaxFileSize is the max size of a file, the larger file fails the fetching, no limit if it is 0.
+	MaxFileSize int64
+	// MaxTotalSize is the max size of the data downloaded from the git repository, and the max total size of the
+	// files read, the larger repository fails the fetching, no limit if it is 0.
+	MaxTotalSize int64
+	// AllowInternal returns whether the host or the ip it resolved to is allowed to be an internal address, the
+	// loopback, private or link-local addresses are refused to be connected if it is nil.
+	AllowInternal func(host string, ip net.IP) bool
+}
+
+// File is a regular file in the git repository.
+type File struct {
+	// Name is the slash separated path of the file relative to the fetched path.
+	Name       string
+	Content    []byte
+	Executable bool
+}
+
+// Snapshot is the files under the path of a commit.
+type Snapshot struct {
+	Commit string
+	Files  []*File
+}
+
+func (o *Options) auth() transport.AuthMethod {
+	if o.Username == "" && o.Token == "" {
+		return nil
+	}
+
+	user := o.Username
+	if user == "" {
+		user = defaultTokenUser
+	}
+	return &http.BasicAuth{Username: user, Password: o.Token}
+}
+
+// ResolveRef resolve the reference name and the commit it points to without cloning the repository, the
+// annotated tag is peeled to its commit.
+func ResolveRef(ctx context.Context, opt *Options) (plumbing.ReferenceName, string, error) {
+	return resolveRef(opt.withContext(ctx), opt)
+}
+
+// withContext set the options used by the http client to the context.
+func (o *Options) withContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, allowInternalKey{}, o.AllowInternal)
+	if o.MaxTotalSize > 0 {
+		ctx = context.WithValue(ctx, sizeBudgetKey{}, &sizeBudget{left: o.MaxTotalSize})
+	}
+	return ctx
+}
+
+func resolveRef(ctx context.Context, opt *Options) (plumbing.ReferenceName, string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{opt.URL},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: opt.auth(), PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return "", "", fmt.Errorf("list git references failed, err: %v", err)
+	}
+	hashes := make(map[plumbing.ReferenceName]string, len(refs))
+	for _, ref := range refs {
+		if ref.Type() == plumbing.HashReference {
+			hashes[ref.Name()] = ref.Hash().String()
+		}
+	}
+
+	candidates := []plumbing.ReferenceName{plumbing.ReferenceName(opt.Ref)}
+	if !strings.HasPrefix(opt.Ref, "refs/") {
+		candidates = []plumbing.ReferenceName{
+			plumbing.NewBranchReferenceName(opt.Ref),
+			plumbing.NewTagReferenceName(opt.Ref),
+		}
+	}
+	for _, name := range candidates {
+		if hash, ok := hashes[name+"^{}"]; ok {
+			return name, hash, nil
+		}
+		if hash, ok := hashes[name]; ok {
+			return name, hash, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("%w: %s", ErrRefNotFound, opt.Ref)
+}
+
+// Fetch clone the reference shallowly in memory, and read the regular files under the path, the symlinks,
+// submodules and the git's own files such as .gitignore are ignored.
+func Fetch(ctx context.Context, opt *Options) (*Snapshot, error) {
+	// the references and the clone share the size budget
+	ctx = opt.withContext(ctx)
+	refName, _, err := resolveRef(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:           opt.URL,
+		Auth:          opt.auth(),
+		ReferenceName: refName,
+		SingleBranch:  true,
+		Depth:         1,
+		Tags:          git.NoTags,
+	})
+	if err != nil {
+		if errors.Is(err, ErrTooLarge) {
+			return nil, fmt.Errorf("%w %d", ErrTooLarge, opt.MaxTotalSize)
+		}
+		return nil, fmt.Errorf("clone git repository failed, err: %v", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	if opt.Path != "" {
+		if tree, err = tree.Tree(opt.Path); err != nil {
+			return nil, fmt.Errorf("read path %s of commit %s failed, err: %v", opt.Path, commit.Hash, err)
+		}
+	}
+
+	snapshot := &Snapshot{Commit: commit.Hash.String(), Files: make([]*File, 0)}
+	var total int64
+	err = tree.Files().ForEach(func(f *object.File) error {
+		if f.Mode != filemode.Regular && f.Mode != filemode.Executable && f.Mode != filemode.Deprecated {
+			return nil
+		}
+		if strings.HasPrefix(path.Base(f.Name), ".git") {
+			return nil
+		}
+		if opt.MaxFileSize > 0 && f.Size > opt.MaxFileSize {
+			return fmt.Errorf("file %s exceeds the max size %d", f.Name, opt.MaxFileSize)
+		}
+		total += f.Size
+		if opt.MaxTotalSize > 0 && total > opt.MaxTotalSize {
+			return fmt.Errorf("%w %d", ErrTooLarge, opt.MaxTotalSize)
+		}
+
+		reader, e := f.Reader()
+		if e != nil {
+			return e
+		}
+		defer reader.Close()
+		content, e := io.ReadAll(reader)
+		if e != nil {
+			return e
+		}
+
+		snapshot.Files = append(snapshot.Files, &File{
+			Name:       f.Name,
+			Content:    content,
+			Executable: f.Mode == filemode.Executable,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
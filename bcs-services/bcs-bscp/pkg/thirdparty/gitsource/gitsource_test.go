@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gitsource
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResolveRefInternalAddress(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	opt := &Options{URL: server.URL + "/repo.git", Ref: "master"}
+	_, _, err := ResolveRef(context.Background(), opt)
+	if err == nil || !strings.Contains(err.Error(), "internal address") {
+		t.Errorf("the internal address should be refused, err: %v", err)
+	}
+	if requested {
+		t.Errorf("the internal address should not be requested")
+	}
+
+	opt.AllowInternal = func(host string, ip net.IP) bool { return ip.IsLoopback() }
+	if _, _, err = ResolveRef(context.Background(), opt); err == nil {
+		t.Errorf("the repository does not exist, but no error returned")
+	}
+	if !requested {
+		t.Errorf("the allowed internal address should be requested")
+	}
+}
+
+func TestBudgetReader(t *testing.T) {
+	budget := &sizeBudget{left: 10}
+	first := &budgetReader{ReadCloser: io.NopCloser(strings.NewReader("12345678")), budget: budget}
+	if _, err := io.ReadAll(first); err != nil {
+		t.Errorf("the data within the budget should be read, err: %v", err)
+	}
+
+	// the requests of one fetching share the budget
+	second := &budgetReader{ReadCloser: io.NopCloser(strings.NewReader("12345678")), budget: budget}
+	if _, err := io.ReadAll(second); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("the data exceeds the budget should be refused, err: %v", err)
+	}
+}
@@ -0,0 +1,386 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tmplprocess
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/criteria/constant"
+)
+
+const (
+	// maxSeqCount is the max count of the sequence generated by one seq function call
+	maxSeqCount = 10000
+	// maxSeqTotal is the max total count of the sequences generated by seq function in one render,
+	// which bounds the iterations of the nested range over seq
+	maxSeqTotal = 100000
+	// maxIndentSpaces is the max spaces of the indent and nindent function
+	maxIndentSpaces = 256
+	// renderTimeout is the max duration to render one go template
+	renderTimeout = 3 * time.Second
+	// maxConcurrentRenders is the max count of the go template executions running at the same time,
+	// including the executions abandoned after the deadline which are still running
+	maxConcurrentRenders = 16
+)
+
+var (
+	// errRenderTooLarge is returned when the rendered content exceeds the max render bytes
+	errRenderTooLarge = fmt.Errorf("rendered content exceeds the max size %d bytes", constant.MaxRenderBytes)
+	// errRenderTimeout is returned when the render does not finish before the deadline
+	errRenderTimeout = fmt.Errorf("render go template exceeds the timeout %s", renderTimeout)
+	// errRenderBusy is returned when no execution slot is released before the deadline
+	errRenderBusy = errors.New("too many go templates are rendering, please try again later")
+
+	// renderSlots bounds the running go template executions, the slot is released when the
+	// execution returns rather than when the render stops waiting for it
+	renderSlots = make(chan struct{}, maxConcurrentRenders)
+)
+
+// goTmplProcessor implements the TmplProcessor interface with go text/template,
+// only the sandboxed function set is available, which has no access to file, network or environment
+type goTmplProcessor struct{}
+
+// ExtractVariables extracts bscp variables referenced in the go template,
+// it falls back to the simple extraction if the template is invalid
+func (p *goTmplProcessor) ExtractVariables(template []byte) []string {
+	if len(template) == 0 {
+		return []string{}
+	}
+
+	tmpl, err := p.parse(template)
+	if err != nil {
+		return (&processor{}).ExtractVariables(template)
+	}
+
+	nameMap := make(map[string]struct{})
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			walkNode(t.Tree.Root, nameMap)
+		}
+	}
+
+	return sortedNames(nameMap)
+}
+
+// Render renders go template with variables key value map
+func (p *goTmplProcessor) Render(content []byte, variablesKV map[string]interface{}) ([]byte, error) {
+	tmpl, err := p.parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if variablesKV == nil {
+		variablesKV = make(map[string]interface{})
+	}
+
+	state := &renderState{deadline: time.Now().Add(renderTimeout), seqLeft: maxSeqTotal}
+	tmpl.Funcs(template.FuncMap{"seq": state.seq})
+	buf := &limitedBuffer{limit: constant.MaxRenderBytes, state: state}
+
+	timer := time.NewTimer(renderTimeout)
+	defer timer.Stop()
+	select {
+	case renderSlots <- struct{}{}:
+	case <-timer.C:
+		return nil, errRenderBusy
+	}
+
+	// text/template can not be canceled, so execute it in another goroutine and stop waiting after the
+	// deadline, the write and seq of the abandoned execution fail fast once the deadline is exceeded.
+	// a loop which neither writes nor calls seq can not be stopped, it keeps the slot until it returns,
+	// so such executions never exceed maxConcurrentRenders.
+	errCh := make(chan error, 1)
+	go func() {
+		defer func() { <-renderSlots }()
+		errCh <- tmpl.Execute(buf, variablesKV)
+	}()
+
+	select {
+	case err = <-errCh:
+	case <-timer.C:
+		return nil, errRenderTimeout
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, errRenderTooLarge):
+			return nil, errRenderTooLarge
+		case errors.Is(err, errRenderTimeout):
+			return nil, errRenderTimeout
+		}
+		return nil, fmt.Errorf("render go template failed, err: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Validate validates the syntax of go template and the functions it used
+func (p *goTmplProcessor) Validate(template []byte) error {
+	if len(template) > constant.MaxRenderBytes {
+		return fmt.Errorf("template size %d exceeds the max size %d bytes", len(template), constant.MaxRenderBytes)
+	}
+
+	if _, err := p.parse(template); err != nil {
+		return fmt.Errorf("invalid go template, err: %v", err)
+	}
+	return nil
+}
+
+func (p *goTmplProcessor) parse(content []byte) (*template.Template, error) {
+	return template.New("bscp").Option("missingkey=error").Funcs(sandboxFuncs).Parse(string(content))
+}
+
+// walkNode collects the bscp variables referenced by field or $ variable in the parse tree
+func walkNode(node parse.Node, nameMap map[string]struct{}) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, sub := range n.Nodes {
+			walkNode(sub, nameMap)
+		}
+	case *parse.ActionNode:
+		walkNode(n.Pipe, nameMap)
+	case *parse.IfNode:
+		walkBranch(&n.BranchNode, nameMap)
+	case *parse.RangeNode:
+		walkBranch(&n.BranchNode, nameMap)
+	case *parse.WithNode:
+		walkBranch(&n.BranchNode, nameMap)
+	case *parse.TemplateNode:
+		walkNode(n.Pipe, nameMap)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			walkNode(cmd, nameMap)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			walkNode(arg, nameMap)
+		}
+	case *parse.ChainNode:
+		walkNode(n.Node, nameMap)
+	case *parse.FieldNode:
+		addVariable(n.Ident, nameMap)
+	case *parse.VariableNode:
+		// only $.bk_bscp_xxx refers to the root variables
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			addVariable(n.Ident[1:], nameMap)
+		}
+	}
+}
+
+func walkBranch(n *parse.BranchNode, nameMap map[string]struct{}) {
+	walkNode(n.Pipe, nameMap)
+	walkNode(n.List, nameMap)
+	walkNode(n.ElseList, nameMap)
+}
+
+func addVariable(ident []string, nameMap map[string]struct{}) {
+	if len(ident) == 0 {
+		return
+	}
+	if strings.HasPrefix(strings.ToLower(ident[0]), "bk_bscp_") {
+		nameMap[ident[0]] = struct{}{}
+	}
+}
+
+// renderState is the state of one render, which limits the time and the seq count of the render
+type renderState struct {
+	deadline time.Time
+	seqLeft  int
+}
+
+// expired returns whether the render exceeds the deadline
+func (s *renderState) expired() bool {
+	return time.Now().After(s.deadline)
+}
+
+// seq generates the integer sequence [0, n), n should be no more than maxSeqCount,
+// and the total count of the sequences in one render should be no more than maxSeqTotal
+func (s *renderState) seq(n int) ([]int, error) {
+	if s.expired() {
+		return nil, errRenderTimeout
+	}
+	ret, err := seq(n)
+	if err != nil {
+		return nil, err
+	}
+	if n > s.seqLeft {
+		return nil, fmt.Errorf("total seq count exceeds the max count %d", maxSeqTotal)
+	}
+	s.seqLeft -= n
+	return ret, nil
+}
+
+// limitedBuffer is a buffer which refuses to grow beyond the limit
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+	state *renderState
+}
+
+// Write writes data to the buffer, returns errRenderTooLarge when exceeding the limit,
+// and returns errRenderTimeout when the render exceeds the deadline
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.state != nil && b.state.expired() {
+		return 0, errRenderTimeout
+	}
+	if b.Len()+len(p) > b.limit {
+		return 0, errRenderTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// sandboxFuncs is the function set available for go template engine,
+// all of them are pure functions without any side effect
+var sandboxFuncs = template.FuncMap{
+	"default":    defaultValue,
+	"empty":      isEmpty,
+	"coalesce":   coalesce,
+	"ternary":    ternary,
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"trim":       strings.TrimSpace,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+	"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+	"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+	"split":      func(sep, s string) []string { return strings.Split(s, sep) },
+	"join":       join,
+	"quote":      func(v interface{}) string { return strconv.Quote(toString(v)) },
+	"squote":     func(v interface{}) string { return "'" + toString(v) + "'" },
+	"indent":     indent,
+	"nindent":    nindent,
+	"toString":   toString,
+	"toJson":     toJSON,
+	"atoi":       func(s string) (int, error) { return strconv.Atoi(strings.TrimSpace(s)) },
+	"list":       func(v ...interface{}) []interface{} { return v },
+	"seq":        seq,
+}
+
+// defaultValue returns the given value if it is not empty, otherwise returns the default value,
+// eg: {{ .bk_bscp_port | default 8080 }}
+func defaultValue(def interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || isEmpty(given[0]) {
+		return def
+	}
+	return given[0]
+}
+
+// isEmpty checks whether the value is the zero value of its type
+func isEmpty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return rv.IsZero()
+	}
+}
+
+func coalesce(v ...interface{}) interface{} {
+	for _, val := range v {
+		if !isEmpty(val) {
+			return val
+		}
+	}
+	return nil
+}
+
+func ternary(trueVal, falseVal interface{}, cond bool) interface{} {
+	if cond {
+		return trueVal
+	}
+	return falseVal
+}
+
+func join(sep string, v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return toString(v)
+	}
+	elems := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elems = append(elems, toString(rv.Index(i).Interface()))
+	}
+	return strings.Join(elems, sep)
+}
+
+// indent pads the spaces to the beginning of each line, spaces should be in [0, maxIndentSpaces]
+func indent(spaces int, s string) (string, error) {
+	if spaces < 0 || spaces > maxIndentSpaces {
+		return "", fmt.Errorf("indent spaces should be in [0, %d], got %d", maxIndentSpaces, spaces)
+	}
+	// check the size before padding to avoid allocating the huge string
+	if len(s)+spaces*(strings.Count(s, "\n")+1) > constant.MaxRenderBytes {
+		return "", errRenderTooLarge
+	}
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad), nil
+}
+
+// nindent is the same as indent, but prepends a new line
+func nindent(spaces int, s string) (string, error) {
+	ret, err := indent(spaces, s)
+	if err != nil {
+		return "", err
+	}
+	return "\n" + ret, nil
+}
+
+func toString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// seq generates the integer sequence [0, n), n should be no more than maxSeqCount
+func seq(n int) ([]int, error) {
+	if n < 0 || n > maxSeqCount {
+		return nil, fmt.Errorf("seq count should be in [0, %d], got %d", maxSeqCount, n)
+	}
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s, nil
+}
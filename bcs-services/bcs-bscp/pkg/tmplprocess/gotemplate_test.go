@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tmplprocess
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGoTmplExtractVariables(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	tmpl := []byte(`port={{ .bk_bscp_port | default 8080 }}
+{{- if eq .bk_bscp_env "prod" }}
+debug=false
+{{- end }}
+{{- range $i, $h := split "," .bk_bscp_hosts }}
+host{{ $i }}={{ $h }} {{ $.bk_bscp_suffix }}
+{{- end }}
+name={{ .other }}`)
+
+	got := p.ExtractVariables(tmpl)
+	want := []string{"bk_bscp_env", "bk_bscp_hosts", "bk_bscp_port", "bk_bscp_suffix"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("extract variables got %v, want %v", got, want)
+	}
+}
+
+func TestGoTmplRender(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	tmpl := []byte(`port={{ .bk_bscp_port | default 8080 }}
+{{- if eq .bk_bscp_env "prod" }}
+debug=false
+{{- else }}
+debug=true
+{{- end }}
+{{- range $i, $h := split "," .bk_bscp_hosts }}
+host{{ $i }}={{ upper $h }}
+{{- end }}`)
+
+	got, err := p.Render(tmpl, map[string]interface{}{
+		"bk_bscp_port":  "",
+		"bk_bscp_env":   "prod",
+		"bk_bscp_hosts": "a,b",
+	})
+	if err != nil {
+		t.Fatalf("render failed, err: %v", err)
+	}
+	want := "port=8080\ndebug=false\nhost0=A\nhost1=B"
+	if string(got) != want {
+		t.Errorf("render got %q, want %q", got, want)
+	}
+
+	// missing variable should fail instead of rendering a placeholder
+	if _, err = p.Render([]byte(`{{ .bk_bscp_missing }}`), nil); err == nil {
+		t.Errorf("render with missing variable should fail")
+	}
+}
+
+func TestGoTmplValidate(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr bool
+	}{
+		{name: "valid", tmpl: `{{ if .bk_bscp_a }}{{ .bk_bscp_a | quote }}{{ end }}`},
+		{name: "unclosed action", tmpl: `{{ if .bk_bscp_a }}`, wantErr: true},
+		{name: "function not in sandbox", tmpl: `{{ env "HOME" }}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := p.Validate([]byte(tt.tmpl)); (err != nil) != tt.wantErr {
+			t.Errorf("%s: validate err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	if err := NewTmplProcessor().Validate([]byte(`{{ if }}`)); err != nil {
+		t.Errorf("simple engine should accept any content, err: %v", err)
+	}
+}
+
+func TestSeqLimit(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	if _, err := p.Render([]byte(`{{ range seq 100000 }}x{{ end }}`), nil); err == nil {
+		t.Errorf("render with too large seq should fail")
+	}
+}
+
+func TestSeqTotalLimit(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	if _, err := p.Render([]byte(`{{ range seq 1000 }}{{ range seq 1000 }}{{ end }}{{ end }}`), nil); err == nil {
+		t.Errorf("render with too large total seq should fail")
+	}
+	if _, err := p.Render([]byte(`{{ range seq 100 }}{{ range seq 100 }}{{ end }}{{ end }}`), nil); err != nil {
+		t.Errorf("render with seq in limit failed, err: %v", err)
+	}
+}
+
+func TestIndentLimit(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	got, err := p.Render([]byte(`a:{{ nindent 2 "b: 1" }}`), nil)
+	if err != nil {
+		t.Fatalf("render failed, err: %v", err)
+	}
+	if string(got) != "a:\n  b: 1" {
+		t.Errorf("render got %q", got)
+	}
+	if _, err = p.Render([]byte(`{{ indent 100000000 "a" }}`), nil); err == nil {
+		t.Errorf("render with too large indent spaces should fail")
+	}
+	if _, err = p.Render([]byte(`{{ indent -1 "a" }}`), nil); err == nil {
+		t.Errorf("render with negative indent spaces should fail")
+	}
+}
+
+func TestRenderTimeout(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	// the nested range over the same sequence does not call any function in the loop body
+	tmpl := `{{ $s := seq 10000 }}{{ range $s }}{{ range $s }}{{ range $s }}{{ end }}{{ end }}{{ end }}`
+	if _, err := p.Render([]byte(tmpl), nil); err != errRenderTimeout {
+		t.Errorf("render got err %v, want %v", err, errRenderTimeout)
+	}
+}
+
+func TestRenderSlots(t *testing.T) {
+	p := NewTmplProcessorByEngine(GoTemplateEngine)
+	got, err := p.Render([]byte(`{{ .bk_bscp_a }}`), map[string]interface{}{"bk_bscp_a": "a"})
+	if err != nil || string(got) != "a" {
+		t.Fatalf("render got %q, err: %v", got, err)
+	}
+
+	// the executions which can not be stopped hold all the slots
+	held := maxConcurrentRenders - len(renderSlots)
+	for i := 0; i < held; i++ {
+		renderSlots <- struct{}{}
+	}
+	if _, err = p.Render([]byte(`{{ .bk_bscp_a }}`), nil); err != errRenderBusy {
+		t.Errorf("render got err %v, want %v", err, errRenderBusy)
+	}
+	for i := 0; i < held; i++ {
+		<-renderSlots
+	}
+}
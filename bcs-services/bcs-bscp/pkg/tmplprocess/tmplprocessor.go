@@ -22,10 +22,19 @@ import (
 
 var re = regexp.MustCompile(`{{\s*\.(?i)(bk_bscp_[A-Za-z0-9_]*)\s*}}`)
 
+const (
+	// SimpleEngine only substitutes the {{ .bk_bscp_xxx }} style variables, it is the default template engine
+	SimpleEngine = "simple"
+	// GoTemplateEngine renders with go text/template and a sandboxed function set,
+	// which supports conditionals, loops, defaults and so on
+	GoTemplateEngine = "go_template"
+)
+
 // TmplProcessor is the interface for search
 type TmplProcessor interface {
 	ExtractVariables(template []byte) []string
-	Render(template []byte, variablesKV map[string]interface{}) []byte
+	Render(template []byte, variablesKV map[string]interface{}) ([]byte, error)
+	Validate(template []byte) error
 }
 
 // processor implements the TmplProcessor interface
@@ -36,6 +45,14 @@ func NewTmplProcessor() TmplProcessor {
 	return &processor{}
 }
 
+// NewTmplProcessorByEngine new a TmplProcessor of the template engine, default is the simple engine
+func NewTmplProcessorByEngine(engine string) TmplProcessor {
+	if engine == GoTemplateEngine {
+		return &goTmplProcessor{}
+	}
+	return &processor{}
+}
+
 // ExtractVariables extracts variables from template
 func (p *processor) ExtractVariables(template []byte) []string {
 	if len(template) == 0 {
@@ -49,6 +66,11 @@ func (p *processor) ExtractVariables(template []byte) []string {
 		nameMap[match[1]] = struct{}{}
 	}
 
+	return sortedNames(nameMap)
+}
+
+// sortedNames returns the names in ascending order
+func sortedNames(nameMap map[string]struct{}) []string {
 	varNames := make([]string, 0, len(nameMap))
 	for name := range nameMap {
 		varNames = append(varNames, name)
@@ -61,7 +83,7 @@ func (p *processor) ExtractVariables(template []byte) []string {
 }
 
 // Render renders template with variables key value map
-func (p *processor) Render(template []byte, variablesKV map[string]interface{}) []byte {
+func (p *processor) Render(template []byte, variablesKV map[string]interface{}) ([]byte, error) {
 	ret := re.ReplaceAllStringFunc(string(template), func(match string) string {
 		varName := strings.TrimPrefix(match, "{{")
 		varName = strings.TrimSuffix(varName, "}}")
@@ -74,5 +96,10 @@ func (p *processor) Render(template []byte, variablesKV map[string]interface{})
 		}
 		return fmt.Sprint(value)
 	})
-	return []byte(ret)
+	return []byte(ret), nil
+}
+
+// Validate validates the template, any content is valid for simple engine
+func (p *processor) Validate(template []byte) error {
+	return nil
 }
@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// UnifiedDiff returns the unified format diff between the old and new text with
+// the given number of context lines, returns empty string if they are the same.
+func UnifiedDiff(oldName, newName, oldText, newText string, context int) string {
+	if oldText == newText {
+		return ""
+	}
+	if context < 0 {
+		context = 0
+	}
+
+	lines := diffLines(oldText, newText)
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "--- %s\n+++ %s\n", oldName, newName)
+
+	idx := 0
+	oldNo, newNo := 1, 1
+	for idx < len(lines) {
+		// find the next changed line
+		first := idx
+		for first < len(lines) && lines[first].op == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+
+		start := first - context
+		if start < idx {
+			start = idx
+		}
+		// skip the unchanged lines before the hunk
+		for i := idx; i < start; i++ {
+			oldNo++
+			newNo++
+		}
+
+		// extend the hunk until the gap of unchanged lines is wider than two contexts
+		end, gap := first, 0
+		for i := first; i < len(lines); i++ {
+			if lines[i].op != ' ' {
+				end, gap = i, 0
+				continue
+			}
+			gap++
+			if gap > 2*context {
+				break
+			}
+		}
+		end += context + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		oldCount, newCount := 0, 0
+		for _, l := range lines[start:end] {
+			if l.op != '+' {
+				oldCount++
+			}
+			if l.op != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(oldNo, oldCount), hunkRange(newNo, newCount))
+		for _, l := range lines[start:end] {
+			b.WriteByte(l.op)
+			b.WriteString(l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		oldNo += oldCount
+		newNo += newCount
+		idx = end
+	}
+
+	return b.String()
+}
+
+// diffLines compares the old and new text line by line.
+func diffLines(oldText, newText string) []diffLine {
+	dmp := diffmatchpatch.New()
+	a, b, lineArray := dmp.DiffLinesToChars(oldText, newText)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(a, b, false), lineArray)
+
+	lines := make([]diffLine, 0)
+	for _, d := range diffs {
+		var op byte
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			op = '+'
+		case diffmatchpatch.DiffDelete:
+			op = '-'
+		default:
+			op = ' '
+		}
+		for _, text := range strings.SplitAfter(d.Text, "\n") {
+			if text == "" {
+				continue
+			}
+			lines = append(lines, diffLine{op: op, text: text})
+		}
+	}
+
+	return lines
+}
+
+// hunkRange returns the range of a hunk in unified format, an empty range starts at the line before.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
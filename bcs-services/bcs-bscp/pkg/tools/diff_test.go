@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import "testing"
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldText  string
+		newText  string
+		context  int
+		expected string
+	}{
+		{
+			name:     "same",
+			oldText:  "a\nb\n",
+			newText:  "a\nb\n",
+			context:  3,
+			expected: "",
+		},
+		{
+			name:     "modify",
+			oldText:  "a\nb\nc\nd\ne\nf\ng\n",
+			newText:  "a\nb\nc\nD\ne\nf\ng\n",
+			context:  2,
+			expected: "--- old\n+++ new\n@@ -2,5 +2,5 @@\n b\n c\n-d\n+D\n e\n f\n",
+		},
+		{
+			name:     "add to empty",
+			oldText:  "",
+			newText:  "a\n",
+			context:  3,
+			expected: "--- old\n+++ new\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name:     "two hunks",
+			oldText:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			newText:  "0\n2\n3\n4\n5\n6\n7\n8\n0\n",
+			context:  1,
+			expected: "--- old\n+++ new\n@@ -1,2 +1,2 @@\n-1\n+0\n 2\n@@ -8,2 +8,2 @@\n 8\n-9\n+0\n",
+		},
+		{
+			name:     "no newline at end",
+			oldText:  "a",
+			newText:  "b",
+			context:  3,
+			expected: "--- old\n+++ new\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+b\n\\ No newline at end of file\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("old", "new", tt.oldText, tt.newText, tt.context); got != tt.expected {
+				t.Errorf("UnifiedDiff() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -13,6 +13,8 @@
 package tools
 
 import (
+	"context"
+	"fmt"
 	"net"
 	"os"
 	"strconv"
@@ -60,3 +62,36 @@ func GetListenAddr(addr string, port int) string {
 
 	return net.JoinHostPort(addr, strconv.Itoa(port))
 }
+
+// IsInternalIP returns whether the ip is a loopback, private, link-local or unspecified address.
+func IsInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified()
+}
+
+// LookupPublicIPs resolve the host to ips, it fails when any of the ips is an internal address which is not
+// allowed by the allow function, the allow function can be nil which allows none of the internal addresses.
+func LookupPublicIPs(ctx context.Context, host string, allow func(host string, ip net.IP) bool) ([]net.IP, error) {
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("resolve host %s failed, err: %v", host, err)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("host %s has no ip", host)
+	}
+
+	for _, ip := range ips {
+		if IsInternalIP(ip) && (allow == nil || !allow(host, ip)) {
+			return nil, fmt.Errorf("host %s resolves to the internal address %s which is not allowed", host, ip)
+		}
+	}
+	return ips, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"context"
+	"net"
+	"testing"
+)
+
+func TestIsInternalIP(t *testing.T) {
+	tests := map[string]bool{
+		"127.0.0.1":       true,
+		"10.1.2.3":        true,
+		"172.16.0.1":      true,
+		"192.168.1.1":     true,
+		"169.254.169.254": true,
+		"0.0.0.0":         true,
+		"::1":             true,
+		"fe80::1":         true,
+		"fd00::1":         true,
+		"8.8.8.8":         false,
+		"2001:4860::8888": false,
+	}
+	for ip, want := range tests {
+		if got := IsInternalIP(net.ParseIP(ip)); got != want {
+			t.Errorf("IsInternalIP(%s) = %v, want %v", ip, got, want)
+		}
+	}
+}
+
+func TestLookupPublicIPs(t *testing.T) {
+	if _, err := LookupPublicIPs(context.Background(), "169.254.169.254", nil); err == nil {
+		t.Errorf("lookup link-local ip should fail")
+	}
+	if _, err := LookupPublicIPs(context.Background(), "8.8.8.8", nil); err != nil {
+		t.Errorf("lookup public ip failed, err: %v", err)
+	}
+
+	allow := func(host string, ip net.IP) bool { return ip.Equal(net.ParseIP("10.0.0.1")) }
+	if _, err := LookupPublicIPs(context.Background(), "10.0.0.1", allow); err != nil {
+		t.Errorf("lookup allowed internal ip failed, err: %v", err)
+	}
+	if _, err := LookupPublicIPs(context.Background(), "10.0.0.2", allow); err == nil {
+		t.Errorf("lookup not allowed internal ip should fail")
+	}
+}
@@ -13,6 +13,7 @@
 package tools
 
 import (
+	"crypto/hmac"
 	// NOCC:gas/crypto(设计如此)
 	"crypto/md5"
 	"crypto/sha256"
@@ -37,6 +38,13 @@ func ByteSHA256(data []byte) string {
 	return fmt.Sprintf("%x", hash.Sum(nil))
 }
 
+// HmacSHA256 returns a hex encoded HMAC-SHA256 string of the data byte signed with the key.
+func HmacSHA256(key string, data []byte) string {
+	h := hmac.New(sha256.New, []byte(key))
+	h.Write(data) // nolint
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
 // FileSHA256 returns sha256 string of the file.
 func FileSHA256(file string) (string, error) {
 	f, err := os.Open(file)
@@ -45,6 +45,8 @@ type ReleasedGroupCache struct {
 	UID        string             `db:"uid" json:"uid"`
 	BizID      uint32             `db:"biz_id" json:"biz_id"`
 	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at"`
+	// RenderedContents are the config item contents rendered with the group variables.
+	RenderedContents table.GroupRenderedContents `db:"rendered_contents" json:"rendered_contents"`
 }
 
 // EventMeta is an event's meta info which is used by feed server to gc cache.
@@ -67,6 +69,37 @@ type ReleaseCICache struct {
 	Revision       *table.Revision             `json:"revision"`
 }
 
+// OverrideRenderedContents returns the released config items whose contents are replaced with the contents
+// rendered with the matched group's variables, the released config items in cache are not modified.
+func OverrideRenderedContents(ciList []*ReleaseCICache, contents table.GroupRenderedContents) []*ReleaseCICache {
+	if len(contents) == 0 {
+		return ciList
+	}
+
+	overrides := make(map[uint32]*table.GroupRenderedContent, len(contents))
+	for _, one := range contents {
+		overrides[one.ReleasedCIID] = one
+	}
+	result := make([]*ReleaseCICache, len(ciList))
+	for idx, one := range ciList {
+		content, ok := overrides[one.ID]
+		if !ok || one.CommitSpec == nil {
+			result[idx] = one
+			continue
+		}
+		overridden := *one
+		overridden.CommitSpec = &CommitSpecCache{
+			ContentID: one.CommitSpec.ContentID,
+			Signature: content.Signature,
+			ByteSize:  content.ByteSize,
+			Md5:       content.Md5,
+		}
+		result[idx] = &overridden
+	}
+
+	return result
+}
+
 // ReleaseKvCache is the release kv info which will be stored in cache.
 type ReleaseKvCache struct {
 	ID          uint32              `json:"id"`
@@ -0,0 +1,51 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bcs/bcs-services/bcs-bscp/pkg/dal/table"
+)
+
+func TestOverrideRenderedContents(t *testing.T) {
+	ciList := []*ReleaseCICache{
+		{ID: 1, CommitSpec: &CommitSpecCache{ContentID: 10, Signature: "release-1", ByteSize: 1, Md5: "md5-1"}},
+		{ID: 2, CommitSpec: &CommitSpecCache{ContentID: 20, Signature: "release-2", ByteSize: 2, Md5: "md5-2"}},
+	}
+
+	// groups without rendered contents get the release's contents
+	if got := OverrideRenderedContents(ciList, nil); got[0] != ciList[0] || got[1] != ciList[1] {
+		t.Error("config items without group rendered contents should not be changed")
+		return
+	}
+
+	contents := table.GroupRenderedContents{
+		{ReleasedCIID: 2, Signature: "group-2", ByteSize: 3, Md5: "group-md5-2"},
+	}
+	got := OverrideRenderedContents(ciList, contents)
+	if got[0] != ciList[0] {
+		t.Error("config item not rendered with group variables should not be changed")
+		return
+	}
+	if got[1].ID != 2 || got[1].CommitSpec.ContentID != 20 || got[1].CommitSpec.Signature != "group-2" ||
+		got[1].CommitSpec.ByteSize != 3 || got[1].CommitSpec.Md5 != "group-md5-2" {
+		t.Errorf("config item should be overridden with group rendered content, got: %+v", got[1].CommitSpec)
+		return
+	}
+
+	// the released config items in cache are shared by all instances, they should not be modified
+	if ciList[1].CommitSpec.Signature != "release-2" || ciList[1].CommitSpec.ByteSize != 2 {
+		t.Errorf("cached config item is modified, got: %+v", ciList[1].CommitSpec)
+	}
+}
@@ -29,6 +29,11 @@ type PublishOption struct {
 	Default   bool                   `json:"default"`
 	Groups    []uint32               `json:"groups"`
 	Revision  *table.CreatedRevision `json:"revision"`
+	// RollbackFrom is the release rolled back from, it is set when the publish rolls the instances back.
+	RollbackFrom uint32 `json:"rollback_from"`
+	// GroupRenderedContents are the config item contents rendered with the group variables,
+	// which is group id => rendered contents, it is rendered just before publishing.
+	GroupRenderedContents map[uint32]table.GroupRenderedContents `json:"-"`
 }
 
 // Validate options is valid or not.
@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+)
+
+const (
+	// WebhookEventHeader is the http header of the release event posted to the webhook.
+	WebhookEventHeader = "X-Bscp-Event"
+	// WebhookDeliveryHeader is the http header of the webhook delivery id, it is the same
+	// when the delivery is retried, so the receiver can use it to deduplicate.
+	WebhookDeliveryHeader = "X-Bscp-Delivery"
+	// WebhookSignatureHeader is the http header of the request body's signature, the value is
+	// 'sha256=' followed by the hex encoded HMAC-SHA256 of the body signed with the webhook secret.
+	WebhookSignatureHeader = "X-Bscp-Signature"
+)
+
+// ReleaseEventMessage is the release event which is published to the message queue and posted to the webhooks.
+type ReleaseEventMessage struct {
+	// NoticeID is the unique id of the release event.
+	NoticeID  uint32 `json:"notice_id"`
+	Event     string `json:"event"`
+	BizID     uint32 `json:"biz_id"`
+	AppID     uint32 `json:"app_id"`
+	AppName   string `json:"app_name"`
+	ReleaseID uint32 `json:"release_id"`
+	// ReleaseName is the name of the release which is published, deprecated or rolled back to.
+	ReleaseName string `json:"release_name"`
+	// FromReleaseID is the release which the changed items are compared with, it is the release rolled
+	// back from for the rollback event, and the previous release of the app for the other events.
+	FromReleaseID   uint32 `json:"from_release_id"`
+	FromReleaseName string `json:"from_release_name"`
+	// All means the release is published to all the instances, otherwise it is published to the groups.
+	All        bool                  `json:"all"`
+	Groups     []*ReleaseEventGroup  `json:"groups"`
+	Operator   string                `json:"operator"`
+	Memo       string                `json:"memo"`
+	OccurredAt time.Time             `json:"occurred_at"`
+	Summary    *ReleaseChangeSummary `json:"summary"`
+}
+
+// ReleaseEventGroup is the group which the release is published to.
+type ReleaseEventGroup struct {
+	ID   uint32 `json:"id"`
+	Name string `json:"name"`
+}
+
+// ReleaseChangeSummary is the summary of the changed items of the release compared with the from release,
+// the items are the absolute path of the config files or the key of the kvs.
+type ReleaseChangeSummary struct {
+	Added   []string `json:"added"`
+	Updated []string `json:"updated"`
+	Deleted []string `json:"deleted"`
+}
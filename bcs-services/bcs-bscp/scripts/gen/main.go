@@ -63,6 +63,13 @@ func main() {
 		table.Client{},
 		table.ClientEvent{},
 		table.ClientQuery{},
+		table.PublishApproval{},
+		table.RolloutPlan{},
+		table.ConfigSchema{},
+		table.Webhook{},
+		table.ReleaseNotice{},
+		table.WebhookDelivery{},
+		table.GitSource{},
 	)
 
 	g.Execute()
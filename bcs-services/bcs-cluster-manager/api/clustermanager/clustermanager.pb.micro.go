@@ -72,6 +72,48 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"PUT"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ClusterManager.UpgradeCluster",
+			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/upgrade"},
+			Method:  []string{"PUT"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.ListClusterDrift",
+			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/drift"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.GetClusterCost",
+			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/cost"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.GetProjectCost",
+			Path:    []string{"/clustermanager/v1/projects/{projectID}/cost"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.EvaluateNodeGroupCost",
+			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/cost/evaluate"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.ExportProjectResources",
+			Path:    []string{"/clustermanager/v1/projects/{projectID}/resources/export"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ClusterManager.ApplyProjectResources",
+			Path:    []string{"/clustermanager/v1/projects/{projectID}/resources/apply"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ClusterManager.AddNodesToCluster",
 			Path:    []string{"/clustermanager/v1/cluster/{clusterID}/node"},
@@ -408,6 +450,12 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ClusterManager.RolloutNodeGroup",
+			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/rollout"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ClusterManager.UpdateGroupDesiredSize",
 			Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/desiredsize"},
@@ -462,6 +510,12 @@ func NewClusterManagerEndpoints() []*api.Endpoint {
 			Method:  []string{"PUT"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ClusterManager.CancelTask",
+			Path:    []string{"/clustermanager/v1/task/{taskID}/cancel"},
+			Method:  []string{"PUT"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ClusterManager.UpdateTask",
 			Path:    []string{"/clustermanager/v1/task/{taskID}"},
@@ -865,6 +919,13 @@ type ClusterManagerService interface {
 	CheckCloudKubeConfigConnect(ctx context.Context, in *KubeConfigConnectReq, opts ...client.CallOption) (*KubeConfigConnectResp, error)
 	ImportCluster(ctx context.Context, in *ImportClusterReq, opts ...client.CallOption) (*ImportClusterResp, error)
 	UpdateCluster(ctx context.Context, in *UpdateClusterReq, opts ...client.CallOption) (*UpdateClusterResp, error)
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, opts ...client.CallOption) (*UpgradeClusterResp, error)
+	ListClusterDrift(ctx context.Context, in *ListClusterDriftReq, opts ...client.CallOption) (*ListClusterDriftResp, error)
+	GetClusterCost(ctx context.Context, in *GetClusterCostReq, opts ...client.CallOption) (*GetClusterCostResp, error)
+	GetProjectCost(ctx context.Context, in *GetProjectCostReq, opts ...client.CallOption) (*GetProjectCostResp, error)
+	EvaluateNodeGroupCost(ctx context.Context, in *EvaluateNodeGroupCostReq, opts ...client.CallOption) (*EvaluateNodeGroupCostResp, error)
+	ExportProjectResources(ctx context.Context, in *ExportProjectResourcesReq, opts ...client.CallOption) (*ExportProjectResourcesResp, error)
+	ApplyProjectResources(ctx context.Context, in *ApplyProjectResourcesReq, opts ...client.CallOption) (*ApplyProjectResourcesResp, error)
 	AddNodesToCluster(ctx context.Context, in *AddNodesRequest, opts ...client.CallOption) (*AddNodesResponse, error)
 	DeleteNodesFromCluster(ctx context.Context, in *DeleteNodesRequest, opts ...client.CallOption) (*DeleteNodesResponse, error)
 	BatchDeleteNodesFromCluster(ctx context.Context, in *BatchDeleteClusterNodesRequest, opts ...client.CallOption) (*BatchDeleteClusterNodesResponse, error)
@@ -927,6 +988,7 @@ type ClusterManagerService interface {
 	ListNodesInGroup(ctx context.Context, in *GetNodeGroupRequest, opts ...client.CallOption) (*ListNodesInGroupResponse, error)
 	ListNodesInGroupV2(ctx context.Context, in *ListNodesInGroupV2Request, opts ...client.CallOption) (*ListNodesInGroupV2Response, error)
 	UpdateGroupDesiredNode(ctx context.Context, in *UpdateGroupDesiredNodeRequest, opts ...client.CallOption) (*UpdateGroupDesiredNodeResponse, error)
+	RolloutNodeGroup(ctx context.Context, in *RolloutNodeGroupRequest, opts ...client.CallOption) (*RolloutNodeGroupResponse, error)
 	UpdateGroupDesiredSize(ctx context.Context, in *UpdateGroupDesiredSizeRequest, opts ...client.CallOption) (*UpdateGroupDesiredSizeResponse, error)
 	UpdateGroupMinMaxSize(ctx context.Context, in *UpdateGroupMinMaxSizeRequest, opts ...client.CallOption) (*UpdateGroupMinMaxSizeResponse, error)
 	GetExternalNodeScriptByGroupID(ctx context.Context, in *GetExternalNodeScriptRequest, opts ...client.CallOption) (*GetExternalNodeScriptResponse, error)
@@ -937,6 +999,7 @@ type ClusterManagerService interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...client.CallOption) (*CreateTaskResponse, error)
 	RetryTask(ctx context.Context, in *RetryTaskRequest, opts ...client.CallOption) (*RetryTaskResponse, error)
 	SkipTask(ctx context.Context, in *SkipTaskRequest, opts ...client.CallOption) (*SkipTaskResponse, error)
+	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...client.CallOption) (*CancelTaskResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...client.CallOption) (*UpdateTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...client.CallOption) (*DeleteTaskResponse, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...client.CallOption) (*GetTaskResponse, error)
@@ -1097,6 +1160,76 @@ func (c *clusterManagerService) UpdateCluster(ctx context.Context, in *UpdateClu
 	return out, nil
 }
 
+func (c *clusterManagerService) UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, opts ...client.CallOption) (*UpgradeClusterResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.UpgradeCluster", in)
+	out := new(UpgradeClusterResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ListClusterDrift(ctx context.Context, in *ListClusterDriftReq, opts ...client.CallOption) (*ListClusterDriftResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ListClusterDrift", in)
+	out := new(ListClusterDriftResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) GetClusterCost(ctx context.Context, in *GetClusterCostReq, opts ...client.CallOption) (*GetClusterCostResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.GetClusterCost", in)
+	out := new(GetClusterCostResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) GetProjectCost(ctx context.Context, in *GetProjectCostReq, opts ...client.CallOption) (*GetProjectCostResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.GetProjectCost", in)
+	out := new(GetProjectCostResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) EvaluateNodeGroupCost(ctx context.Context, in *EvaluateNodeGroupCostReq, opts ...client.CallOption) (*EvaluateNodeGroupCostResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.EvaluateNodeGroupCost", in)
+	out := new(EvaluateNodeGroupCostResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ExportProjectResources(ctx context.Context, in *ExportProjectResourcesReq, opts ...client.CallOption) (*ExportProjectResourcesResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ExportProjectResources", in)
+	out := new(ExportProjectResourcesResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterManagerService) ApplyProjectResources(ctx context.Context, in *ApplyProjectResourcesReq, opts ...client.CallOption) (*ApplyProjectResourcesResp, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.ApplyProjectResources", in)
+	out := new(ApplyProjectResourcesResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) AddNodesToCluster(ctx context.Context, in *AddNodesRequest, opts ...client.CallOption) (*AddNodesResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.AddNodesToCluster", in)
 	out := new(AddNodesResponse)
@@ -1657,6 +1790,16 @@ func (c *clusterManagerService) UpdateGroupDesiredNode(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *clusterManagerService) RolloutNodeGroup(ctx context.Context, in *RolloutNodeGroupRequest, opts ...client.CallOption) (*RolloutNodeGroupResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.RolloutNodeGroup", in)
+	out := new(RolloutNodeGroupResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) UpdateGroupDesiredSize(ctx context.Context, in *UpdateGroupDesiredSizeRequest, opts ...client.CallOption) (*UpdateGroupDesiredSizeResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.UpdateGroupDesiredSize", in)
 	out := new(UpdateGroupDesiredSizeResponse)
@@ -1747,6 +1890,16 @@ func (c *clusterManagerService) SkipTask(ctx context.Context, in *SkipTaskReques
 	return out, nil
 }
 
+func (c *clusterManagerService) CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...client.CallOption) (*CancelTaskResponse, error) {
+	req := c.c.NewRequest(c.name, "ClusterManager.CancelTask", in)
+	out := new(CancelTaskResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterManagerService) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...client.CallOption) (*UpdateTaskResponse, error) {
 	req := c.c.NewRequest(c.name, "ClusterManager.UpdateTask", in)
 	out := new(UpdateTaskResponse)
@@ -2407,6 +2560,13 @@ type ClusterManagerHandler interface {
 	CheckCloudKubeConfigConnect(context.Context, *KubeConfigConnectReq, *KubeConfigConnectResp) error
 	ImportCluster(context.Context, *ImportClusterReq, *ImportClusterResp) error
 	UpdateCluster(context.Context, *UpdateClusterReq, *UpdateClusterResp) error
+	UpgradeCluster(context.Context, *UpgradeClusterReq, *UpgradeClusterResp) error
+	ListClusterDrift(context.Context, *ListClusterDriftReq, *ListClusterDriftResp) error
+	GetClusterCost(context.Context, *GetClusterCostReq, *GetClusterCostResp) error
+	GetProjectCost(context.Context, *GetProjectCostReq, *GetProjectCostResp) error
+	EvaluateNodeGroupCost(context.Context, *EvaluateNodeGroupCostReq, *EvaluateNodeGroupCostResp) error
+	ExportProjectResources(context.Context, *ExportProjectResourcesReq, *ExportProjectResourcesResp) error
+	ApplyProjectResources(context.Context, *ApplyProjectResourcesReq, *ApplyProjectResourcesResp) error
 	AddNodesToCluster(context.Context, *AddNodesRequest, *AddNodesResponse) error
 	DeleteNodesFromCluster(context.Context, *DeleteNodesRequest, *DeleteNodesResponse) error
 	BatchDeleteNodesFromCluster(context.Context, *BatchDeleteClusterNodesRequest, *BatchDeleteClusterNodesResponse) error
@@ -2469,6 +2629,7 @@ type ClusterManagerHandler interface {
 	ListNodesInGroup(context.Context, *GetNodeGroupRequest, *ListNodesInGroupResponse) error
 	ListNodesInGroupV2(context.Context, *ListNodesInGroupV2Request, *ListNodesInGroupV2Response) error
 	UpdateGroupDesiredNode(context.Context, *UpdateGroupDesiredNodeRequest, *UpdateGroupDesiredNodeResponse) error
+	RolloutNodeGroup(context.Context, *RolloutNodeGroupRequest, *RolloutNodeGroupResponse) error
 	UpdateGroupDesiredSize(context.Context, *UpdateGroupDesiredSizeRequest, *UpdateGroupDesiredSizeResponse) error
 	UpdateGroupMinMaxSize(context.Context, *UpdateGroupMinMaxSizeRequest, *UpdateGroupMinMaxSizeResponse) error
 	GetExternalNodeScriptByGroupID(context.Context, *GetExternalNodeScriptRequest, *GetExternalNodeScriptResponse) error
@@ -2479,6 +2640,7 @@ type ClusterManagerHandler interface {
 	CreateTask(context.Context, *CreateTaskRequest, *CreateTaskResponse) error
 	RetryTask(context.Context, *RetryTaskRequest, *RetryTaskResponse) error
 	SkipTask(context.Context, *SkipTaskRequest, *SkipTaskResponse) error
+	CancelTask(context.Context, *CancelTaskRequest, *CancelTaskResponse) error
 	UpdateTask(context.Context, *UpdateTaskRequest, *UpdateTaskResponse) error
 	DeleteTask(context.Context, *DeleteTaskRequest, *DeleteTaskResponse) error
 	GetTask(context.Context, *GetTaskRequest, *GetTaskResponse) error
@@ -2575,6 +2737,13 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		CheckCloudKubeConfigConnect(ctx context.Context, in *KubeConfigConnectReq, out *KubeConfigConnectResp) error
 		ImportCluster(ctx context.Context, in *ImportClusterReq, out *ImportClusterResp) error
 		UpdateCluster(ctx context.Context, in *UpdateClusterReq, out *UpdateClusterResp) error
+		UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, out *UpgradeClusterResp) error
+		ListClusterDrift(ctx context.Context, in *ListClusterDriftReq, out *ListClusterDriftResp) error
+		GetClusterCost(ctx context.Context, in *GetClusterCostReq, out *GetClusterCostResp) error
+		GetProjectCost(ctx context.Context, in *GetProjectCostReq, out *GetProjectCostResp) error
+		EvaluateNodeGroupCost(ctx context.Context, in *EvaluateNodeGroupCostReq, out *EvaluateNodeGroupCostResp) error
+		ExportProjectResources(ctx context.Context, in *ExportProjectResourcesReq, out *ExportProjectResourcesResp) error
+		ApplyProjectResources(ctx context.Context, in *ApplyProjectResourcesReq, out *ApplyProjectResourcesResp) error
 		AddNodesToCluster(ctx context.Context, in *AddNodesRequest, out *AddNodesResponse) error
 		DeleteNodesFromCluster(ctx context.Context, in *DeleteNodesRequest, out *DeleteNodesResponse) error
 		BatchDeleteNodesFromCluster(ctx context.Context, in *BatchDeleteClusterNodesRequest, out *BatchDeleteClusterNodesResponse) error
@@ -2631,6 +2800,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		ListNodesInGroup(ctx context.Context, in *GetNodeGroupRequest, out *ListNodesInGroupResponse) error
 		ListNodesInGroupV2(ctx context.Context, in *ListNodesInGroupV2Request, out *ListNodesInGroupV2Response) error
 		UpdateGroupDesiredNode(ctx context.Context, in *UpdateGroupDesiredNodeRequest, out *UpdateGroupDesiredNodeResponse) error
+		RolloutNodeGroup(ctx context.Context, in *RolloutNodeGroupRequest, out *RolloutNodeGroupResponse) error
 		UpdateGroupDesiredSize(ctx context.Context, in *UpdateGroupDesiredSizeRequest, out *UpdateGroupDesiredSizeResponse) error
 		UpdateGroupMinMaxSize(ctx context.Context, in *UpdateGroupMinMaxSizeRequest, out *UpdateGroupMinMaxSizeResponse) error
 		GetExternalNodeScriptByGroupID(ctx context.Context, in *GetExternalNodeScriptRequest, out *GetExternalNodeScriptResponse) error
@@ -2640,6 +2810,7 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		CreateTask(ctx context.Context, in *CreateTaskRequest, out *CreateTaskResponse) error
 		RetryTask(ctx context.Context, in *RetryTaskRequest, out *RetryTaskResponse) error
 		SkipTask(ctx context.Context, in *SkipTaskRequest, out *SkipTaskResponse) error
+		CancelTask(ctx context.Context, in *CancelTaskRequest, out *CancelTaskResponse) error
 		UpdateTask(ctx context.Context, in *UpdateTaskRequest, out *UpdateTaskResponse) error
 		DeleteTask(ctx context.Context, in *DeleteTaskRequest, out *DeleteTaskResponse) error
 		GetTask(ctx context.Context, in *GetTaskRequest, out *GetTaskResponse) error
@@ -2746,6 +2917,48 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"PUT"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.UpgradeCluster",
+		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/upgrade"},
+		Method:  []string{"PUT"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ListClusterDrift",
+		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/drift"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.GetClusterCost",
+		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/cost"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.GetProjectCost",
+		Path:    []string{"/clustermanager/v1/projects/{projectID}/cost"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.EvaluateNodeGroupCost",
+		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/cost/evaluate"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ExportProjectResources",
+		Path:    []string{"/clustermanager/v1/projects/{projectID}/resources/export"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.ApplyProjectResources",
+		Path:    []string{"/clustermanager/v1/projects/{projectID}/resources/apply"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.AddNodesToCluster",
 		Path:    []string{"/clustermanager/v1/cluster/{clusterID}/node"},
@@ -3082,6 +3295,12 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.RolloutNodeGroup",
+		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/rollout"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.UpdateGroupDesiredSize",
 		Path:    []string{"/clustermanager/v1/nodegroup/{nodeGroupID}/desiredsize"},
@@ -3136,6 +3355,12 @@ func RegisterClusterManagerHandler(s server.Server, hdlr ClusterManagerHandler,
 		Method:  []string{"PUT"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ClusterManager.CancelTask",
+		Path:    []string{"/clustermanager/v1/task/{taskID}/cancel"},
+		Method:  []string{"PUT"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ClusterManager.UpdateTask",
 		Path:    []string{"/clustermanager/v1/task/{taskID}"},
@@ -3557,6 +3782,34 @@ func (h *clusterManagerHandler) UpdateCluster(ctx context.Context, in *UpdateClu
 	return h.ClusterManagerHandler.UpdateCluster(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) UpgradeCluster(ctx context.Context, in *UpgradeClusterReq, out *UpgradeClusterResp) error {
+	return h.ClusterManagerHandler.UpgradeCluster(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ListClusterDrift(ctx context.Context, in *ListClusterDriftReq, out *ListClusterDriftResp) error {
+	return h.ClusterManagerHandler.ListClusterDrift(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) GetClusterCost(ctx context.Context, in *GetClusterCostReq, out *GetClusterCostResp) error {
+	return h.ClusterManagerHandler.GetClusterCost(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) GetProjectCost(ctx context.Context, in *GetProjectCostReq, out *GetProjectCostResp) error {
+	return h.ClusterManagerHandler.GetProjectCost(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) EvaluateNodeGroupCost(ctx context.Context, in *EvaluateNodeGroupCostReq, out *EvaluateNodeGroupCostResp) error {
+	return h.ClusterManagerHandler.EvaluateNodeGroupCost(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ExportProjectResources(ctx context.Context, in *ExportProjectResourcesReq, out *ExportProjectResourcesResp) error {
+	return h.ClusterManagerHandler.ExportProjectResources(ctx, in, out)
+}
+
+func (h *clusterManagerHandler) ApplyProjectResources(ctx context.Context, in *ApplyProjectResourcesReq, out *ApplyProjectResourcesResp) error {
+	return h.ClusterManagerHandler.ApplyProjectResources(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) AddNodesToCluster(ctx context.Context, in *AddNodesRequest, out *AddNodesResponse) error {
 	return h.ClusterManagerHandler.AddNodesToCluster(ctx, in, out)
 }
@@ -3781,6 +4034,10 @@ func (h *clusterManagerHandler) UpdateGroupDesiredNode(ctx context.Context, in *
 	return h.ClusterManagerHandler.UpdateGroupDesiredNode(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) RolloutNodeGroup(ctx context.Context, in *RolloutNodeGroupRequest, out *RolloutNodeGroupResponse) error {
+	return h.ClusterManagerHandler.RolloutNodeGroup(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) UpdateGroupDesiredSize(ctx context.Context, in *UpdateGroupDesiredSizeRequest, out *UpdateGroupDesiredSizeResponse) error {
 	return h.ClusterManagerHandler.UpdateGroupDesiredSize(ctx, in, out)
 }
@@ -3817,6 +4074,10 @@ func (h *clusterManagerHandler) SkipTask(ctx context.Context, in *SkipTaskReques
 	return h.ClusterManagerHandler.SkipTask(ctx, in, out)
 }
 
+func (h *clusterManagerHandler) CancelTask(ctx context.Context, in *CancelTaskRequest, out *CancelTaskResponse) error {
+	return h.ClusterManagerHandler.CancelTask(ctx, in, out)
+}
+
 func (h *clusterManagerHandler) UpdateTask(ctx context.Context, in *UpdateTaskRequest, out *UpdateTaskResponse) error {
 	return h.ClusterManagerHandler.UpdateTask(ctx, in, out)
 }
@@ -5545,6 +5545,21 @@ func (m *NodeGroup) Validate() error {
 
 	// no validation rules for ExtraInfo
 
+	for idx, item := range m.GetScalingSchedules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NodeGroupValidationError{
+					field:  fmt.Sprintf("ScalingSchedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -5613,6 +5628,181 @@ var _NodeGroup_Status_InLookup = map[string]struct{}{
 	"DELETED":        {},
 }
 
+// Validate checks the field values on ScalingSchedule with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ScalingSchedule) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return ScalingScheduleValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetCron()) < 1 {
+		return ScalingScheduleValidationError{
+			field:  "Cron",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for DesiredSize
+
+	// no validation rules for TimeZone
+
+	// no validation rules for Enable
+
+	// no validation rules for LastRunTime
+
+	// no validation rules for LastRunResult
+
+	// no validation rules for LastTaskID
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// ScalingScheduleValidationError is the validation error returned by
+// ScalingSchedule.Validate if the designated constraints aren't met.
+type ScalingScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScalingScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScalingScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScalingScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScalingScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScalingScheduleValidationError) ErrorName() string { return "ScalingScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScalingScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScalingSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScalingScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScalingScheduleValidationError{}
+
+// Validate checks the field values on ScalingScheduleList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScalingScheduleList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScalingScheduleListValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ScalingScheduleListValidationError is the validation error returned by
+// ScalingScheduleList.Validate if the designated constraints aren't met.
+type ScalingScheduleListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScalingScheduleListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScalingScheduleListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScalingScheduleListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScalingScheduleListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScalingScheduleListValidationError) ErrorName() string {
+	return "ScalingScheduleListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScalingScheduleListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScalingScheduleList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScalingScheduleListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScalingScheduleListValidationError{}
+
 // Validate checks the field values on CloudArea with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *CloudArea) Validate() error {
@@ -8746,6 +8936,8 @@ func (m *Step) Validate() error {
 
 	// no validation rules for AllowSkip
 
+	// no validation rules for RollbackMethod
+
 	return nil
 }
 
@@ -11704,10 +11896,1774 @@ var _UpdateClusterReq_Status_InLookup = map[string]struct{}{
 	"":               {},
 }
 
-// Validate checks the field values on UpdateClusterResp with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *UpdateClusterResp) Validate() error {
+// Validate checks the field values on UpdateClusterResp with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UpdateClusterResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateClusterRespValidationError is the validation error returned by
+// UpdateClusterResp.Validate if the designated constraints aren't met.
+type UpdateClusterRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateClusterRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateClusterRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateClusterRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateClusterRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateClusterRespValidationError) ErrorName() string {
+	return "UpdateClusterRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateClusterRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateClusterResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateClusterRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateClusterRespValidationError{}
+
+// Validate checks the field values on UpgradeClusterReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UpgradeClusterReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 1 || l > 100 {
+		return UpgradeClusterReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	if !strings.HasPrefix(m.GetClusterID(), "BCS-") {
+		return UpgradeClusterReqValidationError{
+			field:  "ClusterID",
+			reason: "value does not have prefix \"BCS-\"",
+		}
+	}
+
+	if !_UpgradeClusterReq_ClusterID_Pattern.MatchString(m.GetClusterID()) {
+		return UpgradeClusterReqValidationError{
+			field:  "ClusterID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 100 {
+		return UpgradeClusterReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetOperator()); l < 1 || l > 100 {
+		return UpgradeClusterReqValidationError{
+			field:  "Operator",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	// no validation rules for OnlyUpgradeMaster
+
+	// no validation rules for DrainTimeout
+
+	return nil
+}
+
+// UpgradeClusterReqValidationError is the validation error returned by
+// UpgradeClusterReq.Validate if the designated constraints aren't met.
+type UpgradeClusterReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterReqValidationError) ErrorName() string {
+	return "UpgradeClusterReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterReqValidationError{}
+
+var _UpgradeClusterReq_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpgradeClusterResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpgradeClusterResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterRespValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpgradeClusterRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpgradeClusterRespValidationError is the validation error returned by
+// UpgradeClusterResp.Validate if the designated constraints aren't met.
+type UpgradeClusterRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeClusterRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeClusterRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeClusterRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeClusterRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeClusterRespValidationError) ErrorName() string {
+	return "UpgradeClusterRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeClusterRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeClusterResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeClusterRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeClusterRespValidationError{}
+
+// Validate checks the field values on ClusterDrift with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ClusterDrift) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for DriftID
+
+	// no validation rules for ClusterID
+
+	// no validation rules for NodeGroupID
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Field
+
+	// no validation rules for StoreValue
+
+	// no validation rules for CloudValue
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for DetectTime
+
+	// no validation rules for UpdateTime
+
+	return nil
+}
+
+// ClusterDriftValidationError is the validation error returned by
+// ClusterDrift.Validate if the designated constraints aren't met.
+type ClusterDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterDriftValidationError) ErrorName() string { return "ClusterDriftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterDriftValidationError{}
+
+// Validate checks the field values on ListClusterDriftReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListClusterDriftReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 2 || l > 100 {
+		return ListClusterDriftReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	// no validation rules for NodeGroupID
+
+	if _, ok := _ListClusterDriftReq_ResourceType_InLookup[m.GetResourceType()]; !ok {
+		return ListClusterDriftReqValidationError{
+			field:  "ResourceType",
+			reason: "value must be in list [ cluster nodegroup]",
+		}
+	}
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// ListClusterDriftReqValidationError is the validation error returned by
+// ListClusterDriftReq.Validate if the designated constraints aren't met.
+type ListClusterDriftReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterDriftReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterDriftReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterDriftReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterDriftReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterDriftReqValidationError) ErrorName() string {
+	return "ListClusterDriftReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterDriftReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterDriftReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterDriftReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterDriftReqValidationError{}
+
+var _ListClusterDriftReq_ResourceType_InLookup = map[string]struct{}{
+	"":          {},
+	"cluster":   {},
+	"nodegroup": {},
+}
+
+// Validate checks the field values on ListClusterDriftResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListClusterDriftResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterDriftRespValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListClusterDriftRespValidationError is the validation error returned by
+// ListClusterDriftResp.Validate if the designated constraints aren't met.
+type ListClusterDriftRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterDriftRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterDriftRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterDriftRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterDriftRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterDriftRespValidationError) ErrorName() string {
+	return "ListClusterDriftRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterDriftRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterDriftResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterDriftRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterDriftRespValidationError{}
+
+// Validate checks the field values on NodeGroupCost with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *NodeGroupCost) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NodeGroupID
+
+	// no validation rules for Name
+
+	// no validation rules for InstanceType
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for CurrentNodes
+
+	// no validation rules for DesiredSize
+
+	// no validation rules for MinSize
+
+	// no validation rules for MaxSize
+
+	// no validation rules for CurrentMonthlyCost
+
+	// no validation rules for ProjectedMonthlyCost
+
+	// no validation rules for MinMonthlyCost
+
+	// no validation rules for MaxMonthlyCost
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// NodeGroupCostValidationError is the validation error returned by
+// NodeGroupCost.Validate if the designated constraints aren't met.
+type NodeGroupCostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeGroupCostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeGroupCostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeGroupCostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeGroupCostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeGroupCostValidationError) ErrorName() string { return "NodeGroupCostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeGroupCostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeGroupCost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeGroupCostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeGroupCostValidationError{}
+
+// Validate checks the field values on ClusterCost with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ClusterCost) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ClusterID
+
+	// no validation rules for ClusterName
+
+	// no validation rules for ProjectID
+
+	// no validation rules for Provider
+
+	// no validation rules for Region
+
+	// no validation rules for CurrentMonthlyCost
+
+	// no validation rules for ProjectedMonthlyCost
+
+	// no validation rules for MinMonthlyCost
+
+	// no validation rules for MaxMonthlyCost
+
+	for idx, item := range m.GetNodeGroups() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterCostValidationError{
+					field:  fmt.Sprintf("NodeGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ClusterCostValidationError is the validation error returned by
+// ClusterCost.Validate if the designated constraints aren't met.
+type ClusterCostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterCostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterCostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterCostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterCostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterCostValidationError) ErrorName() string { return "ClusterCostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterCostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterCost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterCostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterCostValidationError{}
+
+// Validate checks the field values on ProjectCost with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ProjectCost) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProjectID
+
+	// no validation rules for CurrentMonthlyCost
+
+	// no validation rules for ProjectedMonthlyCost
+
+	// no validation rules for MinMonthlyCost
+
+	// no validation rules for MaxMonthlyCost
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectCostValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ProjectCostValidationError is the validation error returned by
+// ProjectCost.Validate if the designated constraints aren't met.
+type ProjectCostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectCostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectCostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectCostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectCostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectCostValidationError) ErrorName() string { return "ProjectCostValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectCostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectCost.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectCostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectCostValidationError{}
+
+// Validate checks the field values on GetClusterCostReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetClusterCostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 2 || l > 100 {
+		return GetClusterCostReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	// no validation rules for NodeGroupID
+
+	return nil
+}
+
+// GetClusterCostReqValidationError is the validation error returned by
+// GetClusterCostReq.Validate if the designated constraints aren't met.
+type GetClusterCostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterCostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterCostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterCostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterCostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterCostReqValidationError) ErrorName() string {
+	return "GetClusterCostReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterCostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterCostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterCostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterCostReqValidationError{}
+
+// Validate checks the field values on GetClusterCostResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetClusterCostResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterCostRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetClusterCostRespValidationError is the validation error returned by
+// GetClusterCostResp.Validate if the designated constraints aren't met.
+type GetClusterCostRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterCostRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterCostRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterCostRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterCostRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterCostRespValidationError) ErrorName() string {
+	return "GetClusterCostRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterCostRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterCostResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterCostRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterCostRespValidationError{}
+
+// Validate checks the field values on GetProjectCostReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetProjectCostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 2 || l > 100 {
+		return GetProjectCostReqValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// GetProjectCostReqValidationError is the validation error returned by
+// GetProjectCostReq.Validate if the designated constraints aren't met.
+type GetProjectCostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectCostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectCostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectCostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectCostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectCostReqValidationError) ErrorName() string {
+	return "GetProjectCostReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectCostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectCostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectCostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectCostReqValidationError{}
+
+// Validate checks the field values on GetProjectCostResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetProjectCostResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectCostRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetProjectCostRespValidationError is the validation error returned by
+// GetProjectCostResp.Validate if the designated constraints aren't met.
+type GetProjectCostRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectCostRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectCostRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectCostRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectCostRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectCostRespValidationError) ErrorName() string {
+	return "GetProjectCostRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectCostRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectCostResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectCostRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectCostRespValidationError{}
+
+// Validate checks the field values on EvaluateNodeGroupCostReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EvaluateNodeGroupCostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetNodeGroupID()); l < 2 || l > 100 {
+		return EvaluateNodeGroupCostReqValidationError{
+			field:  "NodeGroupID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMinSize()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateNodeGroupCostReqValidationError{
+				field:  "MinSize",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMaxSize()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateNodeGroupCostReqValidationError{
+				field:  "MaxSize",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InstanceType
+
+	return nil
+}
+
+// EvaluateNodeGroupCostReqValidationError is the validation error returned by
+// EvaluateNodeGroupCostReq.Validate if the designated constraints aren't met.
+type EvaluateNodeGroupCostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateNodeGroupCostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateNodeGroupCostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateNodeGroupCostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateNodeGroupCostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateNodeGroupCostReqValidationError) ErrorName() string {
+	return "EvaluateNodeGroupCostReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateNodeGroupCostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateNodeGroupCostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateNodeGroupCostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateNodeGroupCostReqValidationError{}
+
+// Validate checks the field values on NodeGroupCostEvaluation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NodeGroupCostEvaluation) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetCurrent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeGroupCostEvaluationValidationError{
+				field:  "Current",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetProposed()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeGroupCostEvaluationValidationError{
+				field:  "Proposed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CurrentMonthlyCostDiff
+
+	// no validation rules for ProjectedMonthlyCostDiff
+
+	// no validation rules for MinMonthlyCostDiff
+
+	// no validation rules for MaxMonthlyCostDiff
+
+	return nil
+}
+
+// NodeGroupCostEvaluationValidationError is the validation error returned by
+// NodeGroupCostEvaluation.Validate if the designated constraints aren't met.
+type NodeGroupCostEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeGroupCostEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeGroupCostEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeGroupCostEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeGroupCostEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeGroupCostEvaluationValidationError) ErrorName() string {
+	return "NodeGroupCostEvaluationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NodeGroupCostEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeGroupCostEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeGroupCostEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeGroupCostEvaluationValidationError{}
+
+// Validate checks the field values on EvaluateNodeGroupCostResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EvaluateNodeGroupCostResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateNodeGroupCostRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// EvaluateNodeGroupCostRespValidationError is the validation error returned by
+// EvaluateNodeGroupCostResp.Validate if the designated constraints aren't met.
+type EvaluateNodeGroupCostRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateNodeGroupCostRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateNodeGroupCostRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateNodeGroupCostRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateNodeGroupCostRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateNodeGroupCostRespValidationError) ErrorName() string {
+	return "EvaluateNodeGroupCostRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateNodeGroupCostRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateNodeGroupCostResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateNodeGroupCostRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateNodeGroupCostRespValidationError{}
+
+// Validate checks the field values on ExportProjectResourcesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportProjectResourcesReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 2 || l > 100 {
+		return ExportProjectResourcesReqValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetClusterID()) > 100 {
+		return ExportProjectResourcesReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be at most 100 runes",
+		}
+	}
+
+	return nil
+}
+
+// ExportProjectResourcesReqValidationError is the validation error returned by
+// ExportProjectResourcesReq.Validate if the designated constraints aren't met.
+type ExportProjectResourcesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportProjectResourcesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportProjectResourcesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportProjectResourcesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportProjectResourcesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportProjectResourcesReqValidationError) ErrorName() string {
+	return "ExportProjectResourcesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportProjectResourcesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportProjectResourcesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportProjectResourcesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportProjectResourcesReqValidationError{}
+
+// Validate checks the field values on ExportProjectResourcesResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportProjectResourcesResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	// no validation rules for Data
+
+	return nil
+}
+
+// ExportProjectResourcesRespValidationError is the validation error returned
+// by ExportProjectResourcesResp.Validate if the designated constraints aren't met.
+type ExportProjectResourcesRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportProjectResourcesRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportProjectResourcesRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportProjectResourcesRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportProjectResourcesRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportProjectResourcesRespValidationError) ErrorName() string {
+	return "ExportProjectResourcesRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportProjectResourcesRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportProjectResourcesResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportProjectResourcesRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportProjectResourcesRespValidationError{}
+
+// Validate checks the field values on ApplyProjectResourcesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyProjectResourcesReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectID()); l < 2 || l > 100 {
+		return ApplyProjectResourcesReqValidationError{
+			field:  "ProjectID",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetBundle()) < 1 {
+		return ApplyProjectResourcesReqValidationError{
+			field:  "Bundle",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if l := utf8.RuneCountInString(m.GetOperator()); l < 1 || l > 100 {
+		return ApplyProjectResourcesReqValidationError{
+			field:  "Operator",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// ApplyProjectResourcesReqValidationError is the validation error returned by
+// ApplyProjectResourcesReq.Validate if the designated constraints aren't met.
+type ApplyProjectResourcesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyProjectResourcesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyProjectResourcesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyProjectResourcesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyProjectResourcesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyProjectResourcesReqValidationError) ErrorName() string {
+	return "ApplyProjectResourcesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyProjectResourcesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyProjectResourcesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyProjectResourcesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyProjectResourcesReqValidationError{}
+
+// Validate checks the field values on ApplyResourceResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyResourceResult) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for ResourceID
+
+	// no validation rules for Name
+
+	// no validation rules for Action
+
+	// no validation rules for TaskID
+
+	// no validation rules for Message
+
+	return nil
+}
+
+// ApplyResourceResultValidationError is the validation error returned by
+// ApplyResourceResult.Validate if the designated constraints aren't met.
+type ApplyResourceResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyResourceResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyResourceResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyResourceResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyResourceResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyResourceResultValidationError) ErrorName() string {
+	return "ApplyResourceResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyResourceResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyResourceResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyResourceResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyResourceResultValidationError{}
+
+// Validate checks the field values on ApplyProjectResourcesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyProjectResourcesResp) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -11718,32 +13674,27 @@ func (m *UpdateClusterResp) Validate() error {
 
 	// no validation rules for Result
 
-	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateClusterRespValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateClusterRespValidationError{
-				field:  "WebAnnotations",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplyProjectResourcesRespValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	return nil
 }
 
-// UpdateClusterRespValidationError is the validation error returned by
-// UpdateClusterResp.Validate if the designated constraints aren't met.
-type UpdateClusterRespValidationError struct {
+// ApplyProjectResourcesRespValidationError is the validation error returned by
+// ApplyProjectResourcesResp.Validate if the designated constraints aren't met.
+type ApplyProjectResourcesRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11751,24 +13702,24 @@ type UpdateClusterRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateClusterRespValidationError) Field() string { return e.field }
+func (e ApplyProjectResourcesRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateClusterRespValidationError) Reason() string { return e.reason }
+func (e ApplyProjectResourcesRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateClusterRespValidationError) Cause() error { return e.cause }
+func (e ApplyProjectResourcesRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateClusterRespValidationError) Key() bool { return e.key }
+func (e ApplyProjectResourcesRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateClusterRespValidationError) ErrorName() string {
-	return "UpdateClusterRespValidationError"
+func (e ApplyProjectResourcesRespValidationError) ErrorName() string {
+	return "ApplyProjectResourcesRespValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateClusterRespValidationError) Error() string {
+func (e ApplyProjectResourcesRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11780,14 +13731,14 @@ func (e UpdateClusterRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateClusterResp.%s: %s%s",
+		"invalid %sApplyProjectResourcesResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateClusterRespValidationError{}
+var _ error = ApplyProjectResourcesRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -11795,7 +13746,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateClusterRespValidationError{}
+} = ApplyProjectResourcesRespValidationError{}
 
 // Validate checks the field values on RetryCreateClusterReq with the rules
 // defined in the proto definition for this message. If any rules are
@@ -17356,6 +19307,21 @@ func (m *CreateNodeGroupRequest) Validate() error {
 
 	// no validation rules for OnlyCreateInfo
 
+	for idx, item := range m.GetScalingSchedules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateNodeGroupRequestValidationError{
+					field:  fmt.Sprintf("ScalingSchedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -17796,6 +19762,16 @@ func (m *UpdateNodeGroupRequest) Validate() error {
 
 	// no validation rules for ExtraInfo
 
+	if v, ok := interface{}(m.GetScalingSchedules()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNodeGroupRequestValidationError{
+				field:  "ScalingSchedules",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -21288,43 +23264,288 @@ var _ interface {
 // Validate checks the field values on UpdateGroupDesiredNodeRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateGroupDesiredNodeRequest) Validate() error {
+func (m *UpdateGroupDesiredNodeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetNodeGroupID()); l < 5 || l > 100 {
+		return UpdateGroupDesiredNodeRequestValidationError{
+			field:  "NodeGroupID",
+			reason: "value length must be between 5 and 100 runes, inclusive",
+		}
+	}
+
+	if !_UpdateGroupDesiredNodeRequest_NodeGroupID_Pattern.MatchString(m.GetNodeGroupID()) {
+		return UpdateGroupDesiredNodeRequestValidationError{
+			field:  "NodeGroupID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+	}
+
+	// no validation rules for DesiredNode
+
+	if utf8.RuneCountInString(m.GetOperator()) > 100 {
+		return UpdateGroupDesiredNodeRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 100 runes",
+		}
+	}
+
+	// no validation rules for Manual
+
+	return nil
+}
+
+// UpdateGroupDesiredNodeRequestValidationError is the validation error
+// returned by UpdateGroupDesiredNodeRequest.Validate if the designated
+// constraints aren't met.
+type UpdateGroupDesiredNodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGroupDesiredNodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGroupDesiredNodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGroupDesiredNodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGroupDesiredNodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGroupDesiredNodeRequestValidationError) ErrorName() string {
+	return "UpdateGroupDesiredNodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGroupDesiredNodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGroupDesiredNodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGroupDesiredNodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGroupDesiredNodeRequestValidationError{}
+
+var _UpdateGroupDesiredNodeRequest_NodeGroupID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on UpdateGroupDesiredNodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateGroupDesiredNodeResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateGroupDesiredNodeResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateGroupDesiredNodeResponseValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateGroupDesiredNodeResponseValidationError is the validation error
+// returned by UpdateGroupDesiredNodeResponse.Validate if the designated
+// constraints aren't met.
+type UpdateGroupDesiredNodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGroupDesiredNodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGroupDesiredNodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGroupDesiredNodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGroupDesiredNodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGroupDesiredNodeResponseValidationError) ErrorName() string {
+	return "UpdateGroupDesiredNodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGroupDesiredNodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGroupDesiredNodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGroupDesiredNodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGroupDesiredNodeResponseValidationError{}
+
+// Validate checks the field values on RolloutNodeGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RolloutNodeGroupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 5 || l > 100 {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 5 and 100 runes, inclusive",
+		}
+	}
+
+	if !strings.HasPrefix(m.GetClusterID(), "BCS-") {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "ClusterID",
+			reason: "value does not have prefix \"BCS-\"",
+		}
+	}
+
+	if !_RolloutNodeGroupRequest_ClusterID_Pattern.MatchString(m.GetClusterID()) {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "ClusterID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+	}
+
 	if l := utf8.RuneCountInString(m.GetNodeGroupID()); l < 5 || l > 100 {
-		return UpdateGroupDesiredNodeRequestValidationError{
+		return RolloutNodeGroupRequestValidationError{
 			field:  "NodeGroupID",
 			reason: "value length must be between 5 and 100 runes, inclusive",
 		}
 	}
 
-	if !_UpdateGroupDesiredNodeRequest_NodeGroupID_Pattern.MatchString(m.GetNodeGroupID()) {
-		return UpdateGroupDesiredNodeRequestValidationError{
+	if !_RolloutNodeGroupRequest_NodeGroupID_Pattern.MatchString(m.GetNodeGroupID()) {
+		return RolloutNodeGroupRequestValidationError{
 			field:  "NodeGroupID",
 			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
 		}
 	}
 
-	// no validation rules for DesiredNode
+	if m.GetMaxSurge() > 100 {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "MaxSurge",
+			reason: "value must be less than or equal to 100",
+		}
+	}
 
-	if utf8.RuneCountInString(m.GetOperator()) > 100 {
-		return UpdateGroupDesiredNodeRequestValidationError{
-			field:  "Operator",
-			reason: "value length must be at most 100 runes",
+	if m.GetMaxUnavailable() > 100 {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "MaxUnavailable",
+			reason: "value must be less than or equal to 100",
 		}
 	}
 
-	// no validation rules for Manual
+	// no validation rules for DrainTimeout
+
+	if len(m.GetNodes()) > 500 {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "Nodes",
+			reason: "value must contain no more than 500 item(s)",
+		}
+	}
+
+	_RolloutNodeGroupRequest_Nodes_Unique := make(map[string]struct{}, len(m.GetNodes()))
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if _, exists := _RolloutNodeGroupRequest_Nodes_Unique[item]; exists {
+			return RolloutNodeGroupRequestValidationError{
+				field:  fmt.Sprintf("Nodes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_RolloutNodeGroupRequest_Nodes_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Nodes[idx]
+	}
+
+	if l := utf8.RuneCountInString(m.GetOperator()); l < 2 || l > 100 {
+		return RolloutNodeGroupRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+	}
 
 	return nil
 }
 
-// UpdateGroupDesiredNodeRequestValidationError is the validation error
-// returned by UpdateGroupDesiredNodeRequest.Validate if the designated
-// constraints aren't met.
-type UpdateGroupDesiredNodeRequestValidationError struct {
+// RolloutNodeGroupRequestValidationError is the validation error returned by
+// RolloutNodeGroupRequest.Validate if the designated constraints aren't met.
+type RolloutNodeGroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21332,24 +23553,24 @@ type UpdateGroupDesiredNodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateGroupDesiredNodeRequestValidationError) Field() string { return e.field }
+func (e RolloutNodeGroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateGroupDesiredNodeRequestValidationError) Reason() string { return e.reason }
+func (e RolloutNodeGroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateGroupDesiredNodeRequestValidationError) Cause() error { return e.cause }
+func (e RolloutNodeGroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateGroupDesiredNodeRequestValidationError) Key() bool { return e.key }
+func (e RolloutNodeGroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateGroupDesiredNodeRequestValidationError) ErrorName() string {
-	return "UpdateGroupDesiredNodeRequestValidationError"
+func (e RolloutNodeGroupRequestValidationError) ErrorName() string {
+	return "RolloutNodeGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateGroupDesiredNodeRequestValidationError) Error() string {
+func (e RolloutNodeGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21361,14 +23582,14 @@ func (e UpdateGroupDesiredNodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateGroupDesiredNodeRequest.%s: %s%s",
+		"invalid %sRolloutNodeGroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateGroupDesiredNodeRequestValidationError{}
+var _ error = RolloutNodeGroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21376,14 +23597,16 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateGroupDesiredNodeRequestValidationError{}
+} = RolloutNodeGroupRequestValidationError{}
 
-var _UpdateGroupDesiredNodeRequest_NodeGroupID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+var _RolloutNodeGroupRequest_ClusterID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
 
-// Validate checks the field values on UpdateGroupDesiredNodeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+var _RolloutNodeGroupRequest_NodeGroupID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+// Validate checks the field values on RolloutNodeGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateGroupDesiredNodeResponse) Validate() error {
+func (m *RolloutNodeGroupResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -21396,7 +23619,7 @@ func (m *UpdateGroupDesiredNodeResponse) Validate() error {
 
 	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateGroupDesiredNodeResponseValidationError{
+			return RolloutNodeGroupResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21404,23 +23627,12 @@ func (m *UpdateGroupDesiredNodeResponse) Validate() error {
 		}
 	}
 
-	if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateGroupDesiredNodeResponseValidationError{
-				field:  "WebAnnotations",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// UpdateGroupDesiredNodeResponseValidationError is the validation error
-// returned by UpdateGroupDesiredNodeResponse.Validate if the designated
-// constraints aren't met.
-type UpdateGroupDesiredNodeResponseValidationError struct {
+// RolloutNodeGroupResponseValidationError is the validation error returned by
+// RolloutNodeGroupResponse.Validate if the designated constraints aren't met.
+type RolloutNodeGroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21428,24 +23640,24 @@ type UpdateGroupDesiredNodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateGroupDesiredNodeResponseValidationError) Field() string { return e.field }
+func (e RolloutNodeGroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateGroupDesiredNodeResponseValidationError) Reason() string { return e.reason }
+func (e RolloutNodeGroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateGroupDesiredNodeResponseValidationError) Cause() error { return e.cause }
+func (e RolloutNodeGroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateGroupDesiredNodeResponseValidationError) Key() bool { return e.key }
+func (e RolloutNodeGroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateGroupDesiredNodeResponseValidationError) ErrorName() string {
-	return "UpdateGroupDesiredNodeResponseValidationError"
+func (e RolloutNodeGroupResponseValidationError) ErrorName() string {
+	return "RolloutNodeGroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateGroupDesiredNodeResponseValidationError) Error() string {
+func (e RolloutNodeGroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21457,14 +23669,14 @@ func (e UpdateGroupDesiredNodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateGroupDesiredNodeResponse.%s: %s%s",
+		"invalid %sRolloutNodeGroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateGroupDesiredNodeResponseValidationError{}
+var _ error = RolloutNodeGroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21472,7 +23684,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateGroupDesiredNodeResponseValidationError{}
+} = RolloutNodeGroupResponseValidationError{}
 
 // Validate checks the field values on EnableNodeGroupAutoScaleRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -22406,6 +24618,194 @@ var _ interface {
 	ErrorName() string
 } = SkipTaskResponseValidationError{}
 
+// Validate checks the field values on CancelTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *CancelTaskRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetTaskID()); l < 2 || l > 1024 {
+		return CancelTaskRequestValidationError{
+			field:  "TaskID",
+			reason: "value length must be between 2 and 1024 runes, inclusive",
+		}
+	}
+
+	if !_CancelTaskRequest_TaskID_Pattern.MatchString(m.GetTaskID()) {
+		return CancelTaskRequestValidationError{
+			field:  "TaskID",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetUpdater()); l < 2 || l > 1024 {
+		return CancelTaskRequestValidationError{
+			field:  "Updater",
+			reason: "value length must be between 2 and 1024 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Rollback
+
+	if _, ok := _CancelTaskRequest_DeleteMode_InLookup[m.GetDeleteMode()]; !ok {
+		return CancelTaskRequestValidationError{
+			field:  "DeleteMode",
+			reason: "value must be in list [ retain terminate]",
+		}
+	}
+
+	return nil
+}
+
+// CancelTaskRequestValidationError is the validation error returned by
+// CancelTaskRequest.Validate if the designated constraints aren't met.
+type CancelTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTaskRequestValidationError) ErrorName() string {
+	return "CancelTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTaskRequestValidationError{}
+
+var _CancelTaskRequest_TaskID_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
+
+var _CancelTaskRequest_DeleteMode_InLookup = map[string]struct{}{
+	"":          {},
+	"retain":    {},
+	"terminate": {},
+}
+
+// Validate checks the field values on CancelTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CancelTaskResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelTaskResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CancelTaskResponseValidationError is the validation error returned by
+// CancelTaskResponse.Validate if the designated constraints aren't met.
+type CancelTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTaskResponseValidationError) ErrorName() string {
+	return "CancelTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTaskResponseValidationError{}
+
 // Validate checks the field values on UpdateTaskRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -36272,6 +38672,16 @@ func (m *NotifyTemplate) Validate() error {
 
 	// no validation rules for UpdateTime
 
+	if v, ok := interface{}(m.GetClusterDrift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotifyTemplateValidationError{
+				field:  "ClusterDrift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -36461,6 +38871,16 @@ func (m *CreateNotifyTemplateRequest) Validate() error {
 
 	// no validation rules for Creator
 
+	if v, ok := interface{}(m.GetClusterDrift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNotifyTemplateRequestValidationError{
+				field:  "ClusterDrift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
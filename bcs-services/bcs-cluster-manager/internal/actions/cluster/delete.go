@@ -189,6 +189,10 @@ func (da *DeleteAction) cleanLocalInformation() error {
 				return err
 			}
 		}
+		// clean cluster drift records
+		if err := da.model.DeleteClusterDriftByClusterID(da.ctx, da.req.ClusterID); err != nil {
+			blog.Errorf("clean Cluster %s drift information failed, %s", da.req.ClusterID, err.Error())
+		}
 	} else {
 		// can not force delete, we need to check all relative resource
 		// we don't delete cluster when relative resource are running
@@ -230,6 +234,10 @@ func (da *DeleteAction) deleteRelativeResource() error {
 			return err
 		}
 	}
+	// clean cluster drift records, no relative entity in cloud infrastructure
+	if err := da.model.DeleteClusterDriftByClusterID(da.ctx, da.req.ClusterID); err != nil {
+		blog.Errorf("clean Cluster %s drift information failed, %s", da.req.ClusterID, err.Error())
+	}
 	//! NodeGroup update status for other operation deny, it manage Nodes,
 	//! we don't delete it here, we delete it after all Nodes are releasing
 	for _, group := range da.nodeGroups {
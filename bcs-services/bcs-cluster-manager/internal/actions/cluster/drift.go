@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clusterdrift"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+// ListDriftAction action for list cluster configuration drift
+type ListDriftAction struct {
+	ctx       context.Context
+	model     store.ClusterManagerModel
+	req       *cmproto.ListClusterDriftReq
+	resp      *cmproto.ListClusterDriftResp
+	driftList []*cmproto.ClusterDrift
+}
+
+// NewListDriftAction create list cluster drift action
+func NewListDriftAction(model store.ClusterManagerModel) *ListDriftAction {
+	return &ListDriftAction{
+		model: model,
+	}
+}
+
+func (la *ListDriftAction) validate() error {
+	if err := la.req.Validate(); err != nil {
+		return err
+	}
+
+	_, err := la.model.GetCluster(la.ctx, la.req.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (la *ListDriftAction) listClusterDrift() error {
+	condM := make(operator.M)
+	condM[clusterdrift.ClusterIDKey] = la.req.ClusterID
+	if len(la.req.NodeGroupID) != 0 {
+		condM[clusterdrift.NodeGroupIDKey] = la.req.NodeGroupID
+	}
+	if len(la.req.ResourceType) != 0 {
+		condM[clusterdrift.ResourceTypeKey] = la.req.ResourceType
+	}
+	if len(la.req.Status) != 0 {
+		condM[clusterdrift.StatusKey] = la.req.Status
+	}
+	cond := operator.NewLeafCondition(operator.Eq, condM)
+
+	driftList, err := la.model.ListClusterDrift(la.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return err
+	}
+	for i := range driftList {
+		driftList[i].DetectTime = utils.TransTimeFormat(driftList[i].DetectTime)
+		driftList[i].UpdateTime = utils.TransTimeFormat(driftList[i].UpdateTime)
+		la.driftList = append(la.driftList, &driftList[i])
+	}
+
+	return nil
+}
+
+func (la *ListDriftAction) setResp(code uint32, msg string) {
+	la.resp.Code = code
+	la.resp.Message = msg
+	la.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	la.resp.Data = la.driftList
+}
+
+// Handle list cluster drift request
+func (la *ListDriftAction) Handle(ctx context.Context, req *cmproto.ListClusterDriftReq,
+	resp *cmproto.ListClusterDriftResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("list cluster drift failed, req or resp is empty")
+		return
+	}
+	la.ctx = ctx
+	la.req = req
+	la.resp = resp
+
+	if err := la.validate(); err != nil {
+		la.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := la.listClusterDrift(); err != nil {
+		la.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	la.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,307 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	provider "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// UpgradeAction action for upgrade cluster version
+type UpgradeAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.UpgradeClusterReq
+	resp  *cmproto.UpgradeClusterResp
+
+	cluster        *cmproto.Cluster
+	cloud          *cmproto.Cloud
+	cmOptions      *cloudprovider.CommonOption
+	nodeGroups     []*cmproto.NodeGroup
+	nodeGroupNodes map[string][]*cmproto.Node
+	task           *cmproto.Task
+}
+
+// NewUpgradeAction upgrade cluster action
+func NewUpgradeAction(model store.ClusterManagerModel) *UpgradeAction {
+	return &UpgradeAction{
+		model:          model,
+		nodeGroups:     make([]*cmproto.NodeGroup, 0),
+		nodeGroupNodes: make(map[string][]*cmproto.Node),
+	}
+}
+
+func (ua *UpgradeAction) setResp(code uint32, msg string) {
+	ua.resp.Code = code
+	ua.resp.Message = msg
+	ua.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (ua *UpgradeAction) validate() error {
+	if err := ua.req.Validate(); err != nil {
+		return err
+	}
+
+	if ua.req.DrainTimeout == 0 {
+		ua.req.DrainTimeout = provider.DefaultDrainTimeout
+	}
+
+	return nil
+}
+
+func (ua *UpgradeAction) getCluster() error {
+	cluster, err := ua.model.GetCluster(ua.ctx, ua.req.ClusterID)
+	if err != nil {
+		blog.Errorf("get Cluster %s failed, %s", ua.req.ClusterID, err.Error())
+		return err
+	}
+	ua.cluster = cluster
+
+	if cluster.Status != common.StatusRunning && cluster.Status != common.StatusUpgradeClusterFailed {
+		return fmt.Errorf("cluster %s status %s not support upgrade", cluster.ClusterID, cluster.Status)
+	}
+
+	return nil
+}
+
+func (ua *UpgradeAction) getCloudInfo() error {
+	cloud, err := ua.model.GetCloud(ua.ctx, ua.cluster.Provider)
+	if err != nil {
+		blog.Errorf("get Cluster %s provider %s information failed, %s",
+			ua.cluster.ClusterID, ua.cluster.Provider, err.Error())
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return err
+	}
+	ua.cloud = cloud
+
+	coption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     cloud,
+		AccountID: ua.cluster.CloudAccountID,
+	})
+	if err != nil {
+		blog.Errorf("get Credential failed when upgrade Cluster %s, %s. Cloud %s",
+			ua.cluster.ClusterID, ua.cloud.CloudID, err.Error())
+		ua.setResp(common.BcsErrClusterManagerCloudProviderErr, err.Error())
+		return err
+	}
+	coption.Region = ua.cluster.Region
+	ua.cmOptions = coption
+
+	return nil
+}
+
+// getNodeGroupsAndNodes get nodeGroups which need to upgrade and their nodes
+func (ua *UpgradeAction) getNodeGroupsAndNodes() error {
+	if ua.req.OnlyUpgradeMaster {
+		return nil
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid": ua.req.ClusterID,
+	})
+	groupList, err := ua.model.ListNodeGroup(ua.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("list Cluster %s NodeGroups failed, %s", ua.req.ClusterID, err.Error())
+		return err
+	}
+
+	filter := make(map[string]struct{})
+	for _, id := range ua.req.NodeGroupIDs {
+		filter[id] = struct{}{}
+	}
+	for i := range groupList {
+		if _, ok := filter[groupList[i].NodeGroupID]; len(filter) > 0 && !ok {
+			continue
+		}
+		ua.nodeGroups = append(ua.nodeGroups, &groupList[i])
+		delete(filter, groupList[i].NodeGroupID)
+	}
+	if len(filter) > 0 {
+		notFound := make([]string, 0)
+		for id := range filter {
+			notFound = append(notFound, id)
+		}
+		return fmt.Errorf("cluster %s nodeGroups %v not found", ua.req.ClusterID, notFound)
+	}
+
+	for _, group := range ua.nodeGroups {
+		nodeCond := operator.NewLeafCondition(operator.Eq, operator.M{
+			"clusterid":   ua.req.ClusterID,
+			"nodegroupid": group.NodeGroupID,
+		})
+		nodes, err := ua.model.ListNode(ua.ctx, nodeCond, &storeopt.ListOption{})
+		if err != nil {
+			blog.Errorf("list NodeGroup %s nodes failed, %s", group.NodeGroupID, err.Error())
+			return err
+		}
+		ua.nodeGroupNodes[group.NodeGroupID] = nodes
+	}
+
+	return nil
+}
+
+func (ua *UpgradeAction) createUpgradeClusterTask() error {
+	validateMgr, err := cloudprovider.GetCloudValidateMgr(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get Cluster %s real CloudProvider %s validateManager failed, %s",
+			ua.req.ClusterID, ua.cloud.CloudProvider, err.Error())
+		ua.setResp(common.BcsErrClusterManagerCommonErr, err.Error())
+		return err
+	}
+	err = validateMgr.UpgradeClusterValidate(ua.cluster, ua.req, ua.cmOptions)
+	if err != nil {
+		blog.Errorf("upgrade Cluster %s validate failed, %s", ua.req.ClusterID, err.Error())
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return err
+	}
+
+	clsMgr, err := cloudprovider.GetClusterMgr(ua.cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get Cluster %s real CloudProvider %s manager failed, %s",
+			ua.req.ClusterID, ua.cloud.CloudProvider, err.Error())
+		ua.setResp(common.BcsErrClusterManagerCommonErr, err.Error())
+		return err
+	}
+
+	// call cloud provider api to upgrade cluster by async tasks
+	task, err := clsMgr.UpgradeCluster(ua.cluster, &cloudprovider.UpgradeClusterOption{
+		CommonOption:   *ua.cmOptions,
+		Operator:       ua.req.Operator,
+		Version:        ua.req.Version,
+		Cloud:          ua.cloud,
+		NodeGroups:     ua.nodeGroups,
+		NodeGroupNodes: ua.nodeGroupNodes,
+		DrainTimeout:   ua.req.DrainTimeout,
+	})
+	if err != nil {
+		blog.Errorf("upgrade Cluster %s by cloudprovider %s failed, %s",
+			ua.cluster.ClusterID, ua.cloud.CloudID, err.Error(),
+		)
+		ua.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return err
+	}
+
+	// update cluster status: upgrading, rollback status when task dispatch failed
+	originStatus := ua.cluster.Status
+	ua.cluster.Status = common.StatusUpgrading
+	if err = ua.model.UpdateCluster(ua.ctx, ua.cluster); err != nil {
+		blog.Errorf("update Cluster %s to status UPGRADING failed, %s", ua.req.ClusterID, err.Error())
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return err
+	}
+
+	// create task and dispatch task
+	if err = ua.model.CreateTask(ua.ctx, task); err != nil {
+		blog.Errorf("save upgrade cluster task for cluster %s failed, %s",
+			ua.cluster.ClusterName, err.Error(),
+		)
+		ua.rollbackClusterStatus(originStatus)
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return err
+	}
+	if err = taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch upgrade cluster task for cluster %s failed, %s",
+			ua.cluster.ClusterName, err.Error(),
+		)
+		ua.rollbackClusterStatus(originStatus)
+		ua.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return err
+	}
+
+	ua.task = task
+	return nil
+}
+
+// rollbackClusterStatus rollback cluster status when upgrade task not dispatched
+func (ua *UpgradeAction) rollbackClusterStatus(status string) {
+	ua.cluster.Status = status
+	if err := ua.model.UpdateCluster(ua.ctx, ua.cluster); err != nil {
+		blog.Errorf("rollback Cluster %s status to %s failed, %s", ua.req.ClusterID, status, err.Error())
+	}
+}
+
+// Handle upgrade cluster request
+func (ua *UpgradeAction) Handle(ctx context.Context, req *cmproto.UpgradeClusterReq,
+	resp *cmproto.UpgradeClusterResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("upgrade cluster failed, req or resp is empty")
+		return
+	}
+	ua.ctx = ctx
+	ua.req = req
+	ua.resp = resp
+
+	if err := ua.validate(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	if err := ua.getCluster(); err != nil {
+		if errors.Is(err, drivers.ErrTableRecordNotFound) {
+			ua.setResp(common.BcsErrClusterManagerDatabaseRecordNotFound, err.Error())
+			return
+		}
+		ua.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	if err := ua.getNodeGroupsAndNodes(); err != nil {
+		ua.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	if err := ua.getCloudInfo(); err != nil {
+		return
+	}
+
+	// create upgrade cluster task
+	if err := ua.createUpgradeClusterTask(); err != nil {
+		return
+	}
+	blog.Infof("upgrade cluster[%s] to version[%s] task cloud[%s] provider[%s] successfully",
+		ua.cluster.ClusterID, req.Version, ua.cloud.CloudID, ua.cloud.CloudProvider)
+
+	// build operation log
+	err := ua.model.CreateOperationLog(ua.ctx, &cmproto.OperationLog{
+		ResourceType: common.Cluster.String(),
+		ResourceID:   ua.cluster.ClusterID,
+		TaskID:       ua.task.TaskID,
+		Message:      fmt.Sprintf("升级%s集群%s版本至%s", ua.cluster.Provider, ua.cluster.ClusterID, req.Version),
+		OpUser:       req.Operator,
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    ua.cluster.ClusterID,
+		ProjectID:    ua.cluster.ProjectID,
+		ResourceName: ua.cluster.GetClusterName(),
+	})
+	if err != nil {
+		blog.Errorf("upgrade cluster[%s] CreateOperationLog failed: %v", ua.cluster.ClusterID, err)
+	}
+
+	ua.resp.Data = ua.cluster
+	ua.resp.Task = ua.task
+	ua.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
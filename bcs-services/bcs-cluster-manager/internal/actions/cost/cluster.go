@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cost
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+)
+
+// GetClusterCostAction action for get cluster cost
+type GetClusterCostAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.GetClusterCostReq
+	resp  *cmproto.GetClusterCostResp
+	cost  *cmproto.ClusterCost
+}
+
+// NewGetClusterCostAction create action for get cluster cost
+func NewGetClusterCostAction(model store.ClusterManagerModel) *GetClusterCostAction {
+	return &GetClusterCostAction{
+		model: model,
+	}
+}
+
+func (ga *GetClusterCostAction) validate() error {
+	if err := ga.req.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ga *GetClusterCostAction) setResp(code uint32, msg string) {
+	ga.resp.Code = code
+	ga.resp.Message = msg
+	ga.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ga.resp.Data = ga.cost
+}
+
+// Handle get cluster cost request
+func (ga *GetClusterCostAction) Handle(ctx context.Context,
+	req *cmproto.GetClusterCostReq, resp *cmproto.GetClusterCostResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("get cluster cost failed, req or resp is empty")
+		return
+	}
+	ga.ctx = ctx
+	ga.req = req
+	ga.resp = resp
+
+	if err := ga.validate(); err != nil {
+		ga.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	cls, err := ga.model.GetCluster(ga.ctx, req.ClusterID)
+	if err != nil {
+		blog.Errorf("get cluster %s failed when get cluster cost, %s", req.ClusterID, err.Error())
+		ga.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	ga.cost, err = newCostCalculator(ga.ctx, ga.model).clusterCost(cls, req.NodeGroupID)
+	if err != nil {
+		blog.Errorf("calculate cluster %s cost failed, %s", req.ClusterID, err.Error())
+		ga.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	ga.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
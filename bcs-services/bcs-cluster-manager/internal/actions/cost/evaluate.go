@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cost
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+)
+
+// EvaluateNodeGroupCostAction action for evaluating nodeGroup cost change before applying it
+type EvaluateNodeGroupCostAction struct {
+	ctx        context.Context
+	model      store.ClusterManagerModel
+	req        *cmproto.EvaluateNodeGroupCostReq
+	resp       *cmproto.EvaluateNodeGroupCostResp
+	cluster    *cmproto.Cluster
+	group      *cmproto.NodeGroup
+	evaluation *cmproto.NodeGroupCostEvaluation
+}
+
+// NewEvaluateNodeGroupCostAction create action for evaluating nodeGroup cost
+func NewEvaluateNodeGroupCostAction(model store.ClusterManagerModel) *EvaluateNodeGroupCostAction {
+	return &EvaluateNodeGroupCostAction{
+		model: model,
+	}
+}
+
+func (ea *EvaluateNodeGroupCostAction) validate() error {
+	if err := ea.req.Validate(); err != nil {
+		return err
+	}
+	if ea.req.MinSize == nil && ea.req.MaxSize == nil && ea.req.InstanceType == "" {
+		return fmt.Errorf("minSize, maxSize and instanceType are all empty")
+	}
+
+	return nil
+}
+
+func (ea *EvaluateNodeGroupCostAction) setResp(code uint32, msg string) {
+	ea.resp.Code = code
+	ea.resp.Message = msg
+	ea.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ea.resp.Data = ea.evaluation
+}
+
+func (ea *EvaluateNodeGroupCostAction) getRelativeData() error {
+	group, err := ea.model.GetNodeGroup(ea.ctx, ea.req.NodeGroupID)
+	if err != nil {
+		return err
+	}
+	ea.group = group
+
+	cluster, err := ea.model.GetCluster(ea.ctx, group.ClusterID)
+	if err != nil {
+		return err
+	}
+	ea.cluster = cluster
+
+	return nil
+}
+
+// proposedSize apply request changes to nodeGroup size, desired size is kept in [min, max]
+func (ea *EvaluateNodeGroupCostAction) proposedSize(current nodeGroupSize) (nodeGroupSize, []string) {
+	var (
+		proposed = current
+		warnings = make([]string, 0)
+	)
+	if ea.req.InstanceType != "" {
+		proposed.instanceType = ea.req.InstanceType
+	}
+	if ea.req.MinSize != nil {
+		proposed.min = ea.req.MinSize.GetValue()
+	}
+	if ea.req.MaxSize != nil {
+		proposed.max = ea.req.MaxSize.GetValue()
+	}
+	if proposed.min > proposed.max {
+		warnings = append(warnings, fmt.Sprintf("minSize %d is greater than maxSize %d", proposed.min, proposed.max))
+	}
+
+	if proposed.desired < proposed.min {
+		proposed.desired = proposed.min
+	}
+	if proposed.max > 0 && proposed.desired > proposed.max {
+		proposed.desired = proposed.max
+	}
+	if proposed.desired != current.desired {
+		warnings = append(warnings, fmt.Sprintf("desiredSize will change from %d to %d",
+			current.desired, proposed.desired))
+	}
+	if proposed.max > 0 && current.current > proposed.max {
+		warnings = append(warnings, fmt.Sprintf("current nodes %d exceed maxSize %d, nodes need to be cleaned",
+			current.current, proposed.max))
+	}
+	if proposed.instanceType != current.instanceType && current.current > 0 {
+		warnings = append(warnings, fmt.Sprintf("instanceType only applies to new nodes, existing %d nodes "+
+			"keep their instanceType until rollout", current.current))
+	}
+
+	return proposed, warnings
+}
+
+func (ea *EvaluateNodeGroupCostAction) evaluate() error {
+	groupNodes, err := countNodeGroupNodes(ea.ctx, ea.model, ea.group.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	calculator := newCostCalculator(ea.ctx, ea.model)
+	currentSize := getNodeGroupSize(ea.group, groupNodes[ea.group.NodeGroupID])
+	proposedSize, warnings := ea.proposedSize(currentSize)
+
+	current := calculator.nodeGroupCost(ea.cluster, ea.group, currentSize)
+	proposed := calculator.nodeGroupCost(ea.cluster, ea.group, proposedSize)
+	if proposed.Message != "" {
+		warnings = append(warnings, proposed.Message)
+	}
+
+	ea.evaluation = &cmproto.NodeGroupCostEvaluation{
+		Current:                  current,
+		Proposed:                 proposed,
+		CurrentMonthlyCostDiff:   roundCost(proposed.CurrentMonthlyCost - current.CurrentMonthlyCost),
+		ProjectedMonthlyCostDiff: roundCost(proposed.ProjectedMonthlyCost - current.ProjectedMonthlyCost),
+		MinMonthlyCostDiff:       roundCost(proposed.MinMonthlyCost - current.MinMonthlyCost),
+		MaxMonthlyCostDiff:       roundCost(proposed.MaxMonthlyCost - current.MaxMonthlyCost),
+		Warnings:                 warnings,
+	}
+
+	return nil
+}
+
+// Handle evaluate nodeGroup cost request
+func (ea *EvaluateNodeGroupCostAction) Handle(ctx context.Context,
+	req *cmproto.EvaluateNodeGroupCostReq, resp *cmproto.EvaluateNodeGroupCostResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("evaluate nodeGroup cost failed, req or resp is empty")
+		return
+	}
+	ea.ctx = ctx
+	ea.req = req
+	ea.resp = resp
+
+	if err := ea.validate(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ea.getRelativeData(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ea.evaluate(); err != nil {
+		blog.Errorf("evaluate nodeGroup %s cost failed, %s", req.NodeGroupID, err.Error())
+		ea.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	ea.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,181 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cost
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+const costTestProvider = "costTest"
+
+// costModel store model only implements methods used by cost evaluation
+type costModel struct {
+	store.ClusterManagerModel
+	group *cmproto.NodeGroup
+	nodes []*cmproto.Node
+}
+
+func (m *costModel) GetNodeGroup(ctx context.Context, groupID string) (*cmproto.NodeGroup, error) {
+	return m.group, nil
+}
+
+func (m *costModel) GetCluster(ctx context.Context, clusterID string) (*cmproto.Cluster, error) {
+	return &cmproto.Cluster{ClusterID: clusterID, Provider: costTestProvider, Region: "ap-test"}, nil
+}
+
+func (m *costModel) GetCloud(ctx context.Context, cloudID string) (*cmproto.Cloud, error) {
+	return &cmproto.Cloud{CloudID: cloudID, CloudProvider: cloudID, ConfInfo: &cmproto.CloudConfigInfo{}}, nil
+}
+
+func (m *costModel) ListNode(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]*cmproto.Node, error) {
+	return m.nodes, nil
+}
+
+// priceNodeManager node manager only implements instanceType price list
+type priceNodeManager struct {
+	cloudprovider.NodeManager
+	prices map[string]float32
+}
+
+func (m *priceNodeManager) ListNodeInstanceType(info cloudprovider.InstanceInfo,
+	opt *cloudprovider.CommonOption) ([]*cmproto.InstanceType, error) {
+	insTypes := make([]*cmproto.InstanceType, 0, len(m.prices))
+	for t, price := range m.prices {
+		insTypes = append(insTypes, &cmproto.InstanceType{NodeType: t, UnitPrice: price})
+	}
+	return insTypes, nil
+}
+
+// accountValidateManager cloud validate manager accepts any account
+type accountValidateManager struct {
+	cloudprovider.CloudValidateManager
+}
+
+func (m *accountValidateManager) ImportCloudAccountValidate(account *cmproto.Account) error {
+	return nil
+}
+
+func TestEvaluateNodeGroupCost(t *testing.T) {
+	cloudprovider.InitCloudValidateManager(costTestProvider, &accountValidateManager{})
+	cloudprovider.InitNodeManager(costTestProvider, &priceNodeManager{prices: map[string]float32{
+		"S.SMALL":  1,
+		"S.LARGE":  2,
+		"S.XLARGE": 4,
+	}})
+
+	// nodeGroup instanceType changed from S.SMALL to S.LARGE, existing nodes are not rolled out
+	model := &costModel{
+		group: &cmproto.NodeGroup{
+			NodeGroupID:    "ng-1",
+			ClusterID:      "BCS-K8S-00001",
+			LaunchTemplate: &cmproto.LaunchConfiguration{InstanceType: "S.LARGE"},
+			AutoScaling:    &cmproto.AutoScalingGroup{DesiredSize: 3, MinSize: 1, MaxSize: 5},
+		},
+		nodes: []*cmproto.Node{
+			{NodeGroupID: "ng-1", InstanceType: "S.SMALL", Status: common.StatusRunning},
+			{NodeGroupID: "ng-1", InstanceType: "S.SMALL", Status: common.StatusRunning},
+			// node without instanceType uses nodeGroup instanceType
+			{NodeGroupID: "ng-1", Status: common.StatusRunning},
+			{NodeGroupID: "ng-1", InstanceType: "S.XLARGE", Status: common.StatusDeleting},
+			{NodeGroupID: "ng-2", InstanceType: "S.XLARGE", Status: common.StatusRunning},
+		},
+	}
+	// current: 2 S.SMALL + 1 S.LARGE = 4/h, max 5 nodes adds 2 S.LARGE = 8/h
+	wantCurrent := &cmproto.NodeGroupCost{
+		CurrentMonthlyCost: 2920, ProjectedMonthlyCost: 2920, MinMonthlyCost: 973.33, MaxMonthlyCost: 5840}
+
+	tests := []struct {
+		name         string
+		req          *cmproto.EvaluateNodeGroupCostReq
+		wantProposed *cmproto.NodeGroupCost
+		wantMessage  string
+	}{
+		{
+			name: "existing nodes keep their instanceType",
+			req:  &cmproto.EvaluateNodeGroupCostReq{InstanceType: "S.XLARGE"},
+			// max 5 nodes adds 2 S.XLARGE = 4 + 8 = 12/h
+			wantProposed: &cmproto.NodeGroupCost{UnitPrice: 4,
+				CurrentMonthlyCost: 2920, ProjectedMonthlyCost: 2920, MinMonthlyCost: 973.33, MaxMonthlyCost: 8760},
+		},
+		{
+			name: "scale out new nodes use nodeGroup instanceType",
+			req:  &cmproto.EvaluateNodeGroupCostReq{MinSize: &wrappers.UInt32Value{Value: 4}},
+			// desired 4 adds 1 S.LARGE = 6/h
+			wantProposed: &cmproto.NodeGroupCost{UnitPrice: 2,
+				CurrentMonthlyCost: 2920, ProjectedMonthlyCost: 4380, MinMonthlyCost: 4380, MaxMonthlyCost: 5840},
+		},
+		{
+			name: "scale in removed nodes priced by average",
+			req:  &cmproto.EvaluateNodeGroupCostReq{MaxSize: &wrappers.UInt32Value{Value: 2}},
+			// desired 2 keeps 2 of 3 existing nodes = 4 * 2 / 3 /h
+			wantProposed: &cmproto.NodeGroupCost{UnitPrice: 2, CurrentMonthlyCost: 2920,
+				ProjectedMonthlyCost: 1946.67, MinMonthlyCost: 973.33, MaxMonthlyCost: 1946.67},
+		},
+		{
+			name:         "instanceType price unavailable",
+			req:          &cmproto.EvaluateNodeGroupCostReq{InstanceType: "S.UNKNOWN"},
+			wantProposed: &cmproto.NodeGroupCost{},
+			wantMessage:  "instanceType S.UNKNOWN price unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.NodeGroupID = model.group.NodeGroupID
+			resp := &cmproto.EvaluateNodeGroupCostResp{}
+			NewEvaluateNodeGroupCostAction(model).Handle(context.Background(), tt.req, resp)
+			if !resp.Result {
+				t.Fatalf("evaluate failed: %s", resp.Message)
+			}
+
+			current, proposed := resp.Data.Current, resp.Data.Proposed
+			if current.CurrentNodes != 3 {
+				t.Fatalf("current nodes %d, want 3", current.CurrentNodes)
+			}
+			if !equalCost(current, wantCurrent) {
+				t.Fatalf("current cost %+v, want %+v", current, wantCurrent)
+			}
+			if proposed.Message != tt.wantMessage {
+				t.Fatalf("proposed message %q, want %q", proposed.Message, tt.wantMessage)
+			}
+			if proposed.UnitPrice != tt.wantProposed.UnitPrice || !equalCost(proposed, tt.wantProposed) {
+				t.Fatalf("proposed cost %+v, want %+v", proposed, tt.wantProposed)
+			}
+			if tt.wantMessage != "" && !strings.Contains(strings.Join(resp.Data.Warnings, ";"), tt.wantMessage) {
+				t.Fatalf("warnings %v lost message %q", resp.Data.Warnings, tt.wantMessage)
+			}
+			diff := roundCost(proposed.ProjectedMonthlyCost - current.ProjectedMonthlyCost)
+			if tt.wantMessage == "" && resp.Data.ProjectedMonthlyCostDiff != diff {
+				t.Fatalf("projected cost diff %v, want %v", resp.Data.ProjectedMonthlyCostDiff, diff)
+			}
+		})
+	}
+}
+
+func equalCost(got, want *cmproto.NodeGroupCost) bool {
+	return got.CurrentMonthlyCost == want.CurrentMonthlyCost &&
+		got.ProjectedMonthlyCost == want.ProjectedMonthlyCost &&
+		got.MinMonthlyCost == want.MinMonthlyCost &&
+		got.MaxMonthlyCost == want.MaxMonthlyCost
+}
@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cost
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// GetProjectCostAction action for get project cost
+type GetProjectCostAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.GetProjectCostReq
+	resp  *cmproto.GetProjectCostResp
+	cost  *cmproto.ProjectCost
+}
+
+// NewGetProjectCostAction create action for get project cost
+func NewGetProjectCostAction(model store.ClusterManagerModel) *GetProjectCostAction {
+	return &GetProjectCostAction{
+		model: model,
+	}
+}
+
+func (ga *GetProjectCostAction) validate() error {
+	if err := ga.req.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ga *GetProjectCostAction) setResp(code uint32, msg string) {
+	ga.resp.Code = code
+	ga.resp.Message = msg
+	ga.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ga.resp.Data = ga.cost
+}
+
+func (ga *GetProjectCostAction) calculateProjectCost() error {
+	condCluster := operator.NewLeafCondition(operator.Eq, operator.M{"projectid": ga.req.ProjectID})
+	condStatus := operator.NewLeafCondition(operator.Ne, operator.M{"status": common.StatusDeleted})
+	clusterList, err := ga.model.ListCluster(ga.ctx, operator.NewBranchCondition(operator.And,
+		condCluster, condStatus), &storeopt.ListOption{})
+	if err != nil {
+		return err
+	}
+
+	ga.cost = &cmproto.ProjectCost{
+		ProjectID: ga.req.ProjectID,
+		Clusters:  make([]*cmproto.ClusterCost, 0),
+	}
+	calculator := newCostCalculator(ga.ctx, ga.model)
+	for i := range clusterList {
+		if clusterList[i].ClusterType == common.ClusterTypeVirtual {
+			continue
+		}
+
+		clusterCost, errLocal := calculator.clusterCost(&clusterList[i], "")
+		if errLocal != nil {
+			blog.Errorf("calculate project %s cluster %s cost failed, %s",
+				ga.req.ProjectID, clusterList[i].ClusterID, errLocal.Error())
+			return errLocal
+		}
+		ga.cost.Clusters = append(ga.cost.Clusters, clusterCost)
+		ga.cost.CurrentMonthlyCost += clusterCost.CurrentMonthlyCost
+		ga.cost.ProjectedMonthlyCost += clusterCost.ProjectedMonthlyCost
+		ga.cost.MinMonthlyCost += clusterCost.MinMonthlyCost
+		ga.cost.MaxMonthlyCost += clusterCost.MaxMonthlyCost
+	}
+	ga.cost.CurrentMonthlyCost = roundCost(ga.cost.CurrentMonthlyCost)
+	ga.cost.ProjectedMonthlyCost = roundCost(ga.cost.ProjectedMonthlyCost)
+	ga.cost.MinMonthlyCost = roundCost(ga.cost.MinMonthlyCost)
+	ga.cost.MaxMonthlyCost = roundCost(ga.cost.MaxMonthlyCost)
+
+	return nil
+}
+
+// Handle get project cost request
+func (ga *GetProjectCostAction) Handle(ctx context.Context,
+	req *cmproto.GetProjectCostReq, resp *cmproto.GetProjectCostResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("get project cost failed, req or resp is empty")
+		return
+	}
+	ga.ctx = ctx
+	ga.req = req
+	ga.resp = resp
+
+	if err := ga.validate(); err != nil {
+		ga.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ga.calculateProjectCost(); err != nil {
+		ga.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	ga.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,294 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package cost xxx
+package cost
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+const (
+	// hoursPerMonth instance type unitPrice is hourly price
+	hoursPerMonth = 730
+
+	msgPriceUnavailable = "instanceType %s price unavailable"
+)
+
+// nodeGroupSize nodeGroup scale used for cost calculation
+type nodeGroupSize struct {
+	// instanceType used by new nodes
+	instanceType string
+	// nodes existing nodes count by their own instanceType
+	nodes   map[string]uint32
+	current uint32
+	desired uint32
+	min     uint32
+	max     uint32
+}
+
+// getNodeGroupSize get nodeGroup current scale, nodes without instanceType use nodeGroup instanceType
+func getNodeGroupSize(group *cmproto.NodeGroup, nodes map[string]uint32) nodeGroupSize {
+	size := nodeGroupSize{
+		instanceType: group.GetLaunchTemplate().GetInstanceType(),
+		nodes:        make(map[string]uint32, len(nodes)),
+		desired:      group.GetAutoScaling().GetDesiredSize(),
+		min:          group.GetAutoScaling().GetMinSize(),
+		max:          group.GetAutoScaling().GetMaxSize(),
+	}
+	for insType, num := range nodes {
+		if insType == "" {
+			insType = size.instanceType
+		}
+		size.nodes[insType] += num
+		size.current += num
+	}
+
+	return size
+}
+
+// hourlyCost calculate hourly cost when nodeGroup scales to num nodes. existing nodes keep their own
+// instanceType and new nodes use nodeGroup instanceType, existing nodes removed by scaling in are
+// priced by their average price.
+func (size nodeGroupSize) hourlyCost(prices map[string]float32, num uint32) float64 {
+	existing := float64(0)
+	for insType, n := range size.nodes {
+		existing += float64(prices[insType]) * float64(n)
+	}
+	if num < size.current {
+		return existing * float64(num) / float64(size.current)
+	}
+
+	return existing + float64(prices[size.instanceType])*float64(num-size.current)
+}
+
+// costCalculator calculate cost by instanceType unitPrice, unitPrice is cached by cloud/region/account
+type costCalculator struct {
+	ctx    context.Context
+	model  store.ClusterManagerModel
+	prices map[string]map[string]float32
+}
+
+func newCostCalculator(ctx context.Context, model store.ClusterManagerModel) *costCalculator {
+	return &costCalculator{
+		ctx:    ctx,
+		model:  model,
+		prices: make(map[string]map[string]float32),
+	}
+}
+
+// getUnitPrice get instanceType hourly price of cluster region
+func (c *costCalculator) getUnitPrice(cls *cmproto.Cluster, group *cmproto.NodeGroup,
+	instanceType string) (float32, error) {
+	cloudID, region := group.GetProvider(), group.GetRegion()
+	if cloudID == "" {
+		cloudID = cls.Provider
+	}
+	if region == "" {
+		region = cls.Region
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", cloudID, region, cls.CloudAccountID)
+	prices, ok := c.prices[key]
+	if !ok {
+		var err error
+		prices, err = c.listInstanceTypePrices(cls, cloudID, region)
+		if err != nil {
+			return 0, err
+		}
+		c.prices[key] = prices
+	}
+
+	return prices[instanceType], nil
+}
+
+func (c *costCalculator) listInstanceTypePrices(cls *cmproto.Cluster, cloudID, region string) (
+	map[string]float32, error) {
+	cloud, err := actions.GetCloudByCloudID(c.model, cloudID)
+	if err != nil {
+		return nil, err
+	}
+	nodeMgr, err := cloudprovider.GetNodeMgr(cloud.CloudProvider)
+	if err != nil {
+		return nil, err
+	}
+	cmOption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     cloud,
+		AccountID: cls.CloudAccountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmOption.Region = region
+
+	insTypes, err := nodeMgr.ListNodeInstanceType(cloudprovider.InstanceInfo{
+		Region: region,
+		BizID:  cls.BusinessID,
+	}, cmOption)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]float32, len(insTypes))
+	for _, t := range insTypes {
+		if t.UnitPrice > 0 {
+			prices[t.NodeType] = t.UnitPrice
+		}
+	}
+
+	return prices, nil
+}
+
+// nodeGroupCost calculate nodeGroup cost by size
+func (c *costCalculator) nodeGroupCost(cls *cmproto.Cluster, group *cmproto.NodeGroup,
+	size nodeGroupSize) *cmproto.NodeGroupCost {
+	cost := &cmproto.NodeGroupCost{
+		NodeGroupID:  group.NodeGroupID,
+		Name:         group.Name,
+		InstanceType: size.instanceType,
+		CurrentNodes: size.current,
+		DesiredSize:  size.desired,
+		MinSize:      size.min,
+		MaxSize:      size.max,
+	}
+
+	// price new nodes and every instanceType of existing nodes
+	insTypes := []string{size.instanceType}
+	for insType := range size.nodes {
+		if insType != size.instanceType {
+			insTypes = append(insTypes, insType)
+		}
+	}
+	sort.Strings(insTypes[1:])
+
+	prices := make(map[string]float32, len(insTypes))
+	unavailable := make([]string, 0)
+	for _, insType := range insTypes {
+		price, err := c.getUnitPrice(cls, group, insType)
+		if err != nil {
+			blog.Errorf("nodeGroupCost[%s] get instanceType %s price failed: %v",
+				group.NodeGroupID, insType, err)
+			cost.Message = err.Error()
+			return cost
+		}
+		if price == 0 {
+			unavailable = append(unavailable, insType)
+			continue
+		}
+		prices[insType] = price
+	}
+	if len(unavailable) > 0 {
+		cost.Message = fmt.Sprintf(msgPriceUnavailable, strings.Join(unavailable, ","))
+		return cost
+	}
+
+	cost.UnitPrice = prices[size.instanceType]
+	cost.CurrentMonthlyCost = monthlyCost(size.hourlyCost(prices, size.current))
+	cost.ProjectedMonthlyCost = monthlyCost(size.hourlyCost(prices, size.desired))
+	cost.MinMonthlyCost = monthlyCost(size.hourlyCost(prices, size.min))
+	cost.MaxMonthlyCost = monthlyCost(size.hourlyCost(prices, size.max))
+
+	return cost
+}
+
+// clusterCost calculate cluster cost, only calculate specified nodeGroup when groupID not empty
+func (c *costCalculator) clusterCost(cls *cmproto.Cluster, groupID string) (*cmproto.ClusterCost, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": cls.ClusterID})
+	groups, err := c.model.ListNodeGroup(c.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return nil, err
+	}
+	groupNodes, err := countNodeGroupNodes(c.ctx, c.model, cls.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := &cmproto.ClusterCost{
+		ClusterID:   cls.ClusterID,
+		ClusterName: cls.ClusterName,
+		ProjectID:   cls.ProjectID,
+		Provider:    cls.Provider,
+		Region:      cls.Region,
+		NodeGroups:  make([]*cmproto.NodeGroupCost, 0),
+	}
+	for i := range groups {
+		if groupID != "" && groups[i].NodeGroupID != groupID {
+			continue
+		}
+		if groups[i].Status == common.StatusDeleting {
+			continue
+		}
+
+		groupCost := c.nodeGroupCost(cls, &groups[i], getNodeGroupSize(&groups[i], groupNodes[groups[i].NodeGroupID]))
+		cost.NodeGroups = append(cost.NodeGroups, groupCost)
+		cost.CurrentMonthlyCost += groupCost.CurrentMonthlyCost
+		cost.ProjectedMonthlyCost += groupCost.ProjectedMonthlyCost
+		cost.MinMonthlyCost += groupCost.MinMonthlyCost
+		cost.MaxMonthlyCost += groupCost.MaxMonthlyCost
+	}
+	if groupID != "" && len(cost.NodeGroups) == 0 {
+		return nil, fmt.Errorf("nodeGroup %s not found in cluster %s", groupID, cls.ClusterID)
+	}
+
+	cost.CurrentMonthlyCost = roundCost(cost.CurrentMonthlyCost)
+	cost.ProjectedMonthlyCost = roundCost(cost.ProjectedMonthlyCost)
+	cost.MinMonthlyCost = roundCost(cost.MinMonthlyCost)
+	cost.MaxMonthlyCost = roundCost(cost.MaxMonthlyCost)
+
+	return cost, nil
+}
+
+// countNodeGroupNodes count cluster nodes which are not deleting by nodeGroup and instanceType
+func countNodeGroupNodes(ctx context.Context, model store.ClusterManagerModel,
+	clusterID string) (map[string]map[string]uint32, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": clusterID})
+	nodes, err := model.ListNode(ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return nil, err
+	}
+
+	groupNodes := make(map[string]map[string]uint32)
+	for i := range nodes {
+		if nodes[i].NodeGroupID == "" || nodes[i].Status == common.StatusDeleting {
+			continue
+		}
+		if groupNodes[nodes[i].NodeGroupID] == nil {
+			groupNodes[nodes[i].NodeGroupID] = make(map[string]uint32)
+		}
+		groupNodes[nodes[i].NodeGroupID][nodes[i].InstanceType]++
+	}
+
+	return groupNodes, nil
+}
+
+// monthlyCost calculate monthly cost by hourly cost
+func monthlyCost(hourly float64) float64 {
+	return roundCost(hourly * hoursPerMonth)
+}
+
+// roundCost round cost to cent
+func roundCost(cost float64) float64 {
+	return math.Round(cost*100) / 100
+}
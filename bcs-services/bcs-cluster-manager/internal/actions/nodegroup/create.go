@@ -81,25 +81,26 @@ func (ca *CreateAction) getRelativeResource() error {
 func (ca *CreateAction) constructNodeGroup() *cmproto.NodeGroup {
 	timeStr := time.Now().Format(time.RFC3339)
 	group := &cmproto.NodeGroup{
-		NodeGroupID:     autils.GenerateTemplateID(autils.GroupTemplate),
-		Name:            ca.req.Name,
-		ClusterID:       ca.req.ClusterID,
-		Region:          ca.req.Region,
-		ProjectID:       ca.cluster.ProjectID,
-		EnableAutoscale: ca.req.EnableAutoscale,
-		AutoScaling:     ca.req.AutoScaling,
-		LaunchTemplate:  ca.req.LaunchTemplate,
-		NodeTemplate:    ca.req.NodeTemplate,
-		Labels:          ca.req.Labels,
-		Taints:          ca.req.Taints,
-		Tags:            ca.req.Tags,
-		NodeOS:          ca.req.NodeOS,
-		Provider:        ca.req.Provider,
-		Status:          common.StatusCreateNodeGroupCreating,
-		ConsumerID:      ca.req.ConsumerID,
-		Creator:         ca.req.Creator,
-		CreateTime:      timeStr,
-		UpdateTime:      timeStr,
+		NodeGroupID:      autils.GenerateTemplateID(autils.GroupTemplate),
+		Name:             ca.req.Name,
+		ClusterID:        ca.req.ClusterID,
+		Region:           ca.req.Region,
+		ProjectID:        ca.cluster.ProjectID,
+		EnableAutoscale:  ca.req.EnableAutoscale,
+		AutoScaling:      ca.req.AutoScaling,
+		LaunchTemplate:   ca.req.LaunchTemplate,
+		NodeTemplate:     ca.req.NodeTemplate,
+		Labels:           ca.req.Labels,
+		Taints:           ca.req.Taints,
+		Tags:             ca.req.Tags,
+		NodeOS:           ca.req.NodeOS,
+		Provider:         ca.req.Provider,
+		Status:           common.StatusCreateNodeGroupCreating,
+		ConsumerID:       ca.req.ConsumerID,
+		ScalingSchedules: ca.req.ScalingSchedules,
+		Creator:          ca.req.Creator,
+		CreateTime:       timeStr,
+		UpdateTime:       timeStr,
 		// Area: &cmproto.CloudArea{
 		//	BkCloudID:   ca.req.BkCloudID,
 		//	BkCloudName: ca.req.CloudAreaName,
@@ -180,6 +181,9 @@ func (ca *CreateAction) validate() error {
 	if ca.req.NodeTemplate == nil {
 		return fmt.Errorf("nodeTemplate is empty")
 	}
+	if err := validateScalingSchedules(ca.req.ScalingSchedules); err != nil {
+		return err
+	}
 	// not external nodeGroup check launchTemplate
 	/*
 		if ca.req.NodeGroupType != common.External.String() {
@@ -0,0 +1,252 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/utils"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	provider "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/taskserver"
+)
+
+// RolloutAction action for rollout nodeGroup nodes
+type RolloutAction struct {
+	ctx     context.Context
+	model   store.ClusterManagerModel
+	locker  lock.DistributedLock
+	group   *cmproto.NodeGroup
+	cluster *cmproto.Cluster
+	cloud   *cmproto.Cloud
+	task    *cmproto.Task
+	nodeIPs []string
+	req     *cmproto.RolloutNodeGroupRequest
+	resp    *cmproto.RolloutNodeGroupResponse
+}
+
+// NewRolloutAction create rollout action for nodeGroup
+func NewRolloutAction(model store.ClusterManagerModel, locker lock.DistributedLock) *RolloutAction {
+	return &RolloutAction{
+		model:  model,
+		locker: locker,
+	}
+}
+
+func (ra *RolloutAction) setResp(code uint32, msg string) {
+	ra.resp.Code = code
+	ra.resp.Message = msg
+	ra.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	ra.resp.Data = ra.task
+}
+
+func (ra *RolloutAction) validate() error {
+	if err := ra.req.Validate(); err != nil {
+		return err
+	}
+	if ra.req.MaxSurge+ra.req.MaxUnavailable == 0 {
+		return fmt.Errorf("maxSurge and maxUnavailable can't be both zero")
+	}
+
+	group, err := ra.model.GetNodeGroup(ra.ctx, ra.req.NodeGroupID)
+	if err != nil {
+		return err
+	}
+	if group.ClusterID != ra.req.ClusterID {
+		return fmt.Errorf("nodeGroup %s not belong to cluster %s", group.NodeGroupID, ra.req.ClusterID)
+	}
+	if group.Status != common.StatusRunning {
+		return fmt.Errorf("nodeGroup %s status %s is not running", group.NodeGroupID, group.Status)
+	}
+	if cloudprovider.IsExternalNodePool(group) {
+		return fmt.Errorf("external nodeGroup %s not support rollout", group.NodeGroupID)
+	}
+	ra.group = group
+
+	return nil
+}
+
+// getRolloutNodes get nodes need to replace, nodes must be running nodes of nodeGroup
+func (ra *RolloutAction) getRolloutNodes() error {
+	condM := make(operator.M)
+	condM["nodegroupid"] = ra.group.NodeGroupID
+	condM["clusterid"] = ra.group.ClusterID
+	cond := operator.NewLeafCondition(operator.Eq, condM)
+	nodes, err := ra.model.ListNode(ra.ctx, cond, &options.ListOption{})
+	if err != nil {
+		return err
+	}
+
+	var (
+		total        uint32
+		runningNodes = make(map[string]struct{})
+		runningIPs   = make([]string, 0)
+	)
+	for i := range nodes {
+		if nodes[i].Status == common.StatusDeleting {
+			continue
+		}
+		total++
+		if nodes[i].Status == common.StatusRunning {
+			runningNodes[nodes[i].InnerIP] = struct{}{}
+			runningIPs = append(runningIPs, nodes[i].InnerIP)
+		}
+	}
+
+	// surge nodes must not exceed nodeGroup maxSize
+	if ra.group.AutoScaling != nil && ra.group.AutoScaling.MaxSize > 0 &&
+		total+ra.req.MaxSurge > ra.group.AutoScaling.MaxSize {
+		return fmt.Errorf("nodeGroup nodes %d with maxSurge %d exceed maxSize %d",
+			total, ra.req.MaxSurge, ra.group.AutoScaling.MaxSize)
+	}
+
+	if len(ra.req.Nodes) == 0 {
+		ra.nodeIPs = runningIPs
+	} else {
+		for _, ip := range ra.req.Nodes {
+			if _, ok := runningNodes[ip]; !ok {
+				return fmt.Errorf("node %s is not running node of nodeGroup %s", ip, ra.group.NodeGroupID)
+			}
+		}
+		ra.nodeIPs = ra.req.Nodes
+	}
+	if len(ra.nodeIPs) == 0 {
+		return fmt.Errorf("nodeGroup %s has no running nodes to rollout", ra.group.NodeGroupID)
+	}
+
+	return nil
+}
+
+// checkRolloutRunning nodeGroup only allows one rollout task at the same time, otherwise batches of
+// different tasks surge and clean the same nodes
+func (ra *RolloutAction) checkRolloutRunning() error {
+	cond := operator.NewBranchCondition(operator.And,
+		operator.NewLeafCondition(operator.Eq, operator.M{
+			"clusterid":   ra.group.ClusterID,
+			"nodegroupid": ra.group.NodeGroupID,
+			"tasktype":    cloudprovider.GetTaskType(ra.cloud.CloudProvider, cloudprovider.RolloutNodeGroup),
+		}),
+		operator.NewLeafCondition(operator.In, operator.M{
+			"status": []string{cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning,
+				cloudprovider.TaskStatusNotStarted},
+		}))
+	tasks, err := ra.model.ListTask(ra.ctx, cond, &options.ListOption{})
+	if err != nil {
+		return err
+	}
+	if len(tasks) > 0 {
+		return fmt.Errorf("nodeGroup %s rollout task %s is %s", ra.group.NodeGroupID, tasks[0].TaskID,
+			tasks[0].Status)
+	}
+
+	return nil
+}
+
+func (ra *RolloutAction) handleTask() error {
+	task, err := provider.BuildRolloutNodeGroupTask(ra.group, ra.nodeIPs, &cloudprovider.RolloutNodeGroupOption{
+		Cluster:        ra.cluster,
+		Cloud:          ra.cloud,
+		MaxSurge:       ra.req.MaxSurge,
+		MaxUnavailable: ra.req.MaxUnavailable,
+		DrainTimeout:   ra.req.DrainTimeout,
+		Operator:       ra.req.Operator,
+	})
+	if err != nil {
+		blog.Errorf("build rollout task for NodeGroup %s failed, %s", ra.group.NodeGroupID, err.Error())
+		return err
+	}
+	if err = ra.model.CreateTask(ra.ctx, task); err != nil {
+		blog.Errorf("save rollout task for NodeGroup %s failed, %s", ra.group.NodeGroupID, err.Error())
+		return err
+	}
+	if err = taskserver.GetTaskServer().Dispatch(task); err != nil {
+		blog.Errorf("dispatch rollout task for NodeGroup %s failed, %s", ra.group.NodeGroupID, err.Error())
+		return err
+	}
+	ra.task = task
+
+	return nil
+}
+
+// Handle handle rollout nodeGroup nodes
+func (ra *RolloutAction) Handle(
+	ctx context.Context, req *cmproto.RolloutNodeGroupRequest, resp *cmproto.RolloutNodeGroupResponse) {
+	if req == nil || resp == nil {
+		blog.Errorf("rollout NodeGroup failed, req or resp is empty")
+		return
+	}
+	ra.ctx = ctx
+	ra.req = req
+	ra.resp = resp
+
+	if err := ra.validate(); err != nil {
+		ra.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	cloud, cluster, err := actions.GetCloudAndCluster(ra.model, ra.group.Provider, ra.group.ClusterID)
+	if err != nil {
+		blog.Errorf("get cloud %s and cluster %s when rollout NodeGroup %s failed, %s",
+			ra.group.Provider, ra.group.ClusterID, ra.group.NodeGroupID, err.Error())
+		ra.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	ra.cloud, ra.cluster = cloud, cluster
+
+	rolloutLockKey := fmt.Sprintf("/bcs-services/bcs-cluster-manager/RolloutAction/%s", ra.group.NodeGroupID)
+	ra.locker.Lock(rolloutLockKey, []lock.LockOption{lock.LockTTL(time.Second * 5)}...) // nolint
+	defer ra.locker.Unlock(rolloutLockKey)                                              // nolint
+
+	if err = ra.checkRolloutRunning(); err != nil {
+		ra.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+	if err = ra.getRolloutNodes(); err != nil {
+		ra.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err = ra.handleTask(); err != nil {
+		ra.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	err = ra.model.CreateOperationLog(ra.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroup.String(),
+		ResourceID:   ra.group.NodeGroupID,
+		TaskID:       ra.task.TaskID,
+		Message: fmt.Sprintf("集群%s滚动更新节点池%s节点%v", ra.group.ClusterID, ra.group.NodeGroupID,
+			ra.nodeIPs),
+		OpUser:       req.Operator,
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    ra.cluster.ClusterID,
+		ProjectID:    ra.cluster.ProjectID,
+		ResourceName: ra.group.GetName(),
+	})
+	if err != nil {
+		blog.Errorf("RolloutNodeGroup[%s] CreateOperationLog failed: %v", ra.group.NodeGroupID, err)
+	}
+
+	utils.HandleTaskStepData(ra.ctx, ra.task)
+	blog.Infof("rollout NodeGroup %s nodes %v task %s created", ra.group.NodeGroupID, ra.nodeIPs, ra.task.TaskID)
+	ra.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
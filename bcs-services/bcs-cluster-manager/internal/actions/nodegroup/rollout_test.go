@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nodegroup
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// rolloutModel store model only implements methods used before rollout task created
+type rolloutModel struct {
+	store.ClusterManagerModel
+	tasks []cmproto.Task
+}
+
+func (m *rolloutModel) GetNodeGroup(ctx context.Context, groupID string) (*cmproto.NodeGroup, error) {
+	return &cmproto.NodeGroup{
+		NodeGroupID: groupID,
+		ClusterID:   "BCS-K8S-00001",
+		Provider:    "tencentCloud",
+		Status:      common.StatusRunning,
+	}, nil
+}
+
+func (m *rolloutModel) GetCluster(ctx context.Context, clusterID string) (*cmproto.Cluster, error) {
+	return &cmproto.Cluster{ClusterID: clusterID, Provider: "tencentCloud"}, nil
+}
+
+func (m *rolloutModel) GetCloud(ctx context.Context, cloudID string) (*cmproto.Cloud, error) {
+	return &cmproto.Cloud{CloudID: cloudID, CloudProvider: "qcloud"}, nil
+}
+
+func (m *rolloutModel) ListTask(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]cmproto.Task, error) {
+	return m.tasks, nil
+}
+
+// fakeLocker record locked keys
+type fakeLocker struct {
+	mu       sync.Mutex
+	locked   []string
+	unlocked []string
+}
+
+func (l *fakeLocker) Lock(id string, opts ...lock.LockOption) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.locked = append(l.locked, id)
+	return nil
+}
+
+func (l *fakeLocker) Unlock(id string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.unlocked = append(l.unlocked, id)
+	return nil
+}
+
+func TestRolloutRunningGuard(t *testing.T) {
+	model := &rolloutModel{
+		tasks: []cmproto.Task{{
+			TaskID:      "task-1",
+			NodeGroupID: "BCS-ng-001",
+			TaskType:    cloudprovider.GetTaskType("qcloud", cloudprovider.RolloutNodeGroup),
+			Status:      cloudprovider.TaskStatusRunning,
+		}},
+	}
+	locker := &fakeLocker{}
+	req := &cmproto.RolloutNodeGroupRequest{
+		ClusterID:   "BCS-K8S-00001",
+		NodeGroupID: "BCS-ng-001",
+		MaxSurge:    1,
+		Operator:    "admin",
+	}
+	resp := &cmproto.RolloutNodeGroupResponse{}
+	NewRolloutAction(model, locker).Handle(context.Background(), req, resp)
+
+	if resp.Code != common.BcsErrClusterManagerTaskErr || !strings.Contains(resp.Message, "task-1") {
+		t.Fatalf("rollout should be rejected when nodeGroup rollout task running, resp %d %s",
+			resp.Code, resp.Message)
+	}
+	lockKey := "/bcs-services/bcs-cluster-manager/RolloutAction/BCS-ng-001"
+	if len(locker.locked) != 1 || locker.locked[0] != lockKey ||
+		len(locker.unlocked) != 1 || locker.unlocked[0] != lockKey {
+		t.Fatalf("rollout should lock nodeGroup, locked %v unlocked %v", locker.locked, locker.unlocked)
+	}
+}
+
+func TestCheckRolloutRunning(t *testing.T) {
+	ra := NewRolloutAction(&rolloutModel{}, &fakeLocker{})
+	ra.ctx = context.Background()
+	ra.group = &cmproto.NodeGroup{NodeGroupID: "BCS-ng-001", ClusterID: "BCS-K8S-00001"}
+	ra.cloud = &cmproto.Cloud{CloudProvider: "qcloud"}
+	if err := ra.checkRolloutRunning(); err != nil {
+		t.Fatalf("no rollout task running, err %v", err)
+	}
+}
@@ -73,6 +73,11 @@ func (ua *UpdateAction) validate() error {
 		return fmt.Errorf("updateAction AutoScaling MinSize(%v) lt MaxSize(%v)",
 			ua.req.AutoScaling.MinSize, ua.req.AutoScaling.MaxSize)
 	}
+	if ua.req.ScalingSchedules != nil {
+		if err := validateScalingSchedules(ua.req.ScalingSchedules.Schedules); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -105,6 +110,9 @@ func (ua *UpdateAction) modifyNodeGroupField() {
 	if len(ua.req.GetExtraInfo()) > 0 {
 		group.ExtraInfo = ua.req.GetExtraInfo()
 	}
+	if ua.req.ScalingSchedules != nil {
+		group.ScalingSchedules = mergeScalingSchedules(group.ScalingSchedules, ua.req.ScalingSchedules.Schedules)
+	}
 
 	// autoscaling
 	ua.modifyNodeGroupAutoScaling(group)
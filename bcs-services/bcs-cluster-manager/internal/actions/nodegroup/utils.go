@@ -14,6 +14,7 @@ package nodegroup
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
 
@@ -71,3 +72,47 @@ func listNodeGroupByConds(model store.ClusterManagerModel, options filterNodeGro
 func virtualNodeID() string {
 	return "bcs-" + utils.RandomHexString(8)
 }
+
+// validateScalingSchedules check node group scaling schedules
+func validateScalingSchedules(schedules []*cmproto.ScalingSchedule) error {
+	names := make(map[string]struct{}, len(schedules))
+	for _, schedule := range schedules {
+		if schedule == nil {
+			return fmt.Errorf("scalingSchedule is empty")
+		}
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[schedule.Name]; ok {
+			return fmt.Errorf("scalingSchedule name %s duplicated", schedule.Name)
+		}
+		names[schedule.Name] = struct{}{}
+
+		if _, err := utils.ParseCronSchedule(schedule.Cron, schedule.TimeZone); err != nil {
+			return fmt.Errorf("scalingSchedule %s: %v", schedule.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// mergeScalingSchedules keep running status of existed schedules which are not changed
+func mergeScalingSchedules(old, schedules []*cmproto.ScalingSchedule) []*cmproto.ScalingSchedule {
+	existed := make(map[string]*cmproto.ScalingSchedule, len(old))
+	for _, schedule := range old {
+		existed[schedule.GetName()] = schedule
+	}
+
+	for _, schedule := range schedules {
+		o, ok := existed[schedule.Name]
+		if !ok || o.Cron != schedule.Cron || o.TimeZone != schedule.TimeZone {
+			continue
+		}
+		schedule.LastRunTime = o.LastRunTime
+		schedule.LastRunResult = o.LastRunResult
+		schedule.LastTaskID = o.LastTaskID
+		schedule.Message = o.Message
+	}
+
+	return schedules
+}
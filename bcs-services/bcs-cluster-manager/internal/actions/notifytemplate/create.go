@@ -62,6 +62,7 @@ func (ca *CreateAction) createNotifyTemplate() error {
 		UpdateNodeGroup:   ca.req.UpdateNodeGroup,
 		GroupScaleOutNode: ca.req.GroupScaleOutNode,
 		GroupScaleInNode:  ca.req.GroupScaleInNode,
+		ClusterDrift:      ca.req.ClusterDrift,
 		Receivers:         ca.req.Receivers,
 		Creator:           ca.req.Creator,
 		Updater:           ca.req.Creator,
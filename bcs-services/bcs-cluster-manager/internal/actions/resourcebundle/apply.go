@@ -0,0 +1,661 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcebundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	pb "github.com/golang/protobuf/proto"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	asoptionac "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/autoscalingoption"
+	clusterac "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/cluster"
+	nodegroupac "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodegroup"
+	nodetemplateac "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodetemplate"
+	notifytemplateac "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/notifytemplate"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/auth"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// ApplyAction action for apply project resources bundle
+type ApplyAction struct {
+	ctx     context.Context
+	model   store.ClusterManagerModel
+	locker  lock.DistributedLock
+	req     *cmproto.ApplyProjectResourcesReq
+	resp    *cmproto.ApplyProjectResourcesResp
+	res     *resources
+	results []*cmproto.ApplyResourceResult
+	// checkPerm check user permission of cluster-manager method on resource
+	checkPerm func(ctx context.Context, method, projectID, clusterID string) error
+}
+
+// NewApplyAction create action for apply project resources bundle
+func NewApplyAction(model store.ClusterManagerModel, locker lock.DistributedLock) *ApplyAction {
+	return &ApplyAction{
+		model:     model,
+		locker:    locker,
+		results:   make([]*cmproto.ApplyResourceResult, 0),
+		checkPerm: auth.CheckResourcePerm,
+	}
+}
+
+func (aa *ApplyAction) validate() error {
+	if err := aa.req.Validate(); err != nil {
+		return err
+	}
+
+	meta, res, err := decodeBundle(aa.req.Bundle)
+	if err != nil {
+		return err
+	}
+	if meta.ProjectID != aa.req.ProjectID {
+		return fmt.Errorf("bundle project %s is not request project %s", meta.ProjectID, aa.req.ProjectID)
+	}
+	aa.res = res
+
+	return nil
+}
+
+func (aa *ApplyAction) setResp(code uint32, msg string) {
+	aa.resp.Code = code
+	aa.resp.Message = msg
+	aa.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+	aa.resp.Data = aa.results
+}
+
+func (aa *ApplyAction) addResult(kind, resourceID, name, action, taskID, msg string) {
+	if action == ActionFailed {
+		blog.Errorf("apply project %s resource %s %s/%s failed, %s",
+			aa.req.ProjectID, kind, resourceID, name, msg)
+	}
+	aa.results = append(aa.results, &cmproto.ApplyResourceResult{
+		Kind:       kind,
+		ResourceID: resourceID,
+		Name:       name,
+		Action:     action,
+		TaskID:     taskID,
+		Message:    msg,
+	})
+}
+
+// respErr trans action response code to error
+func respErr(code uint32, msg string) error {
+	if code != common.BcsErrClusterManagerSuccess {
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, drivers.ErrTableRecordNotFound)
+}
+
+func (aa *ApplyAction) applyCluster(cls *cmproto.Cluster) {
+	if cls.ProjectID != aa.req.ProjectID {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "",
+			fmt.Sprintf("cluster project %s is not bundle project", cls.ProjectID))
+		return
+	}
+	current, err := aa.model.GetCluster(aa.ctx, cls.ClusterID)
+	if err != nil && !isNotFound(err) {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "", err.Error())
+		return
+	}
+	if current != nil {
+		aa.updateCluster(current, cls)
+		return
+	}
+
+	// imported cluster depends on kubeConfig or cloud cluster, can't be rebuilt from bundle
+	if cls.ClusterCategory == common.Importer {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionSkip, "",
+			"imported cluster must be imported by ImportCluster")
+		return
+	}
+	if aa.req.DryRun {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionCreate, "", "")
+		return
+	}
+
+	req := &cmproto.CreateClusterReq{}
+	if err = convertMessage(cls, req, "master"); err != nil {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "", err.Error())
+		return
+	}
+	req.CloudID = cls.Provider
+	req.Creator = aa.req.Operator
+	for ip := range cls.Master {
+		req.Master = append(req.Master, ip)
+	}
+	sort.Strings(req.Master)
+
+	resp := &cmproto.CreateClusterResp{}
+	clusterac.NewCreateAction(aa.model, aa.locker).Handle(aa.ctx, req, resp)
+	if err = respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindCluster, resp.GetData().GetClusterID(), cls.ClusterName, ActionCreate,
+		resp.GetTask().GetTaskID(), "")
+}
+
+func (aa *ApplyAction) updateCluster(current, cls *cmproto.Cluster) {
+	if current.Status == common.StatusDeleted || current.ProjectID != aa.req.ProjectID {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "",
+			fmt.Sprintf("cluster is %s in project %s", current.Status, current.ProjectID))
+		return
+	}
+	if pb.Equal(normalizeCluster(current), normalizeCluster(cls)) {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionUnchanged, "", "")
+		return
+	}
+	if aa.req.DryRun {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionUpdate, "", "")
+		return
+	}
+
+	req := &cmproto.UpdateClusterReq{}
+	err := convertMessage(cls, req, "master", "status", "creator", "createTime")
+	if err != nil {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "", err.Error())
+		return
+	}
+	req.ExtraInfo = cls.ExtraInfo
+	req.Updater = aa.req.Operator
+
+	resp := &cmproto.UpdateClusterResp{}
+	clusterac.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err = respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindCluster, cls.ClusterID, cls.ClusterName, ActionUpdate, "", "")
+}
+
+// applyNodeTemplate nodeTemplate ID is generated when created, so match by ID first and then by name
+func (aa *ApplyAction) applyNodeTemplate(template *cmproto.NodeTemplate, existed []cmproto.NodeTemplate) {
+	var current *cmproto.NodeTemplate
+	for i := range existed {
+		if existed[i].NodeTemplateID == template.NodeTemplateID {
+			current = &existed[i]
+			break
+		}
+		if existed[i].Name == template.Name {
+			current = &existed[i]
+		}
+	}
+
+	if current != nil {
+		desired := pb.Clone(template).(*cmproto.NodeTemplate)
+		desired.NodeTemplateID = current.NodeTemplateID
+		desired.ProjectID = current.ProjectID
+		if pb.Equal(normalizeNodeTemplate(current), normalizeNodeTemplate(desired)) {
+			aa.addResult(KindNodeTemplate, current.NodeTemplateID, template.Name, ActionUnchanged, "", "")
+			return
+		}
+		if aa.req.DryRun {
+			aa.addResult(KindNodeTemplate, current.NodeTemplateID, template.Name, ActionUpdate, "", "")
+			return
+		}
+
+		// scaleIn scripts are not converted, update them by UpdateNodeTemplate
+		req := &cmproto.UpdateNodeTemplateRequest{}
+		if err := convertMessage(desired, req, "annotations"); err != nil {
+			aa.addResult(KindNodeTemplate, current.NodeTemplateID, template.Name, ActionFailed, "", err.Error())
+			return
+		}
+		req.UserScript = decodeScript(desired.UserScript)
+		req.PreStartUserScript = decodeScript(desired.PreStartUserScript)
+		req.Annotations = &cmproto.MapStruct{Values: desired.Annotations}
+		req.Updater = aa.req.Operator
+
+		resp := &cmproto.UpdateNodeTemplateResponse{}
+		nodetemplateac.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+		if err := respErr(resp.Code, resp.Message); err != nil {
+			aa.addResult(KindNodeTemplate, current.NodeTemplateID, template.Name, ActionFailed, "", err.Error())
+			return
+		}
+		aa.addResult(KindNodeTemplate, current.NodeTemplateID, template.Name, ActionUpdate, "", "")
+		return
+	}
+
+	if aa.req.DryRun {
+		aa.addResult(KindNodeTemplate, template.NodeTemplateID, template.Name, ActionCreate, "", "")
+		return
+	}
+	req := &cmproto.CreateNodeTemplateRequest{}
+	if err := convertMessage(template, req, "annotations"); err != nil {
+		aa.addResult(KindNodeTemplate, template.NodeTemplateID, template.Name, ActionFailed, "", err.Error())
+		return
+	}
+	req.ProjectID = aa.req.ProjectID
+	req.UserScript = decodeScript(template.UserScript)
+	req.PreStartUserScript = decodeScript(template.PreStartUserScript)
+	req.Annotations = &cmproto.MapStruct{Values: template.Annotations}
+	req.Creator = aa.req.Operator
+
+	resp := &cmproto.CreateNodeTemplateResponse{}
+	nodetemplateac.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err := respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindNodeTemplate, template.NodeTemplateID, template.Name, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindNodeTemplate, template.NodeTemplateID, template.Name, ActionCreate, "", "")
+}
+
+// checkClusterProject check cluster of project resources belongs to bundle project, cluster not exist
+// when it is created by bundle
+func (aa *ApplyAction) checkClusterProject(clusterID string) error {
+	cls, err := aa.model.GetCluster(aa.ctx, clusterID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cls.ProjectID != aa.req.ProjectID {
+		return fmt.Errorf("cluster %s is in project %s", clusterID, cls.ProjectID)
+	}
+
+	return nil
+}
+
+// getCurrentNodeGroup nodeGroup ID is generated when created, so match by ID first and then by cluster & name
+func (aa *ApplyAction) getCurrentNodeGroup(group *cmproto.NodeGroup) (*cmproto.NodeGroup, error) {
+	if group.NodeGroupID != "" {
+		current, err := aa.model.GetNodeGroup(aa.ctx, group.NodeGroupID)
+		if err == nil {
+			return current, nil
+		}
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	cond := operator.NewBranchCondition(operator.And,
+		operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": group.ClusterID, "name": group.Name}),
+		operator.NewLeafCondition(operator.Ne, operator.M{"status": common.StatusDeleted}))
+	groups, err := aa.model.ListNodeGroup(aa.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	return &groups[0], nil
+}
+
+func (aa *ApplyAction) applyNodeGroup(group *cmproto.NodeGroup) {
+	if group.ProjectID != aa.req.ProjectID {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionFailed, "",
+			fmt.Sprintf("nodeGroup project %s is not bundle project", group.ProjectID))
+		return
+	}
+	if err := aa.checkClusterProject(group.ClusterID); err != nil {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	current, err := aa.getCurrentNodeGroup(group)
+	if err != nil {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	if current != nil {
+		if current.ProjectID != aa.req.ProjectID {
+			aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionFailed, "",
+				fmt.Sprintf("nodeGroup is in project %s", current.ProjectID))
+			return
+		}
+		aa.updateNodeGroup(current, group)
+		return
+	}
+	if aa.req.DryRun {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionCreate, "", "")
+		return
+	}
+
+	req := &cmproto.CreateNodeGroupRequest{}
+	if err = convertMessage(group, req); err != nil {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	if group.Area != nil {
+		req.BkCloudID = group.Area.BkCloudID
+		req.CloudAreaName = group.Area.BkCloudName
+	}
+	req.Creator = aa.req.Operator
+
+	resp := &cmproto.CreateNodeGroupResponse{}
+	nodegroupac.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err = respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindNodeGroup, group.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindNodeGroup, resp.GetData().GetNodeGroup().GetNodeGroupID(), group.Name, ActionCreate,
+		resp.GetData().GetTask().GetTaskID(), "")
+}
+
+func (aa *ApplyAction) updateNodeGroup(current, group *cmproto.NodeGroup) {
+	desired := pb.Clone(group).(*cmproto.NodeGroup)
+	desired.NodeGroupID = current.NodeGroupID
+	if !isNodeGroupChanged(current, desired) {
+		aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionUnchanged, "", "")
+		return
+	}
+	if aa.req.DryRun {
+		aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionUpdate, "", "")
+		return
+	}
+
+	req := &cmproto.UpdateNodeGroupRequest{}
+	if err := convertMessage(desired, req, "scalingSchedules"); err != nil {
+		aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	req.ScalingSchedules = &cmproto.ScalingScheduleList{Schedules: desired.ScalingSchedules}
+	// secrets and cloud generated fields are not exported, keep current value
+	if req.LaunchTemplate != nil && current.LaunchTemplate != nil {
+		req.LaunchTemplate.LaunchConfigurationID = current.LaunchTemplate.LaunchConfigurationID
+		if req.LaunchTemplate.InitLoginPassword == "" {
+			req.LaunchTemplate.InitLoginPassword = current.LaunchTemplate.InitLoginPassword
+		}
+		if req.LaunchTemplate.KeyPair != nil && current.LaunchTemplate.KeyPair != nil &&
+			req.LaunchTemplate.KeyPair.KeySecret == "" {
+			req.LaunchTemplate.KeyPair.KeySecret = current.LaunchTemplate.KeyPair.KeySecret
+		}
+	}
+	if req.AutoScaling != nil && current.AutoScaling != nil {
+		req.AutoScaling.AutoScalingID = current.AutoScaling.AutoScalingID
+		req.AutoScaling.AutoScalingName = current.AutoScaling.AutoScalingName
+		req.AutoScaling.DesiredSize = current.AutoScaling.DesiredSize
+	}
+	req.Updater = aa.req.Operator
+
+	resp := &cmproto.UpdateNodeGroupResponse{}
+	nodegroupac.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err := respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindNodeGroup, current.NodeGroupID, group.Name, ActionUpdate, "", "")
+}
+
+func (aa *ApplyAction) applyAutoScalingOption(option *cmproto.ClusterAutoScalingOption) {
+	if option.ProjectID != aa.req.ProjectID {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "",
+			fmt.Sprintf("autoScalingOption project %s is not bundle project", option.ProjectID))
+		return
+	}
+	if err := aa.checkClusterProject(option.ClusterID); err != nil {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+		return
+	}
+	current, err := aa.model.GetAutoScalingOption(aa.ctx, option.ClusterID)
+	if err != nil && !isNotFound(err) {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+		return
+	}
+	if current != nil && current.ProjectID != aa.req.ProjectID {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "",
+			fmt.Sprintf("autoScalingOption is in project %s", current.ProjectID))
+		return
+	}
+
+	if current != nil {
+		if pb.Equal(normalizeAutoScalingOption(current), normalizeAutoScalingOption(option)) {
+			aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionUnchanged, "", "")
+			return
+		}
+		if aa.req.DryRun {
+			aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionUpdate, "", "")
+			return
+		}
+
+		req := &cmproto.UpdateAutoScalingOptionRequest{}
+		if err = convertMessage(option, req); err != nil {
+			aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+			return
+		}
+		req.Updater = aa.req.Operator
+		resp := &cmproto.UpdateAutoScalingOptionResponse{}
+		asoptionac.NewUpdateAction(aa.model).Handle(aa.ctx, req, resp)
+		if err = respErr(resp.Code, resp.Message); err != nil {
+			aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+			return
+		}
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionUpdate, "", "")
+		return
+	}
+
+	if aa.req.DryRun {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionCreate, "", "")
+		return
+	}
+	req := &cmproto.CreateAutoScalingOptionRequest{}
+	if err = convertMessage(option, req); err != nil {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+		return
+	}
+	req.Creator = aa.req.Operator
+	resp := &cmproto.CreateAutoScalingOptionResponse{}
+	asoptionac.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err = respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindAutoScalingOption, option.ClusterID, "", ActionCreate, "", "")
+}
+
+// applyNotifyTemplate notifyTemplate has no update interface, update it in store directly
+func (aa *ApplyAction) applyNotifyTemplate(template *cmproto.NotifyTemplate, existed []cmproto.NotifyTemplate) {
+	var current *cmproto.NotifyTemplate
+	for i := range existed {
+		if existed[i].NotifyTemplateID == template.NotifyTemplateID {
+			current = &existed[i]
+			break
+		}
+		if existed[i].Name == template.Name {
+			current = &existed[i]
+		}
+	}
+
+	if current != nil {
+		desired := pb.Clone(template).(*cmproto.NotifyTemplate)
+		desired.NotifyTemplateID = current.NotifyTemplateID
+		desired.ProjectID = current.ProjectID
+		if pb.Equal(normalizeNotifyTemplate(current), normalizeNotifyTemplate(desired)) {
+			aa.addResult(KindNotifyTemplate, current.NotifyTemplateID, template.Name, ActionUnchanged, "", "")
+			return
+		}
+		if aa.req.DryRun {
+			aa.addResult(KindNotifyTemplate, current.NotifyTemplateID, template.Name, ActionUpdate, "", "")
+			return
+		}
+
+		desired.Creator = current.Creator
+		desired.CreateTime = current.CreateTime
+		desired.Updater = aa.req.Operator
+		desired.UpdateTime = time.Now().Format(time.RFC3339)
+		if err := aa.model.UpdateNotifyTemplate(aa.ctx, desired); err != nil {
+			aa.addResult(KindNotifyTemplate, current.NotifyTemplateID, template.Name, ActionFailed, "", err.Error())
+			return
+		}
+		aa.addResult(KindNotifyTemplate, current.NotifyTemplateID, template.Name, ActionUpdate, "", "")
+		return
+	}
+
+	if aa.req.DryRun {
+		aa.addResult(KindNotifyTemplate, template.NotifyTemplateID, template.Name, ActionCreate, "", "")
+		return
+	}
+	req := &cmproto.CreateNotifyTemplateRequest{}
+	if err := convertMessage(template, req); err != nil {
+		aa.addResult(KindNotifyTemplate, template.NotifyTemplateID, template.Name, ActionFailed, "", err.Error())
+		return
+	}
+	req.ProjectID = aa.req.ProjectID
+	req.Creator = aa.req.Operator
+	resp := &cmproto.CreateNotifyTemplateResponse{}
+	notifytemplateac.NewCreateAction(aa.model).Handle(aa.ctx, req, resp)
+	if err := respErr(resp.Code, resp.Message); err != nil {
+		aa.addResult(KindNotifyTemplate, template.NotifyTemplateID, template.Name, ActionFailed, "", err.Error())
+		return
+	}
+	aa.addResult(KindNotifyTemplate, template.NotifyTemplateID, template.Name, ActionCreate, "", "")
+}
+
+func (aa *ApplyAction) listProjectTemplates() ([]cmproto.NodeTemplate, []cmproto.NotifyTemplate, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"projectid": aa.req.ProjectID})
+	nodeTemplates, err := aa.model.ListNodeTemplate(aa.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	notifyTemplates, err := aa.model.ListNotifyTemplate(aa.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nodeTemplates, notifyTemplates, nil
+}
+
+// checkPermissions ApplyProjectResources only requires project edit permission, bundle creates and updates
+// clusters and cluster resources by their actions, so check the same permissions as their own interfaces
+// before applying anything. clusters created by bundle are covered by cluster create permission
+func (aa *ApplyAction) checkPermissions() error {
+	checked := make(map[string]bool)
+	check := func(method, clusterID string) error {
+		key := method + "/" + clusterID
+		if checked[key] {
+			return nil
+		}
+		checked[key] = true
+		if err := aa.checkPerm(aa.ctx, method, aa.req.ProjectID, clusterID); err != nil {
+			return fmt.Errorf("check %s permission of cluster %s failed, %w", method, clusterID, err)
+		}
+		return nil
+	}
+
+	created := make(map[string]bool)
+	for _, cls := range aa.res.clusters {
+		_, err := aa.model.GetCluster(aa.ctx, cls.ClusterID)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		if err != nil {
+			created[cls.ClusterID] = true
+			if err = check("ClusterManager.CreateCluster", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = check("ClusterManager.UpdateCluster", cls.ClusterID); err != nil {
+			return err
+		}
+	}
+	for _, group := range aa.res.nodeGroups {
+		if created[group.ClusterID] {
+			continue
+		}
+		if err := check("ClusterManager.UpdateNodeGroup", group.ClusterID); err != nil {
+			return err
+		}
+	}
+	for _, option := range aa.res.autoScalingOptions {
+		if created[option.ClusterID] {
+			continue
+		}
+		if err := check("ClusterManager.UpdateAutoScalingOption", option.ClusterID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyResources apply resources by dependency order
+func (aa *ApplyAction) applyResources() error {
+	nodeTemplates, notifyTemplates, err := aa.listProjectTemplates()
+	if err != nil {
+		return err
+	}
+
+	for i := range aa.res.clusters {
+		aa.applyCluster(aa.res.clusters[i])
+	}
+	for i := range aa.res.nodeTemplates {
+		aa.applyNodeTemplate(aa.res.nodeTemplates[i], nodeTemplates)
+	}
+	for i := range aa.res.nodeGroups {
+		aa.applyNodeGroup(aa.res.nodeGroups[i])
+	}
+	for i := range aa.res.autoScalingOptions {
+		aa.applyAutoScalingOption(aa.res.autoScalingOptions[i])
+	}
+	for i := range aa.res.notifyTemplates {
+		aa.applyNotifyTemplate(aa.res.notifyTemplates[i], notifyTemplates)
+	}
+
+	return nil
+}
+
+// Handle apply project resources bundle request
+func (aa *ApplyAction) Handle(ctx context.Context,
+	req *cmproto.ApplyProjectResourcesReq, resp *cmproto.ApplyProjectResourcesResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("apply project resources failed, req or resp is empty")
+		return
+	}
+	aa.ctx = ctx
+	aa.req = req
+	aa.resp = resp
+
+	if err := aa.validate(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := aa.checkPermissions(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerCheckPermErr, err.Error())
+		return
+	}
+	if err := aa.applyResources(); err != nil {
+		aa.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	failed := 0
+	for i := range aa.results {
+		if aa.results[i].Action == ActionFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		aa.setResp(common.BcsErrClusterManagerCommonErr,
+			fmt.Sprintf("%d of %d resources apply failed", failed, len(aa.results)))
+		return
+	}
+
+	aa.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,220 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcebundle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// fakeModel store model only implements methods used by apply checks
+type fakeModel struct {
+	store.ClusterManagerModel
+	clusters   map[string]*cmproto.Cluster
+	nodeGroups map[string]*cmproto.NodeGroup
+	options    map[string]*cmproto.ClusterAutoScalingOption
+}
+
+func (m *fakeModel) GetCluster(ctx context.Context, clusterID string) (*cmproto.Cluster, error) {
+	if cls, ok := m.clusters[clusterID]; ok {
+		return cls, nil
+	}
+	return nil, drivers.ErrTableRecordNotFound
+}
+
+func (m *fakeModel) GetNodeGroup(ctx context.Context, groupID string) (*cmproto.NodeGroup, error) {
+	if group, ok := m.nodeGroups[groupID]; ok {
+		return group, nil
+	}
+	return nil, drivers.ErrTableRecordNotFound
+}
+
+func (m *fakeModel) ListNodeGroup(ctx context.Context, cond *operator.Condition,
+	opt *storeopt.ListOption) ([]cmproto.NodeGroup, error) {
+	return nil, nil
+}
+
+func (m *fakeModel) GetAutoScalingOption(ctx context.Context,
+	clusterID string) (*cmproto.ClusterAutoScalingOption, error) {
+	if option, ok := m.options[clusterID]; ok {
+		return option, nil
+	}
+	return nil, drivers.ErrTableRecordNotFound
+}
+
+func newTestApplyAction() *ApplyAction {
+	model := &fakeModel{
+		clusters: map[string]*cmproto.Cluster{
+			"BCS-K8S-00001": {ClusterID: "BCS-K8S-00001", ProjectID: "project-a"},
+			"BCS-K8S-00002": {ClusterID: "BCS-K8S-00002", ProjectID: "project-b"},
+		},
+		nodeGroups: map[string]*cmproto.NodeGroup{
+			"BCS-ng-a": {NodeGroupID: "BCS-ng-a", ClusterID: "BCS-K8S-00001", ProjectID: "project-a", Name: "a"},
+			"BCS-ng-b": {NodeGroupID: "BCS-ng-b", ClusterID: "BCS-K8S-00002", ProjectID: "project-b", Name: "b"},
+		},
+		options: map[string]*cmproto.ClusterAutoScalingOption{
+			"BCS-K8S-00002": {ClusterID: "BCS-K8S-00002", ProjectID: "project-b"},
+		},
+	}
+	aa := NewApplyAction(model, nil)
+	aa.ctx = context.Background()
+	aa.req = &cmproto.ApplyProjectResourcesReq{ProjectID: "project-a", DryRun: true}
+	return aa
+}
+
+func TestApplyNodeGroupProject(t *testing.T) {
+	tests := []struct {
+		name   string
+		group  *cmproto.NodeGroup
+		action string
+	}{
+		{
+			name:   "bundle project mismatch",
+			group:  &cmproto.NodeGroup{NodeGroupID: "BCS-ng-a", ClusterID: "BCS-K8S-00001", ProjectID: "project-b"},
+			action: ActionFailed,
+		},
+		{
+			name:   "current nodeGroup in other project",
+			group:  &cmproto.NodeGroup{NodeGroupID: "BCS-ng-b", ClusterID: "BCS-K8S-00001", ProjectID: "project-a"},
+			action: ActionFailed,
+		},
+		{
+			name:   "cluster in other project",
+			group:  &cmproto.NodeGroup{ClusterID: "BCS-K8S-00002", ProjectID: "project-a", Name: "new"},
+			action: ActionFailed,
+		},
+		{
+			name:   "create nodeGroup",
+			group:  &cmproto.NodeGroup{ClusterID: "BCS-K8S-00001", ProjectID: "project-a", Name: "new"},
+			action: ActionCreate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aa := newTestApplyAction()
+			aa.applyNodeGroup(tt.group)
+			if len(aa.results) != 1 || aa.results[0].Action != tt.action {
+				t.Fatalf("apply nodeGroup results %v, expected action %s", aa.results, tt.action)
+			}
+		})
+	}
+}
+
+func TestApplyAutoScalingOptionProject(t *testing.T) {
+	tests := []struct {
+		name   string
+		option *cmproto.ClusterAutoScalingOption
+		action string
+	}{
+		{
+			name:   "bundle project mismatch",
+			option: &cmproto.ClusterAutoScalingOption{ClusterID: "BCS-K8S-00001", ProjectID: "project-b"},
+			action: ActionFailed,
+		},
+		{
+			name:   "cluster in other project",
+			option: &cmproto.ClusterAutoScalingOption{ClusterID: "BCS-K8S-00002", ProjectID: "project-a"},
+			action: ActionFailed,
+		},
+		{
+			name:   "create autoScalingOption",
+			option: &cmproto.ClusterAutoScalingOption{ClusterID: "BCS-K8S-00001", ProjectID: "project-a"},
+			action: ActionCreate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aa := newTestApplyAction()
+			aa.applyAutoScalingOption(tt.option)
+			if len(aa.results) != 1 || aa.results[0].Action != tt.action {
+				t.Fatalf("apply autoScalingOption results %v, expected action %s", aa.results, tt.action)
+			}
+		})
+	}
+}
+
+func TestDecodeBundle(t *testing.T) {
+	data, err := encodeBundle(Metadata{ProjectID: "project-a"}, &resources{
+		clusters: []*cmproto.Cluster{{ClusterID: "BCS-K8S-00001", ProjectID: "project-a"}},
+	})
+	if err != nil {
+		t.Fatalf("encode bundle failed: %v", err)
+	}
+	meta, res, err := decodeBundle(data)
+	if err != nil {
+		t.Fatalf("decode bundle failed: %v", err)
+	}
+	if meta.ProjectID != "project-a" || len(res.clusters) != 1 || res.clusters[0].ClusterID != "BCS-K8S-00001" {
+		t.Fatalf("decode bundle %v %v", meta, res.clusters)
+	}
+
+	withClouds := data + "  clouds:\n  - cloudID: tencentCloud\n"
+	if _, _, err = decodeBundle(withClouds); err == nil || !strings.Contains(err.Error(), "clouds") {
+		t.Fatalf("bundle with clouds should be rejected, err %v", err)
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	aa := newTestApplyAction()
+	aa.res = &resources{
+		clusters: []*cmproto.Cluster{
+			{ClusterID: "BCS-K8S-00001", ProjectID: "project-a"},
+			{ClusterID: "BCS-K8S-00003", ProjectID: "project-a"},
+		},
+		nodeGroups: []*cmproto.NodeGroup{
+			{ClusterID: "BCS-K8S-00001", ProjectID: "project-a", Name: "a"},
+			{ClusterID: "BCS-K8S-00003", ProjectID: "project-a", Name: "c"},
+		},
+		autoScalingOptions: []*cmproto.ClusterAutoScalingOption{
+			{ClusterID: "BCS-K8S-00001", ProjectID: "project-a"},
+		},
+	}
+
+	checked := make([]string, 0)
+	aa.checkPerm = func(ctx context.Context, method, projectID, clusterID string) error {
+		checked = append(checked, method+"/"+clusterID)
+		return nil
+	}
+	if err := aa.checkPermissions(); err != nil {
+		t.Fatalf("check permissions failed: %v", err)
+	}
+	expected := []string{
+		"ClusterManager.UpdateCluster/BCS-K8S-00001",
+		"ClusterManager.CreateCluster/",
+		"ClusterManager.UpdateNodeGroup/BCS-K8S-00001",
+		"ClusterManager.UpdateAutoScalingOption/BCS-K8S-00001",
+	}
+	if strings.Join(checked, ",") != strings.Join(expected, ",") {
+		t.Fatalf("checked permissions %v, expected %v", checked, expected)
+	}
+
+	// no cluster manage permission, nothing is applied
+	aa.checkPerm = func(ctx context.Context, method, projectID, clusterID string) error {
+		if method == "ClusterManager.UpdateNodeGroup" {
+			return errors.New("permission denied")
+		}
+		return nil
+	}
+	if err := aa.checkPermissions(); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("nodeGroup without manage permission should be rejected, err %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcebundle
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// ExportAction action for export project resources
+type ExportAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.ExportProjectResourcesReq
+	resp  *cmproto.ExportProjectResourcesResp
+	res   *resources
+}
+
+// NewExportAction create action for export project resources
+func NewExportAction(model store.ClusterManagerModel) *ExportAction {
+	return &ExportAction{
+		model: model,
+		res:   &resources{},
+	}
+}
+
+func (ea *ExportAction) validate() error {
+	if err := ea.req.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ea *ExportAction) setResp(code uint32, msg string) {
+	ea.resp.Code = code
+	ea.resp.Message = msg
+	ea.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (ea *ExportAction) exportClusters() error {
+	conds := []*operator.Condition{
+		operator.NewLeafCondition(operator.Eq, operator.M{"projectid": ea.req.ProjectID}),
+		operator.NewLeafCondition(operator.Ne, operator.M{"status": common.StatusDeleted}),
+	}
+	if ea.req.ClusterID != "" {
+		conds = append(conds, operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": ea.req.ClusterID}))
+	}
+	clusterList, err := ea.model.ListCluster(ea.ctx, operator.NewBranchCondition(operator.And, conds...),
+		&storeopt.ListOption{All: true})
+	if err != nil {
+		return err
+	}
+
+	for i := range clusterList {
+		// virtual clusters are managed by host cluster
+		if clusterList[i].ClusterType == common.ClusterTypeVirtual {
+			continue
+		}
+		ea.res.clusters = append(ea.res.clusters, normalizeCluster(&clusterList[i]))
+	}
+	sort.Slice(ea.res.clusters, func(i, j int) bool {
+		return ea.res.clusters[i].ClusterID < ea.res.clusters[j].ClusterID
+	})
+
+	return nil
+}
+
+func (ea *ExportAction) exportClusterSubResources() error {
+	for _, cls := range ea.res.clusters {
+		cond := operator.NewLeafCondition(operator.Eq, operator.M{"clusterid": cls.ClusterID})
+		groups, err := ea.model.ListNodeGroup(ea.ctx, cond, &storeopt.ListOption{All: true})
+		if err != nil {
+			return err
+		}
+		for i := range groups {
+			if groups[i].Status == common.StatusDeleteNodeGroupDeleting || groups[i].Status == common.StatusDeleted {
+				continue
+			}
+			ea.res.nodeGroups = append(ea.res.nodeGroups, normalizeNodeGroup(&groups[i]))
+		}
+
+		option, err := ea.model.GetAutoScalingOption(ea.ctx, cls.ClusterID)
+		if err != nil && !errors.Is(err, drivers.ErrTableRecordNotFound) {
+			return err
+		}
+		if option != nil {
+			ea.res.autoScalingOptions = append(ea.res.autoScalingOptions, normalizeAutoScalingOption(option))
+		}
+	}
+	sort.Slice(ea.res.nodeGroups, func(i, j int) bool {
+		return ea.res.nodeGroups[i].NodeGroupID < ea.res.nodeGroups[j].NodeGroupID
+	})
+
+	return nil
+}
+
+func (ea *ExportAction) exportProjectTemplates() error {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{"projectid": ea.req.ProjectID})
+	nodeTemplates, err := ea.model.ListNodeTemplate(ea.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return err
+	}
+	for i := range nodeTemplates {
+		ea.res.nodeTemplates = append(ea.res.nodeTemplates, normalizeNodeTemplate(&nodeTemplates[i]))
+	}
+	sort.Slice(ea.res.nodeTemplates, func(i, j int) bool {
+		return ea.res.nodeTemplates[i].Name < ea.res.nodeTemplates[j].Name
+	})
+
+	notifyTemplates, err := ea.model.ListNotifyTemplate(ea.ctx, cond, &storeopt.ListOption{All: true})
+	if err != nil {
+		return err
+	}
+	for i := range notifyTemplates {
+		ea.res.notifyTemplates = append(ea.res.notifyTemplates, normalizeNotifyTemplate(&notifyTemplates[i]))
+	}
+	sort.Slice(ea.res.notifyTemplates, func(i, j int) bool {
+		return ea.res.notifyTemplates[i].Name < ea.res.notifyTemplates[j].Name
+	})
+
+	return nil
+}
+
+// Handle export project resources request
+func (ea *ExportAction) Handle(ctx context.Context,
+	req *cmproto.ExportProjectResourcesReq, resp *cmproto.ExportProjectResourcesResp) {
+	if req == nil || resp == nil {
+		blog.Errorf("export project resources failed, req or resp is empty")
+		return
+	}
+	ea.ctx = ctx
+	ea.req = req
+	ea.resp = resp
+
+	if err := ea.validate(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+	if err := ea.exportClusters(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ea.exportClusterSubResources(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+	if err := ea.exportProjectTemplates(); err != nil {
+		ea.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	data, err := encodeBundle(Metadata{
+		ProjectID:  req.ProjectID,
+		ClusterID:  req.ClusterID,
+		ExportTime: time.Now().Format(time.RFC3339),
+	}, ea.res)
+	if err != nil {
+		blog.Errorf("export project %s resources encode bundle failed, %s", req.ProjectID, err.Error())
+		ea.setResp(common.BcsErrClusterManagerCommonErr, err.Error())
+		return
+	}
+	ea.resp.Data = data
+
+	ea.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
@@ -0,0 +1,230 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package resourcebundle xxx
+package resourcebundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/golang/protobuf/proto"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+const (
+	// APIVersion resource bundle api version, bump it when bundle layout is incompatible
+	APIVersion = "clustermanager.bkbcs.tencent.com/v1"
+	// Kind resource bundle kind
+	Kind = "ResourceBundle"
+)
+
+// resource kinds in bundle
+const (
+	// KindCluster cluster
+	KindCluster = "Cluster"
+	// KindNodeTemplate nodeTemplate
+	KindNodeTemplate = "NodeTemplate"
+	// KindNodeGroup nodeGroup
+	KindNodeGroup = "NodeGroup"
+	// KindAutoScalingOption cluster autoscaling option
+	KindAutoScalingOption = "AutoScalingOption"
+	// KindNotifyTemplate notifyTemplate
+	KindNotifyTemplate = "NotifyTemplate"
+)
+
+// apply actions for each resource
+const (
+	// ActionCreate resource will be created
+	ActionCreate = "create"
+	// ActionUpdate resource will be updated
+	ActionUpdate = "update"
+	// ActionUnchanged resource is same with store
+	ActionUnchanged = "unchanged"
+	// ActionSkip resource can't be applied by bundle
+	ActionSkip = "skip"
+	// ActionFailed resource apply failed
+	ActionFailed = "failed"
+)
+
+// ResourceBundle declarative cluster-manager resources of project
+type ResourceBundle struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	Spec       Spec     `json:"spec"`
+}
+
+// Metadata bundle metadata
+type Metadata struct {
+	ProjectID  string `json:"projectID"`
+	ClusterID  string `json:"clusterID,omitempty"`
+	ExportTime string `json:"exportTime,omitempty"`
+}
+
+// Spec bundle resources, every item is json format of cluster-manager proto. clouds are platform
+// resources shared by all projects, they are not exported or applied by project bundle and must be
+// created by CreateCloud before applying clusters which use them
+type Spec struct {
+	Clusters           []json.RawMessage `json:"clusters,omitempty"`
+	NodeTemplates      []json.RawMessage `json:"nodeTemplates,omitempty"`
+	NodeGroups         []json.RawMessage `json:"nodeGroups,omitempty"`
+	AutoScalingOptions []json.RawMessage `json:"autoScalingOptions,omitempty"`
+	NotifyTemplates    []json.RawMessage `json:"notifyTemplates,omitempty"`
+}
+
+// resources typed bundle resources
+type resources struct {
+	clusters           []*cmproto.Cluster
+	nodeTemplates      []*cmproto.NodeTemplate
+	nodeGroups         []*cmproto.NodeGroup
+	autoScalingOptions []*cmproto.ClusterAutoScalingOption
+	notifyTemplates    []*cmproto.NotifyTemplate
+}
+
+// encodeBundle encode resources to yaml bundle
+func encodeBundle(meta Metadata, res *resources) (string, error) {
+	bundle := &ResourceBundle{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Metadata:   meta,
+	}
+
+	var err error
+	appendItem := func(items *[]json.RawMessage, msg pb.Message) {
+		if err != nil {
+			return
+		}
+		var raw json.RawMessage
+		raw, err = toRaw(msg)
+		*items = append(*items, raw)
+	}
+	for i := range res.clusters {
+		appendItem(&bundle.Spec.Clusters, res.clusters[i])
+	}
+	for i := range res.nodeTemplates {
+		appendItem(&bundle.Spec.NodeTemplates, res.nodeTemplates[i])
+	}
+	for i := range res.nodeGroups {
+		appendItem(&bundle.Spec.NodeGroups, res.nodeGroups[i])
+	}
+	for i := range res.autoScalingOptions {
+		appendItem(&bundle.Spec.AutoScalingOptions, res.autoScalingOptions[i])
+	}
+	for i := range res.notifyTemplates {
+		appendItem(&bundle.Spec.NotifyTemplates, res.notifyTemplates[i])
+	}
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// decodeBundle decode yaml bundle to resources
+func decodeBundle(data string) (*Metadata, *resources, error) {
+	jsonData, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode bundle failed, %s", err.Error())
+	}
+	// unknown sections such as clouds are rejected instead of being ignored silently
+	bundle := &ResourceBundle{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(bundle); err != nil {
+		return nil, nil, fmt.Errorf("decode bundle failed, %s", err.Error())
+	}
+	if bundle.APIVersion != APIVersion || bundle.Kind != Kind {
+		return nil, nil, fmt.Errorf("bundle %s/%s not supported, expect %s/%s",
+			bundle.APIVersion, bundle.Kind, APIVersion, Kind)
+	}
+
+	res := &resources{}
+	decodeItem := func(kind string, index int, raw json.RawMessage, msg pb.Message) {
+		if err != nil {
+			return
+		}
+		if errLocal := fromRaw(raw, msg); errLocal != nil {
+			err = fmt.Errorf("decode %s[%d] failed, %s", kind, index, errLocal.Error())
+		}
+	}
+	for i := range bundle.Spec.Clusters {
+		res.clusters = append(res.clusters, &cmproto.Cluster{})
+		decodeItem(KindCluster, i, bundle.Spec.Clusters[i], res.clusters[i])
+	}
+	for i := range bundle.Spec.NodeTemplates {
+		res.nodeTemplates = append(res.nodeTemplates, &cmproto.NodeTemplate{})
+		decodeItem(KindNodeTemplate, i, bundle.Spec.NodeTemplates[i], res.nodeTemplates[i])
+	}
+	for i := range bundle.Spec.NodeGroups {
+		res.nodeGroups = append(res.nodeGroups, &cmproto.NodeGroup{})
+		decodeItem(KindNodeGroup, i, bundle.Spec.NodeGroups[i], res.nodeGroups[i])
+	}
+	for i := range bundle.Spec.AutoScalingOptions {
+		res.autoScalingOptions = append(res.autoScalingOptions, &cmproto.ClusterAutoScalingOption{})
+		decodeItem(KindAutoScalingOption, i, bundle.Spec.AutoScalingOptions[i], res.autoScalingOptions[i])
+	}
+	for i := range bundle.Spec.NotifyTemplates {
+		res.notifyTemplates = append(res.notifyTemplates, &cmproto.NotifyTemplate{})
+		decodeItem(KindNotifyTemplate, i, bundle.Spec.NotifyTemplates[i], res.notifyTemplates[i])
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &bundle.Metadata, res, nil
+}
+
+// toRaw marshal proto message to json
+func toRaw(msg pb.Message) (json.RawMessage, error) {
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(data), nil
+}
+
+// fromRaw unmarshal json to proto message, unknown fields are rejected
+func fromRaw(raw json.RawMessage, msg pb.Message) error {
+	return (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(raw), msg)
+}
+
+// convertMessage convert resource to request by json field name, fields not in dst and dropFields are discarded
+func convertMessage(src, dst pb.Message, dropFields ...string) error {
+	data, err := (&jsonpb.Marshaler{EmitDefaults: true}).MarshalToString(src)
+	if err != nil {
+		return err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err = json.Unmarshal([]byte(data), &fields); err != nil {
+		return err
+	}
+	for _, field := range dropFields {
+		delete(fields, field)
+	}
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(out), dst)
+}
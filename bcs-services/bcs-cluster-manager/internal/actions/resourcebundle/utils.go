@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcebundle
+
+import (
+	"encoding/base64"
+
+	pb "github.com/golang/protobuf/proto"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// normalizeCluster clean runtime and secret fields of cluster
+func normalizeCluster(cls *cmproto.Cluster) *cmproto.Cluster {
+	cls = pb.Clone(cls).(*cmproto.Cluster)
+	cls.Status = ""
+	cls.Message = ""
+	cls.KubeConfig = ""
+	cls.Creator = ""
+	cls.Updater = ""
+	cls.CreateTime = ""
+	cls.UpdateTime = ""
+
+	return cls
+}
+
+// normalizeNodeGroup clean runtime, cloud generated and secret fields of nodeGroup
+func normalizeNodeGroup(group *cmproto.NodeGroup) *cmproto.NodeGroup {
+	group = pb.Clone(group).(*cmproto.NodeGroup)
+	group.Status = ""
+	group.CloudNodeGroupID = ""
+	group.Creator = ""
+	group.Updater = ""
+	group.CreateTime = ""
+	group.UpdateTime = ""
+	if group.AutoScaling != nil {
+		group.AutoScaling.AutoScalingID = ""
+		group.AutoScaling.AutoScalingName = ""
+	}
+	if group.LaunchTemplate != nil {
+		group.LaunchTemplate.LaunchConfigurationID = ""
+		group.LaunchTemplate.InitLoginPassword = ""
+		if group.LaunchTemplate.KeyPair != nil {
+			group.LaunchTemplate.KeyPair.KeySecret = ""
+		}
+	}
+	for i := range group.ScalingSchedules {
+		group.ScalingSchedules[i].LastRunTime = ""
+		group.ScalingSchedules[i].LastRunResult = ""
+		group.ScalingSchedules[i].LastTaskID = ""
+		group.ScalingSchedules[i].Message = ""
+	}
+
+	return group
+}
+
+// normalizeNodeTemplate clean runtime fields of nodeTemplate
+func normalizeNodeTemplate(template *cmproto.NodeTemplate) *cmproto.NodeTemplate {
+	template = pb.Clone(template).(*cmproto.NodeTemplate)
+	template.Creator = ""
+	template.Updater = ""
+	template.CreateTime = ""
+	template.UpdateTime = ""
+
+	return template
+}
+
+// normalizeAutoScalingOption clean runtime fields of autoscaling option
+func normalizeAutoScalingOption(option *cmproto.ClusterAutoScalingOption) *cmproto.ClusterAutoScalingOption {
+	option = pb.Clone(option).(*cmproto.ClusterAutoScalingOption)
+	option.Status = ""
+	option.ErrorMessage = ""
+	option.Creator = ""
+	option.Updater = ""
+	option.CreateTime = ""
+	option.UpdateTime = ""
+
+	return option
+}
+
+// normalizeNotifyTemplate clean runtime fields of notifyTemplate
+func normalizeNotifyTemplate(template *cmproto.NotifyTemplate) *cmproto.NotifyTemplate {
+	template = pb.Clone(template).(*cmproto.NotifyTemplate)
+	template.Creator = ""
+	template.Updater = ""
+	template.CreateTime = ""
+	template.UpdateTime = ""
+
+	return template
+}
+
+// isNodeGroupChanged compare nodeGroup ignoring desiredSize which is managed by autoscaler
+func isNodeGroupChanged(current, desired *cmproto.NodeGroup) bool {
+	current, desired = normalizeNodeGroup(current), normalizeNodeGroup(desired)
+	if current.AutoScaling != nil {
+		current.AutoScaling.DesiredSize = 0
+	}
+	if desired.AutoScaling != nil {
+		desired.AutoScaling.DesiredSize = 0
+	}
+
+	return !pb.Equal(current, desired)
+}
+
+// decodeScript nodeTemplate scripts are stored in base64 format, requests need raw script
+func decodeScript(script string) string {
+	if script == "" {
+		return ""
+	}
+	data, err := base64.StdEncoding.DecodeString(script)
+	if err != nil {
+		return script
+	}
+
+	return string(data)
+}
@@ -0,0 +1,182 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/utils"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+)
+
+// CancelAction task cancel
+type CancelAction struct {
+	ctx   context.Context
+	model store.ClusterManagerModel
+	req   *cmproto.CancelTaskRequest
+	resp  *cmproto.CancelTaskResponse
+
+	task    *cmproto.Task
+	cluster *cmproto.Cluster
+}
+
+// NewCancelAction create cancel action
+func NewCancelAction(model store.ClusterManagerModel) *CancelAction {
+	return &CancelAction{
+		model: model,
+	}
+}
+
+func (ca *CancelAction) setResp(code uint32, msg string) {
+	ca.resp.Code = code
+	ca.resp.Message = msg
+	ca.resp.Result = (code == common.BcsErrClusterManagerSuccess)
+}
+
+func (ca *CancelAction) getRelativeData() error {
+	task, err := ca.model.GetTask(ca.ctx, ca.req.TaskID)
+	if err != nil {
+		blog.Errorf("CancelTaskAction Task %s failed when cancel task, err %s", ca.req.TaskID, err.Error())
+		return err
+	}
+	cluster, err := ca.model.GetCluster(ca.ctx, task.ClusterID)
+	if err != nil {
+		blog.Errorf("CancelTaskAction %s failed: %v", ca.req.TaskID, err)
+		return err
+	}
+
+	ca.task = task
+	ca.cluster = cluster
+
+	return nil
+}
+
+func (ca *CancelAction) validate() error {
+	if err := ca.req.Validate(); err != nil {
+		return err
+	}
+	// check task status
+	switch ca.task.Status {
+	case cloudprovider.TaskStatusInit, cloudprovider.TaskStatusRunning:
+	case cloudprovider.TaskStatusFailure, cloudprovider.TaskStatusTimeout,
+		cloudprovider.TaskStatusCanceled, cloudprovider.TaskStatusRollbackFailure:
+		// terminated task only support rollback
+		if !ca.req.Rollback {
+			return fmt.Errorf("task[%s] status[%s] already terminated when cancel task",
+				ca.task.TaskID, ca.task.Status)
+		}
+	default:
+		return fmt.Errorf("task[%s] status[%s] done or rolling back when cancel task",
+			ca.task.TaskID, ca.task.Status)
+	}
+
+	return nil
+}
+
+// isStepRunning check task step is running, canceled task will stop after running step done
+func (ca *CancelAction) isStepRunning() bool {
+	for _, step := range ca.task.GetSteps() {
+		if step.Status == cloudprovider.TaskStatusRunning {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ca *CancelAction) cancelTask() error {
+	isRunning := ca.task.Status == cloudprovider.TaskStatusInit || ca.task.Status == cloudprovider.TaskStatusRunning
+
+	if ca.task.CommonParams == nil {
+		ca.task.CommonParams = make(map[string]string)
+	}
+	ca.task.CommonParams[cloudprovider.TaskRollbackKey.String()] = strconv.FormatBool(ca.req.Rollback)
+	if ca.req.Rollback && ca.req.DeleteMode != "" {
+		ca.task.CommonParams[cloudprovider.DeleteModeKey.String()] = ca.req.DeleteMode
+	}
+	if isRunning {
+		ca.task.Status = cloudprovider.TaskStatusCanceled
+		ca.task.Message = fmt.Sprintf("task canceled by %s", ca.req.Updater)
+	}
+	ca.task.Updater = ca.req.Updater
+	ca.task.LastUpdate = time.Now().Format(time.RFC3339)
+
+	err := ca.model.UpdateTask(ca.ctx, ca.task)
+	if err != nil {
+		blog.Errorf("CancelTaskAction[%s] updateTask failed: %v", ca.cluster.ClusterID, err)
+		return err
+	}
+
+	// running step will stop task and rollback it after step done
+	if isRunning && ca.isStepRunning() {
+		blog.Infof("cancel cluster[%s] task[%s] wait running step done", ca.task.ClusterID, ca.task.TaskID)
+		return nil
+	}
+
+	if isRunning {
+		err = cloudprovider.NewJobSyncResult(ca.task).UpdateJobResultStatus(false)
+		if err != nil {
+			blog.Errorf("CancelTaskAction[%s] UpdateJobResultStatus failed: %v", ca.task.TaskID, err)
+		}
+	}
+	if ca.req.Rollback {
+		if err = cloudprovider.RollbackTask(ca.task); err != nil {
+			blog.Errorf("rollback task[%s] for cluster %s failed, %s", ca.req.TaskID, ca.task.ClusterID, err.Error())
+			return err
+		}
+	}
+	blog.Infof("cancel cluster[%s] task[%s] type %s successfully", ca.task.ClusterID, ca.task.TaskID, ca.task.TaskType)
+
+	return nil
+}
+
+// Handle handle cancel task action
+func (ca *CancelAction) Handle(
+	ctx context.Context, req *cmproto.CancelTaskRequest, resp *cmproto.CancelTaskResponse) {
+
+	if req == nil || resp == nil {
+		blog.Errorf("cancel task failed, req or resp is empty")
+		return
+	}
+	ca.ctx = ctx
+	ca.req = req
+	ca.resp = resp
+
+	if err := ca.getRelativeData(); err != nil {
+		ca.setResp(common.BcsErrClusterManagerDBOperation, err.Error())
+		return
+	}
+
+	if err := ca.validate(); err != nil {
+		ca.setResp(common.BcsErrClusterManagerInvalidParameter, err.Error())
+		return
+	}
+
+	if err := ca.cancelTask(); err != nil {
+		ca.setResp(common.BcsErrClusterManagerTaskErr, err.Error())
+		return
+	}
+
+	utils.HandleTaskStepData(ca.ctx, ca.task)
+	ca.resp.Data = ca.task
+
+	ca.setResp(common.BcsErrClusterManagerSuccess, common.BcsErrClusterManagerSuccessStr)
+}
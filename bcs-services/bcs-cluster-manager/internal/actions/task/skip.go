@@ -15,6 +15,7 @@ package task
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 
@@ -105,6 +106,12 @@ func (sa *SkipAction) distributeTask() error {
 	// update current step status SKIP
 	sa.task.Steps[sa.task.GetCurrentStep()].Status = cloudprovider.TaskStatusSkip
 
+	// dag task only skip failed branch step, no steps ready to dispatch when other branch steps
+	// running, blocked by other failed branch step or all done
+	if cloudprovider.IsDAGTask(sa.task) && len(cloudprovider.GetTaskReadySteps(sa.task)) == 0 {
+		return sa.updateDAGTask()
+	}
+
 	err := sa.model.UpdateTask(sa.ctx, sa.task)
 	if err != nil {
 		blog.Errorf("SkipTaskAction[%s] updateTask failed: %v", sa.cluster.ClusterID, err)
@@ -122,6 +129,31 @@ func (sa *SkipAction) distributeTask() error {
 	return nil
 }
 
+// updateDAGTask update dag task state when no steps ready after skipping branch step
+func (sa *SkipAction) updateDAGTask() error {
+	if blocked := cloudprovider.GetDAGBlockedStep(sa.task); blocked != "" {
+		sa.task.Status = cloudprovider.TaskStatusFailure
+		sa.task.CurrentStep = blocked
+		sa.task.Message = fmt.Sprintf("step %s running failed", blocked)
+	} else if cloudprovider.IsDAGTaskDone(sa.task) {
+		sa.task.Status = cloudprovider.TaskStatusSuccess
+		sa.task.Message = "whole task is done"
+		sa.task.End = time.Now().Format(time.RFC3339)
+	}
+
+	if err := sa.model.UpdateTask(sa.ctx, sa.task); err != nil {
+		blog.Errorf("SkipTaskAction[%s] updateTask failed: %v", sa.cluster.ClusterID, err)
+		return err
+	}
+	blog.Infof("skip cluster[%s] dag task[%s] step, task status %s", sa.task.ClusterID, sa.task.TaskID,
+		sa.task.Status)
+
+	utils.HandleTaskStepData(sa.ctx, sa.task)
+
+	sa.resp.Data = sa.task
+	return nil
+}
+
 // Handle handle skip task action
 func (sa *SkipAction) Handle(
 	ctx context.Context, req *cmproto.SkipTaskRequest, resp *cmproto.SkipTaskResponse) {
@@ -171,6 +171,14 @@ func (ua *RetryAction) distributeTask() error {
 	ua.task.Status = cloudprovider.TaskStatusRunning
 	ua.task.Message = "task retrying"
 
+	// dag task only retry failed branch step, other branch steps keep their state
+	if cloudprovider.IsDAGTask(ua.task) {
+		if step, ok := ua.task.Steps[ua.task.GetCurrentStep()]; ok && step.Status == cloudprovider.TaskStatusFailure {
+			step.Status = cloudprovider.TaskStatusNotStarted
+			step.Message = "step retrying"
+		}
+	}
+
 	err := ua.model.UpdateTask(ua.ctx, ua.task)
 	if err != nil {
 		blog.Errorf("RetryTaskAction[%s] updateTask failed: %v", ua.cluster.ClusterID, err)
@@ -68,8 +68,10 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
 	etcdlock "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock/etcd"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/alertmanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/bkmonitor"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/tmp"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/webhook"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/audit"
 	ssmAuth "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/auth"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/cidrmanager"
@@ -262,6 +264,7 @@ func (cm *ClusterManager) initModel() error {
 // init task server
 func (cm *ClusterManager) initTaskServer() error {
 	cloudprovider.InitStorageModel(cm.model)
+	cloudprovider.InitTaskLocker(cm.locker)
 	// get taskserver and init
 	taskMgr := taskserver.GetTaskServer()
 
@@ -409,6 +412,31 @@ func (cm *ClusterManager) initAlarmClient() error {
 		return err
 	}
 
+	// alertmanager client
+	err = alertmanager.SetAlertManagerClient(alertmanager.Options{
+		Enable:   cm.opt.Alarm.AlertManager.Enable,
+		Server:   cm.opt.Alarm.AlertManager.Server,
+		Token:    cm.opt.Alarm.AlertManager.Token,
+		IPLabel:  cm.opt.Alarm.AlertManager.IPLabel,
+		Duration: time.Duration(cm.opt.Alarm.AlertManager.DurationMinutes) * time.Minute,
+		Debug:    cm.opt.Alarm.AlertManager.Debug,
+	})
+	if err != nil {
+		return err
+	}
+
+	// generic webhook client
+	err = webhook.SetWebhookClient(webhook.Options{
+		Enable:   cm.opt.Alarm.Webhook.Enable,
+		Server:   cm.opt.Alarm.Webhook.Server,
+		Token:    cm.opt.Alarm.Webhook.Token,
+		Duration: time.Duration(cm.opt.Alarm.Webhook.DurationMinutes) * time.Minute,
+		Debug:    cm.opt.Alarm.Webhook.Debug,
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -631,7 +659,7 @@ func (cm *ClusterManager) initK8SOperator() {
 
 // init daemon
 func (cm *ClusterManager) initDaemon() {
-	cm.daemon = daemon.NewDaemon(0, cm.model)
+	cm.daemon = daemon.NewDaemon(0, cm.model, cm.locker)
 }
 
 // initRegistry etcd registry
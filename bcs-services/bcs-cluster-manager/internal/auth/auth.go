@@ -23,6 +23,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 	"github.com/Tencent/bk-bcs/bcs-services/pkg/bcs-auth/cloudaccount"
 	"github.com/Tencent/bk-bcs/bcs-services/pkg/bcs-auth/cluster"
+	middleauth "github.com/Tencent/bk-bcs/bcs-services/pkg/bcs-auth/middleware"
 	"github.com/Tencent/bk-bcs/bcs-services/pkg/bcs-auth/project"
 	authutils "github.com/Tencent/bk-bcs/bcs-services/pkg/bcs-auth/utils"
 	"go-micro.dev/v4/server"
@@ -55,11 +56,15 @@ func enableAuth() bool {
 
 // SkipClient skip client
 func SkipClient(ctx context.Context, req server.Request, client string) bool {
+	return clientAllowed(client, req.Method())
+}
+
+func clientAllowed(client, method string) bool {
 	if len(client) == 0 {
 		return false
 	}
 	for _, v := range ClientPermissions[client] {
-		if strings.HasPrefix(v, "*") || v == req.Method() {
+		if strings.HasPrefix(v, "*") || v == method {
 			return true
 		}
 	}
@@ -169,6 +174,52 @@ func CheckUserPerm(ctx context.Context, req server.Request, username string) (bo
 	return allow, nil
 }
 
+// CheckResourcePerm check permission of method on resource inside action, used by actions which
+// operate other resources on behalf of user, e.g. resource bundle creates clusters and nodeGroups.
+// it follows the same rules as request authorization: inner and exempt clients are allowed
+func CheckResourcePerm(ctx context.Context, method, projectID, clusterID string) error {
+	if !enableAuth() {
+		return nil
+	}
+	authUser, err := middleauth.GetUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if authUser.IsInner() || clientAllowed(authUser.ClientName, method) {
+		return nil
+	}
+	if len(authUser.Username) == 0 {
+		return errors.New("username is empty")
+	}
+
+	action, ok := ActionPermissions[method]
+	if !ok {
+		return fmt.Errorf("operation %s has not authorized", method)
+	}
+	allow, url, resources, err := callIAM(authUser.Username, action, resourceID{
+		ProjectID: projectID,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return err
+	}
+	blog.Infof("CheckResourcePerm user[%s] method[%s] project[%s] cluster[%s] allow[%v]",
+		authUser.Username, method, projectID, clusterID, allow)
+	if allow {
+		return nil
+	}
+	if url != "" && resources != nil {
+		return &authutils.PermDeniedError{
+			Perms: authutils.PermData{
+				ApplyURL:   url,
+				ActionList: resources,
+			},
+		}
+	}
+
+	return fmt.Errorf("user %s not authorized to %s", authUser.Username, method)
+}
+
 func callIAM(username, action string, resourceID resourceID) (bool, string, []authutils.ResourceAction, error) {
 	// related actions
 	switch action {
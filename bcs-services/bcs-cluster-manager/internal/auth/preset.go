@@ -121,6 +121,13 @@ var ActionPermissions = map[string]string{
 	"ClusterManager.ImportCluster":             cluster.CanCreateClusterOperation,
 	"ClusterManager.UpdateCluster":             cluster.CanManageClusterOperation,
 	"ClusterManager.DeleteCluster":             cluster.CanDeleteClusterOperation,
+	"ClusterManager.UpgradeCluster":            cluster.CanManageClusterOperation,
+	"ClusterManager.ListClusterDrift":          cluster.CanViewClusterOperation,
+	"ClusterManager.GetClusterCost":            cluster.CanViewClusterOperation,
+	"ClusterManager.EvaluateNodeGroupCost":     cluster.CanViewClusterOperation,
+	"ClusterManager.GetProjectCost":            project.CanViewProjectOperation,
+	"ClusterManager.ExportProjectResources":    project.CanViewProjectOperation,
+	"ClusterManager.ApplyProjectResources":     project.CanEditProjectOperation,
 	"ClusterManager.GetCluster":                cluster.CanViewClusterOperation,
 	"ClusterManager.ListProjectCluster":        project.CanViewProjectOperation,
 	"ClusterManager.ListCluster":               project.CanViewProjectOperation,
@@ -174,6 +181,7 @@ var ActionPermissions = map[string]string{
 	"ClusterManager.ListNodesInGroupV2":   cluster.CanViewClusterOperation,
 
 	"ClusterManager.UpdateGroupDesiredNode":         cluster.CanManageClusterOperation,
+	"ClusterManager.RolloutNodeGroup":               cluster.CanManageClusterOperation,
 	"ClusterManager.UpdateGroupDesiredSize":         cluster.CanManageClusterOperation,
 	"ClusterManager.UpdateGroupMinMaxSize":          cluster.CanManageClusterOperation,
 	"ClusterManager.EnableNodeGroupAutoScale":       cluster.CanManageClusterOperation,
@@ -203,6 +211,7 @@ var ActionPermissions = map[string]string{
 	// task
 	"ClusterManager.CreateTask": cluster.CanManageClusterOperation,
 	"ClusterManager.RetryTask":  cluster.CanManageClusterOperation,
+	"ClusterManager.CancelTask": cluster.CanManageClusterOperation,
 	"ClusterManager.UpdateTask": cluster.CanManageClusterOperation,
 	"ClusterManager.DeleteTask": cluster.CanManageClusterOperation,
 	"ClusterManager.GetTask":    cluster.CanViewClusterOperation,
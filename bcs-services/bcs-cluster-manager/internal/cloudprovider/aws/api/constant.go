@@ -29,6 +29,15 @@ const (
 	NodeGroupStatusDegraded = "DEGRADED"
 )
 
+const (
+	// ClusterStatusActive cluster status active
+	ClusterStatusActive = "ACTIVE"
+	// ClusterStatusUpdating cluster status updating
+	ClusterStatusUpdating = "UPDATING"
+	// ClusterStatusFailed cluster status failed
+	ClusterStatusFailed = "FAILED"
+)
+
 const (
 	// InstanceLifecycleStateInService instance life cycle state InService
 	InstanceLifecycleStateInService = "InService"
@@ -127,6 +127,51 @@ func (cli *EksClient) UpdateNodegroupConfig(input *eks.UpdateNodegroupConfigInpu
 	return output.Update, nil
 }
 
+// UpdateClusterVersion updates eks cluster control plane kubernetes version
+func (cli *EksClient) UpdateClusterVersion(clusterName, version string) (*eks.Update, error) {
+	blog.Infof("UpdateClusterVersion cluster[%s] version[%s]", clusterName, version)
+	if cli == nil {
+		return nil, cloudprovider.ErrServerIsNil
+	}
+	output, err := cli.eksClient.UpdateClusterVersion(&eks.UpdateClusterVersionInput{
+		Name:    aws.String(clusterName),
+		Version: aws.String(version),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.Update == nil {
+		blog.Errorf("UpdateClusterVersion resp is nil")
+		return nil, fmt.Errorf("UpdateClusterVersion resp is nil")
+	}
+	blog.Infof("UpdateClusterVersion[%s] successful, update id %s", clusterName, *output.Update.Id)
+
+	return output.Update, nil
+}
+
+// UpdateNodegroupVersion updates eks node group kubernetes version
+func (cli *EksClient) UpdateNodegroupVersion(clusterName, ngName, version string) (*eks.Update, error) {
+	blog.Infof("UpdateNodegroupVersion cluster[%s] nodegroup[%s] version[%s]", clusterName, ngName, version)
+	if cli == nil {
+		return nil, cloudprovider.ErrServerIsNil
+	}
+	output, err := cli.eksClient.UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(ngName),
+		Version:       aws.String(version),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.Update == nil {
+		blog.Errorf("UpdateNodegroupVersion resp is nil")
+		return nil, fmt.Errorf("UpdateNodegroupVersion resp is nil")
+	}
+	blog.Infof("UpdateNodegroupVersion[%s] successful, update id %s", ngName, *output.Update.Id)
+
+	return output.Update, nil
+}
+
 // DeleteNodegroup deletes a eks node group
 func (cli *EksClient) DeleteNodegroup(input *eks.DeleteNodegroupInput) (*eks.Nodegroup, error) {
 	blog.Infof("DeleteNodegroup request: %s", utils.ToJSONString(input))
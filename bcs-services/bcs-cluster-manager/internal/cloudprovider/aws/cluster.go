@@ -89,6 +89,37 @@ func (c *Cluster) DeleteCluster(cls *proto.Cluster, opt *cloudprovider.DeleteClu
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("%s UpgradeCluster cluster is empty", cloudName)
+	}
+
+	if opt == nil || opt.Account == nil || len(opt.Account.SecretID) == 0 ||
+		len(opt.Account.SecretKey) == 0 || len(opt.Region) == 0 || opt.Cloud == nil {
+		return nil, fmt.Errorf("%s UpgradeCluster cluster lost option", cloudName)
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when UpgradeCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build upgrade cluster task
+	task, err := mgr.BuildUpgradeClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build UpgradeCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
 // GetCluster get kubenretes cluster detail information according cloudprovider
 func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*proto.Cluster, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
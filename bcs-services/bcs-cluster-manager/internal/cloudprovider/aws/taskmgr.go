@@ -44,6 +44,11 @@ func newtask() *Task {
 	task.works[importClusterNodesStep.StepMethod] = tasks.ImportClusterNodesTask
 	task.works[registerClusterKubeConfigStep.StepMethod] = tasks.RegisterClusterKubeConfigTask
 
+	// upgrade cluster task
+	task.works[upgradeEKSClusterMasterStep.StepMethod] = tasks.UpgradeEKSClusterMasterTask
+	task.works[checkEKSClusterUpgradeStatusStep.StepMethod] = tasks.CheckEKSClusterUpgradeStatusTask
+	task.works[upgradeEKSNodeGroupStep.StepMethod] = tasks.UpgradeEKSNodeGroupTask
+
 	// create nodeGroup task
 	task.works[createCloudNodeGroupStep.StepMethod] = tasks.CreateCloudNodeGroupTask
 	task.works[checkCloudNodeGroupStatusStep.StepMethod] = tasks.CheckCloudNodeGroupStatusTask
@@ -154,6 +159,74 @@ func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.Del
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeClusterTask build upgradeCluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (
+	*proto.Task, error) {
+	// upgrade cluster has four steps:
+	// 1. call aws UpdateClusterVersion to upgrade eks cluster control plane
+	// 2. check cluster control plane upgrade status
+	// 3. drain/upgrade/unCordon nodeGroup nodes one by one
+	// 4. update cluster version in DB when upgrade successful
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil || opt.Version == "" {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask TaskOptions is lost")
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeCluster),
+		TaskName:       cloudprovider.UpgradeClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	taskName := fmt.Sprintf(upgradeClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+	task.CommonParams[cloudprovider.UserKey.String()] = opt.Operator
+
+	// setting all steps details
+	upgradeClusterTask := &UpgradeClusterTaskOption{
+		Cluster:        cls,
+		Version:        opt.Version,
+		NodeGroups:     opt.NodeGroups,
+		NodeGroupNodes: opt.NodeGroupNodes,
+		DrainTimeout:   opt.DrainTimeout,
+	}
+	// step1: upgrade eks cluster control plane
+	upgradeClusterTask.BuildUpgradeMasterStep(task)
+	// step2: check cluster control plane upgrade status
+	upgradeClusterTask.BuildCheckUpgradeStatusStep(task)
+	// step3: drain and upgrade nodeGroups one by one
+	upgradeClusterTask.BuildUpgradeNodeGroupsStep(task)
+	// step4: update cluster version
+	upgradeClusterTask.BuildUpdateClusterVersionStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeClusterJob.String()
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+
+	return task, nil
+}
+
 // BuildAddNodesToClusterTask build addNodes task
 func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node,
 	opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
@@ -0,0 +1,349 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/aws/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+// UpgradeEKSClusterMasterTask upgrade eks cluster control plane version
+func UpgradeEKSClusterMasterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeEKSClusterMasterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeEKSClusterMasterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeEKSClusterMasterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	eksVersion, err := getEksVersion(version)
+	if err != nil {
+		blog.Errorf("UpgradeEKSClusterMasterTask[%s]: getEksVersion[%s] failed: %v", taskID, version, err)
+		retErr := fmt.Errorf("getEksVersion failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	client, err := api.NewEksClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpgradeEKSClusterMasterTask[%s]: get eks client failed: %v", taskID, err)
+		retErr := fmt.Errorf("get cloud eks client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// control plane version maybe upgraded when retry task
+	eksCluster, err := client.GetEksCluster(dependInfo.Cluster.SystemID)
+	if err == nil && eksCluster.Version != nil && utils.IsKubeVersionAtLeast(*eksCluster.Version, eksVersion) {
+		blog.Infof("UpgradeEKSClusterMasterTask[%s]: cluster[%s] version[%s] already upgraded",
+			taskID, clusterID, *eksCluster.Version)
+	} else {
+		_, err = client.UpdateClusterVersion(dependInfo.Cluster.SystemID, eksVersion)
+		if err != nil {
+			blog.Errorf("UpgradeEKSClusterMasterTask[%s]: UpdateClusterVersion[%s] failed: %v",
+				taskID, clusterID, err)
+			retErr := fmt.Errorf("call aws UpdateClusterVersion failed: %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	blog.Infof("UpgradeEKSClusterMasterTask[%s]: cluster[%s] upgrade master to version[%s] successful",
+		taskID, clusterID, eksVersion)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeEKSClusterMasterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckEKSClusterUpgradeStatusTask check eks cluster control plane upgrade status
+func CheckEKSClusterUpgradeStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckEKSClusterUpgradeStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckEKSClusterUpgradeStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckEKSClusterUpgradeStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = checkClusterUpgradeStatus(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("CheckEKSClusterUpgradeStatusTask[%s] checkClusterUpgradeStatus[%s] failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("checkClusterUpgradeStatus[%s] failed: %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckEKSClusterUpgradeStatusTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterUpgradeStatus wait cluster active and control plane version upgraded
+func checkClusterUpgradeStatus(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	client, err := api.NewEksClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] get eks client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get cloud eks client err, %s", err.Error())
+	}
+
+	var (
+		failed = false
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Minute)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		cluster, errGet := client.GetEksCluster(info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("checkClusterUpgradeStatus[%s] GetEksCluster failed: %v", taskID, errGet)
+			return nil
+		}
+		if cluster == nil || cluster.Status == nil || cluster.Version == nil {
+			return nil
+		}
+
+		blog.Infof("checkClusterUpgradeStatus[%s] cluster[%s] current status[%s] version[%s]", taskID,
+			info.Cluster.ClusterID, *cluster.Status, *cluster.Version)
+
+		switch *cluster.Status {
+		case api.ClusterStatusActive:
+			if utils.IsKubeVersionAtLeast(*cluster.Version, version) {
+				return loop.EndLoop
+			}
+		case api.ClusterStatusFailed:
+			failed = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] cluster[%s] failed: %v", taskID, info.Cluster.ClusterID, err)
+		return err
+	}
+
+	if failed {
+		return fmt.Errorf("cluster[%s] status failed", info.Cluster.ClusterID)
+	}
+
+	return nil
+}
+
+// UpgradeEKSNodeGroupTask upgrade eks nodeGroup version, eks replace nodes by rolling update
+func UpgradeEKSNodeGroupTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeEKSNodeGroupTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeEKSNodeGroupTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeEKSNodeGroupTask[%s]: GetClusterDependBasicInfo for nodeGroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeNodeGroupVersion(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("UpgradeEKSNodeGroupTask[%s] upgradeNodeGroupVersion[%s] failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("upgradeNodeGroupVersion[%s] failed: %s", nodeGroupID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeEKSNodeGroupTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// upgradeNodeGroupVersion call eks UpdateNodegroupVersion and wait nodeGroup active
+func upgradeNodeGroupVersion(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+	cluster, group := info.Cluster, info.NodeGroup
+
+	eksVersion, err := getEksVersion(version)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewEksClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("upgradeNodeGroupVersion[%s] get eks client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get cloud eks client err, %s", err.Error())
+	}
+
+	// nodeGroup version maybe upgraded when retry task
+	ng, err := client.DescribeNodegroup(&group.CloudNodeGroupID, &cluster.SystemID)
+	if err != nil {
+		blog.Errorf("upgradeNodeGroupVersion[%s] DescribeNodegroup[%s] failed: %v", taskID,
+			group.CloudNodeGroupID, err)
+		return err
+	}
+	if ng.Version == nil || !utils.IsKubeVersionAtLeast(*ng.Version, eksVersion) {
+		_, err = client.UpdateNodegroupVersion(cluster.SystemID, group.CloudNodeGroupID, eksVersion)
+		if err != nil {
+			blog.Errorf("upgradeNodeGroupVersion[%s] UpdateNodegroupVersion[%s] failed: %v", taskID,
+				group.CloudNodeGroupID, err)
+			return err
+		}
+	}
+
+	var (
+		degraded = false
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Hour)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		ng, desErr := client.DescribeNodegroup(&group.CloudNodeGroupID, &cluster.SystemID)
+		if desErr != nil {
+			blog.Errorf("upgradeNodeGroupVersion[%s] DescribeNodegroup[%s] failed: %v", taskID,
+				group.CloudNodeGroupID, desErr)
+			return nil
+		}
+		if ng.Status == nil || ng.Version == nil {
+			return nil
+		}
+
+		blog.Infof("upgradeNodeGroupVersion[%s] nodeGroup[%s] current status[%s] version[%s]", taskID,
+			group.NodeGroupID, *ng.Status, *ng.Version)
+
+		switch *ng.Status {
+		case api.NodeGroupStatusActive:
+			if utils.IsKubeVersionAtLeast(*ng.Version, eksVersion) {
+				return loop.EndLoop
+			}
+		case api.NodeGroupStatusDegraded:
+			degraded = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("upgradeNodeGroupVersion[%s] nodeGroup[%s] failed: %v", taskID, group.NodeGroupID, err)
+		return err
+	}
+
+	if degraded {
+		return fmt.Errorf("nodeGroup[%s] status degraded", group.NodeGroupID)
+	}
+
+	return nil
+}
+
+// getEksVersion eks only support major.minor kubernetes version
+func getEksVersion(version string) (string, error) {
+	v, err := utils.ParseKubeVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d", v.Major(), v.Minor()), nil
+}
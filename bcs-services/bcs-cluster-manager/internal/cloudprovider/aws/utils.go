@@ -19,6 +19,7 @@ import (
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
 )
 
 var (
@@ -32,6 +33,8 @@ const (
 
 	// deleteClusterTaskTemplate bk-sops add task template
 	deleteClusterTaskTemplate = "aws-delete cluster: %s" // nolint
+	// upgradeClusterTaskTemplate bk-sops add task template
+	upgradeClusterTaskTemplate = "aws-upgrade cluster: %s"
 	// awsAddNodeTaskTemplate bk-sops add task template
 	awsAddNodeTaskTemplate = "aws-add node: %s" // nolint
 	// awsCleanNodeTaskTemplate bk-sops add task template
@@ -66,6 +69,20 @@ var (
 		StepName:   "注册集群kubeConfig认证",
 	}
 
+	// upgrade cluster task
+	upgradeEKSClusterMasterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeEKSClusterMasterTask", cloudName),
+		StepName:   "升级集群控制面版本",
+	}
+	checkEKSClusterUpgradeStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckEKSClusterUpgradeStatusTask", cloudName),
+		StepName:   "检测集群升级状态",
+	}
+	upgradeEKSNodeGroupStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeEKSNodeGroupTask", cloudName),
+		StepName:   "升级节点组版本",
+	}
+
 	// create nodeGroup task
 	createCloudNodeGroupStep = cloudprovider.StepInfo{
 		StepMethod: fmt.Sprintf("%s-CreateCloudNodeGroupTask", cloudName),
@@ -136,6 +153,66 @@ func (ic *ImportClusterTaskOption) BuildImportClusterNodesStep(task *proto.Task)
 	task.StepSequence = append(task.StepSequence, importClusterNodesStep.StepMethod)
 }
 
+// UpgradeClusterTaskOption 升级集群
+type UpgradeClusterTaskOption struct {
+	Cluster        *proto.Cluster
+	Version        string
+	NodeGroups     []*proto.NodeGroup
+	NodeGroupNodes map[string][]*proto.Node
+	DrainTimeout   uint32
+}
+
+// BuildUpgradeMasterStep 升级集群控制面版本
+func (uc *UpgradeClusterTaskOption) BuildUpgradeMasterStep(task *proto.Task) {
+	upgradeStep := cloudprovider.InitTaskStep(upgradeEKSClusterMasterStep)
+	upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[upgradeEKSClusterMasterStep.StepMethod] = upgradeStep
+	task.StepSequence = append(task.StepSequence, upgradeEKSClusterMasterStep.StepMethod)
+}
+
+// BuildCheckUpgradeStatusStep 检测集群升级状态
+func (uc *UpgradeClusterTaskOption) BuildCheckUpgradeStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkEKSClusterUpgradeStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[checkEKSClusterUpgradeStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkEKSClusterUpgradeStatusStep.StepMethod)
+}
+
+// BuildUpgradeNodeGroupsStep 节点组依次排水/升级/恢复调度
+func (uc *UpgradeClusterTaskOption) BuildUpgradeNodeGroupsStep(task *proto.Task) {
+	for _, group := range uc.NodeGroups {
+		nodeIPs := make([]string, 0)
+		for _, node := range uc.NodeGroupNodes[group.NodeGroupID] {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+		}
+
+		common.BuildDrainNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs, uc.DrainTimeout)
+
+		upgradeStep := cloudprovider.InitTaskStep(upgradeEKSNodeGroupStep)
+		stepName := common.GetNodeGroupStepName(upgradeEKSNodeGroupStep.StepMethod, group.NodeGroupID)
+		upgradeStep.Name = stepName
+		upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+		upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+		upgradeStep.Params[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+		upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+		task.Steps[stepName] = upgradeStep
+		task.StepSequence = append(task.StepSequence, stepName)
+
+		common.BuildUnCordonGroupNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs)
+	}
+}
+
+// BuildUpdateClusterVersionStep 更新集群版本数据
+func (uc *UpgradeClusterTaskOption) BuildUpdateClusterVersionStep(task *proto.Task) {
+	common.BuildUpdateClusterVersionTaskStep(task, uc.Cluster.ClusterID, uc.Version)
+}
+
 // CreateNodeGroupTaskOption 创建节点组
 type CreateNodeGroupTaskOption struct {
 	Group *proto.NodeGroup
@@ -100,6 +100,40 @@ func (c *CloudValidate) ImportClusterValidate(req *proto.ImportClusterReq, opt *
 	return nil
 }
 
+// UpgradeClusterValidate check upgradeCluster operation
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || cls == nil || req == nil || opt == nil {
+		return fmt.Errorf("%s UpgradeClusterValidate request&options is empty", cloudName)
+	}
+
+	if opt.Account == nil || len(opt.Account.SecretID) == 0 || len(opt.Account.SecretKey) == 0 || len(opt.Region) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate opt lost valid crendential info", cloudName)
+	}
+
+	if len(cls.SystemID) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate cluster[%s] systemID empty", cloudName, cls.ClusterID)
+	}
+
+	// check version skew
+	err := cloudprovider.CheckClusterUpgradeVersion(cls, req.Version)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate failed: %v", cloudName, err)
+	}
+
+	// check eks cluster exist
+	cli, err := api.NewEksClient(opt)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate getEksClient failed: %v", cloudName, err)
+	}
+	_, err = cli.GetEksCluster(cls.SystemID)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate GetEksCluster[%s] failed: %v", cloudName, cls.SystemID, err)
+	}
+
+	return nil
+}
+
 // ImportCloudAccountValidate create cloudAccount account validation
 func (c *CloudValidate) ImportCloudAccountValidate(account *proto.Account) error {
 	// call aws interface to check account
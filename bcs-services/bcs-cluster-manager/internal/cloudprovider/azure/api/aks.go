@@ -17,6 +17,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
@@ -243,6 +244,32 @@ func (aks *AksServiceImpl) DeleteClusterWithName(ctx context.Context, resourceGr
 	return nil
 }
 
+// UpgradeClusterWithName 升级集群控制面版本(仅提交请求, 不等待升级完成)
+//
+// resourceGroupName - 资源组名称(Account.resourceGroupName)
+//
+// resourceName - K8S名称(Cluster.SystemID).
+//
+// version - 目标kubernetes版本
+func (aks *AksServiceImpl) UpgradeClusterWithName(ctx context.Context, resourceGroupName, resourceName,
+	version string) error {
+	cluster, err := aks.GetClusterWithName(ctx, resourceGroupName, resourceName)
+	if err != nil {
+		return errors.Wrapf(err, "call GetClusterWithName failed")
+	}
+	if cluster.Properties == nil {
+		return errors.Errorf("cluster %s properties is empty", resourceName)
+	}
+	// 仅修改控制面版本, 节点池的 orchestratorVersion 保持不变
+	cluster.Properties.KubernetesVersion = to.Ptr(version)
+	_, err = aks.clustersClient.BeginCreateOrUpdate(ctx, resourceGroupName, resourceName, *cluster, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to finish the request, resourcesGroupName: %s, cluster name: %s",
+			resourceGroupName, resourceName)
+	}
+	return nil
+}
+
 // GetClusterAdminCredentials 获取集群管理凭证
 //
 // resourceGroupName - 资源组名称(Account.resourceGroupName)
@@ -93,6 +93,15 @@ type ClusterService interface {
 	// resourceName - K8S名称(Cluster.SystemID).
 	DeleteClusterWithName(ctx context.Context, resourceGroupName, resourceName string) error
 
+	// UpgradeClusterWithName 升级集群控制面版本(仅提交请求, 不等待升级完成)
+	//
+	// resourceGroupName - 资源组名称(Account.resourceGroupName)
+	//
+	// resourceName - K8S名称(Cluster.SystemID).
+	//
+	// version - 目标kubernetes版本
+	UpgradeClusterWithName(ctx context.Context, resourceGroupName, resourceName, version string) error
+
 	// GetClusterAdminCredentials 获取集群管理凭证
 	//
 	// resourceGroupName - 资源组名称(Account.resourceGroupName)
@@ -36,6 +36,10 @@ const (
 	UpdatingState = "Updating"
 	// ScalingState 扩缩容中
 	ScalingState = "Scaling"
+	// UpgradingState 升级中
+	UpgradingState = "Upgrading"
+	// FailedState 失败
+	FailedState = "Failed"
 )
 
 // 频率
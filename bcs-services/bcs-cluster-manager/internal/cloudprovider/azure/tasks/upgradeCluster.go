@@ -0,0 +1,326 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/pkg/errors"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/azure/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+// UpgradeAKSClusterMasterTask 升级集群控制面版本 - upgrade aks cluster control plane version
+func UpgradeAKSClusterMasterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeAKSClusterMasterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeAKSClusterMasterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeAKSClusterMasterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeClusterMaster(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("UpgradeAKSClusterMasterTask[%s]: upgradeClusterMaster[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("upgradeClusterMaster[%s] failed: %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("UpgradeAKSClusterMasterTask[%s]: cluster[%s] upgrade master to version[%s] successful",
+		taskID, clusterID, version)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeAKSClusterMasterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// upgradeClusterMaster 提交集群控制面升级请求, 已升级时跳过(重试场景)
+func upgradeClusterMaster(rootCtx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(rootCtx)
+	resourceGroup := cloudprovider.GetClusterResourceGroup(info.Cluster)
+
+	client, err := api.NewAksServiceImplWithCommonOption(info.CmOption)
+	if err != nil {
+		return errors.Wrapf(err, "call NewAksServiceImplWithCommonOption[%s] failed", taskID)
+	}
+
+	ctx, cancel := context.WithTimeout(rootCtx, 5*time.Minute)
+	defer cancel()
+
+	// control plane version maybe upgraded when retry task
+	cluster, err := client.GetClusterWithName(ctx, resourceGroup, info.Cluster.SystemID)
+	if err == nil && cluster.Properties != nil && cluster.Properties.KubernetesVersion != nil &&
+		utils.IsKubeVersionAtLeast(*cluster.Properties.KubernetesVersion, version) {
+		blog.Infof("upgradeClusterMaster[%s]: cluster[%s] version[%s] already upgraded",
+			taskID, info.Cluster.ClusterID, *cluster.Properties.KubernetesVersion)
+		return nil
+	}
+
+	if err = client.UpgradeClusterWithName(ctx, resourceGroup, info.Cluster.SystemID, version); err != nil {
+		return errors.Wrapf(err, "upgradeClusterMaster[%s]: call UpgradeClusterWithName[%s] failed", taskID,
+			info.Cluster.SystemID)
+	}
+
+	return nil
+}
+
+// CheckAKSClusterUpgradeStatusTask 检测集群升级状态 - check aks cluster control plane upgrade status
+func CheckAKSClusterUpgradeStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckAKSClusterUpgradeStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckAKSClusterUpgradeStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckAKSClusterUpgradeStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = checkClusterUpgradeStatus(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("CheckAKSClusterUpgradeStatusTask[%s] checkClusterUpgradeStatus[%s] failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("checkClusterUpgradeStatus[%s] failed: %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckAKSClusterUpgradeStatusTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterUpgradeStatus 等待集群状态正常且控制面版本已升级
+func checkClusterUpgradeStatus(rootCtx context.Context, info *cloudprovider.CloudDependBasicInfo,
+	version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(rootCtx)
+	resourceGroup := cloudprovider.GetClusterResourceGroup(info.Cluster)
+
+	client, err := api.NewAksServiceImplWithCommonOption(info.CmOption)
+	if err != nil {
+		return errors.Wrapf(err, "call NewAksServiceImplWithCommonOption[%s] failed", taskID)
+	}
+
+	var (
+		failed = false
+	)
+
+	ctx, cancel := context.WithTimeout(rootCtx, 60*time.Minute)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		cluster, errGet := client.GetClusterWithName(ctx, resourceGroup, info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("checkClusterUpgradeStatus[%s] GetClusterWithName failed: %v", taskID, errGet)
+			return nil
+		}
+		if cluster == nil || cluster.Properties == nil || cluster.Properties.ProvisioningState == nil ||
+			cluster.Properties.KubernetesVersion == nil {
+			return nil
+		}
+
+		blog.Infof("checkClusterUpgradeStatus[%s] cluster[%s] current status[%s] version[%s]", taskID,
+			info.Cluster.ClusterID, *cluster.Properties.ProvisioningState, *cluster.Properties.KubernetesVersion)
+
+		switch *cluster.Properties.ProvisioningState {
+		case api.NormalState:
+			if utils.IsKubeVersionAtLeast(*cluster.Properties.KubernetesVersion, version) {
+				return loop.EndLoop
+			}
+		case api.FailedState:
+			failed = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] cluster[%s] failed: %v", taskID, info.Cluster.ClusterID, err)
+		return err
+	}
+
+	if failed {
+		return fmt.Errorf("cluster[%s] status failed", info.Cluster.ClusterID)
+	}
+
+	return nil
+}
+
+// UpgradeAKSAgentPoolTask 升级节点池版本 - upgrade aks agentPool version, aks replace nodes by rolling update
+func UpgradeAKSAgentPoolTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeAKSAgentPoolTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeAKSAgentPoolTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeAKSAgentPoolTask[%s]: GetClusterDependBasicInfo for nodeGroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeAgentPoolVersion(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("UpgradeAKSAgentPoolTask[%s] upgradeAgentPoolVersion[%s] failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("upgradeAgentPoolVersion[%s] failed: %s", nodeGroupID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeAKSAgentPoolTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// upgradeAgentPoolVersion 修改节点池 orchestratorVersion 并等待节点池滚动升级完成
+func upgradeAgentPoolVersion(rootCtx context.Context, info *cloudprovider.CloudDependBasicInfo,
+	version string) error {
+	var (
+		group         = info.NodeGroup
+		cluster       = info.Cluster
+		taskID        = cloudprovider.GetTaskIDFromContext(rootCtx)
+		resourceGroup = cloudprovider.GetClusterResourceGroup(info.Cluster)
+	)
+
+	client, err := api.NewAksServiceImplWithCommonOption(info.CmOption)
+	if err != nil {
+		return errors.Wrapf(err, "call NewAksServiceImplWithCommonOption[%s] failed", taskID)
+	}
+
+	ctx, cancel := context.WithTimeout(rootCtx, 2*time.Hour)
+	defer cancel()
+
+	pool, err := client.GetPoolAndReturn(ctx, resourceGroup, cluster.SystemID, group.CloudNodeGroupID)
+	if err != nil {
+		return errors.Wrapf(err, "upgradeAgentPoolVersion[%s]: call GetPoolAndReturn[%s][%s] failed", taskID,
+			cluster.SystemID, group.CloudNodeGroupID)
+	}
+	if pool.Properties == nil {
+		return fmt.Errorf("agentPool[%s] properties is empty", group.CloudNodeGroupID)
+	}
+
+	// agentPool version maybe upgraded when retry task
+	current := pool.Properties.CurrentOrchestratorVersion
+	if current != nil && utils.IsKubeVersionAtLeast(*current, version) {
+		blog.Infof("upgradeAgentPoolVersion[%s]: agentPool[%s] version[%s] already upgraded",
+			taskID, group.CloudNodeGroupID, *current)
+		return nil
+	}
+
+	// UpdatePoolAndReturn polls until the rolling upgrade done
+	pool.Properties.OrchestratorVersion = to.Ptr(version)
+	pool, err = client.UpdatePoolAndReturn(ctx, pool, resourceGroup, cluster.SystemID, group.CloudNodeGroupID)
+	if err != nil {
+		return errors.Wrapf(err, "upgradeAgentPoolVersion[%s]: call UpdatePoolAndReturn[%s][%s] failed", taskID,
+			cluster.SystemID, group.CloudNodeGroupID)
+	}
+
+	if pool.Properties == nil || pool.Properties.ProvisioningState == nil ||
+		*pool.Properties.ProvisioningState != api.NormalState {
+		return fmt.Errorf("agentPool[%s] upgrade status abnormal", group.CloudNodeGroupID)
+	}
+	blog.Infof("upgradeAgentPoolVersion[%s]: agentPool[%s] upgrade to version[%s] successful",
+		taskID, group.CloudNodeGroupID, version)
+
+	return nil
+}
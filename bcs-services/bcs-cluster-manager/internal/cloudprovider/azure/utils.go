@@ -19,6 +19,7 @@ import (
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
 )
 
 var (
@@ -29,6 +30,8 @@ var (
 const (
 	// deleteClusterTaskTemplate bk-sops add task template
 	deleteClusterTaskTemplate = "aks-delete cluster: %s"
+	// upgradeClusterTaskTemplate bk-sops add task template
+	upgradeClusterTaskTemplate = "aks-upgrade cluster: %s"
 	// createNodeGroupTaskTemplate bk-sops add task template
 	createNodeGroupTaskTemplate = "aks-create node group: %s/%s"
 	// switchNodeGroupAutoScalingTaskTemplate bk-sops add task template
@@ -67,6 +70,20 @@ var (
 		StepName:   "清理集群数据",
 	}
 
+	// upgrade cluster task
+	upgradeAKSClusterMasterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeAKSClusterMasterTask", cloudName),
+		StepName:   "升级集群控制面版本",
+	}
+	checkAKSClusterUpgradeStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckAKSClusterUpgradeStatusTask", cloudName),
+		StepName:   "检测集群升级状态",
+	}
+	upgradeAKSAgentPoolStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeAKSAgentPoolTask", cloudName),
+		StepName:   "升级节点组版本",
+	}
+
 	// create nodeGroup task
 	createCloudNodeGroupStep = cloudprovider.StepInfo{
 		StepMethod: fmt.Sprintf("%s-CreateCloudNodeGroupTask", cloudName),
@@ -129,6 +146,66 @@ func (ic *ImportClusterTaskOption) BuildImportClusterNodesStep(task *proto.Task)
 	task.StepSequence = append(task.StepSequence, importClusterNodesStep.StepMethod)
 }
 
+// UpgradeClusterTaskOption 升级集群
+type UpgradeClusterTaskOption struct {
+	Cluster        *proto.Cluster
+	Version        string
+	NodeGroups     []*proto.NodeGroup
+	NodeGroupNodes map[string][]*proto.Node
+	DrainTimeout   uint32
+}
+
+// BuildUpgradeMasterStep 升级集群控制面版本
+func (uc *UpgradeClusterTaskOption) BuildUpgradeMasterStep(task *proto.Task) {
+	upgradeStep := cloudprovider.InitTaskStep(upgradeAKSClusterMasterStep)
+	upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[upgradeAKSClusterMasterStep.StepMethod] = upgradeStep
+	task.StepSequence = append(task.StepSequence, upgradeAKSClusterMasterStep.StepMethod)
+}
+
+// BuildCheckUpgradeStatusStep 检测集群升级状态
+func (uc *UpgradeClusterTaskOption) BuildCheckUpgradeStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkAKSClusterUpgradeStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[checkAKSClusterUpgradeStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkAKSClusterUpgradeStatusStep.StepMethod)
+}
+
+// BuildUpgradeNodeGroupsStep 节点组依次排水/升级/恢复调度
+func (uc *UpgradeClusterTaskOption) BuildUpgradeNodeGroupsStep(task *proto.Task) {
+	for _, group := range uc.NodeGroups {
+		nodeIPs := make([]string, 0)
+		for _, node := range uc.NodeGroupNodes[group.NodeGroupID] {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+		}
+
+		common.BuildDrainNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs, uc.DrainTimeout)
+
+		upgradeStep := cloudprovider.InitTaskStep(upgradeAKSAgentPoolStep)
+		stepName := common.GetNodeGroupStepName(upgradeAKSAgentPoolStep.StepMethod, group.NodeGroupID)
+		upgradeStep.Name = stepName
+		upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+		upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+		upgradeStep.Params[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+		upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+		task.Steps[stepName] = upgradeStep
+		task.StepSequence = append(task.StepSequence, stepName)
+
+		common.BuildUnCordonGroupNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs)
+	}
+}
+
+// BuildUpdateClusterVersionStep 更新集群版本数据
+func (uc *UpgradeClusterTaskOption) BuildUpdateClusterVersionStep(task *proto.Task) {
+	common.BuildUpdateClusterVersionTaskStep(task, uc.Cluster.ClusterID, uc.Version)
+}
+
 // DeleteClusterTaskOption 删除集群
 type DeleteClusterTaskOption struct {
 	Cluster    *proto.Cluster
@@ -13,6 +13,7 @@
 package azure
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"sync"
@@ -21,6 +22,7 @@ import (
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/azure/api"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/types"
@@ -44,6 +46,41 @@ func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *
 	return cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeClusterValidate upgrade cluster validate
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || cls == nil || req == nil || opt == nil {
+		return fmt.Errorf("%s UpgradeClusterValidate request&options is empty", cloudName)
+	}
+
+	if opt.Account == nil || len(opt.Account.SubscriptionID) == 0 || len(opt.Account.TenantID) == 0 ||
+		len(opt.Account.ClientID) == 0 || len(opt.Account.ClientSecret) == 0 || len(opt.Region) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate opt lost valid crendential info", cloudName)
+	}
+
+	if len(cls.SystemID) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate cluster[%s] systemID empty", cloudName, cls.ClusterID)
+	}
+
+	// check version skew
+	err := cloudprovider.CheckClusterUpgradeVersion(cls, req.Version)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate failed: %v", cloudName, err)
+	}
+
+	// check aks cluster exist
+	cli, err := api.NewAksServiceImplWithCommonOption(opt)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate NewAksServiceImplWithCommonOption failed: %v", cloudName, err)
+	}
+	_, err = cli.GetClusterWithName(context.Background(), cloudprovider.GetClusterResourceGroup(cls), cls.SystemID)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate GetClusterWithName[%s] failed: %v", cloudName, cls.SystemID, err)
+	}
+
+	return nil
+}
+
 // CreateCloudAccountValidate create cloud account validate
 func (c *CloudValidate) CreateCloudAccountValidate(account *proto.Account) error {
 	nm := &NodeManager{}
@@ -42,6 +42,12 @@ func (c *Cluster) DeleteVirtualCluster(cls *proto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // CreateCluster create kubenretes cluster according cloudprovider
 func (c *Cluster) CreateCluster(cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
 	// call blueking interface to create cluster
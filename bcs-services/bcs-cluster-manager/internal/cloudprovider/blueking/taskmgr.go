@@ -213,6 +213,12 @@ func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildDeleteClusterTask build deleteCluster task
 func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
 	// delete cluster has two steps:
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+var (
+	// ShieldHostAlarmActionStep 屏蔽节点告警任务
+	ShieldHostAlarmActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.ShieldHostAlarmAction,
+		StepName:   "屏蔽机器告警",
+	}
+	// UnShieldHostAlarmActionStep 解除节点告警屏蔽任务
+	UnShieldHostAlarmActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.UnShieldHostAlarmAction,
+		StepName:   "解除机器告警屏蔽",
+	}
+)
+
+// BuildShieldHostAlarmTaskStep build shield nodes alarm task step, nodes alarm keep shielded
+// until UnShieldHostAlarm step or shield expired when task failed
+func BuildShieldHostAlarmTaskStep(task *proto.Task, clusterID string, nodeIPs []string) {
+	shieldStep := cloudprovider.InitTaskStep(ShieldHostAlarmActionStep)
+
+	shieldStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	shieldStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	task.Steps[ShieldHostAlarmActionStep.StepMethod] = shieldStep
+	task.StepSequence = append(task.StepSequence, ShieldHostAlarmActionStep.StepMethod)
+}
+
+// BuildUnShieldHostAlarmTaskStep build unShield nodes alarm task step
+func BuildUnShieldHostAlarmTaskStep(task *proto.Task, clusterID string, nodeIPs []string) {
+	unShieldStep := cloudprovider.InitTaskStep(UnShieldHostAlarmActionStep)
+
+	unShieldStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	unShieldStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	task.Steps[UnShieldHostAlarmActionStep.StepMethod] = unShieldStep
+	task.StepSequence = append(task.StepSequence, UnShieldHostAlarmActionStep.StepMethod)
+}
+
+// ShieldHostAlarmTask shield cluster nodes alarm
+func ShieldHostAlarmTask(taskID string, stepName string) error {
+	return hostAlarmTask(taskID, stepName, true)
+}
+
+// UnShieldHostAlarmTask unShield cluster nodes alarm
+func UnShieldHostAlarmTask(taskID string, stepName string) error {
+	return hostAlarmTask(taskID, stepName, false)
+}
+
+func hostAlarmTask(taskID string, stepName string, shield bool) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("hostAlarmTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("hostAlarmTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	if len(clusterID) == 0 || len(nodeIPs) == 0 {
+		retErr := fmt.Errorf("hostAlarmTask[%s] validateParameter failed: clusterID or nodeIPs empty", taskID)
+		blog.Errorf(retErr.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("hostAlarmTask[%s]: get cluster for %s failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// alarm backends errors only logged, alarm shield should not block nodes operation
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	if shield {
+		_ = cloudprovider.ShieldHostAlarm(ctx, cluster, nodeIPs)
+	} else {
+		_ = cloudprovider.UnShieldHostAlarm(ctx, cluster, nodeIPs)
+	}
+
+	blog.Infof("hostAlarmTask[%s] clusterID[%s] IPs[%v] shield[%v] successful", taskID, clusterID, nodeIPs, shield)
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
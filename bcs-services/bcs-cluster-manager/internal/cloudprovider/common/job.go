@@ -147,6 +147,9 @@ func JobExecuteScriptTask(taskID string, stepName string) error {
 	if nodeIPs == template.NodeIPList {
 		if value, ok := template.DynamicParameterInject[nodeIPs]; ok {
 			nodeIPs = state.Task.CommonParams[value]
+			if branchValue, exist := state.Task.CommonParams[cloudprovider.GetBranchParamKey(step, value)]; exist {
+				nodeIPs = branchValue
+			}
 		}
 	}
 
@@ -0,0 +1,474 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/google/uuid"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	// rolloutNodeGroupTaskTemplate task name
+	rolloutNodeGroupTaskTemplate = "集群%s滚动更新节点池%s节点"
+
+	rolloutWaitInterval   = 30 * time.Second
+	rolloutSubTaskTimeout = 2 * time.Hour
+	rolloutNodeTimeout    = 20 * time.Minute
+)
+
+// errRolloutPending sub task or surged nodes not ready, batch step re-enqueued to check later
+var errRolloutPending = errors.New("rollout batch pending")
+
+var (
+	// RolloutNodeGroupBatchStep 节点池滚动更新批次
+	RolloutNodeGroupBatchStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.RolloutNodeGroupBatchAction,
+		StepName:   "滚动更新节点批次",
+	}
+)
+
+// BuildRolloutNodeGroupTask build rollout nodeGroup task, old nodes are replaced by batches. each batch
+// replaces maxSurge+maxUnavailable old nodes, surge maxSurge new nodes and wait ready before cleaning
+// old nodes, then scale up the rest nodes. task stops at the failed batch and could be retried
+func BuildRolloutNodeGroupTask(group *proto.NodeGroup, nodeIPs []string,
+	opt *cloudprovider.RolloutNodeGroupOption) (*proto.Task, error) {
+	if group == nil || len(nodeIPs) == 0 {
+		return nil, fmt.Errorf("BuildRolloutNodeGroupTask nodeGroup or nodes empty")
+	}
+	if opt == nil || opt.Cloud == nil || opt.Cluster == nil || len(opt.Operator) == 0 {
+		return nil, fmt.Errorf("BuildRolloutNodeGroupTask TaskOptions is lost")
+	}
+	batchSize := int(opt.MaxSurge + opt.MaxUnavailable)
+	if batchSize == 0 {
+		return nil, fmt.Errorf("BuildRolloutNodeGroupTask maxSurge and maxUnavailable are both zero")
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(opt.Cloud.CloudProvider, cloudprovider.RolloutNodeGroup),
+		TaskName:       cloudprovider.RolloutNodeGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+		NodeIPList:     nodeIPs,
+	}
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = fmt.Sprintf(rolloutNodeGroupTaskTemplate,
+		group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+
+	for i := 0; i*batchSize < len(nodeIPs); i++ {
+		end := (i + 1) * batchSize
+		if end > len(nodeIPs) {
+			end = len(nodeIPs)
+		}
+		batch := nodeIPs[i*batchSize : end]
+
+		surge := int(opt.MaxSurge)
+		if surge > len(batch) {
+			surge = len(batch)
+		}
+
+		batchStep := cloudprovider.InitTaskStep(RolloutNodeGroupBatchStep)
+		stepName := fmt.Sprintf("%s-%d", RolloutNodeGroupBatchStep.StepMethod, i)
+		batchStep.Name = stepName
+		batchStep.Params[cloudprovider.ClusterIDKey.String()] = group.ClusterID
+		batchStep.Params[cloudprovider.CloudIDKey.String()] = opt.Cloud.CloudID
+		batchStep.Params[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+		batchStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(batch, ",")
+		batchStep.Params[cloudprovider.SurgeNumKey.String()] = strconv.Itoa(surge)
+		batchStep.Params[cloudprovider.DrainTimeoutKey.String()] = strconv.Itoa(int(opt.DrainTimeout))
+
+		task.Steps[stepName] = batchStep
+		task.StepSequence = append(task.StepSequence, stepName)
+	}
+	task.CurrentStep = task.StepSequence[0]
+
+	return task, nil
+}
+
+// RolloutNodeGroupBatchTask replace one batch old nodes of nodeGroup
+func RolloutNodeGroupBatchTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RolloutNodeGroupBatchTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RolloutNodeGroupBatchTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	surge, _ := strconv.Atoi(step.Params[cloudprovider.SurgeNumKey.String()])
+	timeout, _ := strconv.Atoi(step.Params[cloudprovider.DrainTimeoutKey.String()])
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("RolloutNodeGroupBatchTask[%s]: GetClusterDependBasicInfo for nodeGroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	rollout := &nodeGroupRollout{
+		ctx:        ctx,
+		taskID:     taskID,
+		stepName:   stepName,
+		step:       step,
+		dependInfo: dependInfo,
+		operator:   state.Task.CommonParams[cloudprovider.OperatorKey.String()],
+	}
+	rollout.asOption, _ = cloudprovider.GetStorageModel().GetAutoScalingOption(ctx, clusterID)
+
+	// step1: surge new nodes and wait ready before removing old nodes
+	err = rollout.scaleUpNodes(cloudprovider.SurgeTaskIDKey, surge)
+	// step2: drain and clean old nodes
+	if err == nil {
+		err = rollout.cleanNodes(nodeIPs, timeout)
+	}
+	// step3: scale up the rest nodes which are not surged
+	if err == nil {
+		err = rollout.scaleUpNodes(cloudprovider.RestoreTaskIDKey, len(nodeIPs)-surge)
+	}
+	// release worker when waiting sub task, step is re-enqueued to check sub task later
+	if errors.Is(err, errRolloutPending) {
+		blog.Infof("RolloutNodeGroupBatchTask[%s] nodeGroup[%s] nodes[%v] %v, check later",
+			taskID, nodeGroupID, nodeIPs, err)
+		return cloudprovider.RetryStepLater(err.Error(), rolloutWaitInterval)
+	}
+	if err != nil {
+		blog.Errorf("RolloutNodeGroupBatchTask[%s] nodeGroup[%s] nodes[%v] failed: %v",
+			taskID, nodeGroupID, nodeIPs, err)
+		retErr := fmt.Errorf("RolloutNodeGroupBatchTask err: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	blog.Infof("RolloutNodeGroupBatchTask[%s] nodeGroup[%s] nodes[%v] successful", taskID, nodeGroupID, nodeIPs)
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// nodeGroupRollout replace nodeGroup nodes by sub tasks, sub taskIDs are recorded in step params and checked
+// when step re-enqueued or task retry
+type nodeGroupRollout struct {
+	ctx        context.Context
+	taskID     string
+	stepName   string
+	step       *proto.Step
+	dependInfo *cloudprovider.CloudDependBasicInfo
+	asOption   *proto.ClusterAutoScalingOption
+	operator   string
+}
+
+// checkSubTask check sub task recorded by key, return nil task when sub task not created,
+// errRolloutPending when sub task still running and error when sub task failed or timeout.
+// failed sub task is removed from step params, so retry of the batch step dispatches a new sub task
+func (r *nodeGroupRollout) checkSubTask(key cloudprovider.ParamKey) (*proto.Task, error) {
+	subTaskID := r.step.Params[key.String()]
+	if subTaskID == "" {
+		return nil, nil
+	}
+
+	done, task, err := cloudprovider.CheckTaskDone(r.ctx, subTaskID)
+	if err != nil {
+		if task != nil {
+			r.resetSubTask(key)
+		}
+		return nil, err
+	}
+	if done {
+		return task, nil
+	}
+
+	if r.waitTimeout(subTaskWaitKey(key), rolloutSubTaskTimeout) {
+		return nil, fmt.Errorf("wait sub task %s timeout", subTaskID)
+	}
+
+	return nil, fmt.Errorf("%w: sub task %s status %s", errRolloutPending, subTaskID, task.Status)
+}
+
+// runSubTask dispatch sub task and record it to step params, sub task is checked when step re-enqueued
+func (r *nodeGroupRollout) runSubTask(key cloudprovider.ParamKey, task *proto.Task) error {
+	if err := cloudprovider.CreateAndDispatchTask(r.ctx, task); err != nil {
+		return err
+	}
+	r.setStepParam(key.String(), task.TaskID)
+	r.setStepParam(subTaskWaitKey(key), time.Now().Format(time.RFC3339))
+
+	return fmt.Errorf("%w: sub task %s dispatched", errRolloutPending, task.TaskID)
+}
+
+// resetSubTask clear sub task and its wait states of key
+func (r *nodeGroupRollout) resetSubTask(key cloudprovider.ParamKey) {
+	for _, k := range []string{key.String(), nodesReadyKey(key), subTaskWaitKey(key), nodesReadyWaitKey(key)} {
+		if _, ok := r.step.Params[k]; ok {
+			r.setStepParam(k, "")
+		}
+	}
+}
+
+// waitTimeout check wait started by waitKey exceed timeout, wait starts when first checked. wait start is
+// cleared when timeout, so retry of the batch step waits another timeout
+func (r *nodeGroupRollout) waitTimeout(waitKey string, timeout time.Duration) bool {
+	start, err := time.Parse(time.RFC3339, r.step.Params[waitKey])
+	if err != nil {
+		r.setStepParam(waitKey, time.Now().Format(time.RFC3339))
+		return false
+	}
+	if time.Since(start) <= timeout {
+		return false
+	}
+	r.setStepParam(waitKey, "")
+
+	return true
+}
+
+func (r *nodeGroupRollout) setStepParam(key, value string) {
+	r.step.Params[key] = value
+	if err := cloudprovider.SetTaskStepParas(r.taskID, r.stepName, key, value); err != nil {
+		blog.Errorf("nodeGroupRollout[%s] set step %s param %s failed: %v", r.taskID, r.stepName, key, err)
+	}
+}
+
+func nodesReadyKey(key cloudprovider.ParamKey) string {
+	return key.String() + "Ready"
+}
+
+func subTaskWaitKey(key cloudprovider.ParamKey) string {
+	return key.String() + "Wait"
+}
+
+func nodesReadyWaitKey(key cloudprovider.ParamKey) string {
+	return key.String() + "ReadyWait"
+}
+
+// checkNodesReady check sub task scaled nodes ready in k8s, wait at most rolloutNodeTimeout after sub task done
+func (r *nodeGroupRollout) checkNodesReady(key cloudprovider.ParamKey, task *proto.Task) error {
+	if r.step.Params[nodesReadyKey(key)] == "true" {
+		return nil
+	}
+
+	ready, err := clusterNodesReady(r.ctx, r.dependInfo.Cluster.ClusterID, task.NodeIPList)
+	if err != nil {
+		return err
+	}
+	if ready {
+		r.setStepParam(nodesReadyKey(key), "true")
+		return nil
+	}
+
+	if r.waitTimeout(nodesReadyWaitKey(key), rolloutNodeTimeout) {
+		return fmt.Errorf("wait nodes %v ready timeout", task.NodeIPList)
+	}
+
+	return fmt.Errorf("%w: nodes %v not ready", errRolloutPending, task.NodeIPList)
+}
+
+// scaleUpNodes scale up num nodes in nodeGroup and wait nodes ready
+func (r *nodeGroupRollout) scaleUpNodes(key cloudprovider.ParamKey, num int) error {
+	if num <= 0 {
+		return nil
+	}
+	// sub task records scaled nodes when applying instances
+	subTask, err := r.checkSubTask(key)
+	if err != nil {
+		return err
+	}
+	if subTask != nil {
+		return r.checkNodesReady(key, subTask)
+	}
+
+	var (
+		cluster = r.dependInfo.Cluster
+		cloud   = r.dependInfo.Cloud
+	)
+	group, err := cloudprovider.GetNodeGroupByID(r.dependInfo.NodeGroup.NodeGroupID)
+	if err != nil {
+		return err
+	}
+	nodes, err := cloudprovider.ListNodesInClusterNodePool(cluster.ClusterID, group.NodeGroupID)
+	if err != nil {
+		return err
+	}
+
+	nodeGroupMgr, err := cloudprovider.GetNodeGroupMgr(cloud.CloudProvider)
+	if err != nil {
+		return err
+	}
+	scaleResp, err := nodeGroupMgr.UpdateDesiredNodes(uint32(len(nodes)+num), group,
+		&cloudprovider.UpdateDesiredNodeOption{
+			CommonOption: *r.dependInfo.CmOption,
+			Cluster:      cluster,
+			Cloud:        cloud,
+		})
+	if err != nil {
+		return fmt.Errorf("update desired nodes failed: %v", err)
+	}
+	if scaleResp.ScalingUp == 0 {
+		return nil
+	}
+
+	taskMgr, err := cloudprovider.GetTaskManager(cloud.CloudProvider)
+	if err != nil {
+		return err
+	}
+	task, err := taskMgr.BuildUpdateDesiredNodesTask(scaleResp.ScalingUp, group,
+		&cloudprovider.UpdateDesiredNodeOption{
+			CommonOption: *r.dependInfo.CmOption,
+			Cloud:        cloud,
+			Cluster:      cluster,
+			NodeGroup:    group,
+			AsOption:     r.asOption,
+			Operator:     r.operator,
+		})
+	if err != nil {
+		return fmt.Errorf("build scaling task failed: %v", err)
+	}
+	if err = cloudprovider.UpdateNodeGroupDesiredSize(group.NodeGroupID, int(scaleResp.ScalingUp), false); err != nil {
+		return err
+	}
+
+	return r.runSubTask(key, task)
+}
+
+// cleanNodes drain and clean old nodes in nodeGroup
+func (r *nodeGroupRollout) cleanNodes(nodeIPs []string, timeout int) error {
+	subTask, err := r.checkSubTask(cloudprovider.CleanTaskIDKey)
+	if err != nil || subTask != nil {
+		return err
+	}
+
+	var (
+		cluster = r.dependInfo.Cluster
+		cloud   = r.dependInfo.Cloud
+		nodes   = make([]*proto.Node, 0)
+		ips     = make([]string, 0)
+	)
+	group, err := cloudprovider.GetNodeGroupByID(r.dependInfo.NodeGroup.NodeGroupID)
+	if err != nil {
+		return err
+	}
+	for _, ip := range nodeIPs {
+		node, errLocal := cloudprovider.GetStorageModel().GetNodeByIP(r.ctx, ip)
+		if errLocal != nil || node.NodeGroupID != group.NodeGroupID {
+			blog.Warnf("nodeGroupRollout[%s] node[%s] not in nodeGroup %s, skip clean", r.taskID, ip,
+				group.NodeGroupID)
+			continue
+		}
+		nodes = append(nodes, node)
+		ips = append(ips, ip)
+	}
+	// old nodes already removed
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	if err = drainClusterNodes(r.ctx, cluster.ClusterID, ips, timeout); err != nil {
+		return err
+	}
+
+	nodeGroupMgr, err := cloudprovider.GetNodeGroupMgr(cloud.CloudProvider)
+	if err != nil {
+		return err
+	}
+	_ = cloudprovider.UpdateNodeListStatus(true, ips, icommon.StatusDeleting)
+	task, err := nodeGroupMgr.CleanNodesInGroup(nodes, group, &cloudprovider.CleanNodesOption{
+		CommonOption: *r.dependInfo.CmOption,
+		Cloud:        cloud,
+		Cluster:      cluster,
+		AsOption:     r.asOption,
+		Operator:     r.operator,
+	})
+	if err != nil {
+		return fmt.Errorf("build clean nodes task failed: %v", err)
+	}
+
+	return r.runSubTask(cloudprovider.CleanTaskIDKey, task)
+}
+
+// clusterNodesReady check cluster nodes all ready in k8s
+func clusterNodesReady(ctx context.Context, clusterID string, nodeIPs []string) (bool, error) {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+	if len(nodeIPs) == 0 {
+		return true, nil
+	}
+
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+	nodes, err := k8sOperator.ListClusterNodesByIPsOrNames(ctx, clusterops.ListNodeOption{
+		ClusterID: clusterID,
+		NodeIPs:   nodeIPs,
+	})
+	if err != nil {
+		blog.Errorf("clusterNodesReady[%s] ListClusterNodesByIPsOrNames failed: %v", taskID, err)
+		return false, nil
+	}
+
+	ready := 0
+	for i := range nodes {
+		if utils.CheckNodeIfReady(nodes[i]) {
+			ready++
+		}
+	}
+	blog.Infof("clusterNodesReady[%s] cluster[%s] nodes %v ready %d", taskID, clusterID, nodeIPs, ready)
+
+	return ready == len(nodeIPs), nil
+}
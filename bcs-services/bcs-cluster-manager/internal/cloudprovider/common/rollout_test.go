@@ -0,0 +1,128 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	pb "github.com/golang/protobuf/proto"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+)
+
+// taskModel store model only implements task methods
+type taskModel struct {
+	store.ClusterManagerModel
+	mu    sync.Mutex
+	tasks map[string]*proto.Task
+}
+
+func (m *taskModel) GetTask(ctx context.Context, taskID string) (*proto.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(task).(*proto.Task), nil
+}
+
+func (m *taskModel) UpdateTask(ctx context.Context, task *proto.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tasks[task.TaskID] = pb.Clone(task).(*proto.Task)
+	return nil
+}
+
+func newTestRollout(subTaskStatus string) (*nodeGroupRollout, *taskModel) {
+	step := &proto.Step{
+		Name: "rolloutNodeGroupBatch-0",
+		Params: map[string]string{
+			cloudprovider.SurgeTaskIDKey.String():        "sub-task",
+			nodesReadyKey(cloudprovider.SurgeTaskIDKey):  "true",
+			subTaskWaitKey(cloudprovider.SurgeTaskIDKey): time.Now().Format(time.RFC3339),
+		},
+	}
+	model := &taskModel{tasks: map[string]*proto.Task{
+		"rollout-task": {
+			TaskID: "rollout-task",
+			Steps:  map[string]*proto.Step{step.Name: pb.Clone(step).(*proto.Step)},
+		},
+		"sub-task": {
+			TaskID: "sub-task",
+			Status: subTaskStatus,
+			Start:  time.Now().Format(time.RFC3339),
+		},
+	}}
+	cloudprovider.InitStorageModel(model)
+
+	return &nodeGroupRollout{
+		ctx:      context.Background(),
+		taskID:   "rollout-task",
+		stepName: step.Name,
+		step:     step,
+	}, model
+}
+
+func TestRolloutCheckFailedSubTask(t *testing.T) {
+	r, model := newTestRollout(cloudprovider.TaskStatusFailure)
+
+	task, err := r.checkSubTask(cloudprovider.SurgeTaskIDKey)
+	if err == nil || task != nil {
+		t.Fatalf("failed sub task should return error, task %v err %v", task, err)
+	}
+	// retry dispatches new sub task instead of checking the failed one again
+	stored := model.tasks["rollout-task"].Steps[r.stepName].Params
+	for _, params := range []map[string]string{r.step.Params, stored} {
+		if params[cloudprovider.SurgeTaskIDKey.String()] != "" ||
+			params[nodesReadyKey(cloudprovider.SurgeTaskIDKey)] != "" ||
+			params[subTaskWaitKey(cloudprovider.SurgeTaskIDKey)] != "" {
+			t.Fatalf("failed sub task should be reset, params %v", params)
+		}
+	}
+	task, err = r.checkSubTask(cloudprovider.SurgeTaskIDKey)
+	if err != nil || task != nil {
+		t.Fatalf("sub task should be dispatched again, task %v err %v", task, err)
+	}
+}
+
+func TestRolloutCheckRunningSubTask(t *testing.T) {
+	r, _ := newTestRollout(cloudprovider.TaskStatusRunning)
+
+	_, err := r.checkSubTask(cloudprovider.SurgeTaskIDKey)
+	if !errors.Is(err, errRolloutPending) {
+		t.Fatalf("running sub task should be pending, err %v", err)
+	}
+
+	// wait timeout, sub task is kept and wait restarts when retry
+	r.setStepParam(subTaskWaitKey(cloudprovider.SurgeTaskIDKey),
+		time.Now().Add(-rolloutSubTaskTimeout-time.Minute).Format(time.RFC3339))
+	_, err = r.checkSubTask(cloudprovider.SurgeTaskIDKey)
+	if err == nil || errors.Is(err, errRolloutPending) {
+		t.Fatalf("sub task should be timeout, err %v", err)
+	}
+	if r.step.Params[cloudprovider.SurgeTaskIDKey.String()] != "sub-task" {
+		t.Fatalf("running sub task should be kept, params %v", r.step.Params)
+	}
+	_, err = r.checkSubTask(cloudprovider.SurgeTaskIDKey)
+	if !errors.Is(err, errRolloutPending) {
+		t.Fatalf("retry should wait sub task again, err %v", err)
+	}
+}
@@ -31,15 +31,20 @@ import (
 // RegisterCommonActions register common actions
 func RegisterCommonActions() map[string]interface{} {
 	return map[string]interface{}{
-		cloudprovider.BKSOPTask:                  RunBKsopsJob,
-		cloudprovider.UnCordonNodesAction:        UnCordonNodesTask,
-		cloudprovider.CordonNodesAction:          CordonNodesTask,
-		cloudprovider.WatchTask:                  EnsureWatchComponentTask,
-		cloudprovider.InstallGseAgentAction:      InstallGSEAgentTask,
-		cloudprovider.TransferHostModuleAction:   TransferHostModuleTask,
-		cloudprovider.RemoveHostFromCmdbAction:   RemoveHostFromCMDBTask,
-		cloudprovider.JobFastExecuteScriptAction: JobExecuteScriptTask,
-		cloudprovider.EnsureAutoScalerAction:     EnsureAutoScalerTask,
+		cloudprovider.BKSOPTask:                   RunBKsopsJob,
+		cloudprovider.UnCordonNodesAction:         UnCordonNodesTask,
+		cloudprovider.CordonNodesAction:           CordonNodesTask,
+		cloudprovider.ShieldHostAlarmAction:       ShieldHostAlarmTask,
+		cloudprovider.UnShieldHostAlarmAction:     UnShieldHostAlarmTask,
+		cloudprovider.DrainNodesAction:            DrainNodesTask,
+		cloudprovider.UpdateClusterVersionAction:  UpdateClusterVersionTask,
+		cloudprovider.RolloutNodeGroupBatchAction: RolloutNodeGroupBatchTask,
+		cloudprovider.WatchTask:                   EnsureWatchComponentTask,
+		cloudprovider.InstallGseAgentAction:       InstallGSEAgentTask,
+		cloudprovider.TransferHostModuleAction:    TransferHostModuleTask,
+		cloudprovider.RemoveHostFromCmdbAction:    RemoveHostFromCMDBTask,
+		cloudprovider.JobFastExecuteScriptAction:  JobExecuteScriptTask,
+		cloudprovider.EnsureAutoScalerAction:      EnsureAutoScalerTask,
 
 		cloudprovider.InstallVclusterAction:              InstallVclusterTask,
 		cloudprovider.DeleteVclusterAction:               UnInstallVclusterTask,
@@ -109,7 +114,7 @@ func RunBKsopsJob(taskID string, stepName string) error {
 	}
 
 	// render constants dynamic value parameter
-	consMap, err := renderDynamicParaToConstants(state.Task, constants)
+	consMap, err := renderDynamicParaToConstants(state.Task, step, constants)
 	if err != nil {
 		errMsg := fmt.Sprintf("RunBKsopsJob[%s] unmarshal constants failed[%v]", taskID, err)
 		blog.Errorf(errMsg)
@@ -153,8 +158,10 @@ func RunBKsopsJob(taskID string, stepName string) error {
 	return nil
 }
 
-// renderDynamicParaToConstants extract constants parameter & inject dynamic value
-func renderDynamicParaToConstants(task *cmproto.Task, constants string) (map[string]string, error) {
+// renderDynamicParaToConstants extract constants parameter & inject dynamic value, dag task branch step
+// inject value of branch scoped params
+func renderDynamicParaToConstants(task *cmproto.Task, step *cmproto.Step,
+	constants string) (map[string]string, error) {
 	consMap := map[string]string{}
 	err := json.Unmarshal([]byte(constants), &consMap)
 	if err != nil {
@@ -165,6 +172,9 @@ func renderDynamicParaToConstants(task *cmproto.Task, constants string) (map[str
 	for ck, cv := range consMap {
 		if value, ok := template.DynamicParameterInject[cv]; ok {
 			consMap[ck] = task.CommonParams[value]
+			if branchValue, exist := task.CommonParams[cloudprovider.GetBranchParamKey(step, value)]; exist {
+				consMap[ck] = branchValue
+			}
 		}
 	}
 
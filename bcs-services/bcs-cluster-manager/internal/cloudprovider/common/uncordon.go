@@ -125,6 +125,10 @@ func UnCordonNodesTask(taskID string, stepName string) error {
 	// extract parameter
 	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
 	nodeIPs := strings.Split(state.Task.CommonParams[cloudprovider.NodeIPsKey.String()], ",")
+	// nodeGroup upgrade steps carry nodes in step params
+	if len(state.Task.CommonParams[cloudprovider.NodeIPsKey.String()]) == 0 {
+		nodeIPs = cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	}
 
 	if len(clusterID) == 0 || len(nodeIPs) == 0 {
 		errMsg := fmt.Sprintf("UnCordonNodesTask[%s] validateParameter failed: clusterID or nodeIPs empty", taskID)
@@ -0,0 +1,360 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	// DefaultDrainTimeout default drain node timeout seconds
+	DefaultDrainTimeout = 300
+	// DefaultDrainBatchSize nodes drained concurrently in one batch
+	DefaultDrainBatchSize = 2
+	// DefaultDrainReadyTimeout wait evicted workloads ready timeout after one batch drained
+	DefaultDrainReadyTimeout = 10 * time.Minute
+)
+
+var (
+	// DrainNodesActionStep 节点排水任务
+	DrainNodesActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.DrainNodesAction,
+		StepName:   "节点排水",
+	}
+	// UpdateClusterVersionActionStep 更新集群版本数据
+	UpdateClusterVersionActionStep = cloudprovider.StepInfo{
+		StepMethod: cloudprovider.UpdateClusterVersionAction,
+		StepName:   "更新集群版本数据",
+	}
+)
+
+// GetNodeGroupStepName build nodeGroup unique stepName, one task may operate multi nodeGroups
+func GetNodeGroupStepName(stepMethod, nodeGroupID string) string {
+	return fmt.Sprintf("%s-%s", stepMethod, nodeGroupID)
+}
+
+// BuildDrainNodesTaskStep build drain nodeGroup nodes task step
+func BuildDrainNodesTaskStep(task *proto.Task, clusterID, nodeGroupID string, nodeIPs []string, timeout uint32) {
+	drainStep := cloudprovider.InitTaskStep(DrainNodesActionStep)
+	stepName := GetNodeGroupStepName(DrainNodesActionStep.StepMethod, nodeGroupID)
+	drainStep.Name = stepName
+
+	drainStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	drainStep.Params[cloudprovider.NodeGroupIDKey.String()] = nodeGroupID
+	drainStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	drainStep.Params[cloudprovider.DrainTimeoutKey.String()] = strconv.Itoa(int(timeout))
+
+	task.Steps[stepName] = drainStep
+	task.StepSequence = append(task.StepSequence, stepName)
+}
+
+// BuildUnCordonGroupNodesTaskStep build uncordon nodeGroup nodes task step
+func BuildUnCordonGroupNodesTaskStep(task *proto.Task, clusterID, nodeGroupID string, nodeIPs []string) {
+	unCordonStep := cloudprovider.InitTaskStep(UnCordonNodesActionStep)
+	stepName := GetNodeGroupStepName(UnCordonNodesActionStep.StepMethod, nodeGroupID)
+	unCordonStep.Name = stepName
+
+	unCordonStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	unCordonStep.Params[cloudprovider.NodeGroupIDKey.String()] = nodeGroupID
+	unCordonStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	task.Steps[stepName] = unCordonStep
+	task.StepSequence = append(task.StepSequence, stepName)
+}
+
+// BuildUpdateClusterVersionTaskStep build update cluster version task step
+func BuildUpdateClusterVersionTaskStep(task *proto.Task, clusterID string, version string) {
+	updateStep := cloudprovider.InitTaskStep(UpdateClusterVersionActionStep)
+
+	updateStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	updateStep.Params[cloudprovider.ClusterVersionKey.String()] = version
+
+	task.Steps[UpdateClusterVersionActionStep.StepMethod] = updateStep
+	task.StepSequence = append(task.StepSequence, UpdateClusterVersionActionStep.StepMethod)
+}
+
+// DrainNodesTask cordon and drain cluster nodes
+func DrainNodesTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("DrainNodesTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("DrainNodesTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	timeout, _ := strconv.Atoi(step.Params[cloudprovider.DrainTimeoutKey.String()])
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+
+	if len(clusterID) == 0 {
+		errMsg := fmt.Sprintf("DrainNodesTask[%s] validateParameter failed: clusterID empty", taskID)
+		blog.Errorf(errMsg)
+		retErr := fmt.Errorf("DrainNodesTask err: %s", errMsg)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	// nodeGroup without nodes not need to drain
+	if len(nodeIPs) == 0 {
+		blog.Infof("DrainNodesTask[%s] clusterID[%s] nodeIPs empty and skip", taskID, clusterID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = drainClusterNodes(ctx, clusterID, nodeIPs, timeout)
+	if err != nil {
+		blog.Errorf("DrainNodesTask[%s] clusterID[%s] IPs[%v] failed: %v", taskID, clusterID, nodeIPs, err)
+		retErr := fmt.Errorf("DrainNodesTask err: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	blog.Infof("DrainNodesTask[%s] clusterID[%s] IPs[%v] successful", taskID, clusterID, nodeIPs)
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// drainClusterNodes cordon and drain nodes in small batches, the next batch only starts when
+// the workloads evicted by the previous batch are rescheduled(no more pending pods than before)
+func drainClusterNodes(ctx context.Context, clusterID string, nodeIPs []string, timeout int) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), cloudprovider.GetStorageModel())
+	nodes, err := k8sOperator.ListClusterNodesByIPsOrNames(ctx, clusterops.ListNodeOption{
+		ClusterID: clusterID,
+		NodeIPs:   nodeIPs,
+	})
+	if err != nil {
+		blog.Errorf("drainClusterNodes[%s] ListClusterNodesByIPsOrNames failed: %v", taskID, err)
+		return err
+	}
+	k8sCli, err := k8sOperator.GetClusterClient(clusterID)
+	if err != nil {
+		blog.Errorf("drainClusterNodes[%s] GetClusterClient failed: %v", taskID, err)
+		return err
+	}
+
+	drainer := clusterops.DrainHelper{
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		Timeout:             timeout,
+		DeleteLocalData:     true,
+	}
+
+	for start := 0; start < len(nodes); start += DefaultDrainBatchSize {
+		end := start + DefaultDrainBatchSize
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		batch := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, nodes[i].Name)
+		}
+
+		// pending pods before drain, some pods may be pending for other reasons
+		pending, err := countPendingPods(ctx, k8sCli)
+		if err != nil {
+			blog.Errorf("drainClusterNodes[%s] countPendingPods failed: %v", taskID, err)
+			return err
+		}
+
+		err = drainNodesBatch(ctx, k8sOperator, clusterID, batch, drainer)
+		if err != nil {
+			return err
+		}
+
+		err = waitPendingPodsRescheduled(ctx, k8sCli, pending)
+		if err != nil {
+			blog.Errorf("drainClusterNodes[%s] nodes %v drained but workloads not ready: %v", taskID, batch, err)
+			return fmt.Errorf("workloads evicted from nodes %v not ready: %v", batch, err)
+		}
+		blog.Infof("drainClusterNodes[%s] batch nodes %v successful", taskID, batch)
+	}
+
+	return nil
+}
+
+// drainNodesBatch cordon and drain one batch of nodes concurrently
+func drainNodesBatch(ctx context.Context, k8sOperator *clusterops.K8SOperator, clusterID string,
+	nodeNames []string, drainer clusterops.DrainHelper) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	var (
+		lock     sync.Mutex
+		failures = make([]string, 0)
+	)
+	barrier := utils.NewRoutinePool(len(nodeNames))
+	defer barrier.Close()
+
+	for i := range nodeNames {
+		barrier.Add(1)
+		go func(nodeName string) {
+			defer barrier.Done()
+			err := k8sOperator.ClusterUpdateScheduleNode(ctx, clusterops.NodeInfo{
+				ClusterID: clusterID,
+				NodeName:  nodeName,
+				Desired:   true,
+			})
+			if err == nil {
+				err = k8sOperator.DrainNode(ctx, clusterID, nodeName, drainer)
+			}
+			if err != nil {
+				blog.Errorf("drainClusterNodes[%s] node[%s] failed: %v", taskID, nodeName, err)
+				lock.Lock()
+				failures = append(failures, nodeName)
+				lock.Unlock()
+				return
+			}
+			blog.Infof("drainClusterNodes[%s] node[%s] successful", taskID, nodeName)
+		}(nodeNames[i])
+	}
+	barrier.Wait()
+
+	if len(failures) > 0 {
+		return fmt.Errorf("drain nodes %v failed", failures)
+	}
+
+	return nil
+}
+
+// countPendingPods count cluster pods which are waiting to be scheduled
+func countPendingPods(ctx context.Context, k8sCli kubernetes.Interface) (int, error) {
+	podList, err := k8sCli.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase=" + string(corev1.PodPending),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(podList.Items), nil
+}
+
+// waitPendingPodsRescheduled wait pending pods no more than baseline, which means the evicted pods
+// have been rescheduled and started on other nodes
+func waitPendingPodsRescheduled(ctx context.Context, k8sCli kubernetes.Interface, baseline int) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultDrainReadyTimeout)
+	defer cancel()
+
+	return loop.LoopDoFunc(ctx, func() error {
+		pending, err := countPendingPods(ctx, k8sCli)
+		if err != nil {
+			blog.Errorf("waitPendingPodsRescheduled[%s] countPendingPods failed: %v", taskID, err)
+			return nil
+		}
+		if pending <= baseline {
+			return loop.EndLoop
+		}
+		blog.Infof("waitPendingPodsRescheduled[%s] pending pods %d, baseline %d", taskID, pending, baseline)
+
+		return nil
+	}, loop.LoopInterval(10*time.Second))
+}
+
+// UpdateClusterVersionTask update cluster version when upgrade cluster successful
+func UpdateClusterVersionTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpdateClusterVersionTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpdateClusterVersionTask[%s]: run step %s, system: %s, old state: %s, params %v",
+		taskID, stepName, step.System, step.Status, step.Params)
+
+	// extract parameter
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	if len(clusterID) == 0 || len(version) == 0 {
+		errMsg := fmt.Sprintf("UpdateClusterVersionTask[%s] validateParameter failed: clusterID or version empty",
+			taskID)
+		blog.Errorf(errMsg)
+		retErr := fmt.Errorf("UpdateClusterVersionTask err: %s", errMsg)
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cluster, err := cloudprovider.GetClusterByID(clusterID)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersionTask[%s] GetClusterByID[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionTask GetClusterByID failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	if cluster.ClusterBasicSettings == nil {
+		cluster.ClusterBasicSettings = &proto.ClusterBasicSetting{}
+	}
+	cluster.ClusterBasicSettings.Version = version
+
+	err = cloudprovider.UpdateCluster(cluster)
+	if err != nil {
+		blog.Errorf("UpdateClusterVersionTask[%s] UpdateCluster[%s] failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("UpdateClusterVersionTask UpdateCluster failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	blog.Infof("UpdateClusterVersionTask[%s] clusterID[%s] version[%s] successful", taskID, clusterID, version)
+	// update step
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("task %s %s update to storage fatal", taskID, stepName)
+		return err
+	}
+
+	return nil
+}
@@ -36,6 +36,8 @@ var (
 	DeleteCluster TaskType = "DeleteCluster"
 	// DeleteVirtualCluster task
 	DeleteVirtualCluster TaskType = "DeleteVirtualCluster"
+	// UpgradeCluster task
+	UpgradeCluster TaskType = "UpgradeCluster"
 	// AddNodesToCluster task
 	AddNodesToCluster TaskType = "AddNodesToCluster"
 	// RemoveNodesFromCluster task
@@ -72,6 +74,8 @@ var (
 
 	// CleanNodeGroupNodes task
 	CleanNodeGroupNodes TaskType = "CleanNodeGroupNodes"
+	// RolloutNodeGroup task
+	RolloutNodeGroup TaskType = "RolloutNodeGroup"
 
 	// DebugBkSopsTask task
 	DebugBkSopsTask TaskType = "DebugBkSopsTask"
@@ -110,6 +114,8 @@ var (
 	DeleteClusterTask TaskName = "删除集群"
 	// DeleteVirtualClusterTask xx
 	DeleteVirtualClusterTask TaskName = "删除虚拟集群"
+	// UpgradeClusterTask xx
+	UpgradeClusterTask TaskName = "升级集群版本"
 	// AddNodesToClusterTask xx
 	AddNodesToClusterTask TaskName = "集群上架节点"
 	// RemoveNodesFromClusterTask xx
@@ -126,6 +132,8 @@ var (
 	UpdateDesiredNodesTask TaskName = "扩容节点池"
 	// CleanNodesInGroupTask task
 	CleanNodesInGroupTask TaskName = "缩容节点池"
+	// RolloutNodeGroupTask task
+	RolloutNodeGroupTask TaskName = "滚动更新节点池"
 	// UpdateNodeGroupTask task
 	UpdateNodeGroupTask TaskName = "更新节点池"
 	// DeleteNodeGroupTask task
@@ -237,6 +245,8 @@ var (
 	WorkerNodeIPsKey ParamKey = "workerIPs"
 	// VpcKey vpcId
 	VpcKey ParamKey = "vpc"
+	// OriginVpcNodesKey nodes origin vpc before modify instances vpc
+	OriginVpcNodesKey ParamKey = "originVpcNodes"
 
 	// TerminateChargeNodes terminate nodes
 	TerminateChargeNodes ParamKey = "terminateChargeNodes"
@@ -256,6 +266,9 @@ var (
 	// ResourceQuotaKey xxx
 	ResourceQuotaKey ParamKey = "resourceQuota"
 
+	// BranchKey dag task branch name, branch steps read and write branch scoped common params
+	BranchKey ParamKey = "branch"
+
 	// DynamicNodeIPListKey xxx
 	DynamicNodeIPListKey ParamKey = "NodeIPList"
 	// DynamicNodeScriptKey xxx
@@ -340,4 +353,23 @@ var (
 	VclusterEtcdClientKeyKey ParamKey = "vclusterEtcdClientCert"
 	// VclusterServiceCIDRKey xxx
 	VclusterServiceCIDRKey ParamKey = "vclusterServiceCIDR"
+
+	// ClusterVersionKey cluster upgrade target version
+	ClusterVersionKey ParamKey = "clusterVersion"
+	// DrainTimeoutKey drain node timeout seconds
+	DrainTimeoutKey ParamKey = "drainTimeout"
+
+	// TaskRollbackKey rollback task when task canceled
+	TaskRollbackKey ParamKey = "taskRollback"
+	// RollbackStepsKey task rollback steps sequence
+	RollbackStepsKey ParamKey = "rollbackSteps"
+
+	// SurgeNumKey rollout batch surge nodes num
+	SurgeNumKey ParamKey = "surgeNum"
+	// SurgeTaskIDKey rollout batch scale up sub task
+	SurgeTaskIDKey ParamKey = "surgeTaskID"
+	// CleanTaskIDKey rollout batch clean nodes sub task
+	CleanTaskIDKey ParamKey = "cleanTaskID"
+	// RestoreTaskIDKey rollout batch restore nodes sub task
+	RestoreTaskIDKey ParamKey = "restoreTaskID"
 )
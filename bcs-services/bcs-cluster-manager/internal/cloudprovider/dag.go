@@ -0,0 +1,478 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	cmlock "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+)
+
+const (
+	// taskLockTTL dag task state lock ttl
+	taskLockTTL = time.Second * 30
+)
+
+// TaskStepDispatcher dispatch ready task steps to worker concurrently
+type TaskStepDispatcher func(task *proto.Task, steps []string) error
+
+var (
+	stepDispatcher TaskStepDispatcher
+
+	taskLocker    cmlock.DistributedLock
+	taskLocalLock sync.Mutex
+)
+
+// InitTaskStepDispatcher for dag task steps dispatcher initialization
+func InitTaskStepDispatcher(dispatcher TaskStepDispatcher) {
+	lock.Lock()
+	defer lock.Unlock()
+	stepDispatcher = dispatcher
+}
+
+// InitTaskLocker for dag task state distributed locker initialization
+func InitTaskLocker(locker cmlock.DistributedLock) {
+	lock.Lock()
+	defer lock.Unlock()
+	taskLocker = locker
+}
+
+// LockTask lock task state when dag task steps running concurrently, return unlock func.
+// use process lock when distributed locker not init, return error when distributed lock failed
+// and step retried by worker
+func LockTask(taskID string) (func(), error) {
+	if taskLocker == nil {
+		taskLocalLock.Lock()
+		return taskLocalLock.Unlock, nil
+	}
+
+	key := fmt.Sprintf("task-%s", taskID)
+	if err := taskLocker.Lock(key, cmlock.LockTTL(taskLockTTL)); err != nil {
+		blog.Errorf("task[%s] lock task state failed: %v", taskID, err)
+		return nil, fmt.Errorf("lock task %s state failed: %v", taskID, err)
+	}
+	return func() {
+		if err := taskLocker.Unlock(key); err != nil {
+			blog.Errorf("task[%s] unlock task state failed: %v", taskID, err)
+		}
+	}, nil
+}
+
+// IsDAGTask check task steps declare dependencies
+func IsDAGTask(task *proto.Task) bool {
+	if task == nil {
+		return false
+	}
+	for _, step := range task.Steps {
+		if len(step.GetDependsOn()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTaskStepDepends get task step dependent steps, step depends on previous step in
+// StepSequence when not declare dependencies
+func GetTaskStepDepends(task *proto.Task, stepName string) []string {
+	step, ok := task.Steps[stepName]
+	if ok && len(step.GetDependsOn()) > 0 {
+		return step.GetDependsOn()
+	}
+	for i := range task.StepSequence {
+		if task.StepSequence[i] == stepName && i > 0 {
+			return []string{task.StepSequence[i-1]}
+		}
+	}
+	return nil
+}
+
+// isStepDone check step done, failed step also done when skipOnFailed
+func isStepDone(step *proto.Step) bool {
+	switch step.Status {
+	case TaskStatusSuccess, TaskStatusPartFailure, TaskStatusSkip:
+		return true
+	case TaskStatusFailure:
+		return step.SkipOnFailed
+	default:
+	}
+	return false
+}
+
+// IsTaskStepDependDone check task step dependent steps all done
+func IsTaskStepDependDone(task *proto.Task, stepName string) bool {
+	for _, name := range GetTaskStepDepends(task, stepName) {
+		step, ok := task.Steps[name]
+		if !ok || !isStepDone(step) {
+			return false
+		}
+	}
+	return true
+}
+
+// isStepAttempted check step already running or done, failed step only run again by retry
+// or skip task
+func isStepAttempted(step *proto.Step) bool {
+	switch step.Status {
+	case TaskStatusRunning, TaskStatusSuccess, TaskStatusPartFailure, TaskStatusSkip, TaskStatusFailure:
+		return true
+	default:
+	}
+	return false
+}
+
+// IsDAGTaskDone check dag task steps all done
+func IsDAGTaskDone(task *proto.Task) bool {
+	for _, step := range task.Steps {
+		if !isStepDone(step) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetTaskReadySteps get task steps which dependent steps all done and not attempted yet
+func GetTaskReadySteps(task *proto.Task) []string {
+	steps := make([]string, 0)
+	if task == nil {
+		return steps
+	}
+
+	for _, name := range task.StepSequence {
+		step, ok := task.Steps[name]
+		if !ok || isStepAttempted(step) {
+			continue
+		}
+		if IsTaskStepDependDone(task, name) {
+			steps = append(steps, name)
+		}
+	}
+
+	return steps
+}
+
+// MarkTaskStepsInit mark task steps ready to dispatch, steps state must be stored before dispatching
+func MarkTaskStepsInit(task *proto.Task, steps []string) {
+	now := time.Now().Format(time.RFC3339)
+	for _, name := range steps {
+		step, ok := task.Steps[name]
+		if !ok {
+			continue
+		}
+		step.Status = TaskStatusInit
+		step.Message = "step ready to dispatch"
+		step.LastUpdate = now
+	}
+}
+
+// GetDAGBlockedStep get failed step which blocks dag task, dag task blocked when no steps
+// running or ready and failed step not skip on failed
+func GetDAGBlockedStep(task *proto.Task) string {
+	if len(GetTaskReadySteps(task)) > 0 {
+		return ""
+	}
+	blocked := ""
+	for _, name := range task.StepSequence {
+		step, ok := task.Steps[name]
+		if !ok {
+			continue
+		}
+		switch step.Status {
+		case TaskStatusRunning, TaskStatusInit:
+			return ""
+		case TaskStatusFailure:
+			if !step.SkipOnFailed && blocked == "" {
+				blocked = name
+			}
+		default:
+		}
+	}
+	return blocked
+}
+
+// ValidateTaskDAG check task step dependencies exist and no cycle
+func ValidateTaskDAG(task *proto.Task) error {
+	inDegree := make(map[string]int)
+	children := make(map[string][]string)
+	for _, name := range task.StepSequence {
+		if _, ok := inDegree[name]; !ok {
+			inDegree[name] = 0
+		}
+		for _, depend := range GetTaskStepDepends(task, name) {
+			if _, ok := task.Steps[depend]; !ok {
+				return fmt.Errorf("step %s depends on step %s not exist", name, depend)
+			}
+			if depend == name {
+				return fmt.Errorf("step %s depends on itself", name)
+			}
+			inDegree[name]++
+			children[depend] = append(children[depend], name)
+		}
+	}
+
+	// topological sort, steps in cycle never be visited
+	queue := make([]string, 0)
+	for _, name := range task.StepSequence {
+		if inDegree[name] == 0 {
+			queue = append(queue, name)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, child := range children[name] {
+			inDegree[child]--
+			if inDegree[child] == 0 {
+				queue = append(queue, child)
+			}
+		}
+	}
+	if visited != len(inDegree) {
+		return fmt.Errorf("task %s step dependencies exist cycle", task.TaskID)
+	}
+
+	return nil
+}
+
+// SetParallelTaskSteps make consecutive steps in StepSequence run concurrently, all steps depend on
+// the step before them, and the step after them depends on all of them
+func SetParallelTaskSteps(task *proto.Task, steps ...string) {
+	if len(steps) < 2 {
+		return
+	}
+	branches := make([][]string, 0, len(steps))
+	for _, name := range steps {
+		branches = append(branches, []string{name})
+	}
+	SetParallelTaskBranches(task, branches...)
+}
+
+// SetParallelTaskBranches make consecutive branches in StepSequence run concurrently, steps in one branch
+// run by order. branch first step depends on the steps before branches, and the step after branches
+// depends on all branch last steps. parallel groups must be set by StepSequence order after all steps built
+func SetParallelTaskBranches(task *proto.Task, branches ...[]string) {
+	if len(branches) < 2 {
+		return
+	}
+	index := make(map[string]int, len(task.StepSequence))
+	for i, name := range task.StepSequence {
+		index[name] = i
+	}
+	first, last := len(task.StepSequence), -1
+	for _, branch := range branches {
+		for _, name := range branch {
+			i, ok := index[name]
+			if !ok {
+				blog.Warnf("task[%s] branch step %s not exist", task.TaskID, name)
+				return
+			}
+			if i < first {
+				first = i
+			}
+			if i > last {
+				last = i
+			}
+		}
+	}
+	// parallel branches must depend on previous step
+	if first <= 0 || last < first {
+		blog.Warnf("task[%s] branches %v not support run concurrently", task.TaskID, branches)
+		return
+	}
+
+	// first step already depends on last steps of previous parallel group
+	previous := []string{task.StepSequence[first-1]}
+	if depends := task.Steps[task.StepSequence[first]].GetDependsOn(); len(depends) > 0 {
+		previous = depends
+	}
+	tails := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		if len(branch) == 0 {
+			continue
+		}
+		depends := previous
+		for _, name := range branch {
+			task.Steps[name].DependsOn = append([]string{}, depends...)
+			depends = []string{name}
+		}
+		tails = append(tails, branch[len(branch)-1])
+	}
+	if last+1 < len(task.StepSequence) {
+		if next, ok := task.Steps[task.StepSequence[last+1]]; ok {
+			next.DependsOn = tails
+		}
+	}
+}
+
+// GetBranchParamKey get common params key scoped by step branch, return origin key when step not in branch
+func GetBranchParamKey(step *proto.Step, key string) string {
+	branch := step.GetParams()[BranchKey.String()]
+	if branch == "" {
+		return key
+	}
+	return key + "-" + branch
+}
+
+// MergeCommonParams merge values into task common params list split by comma, branch steps of dag task
+// merge params written by other branch steps concurrently
+func (stat *TaskState) MergeCommonParams(key string, values []string) {
+	if stat.Task.CommonParams == nil {
+		stat.Task.CommonParams = make(map[string]string)
+	}
+	if stat.mergeParams == nil {
+		stat.mergeParams = make(map[string][]string)
+	}
+	stat.mergeParams[key] = append(stat.mergeParams[key], values...)
+	stat.Task.CommonParams[key] = mergeParamValues(stat.Task.CommonParams[key], values)
+}
+
+// mergeParamValues merge values into params value split by comma and remove duplicated
+func mergeParamValues(origin string, values []string) string {
+	result := make([]string, 0)
+	exist := make(map[string]bool)
+	for _, v := range append(strings.Split(origin, ","), values...) {
+		if v == "" || exist[v] {
+			continue
+		}
+		exist[v] = true
+		result = append(result, v)
+	}
+	return strings.Join(result, ",")
+}
+
+// isLastStep check step is the last running step of task
+func (stat *TaskState) isLastStep(stepName string) bool {
+	if !IsDAGTask(stat.Task) {
+		return stepName == stat.Task.StepSequence[len(stat.Task.StepSequence)-1]
+	}
+	for name, step := range stat.Task.Steps {
+		if name != stepName && !isStepDone(step) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRunningSteps check task other steps running
+func (stat *TaskState) hasRunningSteps(stepName string) bool {
+	for name, step := range stat.Task.Steps {
+		if name != stepName && step.Status == TaskStatusRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// syncDAGTask sync other steps state from storage before update dag task step state,
+// current step state and common params changed by current step keep local value
+func (stat *TaskState) syncDAGTask(stepName string) error {
+	task, err := GetStorageModel().GetTask(context.Background(), stat.Task.TaskID)
+	if err != nil {
+		blog.Errorf("task[%s] sync dag task from storage failed, %s", stat.Task.TaskID, err.Error())
+		return err
+	}
+	if task.CommonParams == nil {
+		task.CommonParams = make(map[string]string)
+	}
+	for k, v := range stat.Task.CommonParams {
+		if origin, ok := stat.commonParams[k]; ok && origin == v {
+			continue
+		}
+		if values, ok := stat.mergeParams[k]; ok {
+			task.CommonParams[k] = mergeParamValues(task.CommonParams[k], values)
+			continue
+		}
+		task.CommonParams[k] = v
+	}
+	if step, ok := stat.Task.Steps[stepName]; ok {
+		task.Steps[stepName] = step
+	}
+	stat.Task = task
+
+	return nil
+}
+
+// isDAGTaskStopped check dag task already stopped by other branch steps
+func (stat *TaskState) isDAGTaskStopped() bool {
+	switch stat.Task.Status {
+	case TaskStatusRunning, TaskStatusInit, TaskStatusCanceled:
+		return false
+	default:
+	}
+	return true
+}
+
+// updateDAGStepOnly only update dag step state when task already stopped by other branch steps
+func (stat *TaskState) updateDAGStepOnly(stepName string) error {
+	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+		blog.Errorf("task %s fatal, update step %s status failed, %s. required admin intervetion",
+			stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+	blog.Infof("task %s status %s, step %s done with status %s", stat.Task.TaskID, stat.Task.Status,
+		stepName, stat.Task.Steps[stepName].Status)
+	return nil
+}
+
+// markDAGReadySteps mark ready steps dispatched, avoid dispatching steps repeatedly
+func (stat *TaskState) markDAGReadySteps() []string {
+	steps := make([]string, 0)
+	for _, name := range GetTaskReadySteps(stat.Task) {
+		if stat.Task.Steps[name].Status == TaskStatusInit {
+			continue
+		}
+		steps = append(steps, name)
+	}
+	MarkTaskStepsInit(stat.Task, steps)
+	return steps
+}
+
+// failBlockedDAGTask make dag task failure when failed steps of other branches block task,
+// failed step as current step and retry or skip it
+func (stat *TaskState) failBlockedDAGTask(end time.Time) {
+	blocked := GetDAGBlockedStep(stat.Task)
+	if blocked == "" {
+		return
+	}
+	taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
+	stat.Task.CurrentStep = blocked
+	stat.Task.End = end.Format(time.RFC3339)
+	stat.Task.ExecutionTime = uint32(end.Unix() - taskStart.Unix())
+	stat.Task.Status = TaskStatusFailure
+	stat.Task.Message = fmt.Sprintf("step %s running failed, %s", blocked, stat.Task.Steps[blocked].Message)
+	blog.Infof("task %s blocked by failed step %s", stat.Task.TaskID, blocked)
+}
+
+// dispatchDAGSteps dispatch ready steps to worker
+func (stat *TaskState) dispatchDAGSteps(steps []string) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	if stepDispatcher == nil {
+		return fmt.Errorf("task step dispatcher not init")
+	}
+	if err := stepDispatcher(stat.Task, steps); err != nil {
+		blog.Errorf("task[%s] dispatch ready steps %v failed: %v", stat.Task.TaskID, steps, err)
+		return err
+	}
+	blog.Infof("task[%s] dispatch ready steps %v successful", stat.Task.TaskID, steps)
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudprovider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/golang/protobuf/proto"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func buildTestTask(steps ...string) *proto.Task {
+	task := &proto.Task{
+		TaskID:       "test",
+		Steps:        make(map[string]*proto.Step),
+		StepSequence: make([]string, 0),
+	}
+	for _, name := range steps {
+		task.Steps[name] = &proto.Step{Name: name, Status: TaskStatusNotStarted}
+		task.StepSequence = append(task.StepSequence, name)
+	}
+	return task
+}
+
+func TestSetParallelTaskSteps(t *testing.T) {
+	task := buildTestTask("a", "b", "c", "d", "e")
+	if IsDAGTask(task) {
+		t.Fatalf("task without dependencies should not be dag task")
+	}
+	SetParallelTaskSteps(task, "b", "c", "d")
+
+	if !IsDAGTask(task) {
+		t.Fatalf("task with parallel steps should be dag task")
+	}
+	if err := ValidateTaskDAG(task); err != nil {
+		t.Fatalf("ValidateTaskDAG failed: %v", err)
+	}
+	if got := GetTaskStepDepends(task, "e"); !reflect.DeepEqual(got, []string{"b", "c", "d"}) {
+		t.Fatalf("step e depends on %v", got)
+	}
+
+	task.Steps["a"].Status = TaskStatusSuccess
+	if got := GetTaskReadySteps(task); !reflect.DeepEqual(got, []string{"b", "c", "d"}) {
+		t.Fatalf("ready steps %v", got)
+	}
+
+	task.Steps["b"].Status = TaskStatusSuccess
+	task.Steps["c"].Status = TaskStatusFailure
+	task.Steps["d"].Status = TaskStatusRunning
+	if got := GetTaskReadySteps(task); len(got) != 0 {
+		t.Fatalf("ready steps %v", got)
+	}
+	if got := GetDAGBlockedStep(task); got != "" {
+		t.Fatalf("task blocked by step %s when step d running", got)
+	}
+
+	task.Steps["d"].Status = TaskStatusSuccess
+	if got := GetDAGBlockedStep(task); got != "c" {
+		t.Fatalf("task blocked by step %s", got)
+	}
+
+	task.Steps["c"].SkipOnFailed = true
+	if got := GetTaskReadySteps(task); !reflect.DeepEqual(got, []string{"e"}) {
+		t.Fatalf("ready steps %v", got)
+	}
+	if got := GetDAGBlockedStep(task); got != "" {
+		t.Fatalf("task blocked by step %s when step e ready", got)
+	}
+
+	MarkTaskStepsInit(task, []string{"e"})
+	if task.Steps["e"].Status != TaskStatusInit {
+		t.Fatalf("step e status %s", task.Steps["e"].Status)
+	}
+}
+
+func TestValidateTaskDAG(t *testing.T) {
+	task := buildTestTask("a", "b", "c")
+	task.Steps["b"].DependsOn = []string{"c"}
+	if err := ValidateTaskDAG(task); err == nil {
+		t.Fatalf("task with cycle dependencies should be invalid")
+	}
+
+	task = buildTestTask("a", "b")
+	task.Steps["b"].DependsOn = []string{"x"}
+	if err := ValidateTaskDAG(task); err == nil {
+		t.Fatalf("task with not exist dependencies should be invalid")
+	}
+}
+
+func TestSetParallelTaskBranches(t *testing.T) {
+	task := buildTestTask("a", "b1", "c1", "b2", "c2", "d", "e1", "e2", "f")
+	SetParallelTaskBranches(task, []string{"b1", "c1"}, []string{"b2", "c2"})
+	SetParallelTaskSteps(task, "e1", "e2")
+	if err := ValidateTaskDAG(task); err != nil {
+		t.Fatalf("ValidateTaskDAG failed: %v", err)
+	}
+
+	expected := map[string][]string{
+		"b1": {"a"}, "c1": {"b1"}, "b2": {"a"}, "c2": {"b2"},
+		"d": {"c1", "c2"}, "e1": {"d"}, "e2": {"d"}, "f": {"e1", "e2"},
+	}
+	for name, depends := range expected {
+		if got := GetTaskStepDepends(task, name); !reflect.DeepEqual(got, depends) {
+			t.Fatalf("step %s depends on %v, expected %v", name, got, depends)
+		}
+	}
+
+	task.Steps["a"].Status = TaskStatusSuccess
+	task.Steps["b1"].Status = TaskStatusSuccess
+	if got := GetTaskReadySteps(task); !reflect.DeepEqual(got, []string{"c1", "b2"}) {
+		t.Fatalf("ready steps %v", got)
+	}
+}
+
+// buildBranchTask build dag task which steps b1 and b2 run concurrently, step b1 failed and b2 done
+func buildBranchTask(t *testing.T) *taskModel {
+	task := buildTestTask("a", "b1", "b2", "c")
+	SetParallelTaskSteps(task, "b1", "b2")
+	task.Status = TaskStatusRunning
+	task.Start = time.Now().Format(time.RFC3339)
+	task.Steps["a"].Status = TaskStatusSuccess
+	task.Steps["b1"].Status = TaskStatusRunning
+	task.Steps["b2"].Status = TaskStatusRunning
+	model := &taskModel{tasks: map[string]*proto.Task{task.TaskID: pb.Clone(task).(*proto.Task)}}
+	InitStorageModel(model)
+
+	b1 := &TaskState{Task: pb.Clone(task).(*proto.Task)}
+	if err := b1.UpdateStepFailure(time.Now(), "b1", errors.New("failed")); err != nil {
+		t.Fatalf("UpdateStepFailure failed: %v", err)
+	}
+	b2 := &TaskState{Task: pb.Clone(task).(*proto.Task)}
+	if err := b2.UpdateStepSucc(time.Now(), "b2"); err != nil {
+		t.Fatalf("UpdateStepSucc failed: %v", err)
+	}
+	return model
+}
+
+func TestDAGTaskRetryBranch(t *testing.T) {
+	var dispatched []string
+	InitTaskStepDispatcher(func(task *proto.Task, steps []string) error {
+		dispatched = append(dispatched, steps...)
+		return nil
+	})
+	defer InitTaskStepDispatcher(nil)
+	model := buildBranchTask(t)
+	defer InitStorageModel(nil)
+
+	task, _ := model.GetTask(context.Background(), "test")
+	if task.Status != TaskStatusFailure || task.CurrentStep != "b1" {
+		t.Fatalf("task status %s current step %s", task.Status, task.CurrentStep)
+	}
+	if task.Steps["b2"].Status != TaskStatusSuccess || len(dispatched) != 0 {
+		t.Fatalf("step b2 status %s, dispatched %v", task.Steps["b2"].Status, dispatched)
+	}
+
+	// retry task only run failed branch step again
+	task.Status = TaskStatusRunning
+	task.Steps["b1"].Status = TaskStatusNotStarted
+	if got := GetTaskReadySteps(task); !reflect.DeepEqual(got, []string{"b1"}) {
+		t.Fatalf("retry ready steps %v", got)
+	}
+	task.Steps["b1"].Status = TaskStatusRunning
+	_ = model.UpdateTask(context.Background(), task)
+
+	b1 := &TaskState{Task: pb.Clone(task).(*proto.Task)}
+	if err := b1.UpdateStepSucc(time.Now(), "b1"); err != nil {
+		t.Fatalf("UpdateStepSucc failed: %v", err)
+	}
+	if !reflect.DeepEqual(dispatched, []string{"c"}) {
+		t.Fatalf("dispatched steps %v after retry", dispatched)
+	}
+	task, _ = model.GetTask(context.Background(), "test")
+	if task.Status != TaskStatusRunning || task.Steps["b2"].Status != TaskStatusSuccess {
+		t.Fatalf("task status %s step b2 status %s", task.Status, task.Steps["b2"].Status)
+	}
+}
+
+func TestDAGTaskSkipBranch(t *testing.T) {
+	InitTaskStepDispatcher(func(task *proto.Task, steps []string) error { return nil })
+	defer InitTaskStepDispatcher(nil)
+	model := buildBranchTask(t)
+	defer InitStorageModel(nil)
+
+	// skip task only skip failed branch step, steps after branches ready
+	task, _ := model.GetTask(context.Background(), "test")
+	task.Steps[task.CurrentStep].Status = TaskStatusSkip
+	if got := GetTaskReadySteps(task); !reflect.DeepEqual(got, []string{"c"}) {
+		t.Fatalf("skip ready steps %v", got)
+	}
+
+	// other branch step failed too, skip one branch step still blocked by the other
+	task, _ = model.GetTask(context.Background(), "test")
+	task.Steps["b2"].Status = TaskStatusFailure
+	task.Steps["b1"].Status = TaskStatusSkip
+	if got := GetTaskReadySteps(task); len(got) != 0 {
+		t.Fatalf("skip ready steps %v", got)
+	}
+	if got := GetDAGBlockedStep(task); got != "b2" {
+		t.Fatalf("task blocked by step %s", got)
+	}
+}
+
+func TestMergeParamValues(t *testing.T) {
+	if got := mergeParamValues("a,b", []string{"b", "c", ""}); got != "a,b,c" {
+		t.Fatalf("merge params %s", got)
+	}
+	if got := mergeParamValues("", []string{"a"}); got != "a" {
+		t.Fatalf("merge params %s", got)
+	}
+
+	step := &proto.Step{Params: map[string]string{}}
+	if got := GetBranchParamKey(step, "key"); got != "key" {
+		t.Fatalf("branch param key %s", got)
+	}
+	step.Params[BranchKey.String()] = "zone"
+	if got := GetBranchParamKey(step, "key"); got != "key-zone" {
+		t.Fatalf("branch param key %s", got)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v2/tasks"
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// TaskDispatcher dispatch task to worker
+type TaskDispatcher func(task *proto.Task) error
+
+var taskDispatcher TaskDispatcher
+
+// InitTaskDispatcher for sub task dispatcher initialization
+func InitTaskDispatcher(dispatcher TaskDispatcher) {
+	lock.Lock()
+	defer lock.Unlock()
+	taskDispatcher = dispatcher
+}
+
+// CreateAndDispatchTask save task to storage and dispatch it, used by steps which run other tasks
+func CreateAndDispatchTask(ctx context.Context, task *proto.Task) error {
+	if taskDispatcher == nil {
+		return fmt.Errorf("task dispatcher not init")
+	}
+
+	if err := GetStorageModel().CreateTask(ctx, task); err != nil {
+		blog.Errorf("CreateAndDispatchTask[%s] create task[%s] failed: %v",
+			GetTaskIDFromContext(ctx), task.TaskID, err)
+		return err
+	}
+	if err := taskDispatcher(task); err != nil {
+		blog.Errorf("CreateAndDispatchTask[%s] dispatch task[%s] failed: %v",
+			GetTaskIDFromContext(ctx), task.TaskID, err)
+		return err
+	}
+
+	return nil
+}
+
+// CheckTaskDone check task terminated without blocking, return false when task still running and
+// error when task is not successful
+func CheckTaskDone(ctx context.Context, taskID string) (bool, *proto.Task, error) {
+	task, err := GetStorageModel().GetTask(ctx, taskID)
+	if err != nil {
+		blog.Errorf("CheckTaskDone[%s] get task[%s] failed: %v", GetTaskIDFromContext(ctx), taskID, err)
+		return false, nil, err
+	}
+
+	switch task.Status {
+	case TaskStatusInit, TaskStatusRunning, TaskStatusNotStarted, TaskStatusRollingBack:
+		return false, task, nil
+	case TaskStatusSuccess:
+		return true, task, nil
+	default:
+		return true, task, fmt.Errorf("task %s status %s: %s", taskID, task.Status, task.Message)
+	}
+}
+
+// RetryStepLater return error which makes worker re-enqueue current step after retryIn, step keeps
+// running and worker is released when step waits long running sub tasks
+func RetryStepLater(msg string, retryIn time.Duration) error {
+	return tasks.NewErrRetryTaskLater(msg, retryIn)
+}
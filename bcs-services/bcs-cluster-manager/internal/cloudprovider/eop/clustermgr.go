@@ -120,6 +120,12 @@ func (c *Cluster) DeleteVirtualCluster(cls *cmproto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *cmproto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // GetCluster get kubernetes cluster detail information according cloudprovider
 func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*cmproto.Cluster, error) {
 	return nil, cloudprovider.ErrCloudNotImplemented
@@ -81,6 +81,12 @@ func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildCreateClusterTask build create cluster task
 func (t *Task) BuildCreateClusterTask(
 	cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
@@ -157,6 +157,12 @@ func (c *CloudValidate) ListInstancesValidate(req *proto.ListCloudInstancesReque
 	return cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeClusterValidate xxx
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
 // ListInstanceTypeValidate xxx
 func (c *CloudValidate) ListInstanceTypeValidate(req *proto.ListCloudInstanceTypeRequest,
 	account *proto.Account) error {
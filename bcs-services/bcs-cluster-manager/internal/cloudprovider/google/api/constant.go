@@ -13,6 +13,16 @@
 package api
 
 const (
+	// ClusterStatusRunning indicates the cluster has been created and is fully usable
+	ClusterStatusRunning = "RUNNING"
+	// ClusterStatusReconciling indicates that some work is actively being done on the cluster,
+	// such as upgrading the master or node software
+	ClusterStatusReconciling = "RECONCILING"
+	// ClusterStatusError indicates the cluster is unusable
+	ClusterStatusError = "ERROR"
+	// ClusterStatusDegraded indicates the cluster requires user action to restore full functionality
+	ClusterStatusDegraded = "DEGRADED"
+
 	// NodeGroupStatusProvisioning indicates the node pool is being created
 	NodeGroupStatusProvisioning = "PROVISIONING"
 	// NodeGroupStatusRunning indicates the node pool has been created and is fully usable
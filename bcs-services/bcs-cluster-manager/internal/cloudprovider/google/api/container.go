@@ -139,6 +139,24 @@ func (cs *ContainerServiceClient) DeleteCluster(ctx context.Context, clusterName
 	return nil
 }
 
+// UpdateClusterMasterVersion update the cluster control plane version
+func (cs *ContainerServiceClient) UpdateClusterMasterVersion(ctx context.Context, clusterName, version string) (
+	*container.Operation, error) {
+	parent := "projects/" + cs.gkeProjectID + "/locations/" + cs.region + "/clusters/" + clusterName
+	req := &container.UpdateClusterRequest{
+		Update: &container.ClusterUpdate{
+			DesiredMasterVersion: version,
+		},
+	}
+	o, err := cs.containerServiceClient.Projects.Locations.Clusters.Update(parent, req).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("gke client UpdateClusterMasterVersion failed: %v", err)
+	}
+	blog.Infof("gke client UpdateClusterMasterVersion[%s] successful, operation ID: %s", clusterName, o.SelfLink)
+
+	return o, nil
+}
+
 // CreateClusterNodePool create a node pool
 func (cs *ContainerServiceClient) CreateClusterNodePool(ctx context.Context, req *CreateNodePoolRequest,
 	clusterName string) (*container.Operation, error) {
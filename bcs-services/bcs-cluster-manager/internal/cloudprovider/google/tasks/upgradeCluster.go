@@ -0,0 +1,332 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	container "google.golang.org/api/container/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/google/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+// UpgradeGKEClusterMasterTask upgrade gke cluster control plane version
+func UpgradeGKEClusterMasterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeGKEClusterMasterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeGKEClusterMasterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeGKEClusterMasterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	client, err := api.NewContainerServiceClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpgradeGKEClusterMasterTask[%s]: get gke client failed: %v", taskID, err)
+		retErr := fmt.Errorf("get google container service client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// control plane version maybe upgraded when retry task
+	ctx := context.Background()
+	gkeCluster, err := client.GetCluster(ctx, dependInfo.Cluster.SystemID)
+	if err == nil && utils.IsKubeVersionAtLeast(gkeCluster.CurrentMasterVersion, version) {
+		blog.Infof("UpgradeGKEClusterMasterTask[%s]: cluster[%s] version[%s] already upgraded",
+			taskID, clusterID, gkeCluster.CurrentMasterVersion)
+	} else {
+		_, err = client.UpdateClusterMasterVersion(ctx, dependInfo.Cluster.SystemID, version)
+		if err != nil {
+			blog.Errorf("UpgradeGKEClusterMasterTask[%s]: UpdateClusterMasterVersion[%s] failed: %v",
+				taskID, clusterID, err)
+			retErr := fmt.Errorf("call google UpdateClusterMasterVersion failed: %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	blog.Infof("UpgradeGKEClusterMasterTask[%s]: cluster[%s] upgrade master to version[%s] successful",
+		taskID, clusterID, version)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeGKEClusterMasterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckGKEClusterUpgradeStatusTask check gke cluster control plane upgrade status
+func CheckGKEClusterUpgradeStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckGKEClusterUpgradeStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckGKEClusterUpgradeStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckGKEClusterUpgradeStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = checkClusterUpgradeStatus(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("CheckGKEClusterUpgradeStatusTask[%s] checkClusterUpgradeStatus[%s] failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("checkClusterUpgradeStatus[%s] failed: %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckGKEClusterUpgradeStatusTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterUpgradeStatus wait cluster running and control plane version upgraded
+func checkClusterUpgradeStatus(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	client, err := api.NewContainerServiceClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] get gke client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get google container service client err, %s", err.Error())
+	}
+
+	var (
+		failed = false
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Minute)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		cluster, errGet := client.GetCluster(ctx, info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("checkClusterUpgradeStatus[%s] GetCluster failed: %v", taskID, errGet)
+			return nil
+		}
+		if cluster == nil {
+			return nil
+		}
+
+		blog.Infof("checkClusterUpgradeStatus[%s] cluster[%s] current status[%s] version[%s]", taskID,
+			info.Cluster.ClusterID, cluster.Status, cluster.CurrentMasterVersion)
+
+		switch cluster.Status {
+		case api.ClusterStatusRunning:
+			if utils.IsKubeVersionAtLeast(cluster.CurrentMasterVersion, version) {
+				return loop.EndLoop
+			}
+		case api.ClusterStatusError, api.ClusterStatusDegraded:
+			failed = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] cluster[%s] failed: %v", taskID, info.Cluster.ClusterID, err)
+		return err
+	}
+
+	if failed {
+		return fmt.Errorf("cluster[%s] status failed", info.Cluster.ClusterID)
+	}
+
+	return nil
+}
+
+// UpgradeGKENodeGroupTask upgrade gke nodePool version, gke replace nodes by surge upgrade
+func UpgradeGKENodeGroupTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeGKENodeGroupTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeGKENodeGroupTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeGKENodeGroupTask[%s]: GetClusterDependBasicInfo for nodeGroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeNodePoolVersion(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("UpgradeGKENodeGroupTask[%s] upgradeNodePoolVersion[%s] failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("upgradeNodePoolVersion[%s] failed: %s", nodeGroupID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeGKENodeGroupTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// upgradeNodePoolVersion call gke UpdateNodePool and wait nodePool running
+func upgradeNodePoolVersion(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+	cluster, group := info.Cluster, info.NodeGroup
+
+	client, err := api.NewContainerServiceClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("upgradeNodePoolVersion[%s] get gke client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get google container service client err, %s", err.Error())
+	}
+
+	// nodePool version maybe upgraded when retry task
+	np, err := client.GetClusterNodePool(ctx, cluster.SystemID, group.CloudNodeGroupID)
+	if err != nil {
+		blog.Errorf("upgradeNodePoolVersion[%s] GetClusterNodePool[%s] failed: %v", taskID,
+			group.CloudNodeGroupID, err)
+		return err
+	}
+	if !utils.IsKubeVersionAtLeast(np.Version, version) {
+		// imageType is required when update nodePool version, keep it unchanged
+		req := &container.UpdateNodePoolRequest{
+			NodeVersion: version,
+		}
+		if np.Config != nil {
+			req.ImageType = np.Config.ImageType
+		}
+		_, err = client.UpdateClusterNodePool(ctx, req, cluster.SystemID, group.CloudNodeGroupID)
+		if err != nil {
+			blog.Errorf("upgradeNodePoolVersion[%s] UpdateClusterNodePool[%s] failed: %v", taskID,
+				group.CloudNodeGroupID, err)
+			return err
+		}
+	}
+
+	var (
+		failed = false
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Hour)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		np, getErr := client.GetClusterNodePool(ctx, cluster.SystemID, group.CloudNodeGroupID)
+		if getErr != nil {
+			blog.Errorf("upgradeNodePoolVersion[%s] GetClusterNodePool[%s] failed: %v", taskID,
+				group.CloudNodeGroupID, getErr)
+			return nil
+		}
+
+		blog.Infof("upgradeNodePoolVersion[%s] nodeGroup[%s] current status[%s] version[%s]", taskID,
+			group.NodeGroupID, np.Status, np.Version)
+
+		switch np.Status {
+		case api.NodeGroupStatusRunning:
+			if utils.IsKubeVersionAtLeast(np.Version, version) {
+				return loop.EndLoop
+			}
+		case api.NodeGroupStatusError:
+			failed = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("upgradeNodePoolVersion[%s] nodeGroup[%s] failed: %v", taskID, group.NodeGroupID, err)
+		return err
+	}
+
+	if failed {
+		return fmt.Errorf("nodeGroup[%s] status error", group.NodeGroupID)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
 )
 
 var (
@@ -29,6 +30,8 @@ var (
 const (
 	// deleteClusterTaskTemplate bk-sops add task template
 	deleteClusterTaskTemplate = "gke-delete cluster: %s"
+	// upgradeClusterTaskTemplate bk-sops add task template
+	upgradeClusterTaskTemplate = "gke-upgrade cluster: %s"
 	// gkeAddNodeTaskTemplate bk-sops add task template
 	gkeAddNodeTaskTemplate = "gke-add node: %s" // nolint
 	// gkeCleanNodeTaskTemplate bk-sops add task template
@@ -75,6 +78,20 @@ var (
 		StepName:   "清理集群数据",
 	}
 
+	// upgrade cluster task
+	upgradeGKEClusterMasterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeGKEClusterMasterTask", cloudName),
+		StepName:   "升级集群控制面版本",
+	}
+	checkGKEClusterUpgradeStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckGKEClusterUpgradeStatusTask", cloudName),
+		StepName:   "检测集群升级状态",
+	}
+	upgradeGKENodeGroupStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeGKENodeGroupTask", cloudName),
+		StepName:   "升级节点组版本",
+	}
+
 	// create nodeGroup task
 	createCloudNodeGroupStep = cloudprovider.StepInfo{
 		StepMethod: fmt.Sprintf("%s-CreateCloudNodeGroupTask", cloudName),
@@ -145,6 +162,66 @@ func (ic *ImportClusterTaskOption) BuildImportClusterNodesStep(task *proto.Task)
 	task.StepSequence = append(task.StepSequence, importClusterNodesStep.StepMethod)
 }
 
+// UpgradeClusterTaskOption 升级集群
+type UpgradeClusterTaskOption struct {
+	Cluster        *proto.Cluster
+	Version        string
+	NodeGroups     []*proto.NodeGroup
+	NodeGroupNodes map[string][]*proto.Node
+	DrainTimeout   uint32
+}
+
+// BuildUpgradeMasterStep 升级集群控制面版本
+func (uc *UpgradeClusterTaskOption) BuildUpgradeMasterStep(task *proto.Task) {
+	upgradeStep := cloudprovider.InitTaskStep(upgradeGKEClusterMasterStep)
+	upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[upgradeGKEClusterMasterStep.StepMethod] = upgradeStep
+	task.StepSequence = append(task.StepSequence, upgradeGKEClusterMasterStep.StepMethod)
+}
+
+// BuildCheckUpgradeStatusStep 检测集群升级状态
+func (uc *UpgradeClusterTaskOption) BuildCheckUpgradeStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkGKEClusterUpgradeStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[checkGKEClusterUpgradeStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkGKEClusterUpgradeStatusStep.StepMethod)
+}
+
+// BuildUpgradeNodeGroupsStep 节点组依次排水/升级/恢复调度
+func (uc *UpgradeClusterTaskOption) BuildUpgradeNodeGroupsStep(task *proto.Task) {
+	for _, group := range uc.NodeGroups {
+		nodeIPs := make([]string, 0)
+		for _, node := range uc.NodeGroupNodes[group.NodeGroupID] {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+		}
+
+		common.BuildDrainNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs, uc.DrainTimeout)
+
+		upgradeStep := cloudprovider.InitTaskStep(upgradeGKENodeGroupStep)
+		stepName := common.GetNodeGroupStepName(upgradeGKENodeGroupStep.StepMethod, group.NodeGroupID)
+		upgradeStep.Name = stepName
+		upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+		upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+		upgradeStep.Params[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+		upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+		task.Steps[stepName] = upgradeStep
+		task.StepSequence = append(task.StepSequence, stepName)
+
+		common.BuildUnCordonGroupNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs)
+	}
+}
+
+// BuildUpdateClusterVersionStep 更新集群版本数据
+func (uc *UpgradeClusterTaskOption) BuildUpdateClusterVersionStep(task *proto.Task) {
+	common.BuildUpdateClusterVersionTaskStep(task, uc.Cluster.ClusterID, uc.Version)
+}
+
 // DeleteClusterTaskOption 删除集群
 type DeleteClusterTaskOption struct {
 	Cluster    *proto.Cluster
@@ -46,6 +46,41 @@ func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *
 	return cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeClusterValidate upgrade cluster validate
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || cls == nil || req == nil || opt == nil {
+		return fmt.Errorf("%s UpgradeClusterValidate request&options is empty", cloudName)
+	}
+
+	if opt.Account == nil || len(opt.Account.ServiceAccountSecret) == 0 || len(opt.Account.GkeProjectID) == 0 ||
+		len(opt.Region) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate opt lost valid crendential info", cloudName)
+	}
+
+	if len(cls.SystemID) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate cluster[%s] systemID empty", cloudName, cls.ClusterID)
+	}
+
+	// check version skew
+	err := cloudprovider.CheckClusterUpgradeVersion(cls, req.Version)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate failed: %v", cloudName, err)
+	}
+
+	// check gke cluster exist
+	cli, err := api.NewContainerServiceClient(opt)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate NewContainerServiceClient failed: %v", cloudName, err)
+	}
+	_, err = cli.GetCluster(context.Background(), cls.SystemID)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate GetCluster[%s] failed: %v", cloudName, cls.SystemID, err)
+	}
+
+	return nil
+}
+
 // ImportClusterValidate check importCluster operation
 func (c *CloudValidate) ImportClusterValidate(req *proto.ImportClusterReq, opt *cloudprovider.CommonOption) error {
 	// call cloud interface to check cluster
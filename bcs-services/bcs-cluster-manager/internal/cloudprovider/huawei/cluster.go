@@ -195,6 +195,12 @@ func (c *Cluster) DeleteVirtualCluster(cls *proto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // EnableExternalNodeSupport enable cluster support external node
 func (c *Cluster) EnableExternalNodeSupport(cls *proto.Cluster, opt *cloudprovider.EnableExternalNodeOption) error {
 	return nil
@@ -225,6 +225,12 @@ func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
 	return nil, nil
 }
 
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildDeleteClusterTask build deleteCluster task
 // NOCC:CCN_threshold(工具误报:),golint/fnsize(设计如此:)
 func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (
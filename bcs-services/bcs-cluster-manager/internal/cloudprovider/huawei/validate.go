@@ -292,6 +292,12 @@ func (c *CloudValidate) ListInstancesValidate(req *proto.ListCloudInstancesReque
 	return nil
 }
 
+// UpgradeClusterValidate xxx
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
 // ListKeyPairsValidate list key pairs validate
 func (c *CloudValidate) ListKeyPairsValidate(req *proto.ListKeyPairsRequest, account *proto.Account) error {
 	if c == nil || account == nil {
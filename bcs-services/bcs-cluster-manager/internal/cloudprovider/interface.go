@@ -270,6 +270,8 @@ type CloudValidateManager interface {
 	CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest, opt *CommonOption) error
 	// ListInstancesValidate ListInstanceTypeValidate list instance type validate
 	ListInstancesValidate(req *proto.ListCloudInstancesRequest, account *proto.Account) error
+	// UpgradeClusterValidate upgrade cluster version validate
+	UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq, opt *CommonOption) error
 }
 
 // ClusterManager cloud interface for kubernetes cluster management
@@ -284,6 +286,8 @@ type ClusterManager interface {
 	DeleteCluster(cls *proto.Cluster, opt *DeleteClusterOption) (*proto.Task, error)
 	// DeleteVirtualCluster delete virtual cluster in hostCluster according cloudprovider
 	DeleteVirtualCluster(cls *proto.Cluster, opt *DeleteVirtualClusterOption) (*proto.Task, error)
+	// UpgradeCluster upgrade kubernetes cluster master and nodeGroups version according cloudprovider
+	UpgradeCluster(cls *proto.Cluster, opt *UpgradeClusterOption) (*proto.Task, error)
 	// GetCluster get kubernetes cluster detail information according cloudprovider
 	GetCluster(cloudID string, opt *GetClusterOption) (*proto.Cluster, error)
 	// ListCluster get cloud cluster list by region
@@ -445,6 +449,8 @@ type TaskManager interface {
 	BuildCreateClusterTask(cls *proto.Cluster, opt *CreateClusterOption) (*proto.Task, error)
 	// BuildDeleteClusterTask delete cluster by different cloud provider
 	BuildDeleteClusterTask(cls *proto.Cluster, opt *DeleteClusterOption) (*proto.Task, error)
+	// BuildUpgradeClusterTask upgrade cluster version by different cloud provider
+	BuildUpgradeClusterTask(cls *proto.Cluster, opt *UpgradeClusterOption) (*proto.Task, error)
 	// BuildAddNodesToClusterTask add instances to cluster
 	BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node, opt *AddNodesOption) (*proto.Task, error)
 	// BuildRemoveNodesFromClusterTask remove instances from cluster
@@ -90,6 +90,12 @@ func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
 	return nil, cloudprovider.ErrCloudNotImplemented
 }
 
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildCreateNodeGroupTask build create node group task
 func (t *Task) BuildCreateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (
 	*proto.Task, error) {
@@ -218,7 +224,8 @@ func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.Node
 		Operator:  opt.Operator,
 	}
 
-	// step0: cluster cordon nodes
+	// step0: shield nodes alarm and cluster cordon nodes, shield expired after nodes returned
+	common.BuildShieldHostAlarmTaskStep(task, opt.Cluster.ClusterID, nodeIPs)
 	cleanTask.BuildCordonNodesStep(task)
 
 	// 业务自定义流程: 缩容前置流程支持 标准运维任务和执行业务job脚本
@@ -63,7 +63,7 @@ func RemoveNodesFromClusterTask(taskID, stepName string) error {
 	// inject taskID
 	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
 
-	success, err := business.RemoveNodesFromCluster(ctx, dependInfo, nodeIDs, true)
+	success, err := business.RemoveNodesFromCluster(ctx, dependInfo, cloudprovider.Retain.String(), nodeIDs, true)
 	if err != nil {
 		blog.Errorf("RemoveNodesFromClusterTask[%s] removeNodesFromCluster for NodeGroup %s to clean Node in task %s "+
 			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
@@ -131,7 +131,7 @@ func ReturnInstanceToResourcePoolTask(taskID, stepName string) error {
 
 	// inject taskID
 	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
-	cloudprovider.ShieldHostAlarm(ctx, dependInfo.Cluster.BusinessID, nodeIPList) // nolint
+	cloudprovider.ShieldHostAlarm(ctx, dependInfo.Cluster, nodeIPList) // nolint
 
 	// return device from resource-manager module if ResourceModule true, else retain yunti style
 	orderID, err := destroyDeviceList(ctx, dependInfo, deviceList, operator)
@@ -232,7 +232,7 @@ func AddNodesToClusterTask(taskID, stepName string) error { // nolint
 	idToIPMap := cloudprovider.GetIDToIPMap(instanceIDs, instanceIPs)
 
 	// shield nodes alarm
-	cloudprovider.ShieldHostAlarm(ctx, dependInfo.Cluster.BusinessID, instanceIPs) // nolint
+	cloudprovider.ShieldHostAlarm(ctx, dependInfo.Cluster, instanceIPs) // nolint
 
 	// tke cluster add nodes to cluster
 	result, err := business.AddNodesToCluster(ctx, dependInfo, nil, instanceIDs,
@@ -399,6 +399,9 @@ func CheckClusterNodeStatusTask(taskID, stepName string) error { // nolint
 		state.Task.CommonParams[cloudprovider.DynamicNodeIPListKey.String()] = strings.Join(ipList, ",")
 		state.Task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(ipList, ",")
 		state.Task.NodeIPList = ipList
+
+		// nodes ready, clean nodes alarm shield
+		cloudprovider.UnShieldHostAlarm(ctx, dependInfo.Cluster, ipList) // nolint
 	}
 
 	blog.Infof("CheckClusterNodeStatusTask[%s] instanceIP[%+v], instanceID[%+v]", taskID,
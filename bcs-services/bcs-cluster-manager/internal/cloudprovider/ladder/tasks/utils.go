@@ -298,7 +298,7 @@ func returnDevicesToRMAndCleanNodes(ctx context.Context, info *cloudprovider.Clo
 
 	// delete cluster instances
 	if delInstance {
-		successIDs, err := business.DeleteClusterInstance(ctx, info, instanceIDs, true)
+		successIDs, err := business.DeleteClusterInstance(ctx, info, cloudprovider.Retain.String(), instanceIDs, true)
 		if err != nil {
 			blog.Errorf("returnDevicesToRMAndCleanNodes[%s] DeleteClusterInstance failed: %v", taskID, err)
 		} else {
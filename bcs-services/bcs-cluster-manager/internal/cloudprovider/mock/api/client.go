@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package api xxx
+package api
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+// Options mock cloud behavior options
+type Options struct {
+	// Latency every cloud api latency
+	Latency time.Duration
+	// FaultRatio cloud api fault injection ratio
+	FaultRatio float64
+	// FaultActions cloud api actions which inject fault, all actions when empty
+	FaultActions []string
+	// ProvisionTime time for resources from creating to running
+	ProvisionTime time.Duration
+	// KubeConfig base64 kubeConfig of backend kube-apiserver
+	KubeConfig string
+}
+
+// ParseOptions parse mock cloud options from cloud platformInfo
+func ParseOptions(info map[string]string) *Options {
+	opt := &Options{}
+	if info == nil {
+		return opt
+	}
+
+	if latency, err := time.ParseDuration(info[LatencyKey]); err == nil {
+		opt.Latency = latency
+	}
+	if ratio, err := strconv.ParseFloat(info[FaultRatioKey], 64); err == nil {
+		opt.FaultRatio = ratio
+	}
+	for _, action := range strings.Split(info[FaultActionsKey], ",") {
+		if action = strings.TrimSpace(action); action != "" {
+			opt.FaultActions = append(opt.FaultActions, action)
+		}
+	}
+	if provision, err := time.ParseDuration(info[ProvisionTimeKey]); err == nil {
+		opt.ProvisionTime = provision
+	}
+	opt.KubeConfig = info[KubeConfigKey]
+
+	return opt
+}
+
+// MockClient mock cloud client, simulate cloud api latency and fault
+type MockClient struct {
+	region string
+	opt    *Options
+	cloud  *MockCloud
+}
+
+// NewMockClient create mock cloud client, mock options are loaded from mock cloud platformInfo
+func NewMockClient(opt *cloudprovider.CommonOption) (*MockClient, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("create NewMockClient failed, empty option")
+	}
+
+	options := &Options{}
+	if model := cloudprovider.GetStorageModel(); model != nil {
+		cloud, err := model.GetCloudByProvider(context.Background(), CloudProvider)
+		if err != nil {
+			blog.Warnf("NewMockClient get cloud %s failed: %v, use default options", CloudProvider, err)
+		} else {
+			options = ParseOptions(cloud.GetPlatformInfo())
+		}
+	}
+
+	return NewMockClientWithOptions(opt.Region, options), nil
+}
+
+// NewMockClientWithOptions create mock cloud client by specified options
+func NewMockClientWithOptions(region string, opt *Options) *MockClient {
+	if region == "" {
+		region = DefaultRegion
+	}
+	if opt == nil {
+		opt = &Options{}
+	}
+	return &MockClient{
+		region: region,
+		opt:    opt,
+		cloud:  GetMockCloud(),
+	}
+}
+
+// GetOptions get mock client options
+func (c *MockClient) GetOptions() *Options {
+	return c.opt
+}
+
+// simulate cloud api latency and inject fault by ratio
+func (c *MockClient) simulate(action string) error {
+	if c.opt.Latency > 0 {
+		time.Sleep(c.opt.Latency)
+	}
+	if c.opt.FaultRatio <= 0 {
+		return nil
+	}
+	if len(c.opt.FaultActions) > 0 {
+		matched := false
+		for _, a := range c.opt.FaultActions {
+			if a == action || a == "*" {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil
+		}
+	}
+	if rand.Float64() < c.opt.FaultRatio { // nolint
+		blog.Warnf("mock cloud inject fault for action %s", action)
+		return fmt.Errorf("mock cloud %s failed: injected fault", action)
+	}
+	return nil
+}
+
+// CreateCluster create cluster
+func (c *MockClient) CreateCluster(req *CreateClusterRequest) (*Cluster, error) {
+	if err := c.simulate(ActionCreateCluster); err != nil {
+		return nil, err
+	}
+	if req.Region == "" {
+		req.Region = c.region
+	}
+	return c.cloud.CreateCluster(req)
+}
+
+// DescribeCluster describe cluster
+func (c *MockClient) DescribeCluster(clusterID string) (*Cluster, error) {
+	if err := c.simulate(ActionDescribeCluster); err != nil {
+		return nil, err
+	}
+	return c.cloud.DescribeCluster(clusterID, c.opt.ProvisionTime)
+}
+
+// ListClusters list client region clusters
+func (c *MockClient) ListClusters() ([]*Cluster, error) {
+	if err := c.simulate(ActionDescribeCluster); err != nil {
+		return nil, err
+	}
+	return c.cloud.ListClusters(c.region, c.opt.ProvisionTime), nil
+}
+
+// DeleteCluster delete cluster
+func (c *MockClient) DeleteCluster(clusterID string) error {
+	if err := c.simulate(ActionDeleteCluster); err != nil {
+		return err
+	}
+	return c.cloud.DeleteCluster(clusterID)
+}
+
+// CreateInstances create instances
+func (c *MockClient) CreateInstances(req *CreateInstancesRequest) ([]*Instance, error) {
+	if err := c.simulate(ActionCreateInstances); err != nil {
+		return nil, err
+	}
+	if req.Region == "" {
+		req.Region = c.region
+	}
+	return c.cloud.CreateInstances(req)
+}
+
+// DescribeInstances describe instances by ids
+func (c *MockClient) DescribeInstances(ids []string) ([]*Instance, error) {
+	if err := c.simulate(ActionDescribeInstances); err != nil {
+		return nil, err
+	}
+	return c.cloud.DescribeInstances(ids, c.opt.ProvisionTime), nil
+}
+
+// DescribeInstancesByIP describe instances by private ips
+func (c *MockClient) DescribeInstancesByIP(ips []string) ([]*Instance, error) {
+	if err := c.simulate(ActionDescribeInstances); err != nil {
+		return nil, err
+	}
+	return c.cloud.DescribeInstancesByIP(ips, c.opt.ProvisionTime), nil
+}
+
+// DeleteInstances delete instances
+func (c *MockClient) DeleteInstances(ids []string) error {
+	if err := c.simulate(ActionDeleteInstances); err != nil {
+		return err
+	}
+	return c.cloud.DeleteInstances(ids)
+}
+
+// AddClusterInstances add instances to cluster
+func (c *MockClient) AddClusterInstances(clusterID string, ids []string) error {
+	if err := c.simulate(ActionAddClusterInstances); err != nil {
+		return err
+	}
+	return c.cloud.AddClusterInstances(clusterID, ids)
+}
+
+// RemoveClusterInstances remove instances from cluster
+func (c *MockClient) RemoveClusterInstances(clusterID string, ids []string) error {
+	if err := c.simulate(ActionRemoveClusterInstances); err != nil {
+		return err
+	}
+	return c.cloud.RemoveClusterInstances(clusterID, ids)
+}
+
+// CreateNodeGroup create nodeGroup
+func (c *MockClient) CreateNodeGroup(req *CreateNodeGroupRequest) (*NodeGroup, error) {
+	if err := c.simulate(ActionCreateNodeGroup); err != nil {
+		return nil, err
+	}
+	if req.Region == "" {
+		req.Region = c.region
+	}
+	return c.cloud.CreateNodeGroup(req)
+}
+
+// DescribeNodeGroup describe nodeGroup
+func (c *MockClient) DescribeNodeGroup(nodeGroupID string) (*NodeGroup, error) {
+	if err := c.simulate(ActionDescribeNodeGroup); err != nil {
+		return nil, err
+	}
+	return c.cloud.DescribeNodeGroup(nodeGroupID, c.opt.ProvisionTime)
+}
+
+// DeleteNodeGroup delete nodeGroup
+func (c *MockClient) DeleteNodeGroup(nodeGroupID string) error {
+	if err := c.simulate(ActionDeleteNodeGroup); err != nil {
+		return err
+	}
+	return c.cloud.DeleteNodeGroup(nodeGroupID)
+}
+
+// ScaleOutNodeGroup scale out nodeGroup instances
+func (c *MockClient) ScaleOutNodeGroup(nodeGroupID string, count int) ([]*Instance, error) {
+	if err := c.simulate(ActionScaleOutNodeGroup); err != nil {
+		return nil, err
+	}
+	return c.cloud.ScaleOutNodeGroup(nodeGroupID, count)
+}
+
+// ScaleInNodeGroup scale in nodeGroup instances
+func (c *MockClient) ScaleInNodeGroup(nodeGroupID string, ids []string) error {
+	if err := c.simulate(ActionScaleInNodeGroup); err != nil {
+		return err
+	}
+	return c.cloud.ScaleInNodeGroup(nodeGroupID, ids)
+}
+
+// ListVpcs list client region vpcs
+func (c *MockClient) ListVpcs() ([]*Vpc, error) {
+	if err := c.simulate(ActionDescribeVpcs); err != nil {
+		return nil, err
+	}
+	return c.cloud.ListVpcs(c.region), nil
+}
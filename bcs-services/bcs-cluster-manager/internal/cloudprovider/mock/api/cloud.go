@@ -0,0 +1,534 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	mockCloud     *MockCloud
+	mockCloudOnce sync.Once
+)
+
+// GetMockCloud get in-memory mock cloud, all mock clients share the same cloud resources
+func GetMockCloud() *MockCloud {
+	mockCloudOnce.Do(func() {
+		mockCloud = NewMockCloud()
+	})
+	return mockCloud
+}
+
+// MockCloud in-memory cloud resources, simulate cloud cluster/instance/nodeGroup/vpc lifecycle
+type MockCloud struct {
+	lock       sync.RWMutex
+	ipSeq      uint32
+	clusters   map[string]*Cluster
+	instances  map[string]*Instance
+	nodeGroups map[string]*NodeGroup
+	vpcs       map[string]*Vpc
+}
+
+// NewMockCloud create in-memory mock cloud with default region vpc & subnets
+func NewMockCloud() *MockCloud {
+	cloud := &MockCloud{
+		clusters:   make(map[string]*Cluster),
+		instances:  make(map[string]*Instance),
+		nodeGroups: make(map[string]*NodeGroup),
+		vpcs:       make(map[string]*Vpc),
+	}
+
+	vpc := &Vpc{
+		VpcID:     DefaultVpcID,
+		Name:      DefaultVpcID,
+		Region:    DefaultRegion,
+		CidrBlock: DefaultVpcCidr,
+		Subnets:   make([]*Subnet, 0),
+	}
+	for i, zone := range GetRegionZones(DefaultRegion) {
+		vpc.Subnets = append(vpc.Subnets, &Subnet{
+			SubnetID:  fmt.Sprintf("subnet-mock-%d", i+1),
+			Name:      fmt.Sprintf("subnet-mock-%d", i+1),
+			VpcID:     DefaultVpcID,
+			Zone:      zone,
+			CidrBlock: fmt.Sprintf("10.0.%d.0/20", i*16),
+		})
+	}
+	cloud.vpcs[vpc.VpcID] = vpc
+
+	return cloud
+}
+
+// GetRegionZones get mock cloud region zones
+func GetRegionZones(region string) []string {
+	return []string{region + "-a", region + "-b", region + "-c"}
+}
+
+func generateID(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, strings.Split(uuid.New().String(), "-")[0])
+}
+
+// allocateIP allocate private ip in default vpc
+func (mc *MockCloud) allocateIP() string {
+	mc.ipSeq++
+	return fmt.Sprintf("10.0.%d.%d", mc.ipSeq/250, mc.ipSeq%250+2)
+}
+
+// refreshStatus resources become running after provision time
+func (mc *MockCloud) refreshStatus(provisionTime time.Duration) {
+	for _, cls := range mc.clusters {
+		if cls.Status == ClusterStatusCreating && time.Since(cls.CreateTime) >= provisionTime {
+			cls.Status = ClusterStatusRunning
+		}
+	}
+	for _, ins := range mc.instances {
+		if ins.Status == InstanceStatusPending && time.Since(ins.CreateTime) >= provisionTime {
+			ins.Status = InstanceStatusRunning
+		}
+	}
+	for _, group := range mc.nodeGroups {
+		if group.Status == NodeGroupStatusCreating && time.Since(group.CreateTime) >= provisionTime {
+			group.Status = NodeGroupStatusNormal
+		}
+	}
+}
+
+func copyCluster(cls *Cluster) *Cluster {
+	c := *cls
+	c.InstanceIDs = append([]string{}, cls.InstanceIDs...)
+	return &c
+}
+
+func copyInstance(ins *Instance) *Instance {
+	i := *ins
+	return &i
+}
+
+func copyNodeGroup(group *NodeGroup) *NodeGroup {
+	g := *group
+	g.Zones = append([]string{}, group.Zones...)
+	g.SubnetIDs = append([]string{}, group.SubnetIDs...)
+	g.InstanceIDs = append([]string{}, group.InstanceIDs...)
+	return &g
+}
+
+func removeString(list []string, items ...string) []string {
+	filter := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		filter[item] = struct{}{}
+	}
+	result := make([]string, 0, len(list))
+	for _, s := range list {
+		if _, ok := filter[s]; !ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// CreateCluster create cluster, cluster is creating until provision time
+func (mc *MockCloud) CreateCluster(req *CreateClusterRequest) (*Cluster, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if req.VpcID == "" {
+		req.VpcID = DefaultVpcID
+	}
+	if _, ok := mc.vpcs[req.VpcID]; !ok {
+		return nil, fmt.Errorf("vpc %s not found", req.VpcID)
+	}
+	cls := &Cluster{
+		ClusterID:   generateID("cls"),
+		ClusterName: req.ClusterName,
+		Region:      req.Region,
+		Version:     req.Version,
+		VpcID:       req.VpcID,
+		Status:      ClusterStatusCreating,
+		CreateTime:  time.Now(),
+		InstanceIDs: make([]string, 0),
+	}
+	mc.clusters[cls.ClusterID] = cls
+
+	return copyCluster(cls), nil
+}
+
+// DescribeCluster describe cluster
+func (mc *MockCloud) DescribeCluster(clusterID string, provisionTime time.Duration) (*Cluster, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.refreshStatus(provisionTime)
+	cls, ok := mc.clusters[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	return copyCluster(cls), nil
+}
+
+// ListClusters list region clusters
+func (mc *MockCloud) ListClusters(region string, provisionTime time.Duration) []*Cluster {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.refreshStatus(provisionTime)
+	clusters := make([]*Cluster, 0)
+	for _, cls := range mc.clusters {
+		if region != "" && cls.Region != region {
+			continue
+		}
+		clusters = append(clusters, copyCluster(cls))
+	}
+	return clusters
+}
+
+// DeleteCluster delete cluster, release cluster nodeGroups and instances which created by nodeGroup
+func (mc *MockCloud) DeleteCluster(clusterID string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	cls, ok := mc.clusters[clusterID]
+	if !ok {
+		return nil
+	}
+	for id, group := range mc.nodeGroups {
+		if group.ClusterID != clusterID {
+			continue
+		}
+		for _, insID := range group.InstanceIDs {
+			delete(mc.instances, insID)
+		}
+		delete(mc.nodeGroups, id)
+	}
+	for _, insID := range cls.InstanceIDs {
+		if ins, exist := mc.instances[insID]; exist {
+			ins.ClusterID = ""
+		}
+	}
+	delete(mc.clusters, clusterID)
+
+	return nil
+}
+
+// CreateInstances create instances, instances are pending until provision time
+func (mc *MockCloud) CreateInstances(req *CreateInstancesRequest) ([]*Instance, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	return mc.createInstances(req), nil
+}
+
+func (mc *MockCloud) createInstances(req *CreateInstancesRequest) []*Instance {
+	count := req.Count
+	if len(req.IPs) > 0 {
+		count = len(req.IPs)
+	}
+	if req.InstanceType == "" {
+		req.InstanceType = DefaultInstanceType
+	}
+	if req.VpcID == "" {
+		req.VpcID = DefaultVpcID
+	}
+
+	instances := make([]*Instance, 0, count)
+	for i := 0; i < count; i++ {
+		ip := ""
+		if len(req.IPs) > 0 {
+			ip = req.IPs[i]
+		} else {
+			ip = mc.allocateIP()
+		}
+		ins := &Instance{
+			InstanceID:   generateID("ins"),
+			InstanceType: req.InstanceType,
+			Region:       req.Region,
+			Zone:         req.Zone,
+			VpcID:        req.VpcID,
+			SubnetID:     req.SubnetID,
+			PrivateIP:    ip,
+			CPU:          4,
+			Memory:       8,
+			Status:       InstanceStatusPending,
+			CreateTime:   time.Now(),
+		}
+		ins.InstanceName = ins.InstanceID
+		mc.instances[ins.InstanceID] = ins
+		instances = append(instances, copyInstance(ins))
+	}
+
+	return instances
+}
+
+// DescribeInstances describe instances by ids, return all instances when ids empty
+func (mc *MockCloud) DescribeInstances(ids []string, provisionTime time.Duration) []*Instance {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.refreshStatus(provisionTime)
+	instances := make([]*Instance, 0)
+	if len(ids) == 0 {
+		for _, ins := range mc.instances {
+			instances = append(instances, copyInstance(ins))
+		}
+		return instances
+	}
+	for _, id := range ids {
+		if ins, ok := mc.instances[id]; ok {
+			instances = append(instances, copyInstance(ins))
+		}
+	}
+	return instances
+}
+
+// DescribeInstancesByIP describe instances by private ips
+func (mc *MockCloud) DescribeInstancesByIP(ips []string, provisionTime time.Duration) []*Instance {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.refreshStatus(provisionTime)
+	filter := make(map[string]struct{}, len(ips))
+	for _, ip := range ips {
+		filter[ip] = struct{}{}
+	}
+	instances := make([]*Instance, 0)
+	for _, ins := range mc.instances {
+		if _, ok := filter[ins.PrivateIP]; ok {
+			instances = append(instances, copyInstance(ins))
+		}
+	}
+	return instances
+}
+
+// DeleteInstances delete instances
+func (mc *MockCloud) DeleteInstances(ids []string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	for _, id := range ids {
+		ins, ok := mc.instances[id]
+		if !ok {
+			continue
+		}
+		if cls, exist := mc.clusters[ins.ClusterID]; exist {
+			cls.InstanceIDs = removeString(cls.InstanceIDs, id)
+		}
+		if group, exist := mc.nodeGroups[ins.NodeGroupID]; exist {
+			group.InstanceIDs = removeString(group.InstanceIDs, id)
+		}
+		delete(mc.instances, id)
+	}
+	return nil
+}
+
+// AddClusterInstances add instances to cluster
+func (mc *MockCloud) AddClusterInstances(clusterID string, ids []string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	cls, ok := mc.clusters[clusterID]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+	for _, id := range ids {
+		ins, exist := mc.instances[id]
+		if !exist {
+			return fmt.Errorf("instance %s not found", id)
+		}
+		if ins.ClusterID != "" && ins.ClusterID != clusterID {
+			return fmt.Errorf("instance %s already in cluster %s", id, ins.ClusterID)
+		}
+	}
+	for _, id := range ids {
+		if mc.instances[id].ClusterID == clusterID {
+			continue
+		}
+		mc.instances[id].ClusterID = clusterID
+		cls.InstanceIDs = append(cls.InstanceIDs, id)
+	}
+	return nil
+}
+
+// RemoveClusterInstances remove instances from cluster
+func (mc *MockCloud) RemoveClusterInstances(clusterID string, ids []string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	cls, ok := mc.clusters[clusterID]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+	for _, id := range ids {
+		if ins, exist := mc.instances[id]; exist && ins.ClusterID == clusterID {
+			ins.ClusterID = ""
+		}
+	}
+	cls.InstanceIDs = removeString(cls.InstanceIDs, ids...)
+	return nil
+}
+
+// CreateNodeGroup create cluster nodeGroup, nodeGroup is creating until provision time
+func (mc *MockCloud) CreateNodeGroup(req *CreateNodeGroupRequest) (*NodeGroup, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if _, ok := mc.clusters[req.ClusterID]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", req.ClusterID)
+	}
+	if req.MaxSize < req.MinSize {
+		return nil, fmt.Errorf("nodeGroup maxSize %d less than minSize %d", req.MaxSize, req.MinSize)
+	}
+	if len(req.Zones) == 0 {
+		req.Zones = GetRegionZones(req.Region)
+	}
+	if req.InstanceType == "" {
+		req.InstanceType = DefaultInstanceType
+	}
+	group := &NodeGroup{
+		NodeGroupID:  generateID("np"),
+		Name:         req.Name,
+		ClusterID:    req.ClusterID,
+		Region:       req.Region,
+		Zones:        req.Zones,
+		VpcID:        req.VpcID,
+		SubnetIDs:    req.SubnetIDs,
+		InstanceType: req.InstanceType,
+		MinSize:      req.MinSize,
+		MaxSize:      req.MaxSize,
+		Status:       NodeGroupStatusCreating,
+		CreateTime:   time.Now(),
+		InstanceIDs:  make([]string, 0),
+	}
+	mc.nodeGroups[group.NodeGroupID] = group
+
+	return copyNodeGroup(group), nil
+}
+
+// DescribeNodeGroup describe nodeGroup
+func (mc *MockCloud) DescribeNodeGroup(nodeGroupID string, provisionTime time.Duration) (*NodeGroup, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.refreshStatus(provisionTime)
+	group, ok := mc.nodeGroups[nodeGroupID]
+	if !ok {
+		return nil, fmt.Errorf("nodeGroup %s not found", nodeGroupID)
+	}
+	return copyNodeGroup(group), nil
+}
+
+// DeleteNodeGroup delete nodeGroup and release nodeGroup instances
+func (mc *MockCloud) DeleteNodeGroup(nodeGroupID string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	group, ok := mc.nodeGroups[nodeGroupID]
+	if !ok {
+		return nil
+	}
+	if cls, exist := mc.clusters[group.ClusterID]; exist {
+		cls.InstanceIDs = removeString(cls.InstanceIDs, group.InstanceIDs...)
+	}
+	for _, id := range group.InstanceIDs {
+		delete(mc.instances, id)
+	}
+	delete(mc.nodeGroups, nodeGroupID)
+
+	return nil
+}
+
+// ScaleOutNodeGroup create instances in nodeGroup and join nodeGroup cluster
+func (mc *MockCloud) ScaleOutNodeGroup(nodeGroupID string, count int) ([]*Instance, error) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	group, ok := mc.nodeGroups[nodeGroupID]
+	if !ok {
+		return nil, fmt.Errorf("nodeGroup %s not found", nodeGroupID)
+	}
+	if len(group.InstanceIDs)+count > int(group.MaxSize) {
+		return nil, fmt.Errorf("nodeGroup %s current %d scale out %d exceed maxSize %d",
+			nodeGroupID, len(group.InstanceIDs), count, group.MaxSize)
+	}
+	cls, ok := mc.clusters[group.ClusterID]
+	if !ok {
+		return nil, fmt.Errorf("nodeGroup %s cluster %s not found", nodeGroupID, group.ClusterID)
+	}
+
+	instances := make([]*Instance, 0, count)
+	for i := 0; i < count; i++ {
+		subnetID := ""
+		if len(group.SubnetIDs) > 0 {
+			subnetID = group.SubnetIDs[i%len(group.SubnetIDs)]
+		}
+		created := mc.createInstances(&CreateInstancesRequest{
+			Region:       group.Region,
+			Zone:         group.Zones[i%len(group.Zones)],
+			VpcID:        group.VpcID,
+			SubnetID:     subnetID,
+			InstanceType: group.InstanceType,
+			Count:        1,
+		})
+		for _, ins := range created {
+			mc.instances[ins.InstanceID].ClusterID = group.ClusterID
+			mc.instances[ins.InstanceID].NodeGroupID = nodeGroupID
+			group.InstanceIDs = append(group.InstanceIDs, ins.InstanceID)
+			cls.InstanceIDs = append(cls.InstanceIDs, ins.InstanceID)
+			instances = append(instances, copyInstance(mc.instances[ins.InstanceID]))
+		}
+	}
+	group.DesiredSize = uint32(len(group.InstanceIDs))
+
+	return instances, nil
+}
+
+// ScaleInNodeGroup remove instances from nodeGroup and release them
+func (mc *MockCloud) ScaleInNodeGroup(nodeGroupID string, ids []string) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	group, ok := mc.nodeGroups[nodeGroupID]
+	if !ok {
+		return fmt.Errorf("nodeGroup %s not found", nodeGroupID)
+	}
+	if cls, exist := mc.clusters[group.ClusterID]; exist {
+		cls.InstanceIDs = removeString(cls.InstanceIDs, ids...)
+	}
+	group.InstanceIDs = removeString(group.InstanceIDs, ids...)
+	group.DesiredSize = uint32(len(group.InstanceIDs))
+	for _, id := range ids {
+		if ins, exist := mc.instances[id]; exist && ins.NodeGroupID == nodeGroupID {
+			delete(mc.instances, id)
+		}
+	}
+	return nil
+}
+
+// ListVpcs list region vpcs
+func (mc *MockCloud) ListVpcs(region string) []*Vpc {
+	mc.lock.RLock()
+	defer mc.lock.RUnlock()
+
+	vpcs := make([]*Vpc, 0)
+	for _, vpc := range mc.vpcs {
+		if region != "" && vpc.Region != region {
+			continue
+		}
+		v := *vpc
+		vpcs = append(vpcs, &v)
+	}
+	return vpcs
+}
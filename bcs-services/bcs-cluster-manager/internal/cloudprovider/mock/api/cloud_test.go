@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMockCloudClusterLifecycle(t *testing.T) {
+	mc := NewMockCloud()
+
+	cls, err := mc.CreateCluster(&CreateClusterRequest{ClusterName: "test", Region: DefaultRegion})
+	if err != nil {
+		t.Fatalf("CreateCluster failed: %v", err)
+	}
+	if cls.Status != ClusterStatusCreating {
+		t.Fatalf("expect cluster status %s, got %s", ClusterStatusCreating, cls.Status)
+	}
+
+	// cluster keeps creating until provision time elapsed
+	cls, err = mc.DescribeCluster(cls.ClusterID, time.Hour)
+	if err != nil || cls.Status != ClusterStatusCreating {
+		t.Fatalf("expect cluster creating, got %+v %v", cls, err)
+	}
+	cls, err = mc.DescribeCluster(cls.ClusterID, 0)
+	if err != nil || cls.Status != ClusterStatusRunning {
+		t.Fatalf("expect cluster running, got %+v %v", cls, err)
+	}
+
+	instances, err := mc.CreateInstances(&CreateInstancesRequest{Region: DefaultRegion, IPs: []string{"10.0.0.100"}})
+	if err != nil || len(instances) != 1 {
+		t.Fatalf("CreateInstances failed: %v", err)
+	}
+	if err = mc.AddClusterInstances(cls.ClusterID, []string{instances[0].InstanceID}); err != nil {
+		t.Fatalf("AddClusterInstances failed: %v", err)
+	}
+	found := mc.DescribeInstancesByIP([]string{"10.0.0.100"}, 0)
+	if len(found) != 1 || found[0].ClusterID != cls.ClusterID || found[0].Status != InstanceStatusRunning {
+		t.Fatalf("unexpected instances %+v", found)
+	}
+
+	if err = mc.DeleteCluster(cls.ClusterID); err != nil {
+		t.Fatalf("DeleteCluster failed: %v", err)
+	}
+	if _, err = mc.DescribeCluster(cls.ClusterID, 0); err == nil {
+		t.Fatalf("expect cluster %s deleted", cls.ClusterID)
+	}
+	found = mc.DescribeInstances([]string{instances[0].InstanceID}, 0)
+	if len(found) != 1 || found[0].ClusterID != "" {
+		t.Fatalf("expect instance detached from cluster, got %+v", found)
+	}
+}
+
+func TestMockCloudNodeGroupScale(t *testing.T) {
+	mc := NewMockCloud()
+
+	cls, err := mc.CreateCluster(&CreateClusterRequest{ClusterName: "test", Region: DefaultRegion})
+	if err != nil {
+		t.Fatalf("CreateCluster failed: %v", err)
+	}
+	group, err := mc.CreateNodeGroup(&CreateNodeGroupRequest{
+		Name:      "group",
+		ClusterID: cls.ClusterID,
+		Region:    DefaultRegion,
+		MinSize:   0,
+		MaxSize:   3,
+	})
+	if err != nil {
+		t.Fatalf("CreateNodeGroup failed: %v", err)
+	}
+
+	instances, err := mc.ScaleOutNodeGroup(group.NodeGroupID, 2)
+	if err != nil || len(instances) != 2 {
+		t.Fatalf("ScaleOutNodeGroup failed: %v", err)
+	}
+	if _, err = mc.ScaleOutNodeGroup(group.NodeGroupID, 2); err == nil {
+		t.Fatalf("expect scale out exceed maxSize failed")
+	}
+
+	group, err = mc.DescribeNodeGroup(group.NodeGroupID, 0)
+	if err != nil || group.DesiredSize != 2 || group.Status != NodeGroupStatusNormal {
+		t.Fatalf("unexpected nodeGroup %+v %v", group, err)
+	}
+
+	if err = mc.ScaleInNodeGroup(group.NodeGroupID, []string{instances[0].InstanceID}); err != nil {
+		t.Fatalf("ScaleInNodeGroup failed: %v", err)
+	}
+	group, _ = mc.DescribeNodeGroup(group.NodeGroupID, 0)
+	if group.DesiredSize != 1 {
+		t.Fatalf("expect nodeGroup desiredSize 1, got %d", group.DesiredSize)
+	}
+	cls, _ = mc.DescribeCluster(cls.ClusterID, 0)
+	if len(cls.InstanceIDs) != 1 || cls.InstanceIDs[0] != instances[1].InstanceID {
+		t.Fatalf("unexpected cluster instances %v", cls.InstanceIDs)
+	}
+}
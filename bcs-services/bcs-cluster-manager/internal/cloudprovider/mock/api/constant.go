@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+const (
+	// CloudProvider mock cloud provider name
+	CloudProvider = "mock"
+
+	// DefaultRegion mock cloud default region
+	DefaultRegion = "mock-region-1"
+	// DefaultVpcID mock cloud default vpc
+	DefaultVpcID = "vpc-mock"
+	// DefaultVpcCidr mock cloud default vpc cidr
+	DefaultVpcCidr = "10.0.0.0/16"
+	// DefaultSecurityGroupID mock cloud default security group
+	DefaultSecurityGroupID = "sg-mock"
+	// DefaultInstanceType mock cloud default instance type
+	DefaultInstanceType = "MOCK.4C8G"
+)
+
+// mock cloud platformInfo keys, configure mock cloud behavior by cloud platformInfo
+const (
+	// LatencyKey every cloud api latency, eg: 500ms
+	LatencyKey = "mockLatency"
+	// FaultRatioKey cloud api fault injection ratio, range [0, 1]
+	FaultRatioKey = "mockFaultRatio"
+	// FaultActionsKey cloud api actions which inject fault, separated by comma. all actions when empty
+	FaultActionsKey = "mockFaultActions"
+	// ProvisionTimeKey time for cluster/instance from creating to running, eg: 10s
+	ProvisionTimeKey = "mockProvisionTime"
+	// KubeConfigKey base64 kubeConfig of backend kube-apiserver, eg: kind or envtest cluster
+	KubeConfigKey = "mockKubeConfig"
+)
+
+const (
+	// ClusterStatusCreating cluster creating
+	ClusterStatusCreating = "Creating"
+	// ClusterStatusRunning cluster running
+	ClusterStatusRunning = "Running"
+
+	// InstanceStatusPending instance pending
+	InstanceStatusPending = "Pending"
+	// InstanceStatusRunning instance running
+	InstanceStatusRunning = "Running"
+
+	// NodeGroupStatusCreating nodeGroup creating
+	NodeGroupStatusCreating = "Creating"
+	// NodeGroupStatusNormal nodeGroup normal
+	NodeGroupStatusNormal = "Normal"
+)
+
+// mock cloud api actions, used for fault injection
+const (
+	// ActionCreateCluster create cluster
+	ActionCreateCluster = "CreateCluster"
+	// ActionDescribeCluster describe cluster
+	ActionDescribeCluster = "DescribeCluster"
+	// ActionDeleteCluster delete cluster
+	ActionDeleteCluster = "DeleteCluster"
+	// ActionCreateInstances create instances
+	ActionCreateInstances = "CreateInstances"
+	// ActionDescribeInstances describe instances
+	ActionDescribeInstances = "DescribeInstances"
+	// ActionDeleteInstances delete instances
+	ActionDeleteInstances = "DeleteInstances"
+	// ActionAddClusterInstances add instances to cluster
+	ActionAddClusterInstances = "AddClusterInstances"
+	// ActionRemoveClusterInstances remove instances from cluster
+	ActionRemoveClusterInstances = "RemoveClusterInstances"
+	// ActionCreateNodeGroup create nodeGroup
+	ActionCreateNodeGroup = "CreateNodeGroup"
+	// ActionDescribeNodeGroup describe nodeGroup
+	ActionDescribeNodeGroup = "DescribeNodeGroup"
+	// ActionDeleteNodeGroup delete nodeGroup
+	ActionDeleteNodeGroup = "DeleteNodeGroup"
+	// ActionScaleOutNodeGroup scale out nodeGroup
+	ActionScaleOutNodeGroup = "ScaleOutNodeGroup"
+	// ActionScaleInNodeGroup scale in nodeGroup
+	ActionScaleInNodeGroup = "ScaleInNodeGroup"
+	// ActionDescribeVpcs describe vpcs
+	ActionDescribeVpcs = "DescribeVpcs"
+)
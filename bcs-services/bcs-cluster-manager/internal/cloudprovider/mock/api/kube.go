@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+)
+
+const (
+	// mockNodeLabelKey label for nodes registered by mock cloud
+	mockNodeLabelKey = "bcs.mock.cloud/instance-id"
+)
+
+// HasKubeBackend check mock cloud backed by real kube-apiserver
+func (c *MockClient) HasKubeBackend() bool {
+	return c.opt.KubeConfig != ""
+}
+
+// RegisterKubeNodes register instances as ready nodes in backend kube-apiserver,
+// kube-apiserver of kind or envtest not run kubelet, so mock cloud create node objects directly
+func (c *MockClient) RegisterKubeNodes(ctx context.Context, instances []*Instance) error {
+	if !c.HasKubeBackend() || len(instances) == 0 {
+		return nil
+	}
+	cli, err := clusterops.NewKubeClient(c.opt.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("mock cloud create kube client failed: %v", err)
+	}
+
+	for _, ins := range instances {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ins.PrivateIP,
+				Labels: map[string]string{
+					mockNodeLabelKey:               ins.InstanceID,
+					corev1.LabelHostname:           ins.PrivateIP,
+					corev1.LabelInstanceTypeStable: ins.InstanceType,
+					corev1.LabelTopologyRegion:     ins.Region,
+					corev1.LabelTopologyZone:       ins.Zone,
+				},
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: fmt.Sprintf("%s://%s", CloudProvider, ins.InstanceID),
+			},
+		}
+		created, err := cli.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{})
+		if err != nil {
+			if !k8serrors.IsAlreadyExists(err) {
+				return fmt.Errorf("mock cloud create node %s failed: %v", node.Name, err)
+			}
+			created, err = cli.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("mock cloud get node %s failed: %v", node.Name, err)
+			}
+		}
+
+		created.Status = corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    *resource.NewQuantity(int64(ins.CPU), resource.DecimalSI),
+				corev1.ResourceMemory: *resource.NewQuantity(int64(ins.Memory)<<30, resource.BinarySI),
+				corev1.ResourcePods:   *resource.NewQuantity(110, resource.DecimalSI),
+			},
+			Conditions: []corev1.NodeCondition{{
+				Type:               corev1.NodeReady,
+				Status:             corev1.ConditionTrue,
+				Reason:             "MockCloudReady",
+				LastHeartbeatTime:  metav1.Now(),
+				LastTransitionTime: metav1.Now(),
+			}},
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: ins.PrivateIP},
+				{Type: corev1.NodeHostName, Address: ins.PrivateIP},
+			},
+		}
+		created.Status.Allocatable = created.Status.Capacity
+		if _, err = cli.CoreV1().Nodes().UpdateStatus(ctx, created, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("mock cloud update node %s status failed: %v", node.Name, err)
+		}
+		blog.Infof("mock cloud register node %s for instance %s successful", node.Name, ins.InstanceID)
+	}
+
+	return nil
+}
+
+// DeleteKubeNodes delete instances nodes in backend kube-apiserver
+func (c *MockClient) DeleteKubeNodes(ctx context.Context, ips []string) error {
+	if !c.HasKubeBackend() || len(ips) == 0 {
+		return nil
+	}
+	cli, err := clusterops.NewKubeClient(c.opt.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("mock cloud create kube client failed: %v", err)
+	}
+
+	for _, ip := range ips {
+		err = cli.CoreV1().Nodes().Delete(ctx, ip, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("mock cloud delete node %s failed: %v", ip, err)
+		}
+		blog.Infof("mock cloud delete node %s successful", ip)
+	}
+
+	return nil
+}
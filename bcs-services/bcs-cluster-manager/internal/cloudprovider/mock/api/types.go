@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"time"
+)
+
+// Cluster mock cloud cluster
+type Cluster struct {
+	ClusterID   string
+	ClusterName string
+	Region      string
+	Version     string
+	VpcID       string
+	Status      string
+	CreateTime  time.Time
+	// InstanceIDs instances which join cluster
+	InstanceIDs []string
+}
+
+// Instance mock cloud instance
+type Instance struct {
+	InstanceID   string
+	InstanceName string
+	InstanceType string
+	Region       string
+	Zone         string
+	VpcID        string
+	SubnetID     string
+	PrivateIP    string
+	CPU          uint32
+	Memory       uint32
+	Status       string
+	CreateTime   time.Time
+	// ClusterID cluster which instance joined
+	ClusterID string
+	// NodeGroupID nodeGroup which instance belong to
+	NodeGroupID string
+}
+
+// NodeGroup mock cloud nodeGroup
+type NodeGroup struct {
+	NodeGroupID  string
+	Name         string
+	ClusterID    string
+	Region       string
+	Zones        []string
+	VpcID        string
+	SubnetIDs    []string
+	InstanceType string
+	MinSize      uint32
+	MaxSize      uint32
+	DesiredSize  uint32
+	Status       string
+	CreateTime   time.Time
+	InstanceIDs  []string
+}
+
+// Vpc mock cloud vpc
+type Vpc struct {
+	VpcID     string
+	Name      string
+	Region    string
+	CidrBlock string
+	Subnets   []*Subnet
+}
+
+// Subnet mock cloud subnet
+type Subnet struct {
+	SubnetID  string
+	Name      string
+	VpcID     string
+	Zone      string
+	CidrBlock string
+}
+
+// CreateClusterRequest create cluster request
+type CreateClusterRequest struct {
+	ClusterName string
+	Region      string
+	Version     string
+	VpcID       string
+}
+
+// CreateInstancesRequest create instances request
+type CreateInstancesRequest struct {
+	Region       string
+	Zone         string
+	VpcID        string
+	SubnetID     string
+	InstanceType string
+	// IPs create instances with specified private ips, instance number is len(IPs)
+	IPs []string
+	// Count instance number when IPs empty
+	Count int
+}
+
+// CreateNodeGroupRequest create nodeGroup request
+type CreateNodeGroupRequest struct {
+	Name         string
+	ClusterID    string
+	Region       string
+	Zones        []string
+	VpcID        string
+	SubnetIDs    []string
+	InstanceType string
+	MinSize      uint32
+	MaxSize      uint32
+}
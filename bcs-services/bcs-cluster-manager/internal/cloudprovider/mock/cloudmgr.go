@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mock xxx
+package mock
+
+import (
+	"fmt"
+	"sync"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+)
+
+var cloudInfoMgr sync.Once
+
+func init() {
+	cloudInfoMgr.Do(func() {
+		// init Cluster
+		cloudprovider.InitCloudInfoManager(cloudName, &CloudInfoManager{})
+	})
+}
+
+// CloudInfoManager mock cloud management cluster info
+type CloudInfoManager struct {
+}
+
+// InitCloudClusterDefaultInfo init cloud cluster default configInfo
+func (c *CloudInfoManager) InitCloudClusterDefaultInfo(cls *cmproto.Cluster,
+	opt *cloudprovider.InitClusterConfigOption) error {
+	if c == nil || cls == nil {
+		return fmt.Errorf("%s InitCloudClusterDefaultInfo request is empty", cloudName)
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return fmt.Errorf("%s InitCloudClusterDefaultInfo option is empty", cloudName)
+	}
+
+	if len(cls.ManageType) == 0 {
+		cls.ManageType = common.ClusterManageTypeManaged
+	}
+	if len(cls.Region) == 0 {
+		cls.Region = api.DefaultRegion
+	}
+	if len(cls.VpcID) == 0 {
+		cls.VpcID = api.DefaultVpcID
+	}
+
+	if cls.ClusterBasicSettings == nil {
+		cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{}
+	}
+	cls.ClusterBasicSettings.Version = opt.ClusterVersion
+	cls.ClusterBasicSettings.VersionName = opt.ClusterVersion
+
+	if cls.ClusterAdvanceSettings == nil {
+		cls.ClusterAdvanceSettings = &cmproto.ClusterAdvanceSetting{
+			ContainerRuntime: common.ContainerdRuntime,
+		}
+	}
+	if cls.NetworkSettings == nil {
+		cls.NetworkSettings = &cmproto.NetworkSetting{}
+	}
+
+	return nil
+}
+
+// SyncClusterCloudInfo sync cluster metadata
+func (c *CloudInfoManager) SyncClusterCloudInfo(cls *cmproto.Cluster,
+	opt *cloudprovider.SyncClusterCloudInfoOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateClusterCloudInfo update cluster info by cloud
+func (c *CloudInfoManager) UpdateClusterCloudInfo(cls *cmproto.Cluster) error {
+	if c == nil || cls == nil {
+		return fmt.Errorf("%s UpdateClusterCloudInfo request is empty", cloudName)
+	}
+
+	return nil
+}
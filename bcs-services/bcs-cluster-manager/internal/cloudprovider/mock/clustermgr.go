@@ -0,0 +1,316 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+)
+
+var clsMgr sync.Once
+
+func init() {
+	clsMgr.Do(func() {
+		// init Cluster
+		cloudprovider.InitClusterManager(cloudName, &Cluster{})
+	})
+}
+
+// Cluster mock cloud cluster management implementation
+type Cluster struct {
+}
+
+// CreateCluster create kubenretes cluster according cloudprovider
+func (c *Cluster) CreateCluster(cls *cmproto.Cluster, opt *cloudprovider.CreateClusterOption) (*cmproto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("mockCloud CreateCluster cluster is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("mockCloud CreateCluster cluster opt or cloud is empty")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CreateCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build create cluster task
+	task, err := mgr.BuildCreateClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build CreateCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// CreateVirtualCluster create virtual cluster by cloud provider
+func (c *Cluster) CreateVirtualCluster(cls *cmproto.Cluster,
+	opt *cloudprovider.CreateVirtualClusterOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ImportCluster import cluster according cloudprovider
+func (c *Cluster) ImportCluster(cls *cmproto.Cluster, opt *cloudprovider.ImportClusterOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteCluster delete kubenretes cluster according cloudprovider
+func (c *Cluster) DeleteCluster(cls *cmproto.Cluster, opt *cloudprovider.DeleteClusterOption) (*cmproto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("mockCloud DeleteCluster cluster is empty")
+	}
+
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("mockCloud DeleteCluster cluster lost option")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build delete cluster task
+	task, err := mgr.BuildDeleteClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build DeleteCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// DeleteVirtualCluster delete virtual cluster
+func (c *Cluster) DeleteVirtualCluster(cls *cmproto.Cluster,
+	opt *cloudprovider.DeleteVirtualClusterOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *cmproto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*cmproto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetCluster get kubernetes cluster detail information according cloudprovider
+func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*cmproto.Cluster, error) {
+	if opt == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("mockCloud GetCluster cluster lost option")
+	}
+
+	cli, err := api.NewMockClient(&opt.CommonOption)
+	if err != nil {
+		return nil, err
+	}
+	cls, err := cli.DescribeCluster(cloudID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt.Cluster.SystemID = cls.ClusterID
+	if opt.Cluster.ClusterBasicSettings != nil && cls.Version != "" {
+		opt.Cluster.ClusterBasicSettings.Version = cls.Version
+	}
+
+	return opt.Cluster, nil
+}
+
+// ListCluster get cloud cluster list by region
+func (c *Cluster) ListCluster(opt *cloudprovider.ListClusterOption) ([]*cmproto.CloudClusterInfo, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("mockCloud ListCluster lost option")
+	}
+
+	cli, err := api.NewMockClient(&opt.CommonOption)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := cli.ListClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	cloudClusters := make([]*cmproto.CloudClusterInfo, 0, len(clusters))
+	for _, cls := range clusters {
+		cloudClusters = append(cloudClusters, &cmproto.CloudClusterInfo{
+			ClusterID:      cls.ClusterID,
+			ClusterName:    cls.ClusterName,
+			ClusterVersion: cls.Version,
+			ClusterStatus:  cls.Status,
+			Location:       cls.Region,
+		})
+	}
+
+	return cloudClusters, nil
+}
+
+// CheckClusterCidrAvailable check cluster CIDR nodesNum when add nodes
+func (c *Cluster) CheckClusterCidrAvailable(cls *cmproto.Cluster,
+	opt *cloudprovider.CheckClusterCIDROption) (bool, error) {
+	return true, nil
+}
+
+// GetNodesInCluster get all nodes belong to cluster according cloudprovider
+func (c *Cluster) GetNodesInCluster(cls *cmproto.Cluster, opt *cloudprovider.GetNodesOption) ([]*cmproto.Node, error) {
+	if cls == nil || opt == nil {
+		return nil, fmt.Errorf("mockCloud GetNodesInCluster lost cluster or option")
+	}
+	if cls.SystemID == "" {
+		return nil, fmt.Errorf("mockCloud GetNodesInCluster cluster %s systemID empty", cls.ClusterID)
+	}
+
+	cli, err := api.NewMockClient(&opt.CommonOption)
+	if err != nil {
+		return nil, err
+	}
+	mockCls, err := cli.DescribeCluster(cls.SystemID)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := cli.DescribeInstances(mockCls.InstanceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*cmproto.Node, 0, len(instances))
+	for _, ins := range instances {
+		node := transInstanceToNode(ins)
+		node.ClusterID = cls.ClusterID
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// AddNodesToCluster add new node to cluster according cloudprovider
+func (c *Cluster) AddNodesToCluster(cls *cmproto.Cluster, nodes []*cmproto.Node,
+	opt *cloudprovider.AddNodesOption) (*cmproto.Task, error) {
+	if cls == nil || len(nodes) == 0 {
+		return nil, fmt.Errorf("mockCloud AddNodesToCluster cluster or nodes is empty")
+	}
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("mockCloud AddNodesToCluster cluster lost option")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when AddNodesToCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build add nodes task
+	task, err := mgr.BuildAddNodesToClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build AddNodesToCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// DeleteNodesFromCluster delete specified nodes from cluster according cloudprovider
+func (c *Cluster) DeleteNodesFromCluster(cls *cmproto.Cluster, nodes []*cmproto.Node,
+	opt *cloudprovider.DeleteNodesOption) (*cmproto.Task, error) {
+	if cls == nil || len(nodes) == 0 {
+		return nil, fmt.Errorf("mockCloud DeleteNodesFromCluster cluster or nodes is empty")
+	}
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("mockCloud DeleteNodesFromCluster cluster lost option")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteNodesFromCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build remove nodes task
+	task, err := mgr.BuildRemoveNodesFromClusterTask(cls, nodes, opt)
+	if err != nil {
+		blog.Errorf("build DeleteNodesFromCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// EnableExternalNodeSupport enable cluster support external node
+func (c *Cluster) EnableExternalNodeSupport(cls *cmproto.Cluster, opt *cloudprovider.EnableExternalNodeOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// ListOsImage list image os
+func (c *Cluster) ListOsImage(provider string, opt *cloudprovider.CommonOption) ([]*cmproto.OsImage, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// CheckClusterEndpointStatus check cluster endpoint status
+func (c *Cluster) CheckClusterEndpointStatus(clusterID string, isExtranet bool,
+	opt *cloudprovider.CheckEndpointStatusOption) (bool, error) {
+	return true, nil
+}
+
+// ListProjects list cloud projects
+func (c *Cluster) ListProjects(opt *cloudprovider.CommonOption) ([]*cmproto.CloudProject, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetMasterSuggestedMachines get master suggested machines
+func (c *Cluster) GetMasterSuggestedMachines(level, vpcId string,
+	opt *cloudprovider.GetMasterSuggestedMachinesOption) ([]*cmproto.InstanceTemplateConfig, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// AddSubnetsToCluster add subnets to cluster
+func (c *Cluster) AddSubnetsToCluster(ctx context.Context, subnet *cmproto.SubnetSource,
+	opt *cloudprovider.AddSubnetsToClusterOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// AppendCloudNodeInfo append cloud node detailed info
+func (c *Cluster) AppendCloudNodeInfo(ctx context.Context,
+	nodes []*cmproto.ClusterNode, opt *cloudprovider.CommonOption) error {
+	return nil
+}
+
+// CheckIfGetNodesFromCluster check cluster if can get nodes from k8s
+func (c *Cluster) CheckIfGetNodesFromCluster(ctx context.Context, cluster *cmproto.Cluster,
+	nodes []*cmproto.ClusterNode) bool {
+	return true
+}
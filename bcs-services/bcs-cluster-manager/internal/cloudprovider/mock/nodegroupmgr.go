@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+var nodeGroupMgr sync.Once
+
+func init() {
+	nodeGroupMgr.Do(func() {
+		// init NodeGroup
+		cloudprovider.InitNodeGroupManager(cloudName, &NodeGroup{})
+	})
+}
+
+// NodeGroup nodegroup management for mock cloud resource pool solution
+type NodeGroup struct {
+}
+
+// CreateNodeGroup create nodegroup by cloudprovider api, only create NodeGroup entity
+func (ng *NodeGroup) CreateNodeGroup(group *proto.NodeGroup,
+	opt *cloudprovider.CreateNodeGroupOption) (*proto.Task, error) {
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CreateNodeGroup %s failed, %s",
+			cloudName, group.Name, err.Error(),
+		)
+		return nil, err
+	}
+	task, err := mgr.BuildCreateNodeGroupTask(group, opt)
+	if err != nil {
+		blog.Errorf("build CreateNodeGroup task for cluster %s with cloudprovider %s failed, %s",
+			group.ClusterID, cloudName, err.Error(),
+		)
+		return nil, err
+	}
+	return task, nil
+}
+
+// DeleteNodeGroup delete nodegroup by cloudprovider api, all nodes belong to NodeGroup
+// will be released. Task is backgroup automatic task
+func (ng *NodeGroup) DeleteNodeGroup(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when DeleteNodeGroup %s failed, %s",
+			cloudName, group.Name, err.Error(),
+		)
+		return nil, err
+	}
+	task, err := mgr.BuildDeleteNodeGroupTask(group, nodes, opt)
+	if err != nil {
+		blog.Errorf("build DeleteNodeGroup task for cluster %s with cloudprovider %s failed, %s",
+			group.ClusterID, cloudName, err.Error(),
+		)
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateNodeGroup update specified nodegroup configuration, only update nodegroup data
+func (ng *NodeGroup) UpdateNodeGroup(
+	group *proto.NodeGroup, opt *cloudprovider.UpdateNodeGroupOption) (*proto.Task, error) {
+	return nil, nil
+}
+
+// GetNodesInGroup get all nodes belong to NodeGroup
+func (ng *NodeGroup) GetNodesInGroup(group *proto.NodeGroup, opt *cloudprovider.CommonOption) ([]*proto.Node, error) {
+	if group == nil || opt == nil {
+		return nil, fmt.Errorf("mockCloud GetNodesInGroup lost group or option")
+	}
+	if group.CloudNodeGroupID == "" {
+		return nil, fmt.Errorf("mockCloud GetNodesInGroup nodegroup %s cloudNodeGroupID empty", group.NodeGroupID)
+	}
+
+	cli, err := api.NewMockClient(opt)
+	if err != nil {
+		return nil, err
+	}
+	mockGroup, err := cli.DescribeNodeGroup(group.CloudNodeGroupID)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := cli.DescribeInstances(mockGroup.InstanceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*proto.Node, 0, len(instances))
+	for _, ins := range instances {
+		node := transInstanceToNode(ins)
+		node.ClusterID = group.ClusterID
+		node.NodeGroupID = group.NodeGroupID
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// GetNodesInGroupV2 get nodeGroup nodes by v2 version
+func (ng *NodeGroup) GetNodesInGroupV2(group *proto.NodeGroup,
+	opt *cloudprovider.CommonOption) ([]*proto.NodeGroupNode, error) {
+	nodes, err := ng.GetNodesInGroup(group, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	groupNodes := make([]*proto.NodeGroupNode, 0, len(nodes))
+	for _, node := range nodes {
+		groupNodes = append(groupNodes, &proto.NodeGroupNode{
+			NodeID:      node.NodeID,
+			NodeGroupID: group.NodeGroupID,
+			ClusterID:   group.ClusterID,
+			InnerIP:     node.InnerIP,
+			Status:      node.Status,
+		})
+	}
+
+	return groupNodes, nil
+}
+
+// MoveNodesToGroup add cluster nodes to NodeGroup
+func (ng *NodeGroup) MoveNodesToGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// RemoveNodesFromGroup remove nodes from NodeGroup, nodes are still in cluster
+func (ng *NodeGroup) RemoveNodesFromGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.RemoveNodesOption) error {
+	return nil
+}
+
+// CleanNodesInGroup clean specified nodes in NodeGroup,
+func (ng *NodeGroup) CleanNodesInGroup(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	if len(nodes) == 0 || opt == nil || opt.Cluster == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(cloudName)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when CleanNodesInGroup %s failed, %s",
+			cloudName, group.Name, err.Error())
+		return nil, err
+	}
+	task, err := mgr.BuildCleanNodesInGroupTask(nodes, group, opt)
+	if err != nil {
+		blog.Errorf("build CleanNodesInGroup task for cluster %s with cloudprovider %s failed, %s",
+			group.ClusterID, cloudName, err.Error())
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateDesiredNodes update nodegroup desired node
+func (ng *NodeGroup) UpdateDesiredNodes(desired uint32, group *proto.NodeGroup,
+	opt *cloudprovider.UpdateDesiredNodeOption) (*cloudprovider.ScalingResponse, error) {
+	if group == nil || opt == nil || opt.Cluster == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+
+	taskType := cloudprovider.GetTaskType(opt.Cloud.CloudProvider, cloudprovider.UpdateNodeGroupDesiredNode)
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid":   opt.Cluster.ClusterID,
+		"tasktype":    taskType,
+		"nodegroupid": group.NodeGroupID,
+		"status":      cloudprovider.TaskStatusRunning,
+	})
+	taskList, err := cloudprovider.GetStorageModel().ListTask(context.Background(), cond, &storeopt.ListOption{})
+	if err != nil {
+		blog.Errorf("UpdateDesiredNodes failed: %v", err)
+		return nil, err
+	}
+	if len(taskList) != 0 {
+		return nil, fmt.Errorf("%d %s task(s) is still running", len(taskList), taskType)
+	}
+
+	return &cloudprovider.ScalingResponse{
+		ScalingUp: desired,
+	}, nil
+}
+
+// SwitchNodeGroupAutoScaling switch nodegroup autoscaling, only update nodegroup data
+func (ng *NodeGroup) SwitchNodeGroupAutoScaling(group *proto.NodeGroup, enable bool,
+	opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, nil
+}
+
+// CreateAutoScalingOption create cluster autoscaling option, cloudprovider will
+// deploy cluster-autoscaler in backgroup according cloudprovider implementation
+func (ng *NodeGroup) CreateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.CreateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteAutoScalingOption delete cluster autoscaling, cloudprovider will clean
+// cluster-autoscaler in backgroup according cloudprovider implementation
+func (ng *NodeGroup) DeleteAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.DeleteScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// UpdateAutoScalingOption update cluster autoscaling option, only update autoscaling option data
+func (ng *NodeGroup) UpdateAutoScalingOption(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.UpdateScalingOption) (*proto.Task, error) {
+	return nil, nil
+}
+
+// SwitchAutoScalingOptionStatus switch cluster autoscaling option status, only update autoscaling option data
+func (ng *NodeGroup) SwitchAutoScalingOptionStatus(scalingOption *proto.ClusterAutoScalingOption, enable bool,
+	opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, nil
+}
+
+// AddExternalNodeToCluster add external to cluster
+func (ng *NodeGroup) AddExternalNodeToCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.AddExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// DeleteExternalNodeFromCluster remove external node from cluster
+func (ng *NodeGroup) DeleteExternalNodeFromCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetExternalNodeScript get nodegroup external node script
+func (ng *NodeGroup) GetExternalNodeScript(group *proto.NodeGroup, internal bool) (string, error) {
+	return "", cloudprovider.ErrCloudNotImplemented
+}
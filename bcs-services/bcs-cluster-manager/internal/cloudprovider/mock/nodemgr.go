@@ -0,0 +1,194 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"fmt"
+	"sync"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+)
+
+var nodeMgr sync.Once
+
+func init() {
+	nodeMgr.Do(func() {
+		// init Node
+		cloudprovider.InitNodeManager(cloudName, &NodeManager{})
+	})
+}
+
+// NodeManager define node manager
+type NodeManager struct {
+}
+
+// GetNodeByIP get specified Node by innerIP address
+func (n *NodeManager) GetNodeByIP(ip string, opt *cloudprovider.GetNodeOption) (*proto.Node, error) {
+	nodes, err := n.ListNodesByIP([]string{ip}, &cloudprovider.ListNodesOption{
+		Common:       opt.Common,
+		ClusterVPCID: opt.ClusterVPCID,
+		ClusterID:    opt.ClusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("mockCloud node %s not found", ip)
+	}
+
+	return nodes[0], nil
+}
+
+// ListNodesByIP list node by IP set, instances are simulated for unknown ips
+func (n *NodeManager) ListNodesByIP(ips []string, opt *cloudprovider.ListNodesOption) ([]*proto.Node, error) {
+	if opt == nil || opt.Common == nil {
+		return nil, fmt.Errorf("mockCloud ListNodesByIP lost option")
+	}
+	cli, err := api.NewMockClient(opt.Common)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := cli.DescribeInstancesByIP(ips)
+	if err != nil {
+		return nil, err
+	}
+	exist := make(map[string]struct{}, len(instances))
+	for _, ins := range instances {
+		exist[ins.PrivateIP] = struct{}{}
+	}
+	missing := make([]string, 0)
+	for _, ip := range ips {
+		if _, ok := exist[ip]; !ok {
+			missing = append(missing, ip)
+		}
+	}
+	if len(missing) > 0 {
+		created, errCreate := cli.CreateInstances(&api.CreateInstancesRequest{
+			Region: opt.Common.Region,
+			VpcID:  opt.ClusterVPCID,
+			IPs:    missing,
+		})
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		instances = append(instances, created...)
+	}
+
+	nodes := make([]*proto.Node, 0, len(instances))
+	for _, ins := range instances {
+		nodes = append(nodes, transInstanceToNode(ins))
+	}
+
+	return nodes, nil
+}
+
+// GetExternalNodeByIP get specified Node by innerIP address
+func (n *NodeManager) GetExternalNodeByIP(ip string, opt *cloudprovider.GetNodeOption) (*proto.Node, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListExternalNodesByIP list node by IP set
+func (n *NodeManager) ListExternalNodesByIP(ips []string, opt *cloudprovider.ListNodesOption) ([]*proto.Node, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetCVMImageIDByImageName get imageID by imageName
+func (n *NodeManager) GetCVMImageIDByImageName(imageName string, opt *cloudprovider.CommonOption) (string, error) {
+	return "", cloudprovider.ErrCloudNotImplemented
+}
+
+// GetCloudRegions get cloud regions
+func (n *NodeManager) GetCloudRegions(opt *cloudprovider.CommonOption) ([]*proto.RegionInfo, error) {
+	return []*proto.RegionInfo{
+		{
+			Region:      api.DefaultRegion,
+			RegionName:  api.DefaultRegion,
+			RegionState: "AVAILABLE",
+		},
+	}, nil
+}
+
+// GetZoneList get zoneList by region
+func (n *NodeManager) GetZoneList(opt *cloudprovider.GetZoneListOption) ([]*proto.ZoneInfo, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("mockCloud GetZoneList lost option")
+	}
+
+	zoneInfo := make([]*proto.ZoneInfo, 0)
+	for _, zone := range api.GetRegionZones(opt.Region) {
+		zoneInfo = append(zoneInfo, &proto.ZoneInfo{
+			ZoneID:    zone,
+			Zone:      zone,
+			ZoneName:  zone,
+			ZoneState: "AVAILABLE",
+		})
+	}
+
+	return zoneInfo, nil
+}
+
+// ListNodeInstanceType list node type by zone and node family
+func (n *NodeManager) ListNodeInstanceType(info cloudprovider.InstanceInfo,
+	opt *cloudprovider.CommonOption) ([]*proto.InstanceType, error) {
+	region := info.Region
+	if region == "" {
+		region = api.DefaultRegion
+	}
+
+	return []*proto.InstanceType{
+		{
+			NodeType:   api.DefaultInstanceType,
+			TypeName:   api.DefaultInstanceType,
+			NodeFamily: "MOCK",
+			Cpu:        4,
+			Memory:     8,
+			Status:     "SELL",
+			Zones:      api.GetRegionZones(region),
+			Provider:   cloudName,
+		},
+	}, nil
+}
+
+// ListOsImage get osimage list
+func (n *NodeManager) ListOsImage(provider string, opt *cloudprovider.CommonOption) ([]*proto.OsImage, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// ListKeyPairs keyPairs list
+func (n *NodeManager) ListKeyPairs(opt *cloudprovider.ListNetworksOption) ([]*proto.KeyPair, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// GetResourceGroups resource groups list
+func (n *NodeManager) GetResourceGroups(opt *cloudprovider.CommonOption) ([]*proto.ResourceGroupInfo, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// transInstanceToNode trans mock cloud instance to node
+func transInstanceToNode(ins *api.Instance) *proto.Node {
+	return &proto.Node{
+		NodeID:       ins.InstanceID,
+		InnerIP:      ins.PrivateIP,
+		InstanceType: ins.InstanceType,
+		CPU:          ins.CPU,
+		Mem:          ins.Memory,
+		Region:       ins.Region,
+		ZoneID:       ins.Zone,
+		VPC:          ins.VpcID,
+		NodeName:     ins.PrivateIP,
+		Status:       ins.Status,
+	}
+}
@@ -0,0 +1,639 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/tasks"
+)
+
+var taskMgr sync.Once
+
+func init() {
+	taskMgr.Do(func() {
+		cloudprovider.InitTaskManager(cloudName, newtask())
+	})
+}
+
+func newtask() *Task {
+	task := &Task{
+		works: make(map[string]interface{}),
+	}
+
+	// create cluster task
+	task.works[createClusterStep.StepMethod] = tasks.CreateClusterTask
+	task.works[checkClusterStatusStep.StepMethod] = tasks.CheckClusterStatusTask
+	task.works[registerClusterKubeConfigStep.StepMethod] = tasks.RegisterClusterKubeConfigTask
+	task.works[updateCreateClusterDBInfoStep.StepMethod] = tasks.UpdateCreateClusterDBInfoTask
+
+	// delete cluster task
+	task.works[deleteClusterStep.StepMethod] = tasks.DeleteClusterTask
+	task.works[cleanClusterDBInfoStep.StepMethod] = tasks.CleanClusterDBInfoTask
+
+	// add nodes to cluster task
+	task.works[addNodesToClusterStep.StepMethod] = tasks.AddNodesToClusterTask
+	task.works[addNodesToClusterStep.RollbackMethod] = tasks.RollbackAddNodesToClusterTask
+	task.works[checkClusterNodesStatusStep.StepMethod] = tasks.CheckClusterNodesStatusTask
+
+	// remove nodes from cluster task
+	task.works[removeNodesFromClusterStep.StepMethod] = tasks.RemoveNodesFromClusterTask
+
+	// create nodeGroup task
+	task.works[createCloudNodeGroupStep.StepMethod] = tasks.CreateCloudNodeGroupTask
+	task.works[checkCloudNodeGroupStatusStep.StepMethod] = tasks.CheckCloudNodeGroupStatusTask
+
+	// delete nodeGroup task
+	task.works[deleteCloudNodeGroupStep.StepMethod] = tasks.DeleteCloudNodeGroupTask
+
+	// update nodeGroup desired nodes task
+	task.works[applyInstanceMachinesStep.StepMethod] = tasks.ApplyInstanceMachinesTask
+
+	// clean nodeGroup nodes task
+	task.works[removeNodeGroupInstancesStep.StepMethod] = tasks.RemoveNodeGroupInstancesTask
+
+	return task
+}
+
+// Task background task manager
+type Task struct {
+	works map[string]interface{}
+}
+
+// Name get cloudName
+func (t *Task) Name() string {
+	return cloudName
+}
+
+// GetAllTask register all backgroup task for worker running
+func (t *Task) GetAllTask() map[string]interface{} {
+	return t.works
+}
+
+// BuildCreateVirtualClusterTask build create virtual cluster task
+func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.CreateVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteVirtualClusterTask build delete virtual cluster task
+func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.DeleteVirtualClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildCreateClusterTask build create cluster task
+func (t *Task) BuildCreateClusterTask(
+	cls *proto.Cluster, opt *cloudprovider.CreateClusterOption) (*proto.Task, error) {
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildCreateClusterTask TaskOptions is lost")
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.CreateCluster),
+		TaskName:       cloudprovider.CreateClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(createClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	createClusterTask := &CreateClusterTaskOption{Cluster: cls, NodeIPs: opt.WorkerNodes}
+	// step1: create mock cluster and return clusterID inject common paras
+	createClusterTask.BuildCreateClusterStep(task)
+	// step2: check cluster status by clusterID
+	createClusterTask.BuildCheckClusterStatusStep(task)
+	// step3: add worker nodes to cluster and check nodes status
+	createClusterTask.BuildAddNodesToClusterStep(task)
+	// step4: register cluster kubeConfig
+	createClusterTask.BuildRegisterClsKubeConfigStep(task)
+	// step5: update cluster nodes DB info
+	createClusterTask.BuildUpdateCreateClusterDBInfoStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCreateClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.UserKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CreateClusterJob.String()
+	if len(opt.WorkerNodes) > 0 {
+		task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(opt.WorkerNodes, ",")
+	}
+
+	return task, nil
+}
+
+// BuildImportClusterTask build import cluster task
+func (t *Task) BuildImportClusterTask(
+	cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteClusterTask build deleteCluster task
+func (t *Task) BuildDeleteClusterTask(
+	cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildDeleteClusterTask TaskOptions is lost")
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.DeleteCluster),
+		TaskName:       cloudprovider.DeleteClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	taskName := fmt.Sprintf(deleteClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+
+	// setting all steps details
+	deleteCluster := &DeleteClusterTaskOption{
+		Cluster:    cls,
+		DeleteMode: opt.DeleteMode.String(),
+	}
+	// step1: delete mock cluster and cluster instances
+	deleteCluster.BuildDeleteClusterStep(task)
+	// step2: update cluster DB info and associated data
+	deleteCluster.BuildCleanClusterDBInfoStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildDeleteClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteClusterJob.String()
+
+	return task, nil
+}
+
+// BuildAddNodesToClusterTask build addNodes task
+func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.AddNodesOption) (*proto.Task, error) {
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask cluster info empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask nodes info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask TaskOptions is lost")
+	}
+
+	nodeIPs := make([]string, 0)
+	for i := range nodes {
+		nodeIPs = append(nodeIPs, nodes[i].InnerIP)
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.AddNodesToCluster),
+		TaskName:       cloudprovider.AddNodesToClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    opt.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(addNodeTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	addNodesTask := &AddNodesToClusterTaskOption{
+		Cluster:     cls,
+		NodeGroupID: opt.NodeGroupID,
+		NodeIPs:     nodeIPs,
+	}
+	// step1: add instances to mock cluster
+	addNodesTask.BuildAddNodesToClusterStep(task)
+	// step2: check instances status and register cluster nodes
+	addNodesTask.BuildCheckClusterNodesStatusStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildAddNodesToClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.UserKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.AddNodeJob.String()
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+
+	return task, nil
+}
+
+// BuildRemoveNodesFromClusterTask build removeNodes task
+func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodesOption) (*proto.Task, error) {
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask cluster info empty")
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask nodes info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cloud == nil {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask TaskOptions is lost")
+	}
+
+	var (
+		nodeIPs, nodeIDs = make([]string, 0), make([]string, 0)
+	)
+	for i := range nodes {
+		nodeIPs = append(nodeIPs, nodes[i].InnerIP)
+		nodeIDs = append(nodeIDs, nodes[i].NodeID)
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.RemoveNodesFromCluster),
+		TaskName:       cloudprovider.RemoveNodesFromClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(removeNodeTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	removeNodesTask := &RemoveNodesFromClusterTaskOption{
+		Cluster:    cls,
+		DeleteMode: opt.DeleteMode,
+		NodeIPs:    nodeIPs,
+		NodeIDs:    nodeIDs,
+	}
+	// step1: remove instances from mock cluster
+	removeNodesTask.BuildRemoveNodesFromClusterStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildRemoveNodesFromClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.UserKey.String()] = opt.Operator
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteNodeJob.String()
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+
+	return task, nil
+}
+
+// BuildCreateNodeGroupTask build create node group task
+func (t *Task) BuildCreateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CreateNodeGroupOption) (
+	*proto.Task, error) {
+	// validate request params
+	if group == nil {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask group info empty")
+	}
+	if opt == nil {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask TaskOptions is lost")
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.CreateNodeGroup),
+		TaskName:       cloudprovider.CreateNodeGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        group.Creator,
+		Updater:        group.Updater,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(createNodeGroupTaskTemplate, group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	createNodeGroup := &CreateNodeGroupTaskOption{Group: group}
+	// step1. create mock cloud node group
+	createNodeGroup.BuildCreateCloudNodeGroupStep(task)
+	// step2. wait mock cloud node group normal
+	createNodeGroup.BuildCheckCloudNodeGroupStatusStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCreateNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CreateNodeGroupJob.String()
+
+	return task, nil
+}
+
+// BuildCleanNodesInGroupTask clean specified nodes in NodeGroup
+// including remove nodes from NodeGroup, clean data in nodes
+func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.CleanNodesOption) (*proto.Task, error) {
+	// validate request params
+	if nodes == nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask nodes info empty")
+	}
+	if group == nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask group info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask TaskOptions is lost")
+	}
+
+	var (
+		nodeIPs, nodeIDs = make([]string, 0), make([]string, 0)
+	)
+	for _, node := range nodes {
+		nodeIPs = append(nodeIPs, node.InnerIP)
+		nodeIDs = append(nodeIDs, node.NodeID)
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.CleanNodeGroupNodes),
+		TaskName:       cloudprovider.CleanNodesInGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        group.Creator,
+		Updater:        group.Updater,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(cleanNodeGroupNodesTaskTemplate, group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	cleanNodes := &CleanNodeInGroupTaskOption{
+		Group:   group,
+		NodeIPs: nodeIPs,
+		NodeIDs: nodeIDs,
+	}
+	// step1: remove nodeGroup instances
+	cleanNodes.BuildRemoveNodeGroupInstancesStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildCleanNodesInGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+
+	// set global task paras
+	task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+	task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.CleanNodeGroupNodesJob.String()
+
+	return task, nil
+}
+
+// BuildUpdateNodeGroupTask when update nodegroup, we need to create background task,
+func (t *Task) BuildUpdateNodeGroupTask(group *proto.NodeGroup, opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteNodeGroupTask when delete nodegroup, we need to create background
+// task to clean all nodes in nodegroup, release all resource in cloudprovider,
+// finnally delete nodes information in local storage.
+// @param group: need to delete
+func (t *Task) BuildDeleteNodeGroupTask(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteNodeGroupOption) (*proto.Task, error) {
+	// validate request params
+	if group == nil {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask group info empty")
+	}
+	if opt == nil {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask TaskOptions is lost")
+	}
+
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.DeleteNodeGroup),
+		TaskName:       cloudprovider.DeleteNodeGroupTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        group.Creator,
+		Updater:        group.Updater,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(deleteNodeGroupTaskTemplate, group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	deleteNodeGroup := &DeleteNodeGroupTaskOption{Group: group}
+	// step1. delete mock cloud node group
+	deleteNodeGroup.BuildDeleteCloudNodeGroupStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildDeleteNodeGroupTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.DeleteNodeGroupJob.String()
+
+	return task, nil
+}
+
+// BuildMoveNodesToGroupTask build move nodes to group task
+func (t *Task) BuildMoveNodesToGroupTask(nodes []*proto.Node, group *proto.NodeGroup,
+	opt *cloudprovider.MoveNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateDesiredNodesTask build update desired nodes task
+func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGroup,
+	opt *cloudprovider.UpdateDesiredNodeOption) (*proto.Task, error) {
+	// validate request params
+	if desired == 0 {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask desired is zero")
+	}
+	if group == nil {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask group info empty")
+	}
+	if opt == nil || len(opt.Operator) == 0 || opt.Cluster == nil {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask TaskOptions is lost")
+	}
+
+	// generate main task
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpdateNodeGroupDesiredNode),
+		TaskName:       cloudprovider.UpdateDesiredNodesTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      group.ClusterID,
+		ProjectID:      group.ProjectID,
+		Creator:        group.Creator,
+		Updater:        group.Updater,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+		NodeGroupID:    group.NodeGroupID,
+	}
+	// generate taskName
+	taskName := fmt.Sprintf(updateNodeGroupDesiredNodeTemplate, group.ClusterID, group.Name)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+
+	// setting all steps details
+	updateDesired := &UpdateDesiredNodesTaskOption{
+		Group:    group,
+		Desired:  desired,
+		Operator: opt.Operator,
+	}
+	// step1. scale out mock cloud node group instances
+	updateDesired.BuildApplyInstanceMachinesStep(task)
+	// step2. check instances status and register cluster nodes
+	updateDesired.BuildCheckClusterNodesStatusStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpdateDesiredNodesTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+
+	// must set job-type
+	task.CommonParams[cloudprovider.ScalingNodesNumKey.String()] = strconv.Itoa(int(desired))
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpdateNodeGroupDesiredNodeJob.String()
+
+	return task, nil
+}
+
+// BuildSwitchNodeGroupAutoScalingTask ensure auto scaler status and update nodegroup status to normal
+func (t *Task) BuildSwitchNodeGroupAutoScalingTask(group *proto.NodeGroup, enable bool,
+	opt *cloudprovider.SwitchNodeGroupAutoScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildUpdateAutoScalingOptionTask update auto scaling option
+func (t *Task) BuildUpdateAutoScalingOptionTask(scalingOption *proto.ClusterAutoScalingOption,
+	opt *cloudprovider.UpdateScalingOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildSwitchAsOptionStatusTask switch auto scaling option status
+func (t *Task) BuildSwitchAsOptionStatusTask(scalingOption *proto.ClusterAutoScalingOption, enable bool,
+	opt *cloudprovider.CommonOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildAddExternalNodeToCluster add external to cluster
+func (t *Task) BuildAddExternalNodeToCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.AddExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
+// BuildDeleteExternalNodeFromCluster remove external node from cluster
+func (t *Task) BuildDeleteExternalNodeFromCluster(group *proto.NodeGroup, nodes []*proto.Node,
+	opt *cloudprovider.DeleteExternalNodesOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
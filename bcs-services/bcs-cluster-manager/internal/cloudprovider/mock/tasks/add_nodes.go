@@ -0,0 +1,358 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+)
+
+// AddNodesToClusterTask add instances to mock cluster
+func AddNodesToClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("AddNodesToClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("AddNodesToClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	if len(nodeIPs) == 0 {
+		blog.Errorf("AddNodesToClusterTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("AddNodesToClusterTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	instances, err := ensureInstancesByIP(cli, dependInfo, nodeIPs)
+	if err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: ensureInstancesByIP[%v] failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("get mock cloud instances failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	instanceIDs := getInstanceIDs(instances)
+
+	err = cli.AddClusterInstances(dependInfo.Cluster.SystemID, instanceIDs)
+	if err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: AddClusterInstances[%v] failed: %v", taskID, instanceIDs, err)
+		retErr := fmt.Errorf("call mock cloud AddClusterInstances failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("AddNodesToClusterTask[%s]: cluster[%s] add instances %v successful", taskID, clusterID, instanceIDs)
+
+	updateNodesCloudInfo(ctx, clusterID, instances)
+
+	state.Task.CommonParams[cloudprovider.SuccessNodeIDsKey.String()] = strings.Join(instanceIDs, ",")
+	state.Task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(instanceIDs, ",")
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("AddNodesToClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckClusterNodesStatusTask check cluster instances running and register nodes to kube backend
+func CheckClusterNodesStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckClusterNodesStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckClusterNodesStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	instanceIDs := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.CommonParams,
+		cloudprovider.NodeIDsKey.String(), ",")
+	if len(instanceIDs) == 0 {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("CheckClusterNodesStatusTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	instances, err := waitInstancesRunning(ctx, cli, instanceIDs)
+	if err != nil {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: waitInstancesRunning[%v] failed: %v",
+			taskID, instanceIDs, err)
+		retErr := fmt.Errorf("check mock cloud instances status failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cli.RegisterKubeNodes(ctx, instances)
+	if err != nil {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: RegisterKubeNodes failed: %v", taskID, err)
+		retErr := fmt.Errorf("register kube nodes failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CheckClusterNodesStatusTask[%s]: cluster[%s] instances %v running", taskID, clusterID, instanceIDs)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckClusterNodesStatusTask[%s]: task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// RollbackAddNodesToClusterTask remove added instances from mock cluster when task canceled
+func RollbackAddNodesToClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task rollback step
+	state, step, err := cloudprovider.GetTaskStateAndRollbackStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// step already rollback when retry rollback
+	if step == nil {
+		blog.Infof("RollbackAddNodesToClusterTask[%s]: step[%s] already rollback and skip", taskID, stepName)
+		return nil
+	}
+
+	// extract valid info
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	successNodes := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.GetCommonParams(),
+		cloudprovider.SuccessNodeIDsKey.String(), ",")
+	if len(successNodes) == 0 {
+		blog.Infof("RollbackAddNodesToClusterTask[%s] cluster[%s] successNodes empty and skip", taskID, clusterID)
+		if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+			blog.Errorf("RollbackAddNodesToClusterTask[%s] task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] GetClusterDependBasicInfo task %s step %s failed, %s",
+			taskID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud credential err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	instances, err := cli.DescribeInstances(successNodes)
+	if err == nil {
+		err = cli.DeleteKubeNodes(ctx, getInstanceIPs(instances))
+	}
+	if err == nil {
+		err = cli.RemoveClusterInstances(dependInfo.Cluster.SystemID, successNodes)
+	}
+	if err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] RemoveClusterInstances failed: %v", taskID, err)
+		retErr := fmt.Errorf("RemoveClusterInstances err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("RollbackAddNodesToClusterTask[%s] cluster[%s] removed nodes[%v]", taskID, clusterID, successNodes)
+
+	for i := range successNodes {
+		err = cloudprovider.GetStorageModel().DeleteNode(context.Background(), successNodes[i])
+		if err != nil {
+			blog.Errorf("RollbackAddNodesToClusterTask[%s] DeleteNode[%s] failed: %v", taskID, successNodes[i], err)
+		}
+	}
+
+	// update step
+	if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// RemoveNodesFromClusterTask remove instances from mock cluster and delete kube nodes
+func RemoveNodesFromClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RemoveNodesFromClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RemoveNodesFromClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	deleteMode := step.Params[cloudprovider.DeleteModeKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	instances, err := cli.DescribeInstancesByIP(nodeIPs)
+	if err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: DescribeInstancesByIP[%v] failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("call mock cloud DescribeInstances failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	instanceIDs := getInstanceIDs(instances)
+
+	if err = cli.DeleteKubeNodes(ctx, nodeIPs); err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: DeleteKubeNodes[%v] failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("delete kube nodes failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cli.RemoveClusterInstances(dependInfo.Cluster.SystemID, instanceIDs)
+	if err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: RemoveClusterInstances[%v] failed: %v",
+			taskID, instanceIDs, err)
+		retErr := fmt.Errorf("call mock cloud RemoveClusterInstances failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// terminate instances
+	if deleteMode == cloudprovider.Terminate.String() {
+		if err = cli.DeleteInstances(instanceIDs); err != nil {
+			blog.Errorf("RemoveNodesFromClusterTask[%s]: DeleteInstances[%v] failed: %v", taskID, instanceIDs, err)
+			retErr := fmt.Errorf("call mock cloud DeleteInstances failed: %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	blog.Infof("RemoveNodesFromClusterTask[%s]: cluster[%s] remove instances %v successful",
+		taskID, clusterID, instanceIDs)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("RemoveNodesFromClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/types"
+)
+
+// CreateClusterTask call mock cloud interface to create cluster
+func CreateClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CreateClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CreateClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// cluster already created when retry task
+	if dependInfo.Cluster.SystemID != "" {
+		blog.Infof("CreateClusterTask[%s]: cluster[%s] systemID[%s] already created",
+			taskID, clusterID, dependInfo.Cluster.SystemID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("CreateClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cls, err := cli.CreateCluster(&api.CreateClusterRequest{
+		ClusterName: dependInfo.Cluster.ClusterName,
+		Region:      dependInfo.Cluster.Region,
+		Version:     dependInfo.Cluster.GetClusterBasicSettings().GetVersion(),
+		VpcID:       dependInfo.Cluster.VpcID,
+	})
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: call mock cloud CreateCluster failed: %v", taskID, err)
+		retErr := fmt.Errorf("call mock cloud CreateCluster failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cloudprovider.UpdateClusterSystemID(clusterID, cls.ClusterID)
+	if err != nil {
+		blog.Errorf("CreateClusterTask[%s]: update cluster[%s] systemID[%s] failed: %v",
+			taskID, clusterID, cls.ClusterID, err)
+		retErr := fmt.Errorf("update cluster systemID failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CreateClusterTask[%s]: cluster[%s] create mock cluster[%s] successful",
+		taskID, clusterID, cls.ClusterID)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CreateClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckClusterStatusTask check mock cluster status until running
+func CheckClusterStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckClusterStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckClusterStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCheckTimeout)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		cls, errGet := cli.DescribeCluster(dependInfo.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("CheckClusterStatusTask[%s]: DescribeCluster[%s] failed: %v",
+				taskID, dependInfo.Cluster.SystemID, errGet)
+			return nil
+		}
+		blog.Infof("CheckClusterStatusTask[%s]: cluster[%s] status %s", taskID, cls.ClusterID, cls.Status)
+		if cls.Status == api.ClusterStatusRunning {
+			return loop.EndLoop
+		}
+		return nil
+	}, loop.LoopInterval(defaultCheckInterval))
+	if err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: check cluster[%s] status failed: %v", taskID, clusterID, err)
+		retErr := fmt.Errorf("check mock cluster status failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckClusterStatusTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// RegisterClusterKubeConfigTask register backend kube-apiserver kubeConfig as cluster credential
+func RegisterClusterKubeConfigTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RegisterClusterKubeConfigTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RegisterClusterKubeConfigTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("RegisterClusterKubeConfigTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("RegisterClusterKubeConfigTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// mock cloud without backend kube-apiserver, cluster has no credential
+	if !cli.HasKubeBackend() {
+		blog.Infof("RegisterClusterKubeConfigTask[%s]: mock cloud without kube backend, skip", taskID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("RegisterClusterKubeConfigTask[%s]: task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	err = importClusterCredential(cli.GetOptions().KubeConfig, clusterID)
+	if err != nil {
+		blog.Errorf("RegisterClusterKubeConfigTask[%s]: importClusterCredential for cluster[%s] failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("import cluster credential failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("RegisterClusterKubeConfigTask[%s]: register cluster[%s] kubeConfig successful", taskID, clusterID)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("RegisterClusterKubeConfigTask[%s]: task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+func importClusterCredential(kubeConfig string, clusterID string) error {
+	kubeRet, err := base64.StdEncoding.DecodeString(kubeConfig)
+	if err != nil {
+		return err
+	}
+	config, err := types.GetKubeConfigFromYAMLBody(false, types.YamlInput{
+		YamlContent: string(kubeRet),
+	})
+	if err != nil {
+		return err
+	}
+
+	return cloudprovider.UpdateClusterCredentialByConfig(clusterID, config)
+}
+
+// UpdateCreateClusterDBInfoTask update cluster nodes status
+func UpdateCreateClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpdateCreateClusterDBInfoTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpdateCreateClusterDBInfoTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+
+	// update nodes status
+	if len(nodeIPs) > 0 {
+		_ = cloudprovider.UpdateNodeListStatus(true, nodeIPs, icommon.StatusRunning)
+	}
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpdateCreateClusterDBInfoTask[%s]: task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
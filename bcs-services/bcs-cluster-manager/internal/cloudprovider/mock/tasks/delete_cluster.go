@@ -0,0 +1,188 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/utils"
+	icommon "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+)
+
+// DeleteClusterTask delete mock cluster and cluster nodes in kube backend
+func DeleteClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("DeleteClusterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("DeleteClusterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	deleteMode := step.Params[cloudprovider.DeleteModeKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("DeleteClusterTask[%s]: GetClusterDependBasicInfo for cluster %s "+
+			"in task %s step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("DeleteClusterTask[%s]: get mock client for cluster[%s] failed, %s",
+			taskID, clusterID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if dependInfo.Cluster.SystemID == "" {
+		blog.Infof("DeleteClusterTask[%s]: task %s DeleteCluster skip current step "+
+			"because SystemID empty", taskID, taskID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("DeleteClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	cls, err := cli.DescribeCluster(dependInfo.Cluster.SystemID)
+	if err == nil {
+		instances, errGet := cli.DescribeInstances(cls.InstanceIDs)
+		if errGet == nil {
+			if errDel := cli.DeleteKubeNodes(ctx, getInstanceIPs(instances)); errDel != nil {
+				blog.Errorf("DeleteClusterTask[%s]: DeleteKubeNodes failed: %v", taskID, errDel)
+			}
+			// terminate cluster instances
+			if deleteMode == cloudprovider.Terminate.String() {
+				if errDel := cli.DeleteInstances(cls.InstanceIDs); errDel != nil {
+					blog.Errorf("DeleteClusterTask[%s]: DeleteInstances failed: %v", taskID, errDel)
+				}
+			}
+		}
+	}
+
+	err = cli.DeleteCluster(dependInfo.Cluster.SystemID)
+	if err != nil {
+		blog.Errorf("DeleteClusterTask[%s]: task[%s] step[%s] call mock cloud DeleteCluster failed: %v",
+			taskID, taskID, stepName, err)
+		retErr := fmt.Errorf("call mock cloud DeleteCluster failed: %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	_ = cloudprovider.UpdateClusterSystemID(clusterID, "")
+	blog.Infof("DeleteClusterTask[%s]: task %s DeleteCluster[%s] successful",
+		taskID, taskID, dependInfo.Cluster.SystemID)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("DeleteClusterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CleanClusterDBInfoTask clean cluster DB info
+func CleanClusterDBInfoTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CleanClusterDBInfoTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: get cluster for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("get cluster information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// delete cluster autoscalingOption
+	err = cloudprovider.GetStorageModel().DeleteAutoScalingOption(context.Background(), cluster.ClusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: clean cluster[%s] "+
+			"autoscalingOption failed: %v", taskID, cluster.ClusterID, err)
+	}
+
+	// delete nodes
+	err = cloudprovider.GetStorageModel().DeleteNodesByClusterID(context.Background(), cluster.ClusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete nodes for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("delete node for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: delete nodes for cluster[%s] in DB successful", taskID, clusterID)
+
+	// delete nodeGroup
+	err = cloudprovider.GetStorageModel().DeleteNodeGroupByClusterID(context.Background(), cluster.ClusterID)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete nodeGroups for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("delete nodeGroups for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: delete nodeGroups for cluster[%s] in DB successful",
+		taskID, clusterID)
+
+	// delete cluster
+	cluster.Status = icommon.StatusDeleting
+	err = cloudprovider.GetStorageModel().UpdateCluster(context.Background(), cluster)
+	if err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: delete cluster for %s failed", taskID, clusterID)
+		retErr := fmt.Errorf("delete cluster for %s failed, %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CleanClusterDBInfoTask[%s]: delete cluster[%s] in DB successful", taskID, clusterID)
+
+	_ = utils.DeleteClusterCredentialInfo(cluster.ClusterID)
+
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CleanClusterDBInfoTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,471 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+)
+
+// CreateCloudNodeGroupTask create mock cloud nodeGroup
+func CreateCloudNodeGroupTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CreateCloudNodeGroupTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CreateCloudNodeGroupTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("CreateCloudNodeGroupTask[%s]: GetClusterDependBasicInfo for nodegroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	group := dependInfo.NodeGroup
+	// nodeGroup already created when retry task
+	if group.CloudNodeGroupID != "" {
+		blog.Infof("CreateCloudNodeGroupTask[%s]: nodegroup[%s] cloudNodeGroupID[%s] exist and skip",
+			taskID, nodeGroupID, group.CloudNodeGroupID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("CreateCloudNodeGroupTask[%s]: task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("CreateCloudNodeGroupTask[%s]: get mock client for nodegroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	req := &api.CreateNodeGroupRequest{
+		Name:      group.Name,
+		ClusterID: dependInfo.Cluster.SystemID,
+		Region:    group.Region,
+		VpcID:     dependInfo.Cluster.VpcID,
+	}
+	if group.AutoScaling != nil {
+		req.Zones = group.AutoScaling.Zones
+		req.SubnetIDs = group.AutoScaling.SubnetIDs
+		req.MinSize = group.AutoScaling.MinSize
+		req.MaxSize = group.AutoScaling.MaxSize
+		if group.AutoScaling.VpcID != "" {
+			req.VpcID = group.AutoScaling.VpcID
+		}
+	}
+	if group.LaunchTemplate != nil {
+		req.InstanceType = group.LaunchTemplate.InstanceType
+	}
+
+	ng, err := cli.CreateNodeGroup(req)
+	if err != nil {
+		blog.Errorf("CreateCloudNodeGroupTask[%s]: call mock cloud CreateNodeGroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("call mock cloud CreateNodeGroup failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("CreateCloudNodeGroupTask[%s]: call mock cloud CreateNodeGroup[%s] successful",
+		taskID, ng.NodeGroupID)
+
+	group.CloudNodeGroupID = ng.NodeGroupID
+	if group.AutoScaling != nil && group.AutoScaling.VpcID == "" {
+		group.AutoScaling.VpcID = ng.VpcID
+	}
+	err = cloudprovider.UpdateNodeGroupCloudNodeGroupID(nodeGroupID, group)
+	if err != nil {
+		blog.Errorf("CreateCloudNodeGroupTask[%s]: updateNodeGroupCloudNodeGroupID[%s] in task %s step %s "+
+			"failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("call CreateCloudNodeGroupTask updateNodeGroupCloudNodeGroupID[%s] api err, %s",
+			nodeGroupID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CreateCloudNodeGroupTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckCloudNodeGroupStatusTask check mock cloud nodeGroup status
+func CheckCloudNodeGroupStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckCloudNodeGroupStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckCloudNodeGroupStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("CheckCloudNodeGroupStatusTask[%s]: GetClusterDependBasicInfo for nodegroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("CheckCloudNodeGroupStatusTask[%s]: get mock client for nodegroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCheckTimeout)
+	defer cancel()
+
+	// loop nodeGroup status
+	err = loop.LoopDoFunc(ctx, func() error {
+		ng, errGet := cli.DescribeNodeGroup(dependInfo.NodeGroup.CloudNodeGroupID)
+		if errGet != nil {
+			blog.Errorf("CheckCloudNodeGroupStatusTask[%s]: DescribeNodeGroup failed: %v", taskID, errGet)
+			return nil
+		}
+		blog.Infof("CheckCloudNodeGroupStatusTask[%s]: nodegroup[%s] status %s",
+			taskID, ng.NodeGroupID, ng.Status)
+		if ng.Status == api.NodeGroupStatusNormal {
+			return loop.EndLoop
+		}
+		return nil
+	}, loop.LoopInterval(defaultCheckInterval))
+	if err != nil {
+		blog.Errorf("CheckCloudNodeGroupStatusTask[%s]: DescribeNodeGroup failed: %v", taskID, err)
+		retErr := fmt.Errorf("DescribeNodeGroup failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckCloudNodeGroupStatusTask[%s]: task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// DeleteCloudNodeGroupTask delete mock cloud nodeGroup
+func DeleteCloudNodeGroupTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("DeleteCloudNodeGroupTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("DeleteCloudNodeGroupTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("DeleteCloudNodeGroupTask[%s]: GetClusterDependBasicInfo for nodegroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	if dependInfo.NodeGroup.CloudNodeGroupID == "" {
+		blog.Infof("DeleteCloudNodeGroupTask[%s]: nodegroup[%s] cloudNodeGroupID empty and skip",
+			taskID, nodeGroupID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("DeleteCloudNodeGroupTask[%s]: task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("DeleteCloudNodeGroupTask[%s]: get mock client for nodegroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	ng, err := cli.DescribeNodeGroup(dependInfo.NodeGroup.CloudNodeGroupID)
+	if err == nil {
+		instances, errGet := cli.DescribeInstances(ng.InstanceIDs)
+		if errGet == nil && len(instances) > 0 {
+			if errDel := cli.DeleteKubeNodes(ctx, getInstanceIPs(instances)); errDel != nil {
+				blog.Errorf("DeleteCloudNodeGroupTask[%s]: DeleteKubeNodes failed: %v", taskID, errDel)
+			}
+		}
+	}
+
+	err = cli.DeleteNodeGroup(dependInfo.NodeGroup.CloudNodeGroupID)
+	if err != nil {
+		blog.Errorf("DeleteCloudNodeGroupTask[%s]: call mock cloud DeleteNodeGroup[%s] failed, %s",
+			taskID, dependInfo.NodeGroup.CloudNodeGroupID, err.Error())
+		retErr := fmt.Errorf("call mock cloud DeleteNodeGroup failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("DeleteCloudNodeGroupTask[%s]: delete nodegroup[%s] successful",
+		taskID, dependInfo.NodeGroup.CloudNodeGroupID)
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("DeleteCloudNodeGroupTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// ApplyInstanceMachinesTask scale out mock cloud nodeGroup instances
+func ApplyInstanceMachinesTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("ApplyInstanceMachinesTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("ApplyInstanceMachinesTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	desiredNodes := step.Params[cloudprovider.ScalingNodesNumKey.String()]
+	nodeNum, _ := strconv.Atoi(desiredNodes)
+	if nodeNum <= 0 {
+		blog.Errorf("ApplyInstanceMachinesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("ApplyInstanceMachinesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("ApplyInstanceMachinesTask[%s]: GetClusterDependBasicInfo for nodegroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("ApplyInstanceMachinesTask[%s]: get mock client for nodegroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	instances, err := cli.ScaleOutNodeGroup(dependInfo.NodeGroup.CloudNodeGroupID, nodeNum)
+	if err != nil {
+		blog.Errorf("ApplyInstanceMachinesTask[%s]: ScaleOutNodeGroup[%s] failed: %v",
+			taskID, dependInfo.NodeGroup.CloudNodeGroupID, err)
+		retErr := fmt.Errorf("call mock cloud ScaleOutNodeGroup failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	for _, ins := range instances {
+		node := transInstanceToNode(ins, &proto.Node{
+			ClusterID:   clusterID,
+			NodeGroupID: nodeGroupID,
+			Status:      common.StatusInitialization,
+		})
+		if err = cloudprovider.SaveNodeInfoToDB(ctx, node, true); err != nil {
+			blog.Errorf("ApplyInstanceMachinesTask[%s]: SaveNodeInfoToDB[%s] failed: %v",
+				taskID, ins.PrivateIP, err)
+		}
+	}
+	instanceIDs := getInstanceIDs(instances)
+	instanceIPs := getInstanceIPs(instances)
+	blog.Infof("ApplyInstanceMachinesTask[%s]: nodegroup[%s] scale out instances %v successful",
+		taskID, nodeGroupID, instanceIDs)
+
+	state.Task.CommonParams[cloudprovider.SuccessNodeIDsKey.String()] = strings.Join(instanceIDs, ",")
+	state.Task.CommonParams[cloudprovider.NodeIDsKey.String()] = strings.Join(instanceIDs, ",")
+	state.Task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(instanceIPs, ",")
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("ApplyInstanceMachinesTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// RemoveNodeGroupInstancesTask scale in mock cloud nodeGroup instances
+func RemoveNodeGroupInstancesTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("RemoveNodeGroupInstancesTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("RemoveNodeGroupInstancesTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeIPs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+	nodeIDs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIDsKey.String(), ",")
+	if len(nodeIDs) == 0 {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: check parameter validate failed", taskID)
+		retErr := fmt.Errorf("RemoveNodeGroupInstancesTask check parameters failed")
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: GetClusterDependBasicInfo for nodegroup %s in task %s "+
+			"step %s failed, %s", taskID, nodeGroupID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewMockClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: get mock client for nodegroup[%s] failed, %s",
+			taskID, nodeGroupID, err.Error())
+		retErr := fmt.Errorf("get mock cloud client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	if err = cli.DeleteKubeNodes(ctx, nodeIPs); err != nil {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: DeleteKubeNodes[%v] failed: %v", taskID, nodeIPs, err)
+		retErr := fmt.Errorf("delete kube nodes failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	err = cli.ScaleInNodeGroup(dependInfo.NodeGroup.CloudNodeGroupID, nodeIDs)
+	if err != nil {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: ScaleInNodeGroup[%s] failed: %v",
+			taskID, dependInfo.NodeGroup.CloudNodeGroupID, err)
+		retErr := fmt.Errorf("call mock cloud ScaleInNodeGroup failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("RemoveNodeGroupInstancesTask[%s]: nodegroup[%s] scale in instances %v successful",
+		taskID, nodeGroupID, nodeIDs)
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("RemoveNodeGroupInstancesTask[%s]: task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
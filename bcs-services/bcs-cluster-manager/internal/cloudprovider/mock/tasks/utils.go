@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tasks xxx
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+)
+
+const (
+	// defaultCheckTimeout mock cloud resources check status timeout
+	defaultCheckTimeout = 10 * time.Minute
+	// defaultCheckInterval mock cloud resources check status interval
+	defaultCheckInterval = 2 * time.Second
+)
+
+// ensureInstancesByIP get instances by private ips, create instances for ips which not exist in mock cloud
+func ensureInstancesByIP(cli *api.MockClient, info *cloudprovider.CloudDependBasicInfo,
+	ips []string) ([]*api.Instance, error) {
+	instances, err := cli.DescribeInstancesByIP(ips)
+	if err != nil {
+		return nil, err
+	}
+
+	exist := make(map[string]struct{}, len(instances))
+	for _, ins := range instances {
+		exist[ins.PrivateIP] = struct{}{}
+	}
+	missing := make([]string, 0)
+	for _, ip := range ips {
+		if _, ok := exist[ip]; !ok {
+			missing = append(missing, ip)
+		}
+	}
+	if len(missing) == 0 {
+		return instances, nil
+	}
+
+	created, err := cli.CreateInstances(&api.CreateInstancesRequest{
+		Region: info.Cluster.Region,
+		VpcID:  info.Cluster.VpcID,
+		IPs:    missing,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(instances, created...), nil
+}
+
+// waitInstancesRunning wait instances running until timeout
+func waitInstancesRunning(ctx context.Context, cli *api.MockClient, ids []string) ([]*api.Instance, error) {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	var instances []*api.Instance
+	timeCtx, cancel := context.WithTimeout(ctx, defaultCheckTimeout)
+	defer cancel()
+
+	err := loop.LoopDoFunc(timeCtx, func() error {
+		result, err := cli.DescribeInstances(ids)
+		if err != nil {
+			blog.Errorf("waitInstancesRunning[%s] DescribeInstances failed: %v", taskID, err)
+			return nil
+		}
+		if len(result) != len(ids) {
+			return fmt.Errorf("instances %v part not found", ids)
+		}
+
+		running := 0
+		for _, ins := range result {
+			if ins.Status == api.InstanceStatusRunning {
+				running++
+			}
+		}
+		blog.Infof("waitInstancesRunning[%s] instances total %d, running %d", taskID, len(ids), running)
+		if running == len(ids) {
+			instances = result
+			return loop.EndLoop
+		}
+		return nil
+	}, loop.LoopInterval(defaultCheckInterval))
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+// transInstanceToNode trans mock cloud instance to cluster node
+func transInstanceToNode(ins *api.Instance, node *proto.Node) *proto.Node {
+	if node == nil {
+		node = &proto.Node{}
+	}
+	node.NodeID = ins.InstanceID
+	node.InnerIP = ins.PrivateIP
+	node.InstanceType = ins.InstanceType
+	node.CPU = ins.CPU
+	node.Mem = ins.Memory
+	node.Region = ins.Region
+	node.ZoneID = ins.Zone
+	node.VPC = ins.VpcID
+	node.NodeName = ins.PrivateIP
+
+	return node
+}
+
+// updateNodesCloudInfo update cluster nodes cloud instance info
+func updateNodesCloudInfo(ctx context.Context, clusterID string, instances []*api.Instance) {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	for _, ins := range instances {
+		node, err := cloudprovider.GetStorageModel().GetNodeByIP(ctx, ins.PrivateIP)
+		if err != nil {
+			blog.Errorf("updateNodesCloudInfo[%s] cluster[%s] GetNodeByIP[%s] failed: %v",
+				taskID, clusterID, ins.PrivateIP, err)
+			continue
+		}
+		err = cloudprovider.GetStorageModel().UpdateNode(ctx, transInstanceToNode(ins, node))
+		if err != nil {
+			blog.Errorf("updateNodesCloudInfo[%s] cluster[%s] UpdateNode[%s] failed: %v",
+				taskID, clusterID, ins.PrivateIP, err)
+		}
+	}
+}
+
+func getInstanceIDs(instances []*api.Instance) []string {
+	ids := make([]string, 0, len(instances))
+	for _, ins := range instances {
+		ids = append(ids, ins.InstanceID)
+	}
+	return ids
+}
+
+func getInstanceIPs(instances []*api.Instance) []string {
+	ips := make([]string, 0, len(instances))
+	for _, ins := range instances {
+		ips = append(ips, ins.PrivateIP)
+	}
+	return ips
+}
@@ -0,0 +1,348 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+)
+
+var (
+	cloudName = api.CloudProvider
+)
+
+const (
+	// createClusterTaskTemplate bk-sops add task template
+	createClusterTaskTemplate = "mock-create cluster: %s"
+	// deleteClusterTaskTemplate bk-sops add task template
+	deleteClusterTaskTemplate = "mock-delete cluster: %s"
+	// mock-add node task template
+	addNodeTaskTemplate = "mock-add node: %s"
+	// mock-remove node task template
+	removeNodeTaskTemplate = "mock-remove node: %s"
+	// createNodeGroupTaskTemplate bk-sops add task template
+	createNodeGroupTaskTemplate = "mock-create node group: %s/%s"
+	// deleteNodeGroupTaskTemplate bk-sops add task template
+	deleteNodeGroupTaskTemplate = "mock-delete node group: %s/%s"
+	// updateNodeGroupDesiredNodeTemplate bk-sops add task template
+	updateNodeGroupDesiredNodeTemplate = "mock-update node group desired node: %s/%s"
+	// cleanNodeGroupNodesTaskTemplate bk-sops add task template
+	cleanNodeGroupNodesTaskTemplate = "mock-remove node group nodes: %s/%s"
+)
+
+var (
+	// create cluster task
+	createClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CreateClusterTask", cloudName),
+		StepName:   "创建集群",
+	}
+	checkClusterStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckClusterStatusTask", cloudName),
+		StepName:   "检测集群状态",
+	}
+	registerClusterKubeConfigStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-RegisterClusterKubeConfigTask", cloudName),
+		StepName:   "注册集群连接信息",
+	}
+	updateCreateClusterDBInfoStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpdateCreateClusterDBInfoTask", cloudName),
+		StepName:   "更新集群数据",
+	}
+
+	// delete cluster task
+	deleteClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-DeleteClusterTask", cloudName),
+		StepName:   "删除集群",
+	}
+	cleanClusterDBInfoStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CleanClusterDBInfoTask", cloudName),
+		StepName:   "清理集群数据",
+	}
+
+	// add nodes to cluster task
+	addNodesToClusterStep = cloudprovider.StepInfo{
+		StepMethod:     fmt.Sprintf("%s-AddNodesToClusterTask", cloudName),
+		StepName:       "上架集群节点",
+		RollbackMethod: fmt.Sprintf("%s-RollbackAddNodesToClusterTask", cloudName),
+	}
+	checkClusterNodesStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckClusterNodesStatusTask", cloudName),
+		StepName:   "检测集群节点状态",
+	}
+
+	// remove nodes from cluster task
+	removeNodesFromClusterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-RemoveNodesFromClusterTask", cloudName),
+		StepName:   "下架集群节点",
+	}
+
+	// create nodeGroup task
+	createCloudNodeGroupStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CreateCloudNodeGroupTask", cloudName),
+		StepName:   "创建云节点池",
+	}
+	checkCloudNodeGroupStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckCloudNodeGroupStatusTask", cloudName),
+		StepName:   "检测云节点池状态",
+	}
+
+	// delete nodeGroup task
+	deleteCloudNodeGroupStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-DeleteCloudNodeGroupTask", cloudName),
+		StepName:   "删除云节点池",
+	}
+
+	// update desired nodes task
+	applyInstanceMachinesStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-ApplyInstanceMachinesTask", cloudName),
+		StepName:   "申请节点机器",
+	}
+
+	// clean nodes in nodeGroup task
+	removeNodeGroupInstancesStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-RemoveNodeGroupInstancesTask", cloudName),
+		StepName:   "删除节点池节点",
+	}
+)
+
+// CreateClusterTaskOption 创建集群构建step子任务
+type CreateClusterTaskOption struct {
+	Cluster *proto.Cluster
+	NodeIPs []string
+}
+
+// BuildCreateClusterStep 创建集群任务
+func (cn *CreateClusterTaskOption) BuildCreateClusterStep(task *proto.Task) {
+	createStep := cloudprovider.InitTaskStep(createClusterStep)
+	createStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	createStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	task.Steps[createClusterStep.StepMethod] = createStep
+	task.StepSequence = append(task.StepSequence, createClusterStep.StepMethod)
+}
+
+// BuildCheckClusterStatusStep 检测集群状态任务
+func (cn *CreateClusterTaskOption) BuildCheckClusterStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkClusterStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	task.Steps[checkClusterStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkClusterStatusStep.StepMethod)
+}
+
+// BuildAddNodesToClusterStep 集群上架节点任务
+func (cn *CreateClusterTaskOption) BuildAddNodesToClusterStep(task *proto.Task) {
+	if len(cn.NodeIPs) == 0 {
+		return
+	}
+	addNodes := &AddNodesToClusterTaskOption{Cluster: cn.Cluster, NodeIPs: cn.NodeIPs}
+	addNodes.BuildAddNodesToClusterStep(task)
+	addNodes.BuildCheckClusterNodesStatusStep(task)
+}
+
+// BuildRegisterClsKubeConfigStep 注册集群连接信息
+func (cn *CreateClusterTaskOption) BuildRegisterClsKubeConfigStep(task *proto.Task) {
+	registerStep := cloudprovider.InitTaskStep(registerClusterKubeConfigStep)
+	registerStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	registerStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+
+	task.Steps[registerClusterKubeConfigStep.StepMethod] = registerStep
+	task.StepSequence = append(task.StepSequence, registerClusterKubeConfigStep.StepMethod)
+}
+
+// BuildUpdateCreateClusterDBInfoStep 更新集群数据
+func (cn *CreateClusterTaskOption) BuildUpdateCreateClusterDBInfoStep(task *proto.Task) {
+	updateStep := cloudprovider.InitTaskStep(updateCreateClusterDBInfoStep)
+	updateStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Cluster.ClusterID
+	updateStep.Params[cloudprovider.CloudIDKey.String()] = cn.Cluster.Provider
+	updateStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(cn.NodeIPs, ",")
+
+	task.Steps[updateCreateClusterDBInfoStep.StepMethod] = updateStep
+	task.StepSequence = append(task.StepSequence, updateCreateClusterDBInfoStep.StepMethod)
+}
+
+// DeleteClusterTaskOption 删除集群
+type DeleteClusterTaskOption struct {
+	Cluster    *proto.Cluster
+	DeleteMode string
+}
+
+// BuildDeleteClusterStep 删除集群
+func (dc *DeleteClusterTaskOption) BuildDeleteClusterStep(task *proto.Task) {
+	deleteStep := cloudprovider.InitTaskStep(deleteClusterStep)
+	deleteStep.Params[cloudprovider.ClusterIDKey.String()] = dc.Cluster.ClusterID
+	deleteStep.Params[cloudprovider.CloudIDKey.String()] = dc.Cluster.Provider
+	deleteStep.Params[cloudprovider.DeleteModeKey.String()] = dc.DeleteMode
+
+	task.Steps[deleteClusterStep.StepMethod] = deleteStep
+	task.StepSequence = append(task.StepSequence, deleteClusterStep.StepMethod)
+}
+
+// BuildCleanClusterDBInfoStep 清理集群数据
+func (dc *DeleteClusterTaskOption) BuildCleanClusterDBInfoStep(task *proto.Task) {
+	updateStep := cloudprovider.InitTaskStep(cleanClusterDBInfoStep)
+	updateStep.Params[cloudprovider.ClusterIDKey.String()] = dc.Cluster.ClusterID
+	updateStep.Params[cloudprovider.CloudIDKey.String()] = dc.Cluster.Provider
+
+	task.Steps[cleanClusterDBInfoStep.StepMethod] = updateStep
+	task.StepSequence = append(task.StepSequence, cleanClusterDBInfoStep.StepMethod)
+}
+
+// AddNodesToClusterTaskOption 上架节点
+type AddNodesToClusterTaskOption struct {
+	Cluster     *proto.Cluster
+	NodeGroupID string
+	NodeIPs     []string
+}
+
+// BuildAddNodesToClusterStep 上架集群节点
+func (an *AddNodesToClusterTaskOption) BuildAddNodesToClusterStep(task *proto.Task) {
+	addStep := cloudprovider.InitTaskStep(addNodesToClusterStep)
+	addStep.Params[cloudprovider.ClusterIDKey.String()] = an.Cluster.ClusterID
+	addStep.Params[cloudprovider.CloudIDKey.String()] = an.Cluster.Provider
+	addStep.Params[cloudprovider.NodeGroupIDKey.String()] = an.NodeGroupID
+	addStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(an.NodeIPs, ",")
+
+	task.Steps[addNodesToClusterStep.StepMethod] = addStep
+	task.StepSequence = append(task.StepSequence, addNodesToClusterStep.StepMethod)
+}
+
+// BuildCheckClusterNodesStatusStep 检测集群节点状态
+func (an *AddNodesToClusterTaskOption) BuildCheckClusterNodesStatusStep(task *proto.Task) {
+	buildCheckClusterNodesStatusStep(task, an.Cluster.ClusterID, an.Cluster.Provider, an.NodeGroupID)
+}
+
+func buildCheckClusterNodesStatusStep(task *proto.Task, clusterID, cloudID, nodeGroupID string) {
+	checkStep := cloudprovider.InitTaskStep(checkClusterNodesStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = clusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = cloudID
+	checkStep.Params[cloudprovider.NodeGroupIDKey.String()] = nodeGroupID
+
+	task.Steps[checkClusterNodesStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkClusterNodesStatusStep.StepMethod)
+}
+
+// RemoveNodesFromClusterTaskOption 下架节点
+type RemoveNodesFromClusterTaskOption struct {
+	Cluster    *proto.Cluster
+	DeleteMode string
+	NodeIPs    []string
+	NodeIDs    []string
+}
+
+// BuildRemoveNodesFromClusterStep 下架集群节点
+func (rn *RemoveNodesFromClusterTaskOption) BuildRemoveNodesFromClusterStep(task *proto.Task) {
+	removeStep := cloudprovider.InitTaskStep(removeNodesFromClusterStep)
+	removeStep.Params[cloudprovider.ClusterIDKey.String()] = rn.Cluster.ClusterID
+	removeStep.Params[cloudprovider.CloudIDKey.String()] = rn.Cluster.Provider
+	removeStep.Params[cloudprovider.DeleteModeKey.String()] = rn.DeleteMode
+	removeStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(rn.NodeIPs, ",")
+	removeStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(rn.NodeIDs, ",")
+
+	task.Steps[removeNodesFromClusterStep.StepMethod] = removeStep
+	task.StepSequence = append(task.StepSequence, removeNodesFromClusterStep.StepMethod)
+}
+
+// CreateNodeGroupTaskOption 创建节点池
+type CreateNodeGroupTaskOption struct {
+	Group *proto.NodeGroup
+}
+
+// BuildCreateCloudNodeGroupStep 创建云节点池
+func (cn *CreateNodeGroupTaskOption) BuildCreateCloudNodeGroupStep(task *proto.Task) {
+	createStep := cloudprovider.InitTaskStep(createCloudNodeGroupStep)
+	createStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Group.ClusterID
+	createStep.Params[cloudprovider.NodeGroupIDKey.String()] = cn.Group.NodeGroupID
+	createStep.Params[cloudprovider.CloudIDKey.String()] = cn.Group.Provider
+
+	task.Steps[createCloudNodeGroupStep.StepMethod] = createStep
+	task.StepSequence = append(task.StepSequence, createCloudNodeGroupStep.StepMethod)
+}
+
+// BuildCheckCloudNodeGroupStatusStep 检测云节点池状态
+func (cn *CreateNodeGroupTaskOption) BuildCheckCloudNodeGroupStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkCloudNodeGroupStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Group.ClusterID
+	checkStep.Params[cloudprovider.NodeGroupIDKey.String()] = cn.Group.NodeGroupID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = cn.Group.Provider
+
+	task.Steps[checkCloudNodeGroupStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkCloudNodeGroupStatusStep.StepMethod)
+}
+
+// DeleteNodeGroupTaskOption 删除节点池
+type DeleteNodeGroupTaskOption struct {
+	Group *proto.NodeGroup
+}
+
+// BuildDeleteCloudNodeGroupStep 删除云节点池
+func (dn *DeleteNodeGroupTaskOption) BuildDeleteCloudNodeGroupStep(task *proto.Task) {
+	deleteStep := cloudprovider.InitTaskStep(deleteCloudNodeGroupStep)
+	deleteStep.Params[cloudprovider.ClusterIDKey.String()] = dn.Group.ClusterID
+	deleteStep.Params[cloudprovider.NodeGroupIDKey.String()] = dn.Group.NodeGroupID
+	deleteStep.Params[cloudprovider.CloudIDKey.String()] = dn.Group.Provider
+
+	task.Steps[deleteCloudNodeGroupStep.StepMethod] = deleteStep
+	task.StepSequence = append(task.StepSequence, deleteCloudNodeGroupStep.StepMethod)
+}
+
+// UpdateDesiredNodesTaskOption 扩容节点
+type UpdateDesiredNodesTaskOption struct {
+	Group    *proto.NodeGroup
+	Desired  uint32
+	Operator string
+}
+
+// BuildApplyInstanceMachinesStep 申请节点机器
+func (ud *UpdateDesiredNodesTaskOption) BuildApplyInstanceMachinesStep(task *proto.Task) {
+	applyStep := cloudprovider.InitTaskStep(applyInstanceMachinesStep)
+	applyStep.Params[cloudprovider.ClusterIDKey.String()] = ud.Group.ClusterID
+	applyStep.Params[cloudprovider.NodeGroupIDKey.String()] = ud.Group.NodeGroupID
+	applyStep.Params[cloudprovider.CloudIDKey.String()] = ud.Group.Provider
+	applyStep.Params[cloudprovider.ScalingNodesNumKey.String()] = strconv.Itoa(int(ud.Desired))
+	applyStep.Params[cloudprovider.OperatorKey.String()] = ud.Operator
+
+	task.Steps[applyInstanceMachinesStep.StepMethod] = applyStep
+	task.StepSequence = append(task.StepSequence, applyInstanceMachinesStep.StepMethod)
+}
+
+// BuildCheckClusterNodesStatusStep 检测集群节点状态
+func (ud *UpdateDesiredNodesTaskOption) BuildCheckClusterNodesStatusStep(task *proto.Task) {
+	buildCheckClusterNodesStatusStep(task, ud.Group.ClusterID, ud.Group.Provider, ud.Group.NodeGroupID)
+}
+
+// CleanNodeInGroupTaskOption 缩容节点
+type CleanNodeInGroupTaskOption struct {
+	Group   *proto.NodeGroup
+	NodeIPs []string
+	NodeIDs []string
+}
+
+// BuildRemoveNodeGroupInstancesStep 删除节点池节点
+func (cn *CleanNodeInGroupTaskOption) BuildRemoveNodeGroupInstancesStep(task *proto.Task) {
+	removeStep := cloudprovider.InitTaskStep(removeNodeGroupInstancesStep)
+	removeStep.Params[cloudprovider.ClusterIDKey.String()] = cn.Group.ClusterID
+	removeStep.Params[cloudprovider.NodeGroupIDKey.String()] = cn.Group.NodeGroupID
+	removeStep.Params[cloudprovider.CloudIDKey.String()] = cn.Group.Provider
+	removeStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(cn.NodeIPs, ",")
+	removeStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(cn.NodeIDs, ",")
+
+	task.Steps[removeNodeGroupInstancesStep.StepMethod] = removeStep
+	task.StepSequence = append(task.StepSequence, removeNodeGroupInstancesStep.StepMethod)
+}
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"fmt"
+	"sync"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+)
+
+var validateMgr sync.Once
+
+func init() {
+	validateMgr.Do(func() {
+		// init Cluster
+		cloudprovider.InitCloudValidateManager(cloudName, &CloudValidate{})
+	})
+}
+
+// CloudValidate mockCloud validate management implementation, mock cloud need no credential
+type CloudValidate struct {
+}
+
+// CreateClusterValidate create cluster validate
+func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateClusterValidate request is empty", cloudName)
+	}
+
+	if req.ClusterBasicSettings == nil || len(req.ClusterBasicSettings.Version) == 0 {
+		return fmt.Errorf("%s CreateClusterValidate lost kubernetes version in request", cloudName)
+	}
+
+	return nil
+}
+
+// ImportClusterValidate check importCluster operation
+func (c *CloudValidate) ImportClusterValidate(req *proto.ImportClusterReq, opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// AddNodesToClusterValidate xxx
+func (c *CloudValidate) AddNodesToClusterValidate(req *proto.AddNodesRequest, opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s AddNodesToClusterValidate request is empty", cloudName)
+	}
+
+	if len(req.Nodes) == 0 {
+		return fmt.Errorf("%s AddNodesToClusterValidate request lost nodes", cloudName)
+	}
+
+	return nil
+}
+
+// DeleteNodesFromClusterValidate xxx
+func (c *CloudValidate) DeleteNodesFromClusterValidate(req *proto.DeleteNodesRequest,
+	opt *cloudprovider.CommonOption) error {
+	return nil
+}
+
+// CreateCloudAccountValidate create cloud account validate
+func (c *CloudValidate) CreateCloudAccountValidate(account *proto.Account) error {
+	return nil
+}
+
+// ImportCloudAccountValidate create cloudAccount account validation
+func (c *CloudValidate) ImportCloudAccountValidate(account *proto.Account) error {
+	return nil
+}
+
+// GetCloudRegionZonesValidate xxx
+func (c *CloudValidate) GetCloudRegionZonesValidate(req *proto.GetCloudRegionZonesRequest,
+	account *proto.Account) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s GetCloudRegionZonesValidate request is empty", cloudName)
+	}
+
+	if len(req.Region) == 0 || len(req.CloudID) == 0 {
+		return fmt.Errorf("%s GetCloudRegionZonesValidate request lost valid region info", cloudName)
+	}
+
+	return nil
+}
+
+// ListCloudRegionClusterValidate xxx
+func (c *CloudValidate) ListCloudRegionClusterValidate(req *proto.ListCloudRegionClusterRequest,
+	account *proto.Account) error {
+	return nil
+}
+
+// ListCloudSubnetsValidate xxx
+func (c *CloudValidate) ListCloudSubnetsValidate(req *proto.ListCloudSubnetsRequest,
+	account *proto.Account) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s ListCloudSubnetsValidate request is empty", cloudName)
+	}
+
+	if len(req.VpcID) == 0 {
+		return fmt.Errorf("%s ListCloudSubnetsValidate request lost valid vpcID info", cloudName)
+	}
+
+	return nil
+}
+
+// ListCloudVpcsValidate xxx
+func (c *CloudValidate) ListCloudVpcsValidate(req *proto.ListCloudVpcsRequest,
+	account *proto.Account) error {
+	return nil
+}
+
+// ListSecurityGroupsValidate xxx
+func (c *CloudValidate) ListSecurityGroupsValidate(req *proto.ListCloudSecurityGroupsRequest,
+	account *proto.Account) error {
+	return nil
+}
+
+// ListKeyPairsValidate list key pairs validate
+func (c *CloudValidate) ListKeyPairsValidate(req *proto.ListKeyPairsRequest, account *proto.Account) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// ListInstanceTypeValidate xxx
+func (c *CloudValidate) ListInstanceTypeValidate(req *proto.ListCloudInstanceTypeRequest,
+	account *proto.Account) error {
+	return nil
+}
+
+// ListCloudOsImageValidate xxx
+func (c *CloudValidate) ListCloudOsImageValidate(req *proto.ListCloudOsImageRequest, account *proto.Account) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// CreateNodeGroupValidate xxx
+func (c *CloudValidate) CreateNodeGroupValidate(req *proto.CreateNodeGroupRequest,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || req == nil {
+		return fmt.Errorf("%s CreateNodeGroupValidate request is empty", cloudName)
+	}
+
+	if req.AutoScaling != nil && req.AutoScaling.MaxSize < req.AutoScaling.MinSize {
+		return fmt.Errorf("%s CreateNodeGroupValidate autoScaling maxSize less than minSize", cloudName)
+	}
+
+	return nil
+}
+
+// ListInstancesValidate xxx
+func (c *CloudValidate) ListInstancesValidate(req *proto.ListCloudInstancesRequest, account *proto.Account) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
+
+// UpgradeClusterValidate xxx
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	return cloudprovider.ErrCloudNotImplemented
+}
@@ -195,3 +195,84 @@ func sendNotifyMessage(cluster *proto.Cluster, group *proto.NodeGroup, task *pro
 
 	return nil
 }
+
+// SendClusterDriftNotify send cluster/nodeGroup config drift message by project notify templates
+func SendClusterDriftNotify(cls *proto.Cluster, group *proto.NodeGroup, drifts []*proto.ClusterDrift) error {
+	if cls == nil || len(drifts) == 0 {
+		return nil
+	}
+
+	templates, err := ListProjectNotifyTemplates(cls.ProjectID)
+	if err != nil {
+		return err
+	}
+	if len(templates) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(drifts))
+	state := common.DriftStatusReconciled
+	for _, drift := range drifts {
+		fields = append(fields, fmt.Sprintf("%s(%s => %s)", drift.Field, drift.StoreValue, drift.CloudValue))
+		switch drift.Status {
+		case common.DriftStatusReconcileFailed:
+			state = common.DriftStatusReconcileFailed
+		case common.DriftStatusDrifted:
+			if state != common.DriftStatusReconcileFailed {
+				state = common.DriftStatusDrifted
+			}
+		}
+	}
+	extra := business.ExtraParas{
+		Render:       true,
+		OperatorTime: utils.TransTimeFormat(time.Now().Format(time.RFC3339)),
+		Result:       state,
+		DriftFields:  strings.Join(fields, ", "),
+	}
+
+	dimensions := business.BuildClusterDimension(cls.ClusterID, cls.BusinessID, state)
+	if group != nil {
+		dimensions = business.BuildNodeGroupDimension(cls.ClusterID, cls.BusinessID, group.NodeGroupID, state)
+	}
+
+	for i := range templates {
+		nt := templates[i]
+		if !nt.GetEnable() || !nt.GetClusterDrift().GetEnable() {
+			continue
+		}
+
+		title := business.ClusterDrift.GetTitle()
+		content := business.ClusterDrift.GetContent()
+		if nt.GetClusterDrift().GetTitle() != "" {
+			title = nt.GetClusterDrift().GetTitle()
+		}
+		if nt.GetClusterDrift().GetContent() != "" {
+			content = nt.GetClusterDrift().GetContent()
+		}
+
+		content, err = business.GetNotifyTemplateContent(cls, group, extra, content)
+		if err != nil {
+			blog.Errorf("cluster[%s] drift getNotifyTemplateContent failed: %v", cls.ClusterID, err)
+			continue
+		}
+
+		err = server.SendMessageToServer(context.Background(), notify.NotifyType(nt.NotifyType),
+			notify.MessageServer{
+				Server:      nt.GetConfig().GetServer(),
+				DataId:      int64(nt.GetConfig().GetDataId()),
+				AccessToken: nt.GetConfig().GetAccessToken(),
+			}, notify.MessageBody{
+				Users:     nt.Receivers,
+				Content:   content,
+				EventName: title,
+				EventBody: content,
+				Dimension: dimensions,
+			})
+		if err != nil {
+			blog.Errorf("cluster[%s] drift sendMessageToServer failed: %v", cls.ClusterID, err)
+			continue
+		}
+	}
+
+	return nil
+}
@@ -84,6 +84,14 @@ const (
 	TaskStatusForceTerminate = "FORCETERMINATE"
 	// TaskStatusNotStarted force task terminate
 	TaskStatusNotStarted = "NOTSTARTED"
+	// TaskStatusCanceled task canceled by user
+	TaskStatusCanceled = "CANCELED"
+	// TaskStatusRollingBack task running rollback steps
+	TaskStatusRollingBack = "ROLLINGBACK"
+	// TaskStatusRollbackSuccess task or step rollback success
+	TaskStatusRollbackSuccess = "ROLLBACK_SUCCESS"
+	// TaskStatusRollbackFailure task or step rollback failed
+	TaskStatusRollbackFailure = "ROLLBACK_FAILURE"
 )
 
 // CommonOption for all option
@@ -240,6 +248,23 @@ type DeleteClusterOption struct {
 	LatsClusterStatus string
 }
 
+// UpgradeClusterOption upgrade cluster option
+type UpgradeClusterOption struct {
+	CommonOption
+	// Operator user
+	Operator string
+	// Version cluster upgrade target version
+	Version string
+	// Cloud is used for cloudprovider template
+	Cloud *proto.Cloud
+	// NodeGroups upgrade nodeGroups in order, empty means only upgrade master
+	NodeGroups []*proto.NodeGroup
+	// NodeGroupNodes nodeGroup nodes which need to be drained, key is nodeGroupID
+	NodeGroupNodes map[string][]*proto.Node
+	// DrainTimeout drain node timeout seconds
+	DrainTimeout uint32
+}
+
 // DeleteVirtualClusterOption delete virtual cluster option
 type DeleteVirtualClusterOption struct {
 	CommonOption
@@ -473,6 +498,19 @@ type UpdateDesiredNodeOption struct {
 	NodeSchedule bool
 }
 
+// RolloutNodeGroupOption rollout nodegroup nodes
+type RolloutNodeGroupOption struct {
+	Cluster *proto.Cluster
+	Cloud   *proto.Cloud
+	// MaxSurge scale up nodes num before clean old nodes in each batch
+	MaxSurge uint32
+	// MaxUnavailable clean old nodes num before new nodes ready in each batch
+	MaxUnavailable uint32
+	// DrainTimeout drain old nodes timeout seconds
+	DrainTimeout uint32
+	Operator     string
+}
+
 // SwitchNodeGroupAutoScalingOption switch nodegroup auto scaling
 type SwitchNodeGroupAutoScalingOption struct {
 	CommonOption
@@ -527,6 +565,8 @@ type GetMasterSuggestedMachinesOption struct {
 type StepInfo struct {
 	StepMethod string
 	StepName   string
+	// RollbackMethod compensating action for step, empty means step not support rollback
+	RollbackMethod string
 }
 
 // InstanceInfo for get instance type
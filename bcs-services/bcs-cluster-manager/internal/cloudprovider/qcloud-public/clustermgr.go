@@ -158,6 +158,12 @@ func (c *Cluster) DeleteVirtualCluster(cls *proto.Cluster,
 	return task, nil
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // ImportCluster import cluster according cloudprovider
 func (c *Cluster) ImportCluster(cls *proto.Cluster, opt *cloudprovider.ImportClusterOption) (*proto.Task, error) {
 	// call qcloud interface to create cluster
@@ -390,6 +396,7 @@ func updateClusterInfo(cloudID string, opt *cloudprovider.GetClusterOption) (*pr
 		opt.Cluster.ClusterBasicSettings.ClusterLevel = *cls.ClusterLevel
 		opt.Cluster.ClusterBasicSettings.IsAutoUpgradeClusterLevel = *cls.AutoUpgradeClusterLevel
 	}
+	updateClusterNetworkAndTags(opt.Cluster, cls)
 	if opt.Cluster.ExtraInfo == nil {
 		opt.Cluster.ExtraInfo = make(map[string]string)
 	}
@@ -412,6 +419,42 @@ func updateClusterInfo(cloudID string, opt *cloudprovider.GetClusterOption) (*pr
 	return opt.Cluster, nil
 }
 
+// updateClusterNetworkAndTags update cluster vpc/network settings & tags which cloud reported
+func updateClusterNetworkAndTags(cluster *proto.Cluster, cls *tke.Cluster) {
+	if network := cls.ClusterNetworkSettings; network != nil {
+		if cluster.NetworkSettings == nil {
+			cluster.NetworkSettings = &proto.NetworkSetting{}
+		}
+		if network.VpcId != nil {
+			cluster.VpcID = *network.VpcId
+		}
+		if network.ClusterCIDR != nil {
+			cluster.NetworkSettings.ClusterIPv4CIDR = *network.ClusterCIDR
+		}
+		if network.ServiceCIDR != nil {
+			cluster.NetworkSettings.ServiceIPv4CIDR = *network.ServiceCIDR
+		}
+		if network.MaxNodePodNum != nil {
+			cluster.NetworkSettings.MaxNodePodNum = uint32(*network.MaxNodePodNum)
+		}
+		if network.MaxClusterServiceNum != nil {
+			cluster.NetworkSettings.MaxServiceNum = uint32(*network.MaxClusterServiceNum)
+		}
+	}
+
+	tags := make(map[string]string)
+	for _, spec := range cls.TagSpecification {
+		for _, t := range spec.Tags {
+			if t.Key != nil && t.Value != nil {
+				tags[*t.Key] = *t.Value
+			}
+		}
+	}
+	if len(tags) > 0 && cluster.ClusterBasicSettings != nil {
+		cluster.ClusterBasicSettings.ClusterTags = tags
+	}
+}
+
 // ListCluster get cloud cluster list by region
 func (c *Cluster) ListCluster(opt *cloudprovider.ListClusterOption) ([]*proto.CloudClusterInfo, error) {
 	if opt == nil || opt.Account == nil || len(opt.Account.SecretID) == 0 ||
@@ -170,6 +170,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 	// step5: qcloud-public import cluster nodes
 	createClusterTask.BuildImportClusterNodesStep(task)
 	// step5: install cluster watch component
+	parallelStart := len(task.StepSequence)
 	common.BuildWatchComponentTaskStep(task, cls, "")
 	// step4: 若需要则设置节点注解
 	common.BuildNodeAnnotationsTaskStep(task, cls.ClusterID, nil, func() map[string]string {
@@ -197,6 +198,7 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 		}(),
 		AllowReviseCloudId: icommon.True,
 	}, cloudprovider.WithStepAllowSkip(true))
+	parallelSteps := append([]string{}, task.StepSequence[parallelStart:]...)
 
 	// step7: transfer host module
 	common.BuildTransferHostModuleStep(task, cls.BusinessID, cls.GetClusterBasicSettings().GetModule().
@@ -233,6 +235,8 @@ func (t *Task) BuildCreateClusterTask(cls *proto.Cluster, opt *cloudprovider.Cre
 
 	// step9: update DB info by cluster data
 	createClusterTask.BuildUpdateTaskStatusStep(task)
+	// watch component, node annotations and gse agent run concurrently
+	cloudprovider.SetParallelTaskSteps(task, parallelSteps...)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -301,6 +305,7 @@ func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
 	createTask.BuildCreateResourceQuotaStep(task)
 	createTask.BuildInstallVclusterStep(task)
 	createTask.BuildCheckAgentStatusStep(task)
+	parallelStart := len(task.StepSequence)
 	createTask.BuildInstallWatchStep(task)
 
 	// step6: 系统初始化 postAction bkops, platform run default steps
@@ -315,8 +320,11 @@ func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
 			return nil, fmt.Errorf("BuildCreateVirtualClusterTask BuildBkSopsStepAction failed: %v", err)
 		}
 	}
+	parallelSteps := append([]string{}, task.StepSequence[parallelStart:]...)
 
 	createTask.BuildUpdateTaskStatusStep(task)
+	// watch component and system init run concurrently after vcluster agent ready
+	cloudprovider.SetParallelTaskSteps(task, parallelSteps...)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -447,6 +455,12 @@ func (t *Task) BuildDeleteVirtualClusterTask(cls *proto.Cluster,
 	return task, nil
 }
 
+// BuildUpgradeClusterTask build upgrade cluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster,
+	opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	return nil, cloudprovider.ErrCloudNotImplemented
+}
+
 // BuildDeleteClusterTask build deleteCluster task
 func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.DeleteClusterOption) (*proto.Task, error) {
 	// delete cluster has three steps:
@@ -225,6 +225,117 @@ func (cli *TkeClient) DeleteTKECluster(clusterID string, deleteMode DeleteMode)
 	return nil
 }
 
+// UpdateTKEClusterVersion upgrade tke cluster master version
+func (cli *TkeClient) UpdateTKEClusterVersion(clusterID string, version string) error {
+	if cli == nil {
+		return cloudprovider.ErrServerIsNil
+	}
+
+	if len(clusterID) == 0 || len(version) == 0 {
+		return fmt.Errorf("UpdateTKEClusterVersion failed: clusterID or version is empty")
+	}
+
+	req := tke.NewUpdateClusterVersionRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+	req.DstVersion = common.StringPtr(version)
+
+	resp, err := cli.tke.UpdateClusterVersion(req)
+	if err != nil {
+		blog.Errorf("UpdateTKEClusterVersion client UpdateClusterVersion[%s] failed: %v", clusterID, err)
+		return err
+	}
+
+	// check response
+	response := resp.Response
+	if response == nil {
+		blog.Errorf("UpdateTKEClusterVersion client UpdateClusterVersion[%s] but lost response information", clusterID)
+		return cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client UpdateClusterVersion[%s:%s] response successful",
+		*response.RequestId, clusterID, version)
+
+	return nil
+}
+
+// UpgradeTKEClusterInstances upgrade cluster instances version, upgradeType: reset/hot/major
+func (cli *TkeClient) UpgradeTKEClusterInstances(clusterID string, upgradeType UpgradeType,
+	instanceIDs []string) error {
+	if cli == nil {
+		return cloudprovider.ErrServerIsNil
+	}
+
+	if len(clusterID) == 0 || len(instanceIDs) == 0 {
+		return fmt.Errorf("UpgradeTKEClusterInstances failed: clusterID or instanceIDs is empty")
+	}
+
+	req := tke.NewUpgradeClusterInstancesRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+	req.Operation = common.StringPtr(UpgradeOperationCreate)
+	req.UpgradeType = common.StringPtr(upgradeType.String())
+	req.InstanceIds = common.StringPtrs(instanceIDs)
+
+	resp, err := cli.tke.UpgradeClusterInstances(req)
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterInstances client UpgradeClusterInstances[%s] failed: %v", clusterID, err)
+		return err
+	}
+
+	// check response
+	response := resp.Response
+	if response == nil {
+		blog.Errorf("UpgradeTKEClusterInstances client UpgradeClusterInstances[%s] but lost response information",
+			clusterID)
+		return cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client UpgradeClusterInstances[%s] instances[%v] response successful",
+		*response.RequestId, clusterID, instanceIDs)
+
+	return nil
+}
+
+// GetTKEUpgradeInstanceProgress get cluster instances upgrade progress
+func (cli *TkeClient) GetTKEUpgradeInstanceProgress(clusterID string) (*UpgradeInstanceProgress, error) {
+	if cli == nil {
+		return nil, cloudprovider.ErrServerIsNil
+	}
+
+	if len(clusterID) == 0 {
+		return nil, fmt.Errorf("GetTKEUpgradeInstanceProgress failed: clusterID is empty")
+	}
+
+	req := tke.NewGetUpgradeInstanceProgressRequest()
+	req.ClusterId = common.StringPtr(clusterID)
+
+	resp, err := cli.tke.GetUpgradeInstanceProgress(req)
+	if err != nil {
+		blog.Errorf("GetTKEUpgradeInstanceProgress client GetUpgradeInstanceProgress[%s] failed: %v", clusterID, err)
+		return nil, err
+	}
+
+	// check response
+	response := resp.Response
+	if response == nil {
+		blog.Errorf("GetTKEUpgradeInstanceProgress client GetUpgradeInstanceProgress[%s] but lost response "+
+			"information", clusterID)
+		return nil, cloudprovider.ErrCloudLostResponse
+	}
+	blog.Infof("RequestId[%s] tke client GetUpgradeInstanceProgress[%s] response successful",
+		*response.RequestId, clusterID)
+
+	progress := &UpgradeInstanceProgress{}
+	if response.Total != nil {
+		progress.Total = *response.Total
+	}
+	if response.Done != nil {
+		progress.Done = *response.Done
+	}
+	if response.LifeState != nil {
+		progress.LifeState = *response.LifeState
+	}
+
+	return progress, nil
+}
+
 // TKE node relative interface
 
 // QueryTkeClusterAllInstances query all cluster instances
@@ -236,6 +236,44 @@ type Versions struct {
 	Version string
 }
 
+// UpgradeType cluster instances upgrade type
+type UpgradeType string
+
+var (
+	// ResetUpgrade 大版本重装升级
+	ResetUpgrade UpgradeType = "reset"
+	// HotUpgrade 小版本热升级
+	HotUpgrade UpgradeType = "hot"
+	// MajorUpgrade 大版本原地升级
+	MajorUpgrade UpgradeType = "major"
+)
+
+// String to string
+func (ut UpgradeType) String() string {
+	return string(ut)
+}
+
+const (
+	// UpgradeOperationCreate create instances upgrade task
+	UpgradeOperationCreate = "create"
+
+	// UpgradeLifeStateProcess instances upgrade task running
+	UpgradeLifeStateProcess = "process"
+	// UpgradeLifeStateDone instances upgrade task done
+	UpgradeLifeStateDone = "done"
+	// UpgradeLifeStateTimeout instances upgrade task timeout
+	UpgradeLifeStateTimeout = "timeout"
+	// UpgradeLifeStateAborted instances upgrade task aborted
+	UpgradeLifeStateAborted = "aborted"
+)
+
+// UpgradeInstanceProgress cluster instances upgrade progress
+type UpgradeInstanceProgress struct {
+	Total     int64
+	Done      int64
+	LifeState string
+}
+
 // Images cluster image
 type Images struct {
 	OsName  string
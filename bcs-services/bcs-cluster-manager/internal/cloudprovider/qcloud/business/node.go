@@ -574,6 +574,24 @@ func ModifyInstancesVpcAttribute(ctx context.Context, vpcId string, ids []string
 	return nil
 }
 
+// GroupInstancesByVpc group instances by current vpc, vpcId => instanceIds
+func GroupInstancesByVpc(ids []string, opt *cloudprovider.CommonOption) (map[string][]string, error) {
+	nodes, err := ListNodesByInstanceID(ids, &cloudprovider.ListNodesOption{
+		Common: opt,
+	})
+	if err != nil {
+		blog.Errorf("GroupInstancesByVpc[%+v] failed: %v", ids, err)
+		return nil, err
+	}
+
+	vpcNodes := make(map[string][]string)
+	for i := range nodes {
+		vpcNodes[nodes[i].VPC] = append(vpcNodes[nodes[i].VPC], nodes[i].NodeID)
+	}
+
+	return vpcNodes, nil
+}
+
 func sortInstancesByZone(ids []string, opt *cloudprovider.CommonOption) (map[string][]*proto.Node, error) {
 	nodes, err := ListNodesByInstanceID(ids, &cloudprovider.ListNodesOption{
 		Common: opt,
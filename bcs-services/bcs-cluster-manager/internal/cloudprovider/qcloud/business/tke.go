@@ -163,8 +163,8 @@ func DeleteClusterExternalNodes(
 // 普通节点下架操作
 
 // RemoveNodesFromCluster remove nodes from cluster
-func RemoveNodesFromCluster(
-	ctx context.Context, info *cloudprovider.CloudDependBasicInfo, nodeIDs []string, force bool) ([]string, error) {
+func RemoveNodesFromCluster(ctx context.Context, info *cloudprovider.CloudDependBasicInfo,
+	deleteMode string, nodeIDs []string, force bool) ([]string, error) {
 	taskID := cloudprovider.GetTaskIDFromContext(ctx)
 
 	// filter exist instanceIDs
@@ -177,7 +177,7 @@ func RemoveNodesFromCluster(
 		blog.Errorf("removeNodesFromCluster[%s] filterClusterNotExistInstance "+
 			"successful, existInClusterNodes is zero", taskID)
 		// once again delete nodes
-		_, err = DeleteClusterInstance(ctx, info, nodeIDs, force)
+		_, err = DeleteClusterInstance(ctx, info, deleteMode, nodeIDs, force)
 		if err != nil {
 			blog.Errorf("removeNodesFromCluster[%s] onceAgain failed: %v", taskID, err)
 		}
@@ -186,7 +186,7 @@ func RemoveNodesFromCluster(
 	}
 
 	// delete exist instanceIDs
-	success, err := DeleteClusterInstance(ctx, info, existInClusterNodes, force)
+	success, err := DeleteClusterInstance(ctx, info, deleteMode, existInClusterNodes, force)
 	if err != nil {
 		blog.Errorf("removeNodesFromCluster[%s] deleteClusterInstance failed: %v", taskID, err)
 		return nil, err
@@ -239,8 +239,8 @@ func FilterClusterInstanceFromNodesIDs(
 }
 
 // DeleteClusterInstance delete TKE cluster Instances
-func DeleteClusterInstance(
-	ctx context.Context, info *cloudprovider.CloudDependBasicInfo, deleteNodeIDs []string, force bool) ([]string, error) {
+func DeleteClusterInstance(ctx context.Context, info *cloudprovider.CloudDependBasicInfo,
+	deleteMode string, deleteNodeIDs []string, force bool) ([]string, error) {
 	taskID := cloudprovider.GetTaskIDFromContext(ctx)
 	tkeCli, err := api.NewTkeClient(info.CmOption)
 	if err != nil {
@@ -254,6 +254,7 @@ func DeleteClusterInstance(
 		result, errDelete := tkeCli.DeleteTkeClusterInstance(&api.DeleteInstancesRequest{
 			ClusterID:   info.Cluster.SystemID,
 			Instances:   deleteNodeIDs,
+			DeleteMode:  api.DeleteMode(deleteMode),
 			ForceDelete: force,
 		})
 		if errDelete != nil {
@@ -259,6 +259,37 @@ func (c *Cluster) DeleteCluster(cls *proto.Cluster, opt *cloudprovider.DeleteClu
 	return task, nil
 }
 
+// UpgradeCluster upgrade kubernetes cluster version according cloudprovider
+func (c *Cluster) UpgradeCluster(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (*proto.Task, error) {
+	if cls == nil {
+		return nil, fmt.Errorf("%s UpgradeCluster cluster is empty", cloudName)
+	}
+
+	if opt == nil || opt.Account == nil || len(opt.Account.SecretID) == 0 ||
+		len(opt.Account.SecretKey) == 0 || len(opt.Region) == 0 || opt.Cloud == nil {
+		return nil, fmt.Errorf("%s UpgradeCluster cluster lost option", cloudName)
+	}
+
+	mgr, err := cloudprovider.GetTaskManager(opt.Cloud.CloudProvider)
+	if err != nil {
+		blog.Errorf("get cloud %s TaskManager when UpgradeCluster %s failed, %s",
+			opt.Cloud.CloudID, cls.ClusterName, err.Error(),
+		)
+		return nil, err
+	}
+
+	// build upgrade cluster task
+	task, err := mgr.BuildUpgradeClusterTask(cls, opt)
+	if err != nil {
+		blog.Errorf("build UpgradeCluster task for cluster %s with cloudprovider %s failed, %s",
+			cls.ClusterName, cls.Provider, err.Error(),
+		)
+		return nil, err
+	}
+
+	return task, nil
+}
+
 // GetCluster get kubernetes cluster detail information according cloudprovider
 func (c *Cluster) GetCluster(cloudID string, opt *cloudprovider.GetClusterOption) (*proto.Cluster, error) {
 	if cloudID == "" || opt == nil || opt.Cluster == nil {
@@ -351,6 +382,7 @@ func updateClusterInfo(cloudID string, opt *cloudprovider.GetClusterOption) (*pr
 		opt.Cluster.ClusterBasicSettings.ClusterLevel = *cls.ClusterLevel
 		opt.Cluster.ClusterBasicSettings.IsAutoUpgradeClusterLevel = *cls.AutoUpgradeClusterLevel
 	}
+	updateClusterNetworkAndTags(opt.Cluster, cls)
 	if opt.Cluster.ExtraInfo == nil {
 		opt.Cluster.ExtraInfo = make(map[string]string)
 	}
@@ -373,6 +405,42 @@ func updateClusterInfo(cloudID string, opt *cloudprovider.GetClusterOption) (*pr
 	return opt.Cluster, nil
 }
 
+// updateClusterNetworkAndTags update cluster vpc/network settings & tags which cloud reported
+func updateClusterNetworkAndTags(cluster *proto.Cluster, cls *tke.Cluster) {
+	if network := cls.ClusterNetworkSettings; network != nil {
+		if cluster.NetworkSettings == nil {
+			cluster.NetworkSettings = &proto.NetworkSetting{}
+		}
+		if network.VpcId != nil {
+			cluster.VpcID = *network.VpcId
+		}
+		if network.ClusterCIDR != nil {
+			cluster.NetworkSettings.ClusterIPv4CIDR = *network.ClusterCIDR
+		}
+		if network.ServiceCIDR != nil {
+			cluster.NetworkSettings.ServiceIPv4CIDR = *network.ServiceCIDR
+		}
+		if network.MaxNodePodNum != nil {
+			cluster.NetworkSettings.MaxNodePodNum = uint32(*network.MaxNodePodNum)
+		}
+		if network.MaxClusterServiceNum != nil {
+			cluster.NetworkSettings.MaxServiceNum = uint32(*network.MaxClusterServiceNum)
+		}
+	}
+
+	tags := make(map[string]string)
+	for _, spec := range cls.TagSpecification {
+		for _, t := range spec.Tags {
+			if t.Key != nil && t.Value != nil {
+				tags[*t.Key] = *t.Value
+			}
+		}
+	}
+	if len(tags) > 0 && cluster.ClusterBasicSettings != nil {
+		cluster.ClusterBasicSettings.ClusterTags = tags
+	}
+}
+
 // ListCluster get cloud cluster list by region
 func (c *Cluster) ListCluster(opt *cloudprovider.ListClusterOption) ([]*proto.CloudClusterInfo, error) {
 	if opt == nil || opt.Account == nil || len(opt.Account.SecretID) == 0 ||
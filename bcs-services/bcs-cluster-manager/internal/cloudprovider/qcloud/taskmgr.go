@@ -63,11 +63,19 @@ func newtask() *Task {
 	task.works[deleteTKEClusterStep.StepMethod] = tasks.DeleteTKEClusterTask
 	task.works[cleanClusterDBInfoStep.StepMethod] = tasks.CleanClusterDBInfoTask
 
+	// upgrade cluster task
+	task.works[upgradeTKEClusterMasterStep.StepMethod] = tasks.UpgradeTKEClusterMasterTask
+	task.works[checkTKEClusterUpgradeStatusStep.StepMethod] = tasks.CheckTKEClusterUpgradeStatusTask
+	task.works[upgradeTKENodeGroupStep.StepMethod] = tasks.UpgradeTKENodeGroupTask
+
 	// add node to cluster
 	task.works[modifyInstancesVpcStep.StepMethod] = tasks.ModifyInstancesVpcTask
+	task.works[modifyInstancesVpcStep.RollbackMethod] = tasks.RollbackModifyInstancesVpcTask
 	task.works[checkInstanceStateStep.StepMethod] = tasks.CheckInstanceStateTask
 	task.works[addNodesShieldAlarmStep.StepMethod] = tasks.AddNodesShieldAlarmTask
+	task.works[addNodesShieldAlarmStep.RollbackMethod] = tasks.RollbackAddNodesShieldAlarmTask
 	task.works[addNodesToClusterStep.StepMethod] = tasks.AddNodesToClusterTask
+	task.works[addNodesToClusterStep.RollbackMethod] = tasks.RollbackAddNodesToClusterTask
 	task.works[checkAddNodesStatusStep.StepMethod] = tasks.CheckAddNodesStatusTask
 	task.works[updateAddNodeDBInfoStep.StepMethod] = tasks.UpdateNodeDBInfoTask
 
@@ -300,6 +308,7 @@ func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
 	createTask.BuildCreateResourceQuotaStep(task)
 	createTask.BuildInstallVclusterStep(task)
 	createTask.BuildCheckAgentStatusStep(task)
+	parallelStart := len(task.StepSequence)
 	createTask.BuildInstallWatchStep(task)
 
 	// step6: 系统初始化 postAction bkops, platform run default steps
@@ -314,8 +323,11 @@ func (t *Task) BuildCreateVirtualClusterTask(cls *proto.Cluster,
 			return nil, fmt.Errorf("BuildCreateVirtualClusterTask BuildBkSopsStepAction failed: %v", err)
 		}
 	}
+	parallelSteps := append([]string{}, task.StepSequence[parallelStart:]...)
 
 	createTask.BuildUpdateTaskStatusStep(task)
+	// watch component and system init run concurrently after vcluster agent ready
+	cloudprovider.SetParallelTaskSteps(task, parallelSteps...)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -370,12 +382,14 @@ func (t *Task) BuildImportClusterTask(cls *proto.Cluster, opt *cloudprovider.Imp
 	// step0: import cluster nodes step
 	importTask.BuildImportClusterNodesStep(task)
 
+	parallelStart := -1
 	if options.GetEditionInfo().IsCommunicationEdition() ||
 		options.GetEditionInfo().IsEnterpriseEdition() {
 		// setting all steps details
 		// step1: import cluster registerKubeConfigStep
 		importTask.BuildRegisterKubeConfigStep(task)
 		// step2: install cluster watch component
+		parallelStart = len(task.StepSequence)
 		common.BuildWatchComponentTaskStep(task, cls, "")
 	}
 
@@ -397,6 +411,10 @@ func (t *Task) BuildImportClusterTask(cls *proto.Cluster, opt *cloudprovider.Imp
 			return nil, fmt.Errorf("BuildCreateClusterTask BuildBkSopsStepAction failed: %v", err)
 		}
 	}
+	// watch component and system init run concurrently after kubeConfig registered
+	if parallelStart >= 0 {
+		cloudprovider.SetParallelTaskSteps(task, task.StepSequence[parallelStart:]...)
+	}
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -545,6 +563,74 @@ func (t *Task) BuildDeleteClusterTask(cls *proto.Cluster, opt *cloudprovider.Del
 	return task, nil
 }
 
+// BuildUpgradeClusterTask build upgradeCluster task
+func (t *Task) BuildUpgradeClusterTask(cls *proto.Cluster, opt *cloudprovider.UpgradeClusterOption) (
+	*proto.Task, error) {
+	// upgrade cluster has four steps:
+	// 1. call qcloud UpdateClusterVersion to upgrade tke cluster master
+	// 2. check cluster master upgrade status
+	// 3. drain/upgrade/unCordon nodeGroup nodes one by one
+	// 4. update cluster version in DB when upgrade successful
+
+	// validate request params
+	if cls == nil {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask cluster info empty")
+	}
+	if opt == nil || opt.Operator == "" || opt.Cloud == nil || opt.Version == "" {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask TaskOptions is lost")
+	}
+
+	// init task information
+	nowStr := time.Now().Format(time.RFC3339)
+	task := &proto.Task{
+		TaskID:         uuid.New().String(),
+		TaskType:       cloudprovider.GetTaskType(cloudName, cloudprovider.UpgradeCluster),
+		TaskName:       cloudprovider.UpgradeClusterTask.String(),
+		Status:         cloudprovider.TaskStatusInit,
+		Message:        "task initializing",
+		Start:          nowStr,
+		Steps:          make(map[string]*proto.Step),
+		StepSequence:   make([]string, 0),
+		ClusterID:      cls.ClusterID,
+		ProjectID:      cls.ProjectID,
+		Creator:        opt.Operator,
+		Updater:        opt.Operator,
+		LastUpdate:     nowStr,
+		CommonParams:   make(map[string]string),
+		ForceTerminate: false,
+	}
+	taskName := fmt.Sprintf(upgradeClusterTaskTemplate, cls.ClusterID)
+	task.CommonParams[cloudprovider.TaskNameKey.String()] = taskName
+	task.CommonParams[cloudprovider.UserKey.String()] = opt.Operator
+
+	// setting all steps details
+	upgradeClusterTask := &UpgradeClusterTaskOption{
+		Cluster:        cls,
+		Version:        opt.Version,
+		NodeGroups:     opt.NodeGroups,
+		NodeGroupNodes: opt.NodeGroupNodes,
+		DrainTimeout:   opt.DrainTimeout,
+	}
+	// step1: upgrade tke cluster master
+	upgradeClusterTask.BuildUpgradeMasterStep(task)
+	// step2: check cluster master upgrade status
+	upgradeClusterTask.BuildCheckUpgradeStatusStep(task)
+	// step3: drain and upgrade nodeGroups one by one
+	upgradeClusterTask.BuildUpgradeNodeGroupsStep(task)
+	// step4: update cluster version
+	upgradeClusterTask.BuildUpdateClusterVersionStep(task)
+
+	// set current step
+	if len(task.StepSequence) == 0 {
+		return nil, fmt.Errorf("BuildUpgradeClusterTask task StepSequence empty")
+	}
+	task.CurrentStep = task.StepSequence[0]
+	task.CommonParams[cloudprovider.JobTypeKey.String()] = cloudprovider.UpgradeClusterJob.String()
+	task.CommonParams[cloudprovider.OperatorKey.String()] = opt.Operator
+
+	return task, nil
+}
+
 func sortNodesInfo(cls *proto.Cluster, nodes []*proto.Node) ([]string, []string, []string) {
 	var (
 		nodeIPs, nodeIds, vpcDiffNodeIds = make([]string, 0), make([]string, 0), make([]string, 0)
@@ -635,26 +721,42 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	// step2: addNodes shield nodes alarm
 	addNodesTask.BuildShieldAlertStep(task)
 	// step3: addNodesToTKECluster add node to cluster
-	addNodesTask.BuildAddNodesToClusterStep(task)
 	// step4: check cluster add node status
-	addNodesTask.BuildCheckAddNodesStatusStep(task)
+	// 节点分布在多个可用区时各可用区并行上架节点
+	zoneBranches := addNodesTask.BuildZoneAddNodesSteps(task, nodes)
 	// step5: update DB node info by instanceIP
 	addNodesTask.BuildUpdateAddNodeDBInfoStep(task)
 
-	// step6:  postAction bk-sops task
+	// step6:  postAction bk-sops task, 多可用区时各可用区并行初始化节点
+	initBranches := make([][]string, 0)
 	if opt.Cloud != nil && opt.Cloud.ClusterManagement != nil && opt.Cloud.ClusterManagement.AddNodesToCluster != nil {
-		err := template.BuildSopsFactory{
-			StepName: template.SystemInit,
-			Cluster:  cls,
-			Extra: template.ExtraInfo{
-				InstancePasswd: passwd,
-				NodeIPList:     "",
-				NodeOperator:   opt.Operator,
-				ModuleID:       cloudprovider.GetScaleOutModuleID(cls, nil, opt.NodeTemplate, false),
-				BusinessID:     cloudprovider.GetBusinessID(cls, nil, opt.NodeTemplate, true),
-			}}.BuildSopsStep(task, opt.Cloud.ClusterManagement.AddNodesToCluster, false)
-		if err != nil {
-			return nil, fmt.Errorf("BuildAddNodesToClusterTask BuildBkSopsStepAction failed: %v", err)
+		zones := []string{""}
+		if len(zoneBranches) > 0 {
+			zones, _ = groupNodesByZone(nodes)
+		}
+		for _, zone := range zones {
+			branchStart := len(task.StepSequence)
+			err := template.BuildSopsFactory{
+				StepName: template.SystemInit,
+				Cluster:  cls,
+				Extra: template.ExtraInfo{
+					InstancePasswd: passwd,
+					NodeIPList:     "",
+					NodeOperator:   opt.Operator,
+					ModuleID:       cloudprovider.GetScaleOutModuleID(cls, nil, opt.NodeTemplate, false),
+					BusinessID:     cloudprovider.GetBusinessID(cls, nil, opt.NodeTemplate, true),
+				}}.BuildSopsStep(task, opt.Cloud.ClusterManagement.AddNodesToCluster, false)
+			if err != nil {
+				return nil, fmt.Errorf("BuildAddNodesToClusterTask BuildBkSopsStepAction failed: %v", err)
+			}
+			if zone == "" || branchStart == len(task.StepSequence) {
+				continue
+			}
+			branch := append([]string{}, task.StepSequence[branchStart:]...)
+			for _, name := range branch {
+				task.Steps[name].Params[cloudprovider.BranchKey.String()] = zone
+			}
+			initBranches = append(initBranches, branch)
 		}
 	}
 
@@ -705,12 +807,19 @@ func (t *Task) BuildAddNodesToClusterTask(cls *proto.Cluster, nodes []*proto.Nod
 	}
 
 	// step8: 若需要则设置节点注解
+	parallelStart := len(task.StepSequence)
 	addNodesTask.BuildNodeAnnotationsStep(task)
 	// step9: 设置平台公共标签
 	addNodesTask.BuildNodeLabelsStep(task)
+	parallelSteps := append([]string{}, task.StepSequence[parallelStart:]...)
 	// step10: 设置节点可调度状态
 	addNodesTask.BuildUnCordonNodesStep(task)
 
+	// 按步骤顺序设置并行分支: 可用区上架节点, 可用区初始化节点, 节点注解和标签并行设置
+	cloudprovider.SetParallelTaskBranches(task, zoneBranches...)
+	cloudprovider.SetParallelTaskBranches(task, initBranches...)
+	cloudprovider.SetParallelTaskSteps(task, parallelSteps...)
+
 	// set current step
 	if len(task.StepSequence) == 0 {
 		return nil, fmt.Errorf("BuildAddNodesToClusterTask task StepSequence empty")
@@ -786,7 +895,8 @@ func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*prot
 		NodeIDs:    nodeIDs,
 	}
 
-	// step0: cordon nodes
+	// step0: shield nodes alarm and cordon nodes
+	common.BuildShieldHostAlarmTaskStep(task, cls.ClusterID, nodeIPs)
 	removeNodesTask.BuildCordonNodesStep(task)
 
 	// 业务自定义缩容流程: 支持 缩容节点前置脚本和前置标准运维流程
@@ -840,6 +950,8 @@ func (t *Task) BuildRemoveNodesFromClusterTask(cls *proto.Cluster, nodes []*prot
 	removeNodesTask.BuildCheckClusterCleanNodsStep(task)
 	// step2: update node DB info
 	removeNodesTask.BuildUpdateRemoveNodeDBInfoStep(task)
+	// step3: clean nodes alarm shield
+	common.BuildUnShieldHostAlarmTaskStep(task, cls.ClusterID, nodeIPs)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -985,7 +1097,8 @@ func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.Node
 		Operator:  opt.Operator,
 	}
 
-	// step0: cordon nodes
+	// step0: shield nodes alarm and cordon nodes
+	common.BuildShieldHostAlarmTaskStep(task, opt.Cluster.ClusterID, nodeIPs)
 	cleanNodeGroupNodes.BuildCordonNodesStep(task)
 
 	// step1. business user define flow
@@ -1064,6 +1177,8 @@ func (t *Task) BuildCleanNodesInGroupTask(nodes []*proto.Node, group *proto.Node
 		// 归还第三方节点机器
 		cleanNodeGroupNodes.BuildReturnIDCNodeToResPoolStep(task)
 	}
+	// clean nodes alarm shield
+	common.BuildUnShieldHostAlarmTaskStep(task, opt.Cluster.ClusterID, nodeIPs)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -1321,6 +1436,7 @@ func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGrou
 	}
 
 	// set external node labels
+	parallelStart := len(task.StepSequence)
 	updateDesiredNodesTask.BuildNodeLabelsStep(task)
 
 	// step4: set node annotations
@@ -1329,9 +1445,12 @@ func (t *Task) BuildUpdateDesiredNodesTask(desired uint32, group *proto.NodeGrou
 
 	// step5: set resourcePool labels
 	common.BuildResourcePoolLabelTaskStep(task, opt.Cluster.ClusterID)
+	parallelSteps := append([]string{}, task.StepSequence[parallelStart:]...)
 
 	// step6. set node scheduler by nodeIPs
 	updateDesiredNodesTask.BuildUnCordonNodesStep(task)
+	// node labels/annotations/resourcePool labels set concurrently
+	cloudprovider.SetParallelTaskSteps(task, parallelSteps...)
 
 	// set current step
 	if len(task.StepSequence) == 0 {
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qcloud
+
+import (
+	"reflect"
+	"testing"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/common"
+)
+
+// checkShieldSteps nodes alarm shielded before cordon and unShielded at task end
+func checkShieldSteps(t *testing.T, task *proto.Task) {
+	seq := task.StepSequence
+	if len(seq) < 3 {
+		t.Fatalf("unexpected steps %v", seq)
+	}
+	if seq[0] != common.ShieldHostAlarmActionStep.StepMethod {
+		t.Fatalf("first step %s, want shield alarm", seq[0])
+	}
+	if seq[1] != common.CordonNodesActionStep.StepMethod {
+		t.Fatalf("second step %s, want cordon nodes", seq[1])
+	}
+	if seq[len(seq)-1] != common.UnShieldHostAlarmActionStep.StepMethod {
+		t.Fatalf("last step %s, want unShield alarm", seq[len(seq)-1])
+	}
+	if ips := task.Steps[seq[0]].Params[cloudprovider.NodeIPsKey.String()]; ips != "127.0.0.1,127.0.0.2" {
+		t.Fatalf("shield step nodeIPs %s", ips)
+	}
+}
+
+func TestBuildRemoveNodesShieldAlarm(t *testing.T) {
+	nodes := []*proto.Node{
+		{NodeID: "ins-1", InnerIP: "127.0.0.1"},
+		{NodeID: "ins-2", InnerIP: "127.0.0.2"},
+	}
+
+	task, err := newtask().BuildRemoveNodesFromClusterTask(&proto.Cluster{ClusterID: "BCS-K8S-00001"}, nodes,
+		&cloudprovider.DeleteNodesOption{
+			Cloud:    &proto.Cloud{CloudID: cloudName},
+			Operator: "admin",
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkShieldSteps(t, task)
+}
+
+func TestBuildCleanNodesInGroupShieldAlarm(t *testing.T) {
+	nodes := []*proto.Node{
+		{NodeID: "ins-1", InnerIP: "127.0.0.1"},
+		{NodeID: "ins-2", InnerIP: "127.0.0.2"},
+	}
+	group := &proto.NodeGroup{
+		NodeGroupID:    "BCS-ng-00001",
+		ClusterID:      "BCS-K8S-00001",
+		LaunchTemplate: &proto.LaunchConfiguration{},
+	}
+
+	task, err := newtask().BuildCleanNodesInGroupTask(nodes, group, &cloudprovider.CleanNodesOption{
+		Cluster:  &proto.Cluster{ClusterID: "BCS-K8S-00001"},
+		Cloud:    &proto.Cloud{CloudID: cloudName},
+		Operator: "admin",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkShieldSteps(t, task)
+}
+
+func TestBuildCreateVirtualClusterParallelSteps(t *testing.T) {
+	cls := &proto.Cluster{ClusterID: "BCS-K8S-00002", ProjectID: "project"}
+	task, err := newtask().BuildCreateVirtualClusterTask(cls, &cloudprovider.CreateVirtualClusterOption{
+		Cloud: &proto.Cloud{CloudID: cloudName, ClusterManagement: &proto.ClusterMgr{
+			CreateCluster: &proto.Action{
+				PostActions: []string{"init"},
+				Plugins: map[string]*proto.BKOpsPlugin{
+					"init": {System: "bksops", Params: map[string]string{"template_id": "1"}},
+				},
+			},
+		}},
+		HostCluster: &proto.Cluster{ClusterID: "BCS-K8S-00001"},
+		Namespace:   &proto.NamespaceInfo{Name: "vcluster", Quota: &proto.NamespaceQuota{}},
+		Operator:    "admin",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cloudprovider.ValidateTaskDAG(task); err != nil {
+		t.Fatal(err)
+	}
+
+	// watch component and system init both depend on agent status, update db depends on them
+	seq := task.StepSequence
+	watch, sops, update := seq[len(seq)-3], seq[len(seq)-2], seq[len(seq)-1]
+	depends := cloudprovider.GetTaskStepDepends(task, watch)
+	if len(depends) != 1 || !reflect.DeepEqual(depends, cloudprovider.GetTaskStepDepends(task, sops)) {
+		t.Fatalf("watch depends on %v, system init depends on %v", depends,
+			cloudprovider.GetTaskStepDepends(task, sops))
+	}
+	if got := cloudprovider.GetTaskStepDepends(task, update); !reflect.DeepEqual(got, []string{watch, sops}) {
+		t.Fatalf("update step depends on %v", got)
+	}
+}
@@ -14,6 +14,7 @@ package tasks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -68,6 +69,21 @@ func ModifyInstancesVpcTask(taskID string, stepName string) error {
 	// inject taskID
 	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
 
+	// record nodes origin vpc for rollback, keep the first record when retry task
+	if step.Params[cloudprovider.OriginVpcNodesKey.String()] == "" {
+		vpcNodes, errLocal := business.GroupInstancesByVpc(nodeIds, dependInfo.CmOption)
+		if errLocal != nil {
+			blog.Errorf("ModifyInstancesVpcTask[%s]: GroupInstancesByVpc for nodes[%v] failed, %s",
+				taskID, nodeIds, errLocal.Error())
+			retErr := fmt.Errorf("GroupInstancesByVpc err, %s", errLocal.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+		delete(vpcNodes, vpcId)
+		originVpc, _ := json.Marshal(vpcNodes)
+		step.Params[cloudprovider.OriginVpcNodesKey.String()] = string(originVpc)
+	}
+
 	err = business.ModifyInstancesVpcAttribute(ctx, vpcId, nodeIds, dependInfo.CmOption)
 	if err != nil {
 		blog.Errorf("ModifyInstancesVpcTask[%s]: ModifyInstancesVpcAttribute for nodes[%v] failed, %s",
@@ -85,6 +101,82 @@ func ModifyInstancesVpcTask(taskID string, stepName string) error {
 	return nil
 }
 
+// RollbackModifyInstancesVpcTask rollback modify nodes vpc, move nodes back to origin vpc
+func RollbackModifyInstancesVpcTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task rollback step
+	state, step, err := cloudprovider.GetTaskStateAndRollbackStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// step already rollback when retry rollback
+	if step == nil {
+		blog.Infof("RollbackModifyInstancesVpcTask[%s]: step[%s] already rollback and skip", taskID, stepName)
+		return nil
+	}
+
+	// extract valid info
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+
+	vpcNodes := make(map[string][]string)
+	if originVpc := step.Params[cloudprovider.OriginVpcNodesKey.String()]; originVpc != "" {
+		if err = json.Unmarshal([]byte(originVpc), &vpcNodes); err != nil {
+			blog.Errorf("RollbackModifyInstancesVpcTask[%s] unmarshal origin vpc[%s] failed: %v",
+				taskID, originVpc, err)
+			retErr := fmt.Errorf("unmarshal origin vpc err, %s", err.Error())
+			_ = state.UpdateRollbackFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	if len(vpcNodes) == 0 {
+		blog.Infof("RollbackModifyInstancesVpcTask[%s] cluster[%s] origin vpc nodes empty and skip",
+			taskID, clusterID)
+		if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+			blog.Errorf("RollbackModifyInstancesVpcTask[%s] task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("RollbackModifyInstancesVpcTask[%s] GetClusterDependBasicInfo task %s step %s failed, %s",
+			taskID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud credential err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	for vpcId, ids := range vpcNodes {
+		err = business.ModifyInstancesVpcAttribute(ctx, vpcId, ids, dependInfo.CmOption)
+		if err != nil {
+			blog.Errorf("RollbackModifyInstancesVpcTask[%s]: ModifyInstancesVpcAttribute[%s] for nodes[%v] "+
+				"failed, %s", taskID, vpcId, ids, err.Error())
+			retErr := fmt.Errorf("ModifyInstancesVpcAttribute err, %s", err.Error())
+			_ = state.UpdateRollbackFailure(start, stepName, retErr)
+			return retErr
+		}
+		blog.Infof("RollbackModifyInstancesVpcTask[%s] nodes[%v] back to vpc[%s]", taskID, ids, vpcId)
+	}
+
+	// update step
+	if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+		blog.Errorf("RollbackModifyInstancesVpcTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
 // CheckInstanceStateTask check instance operation state
 func CheckInstanceStateTask(taskID string, stepName string) error {
 	start := time.Now()
@@ -260,7 +352,7 @@ func AddNodesShieldAlarmTask(taskID string, stepName string) error {
 	}
 
 	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
-	err = cloudprovider.ShieldHostAlarm(ctx, cluster.BusinessID, ipList)
+	err = cloudprovider.ShieldHostAlarm(ctx, cluster, ipList)
 	if err != nil {
 		blog.Errorf("AddNodesShieldAlarmTask[%s] ShieldHostAlarmConfig failed: %v", taskID, err)
 	} else {
@@ -275,6 +367,55 @@ func AddNodesShieldAlarmTask(taskID string, stepName string) error {
 	return nil
 }
 
+// RollbackAddNodesShieldAlarmTask rollback shield nodes alarm, unshield nodes alarm
+func RollbackAddNodesShieldAlarmTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task rollback step
+	state, step, err := cloudprovider.GetTaskStateAndRollbackStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// step already rollback when retry rollback
+	if step == nil {
+		blog.Infof("RollbackAddNodesShieldAlarmTask[%s]: step[%s] already rollback and skip", taskID, stepName)
+		return nil
+	}
+
+	// extract valid info
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	ipList := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.GetCommonParams(),
+		cloudprovider.NodeIPsKey.String(), ",")
+
+	if len(ipList) > 0 {
+		cluster, errLocal := cloudprovider.GetStorageModel().GetCluster(context.Background(), clusterID)
+		if errLocal != nil {
+			blog.Errorf("RollbackAddNodesShieldAlarmTask[%s]: get cluster for %s failed", taskID, clusterID)
+			retErr := fmt.Errorf("get cluster information failed, %s", errLocal.Error())
+			_ = state.UpdateRollbackFailure(start, stepName, retErr)
+			return retErr
+		}
+
+		ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+		err = cloudprovider.UnShieldHostAlarm(ctx, cluster, ipList)
+		if err != nil {
+			blog.Errorf("RollbackAddNodesShieldAlarmTask[%s] UnShieldHostAlarm failed: %v", taskID, err)
+			retErr := fmt.Errorf("UnShieldHostAlarm err, %s", err.Error())
+			_ = state.UpdateRollbackFailure(start, stepName, retErr)
+			return retErr
+		}
+		blog.Infof("RollbackAddNodesShieldAlarmTask[%s] UnShieldHostAlarm[%v] success", taskID, ipList)
+	}
+
+	// update step
+	if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+		blog.Errorf("RollbackAddNodesShieldAlarmTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
 // AddNodesToClusterTask add node to cluster
 func AddNodesToClusterTask(taskID string, stepName string) error { // nolint
 	start := time.Now()
@@ -311,6 +452,12 @@ func AddNodesToClusterTask(taskID string, stepName string) error { // nolint
 		cloudprovider.NodeIPsKey.String(), ",")
 	idList := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.GetCommonParams(),
 		cloudprovider.NodeIDsKey.String(), ",")
+	// dag task branch step only add branch nodes
+	isBranch := step.Params[cloudprovider.BranchKey.String()] != ""
+	if isBranch {
+		ipList = cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIPsKey.String(), ",")
+		idList = cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIDsKey.String(), ",")
+	}
 	if len(idList) != len(ipList) {
 		blog.Errorf("AddNodesToClusterTask[%s] [inner fatal] task %s step %s NodeID %d is not equal to "+
 			"InnerIP %d, fatal", taskID, taskID, stepName, // nolint
@@ -382,8 +529,15 @@ func AddNodesToClusterTask(taskID string, stepName string) error { // nolint
 		state.Task.CommonParams = make(map[string]string)
 	}
 
-	state.Task.CommonParams[cloudprovider.SuccessNodeIDsKey.String()] = strings.Join(successNodes, ",")
-	state.Task.CommonParams[cloudprovider.FailedNodeIDsKey.String()] = strings.Join(failedNodes, ",")
+	state.Task.CommonParams[cloudprovider.GetBranchParamKey(step,
+		cloudprovider.SuccessNodeIDsKey.String())] = strings.Join(successNodes, ",")
+	state.Task.CommonParams[cloudprovider.GetBranchParamKey(step,
+		cloudprovider.FailedNodeIDsKey.String())] = strings.Join(failedNodes, ",")
+	// branch nodes merged into task nodes
+	if isBranch {
+		state.MergeCommonParams(cloudprovider.SuccessNodeIDsKey.String(), successNodes)
+		state.MergeCommonParams(cloudprovider.FailedNodeIDsKey.String(), failedNodes)
+	}
 	// set failed node status
 	if len(failedNodes) > 0 {
 		reason := "call tke addNode failed"
@@ -399,6 +553,85 @@ func AddNodesToClusterTask(taskID string, stepName string) error { // nolint
 	return nil
 }
 
+// RollbackAddNodesToClusterTask rollback add nodes to cluster, remove added nodes from cluster by
+// deleteMode(retain: return instances to user, terminate: destroy instances) and clean nodes data
+func RollbackAddNodesToClusterTask(taskID string, stepName string) error {
+	start := time.Now()
+
+	// get task and task rollback step
+	state, step, err := cloudprovider.GetTaskStateAndRollbackStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// step already rollback when retry rollback
+	if step == nil {
+		blog.Infof("RollbackAddNodesToClusterTask[%s]: step[%s] already rollback and skip", taskID, stepName)
+		return nil
+	}
+
+	// extract valid info
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	deleteMode := state.Task.GetCommonParams()[cloudprovider.DeleteModeKey.String()]
+	if deleteMode == "" {
+		deleteMode = cloudprovider.Retain.String()
+	}
+	// failed nodes may be partially installed in cluster, remove them together with success nodes
+	nodeIDs := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.GetCommonParams(),
+		cloudprovider.GetBranchParamKey(step, cloudprovider.SuccessNodeIDsKey.String()), ",")
+	nodeIDs = append(nodeIDs, cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.GetCommonParams(),
+		cloudprovider.GetBranchParamKey(step, cloudprovider.FailedNodeIDsKey.String()), ",")...)
+	if len(nodeIDs) == 0 {
+		blog.Infof("RollbackAddNodesToClusterTask[%s] cluster[%s] added nodes empty and skip", taskID, clusterID)
+		if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+			blog.Errorf("RollbackAddNodesToClusterTask[%s] task %s %s update to storage fatal",
+				taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] GetClusterDependBasicInfo task %s step %s failed, %s",
+			taskID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud credential err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// inject taskID
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+
+	deleteResult, err := business.RemoveNodesFromCluster(ctx, dependInfo, deleteMode, nodeIDs, false)
+	if err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] RemoveNodesFromCluster failed: %v", taskID, err)
+		retErr := fmt.Errorf("RemoveNodesFromCluster err, %s", err.Error())
+		_ = state.UpdateRollbackFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("RollbackAddNodesToClusterTask[%s] cluster[%s] deleteMode[%s] removed nodes[%v]",
+		taskID, clusterID, deleteMode, deleteResult)
+
+	for i := range nodeIDs {
+		err = cloudprovider.GetStorageModel().DeleteNode(context.Background(), nodeIDs[i])
+		if err != nil {
+			blog.Errorf("RollbackAddNodesToClusterTask[%s] DeleteNode[%s] failed: %v", taskID, nodeIDs[i], err)
+		}
+	}
+
+	// update step
+	if err = state.UpdateRollbackSucc(start, stepName); err != nil {
+		blog.Errorf("RollbackAddNodesToClusterTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
 // CheckAddNodesStatusTask check add node status
 func CheckAddNodesStatusTask(taskID string, stepName string) error {
 	start := time.Now()
@@ -421,7 +654,8 @@ func CheckAddNodesStatusTask(taskID string, stepName string) error {
 	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
 	// get previous step paras
 	successNodes := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.CommonParams,
-		cloudprovider.SuccessNodeIDsKey.String(), ",")
+		cloudprovider.GetBranchParamKey(step, cloudprovider.SuccessNodeIDsKey.String()), ",")
+	isBranch := step.Params[cloudprovider.BranchKey.String()] != ""
 
 	// handler logic
 	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
@@ -456,7 +690,11 @@ func CheckAddNodesStatusTask(taskID string, stepName string) error {
 	}
 	if len(addFailureNodes) > 0 {
 		insInfos, reason, _ := business.GetFailedNodesReason(ctx, dependInfo, addFailureNodes)
-		state.Task.CommonParams[cloudprovider.FailedClusterNodeIDsKey.String()] = strings.Join(addFailureNodes, ",")
+		if isBranch {
+			state.MergeCommonParams(cloudprovider.FailedClusterNodeIDsKey.String(), addFailureNodes)
+		} else {
+			state.Task.CommonParams[cloudprovider.FailedClusterNodeIDsKey.String()] = strings.Join(addFailureNodes, ",")
+		}
 		state.Task.CommonParams[cloudprovider.FailedClusterNodeReasonKey.String()] = reason
 
 		state.PartFailure = true
@@ -473,10 +711,18 @@ func CheckAddNodesStatusTask(taskID string, stepName string) error {
 	}
 
 	nodeIPs := cloudprovider.GetInstanceIPsByID(ctx, addSuccessNodes)
-	state.Task.CommonParams[cloudprovider.SuccessClusterNodeIDsKey.String()] = strings.Join(addSuccessNodes, ",")
-	state.Task.NodeIPList = nodeIPs
-	state.Task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
-	state.Task.CommonParams[cloudprovider.DynamicNodeIPListKey.String()] = strings.Join(nodeIPs, ",")
+	if isBranch {
+		// branch nodes merged into task nodes, branch steps use branch nodes
+		state.MergeCommonParams(cloudprovider.SuccessClusterNodeIDsKey.String(), addSuccessNodes)
+		state.MergeCommonParams(cloudprovider.DynamicNodeIPListKey.String(), nodeIPs)
+		state.Task.CommonParams[cloudprovider.GetBranchParamKey(step,
+			cloudprovider.DynamicNodeIPListKey.String())] = strings.Join(nodeIPs, ",")
+	} else {
+		state.Task.CommonParams[cloudprovider.SuccessClusterNodeIDsKey.String()] = strings.Join(addSuccessNodes, ",")
+		state.Task.NodeIPList = nodeIPs
+		state.Task.CommonParams[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+		state.Task.CommonParams[cloudprovider.DynamicNodeIPListKey.String()] = strings.Join(nodeIPs, ",")
+	}
 	blog.Infof("CheckAddNodesStatusTask[%s] successNodeIds[%v] successNodeIps[%v]",
 		taskID, addSuccessNodes, nodeIPs)
 
@@ -526,6 +772,15 @@ func UpdateNodeDBInfoTask(taskID string, stepName string) error { // nolint
 	}
 	blog.Infof("UpdateNodeDBInfoTask[%s] step %s successful", taskID, stepName)
 
+	// nodes added, clean nodes alarm shield
+	ipList := cloudprovider.ParseNodeIpOrIdFromCommonMap(state.Task.CommonParams,
+		cloudprovider.NodeIPsKey.String(), ",")
+	cluster, err := cloudprovider.GetStorageModel().GetCluster(context.Background(), state.Task.ClusterID)
+	if err == nil && len(ipList) > 0 {
+		ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+		cloudprovider.UnShieldHostAlarm(ctx, cluster, ipList) // nolint
+	}
+
 	// update step
 	if err = state.UpdateStepSucc(start, stepName); err != nil {
 		blog.Errorf("UpdateNodeDBInfoTask[%s] task %s %s update to storage fatal", taskID, taskID, stepName)
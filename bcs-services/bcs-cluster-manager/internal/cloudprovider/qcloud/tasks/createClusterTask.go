@@ -454,7 +454,7 @@ func CreateClusterShieldAlarmTask(taskID string, stepName string) error {
 	blog.Infof("CreateClusterShieldAlarmTask[%s] ShieldHostAlarmConfig: %+v", taskID, allIPs)
 
 	if len(allIPs) > 0 {
-		err = cloudprovider.ShieldHostAlarm(ctx, cluster.BusinessID, masterIPs)
+		err = cloudprovider.ShieldHostAlarm(ctx, cluster, masterIPs)
 		if err != nil {
 			blog.Errorf("CreateClusterShieldAlarmTask[%s] ShieldHostAlarmConfig failed: %v", taskID, err)
 		} else {
@@ -77,7 +77,7 @@ func DeleteTKEClusterTask(taskID string, stepName string) error {
 			}
 
 			// nolint
-			ids, err := business.DeleteClusterInstance(ctx, dependInfo, nodeIds, false)
+			ids, err := business.DeleteClusterInstance(ctx, dependInfo, cloudprovider.Retain.String(), nodeIds, false)
 			if err != nil {
 				blog.Errorf("DeleteTKEClusterTask[%s] DeleteClusterInstance failed: %v", taskID, err)
 			} else {
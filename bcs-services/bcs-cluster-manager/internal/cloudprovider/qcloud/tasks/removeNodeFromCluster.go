@@ -71,7 +71,7 @@ func RemoveNodesFromClusterTask(taskID string, stepName string) error {
 	// inject taskID
 	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
 
-	deleteResult, err := business.RemoveNodesFromCluster(ctx, dependInfo, idList, false)
+	deleteResult, err := business.RemoveNodesFromCluster(ctx, dependInfo, cloudprovider.Retain.String(), idList, false)
 	if err != nil {
 		blog.Errorf("RemoveNodesFromClusterTask[%s] RemoveNodesFromCluster failed: %v",
 			taskID, err)
@@ -0,0 +1,319 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/qcloud/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/loop"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+// UpgradeTKEClusterMasterTask upgrade tke cluster master version
+func UpgradeTKEClusterMasterTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeTKEClusterMasterTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeTKEClusterMasterTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	cli, err := api.NewTkeClient(dependInfo.CmOption)
+	if err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: get tke client failed: %v", taskID, err)
+		retErr := fmt.Errorf("get cloud tke client err, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// master version maybe upgraded when retry task
+	tkeCluster, err := cli.GetTKECluster(dependInfo.Cluster.SystemID)
+	if err == nil && tkeCluster != nil && tkeCluster.ClusterVersion != nil &&
+		utils.IsKubeVersionAtLeast(*tkeCluster.ClusterVersion, version) {
+		blog.Infof("UpgradeTKEClusterMasterTask[%s]: cluster[%s] version[%s] already upgraded",
+			taskID, clusterID, *tkeCluster.ClusterVersion)
+	} else {
+		err = cli.UpdateTKEClusterVersion(dependInfo.Cluster.SystemID, version)
+		if err != nil {
+			blog.Errorf("UpgradeTKEClusterMasterTask[%s]: UpdateTKEClusterVersion[%s] failed: %v",
+				taskID, clusterID, err)
+			retErr := fmt.Errorf("call qcloud UpdateClusterVersion failed: %s", err.Error())
+			_ = state.UpdateStepFailure(start, stepName, retErr)
+			return retErr
+		}
+	}
+	blog.Infof("UpgradeTKEClusterMasterTask[%s]: cluster[%s] upgrade master to version[%s] successful",
+		taskID, clusterID, version)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeTKEClusterMasterTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// CheckTKEClusterUpgradeStatusTask check tke cluster master upgrade status
+func CheckTKEClusterUpgradeStatusTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("CheckTKEClusterUpgradeStatusTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("CheckTKEClusterUpgradeStatusTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	version := step.Params[cloudprovider.ClusterVersionKey.String()]
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID: clusterID,
+		CloudID:   cloudID,
+	})
+	if err != nil {
+		blog.Errorf("CheckTKEClusterUpgradeStatusTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = checkClusterUpgradeStatus(ctx, dependInfo, version)
+	if err != nil {
+		blog.Errorf("CheckTKEClusterUpgradeStatusTask[%s] checkClusterUpgradeStatus[%s] failed: %v",
+			taskID, clusterID, err)
+		retErr := fmt.Errorf("checkClusterUpgradeStatus[%s] failed: %s", clusterID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	// update step
+	if err = state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("CheckTKEClusterUpgradeStatusTask[%s] task %s %s update to storage fatal",
+			taskID, taskID, stepName)
+		return err
+	}
+
+	return nil
+}
+
+// checkClusterUpgradeStatus wait cluster running and master version upgraded
+func checkClusterUpgradeStatus(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, version string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	cli, err := api.NewTkeClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] get tke client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get cloud tke client err, %s", err.Error())
+	}
+
+	var (
+		abnormal = false
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Minute)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		cluster, errGet := cli.GetTKECluster(info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("checkClusterUpgradeStatus[%s] GetTKECluster failed: %v", taskID, errGet)
+			return nil
+		}
+		if cluster == nil || cluster.ClusterStatus == nil || cluster.ClusterVersion == nil {
+			blog.Warnf("checkClusterUpgradeStatus[%s] cluster[%s] status or version empty", taskID,
+				info.Cluster.ClusterID)
+			return nil
+		}
+
+		blog.Infof("checkClusterUpgradeStatus[%s] cluster[%s] current status[%s] version[%s]", taskID,
+			info.Cluster.ClusterID, *cluster.ClusterStatus, *cluster.ClusterVersion)
+
+		switch *cluster.ClusterStatus {
+		case api.ClusterStatusRunning:
+			if utils.IsKubeVersionAtLeast(*cluster.ClusterVersion, version) {
+				return loop.EndLoop
+			}
+		case api.ClusterStatusAbnormal:
+			abnormal = true
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("checkClusterUpgradeStatus[%s] cluster[%s] failed: %v", taskID, info.Cluster.ClusterID, err)
+		return err
+	}
+
+	if abnormal {
+		return fmt.Errorf("cluster[%s] status abnormal", info.Cluster.ClusterID)
+	}
+
+	return nil
+}
+
+// UpgradeTKENodeGroupTask upgrade nodeGroup nodes version
+func UpgradeTKENodeGroupTask(taskID string, stepName string) error {
+	start := time.Now()
+	// get task and task current step
+	state, step, err := cloudprovider.GetTaskStateAndCurrentStep(taskID, stepName)
+	if err != nil {
+		return err
+	}
+	// previous step successful when retry task
+	if step == nil {
+		blog.Infof("UpgradeTKENodeGroupTask[%s]: current step[%s] successful and skip", taskID, stepName)
+		return nil
+	}
+	blog.Infof("UpgradeTKENodeGroupTask[%s]: task %s run step %s, system: %s, old state: %s, params %v",
+		taskID, taskID, stepName, step.System, step.Status, step.Params)
+
+	// step login started here
+	clusterID := step.Params[cloudprovider.ClusterIDKey.String()]
+	cloudID := step.Params[cloudprovider.CloudIDKey.String()]
+	nodeGroupID := step.Params[cloudprovider.NodeGroupIDKey.String()]
+	nodeIDs := cloudprovider.ParseNodeIpOrIdFromCommonMap(step.Params, cloudprovider.NodeIDsKey.String(), ",")
+
+	// nodeGroup without nodes not need to upgrade
+	if len(nodeIDs) == 0 {
+		blog.Infof("UpgradeTKENodeGroupTask[%s]: nodeGroup[%s] nodes empty and skip", taskID, nodeGroupID)
+		if err = state.UpdateStepSucc(start, stepName); err != nil {
+			blog.Errorf("UpgradeTKENodeGroupTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+			return err
+		}
+		return nil
+	}
+
+	dependInfo, err := cloudprovider.GetClusterDependBasicInfo(cloudprovider.GetBasicInfoReq{
+		ClusterID:   clusterID,
+		CloudID:     cloudID,
+		NodeGroupID: nodeGroupID,
+	})
+	if err != nil {
+		blog.Errorf("UpgradeTKENodeGroupTask[%s]: GetClusterDependBasicInfo for cluster %s in task %s "+
+			"step %s failed, %s", taskID, clusterID, taskID, stepName, err.Error())
+		retErr := fmt.Errorf("get cloud/project information failed, %s", err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+
+	ctx := cloudprovider.WithTaskIDForContext(context.Background(), taskID)
+	err = upgradeNodeGroupInstances(ctx, dependInfo, nodeIDs)
+	if err != nil {
+		blog.Errorf("UpgradeTKENodeGroupTask[%s]: upgradeNodeGroupInstances[%s] failed: %v",
+			taskID, nodeGroupID, err)
+		retErr := fmt.Errorf("upgrade nodeGroup[%s] instances failed: %s", nodeGroupID, err.Error())
+		_ = state.UpdateStepFailure(start, stepName, retErr)
+		return retErr
+	}
+	blog.Infof("UpgradeTKENodeGroupTask[%s]: nodeGroup[%s] instances[%v] upgrade successful",
+		taskID, nodeGroupID, nodeIDs)
+
+	if err := state.UpdateStepSucc(start, stepName); err != nil {
+		blog.Errorf("UpgradeTKENodeGroupTask[%s]: task %s %s update to storage fatal", taskID, taskID, stepName)
+		return err
+	}
+	return nil
+}
+
+// upgradeNodeGroupInstances upgrade instances in place and wait upgrade task done
+func upgradeNodeGroupInstances(ctx context.Context, info *cloudprovider.CloudDependBasicInfo, nodeIDs []string) error {
+	taskID := cloudprovider.GetTaskIDFromContext(ctx)
+
+	cli, err := api.NewTkeClient(info.CmOption)
+	if err != nil {
+		blog.Errorf("upgradeNodeGroupInstances[%s] get tke client failed: %s", taskID, err.Error())
+		return fmt.Errorf("get cloud tke client err, %s", err.Error())
+	}
+
+	err = cli.UpgradeTKEClusterInstances(info.Cluster.SystemID, api.MajorUpgrade, nodeIDs)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lifeState string
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Hour)
+	defer cancel()
+
+	err = loop.LoopDoFunc(ctx, func() error {
+		progress, errGet := cli.GetTKEUpgradeInstanceProgress(info.Cluster.SystemID)
+		if errGet != nil {
+			blog.Errorf("upgradeNodeGroupInstances[%s] GetTKEUpgradeInstanceProgress failed: %v", taskID, errGet)
+			return nil
+		}
+
+		blog.Infof("upgradeNodeGroupInstances[%s] nodeGroup[%s] lifeState[%s] progress[%d/%d]", taskID,
+			info.NodeGroup.GetNodeGroupID(), progress.LifeState, progress.Done, progress.Total)
+
+		switch progress.LifeState {
+		case api.UpgradeLifeStateDone, api.UpgradeLifeStateTimeout, api.UpgradeLifeStateAborted:
+			lifeState = progress.LifeState
+			return loop.EndLoop
+		}
+
+		return nil
+	}, loop.LoopInterval(30*time.Second))
+	if err != nil {
+		blog.Errorf("upgradeNodeGroupInstances[%s] nodeGroup[%s] failed: %v", taskID,
+			info.NodeGroup.GetNodeGroupID(), err)
+		return err
+	}
+
+	if lifeState != api.UpgradeLifeStateDone {
+		return fmt.Errorf("nodeGroup[%s] instances upgrade %s", info.NodeGroup.GetNodeGroupID(), lifeState)
+	}
+
+	return nil
+}
@@ -38,6 +38,8 @@ const (
 	createClusterTaskTemplate = "tke-create cluster: %s"
 	// deleteClusterTaskTemplate bk-sops add task template
 	deleteClusterTaskTemplate = "tke-delete cluster: %s"
+	// upgradeClusterTaskTemplate bk-sops add task template
+	upgradeClusterTaskTemplate = "tke-upgrade cluster: %s"
 	// createVirtualClusterTaskTemplate bk-sops add task template
 	createVirtualClusterTaskTemplate = "tke-create virtual cluster: %s"
 	// deleteVirtualClusterTaskTemplate bk-sops add task template
@@ -128,22 +130,39 @@ var (
 		StepName:   "清理集群数据",
 	}
 
+	// upgrade cluster task
+	upgradeTKEClusterMasterStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeTKEClusterMasterTask", cloudName),
+		StepName:   "升级集群master版本",
+	}
+	checkTKEClusterUpgradeStatusStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-CheckTKEClusterUpgradeStatusTask", cloudName),
+		StepName:   "检测集群升级状态",
+	}
+	upgradeTKENodeGroupStep = cloudprovider.StepInfo{
+		StepMethod: fmt.Sprintf("%s-UpgradeTKENodeGroupTask", cloudName),
+		StepName:   "升级节点池节点版本",
+	}
+
 	// add node to cluster
 	modifyInstancesVpcStep = cloudprovider.StepInfo{
-		StepMethod: fmt.Sprintf("%s-ModifyInstancesVpcTask", cloudName),
-		StepName:   "节点转vpc",
+		StepMethod:     fmt.Sprintf("%s-ModifyInstancesVpcTask", cloudName),
+		StepName:       "节点转vpc",
+		RollbackMethod: fmt.Sprintf("%s-RollbackModifyInstancesVpcTask", cloudName),
 	}
 	checkInstanceStateStep = cloudprovider.StepInfo{
 		StepMethod: fmt.Sprintf("%s-CheckInstanceStateTask", cloudName),
 		StepName:   "节点转vpc状态检测",
 	}
 	addNodesShieldAlarmStep = cloudprovider.StepInfo{
-		StepMethod: fmt.Sprintf("%s-AddNodesShieldAlarmTask", cloudName),
-		StepName:   "屏蔽机器告警",
+		StepMethod:     fmt.Sprintf("%s-AddNodesShieldAlarmTask", cloudName),
+		StepName:       "屏蔽机器告警",
+		RollbackMethod: fmt.Sprintf("%s-RollbackAddNodesShieldAlarmTask", cloudName),
 	}
 	addNodesToClusterStep = cloudprovider.StepInfo{
-		StepMethod: fmt.Sprintf("%s-AddNodesToClusterTask", cloudName),
-		StepName:   "集群上架节点",
+		StepMethod:     fmt.Sprintf("%s-AddNodesToClusterTask", cloudName),
+		StepName:       "集群上架节点",
+		RollbackMethod: fmt.Sprintf("%s-RollbackAddNodesToClusterTask", cloudName),
 	}
 	checkAddNodesStatusStep = cloudprovider.StepInfo{
 		StepMethod: fmt.Sprintf("%s-CheckAddNodesStatusTask", cloudName),
@@ -512,6 +531,67 @@ func (dc *DeleteClusterTaskOption) BuildCleanClusterDBInfoStep(task *proto.Task)
 	task.StepSequence = append(task.StepSequence, cleanClusterDBInfoStep.StepMethod)
 }
 
+// UpgradeClusterTaskOption 升级集群
+type UpgradeClusterTaskOption struct {
+	Cluster        *proto.Cluster
+	Version        string
+	NodeGroups     []*proto.NodeGroup
+	NodeGroupNodes map[string][]*proto.Node
+	DrainTimeout   uint32
+}
+
+// BuildUpgradeMasterStep 升级集群master版本
+func (uc *UpgradeClusterTaskOption) BuildUpgradeMasterStep(task *proto.Task) {
+	upgradeStep := cloudprovider.InitTaskStep(upgradeTKEClusterMasterStep)
+	upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	upgradeStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[upgradeTKEClusterMasterStep.StepMethod] = upgradeStep
+	task.StepSequence = append(task.StepSequence, upgradeTKEClusterMasterStep.StepMethod)
+}
+
+// BuildCheckUpgradeStatusStep 检测集群升级状态
+func (uc *UpgradeClusterTaskOption) BuildCheckUpgradeStatusStep(task *proto.Task) {
+	checkStep := cloudprovider.InitTaskStep(checkTKEClusterUpgradeStatusStep)
+	checkStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+	checkStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+	checkStep.Params[cloudprovider.ClusterVersionKey.String()] = uc.Version
+
+	task.Steps[checkTKEClusterUpgradeStatusStep.StepMethod] = checkStep
+	task.StepSequence = append(task.StepSequence, checkTKEClusterUpgradeStatusStep.StepMethod)
+}
+
+// BuildUpgradeNodeGroupsStep 节点池依次排水/升级/恢复调度
+func (uc *UpgradeClusterTaskOption) BuildUpgradeNodeGroupsStep(task *proto.Task) {
+	for _, group := range uc.NodeGroups {
+		nodeIPs, nodeIDs := make([]string, 0), make([]string, 0)
+		for _, node := range uc.NodeGroupNodes[group.NodeGroupID] {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+			nodeIDs = append(nodeIDs, node.NodeID)
+		}
+
+		common.BuildDrainNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs, uc.DrainTimeout)
+
+		upgradeStep := cloudprovider.InitTaskStep(upgradeTKENodeGroupStep)
+		stepName := common.GetNodeGroupStepName(upgradeTKENodeGroupStep.StepMethod, group.NodeGroupID)
+		upgradeStep.Name = stepName
+		upgradeStep.Params[cloudprovider.ClusterIDKey.String()] = uc.Cluster.ClusterID
+		upgradeStep.Params[cloudprovider.CloudIDKey.String()] = uc.Cluster.Provider
+		upgradeStep.Params[cloudprovider.NodeGroupIDKey.String()] = group.NodeGroupID
+		upgradeStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+		task.Steps[stepName] = upgradeStep
+		task.StepSequence = append(task.StepSequence, stepName)
+
+		common.BuildUnCordonGroupNodesTaskStep(task, uc.Cluster.ClusterID, group.NodeGroupID, nodeIPs)
+	}
+}
+
+// BuildUpdateClusterVersionStep 更新集群版本数据
+func (uc *UpgradeClusterTaskOption) BuildUpdateClusterVersionStep(task *proto.Task) {
+	common.BuildUpdateClusterVersionTaskStep(task, uc.Cluster.ClusterID, uc.Version)
+}
+
 // AddExternalNodesToClusterTaskOption 上架第三方节点
 type AddExternalNodesToClusterTaskOption struct {
 	Group   *proto.NodeGroup
@@ -648,6 +728,72 @@ func (ac *AddNodesToClusterTaskOption) BuildAddNodesToClusterStep(task *proto.Ta
 	task.StepSequence = append(task.StepSequence, addNodesToClusterStep.StepMethod)
 }
 
+// BuildZoneAddNodesSteps 节点分布在多个可用区时按可用区并行上架节点并检测节点状态, 返回各可用区分支步骤
+func (ac *AddNodesToClusterTaskOption) BuildZoneAddNodesSteps(task *proto.Task, nodes []*proto.Node) [][]string {
+	zones, zoneNodes := groupNodesByZone(nodes)
+	if len(zones) < 2 {
+		ac.BuildAddNodesToClusterStep(task)
+		ac.BuildCheckAddNodesStatusStep(task)
+		return nil
+	}
+
+	branches := make([][]string, 0, len(zones))
+	for _, zone := range zones {
+		nodeIPs, nodeIDs := make([]string, 0), make([]string, 0)
+		for _, node := range zoneNodes[zone] {
+			nodeIPs = append(nodeIPs, node.InnerIP)
+			nodeIDs = append(nodeIDs, node.NodeID)
+		}
+		ac.BuildAddNodesToClusterStep(task)
+		ac.BuildCheckAddNodesStatusStep(task)
+
+		branch := []string{
+			renameBranchStep(task, addNodesToClusterStep.StepMethod, zone),
+			renameBranchStep(task, checkAddNodesStatusStep.StepMethod, zone),
+		}
+		addStep := task.Steps[branch[0]]
+		addStep.Params[cloudprovider.NodeIPsKey.String()] = strings.Join(nodeIPs, ",")
+		addStep.Params[cloudprovider.NodeIDsKey.String()] = strings.Join(nodeIDs, ",")
+		branches = append(branches, branch)
+	}
+
+	return branches
+}
+
+// groupNodesByZone group nodes by zone, return zones by order
+func groupNodesByZone(nodes []*proto.Node) ([]string, map[string][]*proto.Node) {
+	zones := make([]string, 0)
+	zoneNodes := make(map[string][]*proto.Node)
+	for i := range nodes {
+		zone := nodes[i].GetZoneID()
+		if zone == "" {
+			zone = "default"
+		}
+		if _, ok := zoneNodes[zone]; !ok {
+			zones = append(zones, zone)
+		}
+		zoneNodes[zone] = append(zoneNodes[zone], nodes[i])
+	}
+	return zones, zoneNodes
+}
+
+// renameBranchStep rename built step as branch step, branch steps read and write branch params
+func renameBranchStep(task *proto.Task, stepName, branch string) string {
+	step := task.Steps[stepName]
+	delete(task.Steps, stepName)
+
+	name := fmt.Sprintf("%s-%s", stepName, branch)
+	step.Name = name
+	step.Params[cloudprovider.BranchKey.String()] = branch
+	task.Steps[name] = step
+	for i := range task.StepSequence {
+		if task.StepSequence[i] == stepName {
+			task.StepSequence[i] = name
+		}
+	}
+	return name
+}
+
 // BuildCheckAddNodesStatusStep 检测节点状态
 func (ac *AddNodesToClusterTaskOption) BuildCheckAddNodesStatusStep(task *proto.Task) {
 	checkStep := cloudprovider.InitTaskStep(checkAddNodesStatusStep)
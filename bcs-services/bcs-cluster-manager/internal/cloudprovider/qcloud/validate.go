@@ -114,6 +114,45 @@ func (c *CloudValidate) CreateClusterValidate(req *proto.CreateClusterReq, opt *
 	return nil
 }
 
+// UpgradeClusterValidate check upgradeCluster operation
+func (c *CloudValidate) UpgradeClusterValidate(cls *proto.Cluster, req *proto.UpgradeClusterReq,
+	opt *cloudprovider.CommonOption) error {
+	if c == nil || cls == nil || req == nil || opt == nil {
+		return fmt.Errorf("%s UpgradeClusterValidate request&options is empty", cloudName)
+	}
+
+	if opt.Account == nil || len(opt.Account.SecretID) == 0 || len(opt.Account.SecretKey) == 0 || len(opt.Region) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate opt lost valid crendential info", cloudName)
+	}
+
+	if len(cls.SystemID) == 0 {
+		return fmt.Errorf("%s UpgradeClusterValidate cluster[%s] systemID empty", cloudName, cls.ClusterID)
+	}
+
+	// check version skew
+	err := cloudprovider.CheckClusterUpgradeVersion(cls, req.Version)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate failed: %v", cloudName, err)
+	}
+
+	// check tke support version
+	cli, err := api.NewTkeClient(opt)
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate getTKEClient failed: %v", cloudName, err)
+	}
+	versions, err := cli.GetTKEClusterVersions()
+	if err != nil {
+		return fmt.Errorf("%s UpgradeClusterValidate GetTKEClusterVersions failed: %v", cloudName, err)
+	}
+	for i := range versions {
+		if versions[i].Version == req.Version {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s UpgradeClusterValidate version[%s] not supported", cloudName, req.Version)
+}
+
 // ImportClusterValidate check importCluster operation
 func (c *CloudValidate) ImportClusterValidate(req *proto.ImportClusterReq, opt *cloudprovider.CommonOption) error {
 	// call qcloud interface to check cluster
@@ -43,6 +43,8 @@ var (
 	DeleteClusterJob JobType = "delete-cluster"
 	// DeleteVirtualClusterJob for deleteVirtualCluster job
 	DeleteVirtualClusterJob JobType = "delete-virtual-cluster"
+	// UpgradeClusterJob for upgradeCluster job
+	UpgradeClusterJob JobType = "upgrade-cluster"
 	// AddNodeJob for addNodes job
 	AddNodeJob JobType = "add-node"
 	// DeleteNodeJob for deleteNodes job
@@ -126,6 +128,9 @@ func (sjr *SyncJobResult) UpdateJobResultStatus(isSuccess bool) error {
 	case ImportClusterJob:
 		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusImportClusterFailed)
 		return sjr.updateClusterResultStatus(isSuccess)
+	case UpgradeClusterJob:
+		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusUpgradeClusterFailed)
+		return sjr.updateClusterResultStatus(isSuccess)
 	// CA 扩容节点
 	case UpdateNodeGroupDesiredNodeJob:
 		sjr.Status = generateStatusResult(common.StatusRunning, common.StatusAddNodesFailed)
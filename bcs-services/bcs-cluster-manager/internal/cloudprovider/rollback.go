@@ -0,0 +1,246 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+// TaskRollbackDispatcher dispatch task rollback steps to worker
+type TaskRollbackDispatcher func(task *proto.Task) error
+
+var rollbackDispatcher TaskRollbackDispatcher
+
+// InitTaskRollbackDispatcher for task rollback dispatcher initialization
+func InitTaskRollbackDispatcher(dispatcher TaskRollbackDispatcher) {
+	lock.Lock()
+	defer lock.Unlock()
+	rollbackDispatcher = dispatcher
+}
+
+// IsTaskRollback check task need to rollback when task canceled
+func IsTaskRollback(task *proto.Task) bool {
+	if task == nil || task.CommonParams == nil {
+		return false
+	}
+	rollback, _ := strconv.ParseBool(task.CommonParams[TaskRollbackKey.String()])
+	return rollback
+}
+
+// GetTaskRollbackSteps get steps which need to rollback by reverse order, only executed
+// steps with compensating action need to rollback
+func GetTaskRollbackSteps(task *proto.Task) []string {
+	steps := make([]string, 0)
+	if task == nil {
+		return steps
+	}
+
+	for i := len(task.StepSequence) - 1; i >= 0; i-- {
+		step, ok := task.Steps[task.StepSequence[i]]
+		if !ok || step.RollbackMethod == "" {
+			continue
+		}
+		switch step.Status {
+		case TaskStatusSuccess, TaskStatusPartFailure, TaskStatusFailure, TaskStatusRollbackFailure:
+			steps = append(steps, task.StepSequence[i])
+		default:
+		}
+	}
+
+	return steps
+}
+
+// RollbackTask update task status to rollingBack and dispatch rollback steps
+func RollbackTask(task *proto.Task) error {
+	if rollbackDispatcher == nil {
+		return fmt.Errorf("task rollback dispatcher not init")
+	}
+
+	steps := GetTaskRollbackSteps(task)
+	if len(steps) == 0 {
+		blog.Infof("task[%s] has no steps need to rollback", task.TaskID)
+		return nil
+	}
+	if task.CommonParams == nil {
+		task.CommonParams = make(map[string]string)
+	}
+	task.CommonParams[RollbackStepsKey.String()] = strings.Join(steps, ",")
+	task.Status = TaskStatusRollingBack
+	task.Message = "task is rolling back"
+	task.LastUpdate = time.Now().Format(time.RFC3339)
+
+	if err := GetStorageModel().UpdateTask(context.Background(), task); err != nil {
+		blog.Errorf("task[%s] update rollingBack status failed: %v", task.TaskID, err)
+		return err
+	}
+	if err := rollbackDispatcher(task); err != nil {
+		blog.Errorf("task[%s] dispatch rollback steps %v failed: %v", task.TaskID, steps, err)
+		return err
+	}
+	blog.Infof("task[%s] dispatch rollback steps %v successful", task.TaskID, steps)
+
+	return nil
+}
+
+// GetTaskStateAndRollbackStep get task and task rollback step
+func GetTaskStateAndRollbackStep(taskID, stepName string) (*TaskState, *proto.Step, error) {
+	task, err := GetStorageModel().GetTask(context.Background(), taskID)
+	if err != nil {
+		blog.Errorf("task[%s] get detail task information from storage failed, %s", taskID, err.Error())
+		return nil, nil, err
+	}
+	if task.CommonParams == nil {
+		task.CommonParams = make(map[string]string)
+	}
+	if task.Status != TaskStatusRollingBack {
+		blog.Errorf("task[%s] status %s is not rolling back, step %s skip", taskID, task.Status, stepName)
+		return nil, nil, fmt.Errorf("task %s is not rolling back, state is %s", taskID, task.Status)
+	}
+
+	step, ok := task.Steps[stepName]
+	if !ok {
+		return nil, nil, fmt.Errorf("task %s lost step %s", taskID, stepName)
+	}
+	state := &TaskState{Task: task}
+	// step already rollback when retry rollback
+	if step.Status == TaskStatusRollbackSuccess {
+		blog.Infof("task[%s]: step[%s] already rollback and skip", taskID, stepName)
+		return state, nil, nil
+	}
+	blog.Infof("task[%s]: rollback step %s, old state: %s, params %v", taskID, stepName, step.Status, step.Params)
+
+	return state, step, nil
+}
+
+// UpdateRollbackSucc update step rollback success
+func (stat *TaskState) UpdateRollbackSucc(start time.Time, stepName string) error {
+	step := stat.Task.Steps[stepName]
+	end := time.Now()
+	step.Status = TaskStatusRollbackSuccess
+	step.Message = "rollback successfully"
+	step.LastUpdate = end.Format(time.RFC3339)
+
+	stat.Task.Message = fmt.Sprintf("step %s rollback successfully", step.Name)
+	stat.Task.LastUpdate = step.LastUpdate
+
+	steps := strings.Split(stat.Task.CommonParams[RollbackStepsKey.String()], ",")
+	if stepName == steps[len(steps)-1] {
+		// last rollback step, just make whole task rollback success
+		stat.Task.Status = TaskStatusRollbackSuccess
+		stat.Task.Message = "whole task rollback is done"
+	}
+
+	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+		blog.Errorf("task %s fatal, update step %s rollback success status failed, %s. required admin intervetion",
+			stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+	blog.Infof("task %s step %s rollback successfully, cost %ds", stat.Task.TaskID, stepName,
+		end.Unix()-start.Unix())
+	return nil
+}
+
+// UpdateRollbackFailure update step rollback failure
+func (stat *TaskState) UpdateRollbackFailure(start time.Time, stepName string, err error) error {
+	step := stat.Task.Steps[stepName]
+	end := time.Now()
+	step.Status = TaskStatusRollbackFailure
+	step.Message = fmt.Sprintf("rollback failed, %s", err.Error())
+	step.LastUpdate = end.Format(time.RFC3339)
+
+	stat.Task.Status = TaskStatusRollbackFailure
+	stat.Task.Message = fmt.Sprintf("step %s rollback failed, %s", step.Name, err.Error())
+	stat.Task.LastUpdate = step.LastUpdate
+
+	if err = GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+		blog.Errorf("task %s fatal, update step %s rollback failure status failed, %s. required admin intervetion",
+			stat.Task.TaskID, stepName, err.Error())
+		return err
+	}
+	blog.Infof("task %s step %s rollback failure, cost %ds", stat.Task.TaskID, stepName, end.Unix()-start.Unix())
+	return nil
+}
+
+// isTaskCanceled check task canceled by user when step running, sync canceled status to
+// current task state to avoid overwriting it
+func (stat *TaskState) isTaskCanceled() bool {
+	task, err := GetStorageModel().GetTask(context.Background(), stat.Task.TaskID)
+	if err != nil || task.Status != TaskStatusCanceled {
+		return false
+	}
+
+	stat.Task.Status = task.Status
+	stat.Task.Message = task.Message
+	stat.Task.Updater = task.Updater
+	if stat.Task.CommonParams == nil {
+		stat.Task.CommonParams = make(map[string]string)
+	}
+	// common params changed by current step keep local value, cancel params set by user
+	// (rollback and deleteMode) must be synced or rollback steps run with stale params
+	for k, v := range task.CommonParams {
+		if _, ok := stat.Task.CommonParams[k]; !ok {
+			stat.Task.CommonParams[k] = v
+		}
+	}
+	for _, key := range []ParamKey{TaskRollbackKey, DeleteModeKey} {
+		if v, ok := task.CommonParams[key.String()]; ok {
+			stat.Task.CommonParams[key.String()] = v
+		}
+	}
+
+	return true
+}
+
+// updateCanceledTask stop canceled task after current step done, then rollback task if need
+func (stat *TaskState) updateCanceledTask(stepName string) error {
+	end := time.Now()
+	taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
+	stat.Task.End = end.Format(time.RFC3339)
+	stat.Task.ExecutionTime = uint32(end.Unix() - taskStart.Unix())
+	stat.Task.LastUpdate = stat.Task.End
+
+	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
+		blog.Errorf("task %s fatal, update canceled task status failed, %s. required admin intervetion",
+			stat.Task.TaskID, err.Error())
+		return err
+	}
+	blog.Infof("task %s canceled and stop after step %s", stat.Task.TaskID, stepName)
+
+	// dag task other branch steps running, the last stopped step handle canceled task
+	if stat.hasRunningSteps(stepName) {
+		return nil
+	}
+
+	if stat.JobResult != nil {
+		err := stat.JobResult.UpdateJobResultStatus(false)
+		if err != nil {
+			blog.Errorf("task[%s] stepName[%s] UpdateJobResultStatus failed: %v", stat.Task.TaskID, stepName, err)
+		}
+	}
+
+	if IsTaskRollback(stat.Task) {
+		if err := RollbackTask(stat.Task); err != nil {
+			blog.Errorf("task[%s] rollback after step %s failed: %v", stat.Task.TaskID, stepName, err)
+		}
+	}
+
+	return nil
+}
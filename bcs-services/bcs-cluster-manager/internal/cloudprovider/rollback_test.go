@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudprovider
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	pb "github.com/golang/protobuf/proto"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+)
+
+type taskModel struct {
+	store.ClusterManagerModel
+	mu    sync.Mutex
+	tasks map[string]*proto.Task
+}
+
+func (m *taskModel) GetTask(ctx context.Context, taskID string) (*proto.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(task).(*proto.Task), nil
+}
+
+func (m *taskModel) UpdateTask(ctx context.Context, task *proto.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tasks[task.TaskID] = pb.Clone(task).(*proto.Task)
+	return nil
+}
+
+func TestCancelTaskRollback(t *testing.T) {
+	task := buildTestTask("addNodes", "checkNodes")
+	task.Steps["addNodes"].RollbackMethod = "rollbackAddNodes"
+	task.Steps["addNodes"].Status = TaskStatusRunning
+	task.Status = TaskStatusRunning
+	task.Start = time.Now().Format(time.RFC3339)
+	task.CommonParams = map[string]string{NodeIPsKey.String(): "127.0.0.1"}
+
+	// user cancel task with rollback & deleteMode when step addNodes running
+	canceled := pb.Clone(task).(*proto.Task)
+	canceled.Status = TaskStatusCanceled
+	canceled.CommonParams[TaskRollbackKey.String()] = "true"
+	canceled.CommonParams[DeleteModeKey.String()] = Terminate.String()
+	model := &taskModel{tasks: map[string]*proto.Task{task.TaskID: canceled}}
+	InitStorageModel(model)
+	defer InitStorageModel(nil)
+
+	var dispatched *proto.Task
+	InitTaskRollbackDispatcher(func(task *proto.Task) error {
+		dispatched = pb.Clone(task).(*proto.Task)
+		return nil
+	})
+	defer InitTaskRollbackDispatcher(nil)
+
+	// step addNodes done and record its result in common params
+	state := &TaskState{Task: task}
+	state.Task.CommonParams[SuccessNodeIDsKey.String()] = "ins-1"
+	if err := state.UpdateStepSucc(time.Now(), "addNodes"); err != nil {
+		t.Fatalf("UpdateStepSucc failed: %v", err)
+	}
+
+	if dispatched == nil {
+		t.Fatalf("canceled task not rollback")
+	}
+	if dispatched.Status != TaskStatusRollingBack {
+		t.Fatalf("task status %s", dispatched.Status)
+	}
+	if got := GetTaskRollbackSteps(dispatched); !reflect.DeepEqual(got, []string{"addNodes"}) {
+		t.Fatalf("rollback steps %v", got)
+	}
+	if mode := dispatched.CommonParams[DeleteModeKey.String()]; mode != Terminate.String() {
+		t.Fatalf("rollback deleteMode %q, want %s", mode, Terminate.String())
+	}
+	if ids := dispatched.CommonParams[SuccessNodeIDsKey.String()]; ids != "ins-1" {
+		t.Fatalf("step result lost, successNodeIDs %q", ids)
+	}
+
+	stored, _ := model.GetTask(context.Background(), task.TaskID)
+	if stored.CommonParams[DeleteModeKey.String()] != Terminate.String() {
+		t.Fatalf("stored deleteMode overwritten: %v", stored.CommonParams)
+	}
+}
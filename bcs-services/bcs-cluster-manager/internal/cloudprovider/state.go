@@ -32,11 +32,31 @@ func GetTaskStateAndCurrentStep(taskID, stepName string) (*TaskState, *proto.Ste
 			taskID, err.Error())
 		return nil, nil, err
 	}
+	// dag task steps run concurrently, lock task to avoid overwriting other steps state
+	if IsDAGTask(task) {
+		unlock, lockErr := LockTask(taskID)
+		if lockErr != nil {
+			return nil, nil, lockErr
+		}
+		defer unlock()
+		task, err = GetStorageModel().GetTask(context.Background(), taskID)
+		if err != nil {
+			blog.Errorf("task[%s] get detail task information from storage failed, %s. task retry",
+				taskID, err.Error())
+			return nil, nil, err
+		}
+	}
 	if task.CommonParams == nil {
 		task.CommonParams = make(map[string]string)
 	}
 
 	state := &TaskState{Task: task, JobResult: NewJobSyncResult(task)}
+	if IsDAGTask(task) {
+		state.commonParams = make(map[string]string, len(task.CommonParams))
+		for k, v := range task.CommonParams {
+			state.commonParams[k] = v
+		}
+	}
 	if state.IsTerminated() {
 		blog.Errorf("task[%s] is terminated, step %s skip", taskID, stepName)
 		return nil, nil, fmt.Errorf("task %s terminated", taskID)
@@ -96,13 +116,20 @@ type TaskState struct {
 	PartFailure bool
 	// Message error log
 	Message string
+
+	// commonParams task common params when step start, only sync changed params for dag task
+	commonParams map[string]string
+	// mergeParams task common params merged by concurrent branch steps
+	mergeParams map[string][]string
 }
 
 // IsTerminated check task already terminated
 func (stat *TaskState) IsTerminated() bool {
-	if stat.Task.Status == TaskStatusFailure || stat.Task.Status == TaskStatusForceTerminate ||
-		stat.Task.Status == TaskStatusTimeout || stat.Task.Status == TaskStatusSuccess {
+	switch stat.Task.Status {
+	case TaskStatusFailure, TaskStatusForceTerminate, TaskStatusTimeout, TaskStatusSuccess,
+		TaskStatusCanceled, TaskStatusRollingBack, TaskStatusRollbackSuccess, TaskStatusRollbackFailure:
 		return true
+	default:
 	}
 	return false
 }
@@ -113,6 +140,8 @@ func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 	case TaskStatusRunning, TaskStatusInit:
 	case TaskStatusForceTerminate:
 		return nil, fmt.Errorf("task %s state for terminate", stat.Task.TaskID)
+	case TaskStatusCanceled:
+		return nil, fmt.Errorf("task %s is canceled", stat.Task.TaskID)
 	default:
 		return nil, fmt.Errorf("task %s is not running, state is %s", stat.Task.TaskID, stat.Task.Status)
 	}
@@ -132,6 +161,14 @@ func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 		blog.Infof("task[%s] current step[%s] status[%s]", stat.Task.TaskID, stepName, curStep.Status)
 	}
 
+	// dag task step turn to run when all dependent steps done
+	if IsDAGTask(stat.Task) {
+		if !IsTaskStepDependDone(stat.Task, stepName) {
+			return nil, fmt.Errorf("task %s step %s dependent steps not done", stat.Task.TaskID, stepName)
+		}
+		stat.Task.CurrentStep = stepName
+	}
+
 	// check turn to step
 	if stepName != stat.Task.CurrentStep {
 		// check if pre steps are all ok, then we can set sthi step running
@@ -196,6 +233,17 @@ func (stat *TaskState) IsReadyToStep(stepName string) (*proto.Step, error) {
 
 // UpdateStepSucc update step to success
 func (stat *TaskState) UpdateStepSucc(start time.Time, stepName string) error {
+	isDAG := IsDAGTask(stat.Task)
+	if isDAG {
+		unlock, lockErr := LockTask(stat.Task.TaskID)
+		if lockErr != nil {
+			return lockErr
+		}
+		defer unlock()
+		if err := stat.syncDAGTask(stepName); err != nil {
+			return err
+		}
+	}
 	step := stat.Task.Steps[stepName]
 	end := time.Now()
 	step.ExecutionTime = uint32(end.Unix() - start.Unix())
@@ -215,11 +263,21 @@ func (stat *TaskState) UpdateStepSucc(start time.Time, stepName string) error {
 	if stat.TaskUrl != "" {
 		step.Params[BkSopsTaskUrlKey.String()] = stat.TaskUrl
 	}
+	// dag task failed by other branch steps, only update current step state
+	if isDAG && stat.isDAGTaskStopped() {
+		return stat.updateDAGStepOnly(stepName)
+	}
 	stat.Task.Status = TaskStatusRunning
 	stat.Task.Message = fmt.Sprintf("step %s running successfully", step.Name)
 	stat.Task.LastUpdate = step.End
 
-	if stepName == stat.Task.StepSequence[len(stat.Task.StepSequence)-1] {
+	// task canceled when step running, stop task after current step done
+	isLastStep := stat.isLastStep(stepName)
+	if !isLastStep && stat.isTaskCanceled() {
+		return stat.updateCanceledTask(stepName)
+	}
+
+	if isLastStep {
 		// last step in task, just make whole task success
 		taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
 		stat.Task.End = end.Format(time.RFC3339)
@@ -246,17 +304,38 @@ func (stat *TaskState) UpdateStepSucc(start time.Time, stepName string) error {
 		metrics.ReportMasterTaskMetric(stat.Task.TaskType, step.Status, metricName, stepStart)
 	}
 
+	// dag task dispatch steps which dependent steps all done
+	readySteps := make([]string, 0)
+	if isDAG && !isLastStep {
+		readySteps = stat.markDAGReadySteps()
+		stat.failBlockedDAGTask(end)
+	}
+
 	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
 		blog.Errorf("task %s fatal, update task success status failed, %s. required admin intervetion",
 			stat.Task.TaskID, err.Error())
 		return err
 	}
+	if err := stat.dispatchDAGSteps(readySteps); err != nil {
+		return err
+	}
 	blog.Infof("task %s step %s running successfully", stat.Task.TaskID, stepName)
 	return nil
 }
 
 // UpdateStepFailure update step failure
 func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err error) error {
+	isDAG := IsDAGTask(stat.Task)
+	if isDAG {
+		unlock, lockErr := LockTask(stat.Task.TaskID)
+		if lockErr != nil {
+			return lockErr
+		}
+		defer unlock()
+		if syncErr := stat.syncDAGTask(stepName); syncErr != nil {
+			return syncErr
+		}
+	}
 	step := stat.Task.Steps[stepName]
 	end := time.Now()
 	step.ExecutionTime = uint32(end.Unix() - start.Unix())
@@ -269,6 +348,17 @@ func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err e
 		step.Params[BkSopsTaskUrlKey.String()] = stat.TaskUrl
 	}
 
+	// task canceled when step running, keep canceled status
+	if stat.isTaskCanceled() {
+		return stat.updateCanceledTask(stepName)
+	}
+	// dag task failed by other branch steps, only update current step state
+	if isDAG && stat.isDAGTaskStopped() {
+		return stat.updateDAGStepOnly(stepName)
+	}
+	// failed branch step as current step, retry or skip it
+	stat.Task.CurrentStep = stepName
+
 	taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
 	stat.Task.End = end.Format(time.RFC3339)
 	stat.Task.ExecutionTime = uint32(end.Unix() - taskStart.Unix())
@@ -305,6 +395,17 @@ func (stat *TaskState) UpdateStepFailure(start time.Time, stepName string, err e
 
 // SkipFailure step if need to skip failure status
 func (stat *TaskState) SkipFailure(start time.Time, stepName string, err error) error {
+	isDAG := IsDAGTask(stat.Task)
+	if isDAG {
+		unlock, lockErr := LockTask(stat.Task.TaskID)
+		if lockErr != nil {
+			return lockErr
+		}
+		defer unlock()
+		if err := stat.syncDAGTask(stepName); err != nil {
+			return err
+		}
+	}
 	step := stat.Task.Steps[stepName]
 	end := time.Now()
 	step.ExecutionTime = uint32(end.Unix() - start.Unix())
@@ -317,11 +418,21 @@ func (stat *TaskState) SkipFailure(start time.Time, stepName string, err error)
 		step.Params[BkSopsTaskUrlKey.String()] = stat.TaskUrl
 	}
 
+	// dag task failed by other branch steps, only update current step state
+	if isDAG && stat.isDAGTaskStopped() {
+		return stat.updateDAGStepOnly(stepName)
+	}
 	stat.Task.Status = TaskStatusRunning
 	stat.Task.Message = fmt.Sprintf("step %s running failed", step.Name)
 	stat.Task.LastUpdate = step.End
 
-	if stepName == stat.Task.StepSequence[len(stat.Task.StepSequence)-1] {
+	// task canceled when step running, stop task after current step done
+	isLastStep := stat.isLastStep(stepName)
+	if !isLastStep && stat.isTaskCanceled() {
+		return stat.updateCanceledTask(stepName)
+	}
+
+	if isLastStep {
 		// last step in task, just make whole task success
 		taskStart, _ := time.Parse(time.RFC3339, stat.Task.Start)
 		stat.Task.End = end.Format(time.RFC3339)
@@ -348,11 +459,21 @@ func (stat *TaskState) SkipFailure(start time.Time, stepName string, err error)
 		metrics.ReportMasterTaskMetric(stat.Task.TaskType, step.Status, metricName, stepStart)
 	}
 
+	// dag task dispatch steps which dependent steps all done
+	readySteps := make([]string, 0)
+	if isDAG && !isLastStep {
+		readySteps = stat.markDAGReadySteps()
+		stat.failBlockedDAGTask(end)
+	}
+
 	if err := GetStorageModel().UpdateTask(context.Background(), stat.Task); err != nil {
 		blog.Errorf("task %s fatal, update task success status failed, %s. required admin intervetion",
 			stat.Task.TaskID, err.Error())
 		return err
 	}
+	if err := stat.dispatchDAGSteps(readySteps); err != nil {
+		return err
+	}
 	blog.Infof("task %s step %s running failed, but skip it", stat.Task.TaskID, stepName)
 	return nil
 }
@@ -35,8 +35,10 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/alertmanager"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/bkmonitor"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/tmp"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm/webhook"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/cmdb"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/nodeman"
 	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
@@ -51,6 +53,16 @@ const (
 	UnCordonNodesAction = "unCordonNodes"
 	// CordonNodesAction 节点不可调度任务
 	CordonNodesAction = "cordonNodes"
+	// ShieldHostAlarmAction 屏蔽节点告警任务
+	ShieldHostAlarmAction = "shieldHostAlarm"
+	// UnShieldHostAlarmAction 解除节点告警屏蔽任务
+	UnShieldHostAlarmAction = "unShieldHostAlarm"
+	// DrainNodesAction 节点排水任务
+	DrainNodesAction = "drainNodes"
+	// UpdateClusterVersionAction 更新集群版本数据
+	UpdateClusterVersionAction = "updateClusterVersion"
+	// RolloutNodeGroupBatchAction 节点池滚动更新批次任务
+	RolloutNodeGroupBatchAction = "rolloutNodeGroupBatch"
 	// WatchTask watch component common job
 	WatchTask = "watchjob"
 	// RemoveHostFromCmdbAction remove host action
@@ -756,17 +768,18 @@ func InitTaskStep(stepInfo StepInfo, opts ...StepOption) *proto.Step {
 
 	nowStr := time.Now().Format(time.RFC3339)
 	return &proto.Step{
-		Name:         stepInfo.StepMethod,
-		System:       "api",
-		Params:       make(map[string]string),
-		Retry:        0,
-		SkipOnFailed: defaultOptions.SkipFailed,
-		Start:        nowStr,
-		Status:       TaskStatusNotStarted,
-		TaskMethod:   stepInfo.StepMethod,
-		TaskName:     stepInfo.StepName,
-		Translate:    defaultOptions.Translate,
-		AllowSkip:    defaultOptions.AllowSkip,
+		Name:           stepInfo.StepMethod,
+		System:         "api",
+		Params:         make(map[string]string),
+		Retry:          0,
+		SkipOnFailed:   defaultOptions.SkipFailed,
+		Start:          nowStr,
+		Status:         TaskStatusNotStarted,
+		TaskMethod:     stepInfo.StepMethod,
+		TaskName:       stepInfo.StepName,
+		Translate:      defaultOptions.Translate,
+		AllowSkip:      defaultOptions.AllowSkip,
+		RollbackMethod: stepInfo.RollbackMethod,
 	}
 }
 
@@ -819,6 +832,20 @@ func ParseNodeIpOrIdFromCommonMap(taskCommonMap map[string]string, key string, c
 	return strings.Split(val, chart)
 }
 
+// CheckClusterUpgradeVersion check cluster upgrade version skew, nodes not upgraded keep current version
+func CheckClusterUpgradeVersion(cls *proto.Cluster, version string) error {
+	if cls == nil || cls.ClusterBasicSettings == nil {
+		return fmt.Errorf("cluster basic settings empty")
+	}
+
+	err := utils.CheckKubeVersionUpgrade(cls.ClusterBasicSettings.Version, version)
+	if err != nil {
+		return err
+	}
+
+	return utils.CheckKubeletVersionSkew(version, cls.ClusterBasicSettings.Version)
+}
+
 // ParseMapFromStepParas from step parse k1=v1;k2=v2; to map
 func ParseMapFromStepParas(stepMap map[string]string, key string) map[string]string {
 	val, ok := stepMap[key]
@@ -980,31 +1007,57 @@ func UpdateNodeGroupCloudAndModuleInfo(nodeGroupID string, cloudGroupID string,
 	return nil
 }
 
-// ShieldHostAlarm shield host alarm for user
-func ShieldHostAlarm(ctx context.Context, bizID string, ips []string) error {
-	taskID := GetTaskIDFromContext(ctx)
-	if len(ips) == 0 {
-		return fmt.Errorf("ShieldHostAlarm[%s] ips empty", taskID)
+// GetClusterAlarmClients get cluster alarm backends, cluster extraInfo overrides cloud platformInfo,
+// all backends are used when not configured
+func GetClusterAlarmClients(cluster *proto.Cluster) []alarm.AlarmInterface {
+	var clients = []alarm.AlarmInterface{tmp.GetBKAlarmClient(), bkmonitor.GetBkMonitorClient(),
+		alertmanager.GetAlertManagerClient(), webhook.GetWebhookClient()}
+
+	backends := cluster.GetExtraInfo()[common.AlarmBackends]
+	if backends == "" {
+		cloud, err := GetStorageModel().GetCloud(context.Background(), cluster.GetProvider())
+		if err == nil {
+			backends = cloud.GetPlatformInfo()[common.AlarmBackends]
+		}
+	}
+	if backends == "" {
+		return clients
 	}
 
+	names := strings.Split(backends, ",")
+	selected := make([]alarm.AlarmInterface, 0)
+	for i := range clients {
+		if utils.StringInSlice(clients[i].Name(), names) {
+			selected = append(selected, clients[i])
+		}
+	}
+
+	return selected
+}
+
+// getShieldHostInfo get shield user & hosts, fall back to plain ips when cmdb is unavailable
+func getShieldHostInfo(ctx context.Context, bizID string, ips []string) (string, []alarm.HostInfo) {
+	taskID := GetTaskIDFromContext(ctx)
+
+	user := common.ClusterManager
 	biz, _ := strconv.Atoi(bizID)
 	bizData, err := cmdb.GetCmdbClient().GetBusinessMaintainer(biz)
 	if err != nil {
 		blog.Errorf("ShieldHostAlarm[%s] GetBusinessMaintainer[%s] failed: %v", taskID, bizID, err)
-		return err
-	}
-	maintainers := strings.Split(bizData.BKBizMaintainer, ",")
-	if len(maintainers) == 0 {
-		return fmt.Errorf("ShieldHostAlarm[%s] BKBizMaintainer[%s] empty", taskID, bizID)
+	} else if maintainers := strings.Split(bizData.BKBizMaintainer, ","); maintainers[0] != "" {
+		user = maintainers[0]
 	}
 
+	hosts := make([]alarm.HostInfo, 0)
 	hostData, err := cmdb.GetCmdbClient().QueryAllHostInfoWithoutBiz(ips)
 	if err != nil {
 		blog.Errorf("ShieldHostAlarm[%s] QueryAllHostInfoWithoutBiz[%+v] failed: %v", taskID, ips, err)
-		return err
+		for i := range ips {
+			hosts = append(hosts, alarm.HostInfo{IP: ips[i]})
+		}
+		return user, hosts
 	}
 
-	hosts := make([]alarm.HostInfo, 0)
 	for i := range hostData {
 		hosts = append(hosts, alarm.HostInfo{
 			IP:      hostData[i].BKHostInnerIP,
@@ -1012,13 +1065,30 @@ func ShieldHostAlarm(ctx context.Context, bizID string, ips []string) error {
 		})
 	}
 
-	blog.Infof("ShieldHostAlarm[%s] bizID[%s] hostInfo[%+v]", taskID, bizID, hosts)
-	var alarms = []alarm.AlarmInterface{tmp.GetBKAlarmClient(), bkmonitor.GetBkMonitorClient()}
+	return user, hosts
+}
+
+// ShieldHostAlarm shield host alarm for user
+func ShieldHostAlarm(ctx context.Context, cluster *proto.Cluster, ips []string) error {
+	taskID := GetTaskIDFromContext(ctx)
+	if len(ips) == 0 {
+		return fmt.Errorf("ShieldHostAlarm[%s] ips empty", taskID)
+	}
+
+	user, hosts := getShieldHostInfo(ctx, cluster.GetBusinessID(), ips)
+	blog.Infof("ShieldHostAlarm[%s] bizID[%s] hostInfo[%+v]", taskID, cluster.GetBusinessID(), hosts)
+
+	alarms := GetClusterAlarmClients(cluster)
 	for i := range alarms {
-		err = alarms[i].ShieldHostAlarmConfig(maintainers[0], &alarm.ShieldHost{
-			BizID:    bizID,
-			HostList: hosts,
+		err := alarms[i].ShieldHostAlarmConfig(user, &alarm.ShieldHost{
+			BizID:     cluster.GetBusinessID(),
+			ClusterID: cluster.GetClusterID(),
+			HostList:  hosts,
 		})
+		if errors.Is(err, alarm.ErrServerNotInit) {
+			blog.V(4).Infof("ShieldHostAlarm[%s][%s] alarm backend not enabled", taskID, alarms[i].Name())
+			continue
+		}
 		if err != nil {
 			blog.Errorf("ShieldHostAlarm[%s][%s] ShieldHostAlarmConfig failed: %v", taskID, alarms[i].Name(), err)
 			continue
@@ -1030,6 +1100,40 @@ func ShieldHostAlarm(ctx context.Context, bizID string, ips []string) error {
 	return nil
 }
 
+// UnShieldHostAlarm clean host alarm shield when node operation finished
+func UnShieldHostAlarm(ctx context.Context, cluster *proto.Cluster, ips []string) error {
+	taskID := GetTaskIDFromContext(ctx)
+	if len(ips) == 0 {
+		return fmt.Errorf("UnShieldHostAlarm[%s] ips empty", taskID)
+	}
+
+	hosts := make([]alarm.HostInfo, 0)
+	for i := range ips {
+		hosts = append(hosts, alarm.HostInfo{IP: ips[i]})
+	}
+
+	alarms := GetClusterAlarmClients(cluster)
+	for i := range alarms {
+		err := alarms[i].UnShieldHostAlarmConfig(common.ClusterManager, &alarm.ShieldHost{
+			BizID:     cluster.GetBusinessID(),
+			ClusterID: cluster.GetClusterID(),
+			HostList:  hosts,
+		})
+		if errors.Is(err, alarm.ErrServerNotInit) {
+			blog.V(4).Infof("UnShieldHostAlarm[%s][%s] alarm backend not enabled", taskID, alarms[i].Name())
+			continue
+		}
+		if err != nil {
+			blog.Errorf("UnShieldHostAlarm[%s][%s] UnShieldHostAlarmConfig failed: %v", taskID, alarms[i].Name(), err)
+			continue
+		}
+
+		blog.Infof("UnShieldHostAlarm[%s][%s] UnShieldHostAlarmConfig success", taskID, alarms[i].Name())
+	}
+
+	return nil
+}
+
 // UpdateAutoScalingOptionModuleInfo update cluster ca moduleInfo
 func UpdateAutoScalingOptionModuleInfo(clusterID string) error {
 	cls, err := GetStorageModel().GetCluster(context.Background(), clusterID)
@@ -321,6 +321,8 @@ const (
 	ClusterResourceGroup = "clusterResourceGroup"
 	// NodeResourceGroup xxx
 	NodeResourceGroup = "nodeResourceGroup"
+	// AlarmBackends cluster extraInfo/cloud platformInfo key, comma separated alarm backend names
+	AlarmBackends = "alarmBackends"
 
 	// CloudProjectId cloud project id
 	CloudProjectId = "cloudProjectId"
@@ -343,6 +345,10 @@ const (
 	StatusDeleted = "DELETED"
 	// StatusDeleteClusterFailed status delete failed
 	StatusDeleteClusterFailed = "DELETE-FAILURE"
+	// StatusUpgrading status upgrading cluster version
+	StatusUpgrading = "UPGRADING"
+	// StatusUpgradeClusterFailed status upgrade cluster failed
+	StatusUpgradeClusterFailed = "UPGRADE-FAILURE"
 	// StatusAddNodesFailed status add nodes failed
 	StatusAddNodesFailed = "ADD-FAILURE"
 	// StatusRemoveNodesFailed status remove nodes failed
@@ -398,6 +404,32 @@ const (
 	TaskStatusTimeout = "TIMEOUT"
 )
 
+const (
+	// DriftStatusDrifted store config is different from cloud
+	DriftStatusDrifted = "DRIFTED"
+	// DriftStatusReconciled drift has been reconciled
+	DriftStatusReconciled = "RECONCILED"
+	// DriftStatusReconcileFailed reconcile drift failed
+	DriftStatusReconcileFailed = "RECONCILE-FAILURE"
+
+	// DriftReconcileStoreToCloud reconcile drift by applying store config to cloud
+	DriftReconcileStoreToCloud = "storeToCloud"
+	// DriftReconcileCloudToStore reconcile drift by syncing cloud config to store
+	DriftReconcileCloudToStore = "cloudToStore"
+)
+
+const (
+	// ScalingScheduleOperator operator of node group scaling schedule
+	ScalingScheduleOperator = "bcs-scaling-schedule"
+
+	// ScalingScheduleResultSuccess scaling schedule run successfully
+	ScalingScheduleResultSuccess = "SUCCESS"
+	// ScalingScheduleResultFailed scaling schedule run failed
+	ScalingScheduleResultFailed = "FAILURE"
+	// ScalingScheduleResultSkipped scaling schedule skipped because node group already in desired size
+	ScalingScheduleResultSkipped = "SKIPPED"
+)
+
 const (
 	// BcsErrClusterManagerSuccess success code
 	BcsErrClusterManagerSuccess = 0
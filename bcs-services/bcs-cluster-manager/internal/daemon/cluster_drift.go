@@ -0,0 +1,715 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	v1 "k8s.io/api/core/v1"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/clusterops"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/metrics"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clusterdrift"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	defaultDriftInterval = 600
+	clusterDriftLockKey  = "/bcs-services/bcs-cluster-manager/ClusterDrift/%s"
+
+	driftFieldVersion        = "clusterBasicSettings.version"
+	driftFieldVpcID          = "vpcID"
+	driftFieldClusterCIDR    = "networkSettings.clusterIPv4CIDR"
+	driftFieldServiceCIDR    = "networkSettings.serviceIPv4CIDR"
+	driftFieldMaxNodePodNum  = "networkSettings.maxNodePodNum"
+	driftFieldMaxServiceNum  = "networkSettings.maxServiceNum"
+	driftFieldClusterTags    = "clusterBasicSettings.clusterTags"
+	driftFieldDesiredSize    = "autoScaling.desiredSize"
+	driftFieldInstanceType   = "launchTemplate.instanceType"
+	driftFieldNodeLabels     = "nodeTemplate.labels"
+	driftFieldNodeTaints     = "nodeTemplate.taints"
+	driftReconcileNotSupport = "reconcile %s not supported for field %s"
+)
+
+// driftInterval cluster drift detect interval seconds
+func driftInterval() int {
+	opt := options.GetGlobalCMOptions()
+	if opt == nil || opt.Drift.Interval <= 0 {
+		return defaultDriftInterval
+	}
+
+	return opt.Drift.Interval
+}
+
+// driftContext cloud dependent info for detecting cluster drift
+type driftContext struct {
+	conf     options.DriftConfig
+	cluster  *cmproto.Cluster
+	cloud    *cmproto.Cloud
+	cmOption *cloudprovider.CommonOption
+}
+
+func (d *Daemon) reportClusterDrift(error chan<- error) {
+	opt := options.GetGlobalCMOptions()
+	if opt == nil || !opt.Drift.Enable {
+		return
+	}
+
+	condCluster := operator.NewLeafCondition(operator.Eq, operator.M{
+		"status": common.StatusRunning,
+	})
+	clusterList, err := d.model.ListCluster(d.ctx, condCluster, &storeopt.ListOption{All: true})
+	if err != nil {
+		blog.Errorf("reportClusterDrift ListCluster failed: %v", err)
+		error <- err
+		return
+	}
+
+	concurency := utils.NewRoutinePool(5)
+	defer concurency.Close()
+
+	for i := range clusterList {
+		concurency.Add(1)
+		go func(cls cmproto.Cluster) {
+			defer concurency.Done()
+
+			if cls.ClusterType == common.ClusterTypeVirtual || cls.SystemID == "" {
+				return
+			}
+			if errLocal := d.detectClusterDrift(&cls, opt.Drift); errLocal != nil {
+				blog.Errorf("reportClusterDrift cluster[%s] failed: %v", cls.ClusterID, errLocal)
+				error <- errLocal
+			}
+		}(clusterList[i])
+	}
+
+	concurency.Wait()
+}
+
+// detectClusterDrift compare cluster & nodeGroups config between store and cloud
+func (d *Daemon) detectClusterDrift(cls *cmproto.Cluster, conf options.DriftConfig) error {
+	// avoid multiple instances detect & reconcile cluster drift concurrently
+	lockKey := fmt.Sprintf(clusterDriftLockKey, cls.ClusterID)
+	if err := d.locker.Lock(lockKey, []lock.LockOption{lock.LockTTL(time.Second * 60)}...); err != nil {
+		return fmt.Errorf("lock cluster %s drift failed: %v", cls.ClusterID, err)
+	}
+	defer d.locker.Unlock(lockKey) // nolint
+
+	cloud, err := d.model.GetCloud(d.ctx, cls.Provider)
+	if err != nil {
+		return err
+	}
+	cmOption, err := cloudprovider.GetCredential(&cloudprovider.CredentialData{
+		Cloud:     cloud,
+		AccountID: cls.CloudAccountID,
+	})
+	if err != nil {
+		return err
+	}
+	cmOption.Region = cls.Region
+
+	dc := &driftContext{conf: conf, cluster: cls, cloud: cloud, cmOption: cmOption}
+
+	clsMgr, err := cloudprovider.GetClusterMgr(cloud.CloudProvider)
+	if err != nil {
+		return err
+	}
+	// cloudprovider update cluster in place, only fields cloud reported are set in snapshot
+	cloudCls, err := clsMgr.GetCluster(cls.SystemID, &cloudprovider.GetClusterOption{
+		CommonOption: *cmOption,
+		Cluster:      newCloudClusterSnapshot(cls),
+	})
+	if err != nil {
+		return err
+	}
+
+	drifts := compareClusterConfig(cls, cloudCls)
+	d.reconcileClusterDrift(dc, cloudCls, drifts)
+	d.handleDriftResult(dc, nil, drifts)
+
+	return d.detectNodeGroupsDrift(dc)
+}
+
+func (d *Daemon) detectNodeGroupsDrift(dc *driftContext) error {
+	condGroup := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid": dc.cluster.ClusterID,
+		"status":    common.StatusRunning,
+	})
+	groupList, err := d.model.ListNodeGroup(d.ctx, condGroup, &storeopt.ListOption{All: true})
+	if err != nil {
+		return err
+	}
+	groupMgr, err := cloudprovider.GetNodeGroupMgr(dc.cloud.CloudProvider)
+	if err != nil {
+		return err
+	}
+
+	for i := range groupList {
+		group := &groupList[i]
+		if group.CloudNodeGroupID == "" || cloudprovider.IsExternalNodePool(group) {
+			continue
+		}
+		// nodeGroup scaling or nodes initializing, cloud nodes not stable
+		if inOperation, reason := d.isNodeGroupInOperation(group); inOperation {
+			blog.Infof("detectNodeGroupsDrift cluster[%s] group[%s] skip: %s",
+				dc.cluster.ClusterID, group.NodeGroupID, reason)
+			continue
+		}
+
+		nodes, errLocal := groupMgr.GetNodesInGroup(group, dc.cmOption)
+		if errLocal != nil {
+			blog.Errorf("detectNodeGroupsDrift cluster[%s] group[%s] GetNodesInGroup failed: %v",
+				dc.cluster.ClusterID, group.NodeGroupID, errLocal)
+			continue
+		}
+
+		drifts := compareNodeGroupConfig(group, nodes)
+		drifts = append(drifts, d.compareNodeGroupTemplate(dc.cluster.ClusterID, group, nodes)...)
+		d.reconcileNodeGroupDrift(dc, group, nodes, drifts)
+		d.handleDriftResult(dc, group, drifts)
+	}
+
+	return nil
+}
+
+// isNodeGroupInOperation check nodeGroup has running tasks or nodes not yet running
+func (d *Daemon) isNodeGroupInOperation(group *cmproto.NodeGroup) (bool, string) {
+	condTask := operator.NewBranchCondition(operator.And,
+		operator.NewLeafCondition(operator.Eq, operator.M{"nodegroupid": group.NodeGroupID}),
+		operator.NewLeafCondition(operator.In, operator.M{"status": []string{cloudprovider.TaskStatusInit,
+			cloudprovider.TaskStatusRunning, cloudprovider.TaskStatusRollingBack}}),
+	)
+	tasks, err := d.model.ListTask(d.ctx, condTask, &storeopt.ListOption{})
+	if err != nil {
+		return true, fmt.Sprintf("list tasks failed: %v", err)
+	}
+	if len(tasks) > 0 {
+		return true, fmt.Sprintf("task %s is running", tasks[0].TaskID)
+	}
+
+	condNode := operator.NewLeafCondition(operator.Eq, operator.M{
+		"clusterid":   group.ClusterID,
+		"nodegroupid": group.NodeGroupID,
+	})
+	nodes, err := d.model.ListNode(d.ctx, condNode, &storeopt.ListOption{})
+	if err != nil {
+		return true, fmt.Sprintf("list nodes failed: %v", err)
+	}
+	for i := range nodes {
+		switch nodes[i].Status {
+		case common.StatusInitialization, common.StatusDeleting, common.StatusResourceApplying:
+			return true, fmt.Sprintf("node %s status %s", nodes[i].NodeID, nodes[i].Status)
+		default:
+		}
+	}
+
+	return false, ""
+}
+
+// handleDriftResult save drift records, report metrics and notify changed drifts
+func (d *Daemon) handleDriftResult(dc *driftContext, group *cmproto.NodeGroup, drifts []*cmproto.ClusterDrift) {
+	changed, err := d.syncDriftRecords(dc.cluster.ClusterID, group.GetNodeGroupID(), drifts)
+	if err != nil {
+		blog.Errorf("handleDriftResult cluster[%s] group[%s] syncDriftRecords failed: %v",
+			dc.cluster.ClusterID, group.GetNodeGroupID(), err)
+		return
+	}
+
+	drifted := 0
+	for _, drift := range drifts {
+		if drift.Status != common.DriftStatusReconciled {
+			drifted++
+		}
+	}
+	metrics.ReportClusterDriftNum(dc.cloud.CloudProvider, dc.cluster.ClusterID, group.GetNodeGroupID(), float64(drifted))
+
+	if !dc.conf.Notify || !changed {
+		return
+	}
+	if err = cloudprovider.SendClusterDriftNotify(dc.cluster, group, drifts); err != nil {
+		blog.Errorf("handleDriftResult cluster[%s] group[%s] SendClusterDriftNotify failed: %v",
+			dc.cluster.ClusterID, group.GetNodeGroupID(), err)
+	}
+}
+
+// syncDriftRecords save current drifts and clean records which disappeared, return true if drifts changed
+func (d *Daemon) syncDriftRecords(clusterID, groupID string, drifts []*cmproto.ClusterDrift) (bool, error) {
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		clusterdrift.ClusterIDKey:   clusterID,
+		clusterdrift.NodeGroupIDKey: groupID,
+	})
+	existList, err := d.model.ListClusterDrift(d.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return false, err
+	}
+	exists := make(map[string]cmproto.ClusterDrift, len(existList))
+	for i := range existList {
+		exists[existList[i].DriftID] = existList[i]
+	}
+
+	var (
+		changed = false
+		now     = time.Now().Format(time.RFC3339)
+		current = make(map[string]struct{}, len(drifts))
+	)
+	for _, drift := range drifts {
+		current[drift.DriftID] = struct{}{}
+		drift.DetectTime = now
+		drift.UpdateTime = now
+
+		old, ok := exists[drift.DriftID]
+		if ok {
+			drift.DetectTime = old.DetectTime
+		}
+		if !ok || old.StoreValue != drift.StoreValue || old.CloudValue != drift.CloudValue ||
+			old.Status != drift.Status {
+			changed = true
+		}
+		if err = d.model.PutClusterDrift(d.ctx, drift); err != nil {
+			return false, err
+		}
+	}
+
+	for id := range exists {
+		if _, ok := current[id]; ok {
+			continue
+		}
+		if err = d.model.DeleteClusterDrift(d.ctx, id); err != nil {
+			blog.Errorf("syncDriftRecords DeleteClusterDrift[%s] failed: %v", id, err)
+		}
+	}
+
+	return changed, nil
+}
+
+func newClusterDrift(clusterID, groupID, field, storeValue, cloudValue string) *cmproto.ClusterDrift {
+	resourceType := common.Cluster.String()
+	if groupID != "" {
+		resourceType = common.NodeGroup.String()
+	}
+
+	return &cmproto.ClusterDrift{
+		DriftID:      fmt.Sprintf("%s-%s-%s", clusterID, groupID, field),
+		ClusterID:    clusterID,
+		NodeGroupID:  groupID,
+		ResourceType: resourceType,
+		Field:        field,
+		StoreValue:   storeValue,
+		CloudValue:   cloudValue,
+		Status:       common.DriftStatusDrifted,
+	}
+}
+
+// newCloudClusterSnapshot build empty cluster which only keep cluster identity for cloudprovider
+// to fill cloud config, store config must not be copied or drift of fields cloud not refreshed is lost
+func newCloudClusterSnapshot(cls *cmproto.Cluster) *cmproto.Cluster {
+	extraInfo := make(map[string]string, len(cls.GetExtraInfo()))
+	for k, v := range cls.GetExtraInfo() {
+		extraInfo[k] = v
+	}
+
+	return &cmproto.Cluster{
+		ClusterID:              cls.ClusterID,
+		ProjectID:              cls.ProjectID,
+		BusinessID:             cls.BusinessID,
+		Provider:               cls.Provider,
+		Region:                 cls.Region,
+		SystemID:               cls.SystemID,
+		CloudAccountID:         cls.CloudAccountID,
+		ExtraInfo:              extraInfo,
+		ClusterBasicSettings:   &cmproto.ClusterBasicSetting{},
+		ClusterAdvanceSettings: &cmproto.ClusterAdvanceSetting{},
+		NetworkSettings:        &cmproto.NetworkSetting{},
+	}
+}
+
+// compareClusterConfig compare cluster config, only compare the fields cloud reported
+func compareClusterConfig(cls, cloudCls *cmproto.Cluster) []*cmproto.ClusterDrift {
+	drifts := make([]*cmproto.ClusterDrift, 0)
+	if cloudCls == nil {
+		return drifts
+	}
+
+	compare := func(field, storeValue, cloudValue string) {
+		if cloudValue == "" || cloudValue == "0" || storeValue == cloudValue {
+			return
+		}
+		drifts = append(drifts, newClusterDrift(cls.ClusterID, "", field, storeValue, cloudValue))
+	}
+
+	compare(driftFieldVersion, cls.GetClusterBasicSettings().GetVersion(),
+		cloudCls.GetClusterBasicSettings().GetVersion())
+	compare(driftFieldVpcID, cls.GetVpcID(), cloudCls.GetVpcID())
+	compare(driftFieldClusterCIDR, cls.GetNetworkSettings().GetClusterIPv4CIDR(),
+		cloudCls.GetNetworkSettings().GetClusterIPv4CIDR())
+	compare(driftFieldServiceCIDR, cls.GetNetworkSettings().GetServiceIPv4CIDR(),
+		cloudCls.GetNetworkSettings().GetServiceIPv4CIDR())
+	compare(driftFieldMaxNodePodNum, strconv.Itoa(int(cls.GetNetworkSettings().GetMaxNodePodNum())),
+		strconv.Itoa(int(cloudCls.GetNetworkSettings().GetMaxNodePodNum())))
+	compare(driftFieldMaxServiceNum, strconv.Itoa(int(cls.GetNetworkSettings().GetMaxServiceNum())),
+		strconv.Itoa(int(cloudCls.GetNetworkSettings().GetMaxServiceNum())))
+
+	// cloud may add extra tags, only compare tags managed in store
+	storeTags := cls.GetClusterBasicSettings().GetClusterTags()
+	if len(storeTags) > 0 && len(cloudCls.GetClusterBasicSettings().GetClusterTags()) > 0 {
+		cloudTags := filterCloudTags(storeTags, cloudCls.GetClusterBasicSettings().GetClusterTags())
+		if formatLabels(storeTags) != formatLabels(cloudTags) {
+			drifts = append(drifts, newClusterDrift(cls.ClusterID, "", driftFieldClusterTags,
+				formatLabels(storeTags), formatLabels(cloudTags)))
+		}
+	}
+
+	return drifts
+}
+
+// filterCloudTags cloud tags which keys exist in store tags
+func filterCloudTags(storeTags, cloudTags map[string]string) map[string]string {
+	tags := make(map[string]string, len(storeTags))
+	for k := range storeTags {
+		if v, ok := cloudTags[k]; ok {
+			tags[k] = v
+		}
+	}
+
+	return tags
+}
+
+// compareNodeGroupConfig compare nodeGroup desired size & instance type with cloud nodes
+func compareNodeGroupConfig(group *cmproto.NodeGroup, nodes []*cmproto.Node) []*cmproto.ClusterDrift {
+	drifts := make([]*cmproto.ClusterDrift, 0)
+
+	if group.GetAutoScaling() != nil && int(group.GetAutoScaling().GetDesiredSize()) != len(nodes) {
+		drifts = append(drifts, newClusterDrift(group.ClusterID, group.NodeGroupID, driftFieldDesiredSize,
+			strconv.Itoa(int(group.GetAutoScaling().GetDesiredSize())), strconv.Itoa(len(nodes))))
+	}
+
+	instanceType := group.GetLaunchTemplate().GetInstanceType()
+	if instanceType == "" {
+		return drifts
+	}
+	cloudTypes := make([]string, 0)
+	for _, n := range nodes {
+		if n.InstanceType == "" || n.InstanceType == instanceType ||
+			utils.StringInSlice(n.InstanceType, cloudTypes) {
+			continue
+		}
+		cloudTypes = append(cloudTypes, n.InstanceType)
+	}
+	if len(cloudTypes) > 0 {
+		sort.Strings(cloudTypes)
+		drifts = append(drifts, newClusterDrift(group.ClusterID, group.NodeGroupID, driftFieldInstanceType,
+			instanceType, strings.Join(cloudTypes, ",")))
+	}
+
+	return drifts
+}
+
+// compareNodeGroupTemplate compare nodeGroup template labels & taints with cluster nodes
+func (d *Daemon) compareNodeGroupTemplate(clusterID string, group *cmproto.NodeGroup,
+	nodes []*cmproto.Node) []*cmproto.ClusterDrift {
+	drifts := make([]*cmproto.ClusterDrift, 0)
+
+	labels := cloudprovider.GetLabelsByNg(group)
+	taints := cloudprovider.GetTaintsByNg(group)
+	if len(nodes) == 0 || (len(labels) == 0 && len(taints) == 0) {
+		return drifts
+	}
+
+	k8sNodes, err := d.listGroupK8sNodes(clusterID, nodes)
+	if err != nil {
+		blog.Errorf("compareNodeGroupTemplate cluster[%s] group[%s] list nodes failed: %v",
+			clusterID, group.NodeGroupID, err)
+		return drifts
+	}
+
+	missLabels, missTaints := make([]string, 0), make([]string, 0)
+	for _, n := range k8sNodes {
+		for k, v := range labels {
+			if value, ok := n.Labels[k]; !ok || value != v {
+				missLabels = append(missLabels, n.Name)
+				break
+			}
+		}
+		for _, t := range taints {
+			if !nodeHasTaint(n, t) {
+				missTaints = append(missTaints, n.Name)
+				break
+			}
+		}
+	}
+
+	if len(missLabels) > 0 {
+		drifts = append(drifts, newClusterDrift(clusterID, group.NodeGroupID, driftFieldNodeLabels,
+			formatLabels(labels), "nodes mismatch: "+strings.Join(missLabels, ",")))
+	}
+	if len(missTaints) > 0 {
+		drifts = append(drifts, newClusterDrift(clusterID, group.NodeGroupID, driftFieldNodeTaints,
+			formatTaints(taints), "nodes mismatch: "+strings.Join(missTaints, ",")))
+	}
+
+	return drifts
+}
+
+func (d *Daemon) listGroupK8sNodes(clusterID string, nodes []*cmproto.Node) ([]*v1.Node, error) {
+	ips := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if n.InnerIP != "" {
+			ips = append(ips, n.InnerIP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, nil
+	}
+
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), d.model)
+	return k8sOperator.ListClusterNodesByIPsOrNames(d.ctx, clusterops.ListNodeOption{
+		ClusterID: clusterID,
+		NodeIPs:   ips,
+	})
+}
+
+// reconcileClusterDrift reconcile cluster drift, cluster config only support cloudToStore
+func (d *Daemon) reconcileClusterDrift(dc *driftContext, cloudCls *cmproto.Cluster, drifts []*cmproto.ClusterDrift) {
+	if dc.conf.Reconcile == "" || len(drifts) == 0 {
+		return
+	}
+	if dc.conf.Reconcile != common.DriftReconcileCloudToStore {
+		for _, drift := range drifts {
+			drift.Message = fmt.Sprintf(driftReconcileNotSupport, dc.conf.Reconcile, drift.Field)
+		}
+		return
+	}
+
+	cls, err := d.model.GetCluster(d.ctx, dc.cluster.ClusterID)
+	if err != nil {
+		setDriftReconcileResult(drifts, err)
+		return
+	}
+	for _, drift := range drifts {
+		switch drift.Field {
+		case driftFieldVersion:
+			if cls.ClusterBasicSettings == nil {
+				cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{}
+			}
+			cls.ClusterBasicSettings.Version = cloudCls.GetClusterBasicSettings().GetVersion()
+		case driftFieldVpcID:
+			cls.VpcID = cloudCls.GetVpcID()
+		case driftFieldClusterCIDR, driftFieldServiceCIDR, driftFieldMaxNodePodNum, driftFieldMaxServiceNum:
+			if cls.NetworkSettings == nil {
+				cls.NetworkSettings = &cmproto.NetworkSetting{}
+			}
+			syncNetworkField(cls.NetworkSettings, cloudCls.GetNetworkSettings(), drift.Field)
+		case driftFieldClusterTags:
+			if cls.ClusterBasicSettings == nil {
+				cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{}
+			}
+			cls.ClusterBasicSettings.ClusterTags = filterCloudTags(cls.ClusterBasicSettings.ClusterTags,
+				cloudCls.GetClusterBasicSettings().GetClusterTags())
+		}
+	}
+
+	setDriftReconcileResult(drifts, d.model.UpdateCluster(d.ctx, cls))
+}
+
+func syncNetworkField(dst, src *cmproto.NetworkSetting, field string) {
+	switch field {
+	case driftFieldClusterCIDR:
+		dst.ClusterIPv4CIDR = src.GetClusterIPv4CIDR()
+	case driftFieldServiceCIDR:
+		dst.ServiceIPv4CIDR = src.GetServiceIPv4CIDR()
+	case driftFieldMaxNodePodNum:
+		dst.MaxNodePodNum = src.GetMaxNodePodNum()
+	case driftFieldMaxServiceNum:
+		dst.MaxServiceNum = src.GetMaxServiceNum()
+	}
+}
+
+// reconcileNodeGroupDrift reconcile nodeGroup drift by reconcile mode
+func (d *Daemon) reconcileNodeGroupDrift(dc *driftContext, group *cmproto.NodeGroup,
+	nodes []*cmproto.Node, drifts []*cmproto.ClusterDrift) {
+	if dc.conf.Reconcile == "" || len(drifts) == 0 {
+		return
+	}
+
+	switch dc.conf.Reconcile {
+	case common.DriftReconcileCloudToStore:
+		d.reconcileNodeGroupCloudToStore(group, nodes, drifts)
+	case common.DriftReconcileStoreToCloud:
+		d.reconcileNodeGroupStoreToCloud(dc, group, nodes, drifts)
+	default:
+		blog.Errorf("reconcileNodeGroupDrift not supported reconcile mode %s", dc.conf.Reconcile)
+	}
+}
+
+func (d *Daemon) reconcileNodeGroupCloudToStore(group *cmproto.NodeGroup,
+	nodes []*cmproto.Node, drifts []*cmproto.ClusterDrift) {
+	newGroup, err := d.model.GetNodeGroup(d.ctx, group.NodeGroupID)
+	if err != nil {
+		setDriftReconcileResult(drifts, err)
+		return
+	}
+
+	reconciled := make([]*cmproto.ClusterDrift, 0)
+	for _, drift := range drifts {
+		switch drift.Field {
+		case driftFieldDesiredSize:
+			if newGroup.AutoScaling == nil {
+				continue
+			}
+			newGroup.AutoScaling.DesiredSize = uint32(len(nodes))
+			reconciled = append(reconciled, drift)
+		case driftFieldInstanceType:
+			// only sync when all cloud nodes use the same instance type
+			if strings.Contains(drift.CloudValue, ",") || len(nodes) == 0 ||
+				nodes[0].InstanceType != drift.CloudValue {
+				drift.Message = "cloud nodes have multiple instance types"
+				continue
+			}
+			if newGroup.LaunchTemplate == nil {
+				continue
+			}
+			newGroup.LaunchTemplate.InstanceType = drift.CloudValue
+			reconciled = append(reconciled, drift)
+		default:
+			drift.Message = fmt.Sprintf(driftReconcileNotSupport, common.DriftReconcileCloudToStore, drift.Field)
+		}
+	}
+	if len(reconciled) == 0 {
+		return
+	}
+
+	setDriftReconcileResult(reconciled, d.model.UpdateNodeGroup(d.ctx, newGroup))
+}
+
+// reconcileNodeGroupStoreToCloud apply nodeGroup template labels & taints to cluster nodes
+func (d *Daemon) reconcileNodeGroupStoreToCloud(dc *driftContext, group *cmproto.NodeGroup,
+	nodes []*cmproto.Node, drifts []*cmproto.ClusterDrift) {
+	k8sOperator := clusterops.NewK8SOperator(options.GetGlobalCMOptions(), d.model)
+
+	for _, drift := range drifts {
+		switch drift.Field {
+		case driftFieldNodeLabels, driftFieldNodeTaints:
+			k8sNodes, err := d.listGroupK8sNodes(dc.cluster.ClusterID, nodes)
+			if err != nil {
+				setDriftReconcileResult([]*cmproto.ClusterDrift{drift}, err)
+				continue
+			}
+			setDriftReconcileResult([]*cmproto.ClusterDrift{drift},
+				syncNodeTemplateToNodes(d, k8sOperator, dc.cluster.ClusterID, group, k8sNodes, drift.Field))
+		default:
+			drift.Message = fmt.Sprintf(driftReconcileNotSupport, common.DriftReconcileStoreToCloud, drift.Field)
+		}
+	}
+}
+
+func syncNodeTemplateToNodes(d *Daemon, k8sOperator *clusterops.K8SOperator, clusterID string,
+	group *cmproto.NodeGroup, nodes []*v1.Node, field string) error {
+	for _, n := range nodes {
+		var err error
+		switch field {
+		case driftFieldNodeLabels:
+			labels := make(map[string]string, len(n.Labels))
+			for k, v := range n.Labels {
+				labels[k] = v
+			}
+			for k, v := range cloudprovider.GetLabelsByNg(group) {
+				labels[k] = v
+			}
+			err = k8sOperator.UpdateNodeLabels(d.ctx, clusterID, n.Name, labels)
+		case driftFieldNodeTaints:
+			taints := append([]v1.Taint{}, n.Spec.Taints...)
+			for _, t := range cloudprovider.GetTaintsByNg(group) {
+				if nodeHasTaint(n, t) {
+					continue
+				}
+				taints = removeTaintByKey(taints, t.Key, t.Effect)
+				taints = append(taints, v1.Taint{Key: t.Key, Value: t.Value, Effect: v1.TaintEffect(t.Effect)})
+			}
+			err = k8sOperator.UpdateNodeTaints(d.ctx, clusterID, n.Name, taints)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setDriftReconcileResult(drifts []*cmproto.ClusterDrift, err error) {
+	for _, drift := range drifts {
+		if err != nil {
+			drift.Status = common.DriftStatusReconcileFailed
+			drift.Message = err.Error()
+			continue
+		}
+		drift.Status = common.DriftStatusReconciled
+		drift.Message = ""
+	}
+}
+
+func nodeHasTaint(node *v1.Node, taint *cmproto.Taint) bool {
+	for _, t := range node.Spec.Taints {
+		if t.Key == taint.Key && t.Value == taint.Value && string(t.Effect) == taint.Effect {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeTaintByKey(taints []v1.Taint, key, effect string) []v1.Taint {
+	result := make([]v1.Taint, 0, len(taints))
+	for _, t := range taints {
+		if t.Key == key && string(t.Effect) == effect {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	kvs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		kvs = append(kvs, k+"="+v)
+	}
+	sort.Strings(kvs)
+
+	return strings.Join(kvs, ",")
+}
+
+func formatTaints(taints []*cmproto.Taint) string {
+	kvs := make([]string, 0, len(taints))
+	for _, t := range taints {
+		kvs = append(kvs, fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect))
+	}
+	sort.Strings(kvs)
+
+	return strings.Join(kvs, ",")
+}
@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package daemon
+
+import (
+	"testing"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+)
+
+func storeCluster() *cmproto.Cluster {
+	return &cmproto.Cluster{
+		ClusterID: "BCS-K8S-00001",
+		SystemID:  "cls-xxx",
+		VpcID:     "vpc-1",
+		ClusterBasicSettings: &cmproto.ClusterBasicSetting{
+			Version:     "1.20.6",
+			ClusterTags: map[string]string{"env": "prod"},
+		},
+		NetworkSettings: &cmproto.NetworkSetting{
+			ClusterIPv4CIDR: "172.16.0.0/16",
+			ServiceIPv4CIDR: "172.17.0.0/20",
+			MaxNodePodNum:   64,
+			MaxServiceNum:   1024,
+		},
+	}
+}
+
+func TestCompareClusterConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cloud  func(cls *cmproto.Cluster)
+		fields map[string]string
+	}{
+		{
+			name:   "cloud same as store",
+			cloud:  func(cls *cmproto.Cluster) {},
+			fields: map[string]string{},
+		},
+		{
+			name: "cloud not reported",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.VpcID = ""
+				cls.ClusterBasicSettings = &cmproto.ClusterBasicSetting{}
+				cls.NetworkSettings = &cmproto.NetworkSetting{}
+			},
+			fields: map[string]string{},
+		},
+		{
+			name: "version and vpc drifted",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.VpcID = "vpc-2"
+				cls.ClusterBasicSettings.Version = "1.22.5"
+			},
+			fields: map[string]string{driftFieldVpcID: "vpc-2", driftFieldVersion: "1.22.5"},
+		},
+		{
+			name: "network drifted",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.NetworkSettings.ClusterIPv4CIDR = "10.0.0.0/16"
+				cls.NetworkSettings.ServiceIPv4CIDR = "10.1.0.0/20"
+				cls.NetworkSettings.MaxNodePodNum = 32
+				cls.NetworkSettings.MaxServiceNum = 256
+			},
+			fields: map[string]string{
+				driftFieldClusterCIDR:   "10.0.0.0/16",
+				driftFieldServiceCIDR:   "10.1.0.0/20",
+				driftFieldMaxNodePodNum: "32",
+				driftFieldMaxServiceNum: "256",
+			},
+		},
+		{
+			name: "cloud extra tags ignored",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.ClusterBasicSettings.ClusterTags = map[string]string{"env": "prod", "operator": "admin"}
+			},
+			fields: map[string]string{},
+		},
+		{
+			name: "tags drifted",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.ClusterBasicSettings.ClusterTags = map[string]string{"env": "test", "operator": "admin"}
+			},
+			fields: map[string]string{driftFieldClusterTags: "env=test"},
+		},
+		{
+			name: "tags removed in cloud",
+			cloud: func(cls *cmproto.Cluster) {
+				cls.ClusterBasicSettings.ClusterTags = map[string]string{"operator": "admin"}
+			},
+			fields: map[string]string{driftFieldClusterTags: ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloudCls := storeCluster()
+			tt.cloud(cloudCls)
+
+			drifts := compareClusterConfig(storeCluster(), cloudCls)
+			if len(drifts) != len(tt.fields) {
+				t.Fatalf("got %d drifts %v, want %v", len(drifts), drifts, tt.fields)
+			}
+			for _, drift := range drifts {
+				cloudValue, ok := tt.fields[drift.Field]
+				if !ok {
+					t.Fatalf("unexpected drift field %s", drift.Field)
+				}
+				if drift.CloudValue != cloudValue {
+					t.Fatalf("field %s cloud value %q, want %q", drift.Field, drift.CloudValue, cloudValue)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCloudClusterSnapshot(t *testing.T) {
+	cls := storeCluster()
+	cls.ExtraInfo = map[string]string{"nodeResourceGroup": "rg"}
+
+	snapshot := newCloudClusterSnapshot(cls)
+	if snapshot.SystemID != cls.SystemID || snapshot.ExtraInfo["nodeResourceGroup"] != "rg" {
+		t.Fatalf("cluster identity lost: %+v", snapshot)
+	}
+	// store config must not leak into cloud snapshot, or unrefreshed fields never drift
+	if snapshot.VpcID != "" || snapshot.GetClusterBasicSettings().GetVersion() != "" ||
+		snapshot.GetNetworkSettings().GetMaxNodePodNum() != 0 ||
+		len(snapshot.GetClusterBasicSettings().GetClusterTags()) != 0 {
+		t.Fatalf("store config copied to snapshot: %+v", snapshot)
+	}
+
+	snapshot.ExtraInfo["key"] = "value"
+	if _, ok := cls.ExtraInfo["key"]; ok {
+		t.Fatalf("snapshot shares extraInfo with store cluster")
+	}
+}
+
+func TestCompareNodeGroupConfig(t *testing.T) {
+	group := &cmproto.NodeGroup{
+		ClusterID:      "BCS-K8S-00001",
+		NodeGroupID:    "BCS-ng-00001",
+		AutoScaling:    &cmproto.AutoScalingGroup{DesiredSize: 2},
+		LaunchTemplate: &cmproto.LaunchConfiguration{InstanceType: "S5.LARGE8"},
+	}
+
+	tests := []struct {
+		name   string
+		nodes  []*cmproto.Node
+		fields map[string]string
+	}{
+		{
+			name:   "cloud nodes same as store",
+			nodes:  []*cmproto.Node{{InstanceType: "S5.LARGE8"}, {InstanceType: "S5.LARGE8"}},
+			fields: map[string]string{},
+		},
+		{
+			name:   "desired size drifted",
+			nodes:  []*cmproto.Node{{InstanceType: "S5.LARGE8"}},
+			fields: map[string]string{driftFieldDesiredSize: "1"},
+		},
+		{
+			name:   "instance type drifted",
+			nodes:  []*cmproto.Node{{InstanceType: "SA2.LARGE8"}, {InstanceType: "S5.LARGE16"}},
+			fields: map[string]string{driftFieldInstanceType: "S5.LARGE16,SA2.LARGE8"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drifts := compareNodeGroupConfig(group, tt.nodes)
+			if len(drifts) != len(tt.fields) {
+				t.Fatalf("got %d drifts %v, want %v", len(drifts), drifts, tt.fields)
+			}
+			for _, drift := range drifts {
+				if drift.CloudValue != tt.fields[drift.Field] {
+					t.Fatalf("field %s cloud value %q, want %q", drift.Field, drift.CloudValue, tt.fields[drift.Field])
+				}
+			}
+		})
+	}
+}
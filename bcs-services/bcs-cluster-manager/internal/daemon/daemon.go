@@ -20,6 +20,7 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
 
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
 )
 
@@ -38,10 +39,11 @@ type Daemon struct {
 	cancel   context.CancelFunc
 	interval int
 	model    store.ClusterManagerModel
+	locker   lock.DistributedLock
 }
 
 // NewDaemon init daemon
-func NewDaemon(interval int, model store.ClusterManagerModel) DaemonInterface {
+func NewDaemon(interval int, model store.ClusterManagerModel, locker lock.DistributedLock) DaemonInterface {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	if interval <= 0 {
@@ -52,6 +54,7 @@ func NewDaemon(interval int, model store.ClusterManagerModel) DaemonInterface {
 		ctx:      ctx,
 		cancel:   cancel,
 		model:    model,
+		locker:   locker,
 		interval: interval,
 	}
 }
@@ -83,6 +86,14 @@ func (d *Daemon) InitDaemon(ctx context.Context) {
 		d.reportMachineryTaskNum(errChan)
 	}, 60)
 
+	go d.simpleDaemon(ctx, &wg, func() {
+		d.reportClusterDrift(errChan)
+	}, driftInterval())
+
+	go d.simpleDaemon(ctx, &wg, func() {
+		d.reportNodeGroupScalingSchedule(errChan)
+	}, scalingScheduleInterval)
+
 	wg.Wait()
 }
 
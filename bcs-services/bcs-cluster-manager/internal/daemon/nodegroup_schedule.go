@@ -0,0 +1,268 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/nodegroup"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/common"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/lock"
+	storeopt "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/utils"
+)
+
+const (
+	scalingScheduleInterval = 60
+	scalingScheduleLockKey  = "/bcs-services/bcs-cluster-manager/NodeGroupScalingSchedule/%s"
+)
+
+func (d *Daemon) reportNodeGroupScalingSchedule(error chan<- error) {
+	condGroup := operator.NewLeafCondition(operator.Eq, operator.M{
+		"status": common.StatusRunning,
+	})
+	groupList, err := d.model.ListNodeGroup(d.ctx, condGroup, &storeopt.ListOption{All: true})
+	if err != nil {
+		blog.Errorf("reportNodeGroupScalingSchedule ListNodeGroup failed: %v", err)
+		error <- err
+		return
+	}
+
+	now := time.Now()
+	concurency := utils.NewRoutinePool(5)
+	defer concurency.Close()
+
+	for i := range groupList {
+		if len(groupList[i].ScalingSchedules) == 0 {
+			continue
+		}
+
+		concurency.Add(1)
+		go func(groupID string) {
+			defer concurency.Done()
+
+			if errLocal := d.runNodeGroupScalingSchedule(groupID, now); errLocal != nil {
+				blog.Errorf("reportNodeGroupScalingSchedule nodeGroup[%s] failed: %v", groupID, errLocal)
+				error <- errLocal
+			}
+		}(groupList[i].NodeGroupID)
+	}
+
+	concurency.Wait()
+}
+
+// runNodeGroupScalingSchedule run the due scaling schedule of nodeGroup and record the result
+func (d *Daemon) runNodeGroupScalingSchedule(groupID string, now time.Time) error {
+	lockKey := fmt.Sprintf(scalingScheduleLockKey, groupID)
+	if err := d.locker.Lock(lockKey, []lock.LockOption{lock.LockTTL(time.Second * 30)}...); err != nil {
+		return fmt.Errorf("lock nodeGroup %s scaling schedule failed: %v", groupID, err)
+	}
+	defer d.locker.Unlock(lockKey) // nolint
+
+	// get nodeGroup again, schedule may be executed by other instance
+	group, err := d.model.GetNodeGroup(d.ctx, groupID)
+	if err != nil {
+		return err
+	}
+	schedule := getDueScalingSchedule(group, now)
+	if schedule == nil {
+		return nil
+	}
+
+	desired := schedule.DesiredSize
+	if group.AutoScaling != nil {
+		desired = clampDesiredSize(desired, group.AutoScaling.MinSize, group.AutoScaling.MaxSize)
+	}
+	blog.Infof("runNodeGroupScalingSchedule nodeGroup[%s] schedule[%s] scaling to %d",
+		groupID, schedule.Name, desired)
+
+	result := common.ScalingScheduleResultSuccess
+	taskID, message, err := d.executeScalingSchedule(group, desired)
+	switch {
+	case err != nil:
+		result = common.ScalingScheduleResultFailed
+		message = err.Error()
+	case taskID == "":
+		result = common.ScalingScheduleResultSkipped
+	}
+
+	// actions may update nodeGroup, get latest data before recording
+	latest, errLocal := d.model.GetNodeGroup(d.ctx, groupID)
+	if errLocal != nil {
+		return errLocal
+	}
+	for _, s := range latest.ScalingSchedules {
+		if s.Name != schedule.Name {
+			continue
+		}
+		s.LastRunTime = now.Format(time.RFC3339)
+		s.LastRunResult = result
+		s.LastTaskID = taskID
+		s.Message = message
+	}
+	if errLocal = d.model.UpdateNodeGroup(d.ctx, latest); errLocal != nil {
+		blog.Errorf("runNodeGroupScalingSchedule nodeGroup[%s] update schedule[%s] failed: %v",
+			groupID, schedule.Name, errLocal)
+	}
+
+	errLocal = d.model.CreateOperationLog(d.ctx, &cmproto.OperationLog{
+		ResourceType: common.NodeGroup.String(),
+		ResourceID:   groupID,
+		TaskID:       taskID,
+		Message: fmt.Sprintf("节点池%s定时伸缩策略%s调整节点数至%d: %s %s",
+			groupID, schedule.Name, desired, result, message),
+		OpUser:       common.ScalingScheduleOperator,
+		CreateTime:   time.Now().Format(time.RFC3339),
+		ClusterID:    group.ClusterID,
+		ProjectID:    group.ProjectID,
+		ResourceName: group.GetName(),
+	})
+	if errLocal != nil {
+		blog.Errorf("runNodeGroupScalingSchedule nodeGroup[%s] CreateOperationLog failed: %v", groupID, errLocal)
+	}
+
+	return err
+}
+
+// executeScalingSchedule scale nodeGroup to desired nodes, return taskID and empty taskID means nothing to do
+func (d *Daemon) executeScalingSchedule(group *cmproto.NodeGroup, desired uint32) (string, string, error) {
+	condM := make(operator.M)
+	condM["nodegroupid"] = group.NodeGroupID
+	condM["clusterid"] = group.ClusterID
+	cond := operator.NewLeafCondition(operator.Eq, condM)
+	nodes, err := d.model.ListNode(d.ctx, cond, &storeopt.ListOption{})
+	if err != nil {
+		return "", "", err
+	}
+
+	var (
+		current      uint32
+		runningNodes = make([]*cmproto.Node, 0)
+	)
+	for i := range nodes {
+		if nodes[i].Status == common.StatusDeleting {
+			continue
+		}
+		current++
+		if nodes[i].Status == common.StatusRunning {
+			runningNodes = append(runningNodes, nodes[i])
+		}
+	}
+
+	switch {
+	case desired == current:
+		return "", fmt.Sprintf("nodeGroup already has %d nodes", current), nil
+	case desired > current:
+		return d.scaleUpNodeGroup(group, desired)
+	default:
+		return d.scaleDownNodeGroup(group, runningNodes, int(current-desired))
+	}
+}
+
+// scaleUpNodeGroup build update desired nodes task for nodeGroup
+func (d *Daemon) scaleUpNodeGroup(group *cmproto.NodeGroup, desired uint32) (string, string, error) {
+	req := &cmproto.UpdateGroupDesiredNodeRequest{
+		NodeGroupID: group.NodeGroupID,
+		DesiredNode: desired,
+		Operator:    common.ScalingScheduleOperator,
+	}
+	resp := &cmproto.UpdateGroupDesiredNodeResponse{}
+	nodegroup.NewUpdateDesiredNodeAction(d.model, d.locker).Handle(d.ctx, req, resp)
+	if !resp.Result {
+		return "", "", fmt.Errorf("update desired nodes failed: %s", resp.Message)
+	}
+
+	return resp.GetData().GetTaskID(), fmt.Sprintf("scale up nodeGroup to %d nodes", desired), nil
+}
+
+// scaleDownNodeGroup clean num running nodes in nodeGroup
+func (d *Daemon) scaleDownNodeGroup(group *cmproto.NodeGroup, nodes []*cmproto.Node, num int) (string, string, error) {
+	if len(nodes) == 0 {
+		return "", "", fmt.Errorf("nodeGroup has no running nodes to clean")
+	}
+	if num > len(nodes) {
+		num = len(nodes)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeID < nodes[j].NodeID
+	})
+	ips := make([]string, 0, num)
+	for _, node := range nodes[:num] {
+		ips = append(ips, node.InnerIP)
+	}
+
+	req := &cmproto.CleanNodesInGroupRequest{
+		ClusterID:   group.ClusterID,
+		Nodes:       ips,
+		NodeGroupID: group.NodeGroupID,
+		Operator:    common.ScalingScheduleOperator,
+	}
+	resp := &cmproto.CleanNodesInGroupResponse{}
+	nodegroup.NewCleanNodesAction(d.model, d.locker).Handle(d.ctx, req, resp)
+	if !resp.Result {
+		return "", "", fmt.Errorf("clean nodes %v failed: %s", ips, resp.Message)
+	}
+
+	return resp.GetData().GetTaskID(), fmt.Sprintf("scale down nodeGroup nodes %v", ips), nil
+}
+
+// getDueScalingSchedule get the enabled schedule which fired latest since last check
+func getDueScalingSchedule(group *cmproto.NodeGroup, now time.Time) *cmproto.ScalingSchedule {
+	var (
+		due      *cmproto.ScalingSchedule
+		dueFire  time.Time
+		lookback = now.Add(-2 * scalingScheduleInterval * time.Second)
+	)
+
+	for _, schedule := range group.GetScalingSchedules() {
+		if !schedule.Enable {
+			continue
+		}
+		sched, err := utils.ParseCronSchedule(schedule.Cron, schedule.TimeZone)
+		if err != nil {
+			blog.Errorf("nodeGroup[%s] schedule[%s] parse cron failed: %v", group.NodeGroupID, schedule.Name, err)
+			continue
+		}
+
+		since := lookback
+		lastRun, err := time.Parse(time.RFC3339, schedule.LastRunTime)
+		if err == nil && lastRun.After(since) {
+			since = lastRun
+		}
+		fire := utils.GetScheduleFireTime(sched, since, now)
+		if !fire.IsZero() && fire.After(dueFire) {
+			due, dueFire = schedule, fire
+		}
+	}
+
+	return due
+}
+
+// clampDesiredSize keep desired size in [min, max]
+func clampDesiredSize(desired, min, max uint32) uint32 {
+	if desired < min {
+		return min
+	}
+	if max > 0 && desired > max {
+		return max
+	}
+
+	return desired
+}
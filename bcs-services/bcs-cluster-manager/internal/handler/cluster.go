@@ -206,6 +206,38 @@ func (cm *ClusterManager) DeleteCluster(ctx context.Context,
 	return nil
 }
 
+// UpgradeCluster implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) UpgradeCluster(ctx context.Context,
+	req *cmproto.UpgradeClusterReq, resp *cmproto.UpgradeClusterResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := clusterac.NewUpgradeAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("UpgradeCluster", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: UpgradeCluster, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// ListClusterDrift implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ListClusterDrift(ctx context.Context,
+	req *cmproto.ListClusterDriftReq, resp *cmproto.ListClusterDriftResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := clusterac.NewListDriftAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ListClusterDrift", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ListClusterDrift, req %v, resp.Code %d, resp.Message %s",
+		reqID, req, resp.Code, resp.Message)
+	blog.V(5).Infof("reqID: %s, action: ListClusterDrift, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // DeleteVirtualCluster implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) DeleteVirtualCluster(ctx context.Context,
 	req *cmproto.DeleteVirtualClusterReq, resp *cmproto.DeleteVirtualClusterResp) error {
@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/cost"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/metrics"
+)
+
+// GetClusterCost implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) GetClusterCost(ctx context.Context,
+	req *cmproto.GetClusterCostReq, resp *cmproto.GetClusterCostResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := cost.NewGetClusterCostAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("GetClusterCost", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: GetClusterCost, req %v, resp.Code %d, resp.Message %s",
+		reqID, req, resp.Code, resp.Message)
+	blog.V(5).Infof("reqID: %s, action: GetClusterCost, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// GetProjectCost implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) GetProjectCost(ctx context.Context,
+	req *cmproto.GetProjectCostReq, resp *cmproto.GetProjectCostResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := cost.NewGetProjectCostAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("GetProjectCost", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: GetProjectCost, req %v, resp.Code %d, resp.Message %s",
+		reqID, req, resp.Code, resp.Message)
+	blog.V(5).Infof("reqID: %s, action: GetProjectCost, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// EvaluateNodeGroupCost implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) EvaluateNodeGroupCost(ctx context.Context,
+	req *cmproto.EvaluateNodeGroupCostReq, resp *cmproto.EvaluateNodeGroupCostResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := cost.NewEvaluateNodeGroupCostAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("EvaluateNodeGroupCost", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: EvaluateNodeGroupCost, req %v, resp %v", reqID, req, resp)
+	return nil
+}
@@ -246,6 +246,21 @@ func (cm *ClusterManager) UpdateGroupDesiredNode(ctx context.Context,
 	return nil
 }
 
+// RolloutNodeGroup implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) RolloutNodeGroup(ctx context.Context,
+	req *cmproto.RolloutNodeGroupRequest, resp *cmproto.RolloutNodeGroupResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := nodegroup.NewRolloutAction(cm.model, cm.locker)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("RolloutNodeGroup", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: RolloutNodeGroup, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
 // UpdateGroupDesiredSize implements interface cmproto.ClusterManagerServer
 func (cm *ClusterManager) UpdateGroupDesiredSize(ctx context.Context,
 	req *cmproto.UpdateGroupDesiredSizeRequest, resp *cmproto.UpdateGroupDesiredSizeResponse) error {
@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+
+	cmproto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/actions/resourcebundle"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/metrics"
+)
+
+// ExportProjectResources implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ExportProjectResources(ctx context.Context,
+	req *cmproto.ExportProjectResourcesReq, resp *cmproto.ExportProjectResourcesResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ea := resourcebundle.NewExportAction(cm.model)
+	ea.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ExportProjectResources", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ExportProjectResources, req %v, resp.Code %d, resp.Message %s",
+		reqID, req, resp.Code, resp.Message)
+	blog.V(5).Infof("reqID: %s, action: ExportProjectResources, req %v, resp %v", reqID, req, resp)
+	return nil
+}
+
+// ApplyProjectResources implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) ApplyProjectResources(ctx context.Context,
+	req *cmproto.ApplyProjectResourcesReq, resp *cmproto.ApplyProjectResourcesResp) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	aa := resourcebundle.NewApplyAction(cm.model, cm.locker)
+	aa.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("ApplyProjectResources", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: ApplyProjectResources, projectID %s, dryRun %v, operator %s, "+
+		"resp.Code %d, resp.Message %s, resp.Data %v",
+		reqID, req.ProjectID, req.DryRun, req.Operator, resp.Code, resp.Message, resp.Data)
+	blog.V(5).Infof("reqID: %s, action: ApplyProjectResources, req %v, resp %v", reqID, req, resp)
+	return nil
+}
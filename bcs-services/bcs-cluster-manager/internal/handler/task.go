@@ -133,3 +133,18 @@ func (cm *ClusterManager) SkipTask(ctx context.Context,
 	blog.Infof("reqID: %s, action: SkipTask, req %v, resp %v", reqID, req, resp)
 	return nil
 }
+
+// CancelTask implements interface cmproto.ClusterManagerServer
+func (cm *ClusterManager) CancelTask(ctx context.Context,
+	req *cmproto.CancelTaskRequest, resp *cmproto.CancelTaskResponse) error {
+	reqID, err := requestIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	ca := task.NewCancelAction(cm.model)
+	ca.Handle(ctx, req, resp)
+	metrics.ReportAPIRequestMetric("CancelTask", "grpc", strconv.Itoa(int(resp.Code)), start)
+	blog.Infof("reqID: %s, action: CancelTask, req %v, resp %v", reqID, req, resp)
+	return nil
+}
@@ -92,6 +92,12 @@ var (
 			350.0, 400.0, 500.0, 600.0, 700.0, 800.0},
 	}, []string{"task_type", "status", "child_task"})
 
+	reportClusterDriftNum = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: BkBcsClusterManager,
+		Name:      "cluster_drift_num",
+		Help:      "census cluster config drift field number between store and cloud",
+	}, []string{"cloud", "cluster", "group"})
+
 	reportMachineryTaskNum = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: BkBcsClusterManager,
 		Name:      "machinery_task",
@@ -111,6 +117,7 @@ func init() {
 	prometheus.MustRegister(reportClusterGroupResourceNum)
 	prometheus.MustRegister(reportClusterGroupMaxResourceNum)
 	prometheus.MustRegister(reportMachineryTaskNum)
+	prometheus.MustRegister(reportClusterDriftNum)
 }
 
 // ReportMasterTaskMetric report lib call metrics
@@ -155,3 +162,8 @@ func ReportCloudClusterHealthStatus(cloud, cluster string, status float64) {
 func ReportMachineryTaskNum(taskName, state string, num float64) {
 	reportMachineryTaskNum.WithLabelValues(taskName, state).Set(num)
 }
+
+// ReportClusterDriftNum report cluster/nodeGroup drift field number
+func ReportClusterDriftNum(cloud, cluster, group string, num float64) {
+	reportClusterDriftNum.WithLabelValues(cloud, cluster, group).Set(num)
+}
@@ -164,6 +164,32 @@ type AlarmConfig struct {
 	BkUserName    string `json:"bkUserName"`
 	Enable        bool   `json:"enable"`
 	Debug         bool   `json:"debug"`
+	// AlertManager prometheus alertmanager silence backend
+	AlertManager AlertManagerConfig `json:"alertManager"`
+	// Webhook generic webhook shield backend
+	Webhook AlarmWebhookConfig `json:"webhook"`
+}
+
+// AlertManagerConfig for alertmanager silence
+type AlertManagerConfig struct {
+	Enable bool   `json:"enable"`
+	Server string `json:"server"`
+	Token  string `json:"token"`
+	// IPLabel alert label which carries host ip, default instance
+	IPLabel string `json:"ipLabel"`
+	// DurationMinutes silence duration
+	DurationMinutes uint32 `json:"durationMinutes"`
+	Debug           bool   `json:"debug"`
+}
+
+// AlarmWebhookConfig for generic alarm webhook
+type AlarmWebhookConfig struct {
+	Enable bool   `json:"enable"`
+	Server string `json:"server"`
+	Token  string `json:"token"`
+	// DurationMinutes shield duration
+	DurationMinutes uint32 `json:"durationMinutes"`
+	Debug           bool   `json:"debug"`
 }
 
 // IAMConfig for perm interface
@@ -271,6 +297,17 @@ type JobConfig struct {
 	JobTaskLink string `json:"jobTaskLink"`
 }
 
+// DriftConfig for cluster configuration drift detection
+type DriftConfig struct {
+	Enable bool `json:"enable"`
+	// Interval detect interval seconds
+	Interval int `json:"interval"`
+	// Reconcile drift reconcile mode, empty means only detect, support storeToCloud/cloudToStore
+	Reconcile string `json:"reconcile"`
+	// Notify send drift message by project notify templates
+	Notify bool `json:"notify"`
+}
+
 // ClusterManagerOptions options of cluster manager
 type ClusterManagerOptions struct {
 	Etcd               EtcdOption            `json:"etcd"`
@@ -293,6 +330,7 @@ type ClusterManagerOptions struct {
 	Auth               AuthConfig            `json:"auth"`
 	Gse                GseConfig             `json:"gse"`
 	Job                JobConfig             `json:"job"`
+	Drift              DriftConfig           `json:"drift"`
 	Debug              bool                  `json:"debug"`
 	Version            BCSEdition            `json:"version"`
 	BCSAppConfig       BCSAppConfig          `json:"bcsapp"`
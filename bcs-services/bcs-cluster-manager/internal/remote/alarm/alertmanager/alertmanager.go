@@ -0,0 +1,209 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package alertmanager xxx
+package alertmanager
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/parnurzeal/gorequest"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+// Options alertmanager options
+type Options struct {
+	Enable bool
+	Server string
+	// Token bearer token when alertmanager behind auth proxy
+	Token string
+	// IPLabel alert label which carries host ip
+	IPLabel string
+	// Duration silence duration
+	Duration time.Duration
+	Debug    bool
+}
+
+// AlertManagerClient global alertmanager client
+var AlertManagerClient *Client
+
+// SetAlertManagerClient set alertmanager client
+func SetAlertManagerClient(options Options) error {
+	cli, err := NewClient(options)
+	if err != nil {
+		return err
+	}
+
+	AlertManagerClient = cli
+	return nil
+}
+
+// GetAlertManagerClient get alertmanager client
+func GetAlertManagerClient() *Client {
+	return AlertManagerClient
+}
+
+// NewClient create alertmanager client
+func NewClient(options Options) (*Client, error) {
+	if !options.Enable {
+		return nil, nil
+	}
+	if options.Server == "" {
+		return nil, fmt.Errorf("alertmanager server empty")
+	}
+
+	c := &Client{
+		server:      options.Server,
+		token:       options.Token,
+		ipLabel:     options.IPLabel,
+		duration:    options.Duration,
+		serverDebug: options.Debug,
+	}
+	if c.ipLabel == "" {
+		c.ipLabel = defaultIPLabel
+	}
+	if c.duration <= 0 {
+		c.duration = defaultDuration
+	}
+
+	return c, nil
+}
+
+// Client for alertmanager
+type Client struct {
+	server      string
+	token       string
+	ipLabel     string
+	duration    time.Duration
+	serverDebug bool
+}
+
+func (c *Client) request(method, reqURL string) *gorequest.SuperAgent {
+	agent := gorequest.New().
+		Timeout(alarm.DefaultTimeOut).
+		CustomMethod(method, c.server+reqURL).
+		Set("Content-Type", "application/json").
+		Set("Accept", "application/json").
+		SetDebug(c.serverDebug)
+	if c.token != "" {
+		agent = agent.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return agent
+}
+
+// ShieldHostAlarmConfig create one silence for each host
+func (c *Client) ShieldHostAlarmConfig(user string, config *alarm.ShieldHost) error {
+	if c == nil {
+		return alarm.ErrServerNotInit
+	}
+	if config == nil || len(config.HostList) == 0 {
+		return fmt.Errorf("ShieldHostAlarmConfig hosts empty")
+	}
+
+	reqURL := "/api/v2/silences"
+	silences := buildHostSilences(c.ipLabel, user, c.duration, config)
+	for i := range silences {
+		respData := &PostSilenceResponse{}
+		resp, _, errs := c.request(http.MethodPost, reqURL).
+			Send(silences[i]).
+			EndStruct(respData)
+		if len(errs) > 0 {
+			blog.Errorf("call api ShieldHostAlarmConfig failed: %v", errs[0])
+			return errs[0]
+		}
+		if resp.StatusCode != http.StatusOK {
+			blog.Errorf("call api ShieldHostAlarmConfig failed: %s", resp.Status)
+			return fmt.Errorf("call api ShieldHostAlarmConfig failed: %s", resp.Status)
+		}
+
+		blog.Infof("call api ShieldHostAlarmConfig silence(%s) for matcher(%s) successfully",
+			respData.SilenceID, silences[i].Matchers[0].Value)
+	}
+
+	return nil
+}
+
+// UnShieldHostAlarmConfig expire silences created for hosts
+func (c *Client) UnShieldHostAlarmConfig(user string, config *alarm.ShieldHost) error {
+	if c == nil {
+		return alarm.ErrServerNotInit
+	}
+	if config == nil || len(config.HostList) == 0 {
+		return fmt.Errorf("UnShieldHostAlarmConfig hosts empty")
+	}
+
+	silences, err := c.listSilences()
+	if err != nil {
+		return err
+	}
+
+	for i := range config.HostList {
+		for j := range silences {
+			if !isHostSilence(c.ipLabel, config.HostList[i].IP, silences[j]) {
+				continue
+			}
+			err = c.deleteSilence(silences[j].ID)
+			if err != nil {
+				return err
+			}
+			blog.Infof("call api UnShieldHostAlarmConfig silence(%s) for host(%s) successfully",
+				silences[j].ID, config.HostList[i].IP)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) listSilences() ([]GettableSilence, error) {
+	var (
+		reqURL   = "/api/v2/silences"
+		respData = make([]GettableSilence, 0)
+	)
+
+	resp, _, errs := c.request(http.MethodGet, reqURL).EndStruct(&respData)
+	if len(errs) > 0 {
+		blog.Errorf("call api listSilences failed: %v", errs[0])
+		return nil, errs[0]
+	}
+	if resp.StatusCode != http.StatusOK {
+		blog.Errorf("call api listSilences failed: %s", resp.Status)
+		return nil, fmt.Errorf("call api listSilences failed: %s", resp.Status)
+	}
+
+	return respData, nil
+}
+
+func (c *Client) deleteSilence(id string) error {
+	reqURL := fmt.Sprintf("/api/v2/silence/%s", id)
+
+	resp, _, errs := c.request(http.MethodDelete, reqURL).End()
+	if len(errs) > 0 {
+		blog.Errorf("call api deleteSilence failed: %v", errs[0])
+		return errs[0]
+	}
+	if resp.StatusCode != http.StatusOK {
+		blog.Errorf("call api deleteSilence failed: %s", resp.Status)
+		return fmt.Errorf("call api deleteSilence failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Name for client name
+func (c *Client) Name() string {
+	return alarm.AlertManager
+}
@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+func newFakeAlertManager(t *testing.T, silences *[]GettableSilence, deleted *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+			silence := Silence{}
+			if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+				t.Fatal(err)
+			}
+			silence.ID = fmt.Sprintf("silence-%d", len(*silences))
+			*silences = append(*silences, GettableSilence{Silence: silence,
+				Status: SilenceStatus{State: silenceStateActive}})
+			_ = json.NewEncoder(w).Encode(PostSilenceResponse{SilenceID: silence.ID})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+			_ = json.NewEncoder(w).Encode(silences)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+			*deleted = append(*deleted, strings.TrimPrefix(r.URL.Path, "/api/v2/silence/"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestClient_ShieldHostAlarmConfig(t *testing.T) {
+	var (
+		silences = make([]GettableSilence, 0)
+		deleted  = make([]string, 0)
+	)
+	server := newFakeAlertManager(t, &silences, &deleted)
+	defer server.Close()
+
+	cli, err := NewClient(Options{Enable: true, Server: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hosts := &alarm.ShieldHost{
+		BizID:     "100",
+		ClusterID: "BCS-K8S-00000",
+		HostList:  []alarm.HostInfo{{IP: "127.0.0.1"}, {IP: "127.0.0.2"}},
+	}
+	err = cli.ShieldHostAlarmConfig("admin", hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(silences) != 2 {
+		t.Fatalf("expected 2 silences, got %d", len(silences))
+	}
+	if silences[0].Matchers[0].Name != defaultIPLabel || silences[0].CreatedBy != "admin" {
+		t.Fatalf("unexpected silence %+v", silences[0])
+	}
+
+	// silence not created by cluster-manager
+	silences = append(silences, GettableSilence{
+		Silence: Silence{ID: "other", Matchers: []Matcher{buildHostMatcher(defaultIPLabel, "127.0.0.1")}},
+		Status:  SilenceStatus{State: silenceStateActive},
+	})
+
+	err = cli.UnShieldHostAlarmConfig("admin", &alarm.ShieldHost{
+		HostList: []alarm.HostInfo{{IP: "127.0.0.1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || deleted[0] != silences[0].ID {
+		t.Fatalf("unexpected deleted silences %v", deleted)
+	}
+}
+
+func TestBuildHostMatcher(t *testing.T) {
+	matcher := buildHostMatcher("instance", "10.0.0.1")
+	if matcher.Value != `^10\.0\.0\.1(:\d+)?$` || !matcher.IsRegex || !matcher.IsEqual {
+		t.Fatalf("unexpected matcher %+v", matcher)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package alertmanager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+const (
+	// defaultIPLabel alert label which carries host ip, e.g. node_exporter instance label
+	defaultIPLabel = "instance"
+	// defaultDuration default silence duration
+	defaultDuration = time.Minute * 30
+	// silenceComment comment prefix, used to find silences created by cluster-manager
+	silenceComment = "bcs-cluster-manager shield host alarm"
+	// silenceStateActive active silence state
+	silenceStateActive = "active"
+	// silenceStatePending pending silence state
+	silenceStatePending = "pending"
+)
+
+// Matcher alertmanager silence matcher
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// Silence alertmanager postable silence
+type Silence struct {
+	ID        string    `json:"id,omitempty"`
+	Matchers  []Matcher `json:"matchers"`
+	StartsAt  string    `json:"startsAt"`
+	EndsAt    string    `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+}
+
+// SilenceStatus silence status
+type SilenceStatus struct {
+	State string `json:"state"`
+}
+
+// GettableSilence alertmanager gettable silence
+type GettableSilence struct {
+	Silence
+	Status SilenceStatus `json:"status"`
+}
+
+// PostSilenceResponse create silence response
+type PostSilenceResponse struct {
+	SilenceID string `json:"silenceID"`
+}
+
+// buildHostMatcher build host ip matcher, ip label may be ip or ip:port
+func buildHostMatcher(label, ip string) Matcher {
+	return Matcher{
+		Name:    label,
+		Value:   fmt.Sprintf(`^%s(:\d+)?$`, regexp.QuoteMeta(ip)),
+		IsRegex: true,
+		IsEqual: true,
+	}
+}
+
+// buildComment build silence comment
+func buildComment(config *alarm.ShieldHost) string {
+	return fmt.Sprintf("%s: biz[%s] cluster[%s]", silenceComment, config.BizID, config.ClusterID)
+}
+
+// buildHostSilences build one silence per host
+func buildHostSilences(label, user string, duration time.Duration, config *alarm.ShieldHost) []Silence {
+	var (
+		now      = time.Now().UTC()
+		silences = make([]Silence, 0)
+	)
+	for i := range config.HostList {
+		silences = append(silences, Silence{
+			Matchers:  []Matcher{buildHostMatcher(label, config.HostList[i].IP)},
+			StartsAt:  now.Format(time.RFC3339),
+			EndsAt:    now.Add(duration).Format(time.RFC3339),
+			CreatedBy: user,
+			Comment:   buildComment(config),
+		})
+	}
+
+	return silences
+}
+
+// isHostSilence check silence is created by cluster-manager for ip
+func isHostSilence(label, ip string, silence GettableSilence) bool {
+	if silence.Status.State != silenceStateActive && silence.Status.State != silenceStatePending {
+		return false
+	}
+	if !strings.HasPrefix(silence.Comment, silenceComment) {
+		return false
+	}
+	if len(silence.Matchers) != 1 {
+		return false
+	}
+
+	return silence.Matchers[0] == buildHostMatcher(label, ip)
+}
@@ -154,7 +154,16 @@ func (c *Client) ShieldHostAlarmConfig(user string, config *alarm.ShieldHost) er
 	return nil
 }
 
+// UnShieldHostAlarmConfig clean host alarm shield, shield expires automatically
+func (c *Client) UnShieldHostAlarmConfig(user string, config *alarm.ShieldHost) error {
+	if c == nil {
+		return alarm.ErrServerNotInit
+	}
+
+	return nil
+}
+
 // Name for client name
 func (c *Client) Name() string {
-	return "bk_monitor"
+	return alarm.BkMonitor
 }
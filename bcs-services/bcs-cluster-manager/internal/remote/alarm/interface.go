@@ -22,6 +22,8 @@ import (
 type AlarmInterface interface { // nolint
 	// ShieldHostAlarmConfig shield host alarm
 	ShieldHostAlarmConfig(user string, config *ShieldHost) error
+	// UnShieldHostAlarmConfig clean host alarm shield
+	UnShieldHostAlarmConfig(user string, config *ShieldHost) error
 	// Name client name
 	Name() string
 }
@@ -33,10 +35,23 @@ var (
 	ErrServerNotInit = errors.New("server not inited")
 )
 
+// backend names for alarm clients, cluster/cloud choose alarm backends by these names
+const (
+	// BkTmp bk tmp alarm backend
+	BkTmp = "bk_tmp"
+	// BkMonitor bk monitor alarm backend
+	BkMonitor = "bk_monitor"
+	// AlertManager prometheus alertmanager backend
+	AlertManager = "alertmanager"
+	// Webhook generic webhook backend
+	Webhook = "webhook"
+)
+
 // ShieldHost parameters
 type ShieldHost struct {
-	BizID    string
-	HostList []HostInfo
+	BizID     string
+	ClusterID string
+	HostList  []HostInfo
 }
 
 // HostInfo xxx
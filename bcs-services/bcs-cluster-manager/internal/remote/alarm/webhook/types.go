@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+// action webhook shield action
+type action string
+
+const (
+	// shieldAction shield host alarm
+	shieldAction action = "shield"
+	// unShieldAction clean host alarm shield
+	unShieldAction action = "unshield"
+)
+
+const (
+	// defaultDuration default shield duration
+	defaultDuration = time.Minute * 30
+)
+
+// Host shield host info
+type Host struct {
+	IP      string `json:"ip"`
+	CloudID uint64 `json:"cloudID"`
+}
+
+// ShieldHostRequest webhook request body
+type ShieldHostRequest struct {
+	Action    string `json:"action"`
+	User      string `json:"user"`
+	BizID     string `json:"bizID"`
+	ClusterID string `json:"clusterID"`
+	Hosts     []Host `json:"hosts"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+}
+
+func buildShieldHostRequest(act action, user string, duration time.Duration,
+	config *alarm.ShieldHost) *ShieldHostRequest {
+	now := time.Now()
+	req := &ShieldHostRequest{
+		Action:    string(act),
+		User:      user,
+		BizID:     config.BizID,
+		ClusterID: config.ClusterID,
+		Hosts:     make([]Host, 0),
+		StartTime: now.Unix(),
+		EndTime:   now.Add(duration).Unix(),
+	}
+	for i := range config.HostList {
+		req.Hosts = append(req.Hosts, Host{
+			IP:      config.HostList[i].IP,
+			CloudID: config.HostList[i].CloudID,
+		})
+	}
+
+	return req
+}
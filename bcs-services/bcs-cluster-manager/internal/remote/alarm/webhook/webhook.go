@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webhook xxx
+package webhook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/common/blog"
+	"github.com/parnurzeal/gorequest"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+// Options webhook options
+type Options struct {
+	Enable bool
+	// Server webhook url, receive shield/unshield request
+	Server string
+	// Token bearer token for webhook auth
+	Token string
+	// Duration shield duration
+	Duration time.Duration
+	Debug    bool
+}
+
+// WebhookClient global webhook client
+var WebhookClient *Client // nolint
+
+// SetWebhookClient set webhook client
+func SetWebhookClient(options Options) error {
+	cli, err := NewClient(options)
+	if err != nil {
+		return err
+	}
+
+	WebhookClient = cli
+	return nil
+}
+
+// GetWebhookClient get webhook client
+func GetWebhookClient() *Client {
+	return WebhookClient
+}
+
+// NewClient create webhook client
+func NewClient(options Options) (*Client, error) {
+	if !options.Enable {
+		return nil, nil
+	}
+	if options.Server == "" {
+		return nil, fmt.Errorf("alarm webhook server empty")
+	}
+
+	c := &Client{
+		server:      options.Server,
+		token:       options.Token,
+		duration:    options.Duration,
+		serverDebug: options.Debug,
+	}
+	if c.duration <= 0 {
+		c.duration = defaultDuration
+	}
+
+	return c, nil
+}
+
+// Client for generic alarm webhook
+type Client struct {
+	server      string
+	token       string
+	duration    time.Duration
+	serverDebug bool
+}
+
+// ShieldHostAlarmConfig notify webhook to shield host alarm
+func (c *Client) ShieldHostAlarmConfig(user string, config *alarm.ShieldHost) error {
+	if c == nil {
+		return alarm.ErrServerNotInit
+	}
+
+	return c.send(buildShieldHostRequest(shieldAction, user, c.duration, config))
+}
+
+// UnShieldHostAlarmConfig notify webhook to clean host alarm shield
+func (c *Client) UnShieldHostAlarmConfig(user string, config *alarm.ShieldHost) error {
+	if c == nil {
+		return alarm.ErrServerNotInit
+	}
+
+	return c.send(buildShieldHostRequest(unShieldAction, user, c.duration, config))
+}
+
+func (c *Client) send(req *ShieldHostRequest) error {
+	if len(req.Hosts) == 0 {
+		return fmt.Errorf("alarm webhook %s hosts empty", req.Action)
+	}
+
+	agent := gorequest.New().
+		Timeout(alarm.DefaultTimeOut).
+		Post(c.server).
+		Set("Content-Type", "application/json").
+		Set("Accept", "application/json").
+		SetDebug(c.serverDebug)
+	if c.token != "" {
+		agent = agent.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, _, errs := agent.Send(req).End()
+	if len(errs) > 0 {
+		blog.Errorf("call alarm webhook %s failed: %v", req.Action, errs[0])
+		return errs[0]
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		blog.Errorf("call alarm webhook %s failed: %s", req.Action, resp.Status)
+		return fmt.Errorf("call alarm webhook %s failed: %s", req.Action, resp.Status)
+	}
+
+	blog.Infof("call alarm webhook %s for cluster[%s] hosts successfully", req.Action, req.ClusterID)
+	return nil
+}
+
+// Name for client name
+func (c *Client) Name() string {
+	return alarm.Webhook
+}
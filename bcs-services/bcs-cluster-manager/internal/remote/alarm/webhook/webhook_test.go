@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/remote/alarm"
+)
+
+func TestClient_ShieldHostAlarmConfig(t *testing.T) {
+	requests := make([]ShieldHostRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer xxx" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := ShieldHostRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, req)
+	}))
+	defer server.Close()
+
+	cli, err := NewClient(Options{Enable: true, Server: server.URL, Token: "xxx"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hosts := &alarm.ShieldHost{
+		BizID:     "100",
+		ClusterID: "BCS-K8S-00000",
+		HostList:  []alarm.HostInfo{{IP: "127.0.0.1", CloudID: 0}},
+	}
+	if err = cli.ShieldHostAlarmConfig("admin", hosts); err != nil {
+		t.Fatal(err)
+	}
+	if err = cli.UnShieldHostAlarmConfig("admin", hosts); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Action != string(shieldAction) || requests[1].Action != string(unShieldAction) {
+		t.Fatalf("unexpected actions %s/%s", requests[0].Action, requests[1].Action)
+	}
+	if requests[0].ClusterID != "BCS-K8S-00000" || len(requests[0].Hosts) != 1 ||
+		requests[0].Hosts[0].IP != "127.0.0.1" {
+		t.Fatalf("unexpected request %+v", requests[0])
+	}
+}
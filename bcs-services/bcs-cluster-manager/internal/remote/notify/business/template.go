@@ -88,6 +88,14 @@ const nodegroupScaleInNodesTemplate = `
 操作节点列表: {{ .NodeIPList }}.
 `
 
+// 集群配置漂移默认通知模板
+const clusterDriftTemplate = `
+集群 {{ .ClusterID }} / {{ .ClusterName }} {{ if .NodeGroupID }}节点池 {{ .NodeGroupID }} / {{ .NodeGroupName }} {{ end }}配置与云上不一致.
+巡检时间: {{ .OperatorTime }}.
+漂移字段: {{ .DriftFields }}.
+处理结果: {{ .Result }}.
+`
+
 var (
 	// CreateCluster 创建集群默认通知模板
 	CreateCluster = proto.NotifyData{
@@ -116,6 +124,11 @@ var (
 		Title:   "group_scalein_nodes",
 		Content: nodegroupScaleInNodesTemplate,
 	}
+	// ClusterDrift 集群配置漂移默认通知模版
+	ClusterDrift = proto.NotifyData{
+		Title:   "cluster_drift",
+		Content: clusterDriftTemplate,
+	}
 )
 
 // GetNotifyTemplateContent get notify template render result
@@ -143,6 +156,7 @@ type ExtraParas struct {
 	OperatorTime string
 	Operator     string
 	Result       string
+	DriftFields  string
 }
 
 // NotifierTemplateVars render template vars
@@ -160,6 +174,7 @@ type NotifierTemplateVars struct {
 	OperatorTime string
 	Operator     string
 	Result       string
+	DriftFields  string
 }
 
 // NewNotifierTemplateVars init render struct
@@ -170,13 +185,14 @@ func NewNotifierTemplateVars(cluster *proto.Cluster, group *proto.NodeGroup, ext
 		ClusterName:   cluster.ClusterName,
 		BusinessID:    cluster.BusinessID,
 		Region:        cluster.Region,
-		NodeGroupID:   group.NodeGroupID,
-		NodeGroupName: group.Name,
+		NodeGroupID:   group.GetNodeGroupID(),
+		NodeGroupName: group.GetName(),
 		NodeNum:       extra.NodeNum,
 		NodeIPList:    extra.NodeIPList,
 		OperatorTime:  extra.OperatorTime,
 		Operator:      extra.Operator,
 		Result:        extra.Result,
+		DriftFields:   extra.DriftFields,
 	}
 }
 
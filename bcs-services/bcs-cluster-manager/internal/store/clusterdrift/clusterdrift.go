@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package clusterdrift xxx
+package clusterdrift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"go.mongodb.org/mongo-driver/bson"
+
+	types "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/util"
+)
+
+const (
+
+	//! we don't setting bson tag in proto file,
+	//! all struct key in mongo is lowcase in default
+
+	tableName = "clusterdrift"
+	// ClusterIDKey xxx
+	ClusterIDKey = "clusterid"
+	// NodeGroupIDKey xxx
+	NodeGroupIDKey = "nodegroupid"
+	// ResourceTypeKey xxx
+	ResourceTypeKey = "resourcetype"
+	// StatusKey xxx
+	StatusKey                  = "status"
+	driftIDKey                 = "driftid"
+	defaultClusterDriftListLen = 5000
+)
+
+var (
+	driftIndexes = []drivers.Index{
+		{
+			Name: tableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: driftIDKey, Value: 1},
+			},
+			Unique: true,
+		},
+		{
+			Name: tableName + "_cluster_idx",
+			Key: bson.D{
+				bson.E{Key: ClusterIDKey, Value: 1},
+				bson.E{Key: NodeGroupIDKey, Value: 1},
+			},
+		},
+	}
+)
+
+// ModelClusterDrift database operation for cluster drift
+type ModelClusterDrift struct {
+	tableName           string
+	indexes             []drivers.Index
+	db                  drivers.DB
+	isTableEnsured      bool
+	isTableEnsuredMutex sync.RWMutex
+}
+
+// New create cluster drift model
+func New(db drivers.DB) *ModelClusterDrift {
+	return &ModelClusterDrift{
+		tableName: util.DataTableNamePrefix + tableName,
+		indexes:   driftIndexes,
+		db:        db,
+	}
+}
+
+// ensure table
+func (m *ModelClusterDrift) ensureTable(ctx context.Context) error {
+	m.isTableEnsuredMutex.RLock()
+	if m.isTableEnsured {
+		m.isTableEnsuredMutex.RUnlock()
+		return nil
+	}
+	if err := util.EnsureTable(ctx, m.db, m.tableName, m.indexes); err != nil {
+		m.isTableEnsuredMutex.RUnlock()
+		return err
+	}
+	m.isTableEnsuredMutex.RUnlock()
+
+	m.isTableEnsuredMutex.Lock()
+	m.isTableEnsured = true
+	m.isTableEnsuredMutex.Unlock()
+	return nil
+}
+
+// PutClusterDrift insert or update cluster drift by driftID
+func (m *ModelClusterDrift) PutClusterDrift(ctx context.Context, drift *types.ClusterDrift) error {
+	if drift == nil || drift.DriftID == "" {
+		return fmt.Errorf("clusterDrift to be put cannot be empty")
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		driftIDKey: drift.DriftID,
+	})
+
+	return m.db.Table(m.tableName).Upsert(ctx, cond, operator.M{"$set": drift})
+}
+
+// DeleteClusterDrift delete cluster drift by driftID
+func (m *ModelClusterDrift) DeleteClusterDrift(ctx context.Context, driftID string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		driftIDKey: driftID,
+	})
+	_, err := m.db.Table(m.tableName).Delete(ctx, cond)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteClusterDriftByClusterID delete all drift records of cluster
+func (m *ModelClusterDrift) DeleteClusterDriftByClusterID(ctx context.Context, clusterID string) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		ClusterIDKey: clusterID,
+	})
+	_, err := m.db.Table(m.tableName).Delete(ctx, cond)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListClusterDrift list cluster drift records
+func (m *ModelClusterDrift) ListClusterDrift(ctx context.Context, cond *operator.Condition,
+	opt *options.ListOption) ([]types.ClusterDrift, error) {
+	driftList := make([]types.ClusterDrift, 0)
+
+	finder := m.db.Table(m.tableName).Find(cond)
+	if len(opt.Sort) != 0 {
+		finder = finder.WithSort(util.MapInt2MapIf(opt.Sort))
+	}
+	if opt.Offset != 0 {
+		finder = finder.WithStart(opt.Offset)
+	}
+	if opt.Limit == 0 {
+		finder = finder.WithLimit(defaultClusterDriftListLen)
+	} else {
+		finder = finder.WithLimit(opt.Limit)
+	}
+
+	if err := finder.All(ctx, &driftList); err != nil {
+		return nil, err
+	}
+
+	return driftList, nil
+}
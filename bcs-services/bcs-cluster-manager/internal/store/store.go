@@ -27,6 +27,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/cloudvpc"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/cluster"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clustercredential"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/clusterdrift"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/machinery"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/moduleflag"
 	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/namespace"
@@ -196,6 +197,13 @@ type ClusterManagerModel interface {
 	GetCloudModuleFlag(ctx context.Context, cloudID, version, module, flag string) (*types.CloudModuleFlag, error)
 	ListCloudModuleFlag(ctx context.Context, cond *operator.Condition,
 		opt *options.ListOption) ([]types.CloudModuleFlag, error)
+
+	// cluster drift storage management
+	PutClusterDrift(ctx context.Context, drift *types.ClusterDrift) error
+	DeleteClusterDrift(ctx context.Context, driftID string) error
+	DeleteClusterDriftByClusterID(ctx context.Context, clusterID string) error
+	ListClusterDrift(ctx context.Context, cond *operator.Condition,
+		opt *options.ListOption) ([]types.ClusterDrift, error)
 }
 
 // ModelSet a set of client
@@ -218,6 +226,7 @@ type ModelSet struct {
 	*moduleflag.ModelCloudModuleFlag
 	*machinery.ModelMachineryTask
 	*notifytemplate.ModelNotifyTemplate
+	*clusterdrift.ModelClusterDrift
 }
 
 // NewModelSet create model set
@@ -257,6 +266,7 @@ func NewModelSet(mongoOptions *mongo.Options) (ClusterManagerModel, error) {
 		ModelCloudModuleFlag:   moduleflag.New(db),
 		ModelMachineryTask:     mTaskDb,
 		ModelNotifyTemplate:    notifytemplate.New(db),
+		ModelClusterDrift:      clusterdrift.New(db),
 	}
 
 	return storeClient, nil
@@ -16,6 +16,7 @@ package taskserver
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -84,6 +85,12 @@ func (ts *TaskServer) Init(opt *options.BrokerConfig, backend *cmongo.Options) e
 		blog.Errorf("task server init go-machinery worker failed, %s", err.Error())
 		return err
 	}
+	// canceled or failed task rollback steps dispatch by task server
+	cloudprovider.InitTaskRollbackDispatcher(ts.DispatchRollback)
+	// dag task ready steps dispatch by task server
+	cloudprovider.InitTaskStepDispatcher(ts.DispatchSteps)
+	// sub task created by task steps dispatch by task server
+	cloudprovider.InitTaskDispatcher(ts.Dispatch)
 	return nil
 }
 
@@ -104,6 +111,26 @@ func (ts *TaskServer) Dispatch(task *proto.Task) error {
 		blog.Errorf("task %s/%s is not validate, %s", task.TaskID, task.TaskType, err.Error())
 		return err
 	}
+	// dag task only dispatch steps which dependent steps all done, other steps dispatched when
+	// their dependent steps done
+	if cloudprovider.IsDAGTask(task) {
+		if err := cloudprovider.ValidateTaskDAG(task); err != nil {
+			blog.Errorf("task %s/%s steps dependencies is not validate, %s", task.TaskID, task.TaskType, err.Error())
+			return err
+		}
+		steps := cloudprovider.GetTaskReadySteps(task)
+		if len(steps) == 0 {
+			blog.Errorf("task %s/%s has no steps ready to dispatch", task.TaskID, task.TaskType)
+			return fmt.Errorf("task %s has no steps ready to dispatch", task.TaskID)
+		}
+		// mark ready steps before dispatching, avoid steps dispatched again by other done steps
+		cloudprovider.MarkTaskStepsInit(task, steps)
+		if err := cloudprovider.GetStorageModel().UpdateTask(context.Background(), task); err != nil {
+			blog.Errorf("task %s/%s update ready steps %v failed, %s", task.TaskID, task.TaskType, steps, err.Error())
+			return err
+		}
+		return ts.DispatchSteps(task, steps)
+	}
 	// create all task to signature
 	blog.Infof("task %s/%s with steps %v ready to dispatch worker", task.TaskID, task.TaskType, task.StepSequence)
 	var signatures []*tasks.Signature
@@ -149,6 +176,84 @@ func (ts *TaskServer) Dispatch(task *proto.Task) error {
 	return nil
 }
 
+// DispatchSteps dispatch dag task steps to worker, steps run concurrently
+func (ts *TaskServer) DispatchSteps(task *proto.Task, steps []string) error {
+	if len(steps) == 0 {
+		blog.Errorf("task %s/%s has no steps ready to dispatch", task.TaskID, task.TaskType)
+		return fmt.Errorf("task %s has no steps ready to dispatch", task.TaskID)
+	}
+
+	blog.Infof("task %s/%s with concurrent steps %v ready to dispatch worker", task.TaskID, task.TaskType, steps)
+	var signatures []*tasks.Signature
+	for _, stepName := range steps {
+		step, ok := task.Steps[stepName]
+		if !ok {
+			return fmt.Errorf("task lost step %s execution information", stepName)
+		}
+		signature := &tasks.Signature{
+			UUID: fmt.Sprintf("task-%s-%s", task.TaskID, stepName),
+			Name: step.TaskMethod,
+			// two parameters: taskID, stepName
+			Args:                        []tasks.Arg{{Type: "string", Value: task.TaskID}, {Type: "string", Value: stepName}},
+			IgnoreWhenTaskNotRegistered: true,
+		}
+		signatures = append(signatures, signature)
+	}
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	// sending to workers
+	group, _ := tasks.NewGroup(signatures...)
+	// NOCC:vet/vet(设计如此)
+	cxt, cancelCtx := context.WithCancel(ts.cxt)
+	defer cancelCtx()
+	_, err := ts.server.SendGroupWithContext(cxt, group, len(signatures))
+	if err != nil {
+		blog.Errorf("sending task %s steps %v to worker failed: %s", task.TaskID, steps, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DispatchRollback dispatch task rollback steps to worker, rollback steps run by reverse order
+func (ts *TaskServer) DispatchRollback(task *proto.Task) error {
+	if err := validateRollbackTask(task); err != nil {
+		blog.Errorf("task %s/%s rollback is not validate, %s", task.TaskID, task.TaskType, err.Error())
+		return err
+	}
+	steps := strings.Split(task.CommonParams[cloudprovider.RollbackStepsKey.String()], ",")
+
+	blog.Infof("task %s/%s with rollback steps %v ready to dispatch worker", task.TaskID, task.TaskType, steps)
+	var signatures []*tasks.Signature
+	for _, stepName := range steps {
+		step := task.Steps[stepName]
+		signature := &tasks.Signature{
+			UUID: fmt.Sprintf("rollback-%s-%s", task.TaskID, stepName),
+			Name: step.RollbackMethod,
+			// two parameters: taskID, stepName
+			Args:                        []tasks.Arg{{Type: "string", Value: task.TaskID}, {Type: "string", Value: stepName}},
+			IgnoreWhenTaskNotRegistered: true,
+		}
+		signatures = append(signatures, signature)
+	}
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	// sending to workers
+	chain, _ := tasks.NewChain(signatures...)
+	// NOCC:vet/vet(设计如此)
+	cxt, cancelCtx := context.WithCancel(ts.cxt)
+	defer cancelCtx()
+	_, err := ts.server.SendChainWithContext(cxt, chain)
+	if err != nil {
+		blog.Errorf("sending task %s rollback steps to worker failed: %s", task.TaskID, err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (ts *TaskServer) validateOption() error {
 	if len(ts.backendOption.Username) == 0 || len(ts.backendOption.Password) == 0 {
 		return fmt.Errorf("backend lost username or password")
@@ -187,6 +292,11 @@ func (ts *TaskServer) initServer() error {
 	lock := eager.New()
 	ts.server = machinery.NewServer(config, broker, backend, lock)
 
+	return ts.registerTasks()
+}
+
+// registerTasks register all cloud actions and common actions to server
+func (ts *TaskServer) registerTasks() error {
 	// get all cloud actions for registry
 	allTasks := make(map[string]interface{})
 	for _, mgr := range cloudprovider.GetAllTaskManager() {
@@ -208,7 +318,7 @@ func (ts *TaskServer) initServer() error {
 		}
 		allTasks[name] = action
 	}
-	if err = ts.server.RegisterTasks(allTasks); err != nil {
+	if err := ts.server.RegisterTasks(allTasks); err != nil {
 		blog.Errorf("task server register tasks failed, %s", err.Error())
 		return err
 	}
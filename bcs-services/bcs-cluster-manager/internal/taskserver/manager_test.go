@@ -0,0 +1,219 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package taskserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	machinery "github.com/RichardKnop/machinery/v2"
+	backend "github.com/RichardKnop/machinery/v2/backends/eager"
+	broker "github.com/RichardKnop/machinery/v2/brokers/eager"
+	"github.com/RichardKnop/machinery/v2/config"
+	"github.com/RichardKnop/machinery/v2/locks/eager"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	pb "github.com/golang/protobuf/proto"
+
+	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
+	// init mock cloud task manager
+	_ "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider/mock/api"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/store/options"
+)
+
+// mockModel storage for tasks, cloud, cluster and nodes
+type mockModel struct {
+	store.ClusterManagerModel
+	mu       sync.Mutex
+	cloud    *proto.Cloud
+	clusters map[string]*proto.Cluster
+	nodes    map[string]*proto.Node
+	tasks    map[string]*proto.Task
+}
+
+func (m *mockModel) GetCloud(ctx context.Context, cloudID string) (*proto.Cloud, error) {
+	return m.GetCloudByProvider(ctx, cloudID)
+}
+
+func (m *mockModel) GetCloudByProvider(ctx context.Context, provider string) (*proto.Cloud, error) {
+	if provider != m.cloud.CloudProvider {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(m.cloud).(*proto.Cloud), nil
+}
+
+func (m *mockModel) GetCluster(ctx context.Context, clusterID string) (*proto.Cluster, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cls, ok := m.clusters[clusterID]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(cls).(*proto.Cluster), nil
+}
+
+func (m *mockModel) UpdateCluster(ctx context.Context, cluster *proto.Cluster) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clusters[cluster.ClusterID] = pb.Clone(cluster).(*proto.Cluster)
+	return nil
+}
+
+func (m *mockModel) GetNodeByIP(ctx context.Context, ip string) (*proto.Node, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	node, ok := m.nodes[ip]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(node).(*proto.Node), nil
+}
+
+func (m *mockModel) UpdateNode(ctx context.Context, node *proto.Node) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[node.InnerIP] = pb.Clone(node).(*proto.Node)
+	return nil
+}
+
+func (m *mockModel) GetTask(ctx context.Context, taskID string) (*proto.Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil, drivers.ErrTableRecordNotFound
+	}
+	return pb.Clone(task).(*proto.Task), nil
+}
+
+func (m *mockModel) UpdateTask(ctx context.Context, task *proto.Task) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tasks[task.TaskID] = pb.Clone(task).(*proto.Task)
+	return nil
+}
+
+func (m *mockModel) ListNotifyTemplate(ctx context.Context, cond *operator.Condition, opt *options.ListOption) (
+	[]proto.NotifyTemplate, error) {
+	return nil, nil
+}
+
+// newEagerTaskServer create task server which run tasks in-process by go-machinery eager mode
+func newEagerTaskServer(t *testing.T) *TaskServer {
+	cxt, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ts := &TaskServer{cxt: cxt, cancel: cancel, lock: &sync.Mutex{}}
+
+	b := broker.New()
+	ts.server = machinery.NewServer(&config.Config{DefaultQueue: "test"}, b, backend.New(), eager.New())
+	if err := ts.registerTasks(); err != nil {
+		t.Fatalf("register tasks failed: %v", err)
+	}
+	ts.worker = ts.server.NewWorker("test", 0)
+	b.(broker.Mode).AssignWorker(ts.worker)
+
+	cloudprovider.InitTaskRollbackDispatcher(ts.DispatchRollback)
+	cloudprovider.InitTaskStepDispatcher(ts.DispatchSteps)
+	cloudprovider.InitTaskDispatcher(ts.Dispatch)
+	t.Cleanup(func() {
+		cloudprovider.InitTaskRollbackDispatcher(nil)
+		cloudprovider.InitTaskStepDispatcher(nil)
+		cloudprovider.InitTaskDispatcher(nil)
+	})
+	return ts
+}
+
+func TestDispatchMockCreateClusterTask(t *testing.T) {
+	cloud := &proto.Cloud{
+		CloudID:       api.CloudProvider,
+		CloudProvider: api.CloudProvider,
+		ConfInfo:      &proto.CloudConfigInfo{},
+	}
+	cls := &proto.Cluster{
+		ClusterID:   "BCS-K8S-90001",
+		ClusterName: "mock-cluster",
+		Provider:    api.CloudProvider,
+		Region:      api.DefaultRegion,
+		VpcID:       api.DefaultVpcID,
+		ProjectID:   "project",
+	}
+	nodeIPs := []string{"192.168.100.1", "192.168.100.2"}
+	model := &mockModel{
+		cloud:    cloud,
+		clusters: map[string]*proto.Cluster{cls.ClusterID: cls},
+		nodes:    make(map[string]*proto.Node),
+		tasks:    make(map[string]*proto.Task),
+	}
+	for _, ip := range nodeIPs {
+		model.nodes[ip] = &proto.Node{InnerIP: ip, ClusterID: cls.ClusterID}
+	}
+	cloudprovider.InitStorageModel(model)
+	defer cloudprovider.InitStorageModel(nil)
+
+	ts := newEagerTaskServer(t)
+
+	mgr, err := cloudprovider.GetTaskManager(api.CloudProvider)
+	if err != nil {
+		t.Fatalf("get mock task manager failed: %v", err)
+	}
+	task, err := mgr.BuildCreateClusterTask(cls, &cloudprovider.CreateClusterOption{
+		CommonOption: cloudprovider.CommonOption{Region: cls.Region},
+		Operator:     "admin",
+		Cloud:        cloud,
+		WorkerNodes:  nodeIPs,
+	})
+	if err != nil {
+		t.Fatalf("build create cluster task failed: %v", err)
+	}
+	if err = model.UpdateTask(context.Background(), task); err != nil {
+		t.Fatalf("store task failed: %v", err)
+	}
+
+	// eager mode runs all steps before dispatch returns
+	if err = ts.Dispatch(task); err != nil {
+		t.Fatalf("dispatch task failed: %v", err)
+	}
+
+	stored, _ := model.GetTask(context.Background(), task.TaskID)
+	for _, name := range stored.StepSequence {
+		if status := stored.Steps[name].Status; status != cloudprovider.TaskStatusSuccess {
+			t.Fatalf("step %s status %s, message %s", name, status, stored.Steps[name].Message)
+		}
+	}
+	if stored.Status != cloudprovider.TaskStatusSuccess {
+		t.Fatalf("task status %s, message %s", stored.Status, stored.Message)
+	}
+
+	created, _ := model.GetCluster(context.Background(), cls.ClusterID)
+	if created.SystemID == "" {
+		t.Fatalf("cluster systemID not updated")
+	}
+	mockCls, err := api.GetMockCloud().DescribeCluster(created.SystemID, time.Duration(0))
+	if err != nil {
+		t.Fatalf("mock cloud cluster %s not found: %v", created.SystemID, err)
+	}
+	if len(mockCls.InstanceIDs) != len(nodeIPs) {
+		t.Fatalf("mock cluster instances %v, want %d", mockCls.InstanceIDs, len(nodeIPs))
+	}
+	for _, ip := range nodeIPs {
+		node, _ := model.GetNodeByIP(context.Background(), ip)
+		if node.NodeID == "" {
+			t.Fatalf("node %s cloud info not updated", ip)
+		}
+	}
+}
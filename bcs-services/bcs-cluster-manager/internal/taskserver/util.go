@@ -14,8 +14,10 @@ package taskserver
 
 import (
 	"fmt"
+	"strings"
 
 	proto "github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/api/clustermanager"
+	"github.com/Tencent/bk-bcs/bcs-services/bcs-cluster-manager/internal/cloudprovider"
 )
 
 func validateTask(task *proto.Task) error {
@@ -43,3 +45,23 @@ func validateTask(task *proto.Task) error {
 	}
 	return nil
 }
+
+func validateRollbackTask(task *proto.Task) error {
+	if task == nil || len(task.Steps) == 0 || len(task.TaskID) == 0 || task.CommonParams == nil {
+		return fmt.Errorf("lost task execution information")
+	}
+	rollbackSteps := task.CommonParams[cloudprovider.RollbackStepsKey.String()]
+	if len(rollbackSteps) == 0 {
+		return fmt.Errorf("task lost rollback steps information")
+	}
+	for _, stepName := range strings.Split(rollbackSteps, ",") {
+		step, ok := task.Steps[stepName]
+		if !ok {
+			return fmt.Errorf("task lost step %s execution information", stepName)
+		}
+		if len(step.RollbackMethod) == 0 {
+			return fmt.Errorf("step %s lost taskserver registered rollback task information", stepName)
+		}
+	}
+	return nil
+}
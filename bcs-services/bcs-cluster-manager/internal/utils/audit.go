@@ -145,6 +145,15 @@ var auditFuncMap = map[string]func(req server.Request, rsp interface{}) (audit.R
 			ProjectCode:  getProjectIDByCluster(res.ProjectID, res.ClusterID),
 		}, audit.Action{ActionID: "cluster_delete", ActivityType: audit.ActivityTypeDelete}
 	},
+	"ClusterManager.UpgradeCluster": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
+		res := getResourceID(req)
+		// return the resource to be recorded.
+		return audit.Resource{
+			ResourceType: audit.ResourceTypeCluster, ResourceID: res.ClusterID, ResourceName: res.ClusterID,
+			ResourceData: res.toMap(),
+			ProjectCode:  getProjectIDByCluster(res.ProjectID, res.ClusterID),
+		}, audit.Action{ActionID: "cluster_manage", ActivityType: audit.ActivityTypeUpdate}
+	},
 	"ClusterManager.AddNodesToCluster": func(req server.Request, rsp interface{}) (audit.Resource, audit.Action) {
 		res := getResourceID(req)
 		// return the resource to be recorded.
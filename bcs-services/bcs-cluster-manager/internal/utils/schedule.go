@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// maxScheduleFireLoop max loop count for searching schedule fire time
+	maxScheduleFireLoop = 1000
+)
+
+// ParseCronSchedule parse standard 5 fields cron spec in timeZone, empty timeZone means UTC
+func ParseCronSchedule(spec, timeZone string) (cron.Schedule, error) {
+	if timeZone == "" {
+		timeZone = time.UTC.String()
+	}
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, fmt.Errorf("invalid timeZone %s: %v", timeZone, err)
+	}
+
+	sched, err := cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", timeZone, spec))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron %s: %v", spec, err)
+	}
+
+	return sched, nil
+}
+
+// GetScheduleFireTime get the latest fire time of schedule in (since, now], return zero time if not fired
+func GetScheduleFireTime(sched cron.Schedule, since, now time.Time) time.Time {
+	var fireTime time.Time
+	if sched == nil || !now.After(since) {
+		return fireTime
+	}
+
+	next := sched.Next(since)
+	for i := 0; i < maxScheduleFireLoop && !next.IsZero() && !next.After(now); i++ {
+		fireTime = next
+		next = sched.Next(next)
+	}
+
+	return fireTime
+}
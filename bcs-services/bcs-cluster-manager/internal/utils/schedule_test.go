@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseCronSchedule test ParseCronSchedule
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		spec     string
+		timeZone string
+		wantErr  bool
+	}{
+		{spec: "0 8 * * 1-5", timeZone: "", wantErr: false},
+		{spec: "0 22 * * *", timeZone: "Asia/Shanghai", wantErr: false},
+		{spec: "0 8 * *", timeZone: "", wantErr: true},
+		{spec: "0 8 * * *", timeZone: "Invalid/Zone", wantErr: true},
+	}
+
+	for _, test := range tests {
+		_, err := ParseCronSchedule(test.spec, test.timeZone)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseCronSchedule(%s, %s) err %v, wantErr %v", test.spec, test.timeZone, err, test.wantErr)
+		}
+	}
+}
+
+// TestGetScheduleFireTime test GetScheduleFireTime
+func TestGetScheduleFireTime(t *testing.T) {
+	sched, err := ParseCronSchedule("0 8 * * 1-5", "Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("ParseCronSchedule failed: %v", err)
+	}
+
+	// 2024-01-08 is Monday, 08:00 Asia/Shanghai is 00:00 UTC
+	fire := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		since time.Time
+		now   time.Time
+		want  time.Time
+	}{
+		{since: fire.Add(-time.Minute), now: fire.Add(time.Second), want: fire},
+		{since: fire, now: fire.Add(time.Minute), want: time.Time{}},
+		{since: fire.Add(-2 * time.Minute), now: fire.Add(-time.Minute), want: time.Time{}},
+		// saturday is skipped, next fire is monday
+		{since: fire.Add(-72 * time.Hour), now: fire.Add(time.Minute), want: fire},
+	}
+
+	for _, test := range tests {
+		got := GetScheduleFireTime(sched, test.since, test.now)
+		if !got.Equal(test.want) {
+			t.Errorf("GetScheduleFireTime(%v, %v) = %v, want %v", test.since, test.now, got, test.want)
+		}
+	}
+}
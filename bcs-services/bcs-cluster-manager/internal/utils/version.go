@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// MaxKubeletMinorVersionSkew kubelet may be up to two minor versions older than kube-apiserver
+	MaxKubeletMinorVersionSkew = 2
+)
+
+// ParseKubeVersion parse kubernetes version, support format like 1.20.6 / v1.20.6 / v1.20.6-tke.1
+func ParseKubeVersion(v string) (*version.Version, error) {
+	if v == "" {
+		return nil, fmt.Errorf("kubernetes version empty")
+	}
+	parsed, err := version.ParseGeneric(v)
+	if err != nil {
+		return nil, fmt.Errorf("parse kubernetes version[%s] failed: %v", v, err)
+	}
+
+	return parsed, nil
+}
+
+// CheckKubeVersionUpgrade check cluster control plane upgrade version skew, target version must be
+// newer than current version and control plane only upgrade one minor version at a time
+func CheckKubeVersionUpgrade(current, target string) error {
+	currentVersion, err := ParseKubeVersion(current)
+	if err != nil {
+		return err
+	}
+	targetVersion, err := ParseKubeVersion(target)
+	if err != nil {
+		return err
+	}
+
+	if currentVersion.Major() != targetVersion.Major() {
+		return fmt.Errorf("cluster version[%s] not support upgrade to major version[%s]", current, target)
+	}
+	if !currentVersion.LessThan(targetVersion) {
+		return fmt.Errorf("cluster upgrade version[%s] must be newer than current version[%s]", target, current)
+	}
+	if targetVersion.Minor() > currentVersion.Minor()+1 {
+		return fmt.Errorf("cluster version[%s] only support upgrade one minor version at a time, target[%s]",
+			current, target)
+	}
+
+	return nil
+}
+
+// CheckKubeletVersionSkew check kubelet version skew with kube-apiserver, kubelet must not be newer
+// than kube-apiserver and may be up to two minor versions older
+func CheckKubeletVersionSkew(apiServer, kubelet string) error {
+	apiServerVersion, err := ParseKubeVersion(apiServer)
+	if err != nil {
+		return err
+	}
+	kubeletVersion, err := ParseKubeVersion(kubelet)
+	if err != nil {
+		return err
+	}
+
+	if apiServerVersion.Major() != kubeletVersion.Major() {
+		return fmt.Errorf("kubelet version[%s] major version mismatch apiServer[%s]", kubelet, apiServer)
+	}
+	if kubeletVersion.Minor() > apiServerVersion.Minor() {
+		return fmt.Errorf("kubelet version[%s] is newer than apiServer version[%s]", kubelet, apiServer)
+	}
+	if apiServerVersion.Minor()-kubeletVersion.Minor() > MaxKubeletMinorVersionSkew {
+		return fmt.Errorf("kubelet version[%s] is older than apiServer version[%s] more than %d minor versions",
+			kubelet, apiServer, MaxKubeletMinorVersionSkew)
+	}
+
+	return nil
+}
+
+// IsKubeVersionAtLeast check current kubernetes version at least target version
+func IsKubeVersionAtLeast(current, target string) bool {
+	currentVersion, err := ParseKubeVersion(current)
+	if err != nil {
+		return false
+	}
+	targetVersion, err := ParseKubeVersion(target)
+	if err != nil {
+		return false
+	}
+
+	return currentVersion.AtLeast(targetVersion)
+}
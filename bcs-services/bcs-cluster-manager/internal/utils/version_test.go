@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package utils
+
+import (
+	"testing"
+)
+
+// TestCheckKubeVersionUpgrade test CheckKubeVersionUpgrade
+func TestCheckKubeVersionUpgrade(t *testing.T) {
+	tests := []struct {
+		current string
+		target  string
+		wantErr bool
+	}{
+		{current: "1.20.6", target: "1.22.5", wantErr: true},
+		{current: "1.20.6", target: "1.21.4", wantErr: false},
+		{current: "v1.20.6-tke.1", target: "1.20.7", wantErr: false},
+		{current: "1.20.6", target: "1.20.6", wantErr: true},
+		{current: "1.22.5", target: "1.20.6", wantErr: true},
+		{current: "1.20.6", target: "2.0.0", wantErr: true},
+		{current: "", target: "1.20.6", wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := CheckKubeVersionUpgrade(test.current, test.target)
+		if (err != nil) != test.wantErr {
+			t.Errorf("CheckKubeVersionUpgrade(%s, %s) err %v, wantErr %v", test.current, test.target,
+				err, test.wantErr)
+		}
+	}
+}
+
+// TestCheckKubeletVersionSkew test CheckKubeletVersionSkew
+func TestCheckKubeletVersionSkew(t *testing.T) {
+	tests := []struct {
+		apiServer string
+		kubelet   string
+		wantErr   bool
+	}{
+		{apiServer: "1.22.5", kubelet: "v1.20.6", wantErr: false},
+		{apiServer: "1.22.5", kubelet: "v1.19.3", wantErr: true},
+		{apiServer: "1.20.6", kubelet: "v1.21.4", wantErr: true},
+		{apiServer: "1.20.6-tke.1", kubelet: "v1.20.6-tke.1", wantErr: false},
+	}
+
+	for _, test := range tests {
+		err := CheckKubeletVersionSkew(test.apiServer, test.kubelet)
+		if (err != nil) != test.wantErr {
+			t.Errorf("CheckKubeletVersionSkew(%s, %s) err %v, wantErr %v", test.apiServer, test.kubelet,
+				err, test.wantErr)
+		}
+	}
+}
@@ -50,6 +50,7 @@ import (
 	basicHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/basic"
 	configHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/config"
 	customResHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/customresource"
+	eventHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/event"
 	hpaHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/hpa"
 	multiHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/multicluster"
 	nsHdlr "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler/namespace"
@@ -221,6 +222,9 @@ func (crSvc *clusterResourcesService) initHandler() error { // nolint:cyclop
 	if err := clusterRes.RegisterHPAHandler(crSvc.microSvc.Server(), hpaHdlr.New()); err != nil {
 		return err
 	}
+	if err := clusterRes.RegisterEventHandler(crSvc.microSvc.Server(), eventHdlr.New()); err != nil {
+		return err
+	}
 	if err := clusterRes.RegisterCustomResHandler(crSvc.microSvc.Server(), customResHdlr.New()); err != nil {
 		return err
 	}
@@ -326,6 +330,7 @@ func (crSvc *clusterResourcesService) initHTTPService() error {
 		clusterRes.RegisterWorkloadGwFromEndpoint, clusterRes.RegisterNetworkGwFromEndpoint,
 		clusterRes.RegisterConfigGwFromEndpoint, clusterRes.RegisterStorageGwFromEndpoint,
 		clusterRes.RegisterRBACGwFromEndpoint, clusterRes.RegisterHPAGwFromEndpoint,
+		clusterRes.RegisterEventGwFromEndpoint,
 		clusterRes.RegisterCustomResGwFromEndpoint, clusterRes.RegisterResourceGwFromEndpoint,
 		clusterRes.RegisterViewConfigGwFromEndpoint, clusterRes.RegisterMultiClusterGwFromEndpoint,
 		clusterRes.RegisterTemplateSetGwFromEndpoint,
@@ -143,6 +143,7 @@ func (e *EnvManageAction) Create(ctx context.Context, req *clusterRes.CreateEnvM
 		Env:               req.GetEnv(),
 		ProjectCode:       p.Code,
 		ClusterNamespaces: protoClusterNamespacesToEntity(req.GetClusterNamespaces()),
+		Variables:         protoVariablesToEntity(req.GetVariables()),
 	}
 	id, err := e.model.CreateEnvManage(ctx, envManage)
 	if err != nil {
@@ -190,6 +191,7 @@ func (e *EnvManageAction) Update(ctx context.Context, req *clusterRes.UpdateEnvM
 	updateEnvManage := entity.M{
 		"clusterNamespaces": protoClusterNamespacesToEntity(req.GetClusterNamespaces()),
 		"env":               req.GetEnv(),
+		"variables":         protoVariablesToEntity(req.GetVariables()),
 	}
 	if err := e.model.UpdateEnvManage(ctx, req.GetId(), updateEnvManage); err != nil {
 		return err
@@ -279,3 +281,14 @@ func protoClusterNamespacesToEntity(ns []*clusterRes.ClusterNamespaces) []entity
 	}
 	return result
 }
+
+// protoVariablesToEntity 转换环境变量
+func protoVariablesToEntity(vars []*clusterRes.EnvVariable) []entity.EnvVariable {
+	result := make([]entity.EnvVariable, 0)
+	for _, v := range vars {
+		result = append(result, entity.EnvVariable{
+			Key:   v.Key,
+			Value: v.Value})
+	}
+	return result
+}
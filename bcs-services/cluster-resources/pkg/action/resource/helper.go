@@ -13,6 +13,8 @@
 package resource
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/slice"
 )
@@ -30,3 +32,11 @@ func isScalable(kind string) bool {
 func isReschedulable(kind string) bool {
 	return slice.StringInSlice(kind, ReschedulableResKinds)
 }
+
+// GenDryRunOpts 生成 Create/Update Options 中的 dry-run 参数
+func GenDryRunOpts(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
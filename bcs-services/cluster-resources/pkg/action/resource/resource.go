@@ -130,6 +130,14 @@ func (m *ResMgr) Update(
 	return resp.BuildUpdateAPIResp(ctx, m.ClusterID, m.Kind, m.GroupVersion, namespace, name, manifest, opts)
 }
 
+// DryRunApply 以 dry-run 方式下发 manifest（已存在则更新，否则创建），返回与集群中资源的差异及校验错误
+func (m *ResMgr) DryRunApply(ctx context.Context, manifest map[string]interface{}) (*structpb.Struct, error) {
+	if err := m.checkAccess(ctx, "", manifest); err != nil {
+		return nil, err
+	}
+	return resp.BuildDryRunApplyAPIResp(ctx, m.ClusterID, m.Kind, manifest)
+}
+
 // Scale 对某个资源进行扩缩容
 func (m *ResMgr) Scale(
 	ctx context.Context, namespace, name string, replicas int64, opts metav1.PatchOptions,
@@ -180,6 +188,16 @@ func (m *ResMgr) Reschedule(ctx context.Context, namespace, name, labelSelector
 	return podCli.BatchDelete(ctx, namespace, allowReschedulePodNames, metav1.DeleteOptions{})
 }
 
+// Patch 以 Patch 的方式更新某个 k8s 资源
+func (m *ResMgr) Patch(
+	ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions,
+) (*structpb.Struct, error) {
+	if err := m.checkAccess(ctx, namespace, nil); err != nil {
+		return nil, err
+	}
+	return resp.BuildPatchAPIResp(ctx, m.ClusterID, m.Kind, m.GroupVersion, namespace, name, pt, data, opts)
+}
+
 // Delete 删除某个 k8s 资源
 func (m *ResMgr) Delete(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
 	if err := m.checkAccess(ctx, namespace, nil); err != nil {
@@ -0,0 +1,212 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resp
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	cli "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/client"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/pbstruct"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/slice"
+)
+
+// DryRunIgnoredPaths dry-run 对比差异时需要忽略的字段（由集群维护，与用户变更无关）
+var DryRunIgnoredPaths = []string{
+	"metadata.managedFields", "metadata.resourceVersion", "metadata.generation", "status",
+}
+
+// IsDryRun 判断 Create/Update Options 中是否指定了 dry-run
+func IsDryRun(dryRun []string) bool {
+	return slice.StringInSlice(metav1.DryRunAll, dryRun)
+}
+
+// BuildDryRunCreateAPIResp 以 dry-run 方式创建资源，返回与集群中同名资源（若存在）的差异及校验错误
+func BuildDryRunCreateAPIResp(
+	ctx context.Context,
+	resCli *cli.ResClient,
+	manifest map[string]interface{},
+	isNSScoped bool,
+	opts metav1.CreateOptions,
+) (*structpb.Struct, error) {
+	namespace := ""
+	if isNSScoped {
+		namespace = mapx.GetStr(manifest, "metadata.namespace")
+	}
+	// 使用 generateName 时无法确定资源名称，视为全新资源
+	liveManifest := map[string]interface{}{}
+	if name := mapx.GetStr(manifest, "metadata.name"); name != "" {
+		var err error
+		if liveManifest, err = getLiveManifest(ctx, resCli, namespace, name); err != nil {
+			return nil, err
+		}
+	}
+	ret, err := resCli.Create(ctx, manifest, isNSScoped, opts)
+	return genDryRunAPIResp(liveManifest, ret, err)
+}
+
+// BuildDryRunUpdateAPIResp 以 dry-run 方式更新资源，返回与集群中现有资源的差异及校验错误
+func BuildDryRunUpdateAPIResp(
+	ctx context.Context,
+	resCli *cli.ResClient,
+	namespace, name string,
+	liveManifest, manifest map[string]interface{},
+	opts metav1.UpdateOptions,
+) (*structpb.Struct, error) {
+	if liveManifest == nil {
+		var err error
+		if liveManifest, err = getLiveManifest(ctx, resCli, namespace, name); err != nil {
+			return nil, err
+		}
+	}
+	ret, err := resCli.Update(ctx, namespace, name, manifest, opts)
+	return genDryRunAPIResp(liveManifest, ret, err)
+}
+
+// BuildDryRunApplyAPIResp 以 dry-run 方式下发资源，集群中已存在同名资源则更新，否则创建
+func BuildDryRunApplyAPIResp(
+	ctx context.Context, clusterID, resKind string, manifest map[string]interface{},
+) (*structpb.Struct, error) {
+	clusterConf := res.NewClusterConf(clusterID)
+	k8sRes, err := res.GetGroupVersionResource(
+		ctx, clusterConf, resKind, mapx.GetStr(manifest, "apiVersion"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	resCli := cli.NewResClient(clusterConf, k8sRes)
+
+	namespace, name := mapx.GetStr(manifest, "metadata.namespace"), mapx.GetStr(manifest, "metadata.name")
+	liveManifest, err := getLiveManifest(ctx, resCli, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(liveManifest) == 0 {
+		return BuildDryRunCreateAPIResp(
+			ctx, resCli, manifest, namespace != "", metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}},
+		)
+	}
+	// 表单渲染结果不包含 resourceVersion，需使用集群中的最新值，否则更新会失败
+	if err = mapx.SetItems(
+		manifest, "metadata.resourceVersion", mapx.GetStr(liveManifest, "metadata.resourceVersion"),
+	); err != nil {
+		return nil, err
+	}
+	return BuildDryRunUpdateAPIResp(
+		ctx, resCli, namespace, name, liveManifest, manifest, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}},
+	)
+}
+
+// getLiveManifest 获取集群中的资源配置，不存在则返回空配置
+func getLiveManifest(
+	ctx context.Context, resCli *cli.ResClient, namespace, name string,
+) (map[string]interface{}, error) {
+	ret, err := resCli.Get(ctx, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+	return ret.UnstructuredContent(), nil
+}
+
+// genDryRunAPIResp 根据 dry-run 结果生成响应数据，集群返回的校验 / 准入错误作为结果的一部分返回
+func genDryRunAPIResp(
+	liveManifest map[string]interface{}, ret *unstructured.Unstructured, err error,
+) (*structpb.Struct, error) {
+	if err != nil {
+		var status apierrors.APIStatus
+		if !errors.As(err, &status) {
+			return nil, err
+		}
+		return pbstruct.Map2pbStruct(map[string]interface{}{
+			"dryRun":   true,
+			"passed":   false,
+			"manifest": map[string]interface{}{},
+			"diffs":    []interface{}{},
+			"errors":   GenDryRunErrors(status.Status()),
+		})
+	}
+	manifest := ret.UnstructuredContent()
+	return pbstruct.Map2pbStruct(map[string]interface{}{
+		"dryRun":   true,
+		"passed":   true,
+		"manifest": manifest,
+		"diffs":    GenDryRunDiffs(liveManifest, manifest),
+		"errors":   []interface{}{},
+	})
+}
+
+// GenDryRunDiffs 对比集群中的资源配置与 dry-run 结果，忽略集群维护的字段，按字段路径排序后返回
+func GenDryRunDiffs(liveManifest, dryRunManifest map[string]interface{}) []interface{} {
+	diffRets := mapx.NewDiffer(pruneDryRunManifest(liveManifest), pruneDryRunManifest(dryRunManifest)).Do()
+	sort.Slice(diffRets, func(i, j int) bool {
+		return diffRets[i].Dotted < diffRets[j].Dotted
+	})
+	diffs := []interface{}{}
+	for _, r := range diffRets {
+		diffs = append(diffs, map[string]interface{}{
+			"action": r.Action,
+			"path":   r.Dotted,
+			"oldVal": r.OldVal,
+			"newVal": r.NewVal,
+		})
+	}
+	return diffs
+}
+
+// GenDryRunErrors 将集群返回的错误（校验失败，准入控制拒绝等）转换成错误列表
+func GenDryRunErrors(status metav1.Status) []interface{} {
+	errs := []interface{}{}
+	if status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			errs = append(errs, map[string]interface{}{
+				"reason":  string(cause.Type),
+				"field":   cause.Field,
+				"message": cause.Message,
+			})
+		}
+	}
+	// 没有具体字段错误的（如 admission webhook 拒绝），使用整体的错误信息
+	if len(errs) == 0 {
+		errs = append(errs, map[string]interface{}{
+			"reason":  string(status.Reason),
+			"field":   "",
+			"message": status.Message,
+		})
+	}
+	return errs
+}
+
+// pruneDryRunManifest 移除不参与对比的字段，不修改原配置
+func pruneDryRunManifest(manifest map[string]interface{}) map[string]interface{} {
+	if len(manifest) == 0 {
+		return map[string]interface{}{}
+	}
+	pruned := runtime.DeepCopyJSON(manifest)
+	for _, path := range DryRunIgnoredPaths {
+		unstructured.RemoveNestedField(pruned, strings.Split(path, ".")...)
+	}
+	return pruned
+}
@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+)
+
+func newDryRunManifest(replicas int64, resourceVersion string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "nginx",
+			"namespace":       "default",
+			"resourceVersion": resourceVersion,
+			"generation":      int64(1),
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+		"status": map[string]interface{}{
+			"readyReplicas": int64(1),
+		},
+	}
+}
+
+func TestPruneDryRunManifest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		manifest map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "nil manifest",
+			manifest: nil,
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "empty manifest",
+			manifest: map[string]interface{}{},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "remove ignored paths",
+			manifest: newDryRunManifest(1, "100"),
+			expected: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+				"spec":       map[string]interface{}{"replicas": int64(1)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pruneDryRunManifest(tc.manifest))
+		})
+	}
+
+	// 不修改原配置
+	manifest := newDryRunManifest(1, "100")
+	_ = pruneDryRunManifest(manifest)
+	assert.Equal(t, "100", mapx.GetStr(manifest, "metadata.resourceVersion"))
+	assert.NotNil(t, manifest["status"])
+}
+
+func TestGenDryRunDiffs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		live     map[string]interface{}
+		dryRun   map[string]interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "no changes",
+			live:     newDryRunManifest(1, "100"),
+			dryRun:   newDryRunManifest(1, "100"),
+			expected: []interface{}{},
+		},
+		{
+			name:     "ignored paths changed only",
+			live:     newDryRunManifest(1, "100"),
+			dryRun:   newDryRunManifest(1, "101"),
+			expected: []interface{}{},
+		},
+		{
+			name:   "spec changed",
+			live:   newDryRunManifest(1, "100"),
+			dryRun: newDryRunManifest(3, "101"),
+			expected: []interface{}{
+				map[string]interface{}{
+					"action": mapx.ActionChange, "path": "spec.replicas", "oldVal": int64(1), "newVal": int64(3),
+				},
+			},
+		},
+		{
+			name: "create new resource",
+			live: map[string]interface{}{},
+			dryRun: map[string]interface{}{
+				"kind": "ConfigMap",
+				"data": map[string]interface{}{"a": "b"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"action": mapx.ActionAdd, "path": "data", "oldVal": nil,
+					"newVal": map[string]interface{}{"a": "b"},
+				},
+				map[string]interface{}{"action": mapx.ActionAdd, "path": "kind", "oldVal": nil, "newVal": "ConfigMap"},
+			},
+		},
+		{
+			name: "field removed",
+			live: map[string]interface{}{
+				"data": map[string]interface{}{"a": "b", "c": "d"},
+			},
+			dryRun: map[string]interface{}{
+				"data": map[string]interface{}{"a": "b"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"action": mapx.ActionRemove, "path": "data.c", "oldVal": "d", "newVal": nil},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GenDryRunDiffs(tc.live, tc.dryRun))
+		})
+	}
+}
+
+func TestGenDryRunErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   metav1.Status
+		expected []interface{}
+	}{
+		{
+			name: "field causes",
+			status: metav1.Status{
+				Reason:  metav1.StatusReasonInvalid,
+				Message: "Deployment.apps \"nginx\" is invalid",
+				Details: &metav1.StatusDetails{
+					Causes: []metav1.StatusCause{
+						{Type: metav1.CauseTypeFieldValueRequired, Field: "spec.selector", Message: "Required value"},
+						{Type: metav1.CauseTypeFieldValueInvalid, Field: "spec.replicas", Message: "must be >= 0"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"reason": string(metav1.CauseTypeFieldValueRequired), "field": "spec.selector",
+					"message": "Required value",
+				},
+				map[string]interface{}{
+					"reason": string(metav1.CauseTypeFieldValueInvalid), "field": "spec.replicas",
+					"message": "must be >= 0",
+				},
+			},
+		},
+		{
+			name: "details without causes",
+			status: metav1.Status{
+				Reason:  metav1.StatusReasonForbidden,
+				Message: "admission webhook denied the request",
+				Details: &metav1.StatusDetails{Name: "nginx"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"reason": string(metav1.StatusReasonForbidden), "field": "",
+					"message": "admission webhook denied the request",
+				},
+			},
+		},
+		{
+			name:   "no details",
+			status: metav1.Status{Reason: metav1.StatusReasonInternalError, Message: "internal error"},
+			expected: []interface{}{
+				map[string]interface{}{
+					"reason": string(metav1.StatusReasonInternalError), "field": "", "message": "internal error",
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GenDryRunErrors(tc.status))
+		})
+	}
+}
@@ -68,8 +68,12 @@ func BuildCreateAPIResp(
 		return nil, err
 	}
 
+	resCli := cli.NewResClient(clusterConf, k8sRes)
+	if IsDryRun(opts.DryRun) {
+		return BuildDryRunCreateAPIResp(ctx, resCli, manifest, isNSScoped, opts)
+	}
 	var ret *unstructured.Unstructured
-	ret, err = cli.NewResClient(clusterConf, k8sRes).Create(ctx, manifest, isNSScoped, opts)
+	ret, err = resCli.Create(ctx, manifest, isNSScoped, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +93,12 @@ func BuildUpdateAPIResp(
 		return nil, err
 	}
 
+	resCli := cli.NewResClient(clusterConf, k8sRes)
+	if IsDryRun(opts.DryRun) {
+		return BuildDryRunUpdateAPIResp(ctx, resCli, namespace, name, nil, manifest, opts)
+	}
 	var ret *unstructured.Unstructured
-	ret, err = cli.NewResClient(clusterConf, k8sRes).Update(ctx, namespace, name, manifest, opts)
+	ret, err = resCli.Update(ctx, namespace, name, manifest, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -272,9 +280,14 @@ func BuildUpdateCObjAPIResp(
 		return nil, err
 	}
 
+	resCli := cli.NewResClient(clusterConf, cobjRes)
+	if IsDryRun(opts.DryRun) {
+		return BuildDryRunUpdateAPIResp(ctx, resCli, namespace, name, cobjManifest, manifest, opts)
+	}
+
 	// 下发更新指令到集群
 	var ret *unstructured.Unstructured
-	ret, err = cli.NewResClient(clusterConf, cobjRes).Update(ctx, namespace, name, manifest, opts)
+	ret, err = resCli.Update(ctx, namespace, name, manifest, opts)
 	if err != nil {
 		return nil, err
 	}
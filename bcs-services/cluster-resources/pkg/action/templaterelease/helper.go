@@ -0,0 +1,446 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templaterelease
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/perm"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/resp"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/cluster"
+	crAction "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/action"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/ctxkey"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/errcode"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/i18n"
+	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	cli "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/client"
+	resPerm "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/perm"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/errorx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/slice"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+// notesFileSuffix 模板中的 NOTES.txt 仅用于展示，不会下发到集群
+const notesFileSuffix = "NOTES.txt"
+
+// deployTarget 部署目标（集群 + 命名空间）
+type deployTarget struct {
+	ClusterID string
+	Namespace string
+}
+
+// releaseObj 资源清单中的单个资源及其在集群中对应的 GroupVersionResource
+type releaseObj struct {
+	manifest map[string]interface{}
+	gvr      schema.GroupVersionResource
+}
+
+// toReleaseResource 转换成部署记录中的资源信息
+func (o *releaseObj) toReleaseResource() entity.ReleaseResource {
+	return entity.ReleaseResource{
+		APIVersion: mapx.GetStr(o.manifest, "apiVersion"),
+		Kind:       mapx.GetStr(o.manifest, "kind"),
+		Namespace:  mapx.GetStr(o.manifest, "metadata.namespace"),
+		Name:       mapx.GetStr(o.manifest, "metadata.name"),
+	}
+}
+
+func toEntityTemplateIDs(templateIDs []*clusterRes.TemplateID) []entity.TemplateID {
+	ids := make([]entity.TemplateID, 0, len(templateIDs))
+	for _, id := range templateIDs {
+		ids = append(ids, entity.TemplateID{
+			TemplateSpace:   id.TemplateSpace,
+			TemplateName:    id.TemplateName,
+			TemplateVersion: id.Version,
+		})
+	}
+	return ids
+}
+
+// mergeValues 合并模板集默认值与环境变量，环境变量优先级更高，key 支持以 . 分隔的多级路径（如 image.tag）
+func mergeValues(values string, variables []entity.EnvVariable) (map[string]interface{}, error) {
+	vals, err := chartutil.ReadValues([]byte(values))
+	if err != nil {
+		return nil, err
+	}
+	ret := vals.AsMap()
+	for _, v := range variables {
+		if v.Key == "" {
+			continue
+		}
+		if err = unstructured.SetNestedField(ret, v.Value, strings.Split(v.Key, ".")...); err != nil {
+			return nil, fmt.Errorf("set variable %s failed: %v", v.Key, err)
+		}
+	}
+	return ret, nil
+}
+
+// renderManifest 渲染模板文件版本，返回按依赖顺序（如 Namespace -> ConfigMap -> Deployment）排列的资源清单
+func renderManifest(
+	name, namespace string, revision int, templates []*entity.TemplateVersion, values map[string]interface{},
+) (string, error) {
+	cht := &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       name,
+			Version:    fmt.Sprintf("0.0.%d", revision),
+		},
+	}
+	// 不同文件夹下可能存在同名的模板文件，因此路径中需要包含文件夹名称
+	for _, tmp := range templates {
+		cht.Templates = append(cht.Templates, &chart.File{
+			Name: fmt.Sprintf("templates/%s/%s", tmp.TemplateSpace, tmp.TemplateName),
+			Data: []byte(tmp.Content),
+		})
+	}
+
+	options := chartutil.ReleaseOptions{
+		Name:      name,
+		Namespace: namespace,
+		Revision:  revision,
+		IsInstall: revision <= 1,
+		IsUpgrade: revision > 1,
+	}
+	renderVals, err := chartutil.ToRenderValues(cht, values, options, chartutil.DefaultCapabilities)
+	if err != nil {
+		return "", err
+	}
+	files, err := engine.Render(cht, renderVals)
+	if err != nil {
+		return "", err
+	}
+	for k := range files {
+		if strings.HasSuffix(k, notesFileSuffix) {
+			delete(files, k)
+		}
+	}
+
+	hooks, manifests, err := releaseutil.SortManifests(files, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return "", err
+	}
+	// 模板集部署不区分 helm hook，统一在普通资源之后下发
+	var b strings.Builder
+	for _, m := range manifests {
+		fmt.Fprintf(&b, "---\n# Source: %s\n%s\n", m.Name, m.Content)
+	}
+	for _, h := range hooks {
+		fmt.Fprintf(&b, "---\n# Source: %s\n%s\n", h.Path, h.Manifest)
+	}
+	return b.String(), nil
+}
+
+// parseManifest 将资源清单按顺序拆分并转换为资源配置
+func parseManifest(ctx context.Context, manifest string) ([]map[string]interface{}, error) {
+	docs := releaseutil.SplitManifests(manifest)
+	keys := make([]string, 0, len(docs))
+	for k := range docs {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	manifests := []map[string]interface{}{}
+	for _, k := range keys {
+		jsonBytes, err := yaml.YAMLToJSON([]byte(docs[k]))
+		if err != nil {
+			return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "资源清单格式错误：%v"), err)
+		}
+		obj := map[string]interface{}{}
+		if err = json.Unmarshal(jsonBytes, &obj); err != nil {
+			return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "资源清单格式错误：%v"), err)
+		}
+		// 仅包含注释的文档会被解析为空
+		if len(obj) == 0 {
+			continue
+		}
+		if mapx.GetStr(obj, "apiVersion") == "" || mapx.GetStr(obj, "kind") == "" ||
+			mapx.GetStr(obj, "metadata.name") == "" {
+			return nil, errorx.New(
+				errcode.ValidateErr, i18n.GetMsg(ctx, "资源清单中的资源必须指定 apiVersion，kind 与 metadata.name"),
+			)
+		}
+		manifests = append(manifests, obj)
+	}
+	return manifests, nil
+}
+
+// genResKey 生成资源唯一标识，不包含版本信息，以免 apiVersion 升级导致资源被当作新资源
+func genResKey(apiVersion, kind, namespace, name string) string {
+	gk := schema.FromAPIVersionAndKind(apiVersion, kind).GroupKind()
+	return fmt.Sprintf("%s/%s/%s", gk.String(), namespace, name)
+}
+
+// genManifestResKey 生成资源配置的唯一标识
+func genManifestResKey(manifest map[string]interface{}) string {
+	return genResKey(
+		mapx.GetStr(manifest, "apiVersion"),
+		mapx.GetStr(manifest, "kind"),
+		mapx.GetStr(manifest, "metadata.namespace"),
+		mapx.GetStr(manifest, "metadata.name"),
+	)
+}
+
+// withClusterCtx 检查集群及命名空间的访问权限，并将集群信息注入到 Context 中
+func withClusterCtx(ctx context.Context, projectID, clusterID, namespace string) (context.Context, error) {
+	clusterInfo, err := cluster.GetClusterInfo(ctx, clusterID)
+	if err != nil {
+		return nil, errorx.New(errcode.General, i18n.GetMsg(ctx, "获取集群 %s 信息失败：%v"), clusterID, err)
+	}
+	// 若集群类型非共享集群，则需确认集群的项目 ID 与当前项目一致
+	if !slice.StringInSlice(clusterInfo.Type, cluster.SharedClusterTypes) && clusterInfo.ProjID != projectID {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "集群 %s 不属于指定项目!"), clusterID)
+	}
+	ctx = context.WithValue(ctx, ctxkey.ClusterKey, clusterInfo)
+	if err = perm.CheckNSAccess(ctx, clusterID, namespace); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// buildReleaseObjs 解析并校验资源清单，获取各资源在集群中对应的 GroupVersionResource
+func buildReleaseObjs(ctx context.Context, clusterID, namespace, manifest string) ([]*releaseObj, error) {
+	manifests, err := parseManifest(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "模板渲染结果中不包含任何资源"))
+	}
+
+	conf := res.NewClusterConf(clusterID)
+	objs := []*releaseObj{}
+	keys := map[string]struct{}{}
+	for _, m := range manifests {
+		apiVersion, kind := mapx.GetStr(m, "apiVersion"), mapx.GetStr(m, "kind")
+		namespaced, err := res.IsNamespacedRes(ctx, conf, kind, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		// 部署记录以命名空间为维度，因此不支持集群维度的资源
+		if !namespaced {
+			return nil, errorx.New(errcode.Unsupported, i18n.GetMsg(ctx, "模板集部署不支持集群维度资源 %s"), kind)
+		}
+		ns := mapx.GetStr(m, "metadata.namespace")
+		if ns == "" {
+			_ = unstructured.SetNestedField(m, namespace, "metadata", "namespace")
+		} else if ns != namespace {
+			return nil, errorx.New(
+				errcode.ValidateErr, i18n.GetMsg(ctx, "资源 %s/%s 的命名空间 %s 与部署目标命名空间 %s 不一致"),
+				kind, mapx.GetStr(m, "metadata.name"), ns, namespace,
+			)
+		}
+		key := genManifestResKey(m)
+		if _, ok := keys[key]; ok {
+			return nil, errorx.New(
+				errcode.ValidateErr, i18n.GetMsg(ctx, "资源 %s/%s 重复定义"), kind, mapx.GetStr(m, "metadata.name"),
+			)
+		}
+		keys[key] = struct{}{}
+
+		gvr, err := res.GetGroupVersionResource(ctx, conf, kind, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, &releaseObj{manifest: m, gvr: gvr})
+	}
+	return objs, nil
+}
+
+// precheckReleaseObjs 下发前校验部署目标：检查各资源的创建 / 更新权限及待清理资源的删除权限，
+// 并以 dry-run 方式下发全部资源，确保集群校验（含准入控制）通过
+func precheckReleaseObjs(
+	ctx context.Context, projectID, clusterID, namespace string, objs []*releaseObj, prev []entity.ReleaseResource,
+) error {
+	conf := res.NewClusterConf(clusterID)
+	for _, obj := range objs {
+		name := mapx.GetStr(obj.manifest, "metadata.name")
+		action := crAction.Update
+		if _, err := cli.NewResClient(conf, obj.gvr).Get(ctx, namespace, name, metav1.GetOptions{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			action = crAction.Create
+		}
+		if err := resPerm.Validate(ctx, obj.gvr.Resource, action, projectID, clusterID, namespace); err != nil {
+			return err
+		}
+	}
+	for _, r := range getPruneResources(prev, objs) {
+		gvr, err := res.GetGroupVersionResource(ctx, conf, r.Kind, r.APIVersion)
+		// 无法获取 gvr 的资源不会被清理，无需校验删除权限
+		if err != nil {
+			continue
+		}
+		if err = resPerm.Validate(ctx, gvr.Resource, crAction.Delete, projectID, clusterID, namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range objs {
+		kind, name := mapx.GetStr(obj.manifest, "kind"), mapx.GetStr(obj.manifest, "metadata.name")
+		// dry-run 会修改 resourceVersion 等字段，使用副本以免影响实际下发
+		ret, err := resp.BuildDryRunApplyAPIResp(ctx, clusterID, kind, runtime.DeepCopyJSON(obj.manifest))
+		if err != nil {
+			return errorx.New(errcode.General, i18n.GetMsg(ctx, "资源 %s/%s 预检失败：%v"), kind, name, err)
+		}
+		if passed, _ := ret.AsMap()["passed"].(bool); !passed {
+			return errorx.New(
+				errcode.ValidateErr, i18n.GetMsg(ctx, "资源 %s/%s 未通过集群校验：%s"),
+				kind, name, formatDryRunErrors(ret.AsMap()["errors"]),
+			)
+		}
+	}
+	return nil
+}
+
+// formatDryRunErrors 将 dry-run 返回的错误列表拼接为错误信息
+func formatDryRunErrors(errs interface{}) string {
+	errList, _ := errs.([]interface{})
+	msgs := make([]string, 0, len(errList))
+	for _, e := range errList {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if field := mapx.GetStr(m, "field"); field != "" {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", field, mapx.GetStr(m, "message")))
+			continue
+		}
+		msgs = append(msgs, mapx.GetStr(m, "message"))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// applyReleaseObjs 按顺序下发资源，已存在的资源进行更新，否则创建
+func applyReleaseObjs(ctx context.Context, clusterID string, objs []*releaseObj) error {
+	conf := res.NewClusterConf(clusterID)
+	for _, obj := range objs {
+		resCli := cli.NewResClient(conf, obj.gvr)
+		kind := mapx.GetStr(obj.manifest, "kind")
+		namespace := mapx.GetStr(obj.manifest, "metadata.namespace")
+		name := mapx.GetStr(obj.manifest, "metadata.name")
+
+		live, err := resCli.Get(ctx, namespace, name, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			_ = unstructured.SetNestedField(obj.manifest, live.GetResourceVersion(), "metadata", "resourceVersion")
+			_, err = resCli.Update(ctx, namespace, name, obj.manifest, metav1.UpdateOptions{})
+		case apierrors.IsNotFound(err):
+			_, err = resCli.Create(ctx, obj.manifest, true, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return errorx.New(errcode.General, i18n.GetMsg(ctx, "下发资源 %s/%s 失败：%v"), kind, name, err)
+		}
+	}
+	return nil
+}
+
+// getPruneResources 获取上一次部署中存在，但本次部署中已不存在的资源
+func getPruneResources(prev []entity.ReleaseResource, objs []*releaseObj) []entity.ReleaseResource {
+	keys := map[string]struct{}{}
+	for _, obj := range objs {
+		keys[genManifestResKey(obj.manifest)] = struct{}{}
+	}
+
+	ret := []entity.ReleaseResource{}
+	for _, r := range prev {
+		if _, ok := keys[genResKey(r.APIVersion, r.Kind, r.Namespace, r.Name)]; !ok {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// pruneReleaseResources 清理上一次部署中存在，但本次部署中已不存在的资源
+func pruneReleaseResources(
+	ctx context.Context, clusterID string, prev []entity.ReleaseResource, objs []*releaseObj,
+) {
+	conf := res.NewClusterConf(clusterID)
+	for _, r := range getPruneResources(prev, objs) {
+		gvr, err := res.GetGroupVersionResource(ctx, conf, r.Kind, r.APIVersion)
+		if err != nil {
+			log.Warn(ctx, "get %s/%s gvr failed, skip prune: %v", r.Kind, r.Name, err)
+			continue
+		}
+		err = cli.NewResClient(conf, gvr).Delete(ctx, r.Namespace, r.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Warn(ctx, "prune %s %s/%s in cluster %s failed: %v", r.Kind, r.Namespace, r.Name, clusterID, err)
+		}
+	}
+}
+
+// diffManifest 按资源维度对比两份资源清单
+func diffManifest(ctx context.Context, oldManifest, newManifest string) ([]interface{}, error) {
+	oldObjs, err := parseManifest(ctx, oldManifest)
+	if err != nil {
+		return nil, err
+	}
+	newObjs, err := parseManifest(ctx, newManifest)
+	if err != nil {
+		return nil, err
+	}
+	oldMap := map[string]map[string]interface{}{}
+	for _, obj := range oldObjs {
+		oldMap[genManifestResKey(obj)] = obj
+	}
+
+	diffs := []interface{}{}
+	for _, obj := range newObjs {
+		key := genManifestResKey(obj)
+		oldObj, ok := oldMap[key]
+		if !ok {
+			diffs = append(diffs, genResDiff(mapx.ActionAdd, obj, resp.GenDryRunDiffs(map[string]interface{}{}, obj)))
+			continue
+		}
+		delete(oldMap, key)
+		if changes := resp.GenDryRunDiffs(oldObj, obj); len(changes) != 0 {
+			diffs = append(diffs, genResDiff(mapx.ActionChange, obj, changes))
+		}
+	}
+	// 剩余的即为被移除的资源，保持其在旧清单中的顺序
+	for _, obj := range oldObjs {
+		if _, ok := oldMap[genManifestResKey(obj)]; ok {
+			diffs = append(diffs, genResDiff(mapx.ActionRemove, obj, resp.GenDryRunDiffs(obj, map[string]interface{}{})))
+		}
+	}
+	return diffs, nil
+}
+
+func genResDiff(action string, manifest map[string]interface{}, changes []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"action":     action,
+		"apiVersion": mapx.GetStr(manifest, "apiVersion"),
+		"kind":       mapx.GetStr(manifest, "kind"),
+		"namespace":  mapx.GetStr(manifest, "metadata.namespace"),
+		"name":       mapx.GetStr(manifest, "metadata.name"),
+		"changes":    changes,
+	}
+}
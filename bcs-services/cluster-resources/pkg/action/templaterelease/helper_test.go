@@ -0,0 +1,202 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templaterelease
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+)
+
+var testTemplates = []*entity.TemplateVersion{
+	{
+		TemplateSpace: "space1",
+		TemplateName:  "deploy.yaml",
+		Content: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+        - name: app
+          image: "nginx:{{ .Values.image.tag }}"`,
+	},
+	{
+		TemplateSpace: "space1",
+		TemplateName:  "cm.yaml",
+		Content: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-cm
+  namespace: {{ .Release.Namespace }}
+data:
+  revision: "{{ .Release.Revision }}"`,
+	},
+}
+
+func TestMergeValues(t *testing.T) {
+	values := "replicas: 1\nimage:\n  tag: latest\n"
+	vals, err := mergeValues(values, []entity.EnvVariable{
+		{Key: "image.tag", Value: "1.25"},
+		{Key: "env", Value: "prod"},
+		{Key: "", Value: "ignored"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(1), vals["replicas"])
+	assert.Equal(t, "1.25", mapx.GetStr(vals, "image.tag"))
+	assert.Equal(t, "prod", vals["env"])
+
+	// 中间路径不是 map，无法设置
+	_, err = mergeValues(values, []entity.EnvVariable{{Key: "replicas.count", Value: "2"}})
+	assert.NotNil(t, err)
+
+	_, err = mergeValues("a: [", nil)
+	assert.NotNil(t, err)
+}
+
+func TestRenderManifest(t *testing.T) {
+	vals, err := mergeValues("replicas: 2\nimage:\n  tag: \"1.25\"\n", nil)
+	assert.Nil(t, err)
+	manifest, err := renderManifest("demo", "default", 3, testTemplates, vals)
+	assert.Nil(t, err)
+
+	objs, err := parseManifest(context.TODO(), manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+	// 按依赖顺序排列，ConfigMap 在 Deployment 之前
+	assert.Equal(t, "ConfigMap", objs[0]["kind"])
+	assert.Equal(t, "demo-cm", mapx.GetStr(objs[0], "metadata.name"))
+	assert.Equal(t, "default", mapx.GetStr(objs[0], "metadata.namespace"))
+	assert.Equal(t, "3", mapx.GetStr(objs[0], "data.revision"))
+	assert.Equal(t, "Deployment", objs[1]["kind"])
+	assert.Equal(t, int64(2), mapx.GetInt64(objs[1], "spec.replicas"))
+
+	// 模板语法错误
+	_, err = renderManifest("demo", "default", 1, []*entity.TemplateVersion{
+		{TemplateSpace: "space1", TemplateName: "err.yaml", Content: "{{ .Values.a "},
+	}, vals)
+	assert.NotNil(t, err)
+}
+
+func TestParseManifest(t *testing.T) {
+	manifest := "---\n# only comment\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n"
+	objs, err := parseManifest(context.TODO(), manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+
+	_, err = parseManifest(context.TODO(), "apiVersion: v1\nkind: ConfigMap\n")
+	assert.NotNil(t, err)
+}
+
+func TestGenResKey(t *testing.T) {
+	// 版本不同，但 Group 相同的资源视为同一个资源
+	assert.Equal(t,
+		genResKey("apps/v1", "Deployment", "default", "demo"),
+		genResKey("apps/v1beta1", "Deployment", "default", "demo"),
+	)
+	assert.NotEqual(t,
+		genResKey("v1", "ConfigMap", "default", "demo"),
+		genResKey("v1", "Secret", "default", "demo"),
+	)
+}
+
+func TestDiffManifest(t *testing.T) {
+	oldManifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+data:
+  a: "1"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: s1`
+	newManifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+data:
+  a: "2"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm3`
+	diffs, err := diffManifest(context.TODO(), oldManifest, newManifest)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diffs))
+
+	expected := [][]string{
+		{mapx.ActionChange, "ConfigMap", "cm1"},
+		{mapx.ActionAdd, "ConfigMap", "cm3"},
+		{mapx.ActionRemove, "Secret", "s1"},
+	}
+	for idx, d := range diffs {
+		diff := d.(map[string]interface{})
+		assert.Equal(t, expected[idx][0], diff["action"])
+		assert.Equal(t, expected[idx][1], diff["kind"])
+		assert.Equal(t, expected[idx][2], diff["name"])
+	}
+	changes := diffs[0].(map[string]interface{})["changes"].([]interface{})
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, "data.a", changes[0].(map[string]interface{})["path"])
+
+	// 与空清单对比，全部为新增
+	diffs, err = diffManifest(context.TODO(), "", newManifest)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diffs))
+}
+
+func TestGetPruneResources(t *testing.T) {
+	objs := []*releaseObj{
+		{manifest: map[string]interface{}{
+			"apiVersion": "apps/v1", "kind": "Deployment",
+			"metadata": map[string]interface{}{"name": "app", "namespace": "default"},
+		}},
+	}
+	prev := []entity.ReleaseResource{
+		// apiVersion 升级的同名资源不会被清理
+		{APIVersion: "apps/v1beta2", Kind: "Deployment", Namespace: "default", Name: "app"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "app-cm"},
+	}
+	assert.Equal(t, []entity.ReleaseResource{prev[1]}, getPruneResources(prev, objs))
+	assert.Empty(t, getPruneResources(nil, objs))
+}
+
+func TestFormatDryRunErrors(t *testing.T) {
+	errs := []interface{}{
+		map[string]interface{}{"reason": "FieldValueInvalid", "field": "spec.replicas", "message": "must be >= 0"},
+		map[string]interface{}{"reason": "Forbidden", "field": "", "message": "denied by webhook"},
+		"invalid",
+	}
+	assert.Equal(t, "spec.replicas: must be >= 0; denied by webhook", formatDryRunErrors(errs))
+	assert.Equal(t, "", formatDryRunErrors(nil))
+}
@@ -0,0 +1,509 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package templaterelease 模板集部署及部署记录
+package templaterelease
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+
+	crAction "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/action"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/ctxkey"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/errcode"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/component/project"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/config"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/i18n"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/iam"
+	projectAuth "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/iam/perm/resource/project"
+	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	resPerm "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/perm"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/utils"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/errorx"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+// TemplateReleaseAction provides the action to deploy template set and manage releases
+// nolint
+type TemplateReleaseAction struct {
+	ctx context.Context
+
+	model store.ClusterResourcesModel
+}
+
+// NewTemplateReleaseAction return a new TemplateReleaseAction instance
+func NewTemplateReleaseAction(model store.ClusterResourcesModel) *TemplateReleaseAction {
+	return &TemplateReleaseAction{
+		model: model,
+	}
+}
+
+// releaseTask 单个部署目标的部署任务，渲染及校验均通过后才会统一下发
+type releaseTask struct {
+	ctx      context.Context
+	release  *entity.TemplateRelease
+	deployed *entity.TemplateRelease
+	objs     []*releaseObj
+}
+
+func (t *TemplateReleaseAction) checkAccess(ctx context.Context) error {
+	if config.G.Auth.Disabled {
+		return nil
+	}
+	project, err := project.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	// 权限控制为项目查看
+	permCtx := &projectAuth.PermCtx{
+		Username:  ctxkey.GetUsernameFromCtx(ctx),
+		ProjectID: project.ID,
+	}
+	if allow, err := iam.NewProjectPerm().CanView(permCtx); err != nil {
+		return err
+	} else if !allow {
+		return errorx.New(errcode.NoIAMPerm, i18n.GetMsg(ctx, "无项目查看权限"))
+	}
+	return nil
+}
+
+// Deploy 渲染模板文件版本并部署到目标命名空间，每个目标命名空间生成一条部署记录
+func (t *TemplateReleaseAction) Deploy(
+	ctx context.Context, req *clusterRes.DeployTemplateSetReq) ([]map[string]interface{}, error) {
+	if err := t.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := project.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 获取部署目标及环境变量
+	env, targets, variables, err := t.resolveTargets(ctx, p.Code, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 获取模板文件版本，必须全部存在
+	templateIDs := toEntityTemplateIDs(req.GetTemplates())
+	tmps := t.model.ListTemplateVersionFromTemplateIDs(ctx, p.Code, templateIDs)
+	if len(tmps) != len(templateIDs) {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "部分模板文件版本不存在"))
+	}
+
+	values, err := mergeValues(req.GetValues(), variables)
+	if err != nil {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "解析模板集默认值失败：%v"), err)
+	}
+
+	// 3. 逐个目标渲染，校验，任意目标失败则不下发
+	tasks := make([]*releaseTask, 0, len(targets))
+	for _, target := range targets {
+		task, err := t.newReleaseTask(ctx, p.ID, p.Code, req.GetName(), target)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := renderManifest(req.GetName(), target.Namespace, task.release.Revision, tmps, values)
+		if err != nil {
+			return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "渲染模板失败：%v"), err)
+		}
+		if task.objs, err = buildReleaseObjs(task.ctx, target.ClusterID, target.Namespace, manifest); err != nil {
+			return nil, err
+		}
+		task.release.Env = env
+		task.release.Templates = templateIDs
+		task.release.Values = req.GetValues()
+		task.release.Manifest = manifest
+		tasks = append(tasks, task)
+	}
+
+	// 4. 下发前校验所有目标的资源权限，并以 dry-run 方式预检，任意目标失败则不下发
+	for _, task := range tasks {
+		if err = t.precheck(p.ID, task); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. 占用部署版本号后下发资源并记录部署结果
+	if err = t.reserve(ctx, tasks); err != nil {
+		return nil, err
+	}
+	ret := make([]map[string]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		if err = t.release(task); err != nil {
+			return nil, err
+		}
+		ret = append(ret, task.release.ToMap())
+	}
+	return ret, nil
+}
+
+// List 获取部署记录列表，按创建时间倒序
+func (t *TemplateReleaseAction) List(
+	ctx context.Context, req *clusterRes.ListTemplateReleasesReq) ([]map[string]interface{}, error) {
+	if err := t.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := project.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 通过项目编码检索，集群，命名空间及部署名称为空则不过滤
+	m := operator.M{entity.FieldKeyProjectCode: p.Code}
+	if req.GetClusterID() != "" {
+		m[entity.FieldKeyClusterID] = req.GetClusterID()
+	}
+	if req.GetNamespace() != "" {
+		m[entity.FieldKeyNamespace] = req.GetNamespace()
+	}
+	if req.GetName() != "" {
+		m[entity.FieldKeyName] = req.GetName()
+	}
+	_, releases, err := t.model.ListTemplateReleases(
+		ctx, operator.NewLeafCondition(operator.Eq, m),
+		&utils.ListOption{Sort: map[string]int{entity.FieldKeyCreateAt: -1}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]map[string]interface{}, 0)
+	for _, v := range releases {
+		// 列表不返回资源清单，避免数据量过大
+		item := v.ToMap()
+		delete(item, "manifest")
+		ret = append(ret, item)
+	}
+	return ret, nil
+}
+
+// Get 获取部署记录详情
+func (t *TemplateReleaseAction) Get(ctx context.Context, id string) (map[string]interface{}, error) {
+	if err := t.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	release, err := t.getRelease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.checkSecretAccess(ctx, release); err != nil {
+		return nil, err
+	}
+	return release.ToMap(), nil
+}
+
+// Diff 对比两条部署记录的资源清单，未指定对比记录时与目标命名空间当前生效的部署记录对比
+func (t *TemplateReleaseAction) Diff(
+	ctx context.Context, req *clusterRes.DiffTemplateReleaseReq) (map[string]interface{}, error) {
+	if err := t.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	release, err := t.getRelease(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = t.checkSecretAccess(ctx, release); err != nil {
+		return nil, err
+	}
+
+	var compare *entity.TemplateRelease
+	if req.GetCompareID() != "" {
+		if compare, err = t.getRelease(ctx, req.GetCompareID()); err != nil {
+			return nil, err
+		}
+	} else {
+		compare, err = t.getLatestRelease(
+			ctx, release.ProjectCode, release.ClusterID, release.Namespace, release.Name, entity.ReleaseStatusDeployed,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = t.checkSecretAccess(ctx, compare); err != nil {
+		return nil, err
+	}
+
+	// 对比记录为空时，视为全部资源新增
+	compareManifest, compareRevision := "", 0
+	if compare != nil {
+		compareManifest, compareRevision = compare.Manifest, compare.Revision
+	}
+	diffs, err := diffManifest(ctx, compareManifest, release.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"revision":        release.Revision,
+		"compareRevision": compareRevision,
+		"diffs":           diffs,
+	}, nil
+}
+
+// Rollback 将指定部署记录的资源清单重新下发，并生成新的部署记录
+func (t *TemplateReleaseAction) Rollback(ctx context.Context, id string) (map[string]interface{}, error) {
+	if err := t.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := project.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := t.getRelease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 部署失败的记录，资源可能并未完整下发，不允许回滚
+	if target.Status == entity.ReleaseStatusFailed {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "不能回滚到部署失败的记录"))
+	}
+
+	task, err := t.newReleaseTask(
+		ctx, p.ID, p.Code, target.Name, deployTarget{ClusterID: target.ClusterID, Namespace: target.Namespace},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if task.objs, err = buildReleaseObjs(task.ctx, target.ClusterID, target.Namespace, target.Manifest); err != nil {
+		return nil, err
+	}
+	task.release.Env = target.Env
+	task.release.Templates = target.Templates
+	task.release.Values = target.Values
+	task.release.Manifest = target.Manifest
+	task.release.RollbackFrom = target.Revision
+
+	if err = t.precheck(p.ID, task); err != nil {
+		return nil, err
+	}
+	if err = t.reserve(ctx, []*releaseTask{task}); err != nil {
+		return nil, err
+	}
+	if err = t.release(task); err != nil {
+		return nil, err
+	}
+	return task.release.ToMap(), nil
+}
+
+// resolveTargets 获取部署目标，指定环境管理时部署到环境关联的所有命名空间，否则部署到指定的集群命名空间
+func (t *TemplateReleaseAction) resolveTargets(
+	ctx context.Context, projectCode string, req *clusterRes.DeployTemplateSetReq,
+) (string, []deployTarget, []entity.EnvVariable, error) {
+	if req.GetEnvManageID() == "" {
+		if req.GetClusterID() == "" || req.GetNamespace() == "" {
+			return "", nil, nil, errorx.New(
+				errcode.ValidateErr, i18n.GetMsg(ctx, "未指定环境管理时，集群 ID 与命名空间不能为空"),
+			)
+		}
+		return "", []deployTarget{{ClusterID: req.GetClusterID(), Namespace: req.GetNamespace()}}, nil, nil
+	}
+
+	envManage, err := t.model.GetEnvManage(ctx, req.GetEnvManageID())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	// 只能使用当前项目环境管理
+	if envManage.ProjectCode != projectCode {
+		return "", nil, nil, errorx.New(errcode.NoPerm, i18n.GetMsg(ctx, "无权限访问"))
+	}
+
+	targets := []deployTarget{}
+	for _, cn := range envManage.ClusterNamespaces {
+		for _, ns := range cn.Namespaces {
+			targets = append(targets, deployTarget{ClusterID: cn.ClusterID, Namespace: ns})
+		}
+	}
+	if len(targets) == 0 {
+		return "", nil, nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "环境 %s 未关联任何命名空间"), envManage.Env)
+	}
+	return envManage.Env, targets, envManage.Variables, nil
+}
+
+// newReleaseTask 检查目标命名空间访问权限，并根据历史部署记录生成新的部署记录
+func (t *TemplateReleaseAction) newReleaseTask(
+	ctx context.Context, projectID, projectCode, name string, target deployTarget,
+) (*releaseTask, error) {
+	clusterCtx, err := withClusterCtx(ctx, projectID, target.ClusterID, target.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := t.getLatestRelease(ctx, projectCode, target.ClusterID, target.Namespace, name, "")
+	if err != nil {
+		return nil, err
+	}
+	deployed, err := t.getLatestRelease(
+		ctx, projectCode, target.ClusterID, target.Namespace, name, entity.ReleaseStatusDeployed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := 1
+	if latest != nil {
+		revision = latest.Revision + 1
+	}
+	return &releaseTask{
+		ctx: clusterCtx,
+		release: &entity.TemplateRelease{
+			ProjectCode: projectCode,
+			Name:        name,
+			ClusterID:   target.ClusterID,
+			Namespace:   target.Namespace,
+			Revision:    revision,
+			Creator:     ctxkey.GetUsernameFromCtx(ctx),
+		},
+		deployed: deployed,
+	}, nil
+}
+
+// precheck 校验部署目标下资源的操作权限，并以 dry-run 方式预检资源
+func (t *TemplateReleaseAction) precheck(projectID string, task *releaseTask) error {
+	var prev []entity.ReleaseResource
+	if task.deployed != nil {
+		prev = task.deployed.Resources
+	}
+	rel := task.release
+	return precheckReleaseObjs(task.ctx, projectID, rel.ClusterID, rel.Namespace, task.objs, prev)
+}
+
+// reserve 下发资源前写入部署中的部署记录以占用版本号，版本号冲突（并发部署同一目标）时不下发任何资源
+func (t *TemplateReleaseAction) reserve(ctx context.Context, tasks []*releaseTask) error {
+	for i, task := range tasks {
+		rel := task.release
+		for _, obj := range task.objs {
+			rel.Resources = append(rel.Resources, obj.toReleaseResource())
+		}
+		rel.Status = entity.ReleaseStatusDeploying
+		_, err := t.model.CreateTemplateRelease(ctx, rel)
+		if err == nil {
+			continue
+		}
+
+		// 已占用的部署记录标记为失败，其资源均未下发
+		for _, reserved := range tasks[:i] {
+			_ = t.model.UpdateTemplateRelease(ctx, reserved.release.ID.Hex(), entity.M{
+				entity.FieldKeyStatus: entity.ReleaseStatusFailed, entity.FieldKeyMessage: err.Error(),
+			})
+		}
+		if errors.Is(err, drivers.ErrTableRecordDuplicateKey) {
+			return errorx.New(
+				errcode.General, i18n.GetMsg(ctx, "%s/%s 下的部署 %s 正在进行中，请稍后重试"),
+				rel.ClusterID, rel.Namespace, rel.Name,
+			)
+		}
+		return err
+	}
+	return nil
+}
+
+// release 下发资源并更新部署记录的结果，下发失败时部署记录状态为 failed
+func (t *TemplateReleaseAction) release(task *releaseTask) error {
+	ctx, rel := task.ctx, task.release
+
+	rel.Status = entity.ReleaseStatusDeployed
+	if err := applyReleaseObjs(ctx, rel.ClusterID, task.objs); err != nil {
+		log.Warn(ctx, "deploy template release %s to %s/%s failed: %v", rel.Name, rel.ClusterID, rel.Namespace, err)
+		rel.Status, rel.Message = entity.ReleaseStatusFailed, err.Error()
+	} else if task.deployed != nil {
+		// 清理已经不在模板中的资源，并将之前生效的部署记录标记为已取代
+		pruneReleaseResources(ctx, rel.ClusterID, task.deployed.Resources, task.objs)
+		err = t.model.UpdateTemplateRelease(ctx, task.deployed.ID.Hex(), entity.M{
+			entity.FieldKeyStatus: entity.ReleaseStatusSuperseded,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.model.UpdateTemplateRelease(ctx, rel.ID.Hex(), entity.M{
+		entity.FieldKeyStatus: rel.Status, entity.FieldKeyMessage: rel.Message,
+	})
+}
+
+// checkSecretAccess 部署记录的资源清单包含 Secret 数据，需具备目标命名空间 Secret 的查看权限
+func (t *TemplateReleaseAction) checkSecretAccess(ctx context.Context, release *entity.TemplateRelease) error {
+	if release == nil {
+		return nil
+	}
+	hasSecret := false
+	for _, r := range release.Resources {
+		if r.Kind == resCsts.Secret {
+			hasSecret = true
+			break
+		}
+	}
+	if !hasSecret {
+		return nil
+	}
+
+	p, err := project.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	return resPerm.Validate(ctx, "secrets", crAction.View, p.ID, release.ClusterID, release.Namespace)
+}
+
+// getRelease 获取部署记录，只能获取当前项目的部署记录
+func (t *TemplateReleaseAction) getRelease(ctx context.Context, id string) (*entity.TemplateRelease, error) {
+	p, err := project.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := t.model.GetTemplateRelease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if release.ProjectCode != p.Code {
+		return nil, errorx.New(errcode.NoPerm, i18n.GetMsg(ctx, "无权限访问"))
+	}
+	return release, nil
+}
+
+// getLatestRelease 获取目标命名空间下同名部署的最新一条记录，status 为空则不过滤状态
+func (t *TemplateReleaseAction) getLatestRelease(
+	ctx context.Context, projectCode, clusterID, namespace, name, status string,
+) (*entity.TemplateRelease, error) {
+	m := operator.M{
+		entity.FieldKeyProjectCode: projectCode,
+		entity.FieldKeyClusterID:   clusterID,
+		entity.FieldKeyNamespace:   namespace,
+		entity.FieldKeyName:        name,
+	}
+	if status != "" {
+		m[entity.FieldKeyStatus] = status
+	}
+	_, releases, err := t.model.ListTemplateReleases(
+		ctx, operator.NewLeafCondition(operator.Eq, m),
+		&utils.ListOption{Sort: map[string]int{entity.FieldKeyRevision: -1}, Size: 1},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, nil
+	}
+	return releases[0], nil
+}
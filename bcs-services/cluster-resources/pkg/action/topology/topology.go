@@ -0,0 +1,500 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package topology 资源关联拓扑相关逻辑
+package topology
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/cache"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/cache/redis"
+	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	cli "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/client"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/formatter"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/stringx"
+)
+
+const (
+	// cacheName 拓扑缓存名称
+	cacheName = "topology"
+	// cacheTTL 拓扑缓存过期时间，拓扑需要反映资源状态，不宜过长
+	cacheTTL = 30 * time.Second
+	// maxOwnerDepth 向上查找 OwnerReferences 的最大层数
+	maxOwnerDepth = 5
+)
+
+// Node 拓扑节点
+type Node struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+}
+
+// Edge 拓扑边，由 From 指向 To
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// Topology 资源关联拓扑
+type Topology struct {
+	Root  string `json:"root"`
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// ToMap 转换成 map 格式，便于构建响应
+func (t *Topology) ToMap() (map[string]interface{}, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]interface{}{}
+	err = json.Unmarshal(data, &ret)
+	return ret, err
+}
+
+// GetResourceTopology 获取指定工作负载的关联拓扑，包含 OwnerReferences，标签选择器（Service/HPA/PDB/NetworkPolicy），
+// 挂载卷（ConfigMap/Secret/PVC）及 Ingress 后端等关系，结果会缓存一段时间
+func GetResourceTopology(ctx context.Context, clusterID, namespace, kind, name string) (*Topology, error) {
+	conf := res.NewClusterConf(clusterID)
+	// 先获取根资源，同时完成命名空间维度的权限校验，避免通过缓存绕过权限
+	root, err := getManifest(ctx, conf, namespace, kind, "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	topoCache := redis.NewCache(cacheName, cacheTTL)
+	cacheKey := cache.NewStringKey(fmt.Sprintf("%s:%s:%s:%s", clusterID, namespace, kind, name))
+	topo := &Topology{}
+	if err = topoCache.Get(ctx, cacheKey, topo); err == nil {
+		return topo, nil
+	}
+
+	if topo, err = newBuilder(conf, namespace).Build(ctx, kind, root); err != nil {
+		return nil, err
+	}
+	if err = topoCache.Set(ctx, cacheKey, topo, 0); err != nil {
+		// 缓存错误不影响正常流程
+		log.Warn(ctx, "set resource topology cache failed: %v", err)
+	}
+	return topo, nil
+}
+
+// builder 拓扑构建器
+type builder struct {
+	conf      *res.ClusterConf
+	namespace string
+	topo      *Topology
+	nodes     map[string]bool
+	edges     map[string]bool
+	// 同类资源在命名空间下只查询一次
+	listCache map[string][]interface{}
+}
+
+func newBuilder(conf *res.ClusterConf, namespace string) *builder {
+	return &builder{
+		conf:      conf,
+		namespace: namespace,
+		topo:      &Topology{Nodes: []Node{}, Edges: []Edge{}},
+		nodes:     map[string]bool{},
+		edges:     map[string]bool{},
+		listCache: map[string][]interface{}{},
+	}
+}
+
+// Build 以 root 为起点构建拓扑
+func (b *builder) Build(ctx context.Context, kind string, root map[string]interface{}) (*Topology, error) {
+	b.topo.Root = b.addNode(kind, root)
+
+	// 向上查找管理者（如 Pod -> ReplicaSet -> Deployment），最上层的资源作为标签选择器，挂载卷的匹配对象
+	anchorKind, anchor := b.addOwners(ctx, kind, root)
+	// 向下查找子资源（如 Deployment -> ReplicaSet -> Pod）
+	pods, err := b.addSubResources(ctx, kind, root)
+	if err != nil {
+		return nil, err
+	}
+	if kind == resCsts.Po {
+		pods = append(pods, root)
+	}
+
+	// 工作负载中 Pod 模板的标签，Pod 则为自身标签
+	podLabels := getPodTemplateLabels(anchorKind, anchor)
+	anchorID := genNodeID(anchorKind, mapx.GetStr(anchor, "metadata.name"))
+
+	svcIDs := b.addServices(ctx, anchorID, podLabels)
+	b.addLabelSelectorRefs(ctx, resCsts.PDB, "spec.selector", anchorID, podLabels)
+	b.addLabelSelectorRefs(ctx, resCsts.NetPolicy, "spec.podSelector", anchorID, podLabels)
+	b.addHPAs(ctx)
+	b.addIngresses(ctx, svcIDs)
+
+	// 挂载卷：模板中声明的挂载在工作负载上，由 Pod 自身产生的（如 StatefulSet volumeClaimTemplates）挂载在 Pod 上
+	b.addVolumes(ctx, anchorID, getPodTemplateSpec(anchorKind, anchor))
+	for _, po := range pods {
+		b.addVolumes(ctx, genNodeID(resCsts.Po, mapx.GetStr(po, "metadata.name")), mapx.GetMap(po, "spec"))
+	}
+	return b.topo, nil
+}
+
+// addOwners 逐层添加 Controller OwnerReferences，返回最上层的资源
+func (b *builder) addOwners(
+	ctx context.Context, kind string, manifest map[string]interface{},
+) (string, map[string]interface{}) {
+	for i := 0; i < maxOwnerDepth; i++ {
+		ref := getControllerRef(manifest)
+		if ref == nil {
+			break
+		}
+		ownerKind := mapx.GetStr(ref, "kind")
+		owner, err := getManifest(
+			ctx, b.conf, b.namespace, ownerKind, mapx.GetStr(ref, "apiVersion"), mapx.GetStr(ref, "name"),
+		)
+		if err != nil {
+			log.Warn(ctx, "get owner %s/%s failed: %v", ownerKind, mapx.GetStr(ref, "name"), err)
+			break
+		}
+		b.addEdge(b.addNode(ownerKind, owner), genNodeID(kind, mapx.GetStr(manifest, "metadata.name")),
+			resCsts.TopoEdgeTypeOwner)
+		kind, manifest = ownerKind, owner
+	}
+	return kind, manifest
+}
+
+// addSubResources 按层级添加通过 OwnerReferences 关联的子资源，返回其中的 Pod 列表
+func (b *builder) addSubResources(
+	ctx context.Context, kind string, manifest map[string]interface{},
+) ([]map[string]interface{}, error) {
+	pods := []map[string]interface{}{}
+	// key 为上层资源的 uid，value 为上层资源的节点 ID
+	owners := map[string]map[string]string{kind: {mapx.GetStr(manifest, "metadata.uid"): b.topo.Root}}
+	for len(owners) != 0 {
+		subOwners := map[string]map[string]string{}
+		for ownerKind, uids := range owners {
+			for _, subKind := range resCsts.SubResKinds[ownerKind] {
+				items, err := b.list(ctx, subKind)
+				if err != nil {
+					return nil, err
+				}
+				for _, item := range items {
+					sub, _ := item.(map[string]interface{})
+					ref := getControllerRef(sub)
+					if ref == nil {
+						continue
+					}
+					ownerID, ok := uids[mapx.GetStr(ref, "uid")]
+					if !ok {
+						continue
+					}
+					subID := b.addNode(subKind, sub)
+					b.addEdge(ownerID, subID, resCsts.TopoEdgeTypeOwner)
+					if subKind == resCsts.Po {
+						pods = append(pods, sub)
+					}
+					if _, ok = subOwners[subKind]; !ok {
+						subOwners[subKind] = map[string]string{}
+					}
+					subOwners[subKind][mapx.GetStr(sub, "metadata.uid")] = subID
+				}
+			}
+		}
+		owners = subOwners
+	}
+	return pods, nil
+}
+
+// addServices 添加标签选择器匹配的 Service，返回 Service 节点 ID 集合
+func (b *builder) addServices(ctx context.Context, targetID string, podLabels labels.Set) map[string]string {
+	svcIDs := map[string]string{}
+	for _, item := range b.listOptional(ctx, resCsts.SVC) {
+		svc, _ := item.(map[string]interface{})
+		selector := mapx.GetMap(svc, "spec.selector")
+		// Service 未指定 selector 时，不会选中任何 Pod
+		if len(selector) == 0 {
+			continue
+		}
+		set := labels.Set{}
+		for k, v := range selector {
+			set[k], _ = v.(string)
+		}
+		if !labels.SelectorFromSet(set).Matches(podLabels) {
+			continue
+		}
+		svcID := b.addNode(resCsts.SVC, svc)
+		b.addEdge(svcID, targetID, resCsts.TopoEdgeTypeSelector)
+		svcIDs[mapx.GetStr(svc, "metadata.name")] = svcID
+	}
+	return svcIDs
+}
+
+// addLabelSelectorRefs 添加通过 LabelSelector 匹配 Pod 的资源（如 PDB，NetworkPolicy）
+func (b *builder) addLabelSelectorRefs(
+	ctx context.Context, kind, selectorPath, targetID string, podLabels labels.Set,
+) {
+	for _, item := range b.listOptional(ctx, kind) {
+		manifest, _ := item.(map[string]interface{})
+		selector, err := parseLabelSelector(mapx.GetMap(manifest, selectorPath))
+		if err != nil || !selector.Matches(podLabels) {
+			continue
+		}
+		b.addEdge(b.addNode(kind, manifest), targetID, resCsts.TopoEdgeTypeSelector)
+	}
+}
+
+// addHPAs 添加扩缩容目标在拓扑中的 HPA
+func (b *builder) addHPAs(ctx context.Context) {
+	for _, item := range b.listOptional(ctx, resCsts.HPA) {
+		hpa, _ := item.(map[string]interface{})
+		targetID := genNodeID(mapx.GetStr(hpa, "spec.scaleTargetRef.kind"), mapx.GetStr(hpa, "spec.scaleTargetRef.name"))
+		if !b.nodes[targetID] {
+			continue
+		}
+		b.addEdge(b.addNode(resCsts.HPA, hpa), targetID, resCsts.TopoEdgeTypeScaleTarget)
+	}
+}
+
+// addIngresses 添加后端为拓扑中 Service 的 Ingress
+func (b *builder) addIngresses(ctx context.Context, svcIDs map[string]string) {
+	if len(svcIDs) == 0 {
+		return
+	}
+	for _, item := range b.listOptional(ctx, resCsts.Ing) {
+		ing, _ := item.(map[string]interface{})
+		ingID := ""
+		for _, svcName := range getIngBackendSvcNames(ing) {
+			svcID, ok := svcIDs[svcName]
+			if !ok {
+				continue
+			}
+			if ingID == "" {
+				ingID = b.addNode(resCsts.Ing, ing)
+			}
+			b.addEdge(ingID, svcID, resCsts.TopoEdgeTypeIngBackend)
+		}
+	}
+}
+
+// addVolumes 添加 Pod Spec 中挂载的 ConfigMap，Secret，PVC（含 projected 类型）
+func (b *builder) addVolumes(ctx context.Context, ownerID string, podSpec map[string]interface{}) {
+	for _, item := range mapx.GetList(podSpec, "volumes") {
+		vol, _ := item.(map[string]interface{})
+		for kind, nameKey := range resCsts.Volume2ResNameKeyMap {
+			if name := mapx.GetStr(vol, []string{stringx.Decapitalize(kind), nameKey}); name != "" {
+				b.addVolumeRef(ctx, ownerID, kind, name)
+			}
+		}
+		for _, src := range mapx.GetList(vol, "projected.sources") {
+			s, _ := src.(map[string]interface{})
+			if name := mapx.GetStr(s, "configMap.name"); name != "" {
+				b.addVolumeRef(ctx, ownerID, resCsts.CM, name)
+			}
+			if name := mapx.GetStr(s, "secret.name"); name != "" {
+				b.addVolumeRef(ctx, ownerID, resCsts.Secret, name)
+			}
+		}
+	}
+}
+
+// addVolumeRef 添加挂载卷关联的资源，资源不存在时同样添加节点，状态标记为不存在
+func (b *builder) addVolumeRef(ctx context.Context, ownerID, kind, name string) {
+	nodeID := genNodeID(kind, name)
+	if !b.nodes[nodeID] {
+		manifest, err := getManifest(ctx, b.conf, b.namespace, kind, "", name)
+		switch {
+		case err == nil:
+			b.addNode(kind, manifest)
+		case apierrors.IsNotFound(err):
+			b.nodes[nodeID] = true
+			b.topo.Nodes = append(b.topo.Nodes, Node{
+				ID: nodeID, Kind: kind, Namespace: b.namespace, Name: name, Status: resCsts.TopoNodeStatusNotFound,
+			})
+		default:
+			log.Warn(ctx, "get volume ref %s failed: %v", nodeID, err)
+			return
+		}
+	}
+	b.addEdge(ownerID, nodeID, resCsts.TopoEdgeTypeVolume)
+}
+
+// addNode 添加节点（已存在则忽略），返回节点 ID
+func (b *builder) addNode(kind string, manifest map[string]interface{}) string {
+	nodeID := genNodeID(kind, mapx.GetStr(manifest, "metadata.name"))
+	if b.nodes[nodeID] {
+		return nodeID
+	}
+	b.nodes[nodeID] = true
+	b.topo.Nodes = append(b.topo.Nodes, Node{
+		ID:         nodeID,
+		Kind:       kind,
+		APIVersion: mapx.GetStr(manifest, "apiVersion"),
+		Namespace:  mapx.GetStr(manifest, "metadata.namespace"),
+		Name:       mapx.GetStr(manifest, "metadata.name"),
+		Status:     getNodeStatus(kind, manifest),
+	})
+	return nodeID
+}
+
+// addEdge 添加边（已存在则忽略）
+func (b *builder) addEdge(from, to, edgeType string) {
+	key := from + "|" + to + "|" + edgeType
+	if b.edges[key] {
+		return
+	}
+	b.edges[key] = true
+	b.topo.Edges = append(b.topo.Edges, Edge{From: from, To: to, Type: edgeType})
+}
+
+// list 获取命名空间下某类资源列表
+func (b *builder) list(ctx context.Context, kind string) ([]interface{}, error) {
+	if items, ok := b.listCache[kind]; ok {
+		return items, nil
+	}
+	k8sRes, err := res.GetGroupVersionResource(ctx, b.conf, kind, "")
+	if err != nil {
+		return nil, err
+	}
+	ret, err := cli.NewResClient(b.conf, k8sRes).List(ctx, b.namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	b.listCache[kind] = mapx.GetList(ret.UnstructuredContent(), "items")
+	return b.listCache[kind], nil
+}
+
+// listOptional 获取命名空间下某类资源列表，集群中可能不存在该类资源（如低版本集群无 PDB），获取失败不影响拓扑构建
+func (b *builder) listOptional(ctx context.Context, kind string) []interface{} {
+	items, err := b.list(ctx, kind)
+	if err != nil {
+		log.Warn(ctx, "list %s in namespace %s failed: %v", kind, b.namespace, err)
+		return nil
+	}
+	return items
+}
+
+// getManifest 获取指定资源配置
+func getManifest(
+	ctx context.Context, conf *res.ClusterConf, namespace, kind, groupVersion, name string,
+) (map[string]interface{}, error) {
+	k8sRes, err := res.GetGroupVersionResource(ctx, conf, kind, groupVersion)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := cli.NewResClient(conf, k8sRes).Get(ctx, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return ret.UnstructuredContent(), nil
+}
+
+// getControllerRef 获取资源的 Controller OwnerReference，不存在则返回 nil
+func getControllerRef(manifest map[string]interface{}) map[string]interface{} {
+	for _, item := range mapx.GetList(manifest, "metadata.ownerReferences") {
+		if ref, ok := item.(map[string]interface{}); ok && mapx.GetBool(ref, "controller") {
+			return ref
+		}
+	}
+	return nil
+}
+
+// getPodTemplateSpec 获取工作负载的 Pod 模板 Spec，Pod 则为自身 Spec
+func getPodTemplateSpec(kind string, manifest map[string]interface{}) map[string]interface{} {
+	switch kind {
+	case resCsts.Po:
+		return mapx.GetMap(manifest, "spec")
+	case resCsts.CJ:
+		return mapx.GetMap(manifest, "spec.jobTemplate.spec.template.spec")
+	default:
+		return mapx.GetMap(manifest, "spec.template.spec")
+	}
+}
+
+// getPodTemplateLabels 获取工作负载的 Pod 模板标签，Pod 则为自身标签
+func getPodTemplateLabels(kind string, manifest map[string]interface{}) labels.Set {
+	var rawLabels map[string]interface{}
+	switch kind {
+	case resCsts.Po:
+		rawLabels = mapx.GetMap(manifest, "metadata.labels")
+	case resCsts.CJ:
+		rawLabels = mapx.GetMap(manifest, "spec.jobTemplate.spec.template.metadata.labels")
+	default:
+		rawLabels = mapx.GetMap(manifest, "spec.template.metadata.labels")
+	}
+	set := labels.Set{}
+	for k, v := range rawLabels {
+		set[k], _ = v.(string)
+	}
+	return set
+}
+
+// getIngBackendSvcNames 获取 Ingress 后端的 Service 名称，兼容 networking.k8s.io/v1 及 extensions/v1beta1
+func getIngBackendSvcNames(ing map[string]interface{}) []string {
+	backends := []map[string]interface{}{}
+	for _, path := range []string{"spec.defaultBackend", "spec.backend"} {
+		backends = append(backends, mapx.GetMap(ing, path))
+	}
+	for _, rule := range mapx.GetList(ing, "spec.rules") {
+		r, _ := rule.(map[string]interface{})
+		for _, p := range mapx.GetList(r, "http.paths") {
+			path, _ := p.(map[string]interface{})
+			backends = append(backends, mapx.GetMap(path, "backend"))
+		}
+	}
+	names := []string{}
+	for _, backend := range backends {
+		if name := mapx.GetStr(backend, "service.name"); name != "" {
+			names = append(names, name)
+		} else if name = mapx.GetStr(backend, "serviceName"); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// parseLabelSelector 解析 LabelSelector，空的 LabelSelector 匹配所有 Pod
+func parseLabelSelector(raw map[string]interface{}) (labels.Selector, error) {
+	selector := metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &selector); err != nil {
+		return nil, err
+	}
+	return metav1.LabelSelectorAsSelector(&selector)
+}
+
+// getNodeStatus 获取节点状态：工作负载及 Pod 使用格式化后的状态，其他资源使用 status.phase（如 PVC）
+func getNodeStatus(kind string, manifest map[string]interface{}) string {
+	switch kind {
+	case resCsts.Deploy, resCsts.STS, resCsts.Po:
+		status, _ := formatter.GetFormatFunc(kind, mapx.GetStr(manifest, "apiVersion"))(manifest)["status"].(string)
+		return status
+	default:
+		return mapx.GetStr(manifest, "status.phase")
+	}
+}
+
+// genNodeID 生成拓扑节点 ID（同一命名空间下唯一）
+func genNodeID(kind, name string) string {
+	return kind + "/" + name
+}
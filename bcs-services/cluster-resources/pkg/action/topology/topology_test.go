@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+)
+
+func TestGetIngBackendSvcNames(t *testing.T) {
+	ing := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"defaultBackend": map[string]interface{}{
+				"service": map[string]interface{}{"name": "svc-default"},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"http": map[string]interface{}{
+						"paths": []interface{}{
+							map[string]interface{}{
+								"backend": map[string]interface{}{"service": map[string]interface{}{"name": "svc-v1"}},
+							},
+							map[string]interface{}{
+								"backend": map[string]interface{}{"serviceName": "svc-v1beta1"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"svc-default", "svc-v1", "svc-v1beta1"}, getIngBackendSvcNames(ing))
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	podLabels := labels.Set{"app": "nginx", "tier": "web"}
+
+	selector, err := parseLabelSelector(map[string]interface{}{
+		"matchLabels": map[string]interface{}{"app": "nginx"},
+		"matchExpressions": []interface{}{
+			map[string]interface{}{"key": "tier", "operator": "In", "values": []interface{}{"web", "api"}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, selector.Matches(podLabels))
+
+	selector, err = parseLabelSelector(map[string]interface{}{
+		"matchLabels": map[string]interface{}{"app": "redis"},
+	})
+	assert.Nil(t, err)
+	assert.False(t, selector.Matches(podLabels))
+
+	// 空的 LabelSelector 匹配所有 Pod
+	selector, err = parseLabelSelector(map[string]interface{}{})
+	assert.Nil(t, err)
+	assert.True(t, selector.Matches(podLabels))
+}
+
+func TestGetPodTemplateLabels(t *testing.T) {
+	deploy := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "nginx"}},
+			},
+		},
+	}
+	assert.Equal(t, labels.Set{"app": "nginx"}, getPodTemplateLabels(resCsts.Deploy, deploy))
+
+	cj := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"jobTemplate": map[string]interface{}{"spec": deploy["spec"]},
+		},
+	}
+	assert.Equal(t, labels.Set{"app": "nginx"}, getPodTemplateLabels(resCsts.CJ, cj))
+
+	po := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "busybox"}},
+	}
+	assert.Equal(t, labels.Set{"app": "busybox"}, getPodTemplateLabels(resCsts.Po, po))
+}
+
+func TestBuilderDedup(t *testing.T) {
+	b := newBuilder(nil, "default")
+	svc := map[string]interface{}{
+		"apiVersion": "v1",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+	}
+	hpa := map[string]interface{}{
+		"apiVersion": "autoscaling/v2",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+	}
+	svcID := b.addNode(resCsts.SVC, svc)
+	assert.Equal(t, "Service/nginx", svcID)
+	assert.Equal(t, svcID, b.addNode(resCsts.SVC, svc))
+	hpaID := b.addNode(resCsts.HPA, hpa)
+	assert.Equal(t, "HorizontalPodAutoscaler/nginx", hpaID)
+
+	b.addEdge(hpaID, svcID, resCsts.TopoEdgeTypeScaleTarget)
+	b.addEdge(hpaID, svcID, resCsts.TopoEdgeTypeScaleTarget)
+	assert.Equal(t, 2, len(b.topo.Nodes))
+	assert.Equal(t, 1, len(b.topo.Edges))
+
+	ret, err := b.topo.ToMap()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ret["nodes"].([]interface{})))
+	assert.Equal(t, 1, len(ret["edges"].([]interface{})))
+}
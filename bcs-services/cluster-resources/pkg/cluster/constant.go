@@ -41,6 +41,7 @@ var SharedClusterTypes = []string{ClusterTypeShared, ClusterTypeFederationShared
 var SharedClusterEnabledNativeKinds = []string{
 	resCsts.NS, resCsts.CJ, resCsts.Deploy, resCsts.Job, resCsts.Po, resCsts.STS, resCsts.HPA,
 	resCsts.EP, resCsts.Ing, resCsts.SVC, resCsts.CM, resCsts.Secret, resCsts.PVC, resCsts.SA,
+	resCsts.Event,
 }
 
 // SharedClusterBypassClusterScopedKinds 共享集群鉴权忽略的原生资源
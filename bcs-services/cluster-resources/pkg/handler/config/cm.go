@@ -86,7 +86,7 @@ func (h *Handler) CreateCM(
 	ctx context.Context, req *clusterRes.ResCreateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.CM).Create(
-		ctx, req.RawData, req.Format, true, metav1.CreateOptions{},
+		ctx, req.RawData, req.Format, true, metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -96,7 +96,8 @@ func (h *Handler) UpdateCM(
 	ctx context.Context, req *clusterRes.ResUpdateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.CM).Update(
-		ctx, req.Namespace, req.Name, req.RawData, req.Format, metav1.UpdateOptions{},
+		ctx, req.Namespace, req.Name, req.RawData, req.Format,
+		metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
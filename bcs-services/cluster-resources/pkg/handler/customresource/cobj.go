@@ -121,7 +121,8 @@ func (h *Handler) CreateCObj(
 	}
 	// 经过命名空间检查后，若不需要指定命名空间，则认为是集群维度的
 	resp.Data, err = respUtil.BuildCreateAPIResp(
-		ctx, req.ClusterID, kind, apiVersion, manifest, namespace != "", metav1.CreateOptions{},
+		ctx, req.ClusterID, kind, apiVersion, manifest, namespace != "",
+		metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -151,7 +152,8 @@ func (h *Handler) UpdateCObj(
 		return err
 	}
 	resp.Data, err = respUtil.BuildUpdateCObjAPIResp(
-		ctx, req.ClusterID, kind, apiVersion, req.Namespace, req.CobjName, manifest, metav1.UpdateOptions{},
+		ctx, req.ClusterID, kind, apiVersion, req.Namespace, req.CobjName, manifest,
+		metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package event Event 接口实现
+package event
+
+import (
+	"context"
+
+	spb "google.golang.org/protobuf/types/known/structpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/perm"
+	respUtil "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/resp"
+	cli "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/client"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/pbstruct"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+// Handler xxx
+type Handler struct{}
+
+// New xxx
+func New() *Handler {
+	return &Handler{}
+}
+
+// ListEvents 获取命名空间下的事件列表
+func (h *Handler) ListEvents(
+	ctx context.Context, req *clusterRes.ListEventsReq, resp *clusterRes.CommonResp,
+) (err error) {
+	if err = perm.CheckNSAccess(ctx, req.ClusterID, req.Namespace); err != nil {
+		return err
+	}
+	ret, err := cli.NewEventCliByClusterID(ctx, req.ClusterID).List(
+		ctx, req.Namespace, req.Type, metav1.ListOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	resp.Data, err = buildEventListResp(ctx, ret)
+	return err
+}
+
+// GetResourceEvents 获取指定资源及其下层子资源的事件列表
+func (h *Handler) GetResourceEvents(
+	ctx context.Context, req *clusterRes.GetResourceEventsReq, resp *clusterRes.CommonResp,
+) (err error) {
+	if err = perm.CheckNSAccess(ctx, req.ClusterID, req.Namespace); err != nil {
+		return err
+	}
+	ret, err := cli.NewEventCliByClusterID(ctx, req.ClusterID).ListResEvents(
+		ctx, req.Namespace, req.Kind, req.Name, req.Type,
+	)
+	if err != nil {
+		return err
+	}
+	resp.Data, err = buildEventListResp(ctx, ret)
+	return err
+}
+
+// buildEventListResp 事件列表统一以 manifest 格式返回
+func buildEventListResp(ctx context.Context, manifest map[string]interface{}) (*spb.Struct, error) {
+	respDataBuilder, err := respUtil.NewRespDataBuilder(
+		ctx, respUtil.DataBuilderParams{Manifest: manifest, Kind: resCsts.Event, Format: action.ManifestFormat},
+	)
+	if err != nil {
+		return nil, err
+	}
+	respData, err := respDataBuilder.BuildList()
+	if err != nil {
+		return nil, err
+	}
+	return pbstruct.Map2pbStruct(respData)
+}
@@ -0,0 +1,51 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/envs"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/handler"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+func TestEvent(t *testing.T) {
+	h := New()
+	ctx := handler.NewInjectedContext("", "", "")
+
+	// List
+	listReq, listResp := clusterRes.ListEventsReq{
+		ProjectID: envs.TestProjectID,
+		ClusterID: envs.TestClusterID,
+		Namespace: envs.TestNamespace,
+	}, clusterRes.CommonResp{}
+	err := h.ListEvents(ctx, &listReq, &listResp)
+	assert.Nil(t, err)
+	assert.Equal(t, "EventList", mapx.GetStr(listResp.Data.AsMap(), "manifest.kind"))
+
+	// GetResourceEvents
+	getReq, getResp := clusterRes.GetResourceEventsReq{
+		ProjectID: envs.TestProjectID,
+		ClusterID: envs.TestClusterID,
+		Namespace: envs.TestNamespace,
+		Kind:      "Deployment",
+		Name:      "nginx",
+	}, clusterRes.CommonResp{}
+	err = h.GetResourceEvents(ctx, &getReq, &getResp)
+	assert.Nil(t, err)
+	assert.Equal(t, "EventList", mapx.GetStr(getResp.Data.AsMap(), "manifest.kind"))
+}
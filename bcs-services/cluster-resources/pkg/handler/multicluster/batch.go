@@ -0,0 +1,459 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multicluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/audit"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	resAction "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/resource"
+	audit2 "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/audit"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/cluster"
+	crAction "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/action"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/ctxkey"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/errcode"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/component/project"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/i18n"
+	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/perm"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/errorx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/pbstruct"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/slice"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/wrapper"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+const (
+	// BatchActionScale 扩缩容
+	BatchActionScale = "scale"
+	// BatchActionRestart 重启
+	BatchActionRestart = "restart"
+	// BatchActionPause 暂停更新
+	BatchActionPause = "pause"
+	// BatchActionResume 恢复更新
+	BatchActionResume = "resume"
+	// BatchActionLabel 修改标签
+	BatchActionLabel = "label"
+	// BatchActionAnnotate 修改注解
+	BatchActionAnnotate = "annotate"
+	// BatchActionDelete 删除
+	BatchActionDelete = "delete"
+)
+
+const (
+	// BatchStatusSuccess 操作成功
+	BatchStatusSuccess = "success"
+	// BatchStatusFailed 操作失败
+	BatchStatusFailed = "failed"
+)
+
+const (
+	// defaultBatchConcurrency 批量操作默认并发数
+	defaultBatchConcurrency = 10
+	// maxBatchTargets 单次批量操作允许的最大资源数量
+	maxBatchTargets = 500
+	// batchAuditActionID 批量操作审计的 ActionID
+	batchAuditActionID = "MultiCluster.MultiClusterBatchOperate"
+)
+
+// batchActionKinds 批量操作支持的资源类型，未配置的操作不限制资源类型
+var batchActionKinds = map[string][]string{
+	BatchActionScale:   resAction.ScalableResKinds,
+	BatchActionRestart: {resCsts.Deploy, resCsts.STS, resCsts.DS},
+	BatchActionPause:   {resCsts.Deploy},
+	BatchActionResume:  {resCsts.Deploy},
+}
+
+// batchTarget 批量操作目标及其操作结果
+type batchTarget struct {
+	ClusterID string
+	Namespace string
+	Name      string
+	Status    string
+	Message   string
+
+	manifest map[string]interface{}
+}
+
+func (t *batchTarget) key() string {
+	return fmt.Sprintf("%s/%s/%s", t.ClusterID, t.Namespace, t.Name)
+}
+
+// setResult 设置操作结果
+func (t *batchTarget) setResult(err error) {
+	if err != nil {
+		t.Status, t.Message = BatchStatusFailed, err.Error()
+		return
+	}
+	t.Status, t.Message = BatchStatusSuccess, ""
+}
+
+func (t *batchTarget) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"clusterID": t.ClusterID,
+		"namespace": t.Namespace,
+		"name":      t.Name,
+		"status":    t.Status,
+		"message":   t.Message,
+	}
+}
+
+// MultiClusterBatchOperate 对多集群资源查询结果进行批量操作
+func (h *Handler) MultiClusterBatchOperate(ctx context.Context, req *clusterRes.MultiClusterBatchOperateReq,
+	resp *clusterRes.CommonResp) (err error) {
+	if err = validateBatchOperateReq(ctx, req); err != nil {
+		return err
+	}
+	// 获取视图信息
+	view := &entity.View{}
+	if req.GetViewID() != "" {
+		view, err = h.model.GetView(ctx, req.GetViewID())
+		if err != nil {
+			return err
+		}
+	}
+
+	targets, err := listBatchTargets(ctx, req, viewQueryToQueryFilter(view.Filter))
+	if err != nil {
+		return err
+	}
+	if len(targets) > maxBatchTargets {
+		return errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "批量操作的资源数量 %d 超过上限 %d，请缩小查询范围"),
+			len(targets), maxBatchTargets)
+	}
+
+	projInfo, err := project.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	op := &batchOperator{req: req, projectID: projInfo.ID, projectCode: projInfo.Code}
+	op.Do(ctx, targets)
+
+	resp.Data, err = pbstruct.Map2pbStruct(genBatchReport(targets))
+	return err
+}
+
+// validateBatchOperateReq 检查批量操作与资源类型，参数是否匹配
+func validateBatchOperateReq(ctx context.Context, req *clusterRes.MultiClusterBatchOperateReq) error {
+	if kinds, ok := batchActionKinds[req.GetAction()]; ok && !slice.StringInSlice(req.GetKind(), kinds) {
+		return errorx.New(errcode.Unsupported, i18n.GetMsg(ctx, "资源类型 %s 不支持批量操作 %s"),
+			req.GetKind(), req.GetAction())
+	}
+	if (req.GetAction() == BatchActionLabel || req.GetAction() == BatchActionAnnotate) &&
+		len(req.GetMetadata()) == 0 && len(req.GetRemoveKeys()) == 0 {
+		return errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "修改标签/注解需要指定新增或移除的键"))
+	}
+	// 未指定副本数时不能默认缩容到 0，必须显式传入
+	if req.GetAction() == BatchActionScale && req.GetReplicas() == nil {
+		return errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "扩缩容需要指定副本数量"))
+	}
+	// 不允许以空目标操作查询结果中的全部资源
+	if len(req.GetTargets()) == 0 {
+		return errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "批量操作需要指定操作目标"))
+	}
+	return nil
+}
+
+// listBatchTargets 获取多集群资源查询结果作为批量操作目标，仅保留查询结果中指定的资源
+func listBatchTargets(ctx context.Context, req *clusterRes.MultiClusterBatchOperateReq, viewFilter QueryFilter) (
+	[]*batchTarget, error) {
+	filter := QueryFilter{
+		Creator:       req.GetCreator(),
+		Name:          req.GetName(),
+		LabelSelector: req.GetLabelSelector(),
+		IP:            req.GetIp(),
+		Status:        req.GetStatus(),
+	}
+	clusterNS := filterClusteredNamespace(req.GetClusterNamespaces(), string(getScopedByKind(req.GetKind())))
+	clusterNS, err := checkMultiClusterAccess(ctx, req.GetKind(), clusterNS)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := listResource(ctx, clusterNS, getGroupVersionByKind(req.GetKind()), req.GetKind(),
+		metav1.ListOptions{LabelSelector: viewFilter.LabelSelectorString()})
+	if err != nil {
+		return nil, err
+	}
+	resources = ApplyFilter(resources, viewFilter.CreatorFilter, viewFilter.NameFilter)
+	resources = ApplyFilter(resources, filter.CreatorFilter, filter.NameFilter,
+		filter.StatusFilter, filter.LabelSelectorFilter, filter.IPFilter)
+
+	selected := map[string]struct{}{}
+	for _, v := range req.GetTargets() {
+		t := batchTarget{ClusterID: v.GetClusterID(), Namespace: v.GetNamespace(), Name: v.GetName()}
+		selected[t.key()] = struct{}{}
+	}
+
+	targets := []*batchTarget{}
+	matched := map[string]struct{}{}
+	for _, r := range resources {
+		t := &batchTarget{
+			ClusterID: r.ClusterID,
+			Namespace: mapx.GetStr(r.Data, "metadata.namespace"),
+			Name:      mapx.GetStr(r.Data, "metadata.name"),
+			manifest:  r.Data,
+		}
+		if _, ok := selected[t.key()]; !ok {
+			continue
+		}
+		matched[t.key()] = struct{}{}
+		targets = append(targets, t)
+	}
+	// 指定的目标不在查询结果中（不存在或无权限访问），直接标记为失败
+	for _, v := range req.GetTargets() {
+		t := &batchTarget{ClusterID: v.GetClusterID(), Namespace: v.GetNamespace(), Name: v.GetName()}
+		if _, ok := matched[t.key()]; !ok {
+			t.setResult(errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "资源不在查询结果中，可能不存在或无权限访问")))
+			targets = append(targets, t)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].key() < targets[j].key()
+	})
+	return targets, nil
+}
+
+// batchOperator 多集群资源批量操作执行器
+type batchOperator struct {
+	req         *clusterRes.MultiClusterBatchOperateReq
+	projectID   string
+	projectCode string
+}
+
+// Do 按集群检查权限后，并发执行批量操作，结果记录在各个操作目标中
+func (o *batchOperator) Do(ctx context.Context, targets []*batchTarget) {
+	concurrency := int(o.req.GetConcurrency())
+	if concurrency == 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	// 1. 按集群注入集群信息，每个集群只获取一次
+	clusterCtxs := map[string]context.Context{}
+	clusterErrs := map[string]error{}
+	for _, t := range targets {
+		if t.Status != "" {
+			continue
+		}
+		if _, ok := clusterCtxs[t.ClusterID]; ok {
+			continue
+		}
+		if _, ok := clusterErrs[t.ClusterID]; ok {
+			continue
+		}
+		clusterCtx, err := o.withClusterCtx(ctx, t.ClusterID)
+		if err != nil {
+			clusterErrs[t.ClusterID] = err
+			continue
+		}
+		clusterCtxs[t.ClusterID] = clusterCtx
+	}
+
+	// 2. 按集群 + 命名空间进行 IAM 权限检查，每个命名空间只检查一次
+	permKeys := map[string]*batchTarget{}
+	for _, t := range targets {
+		if t.Status != "" || clusterErrs[t.ClusterID] != nil {
+			continue
+		}
+		if _, ok := permKeys[t.ClusterID+"/"+t.Namespace]; !ok {
+			permKeys[t.ClusterID+"/"+t.Namespace] = t
+		}
+	}
+	permErrs := map[string]error{}
+	mux := sync.Mutex{}
+	eg := errgroup.Group{}
+	eg.SetLimit(concurrency)
+	for k, v := range permKeys {
+		key, t := k, v
+		eg.Go(func() error {
+			err := o.validatePerm(clusterCtxs[t.ClusterID], t.ClusterID, t.Namespace)
+			mux.Lock()
+			defer mux.Unlock()
+			permErrs[key] = err
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	// 3. 并发执行操作，并逐个记录审计
+	eg = errgroup.Group{}
+	eg.SetLimit(concurrency)
+	for _, v := range targets {
+		t := v
+		if t.Status != "" {
+			continue
+		}
+		if err := clusterErrs[t.ClusterID]; err != nil {
+			t.setResult(err)
+			continue
+		}
+		if err := permErrs[t.ClusterID+"/"+t.Namespace]; err != nil {
+			t.setResult(err)
+			o.addAudit(ctx, t, time.Now(), time.Now())
+			continue
+		}
+		eg.Go(func() error {
+			startTime := time.Now()
+			t.setResult(o.operate(clusterCtxs[t.ClusterID], t))
+			o.addAudit(ctx, t, startTime, time.Now())
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+// withClusterCtx 获取集群信息并注入到 Context 中，独占集群需确认属于当前项目
+func (o *batchOperator) withClusterCtx(ctx context.Context, clusterID string) (context.Context, error) {
+	clusterInfo, err := cluster.GetClusterInfo(ctx, clusterID)
+	if err != nil {
+		return nil, errorx.New(errcode.General, i18n.GetMsg(ctx, "获取集群 %s 信息失败：%v"), clusterID, err)
+	}
+	if !slice.StringInSlice(clusterInfo.Type, cluster.SharedClusterTypes) && clusterInfo.ProjID != o.projectID {
+		return nil, errorx.New(errcode.ValidateErr, i18n.GetMsg(ctx, "集群 %s 不属于指定项目!"), clusterID)
+	}
+	return context.WithValue(ctx, ctxkey.ClusterKey, clusterInfo), nil
+}
+
+// validatePerm 检查指定集群命名空间下的资源操作权限
+func (o *batchOperator) validatePerm(ctx context.Context, clusterID, namespace string) error {
+	k8sRes, err := res.GetGroupVersionResource(
+		ctx, res.NewClusterConf(clusterID), o.req.GetKind(), getGroupVersionByKind(o.req.GetKind()),
+	)
+	if err != nil {
+		return err
+	}
+	action := crAction.Update
+	if o.req.GetAction() == BatchActionDelete {
+		action = crAction.Delete
+	}
+	return perm.Validate(ctx, k8sRes.Resource, action, o.projectID, clusterID, namespace)
+}
+
+// operate 对单个资源执行操作
+func (o *batchOperator) operate(ctx context.Context, t *batchTarget) (err error) {
+	resMgr := resAction.NewResMgr(t.ClusterID, mapx.GetStr(t.manifest, "apiVersion"), o.req.GetKind())
+	switch o.req.GetAction() {
+	case BatchActionScale:
+		_, err = resMgr.Scale(ctx, t.Namespace, t.Name, o.req.GetReplicas().GetValue(), metav1.PatchOptions{})
+	case BatchActionRestart:
+		// 标记 generation 用来标识应用是否在重启状态
+		generation := mapx.GetInt64(t.manifest, "metadata.generation")
+		_, err = resMgr.Restart(
+			ctx, t.Namespace, t.Name, generation+1, metav1.PatchOptions{FieldManager: "kubectl-rollout"},
+		)
+	case BatchActionPause, BatchActionResume:
+		_, err = resMgr.PauseOrResume(
+			ctx, t.Namespace, t.Name, o.req.GetAction() == BatchActionPause, metav1.PatchOptions{},
+		)
+	case BatchActionLabel, BatchActionAnnotate:
+		field := "labels"
+		if o.req.GetAction() == BatchActionAnnotate {
+			field = "annotations"
+		}
+		patchByte := genMetadataPatch(field, o.req.GetMetadata(), o.req.GetRemoveKeys())
+		_, err = resMgr.Patch(ctx, t.Namespace, t.Name, types.MergePatchType, patchByte, metav1.PatchOptions{})
+	case BatchActionDelete:
+		err = resMgr.Delete(ctx, t.Namespace, t.Name, metav1.DeleteOptions{})
+	default:
+		err = errorx.New(errcode.Unsupported, i18n.GetMsg(ctx, "不支持的批量操作 %s"), o.req.GetAction())
+	}
+	if err != nil {
+		log.Warn(ctx, "batch %s %s %s failed: %v", o.req.GetAction(), o.req.GetKind(), t.key(), err)
+	}
+	return err
+}
+
+// addAudit 记录单个资源的操作审计
+func (o *batchOperator) addAudit(ctx context.Context, t *batchTarget, startTime, endTime time.Time) {
+	activityType := audit.ActivityTypeUpdate
+	if o.req.GetAction() == BatchActionDelete {
+		activityType = audit.ActivityTypeDelete
+	}
+	auditCtx := audit.RecorderContext{
+		Username:  ctxkey.GetUsernameFromCtx(ctx),
+		SourceIP:  wrapper.GetSourceIPFromCtx(ctx),
+		UserAgent: wrapper.GetUserAgentFromCtx(ctx),
+		RequestID: fmt.Sprintf("%s", ctx.Value(ctxkey.RequestIDKey)),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	resource := audit.Resource{
+		ProjectCode:  o.projectCode,
+		ResourceType: audit.ResourceTypeK8SResource,
+		ResourceID:   t.Name,
+		ResourceName: t.Name,
+		ResourceData: map[string]any{
+			"ClusterID":   t.ClusterID,
+			"Namespace":   t.Namespace,
+			"Name":        t.Name,
+			"Kind":        o.req.GetKind(),
+			"BatchAction": o.req.GetAction(),
+		},
+	}
+	action := audit.Action{ActionID: batchAuditActionID, ActivityType: activityType}
+	result := audit.ActionResult{Status: audit.ActivityStatusSuccess, ResultCode: errcode.NoErr}
+	if t.Status != BatchStatusSuccess {
+		result = audit.ActionResult{
+			Status: audit.ActivityStatusFailed, ResultCode: errcode.General, ResultContent: t.Message,
+		}
+	}
+	_ = audit2.GetAuditClient().R().SetContext(auditCtx).SetResource(resource).SetAction(action).
+		SetResult(result).Do()
+}
+
+// genMetadataPatch 生成修改标签/注解的 merge patch，需要移除的键设置为 null
+func genMetadataPatch(field string, items map[string]string, removeKeys []string) []byte {
+	values := map[string]interface{}{}
+	for k, v := range items {
+		values[k] = v
+	}
+	for _, k := range removeKeys {
+		values[k] = nil
+	}
+	patchByte, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{field: values},
+	})
+	return patchByte
+}
+
+// genBatchReport 生成批量操作结果报告
+func genBatchReport(targets []*batchTarget) map[string]interface{} {
+	succeeded, failed := 0, 0
+	results := make([]map[string]interface{}, 0, len(targets))
+	for _, t := range targets {
+		if t.Status == BatchStatusSuccess {
+			succeeded++
+		} else {
+			failed++
+		}
+		results = append(results, t.toMap())
+	}
+	return map[string]interface{}{
+		"total":     len(targets),
+		"succeeded": succeeded,
+		"failed":    failed,
+		"results":   results,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+func TestValidateBatchOperateReq(t *testing.T) {
+	ctx := context.TODO()
+	targets := []*clusterRes.BatchOperateTarget{{ClusterID: "BCS-K8S-00000", Namespace: "default", Name: "a"}}
+	// 支持的资源类型
+	assert.Nil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.Deploy, Action: BatchActionScale, Targets: targets, Replicas: wrapperspb.Int64(0),
+	}))
+	assert.Nil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.DS, Action: BatchActionRestart, Targets: targets,
+	}))
+	// 未限制资源类型的操作
+	assert.Nil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.CM, Action: BatchActionDelete, Targets: targets,
+	}))
+	// 不支持的资源类型
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.DS, Action: BatchActionScale, Targets: targets, Replicas: wrapperspb.Int64(1),
+	}))
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.STS, Action: BatchActionPause, Targets: targets,
+	}))
+	// 标签/注解操作必须指定键
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.CM, Action: BatchActionLabel, Targets: targets,
+	}))
+	assert.Nil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.CM, Action: BatchActionAnnotate, RemoveKeys: []string{"a"}, Targets: targets,
+	}))
+	// 扩缩容必须指定副本数量
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.Deploy, Action: BatchActionScale, Targets: targets,
+	}))
+	// 必须指定操作目标
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.CM, Action: BatchActionDelete,
+	}))
+	assert.NotNil(t, validateBatchOperateReq(ctx, &clusterRes.MultiClusterBatchOperateReq{
+		Kind: resCsts.Deploy, Action: BatchActionScale, Replicas: wrapperspb.Int64(1),
+	}))
+}
+
+func TestGenMetadataPatch(t *testing.T) {
+	patch := genMetadataPatch("labels", map[string]string{"app": "nginx"}, []string{"env"})
+	assert.Equal(t, `{"metadata":{"labels":{"app":"nginx","env":null}}}`, string(patch))
+
+	patch = genMetadataPatch("annotations", nil, nil)
+	assert.Equal(t, `{"metadata":{"annotations":{}}}`, string(patch))
+}
+
+func TestGenBatchReport(t *testing.T) {
+	targets := []*batchTarget{
+		{ClusterID: "BCS-K8S-00000", Namespace: "default", Name: "a"},
+		{ClusterID: "BCS-K8S-00000", Namespace: "default", Name: "b"},
+		{ClusterID: "BCS-K8S-00001", Namespace: "", Name: "c"},
+	}
+	targets[0].setResult(nil)
+	targets[1].setResult(errors.New("forbidden"))
+	targets[2].setResult(nil)
+
+	report := genBatchReport(targets)
+	assert.Equal(t, 3, report["total"])
+	assert.Equal(t, 2, report["succeeded"])
+	assert.Equal(t, 1, report["failed"])
+
+	results := report["results"].([]map[string]interface{})
+	assert.Equal(t, BatchStatusFailed, results[1]["status"])
+	assert.Equal(t, "forbidden", mapx.GetStr(results[1], "message"))
+	assert.Equal(t, "BCS-K8S-00001//c", targets[2].key())
+}
@@ -73,7 +73,7 @@ type QueryFilter struct {
 	Name          string
 	LabelSelector []*clusterRes.LabelSelector
 	IP            string   // IP 过滤条件，包括IPV4、IPV6、HostIP，目前仅 Pod 支持
-	Status        []string // 状态过滤条件，目前仅 Deployment 支持
+	Status        []string // 状态过滤条件，目前支持 Deployment 及 Event（按事件类型 Normal/Warning 过滤）
 	SortBy        SortBy
 	Order         Order
 	Limit         int
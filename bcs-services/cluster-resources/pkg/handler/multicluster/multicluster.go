@@ -75,7 +75,7 @@ func (h *Handler) FetchMultiClusterResource(ctx context.Context, req *clusterRes
 	}
 
 	var data map[string]interface{}
-	data, err = query.Fetch(ctx, "", req.GetKind())
+	data, err = query.Fetch(ctx, getGroupVersionByKind(req.GetKind()), req.GetKind())
 	if err != nil {
 		return err
 	}
@@ -173,7 +173,7 @@ func (h *Handler) MultiClusterResourceCount(ctx context.Context, req *clusterRes
 				inBlackList(req.GetProjectCode(), clusterNS)) {
 				query = NewStorageQuery(clusterNS, filter, viewQueryToQueryFilter(view.Filter))
 			}
-			result, innerErr := query.Fetch(ctx, "", kind)
+			result, innerErr := query.Fetch(ctx, getGroupVersionByKind(kind), kind)
 			mux.Lock()
 			defer mux.Unlock()
 			if innerErr != nil {
@@ -33,6 +33,7 @@ import (
 	log "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/logging"
 	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
 	cli "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/client"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/formatter"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/storage"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
@@ -350,6 +351,15 @@ func getScopedByKind(kind string) apiextensions.ResourceScope {
 	return apiextensions.NamespaceScoped
 }
 
+// getGroupVersionByKind 根据资源类型获取需特殊指定的 groupVersion，为空则使用 preferred version
+func getGroupVersionByKind(kind string) string {
+	// events.k8s.io 下存在同名资源 Event，需指定为 core/v1
+	if kind == resCsts.Event {
+		return resCsts.DefaultEventGroupVersion
+	}
+	return ""
+}
+
 // filterClusteredNamespace 过滤集群命名空间，如果是集群域资源，则不能带命名空间
 func filterClusteredNamespace(clusterNs []*clusterRes.ClusterNamespaces,
 	scoped string) []*clusterRes.ClusterNamespaces {
@@ -77,7 +77,7 @@ func (h *Handler) CreateEP(
 	ctx context.Context, req *clusterRes.ResCreateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.EP).Create(
-		ctx, req.RawData, req.Format, true, metav1.CreateOptions{},
+		ctx, req.RawData, req.Format, true, metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -87,7 +87,8 @@ func (h *Handler) UpdateEP(
 	ctx context.Context, req *clusterRes.ResUpdateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.EP).Update(
-		ctx, req.Namespace, req.Name, req.RawData, req.Format, metav1.UpdateOptions{},
+		ctx, req.Namespace, req.Name, req.RawData, req.Format,
+		metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -70,7 +70,7 @@ func (h *Handler) CreateIng(
 	ctx context.Context, req *clusterRes.ResCreateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.Ing).Create(
-		ctx, req.RawData, req.Format, true, metav1.CreateOptions{},
+		ctx, req.RawData, req.Format, true, metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -80,7 +80,8 @@ func (h *Handler) UpdateIng(
 	ctx context.Context, req *clusterRes.ResUpdateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.Ing).Update(
-		ctx, req.Namespace, req.Name, req.RawData, req.Format, metav1.UpdateOptions{},
+		ctx, req.Namespace, req.Name, req.RawData, req.Format,
+		metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
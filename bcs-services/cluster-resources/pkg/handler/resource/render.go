@@ -15,6 +15,7 @@ package resource
 import (
 	"context"
 
+	resAction "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/resource"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/common/errcode"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/i18n"
 	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
@@ -35,6 +36,11 @@ func (h *Handler) FormDataRenderPreview(
 	if err != nil {
 		return errorx.New(errcode.General, i18n.GetMsg(ctx, "预览表单渲染结果失败，请检查您填写的表单配置；错误信息：%w"), err)
 	}
+	// dry-run 模式下，将渲染结果下发到集群进行校验，并返回与集群中资源的差异
+	if req.DryRun {
+		resp.Data, err = resAction.NewResMgr(req.ClusterID, "", req.Kind).DryRunApply(ctx, manifest)
+		return err
+	}
 	resp.Data, err = pbstruct.Map2pbStruct(manifest)
 	return err
 }
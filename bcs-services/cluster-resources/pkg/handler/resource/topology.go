@@ -0,0 +1,41 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resource
+
+import (
+	"context"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/perm"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/topology"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/pbstruct"
+	clusterRes "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/proto/cluster-resources"
+)
+
+// GetResourceTopology 获取指定工作负载的关联拓扑
+func (h *Handler) GetResourceTopology(
+	ctx context.Context, req *clusterRes.GetResourceTopologyReq, resp *clusterRes.CommonResp,
+) error {
+	if err := perm.CheckNSAccess(ctx, req.ClusterID, req.Namespace); err != nil {
+		return err
+	}
+	topo, err := topology.GetResourceTopology(ctx, req.ClusterID, req.Namespace, req.Kind, req.Name)
+	if err != nil {
+		return err
+	}
+	data, err := topo.ToMap()
+	if err != nil {
+		return err
+	}
+	resp.Data, err = pbstruct.Map2pbStruct(data)
+	return err
+}
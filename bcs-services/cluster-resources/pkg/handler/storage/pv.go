@@ -79,7 +79,7 @@ func (h *Handler) CreatePV(
 	ctx context.Context, req *clusterRes.ResCreateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.PV).Create(
-		ctx, req.RawData, req.Format, false, metav1.CreateOptions{},
+		ctx, req.RawData, req.Format, false, metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -89,7 +89,7 @@ func (h *Handler) UpdatePV(
 	ctx context.Context, req *clusterRes.ResUpdateReq, resp *clusterRes.CommonResp,
 ) (err error) {
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.PV).Update(
-		ctx, "", req.Name, req.RawData, req.Format, metav1.UpdateOptions{},
+		ctx, "", req.Name, req.RawData, req.Format, metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -72,7 +72,7 @@ func (h *Handler) CreateSC(
 		return errorx.New(errcode.NoPerm, i18n.GetMsg(ctx, "该请求资源类型 %s 在共享集群中不可用"), resCsts.SC)
 	}
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.SC).Create(
-		ctx, req.RawData, req.Format, false, metav1.CreateOptions{},
+		ctx, req.RawData, req.Format, false, metav1.CreateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -89,7 +89,7 @@ func (h *Handler) UpdateSC(
 		return errorx.New(errcode.NoPerm, i18n.GetMsg(ctx, "该请求资源类型 %s 在共享集群中不可用"), resCsts.SC)
 	}
 	resp.Data, err = resAction.NewResMgr(req.ClusterID, "", resCsts.SC).Update(
-		ctx, "", req.Name, req.RawData, req.Format, metav1.UpdateOptions{},
+		ctx, "", req.Name, req.RawData, req.Format, metav1.UpdateOptions{DryRun: resAction.GenDryRunOpts(req.DryRun)},
 	)
 	return err
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/envmanage"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/template"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/templaterelease"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/templatespace"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/action/templateversion"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store"
@@ -301,3 +302,73 @@ func (h *Handler) DeleteEnvManage(
 	}
 	return nil
 }
+
+// DeployTemplateSet 部署模板集
+func (h *Handler) DeployTemplateSet(
+	ctx context.Context, req *clusterRes.DeployTemplateSetReq, resp *clusterRes.CommonResp) error {
+	action := templaterelease.NewTemplateReleaseAction(h.model)
+	releases, err := action.Deploy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Data, err = pbstruct.Map2pbStruct(map[string]interface{}{"releases": releases}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListTemplateReleases 获取模板集部署记录列表
+func (h *Handler) ListTemplateReleases(
+	ctx context.Context, req *clusterRes.ListTemplateReleasesReq, resp *clusterRes.CommonListResp) error {
+	action := templaterelease.NewTemplateReleaseAction(h.model)
+	data, err := action.List(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Data, err = pbstruct.MapSlice2ListValue(data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTemplateRelease 获取模板集部署记录详情
+func (h *Handler) GetTemplateRelease(
+	ctx context.Context, req *clusterRes.GetTemplateReleaseReq, resp *clusterRes.CommonResp) error {
+	action := templaterelease.NewTemplateReleaseAction(h.model)
+	data, err := action.Get(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	if resp.Data, err = pbstruct.Map2pbStruct(data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DiffTemplateRelease 对比模板集部署记录
+func (h *Handler) DiffTemplateRelease(
+	ctx context.Context, req *clusterRes.DiffTemplateReleaseReq, resp *clusterRes.CommonResp) error {
+	action := templaterelease.NewTemplateReleaseAction(h.model)
+	data, err := action.Diff(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Data, err = pbstruct.Map2pbStruct(data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RollbackTemplateRelease 回滚模板集部署记录
+func (h *Handler) RollbackTemplateRelease(
+	ctx context.Context, req *clusterRes.RollbackTemplateReleaseReq, resp *clusterRes.CommonResp) error {
+	action := templaterelease.NewTemplateReleaseAction(h.model)
+	data, err := action.Rollback(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	if resp.Data, err = pbstruct.Map2pbStruct(data); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	res "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource"
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/formatter"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+)
+
+// EventClient Event Client
+type EventClient struct {
+	ResClient
+}
+
+// NewEventClient ...
+func NewEventClient(ctx context.Context, conf *res.ClusterConf) *EventClient {
+	eventRes, _ := res.GetGroupVersionResource(ctx, conf, resCsts.Event, resCsts.DefaultEventGroupVersion)
+	return &EventClient{ResClient{NewDynamicClient(conf), conf, eventRes}}
+}
+
+// NewEventCliByClusterID ...
+func NewEventCliByClusterID(ctx context.Context, clusterID string) *EventClient {
+	return NewEventClient(ctx, res.NewClusterConf(clusterID))
+}
+
+// List 获取命名空间下的事件列表，可按事件类型过滤，结果已去重并按最后发生时间倒序
+func (c *EventClient) List(
+	ctx context.Context, namespace, eventType string, opts metav1.ListOptions,
+) (map[string]interface{}, error) {
+	if eventType != "" {
+		opts.FieldSelector = "type=" + eventType
+	}
+	ret, err := c.ResClient.List(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	manifest := ret.UnstructuredContent()
+	manifest["items"] = AggregateEvents(mapx.GetList(manifest, "items"))
+	return manifest, nil
+}
+
+// ListResEvents 获取指定资源及其下层子资源（如 Deployment -> ReplicaSet -> Pod）的事件列表，
+// 结果已去重并按最后发生时间倒序
+func (c *EventClient) ListResEvents(
+	ctx context.Context, namespace, kind, name, eventType string,
+) (map[string]interface{}, error) {
+	involvedObjs, err := c.getInvolvedObjects(ctx, namespace, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	opts := metav1.ListOptions{}
+	if eventType != "" {
+		opts.FieldSelector = "type=" + eventType
+	}
+	ret, err := c.ResClient.List(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	manifest := ret.UnstructuredContent()
+
+	events := []interface{}{}
+	for _, item := range mapx.GetList(manifest, "items") {
+		event, _ := item.(map[string]interface{})
+		objKey := genInvolvedObjKey(
+			mapx.GetStr(event, "involvedObject.kind"), mapx.GetStr(event, "involvedObject.name"),
+		)
+		if involvedObjs[objKey] {
+			events = append(events, event)
+		}
+	}
+	manifest["items"] = AggregateEvents(events)
+	return manifest, nil
+}
+
+// getInvolvedObjects 获取指定资源及通过 OwnerReferences 逐层关联的所有子资源，key 为 kind/name
+func (c *EventClient) getInvolvedObjects(
+	ctx context.Context, namespace, kind, name string,
+) (map[string]bool, error) {
+	involvedObjs := map[string]bool{genInvolvedObjKey(kind, name): true}
+	ownerRefs := []map[string]string{{"kind": kind, "name": name}}
+	// 按层级向下钻取，同一层级中的同类子资源只需查询一次
+	for len(ownerRefs) != 0 {
+		subKindOwnerRefs := map[string][]map[string]string{}
+		for _, ref := range ownerRefs {
+			for _, subKind := range resCsts.SubResKinds[ref["kind"]] {
+				subKindOwnerRefs[subKind] = append(subKindOwnerRefs[subKind], ref)
+			}
+		}
+		ownerRefs = []map[string]string{}
+		for subKind, refs := range subKindOwnerRefs {
+			subRes, err := res.GetGroupVersionResource(ctx, c.conf, subKind, "")
+			if err != nil {
+				return nil, err
+			}
+			ret, err := NewResClient(c.conf, subRes).List(ctx, namespace, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range filterByOwnerRefs(mapx.GetList(ret.UnstructuredContent(), "items"), refs) {
+				subName := mapx.GetStr(r.(map[string]interface{}), "metadata.name")
+				if involvedObjs[genInvolvedObjKey(subKind, subName)] {
+					continue
+				}
+				involvedObjs[genInvolvedObjKey(subKind, subName)] = true
+				ownerRefs = append(ownerRefs, map[string]string{"kind": subKind, "name": subName})
+			}
+		}
+	}
+	return involvedObjs, nil
+}
+
+// AggregateEvents 事件去重聚合：同一对象上类型，原因，信息均相同的事件合并为一条，
+// 累加发生次数，保留最早的首次发生时间及最晚的最后发生时间，结果按最后发生时间倒序
+func AggregateEvents(events []interface{}) []interface{} {
+	aggregated := map[string]map[string]interface{}{}
+	keys := []string{}
+	for _, item := range events {
+		event, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := genEventAggKey(event)
+		exists, ok := aggregated[key]
+		if !ok {
+			aggregated[key] = event
+			keys = append(keys, key)
+			continue
+		}
+		// 以最后发生的事件为准，累加次数并保留最早的首次发生时间
+		last := exists
+		if parseEventTime(formatter.GetEventLastTimestamp(event)).After(
+			parseEventTime(formatter.GetEventLastTimestamp(exists)),
+		) {
+			last = event
+		}
+		firstTS := earliestFirstTimestamp(exists, event)
+		last["count"] = formatter.GetEventCount(exists) + formatter.GetEventCount(event)
+		last["lastTimestamp"] = formatter.GetEventLastTimestamp(last)
+		if firstTS != "" {
+			last["firstTimestamp"] = firstTS
+		}
+		aggregated[key] = last
+	}
+
+	rets := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		rets = append(rets, aggregated[key])
+	}
+	sort.SliceStable(rets, func(i, j int) bool {
+		return parseEventTime(formatter.GetEventLastTimestamp(rets[i].(map[string]interface{}))).After(
+			parseEventTime(formatter.GetEventLastTimestamp(rets[j].(map[string]interface{}))),
+		)
+	})
+	return rets
+}
+
+// genInvolvedObjKey 生成事件关联对象的唯一标识
+func genInvolvedObjKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// genEventAggKey 生成事件聚合的唯一标识
+func genEventAggKey(event map[string]interface{}) string {
+	return strings.Join([]string{
+		mapx.GetStr(event, "involvedObject.kind"), mapx.GetStr(event, "involvedObject.name"),
+		mapx.GetStr(event, "type"), mapx.GetStr(event, "reason"), mapx.GetStr(event, "message"),
+	}, "|")
+}
+
+// earliestFirstTimestamp 获取两个事件中较早的首次发生时间
+func earliestFirstTimestamp(a, b map[string]interface{}) string {
+	tsA, _ := mapx.Get(a, "firstTimestamp", "").(string)
+	tsB, _ := mapx.Get(b, "firstTimestamp", "").(string)
+	if tsA == "" || (tsB != "" && parseEventTime(tsB).Before(parseEventTime(tsA))) {
+		return tsB
+	}
+	return tsA
+}
+
+// parseEventTime 解析事件时间，兼容 Time 与 MicroTime 格式，解析失败返回零值
+func parseEventTime(ts string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, ts)
+	return t
+}
@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	resCsts "github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/resource/constants"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+)
+
+func genEvent(kind, name, reason string, count int64, first, last string) map[string]interface{} {
+	return map[string]interface{}{
+		"involvedObject": map[string]interface{}{"kind": kind, "name": name},
+		"type":           resCsts.EventTypeWarning,
+		"reason":         reason,
+		"message":        "back-off restarting failed container",
+		"count":          count,
+		"firstTimestamp": first,
+		"lastTimestamp":  last,
+	}
+}
+
+func TestAggregateEvents(t *testing.T) {
+	events := []interface{}{
+		genEvent(resCsts.Po, "po-1", "BackOff", 2, "2022-01-01T10:00:00Z", "2022-01-01T10:05:00Z"),
+		genEvent(resCsts.Deploy, "deploy-1", "ScalingReplicaSet", 1, "2022-01-01T09:00:00Z", "2022-01-01T09:00:00Z"),
+		genEvent(resCsts.Po, "po-1", "BackOff", 3, "2022-01-01T09:30:00Z", "2022-01-01T10:30:00Z"),
+		genEvent(resCsts.Po, "po-2", "BackOff", 1, "2022-01-01T10:10:00Z", "2022-01-01T10:10:00Z"),
+	}
+	// 新版本事件，无 lastTimestamp 及 count
+	events = append(events, map[string]interface{}{
+		"involvedObject": map[string]interface{}{"kind": resCsts.RS, "name": "rs-1"},
+		"type":           resCsts.EventTypeNormal,
+		"reason":         "SuccessfulCreate",
+		"lastTimestamp":  nil,
+		"eventTime":      "2022-01-01T10:20:00.123456Z",
+	})
+
+	rets := AggregateEvents(events)
+	assert.Equal(t, 4, len(rets))
+
+	// 按最后发生时间倒序
+	names := []string{}
+	for _, r := range rets {
+		names = append(names, mapx.GetStr(r.(map[string]interface{}), "involvedObject.name"))
+	}
+	assert.Equal(t, []string{"po-1", "rs-1", "po-2", "deploy-1"}, names)
+
+	// 相同事件合并
+	merged := rets[0].(map[string]interface{})
+	assert.Equal(t, int64(5), merged["count"])
+	assert.Equal(t, "2022-01-01T09:30:00Z", merged["firstTimestamp"])
+	assert.Equal(t, "2022-01-01T10:30:00Z", merged["lastTimestamp"])
+}
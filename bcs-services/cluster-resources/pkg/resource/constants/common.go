@@ -63,6 +63,15 @@ const (
 	// GPA ...
 	GPA = "GeneralPodAutoscaler"
 
+	// PDB ...
+	PDB = "PodDisruptionBudget"
+
+	// NetPolicy ...
+	NetPolicy = "NetworkPolicy"
+
+	// Event ...
+	Event = "Event"
+
 	// CRD ...
 	CRD = "CustomResourceDefinition"
 	// CObj ...
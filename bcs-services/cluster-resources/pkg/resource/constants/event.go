@@ -0,0 +1,25 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package constants
+
+const (
+	// DefaultEventGroupVersion 特殊指定的 Event 版本，events.k8s.io 下同名资源字段不同，统一使用 core/v1
+	DefaultEventGroupVersion = "v1"
+)
+
+const (
+	// EventTypeNormal 事件类型：正常
+	EventTypeNormal = "Normal"
+	// EventTypeWarning 事件类型：警告
+	EventTypeWarning = "Warning"
+)
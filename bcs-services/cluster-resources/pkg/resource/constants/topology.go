@@ -0,0 +1,31 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package constants
+
+const (
+	// TopoEdgeTypeOwner 拓扑关系：OwnerReferences（上层资源 -> 子资源）
+	TopoEdgeTypeOwner = "owner"
+	// TopoEdgeTypeSelector 拓扑关系：标签选择器匹配（Service/PDB/NetworkPolicy -> 工作负载）
+	TopoEdgeTypeSelector = "selector"
+	// TopoEdgeTypeScaleTarget 拓扑关系：扩缩容目标（HPA -> 工作负载）
+	TopoEdgeTypeScaleTarget = "scaleTarget"
+	// TopoEdgeTypeVolume 拓扑关系：挂载卷（工作负载 -> ConfigMap/Secret/PVC）
+	TopoEdgeTypeVolume = "volume"
+	// TopoEdgeTypeIngBackend 拓扑关系：Ingress 后端（Ingress -> Service）
+	TopoEdgeTypeIngBackend = "ingressBackend"
+)
+
+const (
+	// TopoNodeStatusNotFound 拓扑节点状态：资源不存在（如挂载了不存在的 ConfigMap）
+	TopoNodeStatusNotFound = "notFound"
+)
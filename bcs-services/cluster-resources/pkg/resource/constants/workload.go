@@ -19,6 +19,18 @@ var Volume2ResNameKeyMap = map[string]string{
 	CM:     "name",
 }
 
+// SubResKinds 通过 OwnerReferences 关联的下层子资源类型（如 Deployment -> ReplicaSet -> Pod）
+var SubResKinds = map[string][]string{
+	Deploy:  {RS},
+	RS:      {Po},
+	CJ:      {Job},
+	Job:     {Po},
+	STS:     {Po},
+	DS:      {Po},
+	GDeploy: {Po},
+	GSTS:    {Po},
+}
+
 const (
 	// DefaultUpdateStrategy 默认更新策略
 	DefaultUpdateStrategy = "RollingUpdate"
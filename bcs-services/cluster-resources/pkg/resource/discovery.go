@@ -221,6 +221,22 @@ func (d *RedisCacheClient) getResWithGroupVersion(kind, groupVersion string) (sc
 	return filterResByKind(kind, d.clusterID, groupVersion, []*metav1.APIResourceList{all})
 }
 
+// isNamespacedRes 根据指定的 Group, Version 检查资源是否为命名空间维度
+func (d *RedisCacheClient) isNamespacedRes(kind, groupVersion string) (bool, error) {
+	all, err := d.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return false, err
+	}
+	for _, res := range all.APIResources {
+		if res.Kind == kind {
+			return res.Namespaced, nil
+		}
+	}
+	return false, errorx.New(
+		errcode.General, fmt.Sprintf("kind %s not found in cluster %s, groupVersion: %s", kind, d.clusterID, groupVersion),
+	)
+}
+
 // getPreferredResource 获取指定资源当前集群 Preferred 版本
 func (d *RedisCacheClient) getPreferredResource(kind string) (schema.GroupVersionResource, error) {
 	all, err := d.ServerPreferredResources()
@@ -322,6 +338,20 @@ func GetGroupVersionResource(
 	return res, nil
 }
 
+// IsNamespacedRes 检查指定 GroupVersion 下的资源是否为命名空间维度（含刷新缓存重试）
+func IsNamespacedRes(ctx context.Context, conf *ClusterConf, kind, groupVersion string) (bool, error) {
+	cli, err := NewRedisCacheClient4Conf(ctx, conf)
+	if err != nil {
+		return false, err
+	}
+	namespaced, err := cli.isNamespacedRes(kind, groupVersion)
+	if err != nil {
+		cli.Invalidate()
+		return cli.isNamespacedRes(kind, groupVersion)
+	}
+	return namespaced, nil
+}
+
 // GetResPreferredVersion 获取某类资源在集群中的 Preferred 版本
 func GetResPreferredVersion(ctx context.Context, clusterID, kind string) (string, error) {
 	resInfo, err := GetGroupVersionResource(ctx, NewClusterConf(clusterID), kind, "")
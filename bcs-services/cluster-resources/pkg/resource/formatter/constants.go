@@ -50,6 +50,9 @@ var Kind2FormatFuncMap = map[string]func(manifest map[string]interface{}) map[st
 	// hpa
 	resCsts.HPA: FormatHPA,
 
+	// event
+	resCsts.Event: FormatEvent,
+
 	// CustomResource
 	resCsts.CRD:     FormatCRD,
 	resCsts.GDeploy: FormatGDeploy,
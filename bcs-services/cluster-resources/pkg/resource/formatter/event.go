@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formatter
+
+import (
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/util/mapx"
+)
+
+// FormatEvent 格式化 Event，status 即事件类型（Normal/Warning），便于多集群查询时按状态过滤
+func FormatEvent(manifest map[string]interface{}) map[string]interface{} {
+	ret := CommonFormatRes(manifest)
+	ret["status"] = mapx.GetStr(manifest, "type")
+	ret["reason"] = mapx.GetStr(manifest, "reason")
+	ret["message"] = mapx.GetStr(manifest, "message")
+	ret["count"] = GetEventCount(manifest)
+	ret["involvedObject"] = map[string]interface{}{
+		"kind": mapx.GetStr(manifest, "involvedObject.kind"),
+		"name": mapx.GetStr(manifest, "involvedObject.name"),
+	}
+	ret["source"] = mapx.GetStr(manifest, "source.component")
+	ret["firstTimestamp"], _ = mapx.Get(manifest, "firstTimestamp", "").(string)
+	ret["lastTimestamp"] = GetEventLastTimestamp(manifest)
+	return ret
+}
+
+// GetEventLastTimestamp 获取事件最后发生时间，新版本事件可能只有 eventTime / series.lastObservedTime
+func GetEventLastTimestamp(manifest map[string]interface{}) string {
+	paths := []string{"lastTimestamp", "series.lastObservedTime", "eventTime", "metadata.creationTimestamp"}
+	for _, path := range paths {
+		// 事件中未设置的时间字段值为 null，不能直接使用 GetStr
+		if ts, _ := mapx.Get(manifest, path, "").(string); ts != "" {
+			return ts
+		}
+	}
+	return ""
+}
+
+// GetEventCount 获取事件发生次数，新版本事件的次数记录在 series.count 中，均未设置则视为 1 次
+func GetEventCount(manifest map[string]interface{}) int64 {
+	for _, path := range []string{"count", "series.count"} {
+		switch cnt := mapx.Get(manifest, path, nil).(type) {
+		case int64:
+			if cnt > 0 {
+				return cnt
+			}
+		case float64:
+			if cnt > 0 {
+				return int64(cnt)
+			}
+		}
+	}
+	return 1
+}
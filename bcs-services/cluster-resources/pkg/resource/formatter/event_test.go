@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package formatter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var lightEventManifest = map[string]interface{}{
+	"apiVersion": "v1",
+	"kind":       "Event",
+	"metadata": map[string]interface{}{
+		"creationTimestamp": "2022-01-01T10:00:00Z",
+		"name":              "nginx-7d8b49557c-abcde.16c8e4a8d4d6f0a1",
+		"namespace":         "default",
+	},
+	"involvedObject": map[string]interface{}{
+		"kind": "Pod",
+		"name": "nginx-7d8b49557c-abcde",
+	},
+	"type":           "Warning",
+	"reason":         "BackOff",
+	"message":        "Back-off restarting failed container",
+	"count":          int64(3),
+	"source":         map[string]interface{}{"component": "kubelet"},
+	"firstTimestamp": "2022-01-01T10:00:00Z",
+	"lastTimestamp":  "2022-01-01T10:05:00Z",
+	"eventTime":      nil,
+}
+
+func TestFormatEvent(t *testing.T) {
+	ret := FormatEvent(lightEventManifest)
+	assert.Equal(t, "Warning", ret["status"])
+	assert.Equal(t, "BackOff", ret["reason"])
+	assert.Equal(t, int64(3), ret["count"])
+	assert.Equal(t, "kubelet", ret["source"])
+	assert.Equal(t, "2022-01-01T10:05:00Z", ret["lastTimestamp"])
+	assert.Equal(t, map[string]interface{}{"kind": "Pod", "name": "nginx-7d8b49557c-abcde"}, ret["involvedObject"])
+}
+
+func TestGetEventLastTimestamp(t *testing.T) {
+	// 新版本事件仅有 eventTime / series
+	manifest := map[string]interface{}{
+		"lastTimestamp": nil,
+		"eventTime":     "2022-01-01T10:00:00.000000Z",
+		"series":        map[string]interface{}{"count": int64(4), "lastObservedTime": "2022-01-01T10:10:00.000000Z"},
+	}
+	assert.Equal(t, "2022-01-01T10:10:00.000000Z", GetEventLastTimestamp(manifest))
+	assert.Equal(t, int64(4), GetEventCount(manifest))
+	assert.Equal(t, int64(1), GetEventCount(map[string]interface{}{"count": nil}))
+}
@@ -22,6 +22,13 @@ type EnvManage struct {
 	Env               string              `json:"env" bson:"env"`
 	ProjectCode       string              `json:"projectCode" bson:"projectCode"`
 	ClusterNamespaces []ClusterNamespaces `json:"clusterNamespaces" bson:"clusterNamespaces"`
+	Variables         []EnvVariable       `json:"variables" bson:"variables"`
+}
+
+// EnvVariable 环境变量，部署模板集时用于渲染模板
+type EnvVariable struct {
+	Key   string `json:"key" bson:"key"`
+	Value string `json:"value" bson:"value"`
 }
 
 // ToMap trans EnvManage to map
@@ -40,5 +47,13 @@ func (e *EnvManage) ToMap() map[string]interface{} {
 		})
 	}
 	m["clusterNamespaces"] = clusterNamespaces
+	variables := make([]map[string]interface{}, 0)
+	for _, v := range e.Variables {
+		variables = append(variables, map[string]interface{}{
+			"key":   v.Key,
+			"value": v.Value,
+		})
+	}
+	m["variables"] = variables
 	return m
 }
@@ -23,6 +23,10 @@ const (
 	FieldKeyVersion       = "version"
 	FieldKeyTemplateName  = "templateName"
 	FieldKeyEnv           = "env"
+	FieldKeyNamespace     = "namespace"
+	FieldKeyRevision      = "revision"
+	FieldKeyStatus        = "status"
+	FieldKeyMessage       = "message"
 
 	FieldKeyCreateBy = "createBy"
 	FieldKeyCreateAt = "createAt"
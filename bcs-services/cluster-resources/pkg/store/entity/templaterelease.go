@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// ReleaseStatusDeploying 部署中，下发资源前写入，用于占用部署版本号
+	ReleaseStatusDeploying = "deploying"
+	// ReleaseStatusDeployed 部署成功，当前生效
+	ReleaseStatusDeployed = "deployed"
+	// ReleaseStatusFailed 部署失败
+	ReleaseStatusFailed = "failed"
+	// ReleaseStatusSuperseded 已被新的部署记录取代
+	ReleaseStatusSuperseded = "superseded"
+)
+
+// TemplateRelease 定义了模板集部署记录的模型，记录模板文件版本部署到了哪个集群命名空间
+type TemplateRelease struct {
+	ID           primitive.ObjectID `json:"id" bson:"_id"`
+	ProjectCode  string             `json:"projectCode" bson:"projectCode"`
+	Name         string             `json:"name" bson:"name"`
+	Env          string             `json:"env" bson:"env"`
+	ClusterID    string             `json:"clusterID" bson:"clusterID"`
+	Namespace    string             `json:"namespace" bson:"namespace"`
+	Revision     int                `json:"revision" bson:"revision"`
+	Templates    []TemplateID       `json:"templates" bson:"templates"`
+	Values       string             `json:"values" bson:"values"`
+	Manifest     string             `json:"manifest" bson:"manifest"`
+	Resources    []ReleaseResource  `json:"resources" bson:"resources"`
+	Status       string             `json:"status" bson:"status"`
+	Message      string             `json:"message" bson:"message"`
+	RollbackFrom int                `json:"rollbackFrom" bson:"rollbackFrom"`
+	Creator      string             `json:"creator" bson:"creator"`
+	CreateAt     int64              `json:"createAt" bson:"createAt"`
+}
+
+// ReleaseResource 部署记录中包含的资源
+type ReleaseResource struct {
+	APIVersion string `json:"apiVersion" bson:"apiVersion"`
+	Kind       string `json:"kind" bson:"kind"`
+	Namespace  string `json:"namespace" bson:"namespace"`
+	Name       string `json:"name" bson:"name"`
+}
+
+// ToMap trans TemplateRelease to map
+func (t *TemplateRelease) ToMap() map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+	m := make(map[string]interface{}, 0)
+	m["id"] = t.ID.Hex()
+	m["projectCode"] = t.ProjectCode
+	m["name"] = t.Name
+	m["env"] = t.Env
+	m["clusterID"] = t.ClusterID
+	m["namespace"] = t.Namespace
+	m["revision"] = t.Revision
+	templates := make([]map[string]interface{}, 0)
+	for _, v := range t.Templates {
+		templates = append(templates, map[string]interface{}{
+			"templateSpace": v.TemplateSpace,
+			"templateName":  v.TemplateName,
+			"version":       v.TemplateVersion,
+		})
+	}
+	m["templates"] = templates
+	m["values"] = t.Values
+	m["manifest"] = t.Manifest
+	resources := make([]map[string]interface{}, 0)
+	for _, v := range t.Resources {
+		resources = append(resources, map[string]interface{}{
+			"apiVersion": v.APIVersion,
+			"kind":       v.Kind,
+			"namespace":  v.Namespace,
+			"name":       v.Name,
+		})
+	}
+	m["resources"] = resources
+	m["status"] = t.Status
+	m["message"] = t.Message
+	m["rollbackFrom"] = t.RollbackFrom
+	m["creator"] = t.Creator
+	m["createAt"] = t.CreateAt
+	return m
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/envmanage"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/template"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/templaterelease"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/templatespace"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/templateversion"
 	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/utils"
@@ -73,6 +74,13 @@ type ClusterResourcesModel interface {
 	CreateEnvManage(ctx context.Context, envManage *entity.EnvManage) (string, error)
 	UpdateEnvManage(ctx context.Context, id string, envManage entity.M) error
 	DeleteEnvManage(ctx context.Context, id string) error
+
+	// 模板集部署记录
+	GetTemplateRelease(ctx context.Context, id string) (*entity.TemplateRelease, error)
+	ListTemplateReleases(ctx context.Context, cond *operator.Condition, opt *utils.ListOption) (
+		int64, []*entity.TemplateRelease, error)
+	CreateTemplateRelease(ctx context.Context, release *entity.TemplateRelease) (string, error)
+	UpdateTemplateRelease(ctx context.Context, id string, release entity.M) error
 }
 
 type modelSet struct {
@@ -81,6 +89,7 @@ type modelSet struct {
 	*template.ModelTemplate
 	*templateversion.ModelTemplateVersion
 	*envmanage.ModelEnvManage
+	*templaterelease.ModelTemplateRelease
 }
 
 // New return a new ClusterResourcesModel instance
@@ -91,5 +100,6 @@ func New(db drivers.DB) ClusterResourcesModel {
 		ModelTemplate:        template.New(db),
 		ModelTemplateVersion: templateversion.New(db),
 		ModelEnvManage:       envmanage.New(db),
+		ModelTemplateRelease: templaterelease.New(db),
 	}
 }
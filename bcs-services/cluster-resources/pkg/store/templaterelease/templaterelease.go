@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package templaterelease template set release
+package templaterelease
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/drivers"
+	"github.com/Tencent/bk-bcs/bcs-common/pkg/odm/operator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/entity"
+	"github.com/Tencent/bk-bcs/bcs-services/cluster-resources/pkg/store/utils"
+)
+
+const (
+	tableName = "templaterelease"
+)
+
+var (
+	tableIndexes = []drivers.Index{
+		{
+			Name: tableName + "_idx",
+			Key: bson.D{
+				bson.E{Key: entity.FieldKeyProjectCode, Value: 1},
+				bson.E{Key: entity.FieldKeyClusterID, Value: 1},
+				bson.E{Key: entity.FieldKeyNamespace, Value: 1},
+				bson.E{Key: entity.FieldKeyName, Value: 1},
+				bson.E{Key: entity.FieldKeyRevision, Value: 1},
+			},
+			// 同一部署目标的版本号唯一，避免并发部署产生相同版本的部署记录
+			Unique: true,
+		},
+	}
+)
+
+// ModelTemplateRelease provides handling TemplateRelease operations to database
+type ModelTemplateRelease struct {
+	tableName           string
+	indexes             []drivers.Index
+	db                  drivers.DB
+	isTableEnsured      bool
+	isTableEnsuredMutex sync.Mutex
+}
+
+// New return a new ModelTemplateRelease instance
+func New(db drivers.DB) *ModelTemplateRelease {
+	return &ModelTemplateRelease{
+		tableName: utils.DataTableNamePrefix + tableName,
+		indexes:   tableIndexes,
+		db:        db,
+	}
+}
+
+func (m *ModelTemplateRelease) ensureTable(ctx context.Context) error {
+	if m.isTableEnsured {
+		return nil
+	}
+
+	m.isTableEnsuredMutex.Lock()
+	defer m.isTableEnsuredMutex.Unlock()
+	if m.isTableEnsured {
+		return nil
+	}
+
+	if err := utils.EnsureTable(ctx, m.db, m.tableName, m.indexes); err != nil {
+		return err
+	}
+	m.isTableEnsured = true
+	return nil
+}
+
+// GetTemplateRelease get a specific entity.TemplateRelease from database
+func (m *ModelTemplateRelease) GetTemplateRelease(ctx context.Context, id string) (
+	*entity.TemplateRelease, error) {
+	if id == "" {
+		return nil, fmt.Errorf("can not get with empty id")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		entity.FieldKeyObjectID: objectID,
+	})
+
+	release := &entity.TemplateRelease{}
+	if err := m.db.Table(m.tableName).Find(cond).One(ctx, release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// ListTemplateReleases get a list of entity.TemplateRelease by condition and option from database
+func (m *ModelTemplateRelease) ListTemplateReleases(
+	ctx context.Context, cond *operator.Condition, opt *utils.ListOption) (int64, []*entity.TemplateRelease, error) {
+
+	l := make([]*entity.TemplateRelease, 0)
+	finder := m.db.Table(m.tableName).Find(cond)
+	if len(opt.Sort) != 0 {
+		finder = finder.WithSort(utils.MapInt2MapIf(opt.Sort))
+	}
+	if opt.Page != 0 {
+		finder = finder.WithStart(opt.Page * opt.Size)
+	}
+	if opt.Size != 0 {
+		finder = finder.WithLimit(opt.Size)
+	}
+
+	if err := finder.All(ctx, &l); err != nil {
+		return 0, nil, err
+	}
+
+	total, err := finder.Count(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return total, l, nil
+}
+
+// CreateTemplateRelease create a new entity.TemplateRelease into database
+func (m *ModelTemplateRelease) CreateTemplateRelease(
+	ctx context.Context, release *entity.TemplateRelease) (string, error) {
+	if release == nil {
+		return "", fmt.Errorf("can not create empty templateRelease")
+	}
+
+	if err := m.ensureTable(ctx); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if release.ID.IsZero() {
+		release.ID = primitive.NewObjectIDFromTimestamp(now)
+	}
+	if release.CreateAt == 0 {
+		release.CreateAt = now.UTC().Unix()
+	}
+	if _, err := m.db.Table(m.tableName).Insert(ctx, []interface{}{release}); err != nil {
+		return "", err
+	}
+	return release.ID.Hex(), nil
+}
+
+// UpdateTemplateRelease update an entity.TemplateRelease into database
+func (m *ModelTemplateRelease) UpdateTemplateRelease(ctx context.Context, id string, release entity.M) error {
+	if id == "" {
+		return fmt.Errorf("can not update with empty id")
+	}
+
+	if release == nil {
+		return fmt.Errorf("can not update empty templateRelease")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid id")
+	}
+
+	cond := operator.NewLeafCondition(operator.Eq, operator.M{
+		entity.FieldKeyObjectID: objectID,
+	})
+	if err := m.db.Table(m.tableName).Update(ctx, cond, operator.M{"$set": release}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -117,6 +117,7 @@ type reqResource struct {
 	Name        string `json:"name" yaml:"name"`
 	Kind        string `json:"kind" yaml:"kind"`
 	Version     string `json:"apiVersion" yaml:"apiVersion"`
+	DryRun      bool   `json:"dryRun" yaml:"dryRun"`
 	RawData     *struct {
 		Version  string `json:"apiVersion" yaml:"apiVersion"`
 		Kind     string `json:"kind,omitempty" yaml:"kind"`
@@ -290,6 +291,10 @@ func addAudit(ctx context.Context, req server.Request, rsp interface{}, startTim
 	if !ok {
 		return
 	}
+	// dry-run 请求不会实际变更集群资源，不记录审计
+	if getReqResource(req).DryRun {
+		return
+	}
 
 	res, act := fn(req)
 
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/golang/protobuf/ptypes/struct"
+	_ "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	proto "google.golang.org/protobuf/proto"
@@ -3724,6 +3725,107 @@ func (h *hPAHandler) DeleteHPA(ctx context.Context, in *ResDeleteReq, out *Commo
 	return h.HPAHandler.DeleteHPA(ctx, in, out)
 }
 
+// Api Endpoints for Event service
+
+func NewEventEndpoints() []*api.Endpoint {
+	return []*api.Endpoint{
+		{
+			Name:    "Event.ListEvents",
+			Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/events"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "Event.GetResourceEvents",
+			Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/events/{kind}/{name}"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+	}
+}
+
+// Client API for Event service
+
+type EventService interface {
+	ListEvents(ctx context.Context, in *ListEventsReq, opts ...client.CallOption) (*CommonResp, error)
+	GetResourceEvents(ctx context.Context, in *GetResourceEventsReq, opts ...client.CallOption) (*CommonResp, error)
+}
+
+type eventService struct {
+	c    client.Client
+	name string
+}
+
+func NewEventService(name string, c client.Client) EventService {
+	return &eventService{
+		c:    c,
+		name: name,
+	}
+}
+
+func (c *eventService) ListEvents(ctx context.Context, in *ListEventsReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "Event.ListEvents", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventService) GetResourceEvents(ctx context.Context, in *GetResourceEventsReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "Event.GetResourceEvents", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Event service
+
+type EventHandler interface {
+	ListEvents(context.Context, *ListEventsReq, *CommonResp) error
+	GetResourceEvents(context.Context, *GetResourceEventsReq, *CommonResp) error
+}
+
+func RegisterEventHandler(s server.Server, hdlr EventHandler, opts ...server.HandlerOption) error {
+	type event interface {
+		ListEvents(ctx context.Context, in *ListEventsReq, out *CommonResp) error
+		GetResourceEvents(ctx context.Context, in *GetResourceEventsReq, out *CommonResp) error
+	}
+	type Event struct {
+		event
+	}
+	h := &eventHandler{hdlr}
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Event.ListEvents",
+		Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/events"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Event.GetResourceEvents",
+		Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/events/{kind}/{name}"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	return s.Handle(s.NewHandler(&Event{h}, opts...))
+}
+
+type eventHandler struct {
+	EventHandler
+}
+
+func (h *eventHandler) ListEvents(ctx context.Context, in *ListEventsReq, out *CommonResp) error {
+	return h.EventHandler.ListEvents(ctx, in, out)
+}
+
+func (h *eventHandler) GetResourceEvents(ctx context.Context, in *GetResourceEventsReq, out *CommonResp) error {
+	return h.EventHandler.GetResourceEvents(ctx, in, out)
+}
+
 // Api Endpoints for CustomRes service
 
 func NewCustomResEndpoints() []*api.Endpoint {
@@ -4191,6 +4293,12 @@ func NewResourceEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "Resource.GetResourceTopology",
+			Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/topology/{kind}/{name}"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -4210,6 +4318,8 @@ type ResourceService interface {
 	GetFormSupportedAPIVersions(ctx context.Context, in *GetFormSupportedApiVersionsReq, opts ...client.CallOption) (*CommonResp, error)
 	// 获取用于下拉框选项的资源数据
 	GetResSelectItems(ctx context.Context, in *GetResSelectItemsReq, opts ...client.CallOption) (*CommonResp, error)
+	// 获取资源关联拓扑
+	GetResourceTopology(ctx context.Context, in *GetResourceTopologyReq, opts ...client.CallOption) (*CommonResp, error)
 }
 
 type resourceService struct {
@@ -4338,6 +4448,16 @@ func (c *resourceService) GetResSelectItems(ctx context.Context, in *GetResSelec
 	return out, nil
 }
 
+func (c *resourceService) GetResourceTopology(ctx context.Context, in *GetResourceTopologyReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "Resource.GetResourceTopology", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Resource service
 
 type ResourceHandler interface {
@@ -4354,6 +4474,8 @@ type ResourceHandler interface {
 	GetFormSupportedAPIVersions(context.Context, *GetFormSupportedApiVersionsReq, *CommonResp) error
 	// 获取用于下拉框选项的资源数据
 	GetResSelectItems(context.Context, *GetResSelectItemsReq, *CommonResp) error
+	// 获取资源关联拓扑
+	GetResourceTopology(context.Context, *GetResourceTopologyReq, *CommonResp) error
 }
 
 func RegisterResourceHandler(s server.Server, hdlr ResourceHandler, opts ...server.HandlerOption) error {
@@ -4365,6 +4487,7 @@ func RegisterResourceHandler(s server.Server, hdlr ResourceHandler, opts ...serv
 		GetResFormSchema(ctx context.Context, in *GetResFormSchemaReq, out *CommonResp) error
 		GetFormSupportedAPIVersions(ctx context.Context, in *GetFormSupportedApiVersionsReq, out *CommonResp) error
 		GetResSelectItems(ctx context.Context, in *GetResSelectItemsReq, out *CommonResp) error
+		GetResourceTopology(ctx context.Context, in *GetResourceTopologyReq, out *CommonResp) error
 	}
 	type Resource struct {
 		resource
@@ -4413,6 +4536,12 @@ func RegisterResourceHandler(s server.Server, hdlr ResourceHandler, opts ...serv
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Resource.GetResourceTopology",
+		Path:    []string{"/clusterresources/v1/projects/{projectID}/clusters/{clusterID}/namespaces/{namespace}/topology/{kind}/{name}"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&Resource{h}, opts...))
 }
 
@@ -4484,6 +4613,10 @@ func (h *resourceHandler) GetResSelectItems(ctx context.Context, in *GetResSelec
 	return h.ResourceHandler.GetResSelectItems(ctx, in, out)
 }
 
+func (h *resourceHandler) GetResourceTopology(ctx context.Context, in *GetResourceTopologyReq, out *CommonResp) error {
+	return h.ResourceHandler.GetResourceTopology(ctx, in, out)
+}
+
 // Api Endpoints for ViewConfig service
 
 func NewViewConfigEndpoints() []*api.Endpoint {
@@ -4936,6 +5069,36 @@ func NewTemplateSetEndpoints() []*api.Endpoint {
 			Method:  []string{"DELETE"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "TemplateSet.DeployTemplateSet",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/deploy"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "TemplateSet.ListTemplateReleases",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "TemplateSet.GetTemplateRelease",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "TemplateSet.DiffTemplateRelease",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}/diff"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "TemplateSet.RollbackTemplateRelease",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}/rollback"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -4984,6 +5147,16 @@ type TemplateSetService interface {
 	RenameEnvManage(ctx context.Context, in *RenameEnvManageReq, opts ...client.CallOption) (*CommonResp, error)
 	// 删除环境管理
 	DeleteEnvManage(ctx context.Context, in *DeleteEnvManageReq, opts ...client.CallOption) (*CommonResp, error)
+	// 部署模板集
+	DeployTemplateSet(ctx context.Context, in *DeployTemplateSetReq, opts ...client.CallOption) (*CommonResp, error)
+	// 获取模板集部署记录列表
+	ListTemplateReleases(ctx context.Context, in *ListTemplateReleasesReq, opts ...client.CallOption) (*CommonListResp, error)
+	// 获取模板集部署记录详情
+	GetTemplateRelease(ctx context.Context, in *GetTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error)
+	// 对比模板集部署记录
+	DiffTemplateRelease(ctx context.Context, in *DiffTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error)
+	// 回滚到指定的模板集部署记录
+	RollbackTemplateRelease(ctx context.Context, in *RollbackTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error)
 }
 
 type templateSetService struct {
@@ -5208,6 +5381,56 @@ func (c *templateSetService) DeleteEnvManage(ctx context.Context, in *DeleteEnvM
 	return out, nil
 }
 
+func (c *templateSetService) DeployTemplateSet(ctx context.Context, in *DeployTemplateSetReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "TemplateSet.DeployTemplateSet", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateSetService) ListTemplateReleases(ctx context.Context, in *ListTemplateReleasesReq, opts ...client.CallOption) (*CommonListResp, error) {
+	req := c.c.NewRequest(c.name, "TemplateSet.ListTemplateReleases", in)
+	out := new(CommonListResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateSetService) GetTemplateRelease(ctx context.Context, in *GetTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "TemplateSet.GetTemplateRelease", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateSetService) DiffTemplateRelease(ctx context.Context, in *DiffTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "TemplateSet.DiffTemplateRelease", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateSetService) RollbackTemplateRelease(ctx context.Context, in *RollbackTemplateReleaseReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "TemplateSet.RollbackTemplateRelease", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TemplateSet service
 
 type TemplateSetHandler interface {
@@ -5253,6 +5476,16 @@ type TemplateSetHandler interface {
 	RenameEnvManage(context.Context, *RenameEnvManageReq, *CommonResp) error
 	// 删除环境管理
 	DeleteEnvManage(context.Context, *DeleteEnvManageReq, *CommonResp) error
+	// 部署模板集
+	DeployTemplateSet(context.Context, *DeployTemplateSetReq, *CommonResp) error
+	// 获取模板集部署记录列表
+	ListTemplateReleases(context.Context, *ListTemplateReleasesReq, *CommonListResp) error
+	// 获取模板集部署记录详情
+	GetTemplateRelease(context.Context, *GetTemplateReleaseReq, *CommonResp) error
+	// 对比模板集部署记录
+	DiffTemplateRelease(context.Context, *DiffTemplateReleaseReq, *CommonResp) error
+	// 回滚到指定的模板集部署记录
+	RollbackTemplateRelease(context.Context, *RollbackTemplateReleaseReq, *CommonResp) error
 }
 
 func RegisterTemplateSetHandler(s server.Server, hdlr TemplateSetHandler, opts ...server.HandlerOption) error {
@@ -5278,6 +5511,11 @@ func RegisterTemplateSetHandler(s server.Server, hdlr TemplateSetHandler, opts .
 		UpdateEnvManage(ctx context.Context, in *UpdateEnvManageReq, out *CommonResp) error
 		RenameEnvManage(ctx context.Context, in *RenameEnvManageReq, out *CommonResp) error
 		DeleteEnvManage(ctx context.Context, in *DeleteEnvManageReq, out *CommonResp) error
+		DeployTemplateSet(ctx context.Context, in *DeployTemplateSetReq, out *CommonResp) error
+		ListTemplateReleases(ctx context.Context, in *ListTemplateReleasesReq, out *CommonListResp) error
+		GetTemplateRelease(ctx context.Context, in *GetTemplateReleaseReq, out *CommonResp) error
+		DiffTemplateRelease(ctx context.Context, in *DiffTemplateReleaseReq, out *CommonResp) error
+		RollbackTemplateRelease(ctx context.Context, in *RollbackTemplateReleaseReq, out *CommonResp) error
 	}
 	type TemplateSet struct {
 		templateSet
@@ -5409,6 +5647,36 @@ func RegisterTemplateSetHandler(s server.Server, hdlr TemplateSetHandler, opts .
 		Method:  []string{"DELETE"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "TemplateSet.DeployTemplateSet",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/deploy"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "TemplateSet.ListTemplateReleases",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "TemplateSet.GetTemplateRelease",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "TemplateSet.DiffTemplateRelease",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}/diff"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "TemplateSet.RollbackTemplateRelease",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/templatesets/releases/{id}/rollback"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&TemplateSet{h}, opts...))
 }
 
@@ -5500,6 +5768,26 @@ func (h *templateSetHandler) DeleteEnvManage(ctx context.Context, in *DeleteEnvM
 	return h.TemplateSetHandler.DeleteEnvManage(ctx, in, out)
 }
 
+func (h *templateSetHandler) DeployTemplateSet(ctx context.Context, in *DeployTemplateSetReq, out *CommonResp) error {
+	return h.TemplateSetHandler.DeployTemplateSet(ctx, in, out)
+}
+
+func (h *templateSetHandler) ListTemplateReleases(ctx context.Context, in *ListTemplateReleasesReq, out *CommonListResp) error {
+	return h.TemplateSetHandler.ListTemplateReleases(ctx, in, out)
+}
+
+func (h *templateSetHandler) GetTemplateRelease(ctx context.Context, in *GetTemplateReleaseReq, out *CommonResp) error {
+	return h.TemplateSetHandler.GetTemplateRelease(ctx, in, out)
+}
+
+func (h *templateSetHandler) DiffTemplateRelease(ctx context.Context, in *DiffTemplateReleaseReq, out *CommonResp) error {
+	return h.TemplateSetHandler.DiffTemplateRelease(ctx, in, out)
+}
+
+func (h *templateSetHandler) RollbackTemplateRelease(ctx context.Context, in *RollbackTemplateReleaseReq, out *CommonResp) error {
+	return h.TemplateSetHandler.RollbackTemplateRelease(ctx, in, out)
+}
+
 // Api Endpoints for MultiCluster service
 
 func NewMultiClusterEndpoints() []*api.Endpoint {
@@ -5522,6 +5810,12 @@ func NewMultiClusterEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "MultiCluster.MultiClusterBatchOperate",
+			Path:    []string{"/clusterresources/v1/projects/{projectCode}/multi_cluster_resources/{kind}/batch_operate"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -5531,6 +5825,7 @@ type MultiClusterService interface {
 	FetchMultiClusterResource(ctx context.Context, in *FetchMultiClusterResourceReq, opts ...client.CallOption) (*CommonResp, error)
 	FetchMultiClusterCustomResource(ctx context.Context, in *FetchMultiClusterCustomResourceReq, opts ...client.CallOption) (*CommonResp, error)
 	MultiClusterResourceCount(ctx context.Context, in *MultiClusterResourceCountReq, opts ...client.CallOption) (*CommonResp, error)
+	MultiClusterBatchOperate(ctx context.Context, in *MultiClusterBatchOperateReq, opts ...client.CallOption) (*CommonResp, error)
 }
 
 type multiClusterService struct {
@@ -5575,12 +5870,23 @@ func (c *multiClusterService) MultiClusterResourceCount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *multiClusterService) MultiClusterBatchOperate(ctx context.Context, in *MultiClusterBatchOperateReq, opts ...client.CallOption) (*CommonResp, error) {
+	req := c.c.NewRequest(c.name, "MultiCluster.MultiClusterBatchOperate", in)
+	out := new(CommonResp)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MultiCluster service
 
 type MultiClusterHandler interface {
 	FetchMultiClusterResource(context.Context, *FetchMultiClusterResourceReq, *CommonResp) error
 	FetchMultiClusterCustomResource(context.Context, *FetchMultiClusterCustomResourceReq, *CommonResp) error
 	MultiClusterResourceCount(context.Context, *MultiClusterResourceCountReq, *CommonResp) error
+	MultiClusterBatchOperate(context.Context, *MultiClusterBatchOperateReq, *CommonResp) error
 }
 
 func RegisterMultiClusterHandler(s server.Server, hdlr MultiClusterHandler, opts ...server.HandlerOption) error {
@@ -5588,6 +5894,7 @@ func RegisterMultiClusterHandler(s server.Server, hdlr MultiClusterHandler, opts
 		FetchMultiClusterResource(ctx context.Context, in *FetchMultiClusterResourceReq, out *CommonResp) error
 		FetchMultiClusterCustomResource(ctx context.Context, in *FetchMultiClusterCustomResourceReq, out *CommonResp) error
 		MultiClusterResourceCount(ctx context.Context, in *MultiClusterResourceCountReq, out *CommonResp) error
+		MultiClusterBatchOperate(ctx context.Context, in *MultiClusterBatchOperateReq, out *CommonResp) error
 	}
 	type MultiCluster struct {
 		multiCluster
@@ -5611,6 +5918,12 @@ func RegisterMultiClusterHandler(s server.Server, hdlr MultiClusterHandler, opts
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "MultiCluster.MultiClusterBatchOperate",
+		Path:    []string{"/clusterresources/v1/projects/{projectCode}/multi_cluster_resources/{kind}/batch_operate"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&MultiCluster{h}, opts...))
 }
 
@@ -5629,3 +5942,7 @@ func (h *multiClusterHandler) FetchMultiClusterCustomResource(ctx context.Contex
 func (h *multiClusterHandler) MultiClusterResourceCount(ctx context.Context, in *MultiClusterResourceCountReq, out *CommonResp) error {
 	return h.MultiClusterHandler.MultiClusterResourceCount(ctx, in, out)
 }
+
+func (h *multiClusterHandler) MultiClusterBatchOperate(ctx context.Context, in *MultiClusterBatchOperateReq, out *CommonResp) error {
+	return h.MultiClusterHandler.MultiClusterBatchOperate(ctx, in, out)
+}
@@ -1377,6 +1377,8 @@ func (m *ResCreateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
 		return ResCreateReqMultiError(errors)
 	}
@@ -1590,6 +1592,8 @@ func (m *ResUpdateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
 		return ResUpdateReqMultiError(errors)
 	}
@@ -3480,110 +3484,55 @@ var _ContainerGetReq_PodName_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a
 
 var _ContainerGetReq_ContainerName_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?")
 
-// Validate checks the field values on GetK8SResTemplateReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetK8SResTemplateReq) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetK8SResTemplateReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetK8SResTemplateReqMultiError, or nil if none found.
-func (m *GetK8SResTemplateReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetK8SResTemplateReq) validate(all bool) error {
+// Validate checks the field values on ListEventsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ListEventsReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if !_GetK8SResTemplateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := GetK8SResTemplateReqValidationError{
+	if !_ListEventsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		return ListEventsReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := GetK8SResTemplateReqValidationError{
+		return ListEventsReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetKind()); l < 3 || l > 64 {
-		err := GetK8SResTemplateReqValidationError{
-			field:  "Kind",
-			reason: "value length must be between 3 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := GetK8SResTemplateReqValidationError{
+		return ListEventsReqValidationError{
 			field:  "Namespace",
 			reason: "value length must be at most 63 runes",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if !_GetK8SResTemplateReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := GetK8SResTemplateReqValidationError{
+	if !_ListEventsReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		return ListEventsReqValidationError{
 			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
-		}
-		if !all {
-			return err
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
 		}
-		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return GetK8SResTemplateReqMultiError(errors)
+	if _, ok := _ListEventsReq_Type_InLookup[m.GetType()]; !ok {
+		return ListEventsReqValidationError{
+			field:  "Type",
+			reason: "value must be in list [ Normal Warning]",
+		}
 	}
 
 	return nil
 }
 
-// GetK8SResTemplateReqMultiError is an error wrapping multiple validation
-// errors returned by GetK8SResTemplateReq.ValidateAll() if the designated
-// constraints aren't met.
-type GetK8SResTemplateReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetK8SResTemplateReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetK8SResTemplateReqMultiError) AllErrors() []error { return m }
-
-// GetK8SResTemplateReqValidationError is the validation error returned by
-// GetK8SResTemplateReq.Validate if the designated constraints aren't met.
-type GetK8SResTemplateReqValidationError struct {
+// ListEventsReqValidationError is the validation error returned by
+// ListEventsReq.Validate if the designated constraints aren't met.
+type ListEventsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3591,24 +3540,22 @@ type GetK8SResTemplateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetK8SResTemplateReqValidationError) Field() string { return e.field }
+func (e ListEventsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetK8SResTemplateReqValidationError) Reason() string { return e.reason }
+func (e ListEventsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetK8SResTemplateReqValidationError) Cause() error { return e.cause }
+func (e ListEventsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetK8SResTemplateReqValidationError) Key() bool { return e.key }
+func (e ListEventsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetK8SResTemplateReqValidationError) ErrorName() string {
-	return "GetK8SResTemplateReqValidationError"
-}
+func (e ListEventsReqValidationError) ErrorName() string { return "ListEventsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetK8SResTemplateReqValidationError) Error() string {
+func (e ListEventsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3620,14 +3567,14 @@ func (e GetK8SResTemplateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetK8SResTemplateReq.%s: %s%s",
+		"invalid %sListEventsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetK8SResTemplateReqValidationError{}
+var _ error = ListEventsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3635,137 +3582,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetK8SResTemplateReqValidationError{}
-
-var _GetK8SResTemplateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = ListEventsReqValidationError{}
 
-var _GetK8SResTemplateReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _ListEventsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-// Validate checks the field values on CObjListReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CObjListReq) Validate() error {
-	return m.validate(false)
-}
+var _ListEventsReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
 
-// ValidateAll checks the field values on CObjListReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjListReqMultiError, or
-// nil if none found.
-func (m *CObjListReq) ValidateAll() error {
-	return m.validate(true)
+var _ListEventsReq_Type_InLookup = map[string]struct{}{
+	"":        {},
+	"Normal":  {},
+	"Warning": {},
 }
 
-func (m *CObjListReq) validate(all bool) error {
+// Validate checks the field values on GetResourceEventsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetResourceEventsReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if !_CObjListReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjListReqValidationError{
+	if !_GetResourceEventsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		return GetResourceEventsReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjListReqValidationError{
+		return GetResourceEventsReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjListReqValidationError{
-			field:  "CRDName",
-			reason: "value length must be at most 256 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjListReqValidationError{
+		return GetResourceEventsReqValidationError{
 			field:  "Namespace",
 			reason: "value length must be at most 63 runes",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if !_CObjListReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjListReqValidationError{
+	if !_GetResourceEventsReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		return GetResourceEventsReqValidationError{
 			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
-		}
-		if !all {
-			return err
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
 		}
-		errors = append(errors, err)
 	}
 
-	if _, ok := _CObjListReq_Format_InLookup[m.GetFormat()]; !ok {
-		err := CObjListReqValidationError{
-			field:  "Format",
-			reason: "value must be in list [ manifest selectItems]",
-		}
-		if !all {
-			return err
+	if l := utf8.RuneCountInString(m.GetKind()); l < 1 || l > 64 {
+		return GetResourceEventsReqValidationError{
+			field:  "Kind",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetScene()) > 32 {
-		err := CObjListReqValidationError{
-			field:  "Scene",
-			reason: "value length must be at most 32 runes",
+	if utf8.RuneCountInString(m.GetName()) > 253 {
+		return GetResourceEventsReqValidationError{
+			field:  "Name",
+			reason: "value length must be at most 253 runes",
 		}
-		if !all {
-			return err
+	}
+
+	if !_GetResourceEventsReq_Name_Pattern.MatchString(m.GetName()) {
+		return GetResourceEventsReqValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\"",
 		}
-		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return CObjListReqMultiError(errors)
+	if _, ok := _GetResourceEventsReq_Type_InLookup[m.GetType()]; !ok {
+		return GetResourceEventsReqValidationError{
+			field:  "Type",
+			reason: "value must be in list [ Normal Warning]",
+		}
 	}
 
 	return nil
 }
 
-// CObjListReqMultiError is an error wrapping multiple validation errors
-// returned by CObjListReq.ValidateAll() if the designated constraints aren't met.
-type CObjListReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m CObjListReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m CObjListReqMultiError) AllErrors() []error { return m }
-
-// CObjListReqValidationError is the validation error returned by
-// CObjListReq.Validate if the designated constraints aren't met.
-type CObjListReqValidationError struct {
+// GetResourceEventsReqValidationError is the validation error returned by
+// GetResourceEventsReq.Validate if the designated constraints aren't met.
+type GetResourceEventsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3773,22 +3671,24 @@ type CObjListReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjListReqValidationError) Field() string { return e.field }
+func (e GetResourceEventsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjListReqValidationError) Reason() string { return e.reason }
+func (e GetResourceEventsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjListReqValidationError) Cause() error { return e.cause }
+func (e GetResourceEventsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjListReqValidationError) Key() bool { return e.key }
+func (e GetResourceEventsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjListReqValidationError) ErrorName() string { return "CObjListReqValidationError" }
+func (e GetResourceEventsReqValidationError) ErrorName() string {
+	return "GetResourceEventsReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CObjListReqValidationError) Error() string {
+func (e GetResourceEventsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3800,14 +3700,14 @@ func (e CObjListReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjListReq.%s: %s%s",
+		"invalid %sGetResourceEventsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjListReqValidationError{}
+var _ error = GetResourceEventsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3815,143 +3715,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjListReqValidationError{}
-
-var _CObjListReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = GetResourceEventsReqValidationError{}
 
-var _CObjListReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _GetResourceEventsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjListReq_Format_InLookup = map[string]struct{}{
-	"":            {},
-	"manifest":    {},
-	"selectItems": {},
-}
+var _GetResourceEventsReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
 
-// Validate checks the field values on CObjGetReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CObjGetReq) Validate() error {
-	return m.validate(false)
-}
+var _GetResourceEventsReq_Name_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*")
 
-// ValidateAll checks the field values on CObjGetReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjGetReqMultiError, or
-// nil if none found.
-func (m *CObjGetReq) ValidateAll() error {
-	return m.validate(true)
+var _GetResourceEventsReq_Type_InLookup = map[string]struct{}{
+	"":        {},
+	"Normal":  {},
+	"Warning": {},
 }
 
-func (m *CObjGetReq) validate(all bool) error {
+// Validate checks the field values on GetResourceTopologyReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetResourceTopologyReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if !_CObjGetReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjGetReqValidationError{
+	if !_GetResourceTopologyReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		return GetResourceTopologyReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjGetReqValidationError{
+		return GetResourceTopologyReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjGetReqValidationError{
-			field:  "CRDName",
-			reason: "value length must be at most 256 runes",
-		}
-		if !all {
-			return err
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		return GetResourceTopologyReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjGetReqValidationError{
-			field:  "CobjName",
-			reason: "value length must be at most 256 runes",
-		}
-		if !all {
-			return err
+	if !_GetResourceTopologyReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		return GetResourceTopologyReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]+$\"",
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjGetReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 63 runes",
-		}
-		if !all {
-			return err
+	if _, ok := _GetResourceTopologyReq_Kind_InLookup[m.GetKind()]; !ok {
+		return GetResourceTopologyReqValidationError{
+			field:  "Kind",
+			reason: "value must be in list [Deployment ReplicaSet StatefulSet DaemonSet Job CronJob Pod GameDeployment GameStatefulSet]",
 		}
-		errors = append(errors, err)
 	}
 
-	if !_CObjGetReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjGetReqValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
-		}
-		if !all {
-			return err
+	if utf8.RuneCountInString(m.GetName()) > 253 {
+		return GetResourceTopologyReqValidationError{
+			field:  "Name",
+			reason: "value length must be at most 253 runes",
 		}
-		errors = append(errors, err)
 	}
 
-	if _, ok := _CObjGetReq_Format_InLookup[m.GetFormat()]; !ok {
-		err := CObjGetReqValidationError{
-			field:  "Format",
-			reason: "value must be in list [ manifest formData]",
-		}
-		if !all {
-			return err
+	if !_GetResourceTopologyReq_Name_Pattern.MatchString(m.GetName()) {
+		return GetResourceTopologyReqValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\"",
 		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return CObjGetReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjGetReqMultiError is an error wrapping multiple validation errors
-// returned by CObjGetReq.ValidateAll() if the designated constraints aren't met.
-type CObjGetReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m CObjGetReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m CObjGetReqMultiError) AllErrors() []error { return m }
-
-// CObjGetReqValidationError is the validation error returned by
-// CObjGetReq.Validate if the designated constraints aren't met.
-type CObjGetReqValidationError struct {
+// GetResourceTopologyReqValidationError is the validation error returned by
+// GetResourceTopologyReq.Validate if the designated constraints aren't met.
+type GetResourceTopologyReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3959,22 +3799,24 @@ type CObjGetReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjGetReqValidationError) Field() string { return e.field }
+func (e GetResourceTopologyReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjGetReqValidationError) Reason() string { return e.reason }
+func (e GetResourceTopologyReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjGetReqValidationError) Cause() error { return e.cause }
+func (e GetResourceTopologyReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjGetReqValidationError) Key() bool { return e.key }
+func (e GetResourceTopologyReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjGetReqValidationError) ErrorName() string { return "CObjGetReqValidationError" }
+func (e GetResourceTopologyReqValidationError) ErrorName() string {
+	return "GetResourceTopologyReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CObjGetReqValidationError) Error() string {
+func (e GetResourceTopologyReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3986,14 +3828,14 @@ func (e CObjGetReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjGetReq.%s: %s%s",
+		"invalid %sGetResourceTopologyReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjGetReqValidationError{}
+var _ error = GetResourceTopologyReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4001,42 +3843,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjGetReqValidationError{}
+} = GetResourceTopologyReqValidationError{}
 
-var _CObjGetReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _GetResourceTopologyReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjGetReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _GetResourceTopologyReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]+$")
 
-var _CObjGetReq_Format_InLookup = map[string]struct{}{
-	"":         {},
-	"manifest": {},
-	"formData": {},
+var _GetResourceTopologyReq_Kind_InLookup = map[string]struct{}{
+	"Deployment":      {},
+	"ReplicaSet":      {},
+	"StatefulSet":     {},
+	"DaemonSet":       {},
+	"Job":             {},
+	"CronJob":         {},
+	"Pod":             {},
+	"GameDeployment":  {},
+	"GameStatefulSet": {},
 }
 
-// Validate checks the field values on CObjHistoryReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CObjHistoryReq) Validate() error {
+var _GetResourceTopologyReq_Name_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*")
+
+// Validate checks the field values on GetK8SResTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetK8SResTemplateReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjHistoryReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjHistoryReqMultiError,
-// or nil if none found.
-func (m *CObjHistoryReq) ValidateAll() error {
+// ValidateAll checks the field values on GetK8SResTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetK8SResTemplateReqMultiError, or nil if none found.
+func (m *GetK8SResTemplateReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjHistoryReq) validate(all bool) error {
+func (m *GetK8SResTemplateReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjHistoryReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjHistoryReqValidationError{
+	if !_GetK8SResTemplateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := GetK8SResTemplateReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4047,7 +3897,7 @@ func (m *CObjHistoryReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjHistoryReqValidationError{
+		err := GetK8SResTemplateReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4057,21 +3907,10 @@ func (m *CObjHistoryReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjHistoryReqValidationError{
-			field:  "CRDName",
-			reason: "value length must be at most 256 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjHistoryReqValidationError{
-			field:  "CobjName",
-			reason: "value length must be at most 256 runes",
+	if l := utf8.RuneCountInString(m.GetKind()); l < 3 || l > 64 {
+		err := GetK8SResTemplateReqValidationError{
+			field:  "Kind",
+			reason: "value length must be between 3 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -4080,7 +3919,7 @@ func (m *CObjHistoryReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjHistoryReqValidationError{
+		err := GetK8SResTemplateReqValidationError{
 			field:  "Namespace",
 			reason: "value length must be at most 63 runes",
 		}
@@ -4090,8 +3929,8 @@ func (m *CObjHistoryReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjHistoryReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjHistoryReqValidationError{
+	if !_GetK8SResTemplateReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := GetK8SResTemplateReqValidationError{
 			field:  "Namespace",
 			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
@@ -4102,19 +3941,19 @@ func (m *CObjHistoryReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CObjHistoryReqMultiError(errors)
+		return GetK8SResTemplateReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjHistoryReqMultiError is an error wrapping multiple validation errors
-// returned by CObjHistoryReq.ValidateAll() if the designated constraints
-// aren't met.
-type CObjHistoryReqMultiError []error
+// GetK8SResTemplateReqMultiError is an error wrapping multiple validation
+// errors returned by GetK8SResTemplateReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetK8SResTemplateReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjHistoryReqMultiError) Error() string {
+func (m GetK8SResTemplateReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4123,11 +3962,11 @@ func (m CObjHistoryReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjHistoryReqMultiError) AllErrors() []error { return m }
+func (m GetK8SResTemplateReqMultiError) AllErrors() []error { return m }
 
-// CObjHistoryReqValidationError is the validation error returned by
-// CObjHistoryReq.Validate if the designated constraints aren't met.
-type CObjHistoryReqValidationError struct {
+// GetK8SResTemplateReqValidationError is the validation error returned by
+// GetK8SResTemplateReq.Validate if the designated constraints aren't met.
+type GetK8SResTemplateReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4135,22 +3974,24 @@ type CObjHistoryReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjHistoryReqValidationError) Field() string { return e.field }
+func (e GetK8SResTemplateReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjHistoryReqValidationError) Reason() string { return e.reason }
+func (e GetK8SResTemplateReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjHistoryReqValidationError) Cause() error { return e.cause }
+func (e GetK8SResTemplateReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjHistoryReqValidationError) Key() bool { return e.key }
+func (e GetK8SResTemplateReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjHistoryReqValidationError) ErrorName() string { return "CObjHistoryReqValidationError" }
+func (e GetK8SResTemplateReqValidationError) ErrorName() string {
+	return "GetK8SResTemplateReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CObjHistoryReqValidationError) Error() string {
+func (e GetK8SResTemplateReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4162,14 +4003,14 @@ func (e CObjHistoryReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjHistoryReq.%s: %s%s",
+		"invalid %sGetK8SResTemplateReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjHistoryReqValidationError{}
+var _ error = GetK8SResTemplateReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4177,36 +4018,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjHistoryReqValidationError{}
+} = GetK8SResTemplateReqValidationError{}
 
-var _CObjHistoryReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _GetK8SResTemplateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjHistoryReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _GetK8SResTemplateReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjRestartReq with the rules defined in
+// Validate checks the field values on CObjListReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjRestartReq) Validate() error {
+func (m *CObjListReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjRestartReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjRestartReqMultiError,
-// or nil if none found.
-func (m *CObjRestartReq) ValidateAll() error {
+// ValidateAll checks the field values on CObjListReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CObjListReqMultiError, or
+// nil if none found.
+func (m *CObjListReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjRestartReq) validate(all bool) error {
+func (m *CObjListReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjRestartReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjRestartReqValidationError{
+	if !_CObjListReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjListReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4217,7 +4058,7 @@ func (m *CObjRestartReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjRestartReqValidationError{
+		err := CObjListReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4228,7 +4069,7 @@ func (m *CObjRestartReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjRestartReqValidationError{
+		err := CObjListReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4238,10 +4079,10 @@ func (m *CObjRestartReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjRestartReqValidationError{
-			field:  "CobjName",
-			reason: "value length must be at most 256 runes",
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjListReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
 		}
 		if !all {
 			return err
@@ -4249,10 +4090,10 @@ func (m *CObjRestartReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjRestartReqValidationError{
+	if !_CObjListReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjListReqValidationError{
 			field:  "Namespace",
-			reason: "value length must be at most 63 runes",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
 		if !all {
 			return err
@@ -4260,10 +4101,21 @@ func (m *CObjRestartReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjRestartReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjRestartReqValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+	if _, ok := _CObjListReq_Format_InLookup[m.GetFormat()]; !ok {
+		err := CObjListReqValidationError{
+			field:  "Format",
+			reason: "value must be in list [ manifest selectItems]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetScene()) > 32 {
+		err := CObjListReqValidationError{
+			field:  "Scene",
+			reason: "value length must be at most 32 runes",
 		}
 		if !all {
 			return err
@@ -4272,19 +4124,18 @@ func (m *CObjRestartReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CObjRestartReqMultiError(errors)
+		return CObjListReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjRestartReqMultiError is an error wrapping multiple validation errors
-// returned by CObjRestartReq.ValidateAll() if the designated constraints
-// aren't met.
-type CObjRestartReqMultiError []error
+// CObjListReqMultiError is an error wrapping multiple validation errors
+// returned by CObjListReq.ValidateAll() if the designated constraints aren't met.
+type CObjListReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjRestartReqMultiError) Error() string {
+func (m CObjListReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4293,11 +4144,11 @@ func (m CObjRestartReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjRestartReqMultiError) AllErrors() []error { return m }
+func (m CObjListReqMultiError) AllErrors() []error { return m }
 
-// CObjRestartReqValidationError is the validation error returned by
-// CObjRestartReq.Validate if the designated constraints aren't met.
-type CObjRestartReqValidationError struct {
+// CObjListReqValidationError is the validation error returned by
+// CObjListReq.Validate if the designated constraints aren't met.
+type CObjListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4305,22 +4156,22 @@ type CObjRestartReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjRestartReqValidationError) Field() string { return e.field }
+func (e CObjListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjRestartReqValidationError) Reason() string { return e.reason }
+func (e CObjListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjRestartReqValidationError) Cause() error { return e.cause }
+func (e CObjListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjRestartReqValidationError) Key() bool { return e.key }
+func (e CObjListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjRestartReqValidationError) ErrorName() string { return "CObjRestartReqValidationError" }
+func (e CObjListReqValidationError) ErrorName() string { return "CObjListReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjRestartReqValidationError) Error() string {
+func (e CObjListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4332,14 +4183,14 @@ func (e CObjRestartReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjRestartReq.%s: %s%s",
+		"invalid %sCObjListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjRestartReqValidationError{}
+var _ error = CObjListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4347,36 +4198,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjRestartReqValidationError{}
+} = CObjListReqValidationError{}
 
-var _CObjRestartReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjListReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjRestartReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjListReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjRolloutReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+var _CObjListReq_Format_InLookup = map[string]struct{}{
+	"":            {},
+	"manifest":    {},
+	"selectItems": {},
+}
+
+// Validate checks the field values on CObjGetReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjRolloutReq) Validate() error {
+func (m *CObjGetReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjRolloutReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjRolloutReqMultiError,
-// or nil if none found.
-func (m *CObjRolloutReq) ValidateAll() error {
+// ValidateAll checks the field values on CObjGetReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CObjGetReqMultiError, or
+// nil if none found.
+func (m *CObjGetReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjRolloutReq) validate(all bool) error {
+func (m *CObjGetReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjRolloutReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjRolloutReqValidationError{
+	if !_CObjGetReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjGetReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4387,7 +4244,7 @@ func (m *CObjRolloutReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjRolloutReqValidationError{
+		err := CObjGetReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4398,7 +4255,7 @@ func (m *CObjRolloutReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjRolloutReqValidationError{
+		err := CObjGetReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4409,7 +4266,7 @@ func (m *CObjRolloutReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjRolloutReqValidationError{
+		err := CObjGetReqValidationError{
 			field:  "CobjName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4420,7 +4277,7 @@ func (m *CObjRolloutReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjRolloutReqValidationError{
+		err := CObjGetReqValidationError{
 			field:  "Namespace",
 			reason: "value length must be at most 63 runes",
 		}
@@ -4430,8 +4287,8 @@ func (m *CObjRolloutReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjRolloutReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjRolloutReqValidationError{
+	if !_CObjGetReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjGetReqValidationError{
 			field:  "Namespace",
 			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
@@ -4441,10 +4298,10 @@ func (m *CObjRolloutReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetRevision() < 0 {
-		err := CObjRolloutReqValidationError{
-			field:  "Revision",
-			reason: "value must be greater than or equal to 0",
+	if _, ok := _CObjGetReq_Format_InLookup[m.GetFormat()]; !ok {
+		err := CObjGetReqValidationError{
+			field:  "Format",
+			reason: "value must be in list [ manifest formData]",
 		}
 		if !all {
 			return err
@@ -4453,19 +4310,18 @@ func (m *CObjRolloutReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CObjRolloutReqMultiError(errors)
+		return CObjGetReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjRolloutReqMultiError is an error wrapping multiple validation errors
-// returned by CObjRolloutReq.ValidateAll() if the designated constraints
-// aren't met.
-type CObjRolloutReqMultiError []error
+// CObjGetReqMultiError is an error wrapping multiple validation errors
+// returned by CObjGetReq.ValidateAll() if the designated constraints aren't met.
+type CObjGetReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjRolloutReqMultiError) Error() string {
+func (m CObjGetReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4474,11 +4330,11 @@ func (m CObjRolloutReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjRolloutReqMultiError) AllErrors() []error { return m }
+func (m CObjGetReqMultiError) AllErrors() []error { return m }
 
-// CObjRolloutReqValidationError is the validation error returned by
-// CObjRolloutReq.Validate if the designated constraints aren't met.
-type CObjRolloutReqValidationError struct {
+// CObjGetReqValidationError is the validation error returned by
+// CObjGetReq.Validate if the designated constraints aren't met.
+type CObjGetReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4486,22 +4342,22 @@ type CObjRolloutReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjRolloutReqValidationError) Field() string { return e.field }
+func (e CObjGetReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjRolloutReqValidationError) Reason() string { return e.reason }
+func (e CObjGetReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjRolloutReqValidationError) Cause() error { return e.cause }
+func (e CObjGetReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjRolloutReqValidationError) Key() bool { return e.key }
+func (e CObjGetReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjRolloutReqValidationError) ErrorName() string { return "CObjRolloutReqValidationError" }
+func (e CObjGetReqValidationError) ErrorName() string { return "CObjGetReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjRolloutReqValidationError) Error() string {
+func (e CObjGetReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4513,14 +4369,14 @@ func (e CObjRolloutReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjRolloutReq.%s: %s%s",
+		"invalid %sCObjGetReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjRolloutReqValidationError{}
+var _ error = CObjGetReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4528,36 +4384,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjRolloutReqValidationError{}
+} = CObjGetReqValidationError{}
 
-var _CObjRolloutReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjGetReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjRolloutReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjGetReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjCreateReq with the rules defined in
+var _CObjGetReq_Format_InLookup = map[string]struct{}{
+	"":         {},
+	"manifest": {},
+	"formData": {},
+}
+
+// Validate checks the field values on CObjHistoryReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjCreateReq) Validate() error {
+func (m *CObjHistoryReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjCreateReq with the rules defined
+// ValidateAll checks the field values on CObjHistoryReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjCreateReqMultiError, or
-// nil if none found.
-func (m *CObjCreateReq) ValidateAll() error {
+// result is a list of violation errors wrapped in CObjHistoryReqMultiError,
+// or nil if none found.
+func (m *CObjHistoryReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjCreateReq) validate(all bool) error {
+func (m *CObjHistoryReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjCreateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjCreateReqValidationError{
+	if !_CObjHistoryReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjHistoryReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4568,7 +4430,7 @@ func (m *CObjCreateReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjCreateReqValidationError{
+		err := CObjHistoryReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4579,7 +4441,7 @@ func (m *CObjCreateReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjCreateReqValidationError{
+		err := CObjHistoryReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4589,39 +4451,32 @@ func (m *CObjCreateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetRawData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CObjCreateReqValidationError{
-					field:  "RawData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CObjCreateReqValidationError{
-					field:  "RawData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
+		err := CObjHistoryReqValidationError{
+			field:  "CobjName",
+			reason: "value length must be at most 256 runes",
 		}
-	} else if v, ok := interface{}(m.GetRawData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CObjCreateReqValidationError{
-				field:  "RawData",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if _, ok := _CObjCreateReq_Format_InLookup[m.GetFormat()]; !ok {
-		err := CObjCreateReqValidationError{
-			field:  "Format",
-			reason: "value must be in list [ manifest formData]",
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjHistoryReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CObjHistoryReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjHistoryReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
 		if !all {
 			return err
@@ -4630,19 +4485,19 @@ func (m *CObjCreateReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CObjCreateReqMultiError(errors)
+		return CObjHistoryReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjCreateReqMultiError is an error wrapping multiple validation errors
-// returned by CObjCreateReq.ValidateAll() if the designated constraints
+// CObjHistoryReqMultiError is an error wrapping multiple validation errors
+// returned by CObjHistoryReq.ValidateAll() if the designated constraints
 // aren't met.
-type CObjCreateReqMultiError []error
+type CObjHistoryReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjCreateReqMultiError) Error() string {
+func (m CObjHistoryReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4651,11 +4506,11 @@ func (m CObjCreateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjCreateReqMultiError) AllErrors() []error { return m }
+func (m CObjHistoryReqMultiError) AllErrors() []error { return m }
 
-// CObjCreateReqValidationError is the validation error returned by
-// CObjCreateReq.Validate if the designated constraints aren't met.
-type CObjCreateReqValidationError struct {
+// CObjHistoryReqValidationError is the validation error returned by
+// CObjHistoryReq.Validate if the designated constraints aren't met.
+type CObjHistoryReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4663,22 +4518,22 @@ type CObjCreateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjCreateReqValidationError) Field() string { return e.field }
+func (e CObjHistoryReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjCreateReqValidationError) Reason() string { return e.reason }
+func (e CObjHistoryReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjCreateReqValidationError) Cause() error { return e.cause }
+func (e CObjHistoryReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjCreateReqValidationError) Key() bool { return e.key }
+func (e CObjHistoryReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjCreateReqValidationError) ErrorName() string { return "CObjCreateReqValidationError" }
+func (e CObjHistoryReqValidationError) ErrorName() string { return "CObjHistoryReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjCreateReqValidationError) Error() string {
+func (e CObjHistoryReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4690,14 +4545,14 @@ func (e CObjCreateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjCreateReq.%s: %s%s",
+		"invalid %sCObjHistoryReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjCreateReqValidationError{}
+var _ error = CObjHistoryReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4705,40 +4560,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjCreateReqValidationError{}
+} = CObjHistoryReqValidationError{}
 
-var _CObjCreateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjHistoryReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjCreateReq_Format_InLookup = map[string]struct{}{
-	"":         {},
-	"manifest": {},
-	"formData": {},
-}
+var _CObjHistoryReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjUpdateReq with the rules defined in
+// Validate checks the field values on CObjRestartReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjUpdateReq) Validate() error {
+func (m *CObjRestartReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjUpdateReq with the rules defined
+// ValidateAll checks the field values on CObjRestartReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjUpdateReqMultiError, or
-// nil if none found.
-func (m *CObjUpdateReq) ValidateAll() error {
+// result is a list of violation errors wrapped in CObjRestartReqMultiError,
+// or nil if none found.
+func (m *CObjRestartReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjUpdateReq) validate(all bool) error {
+func (m *CObjRestartReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjUpdateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjUpdateReqValidationError{
+	if !_CObjRestartReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjRestartReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4749,7 +4600,7 @@ func (m *CObjUpdateReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjUpdateReqValidationError{
+		err := CObjRestartReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4760,7 +4611,7 @@ func (m *CObjUpdateReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjUpdateReqValidationError{
+		err := CObjRestartReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4771,7 +4622,7 @@ func (m *CObjUpdateReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjUpdateReqValidationError{
+		err := CObjRestartReqValidationError{
 			field:  "CobjName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -4782,7 +4633,7 @@ func (m *CObjUpdateReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjUpdateReqValidationError{
+		err := CObjRestartReqValidationError{
 			field:  "Namespace",
 			reason: "value length must be at most 63 runes",
 		}
@@ -4792,8 +4643,8 @@ func (m *CObjUpdateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjUpdateReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjUpdateReqValidationError{
+	if !_CObjRestartReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjRestartReqValidationError{
 			field:  "Namespace",
 			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
@@ -4803,60 +4654,20 @@ func (m *CObjUpdateReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetRawData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CObjUpdateReqValidationError{
-					field:  "RawData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CObjUpdateReqValidationError{
-					field:  "RawData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRawData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CObjUpdateReqValidationError{
-				field:  "RawData",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if _, ok := _CObjUpdateReq_Format_InLookup[m.GetFormat()]; !ok {
-		err := CObjUpdateReqValidationError{
-			field:  "Format",
-			reason: "value must be in list [ manifest formData]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return CObjUpdateReqMultiError(errors)
+		return CObjRestartReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjUpdateReqMultiError is an error wrapping multiple validation errors
-// returned by CObjUpdateReq.ValidateAll() if the designated constraints
+// CObjRestartReqMultiError is an error wrapping multiple validation errors
+// returned by CObjRestartReq.ValidateAll() if the designated constraints
 // aren't met.
-type CObjUpdateReqMultiError []error
+type CObjRestartReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjUpdateReqMultiError) Error() string {
+func (m CObjRestartReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4865,11 +4676,11 @@ func (m CObjUpdateReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjUpdateReqMultiError) AllErrors() []error { return m }
+func (m CObjRestartReqMultiError) AllErrors() []error { return m }
 
-// CObjUpdateReqValidationError is the validation error returned by
-// CObjUpdateReq.Validate if the designated constraints aren't met.
-type CObjUpdateReqValidationError struct {
+// CObjRestartReqValidationError is the validation error returned by
+// CObjRestartReq.Validate if the designated constraints aren't met.
+type CObjRestartReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4877,22 +4688,22 @@ type CObjUpdateReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjUpdateReqValidationError) Field() string { return e.field }
+func (e CObjRestartReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjUpdateReqValidationError) Reason() string { return e.reason }
+func (e CObjRestartReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjUpdateReqValidationError) Cause() error { return e.cause }
+func (e CObjRestartReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjUpdateReqValidationError) Key() bool { return e.key }
+func (e CObjRestartReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjUpdateReqValidationError) ErrorName() string { return "CObjUpdateReqValidationError" }
+func (e CObjRestartReqValidationError) ErrorName() string { return "CObjRestartReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjUpdateReqValidationError) Error() string {
+func (e CObjRestartReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4904,14 +4715,14 @@ func (e CObjUpdateReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjUpdateReq.%s: %s%s",
+		"invalid %sCObjRestartReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjUpdateReqValidationError{}
+var _ error = CObjRestartReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4919,42 +4730,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjUpdateReqValidationError{}
-
-var _CObjUpdateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = CObjRestartReqValidationError{}
 
-var _CObjUpdateReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjRestartReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjUpdateReq_Format_InLookup = map[string]struct{}{
-	"":         {},
-	"manifest": {},
-	"formData": {},
-}
+var _CObjRestartReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjScaleReq with the rules defined in
+// Validate checks the field values on CObjRolloutReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjScaleReq) Validate() error {
+func (m *CObjRolloutReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjScaleReq with the rules defined
+// ValidateAll checks the field values on CObjRolloutReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjScaleReqMultiError, or
-// nil if none found.
-func (m *CObjScaleReq) ValidateAll() error {
+// result is a list of violation errors wrapped in CObjRolloutReqMultiError,
+// or nil if none found.
+func (m *CObjRolloutReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjScaleReq) validate(all bool) error {
+func (m *CObjRolloutReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjScaleReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjScaleReqValidationError{
+	if !_CObjRolloutReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjRolloutReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -4965,7 +4770,7 @@ func (m *CObjScaleReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjScaleReqValidationError{
+		err := CObjRolloutReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -4975,10 +4780,10 @@ func (m *CObjScaleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjScaleReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 63 runes",
+	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
+		err := CObjRolloutReqValidationError{
+			field:  "CRDName",
+			reason: "value length must be at most 256 runes",
 		}
 		if !all {
 			return err
@@ -4986,10 +4791,10 @@ func (m *CObjScaleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjScaleReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjScaleReqValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
+		err := CObjRolloutReqValidationError{
+			field:  "CobjName",
+			reason: "value length must be at most 256 runes",
 		}
 		if !all {
 			return err
@@ -4997,10 +4802,10 @@ func (m *CObjScaleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjScaleReqValidationError{
-			field:  "CRDName",
-			reason: "value length must be at most 256 runes",
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjRolloutReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
 		}
 		if !all {
 			return err
@@ -5008,10 +4813,10 @@ func (m *CObjScaleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjScaleReqValidationError{
-			field:  "CobjName",
-			reason: "value length must be at most 256 runes",
+	if !_CObjRolloutReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjRolloutReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
 		if !all {
 			return err
@@ -5019,10 +4824,10 @@ func (m *CObjScaleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetReplicas(); val < 0 || val > 8192 {
-		err := CObjScaleReqValidationError{
-			field:  "Replicas",
-			reason: "value must be inside range [0, 8192]",
+	if m.GetRevision() < 0 {
+		err := CObjRolloutReqValidationError{
+			field:  "Revision",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -5031,18 +4836,19 @@ func (m *CObjScaleReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CObjScaleReqMultiError(errors)
+		return CObjRolloutReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjScaleReqMultiError is an error wrapping multiple validation errors
-// returned by CObjScaleReq.ValidateAll() if the designated constraints aren't met.
-type CObjScaleReqMultiError []error
+// CObjRolloutReqMultiError is an error wrapping multiple validation errors
+// returned by CObjRolloutReq.ValidateAll() if the designated constraints
+// aren't met.
+type CObjRolloutReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjScaleReqMultiError) Error() string {
+func (m CObjRolloutReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5051,11 +4857,11 @@ func (m CObjScaleReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjScaleReqMultiError) AllErrors() []error { return m }
+func (m CObjRolloutReqMultiError) AllErrors() []error { return m }
 
-// CObjScaleReqValidationError is the validation error returned by
-// CObjScaleReq.Validate if the designated constraints aren't met.
-type CObjScaleReqValidationError struct {
+// CObjRolloutReqValidationError is the validation error returned by
+// CObjRolloutReq.Validate if the designated constraints aren't met.
+type CObjRolloutReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5063,22 +4869,22 @@ type CObjScaleReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjScaleReqValidationError) Field() string { return e.field }
+func (e CObjRolloutReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjScaleReqValidationError) Reason() string { return e.reason }
+func (e CObjRolloutReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjScaleReqValidationError) Cause() error { return e.cause }
+func (e CObjRolloutReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjScaleReqValidationError) Key() bool { return e.key }
+func (e CObjRolloutReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjScaleReqValidationError) ErrorName() string { return "CObjScaleReqValidationError" }
+func (e CObjRolloutReqValidationError) ErrorName() string { return "CObjRolloutReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjScaleReqValidationError) Error() string {
+func (e CObjRolloutReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5090,14 +4896,14 @@ func (e CObjScaleReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjScaleReq.%s: %s%s",
+		"invalid %sCObjRolloutReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjScaleReqValidationError{}
+var _ error = CObjRolloutReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5105,36 +4911,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjScaleReqValidationError{}
+} = CObjRolloutReqValidationError{}
 
-var _CObjScaleReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjRolloutReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjScaleReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjRolloutReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-// Validate checks the field values on CObjDeleteReq with the rules defined in
+// Validate checks the field values on CObjCreateReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CObjDeleteReq) Validate() error {
+func (m *CObjCreateReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjDeleteReq with the rules defined
+// ValidateAll checks the field values on CObjCreateReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CObjDeleteReqMultiError, or
+// result is a list of violation errors wrapped in CObjCreateReqMultiError, or
 // nil if none found.
-func (m *CObjDeleteReq) ValidateAll() error {
+func (m *CObjCreateReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjDeleteReq) validate(all bool) error {
+func (m *CObjCreateReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjDeleteReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjDeleteReqValidationError{
+	if !_CObjCreateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjCreateReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -5145,7 +4951,7 @@ func (m *CObjDeleteReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjDeleteReqValidationError{
+		err := CObjCreateReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -5156,7 +4962,7 @@ func (m *CObjDeleteReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjDeleteReqValidationError{
+		err := CObjCreateReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -5166,21 +4972,39 @@ func (m *CObjDeleteReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjDeleteReqValidationError{
-			field:  "CobjName",
-			reason: "value length must be at most 256 runes",
+	if all {
+		switch v := interface{}(m.GetRawData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CObjCreateReqValidationError{
+					field:  "RawData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CObjCreateReqValidationError{
+					field:  "RawData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetRawData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CObjCreateReqValidationError{
+				field:  "RawData",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjDeleteReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 63 runes",
+	if _, ok := _CObjCreateReq_Format_InLookup[m.GetFormat()]; !ok {
+		err := CObjCreateReqValidationError{
+			field:  "Format",
+			reason: "value must be in list [ manifest formData]",
 		}
 		if !all {
 			return err
@@ -5188,31 +5012,22 @@ func (m *CObjDeleteReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CObjDeleteReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjDeleteReqValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return CObjDeleteReqMultiError(errors)
+		return CObjCreateReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjDeleteReqMultiError is an error wrapping multiple validation errors
-// returned by CObjDeleteReq.ValidateAll() if the designated constraints
+// CObjCreateReqMultiError is an error wrapping multiple validation errors
+// returned by CObjCreateReq.ValidateAll() if the designated constraints
 // aren't met.
-type CObjDeleteReqMultiError []error
+type CObjCreateReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjDeleteReqMultiError) Error() string {
+func (m CObjCreateReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5221,11 +5036,11 @@ func (m CObjDeleteReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjDeleteReqMultiError) AllErrors() []error { return m }
+func (m CObjCreateReqMultiError) AllErrors() []error { return m }
 
-// CObjDeleteReqValidationError is the validation error returned by
-// CObjDeleteReq.Validate if the designated constraints aren't met.
-type CObjDeleteReqValidationError struct {
+// CObjCreateReqValidationError is the validation error returned by
+// CObjCreateReq.Validate if the designated constraints aren't met.
+type CObjCreateReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5233,22 +5048,22 @@ type CObjDeleteReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjDeleteReqValidationError) Field() string { return e.field }
+func (e CObjCreateReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjDeleteReqValidationError) Reason() string { return e.reason }
+func (e CObjCreateReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjDeleteReqValidationError) Cause() error { return e.cause }
+func (e CObjCreateReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjDeleteReqValidationError) Key() bool { return e.key }
+func (e CObjCreateReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjDeleteReqValidationError) ErrorName() string { return "CObjDeleteReqValidationError" }
+func (e CObjCreateReqValidationError) ErrorName() string { return "CObjCreateReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjDeleteReqValidationError) Error() string {
+func (e CObjCreateReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5260,14 +5075,14 @@ func (e CObjDeleteReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjDeleteReq.%s: %s%s",
+		"invalid %sCObjCreateReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjDeleteReqValidationError{}
+var _ error = CObjCreateReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5275,36 +5090,40 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjDeleteReqValidationError{}
+} = CObjCreateReqValidationError{}
 
-var _CObjDeleteReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjCreateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjDeleteReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjCreateReq_Format_InLookup = map[string]struct{}{
+	"":         {},
+	"manifest": {},
+	"formData": {},
+}
 
-// Validate checks the field values on CObjBatchRescheduleReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CObjBatchRescheduleReq) Validate() error {
+// Validate checks the field values on CObjUpdateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CObjUpdateReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CObjBatchRescheduleReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CObjBatchRescheduleReqMultiError, or nil if none found.
-func (m *CObjBatchRescheduleReq) ValidateAll() error {
+// ValidateAll checks the field values on CObjUpdateReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CObjUpdateReqMultiError, or
+// nil if none found.
+func (m *CObjUpdateReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CObjBatchRescheduleReq) validate(all bool) error {
+func (m *CObjUpdateReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CObjBatchRescheduleReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := CObjBatchRescheduleReqValidationError{
+	if !_CObjUpdateReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjUpdateReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -5315,7 +5134,7 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := CObjBatchRescheduleReqValidationError{
+		err := CObjUpdateReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -5325,30 +5144,8 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
-		err := CObjBatchRescheduleReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 63 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_CObjBatchRescheduleReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		err := CObjBatchRescheduleReqValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
-		err := CObjBatchRescheduleReqValidationError{
+		err := CObjUpdateReqValidationError{
 			field:  "CRDName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -5359,7 +5156,7 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
-		err := CObjBatchRescheduleReqValidationError{
+		err := CObjUpdateReqValidationError{
 			field:  "CobjName",
 			reason: "value length must be at most 256 runes",
 		}
@@ -5369,10 +5166,10 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetLabelSelector()) > 2048 {
-		err := CObjBatchRescheduleReqValidationError{
-			field:  "LabelSelector",
-			reason: "value length must be at most 2048 runes",
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjUpdateReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
 		}
 		if !all {
 			return err
@@ -5380,10 +5177,10 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetPodNames()) < 1 {
-		err := CObjBatchRescheduleReqValidationError{
-			field:  "PodNames",
-			reason: "value must contain at least 1 item(s)",
+	if !_CObjUpdateReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjUpdateReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
 		}
 		if !all {
 			return err
@@ -5391,62 +5188,62 @@ func (m *CObjBatchRescheduleReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_CObjBatchRescheduleReq_PodNames_Unique := make(map[string]struct{}, len(m.GetPodNames()))
-
-	for idx, item := range m.GetPodNames() {
-		_, _ = idx, item
-
-		if _, exists := _CObjBatchRescheduleReq_PodNames_Unique[item]; exists {
-			err := CObjBatchRescheduleReqValidationError{
-				field:  fmt.Sprintf("PodNames[%v]", idx),
-				reason: "repeated value must contain unique items",
+	if all {
+		switch v := interface{}(m.GetRawData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CObjUpdateReqValidationError{
+					field:  "RawData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CObjUpdateReqValidationError{
+					field:  "RawData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
-		} else {
-			_CObjBatchRescheduleReq_PodNames_Unique[item] = struct{}{}
 		}
-
-		if utf8.RuneCountInString(item) > 253 {
-			err := CObjBatchRescheduleReqValidationError{
-				field:  fmt.Sprintf("PodNames[%v]", idx),
-				reason: "value length must be at most 253 runes",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetRawData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CObjUpdateReqValidationError{
+				field:  "RawData",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if !_CObjBatchRescheduleReq_PodNames_Pattern.MatchString(item) {
-			err := CObjBatchRescheduleReqValidationError{
-				field:  fmt.Sprintf("PodNames[%v]", idx),
-				reason: "value does not match regex pattern \"[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if _, ok := _CObjUpdateReq_Format_InLookup[m.GetFormat()]; !ok {
+		err := CObjUpdateReqValidationError{
+			field:  "Format",
+			reason: "value must be in list [ manifest formData]",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return CObjBatchRescheduleReqMultiError(errors)
+		return CObjUpdateReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CObjBatchRescheduleReqMultiError is an error wrapping multiple validation
-// errors returned by CObjBatchRescheduleReq.ValidateAll() if the designated
-// constraints aren't met.
-type CObjBatchRescheduleReqMultiError []error
+// CObjUpdateReqMultiError is an error wrapping multiple validation errors
+// returned by CObjUpdateReq.ValidateAll() if the designated constraints
+// aren't met.
+type CObjUpdateReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CObjBatchRescheduleReqMultiError) Error() string {
+func (m CObjUpdateReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5455,11 +5252,11 @@ func (m CObjBatchRescheduleReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CObjBatchRescheduleReqMultiError) AllErrors() []error { return m }
+func (m CObjUpdateReqMultiError) AllErrors() []error { return m }
 
-// CObjBatchRescheduleReqValidationError is the validation error returned by
-// CObjBatchRescheduleReq.Validate if the designated constraints aren't met.
-type CObjBatchRescheduleReqValidationError struct {
+// CObjUpdateReqValidationError is the validation error returned by
+// CObjUpdateReq.Validate if the designated constraints aren't met.
+type CObjUpdateReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5467,24 +5264,22 @@ type CObjBatchRescheduleReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CObjBatchRescheduleReqValidationError) Field() string { return e.field }
+func (e CObjUpdateReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CObjBatchRescheduleReqValidationError) Reason() string { return e.reason }
+func (e CObjUpdateReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CObjBatchRescheduleReqValidationError) Cause() error { return e.cause }
+func (e CObjUpdateReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CObjBatchRescheduleReqValidationError) Key() bool { return e.key }
+func (e CObjUpdateReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CObjBatchRescheduleReqValidationError) ErrorName() string {
-	return "CObjBatchRescheduleReqValidationError"
-}
+func (e CObjUpdateReqValidationError) ErrorName() string { return "CObjUpdateReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CObjBatchRescheduleReqValidationError) Error() string {
+func (e CObjUpdateReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5496,14 +5291,14 @@ func (e CObjBatchRescheduleReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCObjBatchRescheduleReq.%s: %s%s",
+		"invalid %sCObjUpdateReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CObjBatchRescheduleReqValidationError{}
+var _ error = CObjUpdateReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5511,113 +5306,1508 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CObjBatchRescheduleReqValidationError{}
+} = CObjUpdateReqValidationError{}
 
-var _CObjBatchRescheduleReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _CObjUpdateReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-var _CObjBatchRescheduleReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+var _CObjUpdateReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
 
-var _CObjBatchRescheduleReq_PodNames_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*")
+var _CObjUpdateReq_Format_InLookup = map[string]struct{}{
+	"":         {},
+	"manifest": {},
+	"formData": {},
+}
 
-// Validate checks the field values on CommonResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on CObjScaleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CommonResp) Validate() error {
+func (m *CObjScaleReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommonResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommonRespMultiError, or
+// ValidateAll checks the field values on CObjScaleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CObjScaleReqMultiError, or
 // nil if none found.
-func (m *CommonResp) ValidateAll() error {
+func (m *CObjScaleReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommonResp) validate(all bool) error {
+func (m *CObjScaleReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
-
-	// no validation rules for RequestID
+	if !_CObjScaleReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjScaleReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommonRespValidationError{
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := CObjScaleReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjScaleReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CObjScaleReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjScaleReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
+		err := CObjScaleReqValidationError{
+			field:  "CRDName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
+		err := CObjScaleReqValidationError{
+			field:  "CobjName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetReplicas(); val < 0 || val > 8192 {
+		err := CObjScaleReqValidationError{
+			field:  "Replicas",
+			reason: "value must be inside range [0, 8192]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CObjScaleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CObjScaleReqMultiError is an error wrapping multiple validation errors
+// returned by CObjScaleReq.ValidateAll() if the designated constraints aren't met.
+type CObjScaleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CObjScaleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CObjScaleReqMultiError) AllErrors() []error { return m }
+
+// CObjScaleReqValidationError is the validation error returned by
+// CObjScaleReq.Validate if the designated constraints aren't met.
+type CObjScaleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CObjScaleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CObjScaleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CObjScaleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CObjScaleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CObjScaleReqValidationError) ErrorName() string { return "CObjScaleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CObjScaleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCObjScaleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CObjScaleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CObjScaleReqValidationError{}
+
+var _CObjScaleReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+var _CObjScaleReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+// Validate checks the field values on CObjDeleteReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CObjDeleteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CObjDeleteReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CObjDeleteReqMultiError, or
+// nil if none found.
+func (m *CObjDeleteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CObjDeleteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CObjDeleteReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjDeleteReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := CObjDeleteReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
+		err := CObjDeleteReqValidationError{
+			field:  "CRDName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
+		err := CObjDeleteReqValidationError{
+			field:  "CobjName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjDeleteReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CObjDeleteReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjDeleteReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CObjDeleteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CObjDeleteReqMultiError is an error wrapping multiple validation errors
+// returned by CObjDeleteReq.ValidateAll() if the designated constraints
+// aren't met.
+type CObjDeleteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CObjDeleteReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CObjDeleteReqMultiError) AllErrors() []error { return m }
+
+// CObjDeleteReqValidationError is the validation error returned by
+// CObjDeleteReq.Validate if the designated constraints aren't met.
+type CObjDeleteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CObjDeleteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CObjDeleteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CObjDeleteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CObjDeleteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CObjDeleteReqValidationError) ErrorName() string { return "CObjDeleteReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CObjDeleteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCObjDeleteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CObjDeleteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CObjDeleteReqValidationError{}
+
+var _CObjDeleteReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+var _CObjDeleteReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+// Validate checks the field values on CObjBatchRescheduleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CObjBatchRescheduleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CObjBatchRescheduleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CObjBatchRescheduleReqMultiError, or nil if none found.
+func (m *CObjBatchRescheduleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CObjBatchRescheduleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CObjBatchRescheduleReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CObjBatchRescheduleReq_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCRDName()) > 256 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "CRDName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCobjName()) > 256 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "CobjName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabelSelector()) > 2048 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "LabelSelector",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPodNames()) < 1 {
+		err := CObjBatchRescheduleReqValidationError{
+			field:  "PodNames",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CObjBatchRescheduleReq_PodNames_Unique := make(map[string]struct{}, len(m.GetPodNames()))
+
+	for idx, item := range m.GetPodNames() {
+		_, _ = idx, item
+
+		if _, exists := _CObjBatchRescheduleReq_PodNames_Unique[item]; exists {
+			err := CObjBatchRescheduleReqValidationError{
+				field:  fmt.Sprintf("PodNames[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CObjBatchRescheduleReq_PodNames_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) > 253 {
+			err := CObjBatchRescheduleReqValidationError{
+				field:  fmt.Sprintf("PodNames[%v]", idx),
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CObjBatchRescheduleReq_PodNames_Pattern.MatchString(item) {
+			err := CObjBatchRescheduleReqValidationError{
+				field:  fmt.Sprintf("PodNames[%v]", idx),
+				reason: "value does not match regex pattern \"[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CObjBatchRescheduleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CObjBatchRescheduleReqMultiError is an error wrapping multiple validation
+// errors returned by CObjBatchRescheduleReq.ValidateAll() if the designated
+// constraints aren't met.
+type CObjBatchRescheduleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CObjBatchRescheduleReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CObjBatchRescheduleReqMultiError) AllErrors() []error { return m }
+
+// CObjBatchRescheduleReqValidationError is the validation error returned by
+// CObjBatchRescheduleReq.Validate if the designated constraints aren't met.
+type CObjBatchRescheduleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CObjBatchRescheduleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CObjBatchRescheduleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CObjBatchRescheduleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CObjBatchRescheduleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CObjBatchRescheduleReqValidationError) ErrorName() string {
+	return "CObjBatchRescheduleReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CObjBatchRescheduleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCObjBatchRescheduleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CObjBatchRescheduleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CObjBatchRescheduleReqValidationError{}
+
+var _CObjBatchRescheduleReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+var _CObjBatchRescheduleReq_Namespace_Pattern = regexp.MustCompile("^[0-9a-zA-Z-]*$")
+
+var _CObjBatchRescheduleReq_PodNames_Pattern = regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*")
+
+// Validate checks the field values on CommonResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommonResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommonRespMultiError, or
+// nil if none found.
+func (m *CommonResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for RequestID
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommonRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommonRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommonRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommonRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommonRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommonRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommonRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonRespMultiError is an error wrapping multiple validation errors
+// returned by CommonResp.ValidateAll() if the designated constraints aren't met.
+type CommonRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonRespMultiError) AllErrors() []error { return m }
+
+// CommonRespValidationError is the validation error returned by
+// CommonResp.Validate if the designated constraints aren't met.
+type CommonRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonRespValidationError) ErrorName() string { return "CommonRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommonRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonRespValidationError{}
+
+// Validate checks the field values on CommonListResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommonListResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonListResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommonListRespMultiError,
+// or nil if none found.
+func (m *CommonListResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonListResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for RequestID
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommonListRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommonListRespValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommonListRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommonListRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommonListRespValidationError{
+					field:  "WebAnnotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommonListRespValidationError{
+				field:  "WebAnnotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommonListRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonListRespMultiError is an error wrapping multiple validation errors
+// returned by CommonListResp.ValidateAll() if the designated constraints
+// aren't met.
+type CommonListRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonListRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonListRespMultiError) AllErrors() []error { return m }
+
+// CommonListRespValidationError is the validation error returned by
+// CommonListResp.Validate if the designated constraints aren't met.
+type CommonListRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonListRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonListRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonListRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonListRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonListRespValidationError) ErrorName() string { return "CommonListRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommonListRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonListResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonListRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonListRespValidationError{}
+
+// Validate checks the field values on SubscribeReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubscribeReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscribeReqMultiError, or
+// nil if none found.
+func (m *SubscribeReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SubscribeReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := SubscribeReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := SubscribeReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceVersion()) > 32 {
+		err := SubscribeReqValidationError{
+			field:  "ResourceVersion",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) > 128 {
+		err := SubscribeReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCRDName()) > 128 {
+		err := SubscribeReqValidationError{
+			field:  "CRDName",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApiVersion()) > 128 {
+		err := SubscribeReqValidationError{
+			field:  "ApiVersion",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 64 {
+		err := SubscribeReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubscribeReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeReqMultiError is an error wrapping multiple validation errors
+// returned by SubscribeReq.ValidateAll() if the designated constraints aren't met.
+type SubscribeReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeReqMultiError) AllErrors() []error { return m }
+
+// SubscribeReqValidationError is the validation error returned by
+// SubscribeReq.Validate if the designated constraints aren't met.
+type SubscribeReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeReqValidationError) ErrorName() string { return "SubscribeReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscribeReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeReqValidationError{}
+
+var _SubscribeReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// Validate checks the field values on SubscribeResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubscribeResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscribeRespMultiError, or
+// nil if none found.
+func (m *SubscribeResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Kind
+
+	// no validation rules for Type
+
+	// no validation rules for Uid
+
+	if all {
+		switch v := interface{}(m.GetManifest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscribeRespValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommonRespValidationError{
-					field:  "Data",
+				errors = append(errors, SubscribeRespValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManifest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscribeRespValidationError{
+				field:  "Manifest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetManifestExt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscribeRespValidationError{
+					field:  "ManifestExt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubscribeRespValidationError{
+					field:  "ManifestExt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommonRespValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	} else if v, ok := interface{}(m.GetManifestExt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscribeRespValidationError{
+				field:  "ManifestExt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubscribeRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeRespMultiError is an error wrapping multiple validation errors
+// returned by SubscribeResp.ValidateAll() if the designated constraints
+// aren't met.
+type SubscribeRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeRespMultiError) AllErrors() []error { return m }
+
+// SubscribeRespValidationError is the validation error returned by
+// SubscribeResp.Validate if the designated constraints aren't met.
+type SubscribeRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeRespValidationError) ErrorName() string { return "SubscribeRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscribeRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeRespValidationError{}
+
+// Validate checks the field values on InvalidateDiscoveryCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvalidateDiscoveryCacheReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvalidateDiscoveryCacheReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvalidateDiscoveryCacheReqMultiError, or nil if none found.
+func (m *InvalidateDiscoveryCacheReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvalidateDiscoveryCacheReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_InvalidateDiscoveryCacheReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := InvalidateDiscoveryCacheReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := InvalidateDiscoveryCacheReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AuthToken
+
+	if len(errors) > 0 {
+		return InvalidateDiscoveryCacheReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvalidateDiscoveryCacheReqMultiError is an error wrapping multiple
+// validation errors returned by InvalidateDiscoveryCacheReq.ValidateAll() if
+// the designated constraints aren't met.
+type InvalidateDiscoveryCacheReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvalidateDiscoveryCacheReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvalidateDiscoveryCacheReqMultiError) AllErrors() []error { return m }
+
+// InvalidateDiscoveryCacheReqValidationError is the validation error returned
+// by InvalidateDiscoveryCacheReq.Validate if the designated constraints
+// aren't met.
+type InvalidateDiscoveryCacheReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvalidateDiscoveryCacheReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvalidateDiscoveryCacheReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvalidateDiscoveryCacheReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvalidateDiscoveryCacheReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvalidateDiscoveryCacheReqValidationError) ErrorName() string {
+	return "InvalidateDiscoveryCacheReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvalidateDiscoveryCacheReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvalidateDiscoveryCacheReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvalidateDiscoveryCacheReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvalidateDiscoveryCacheReqValidationError{}
+
+var _InvalidateDiscoveryCacheReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// Validate checks the field values on FormRenderPreviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FormRenderPreviewReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormRenderPreviewReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormRenderPreviewReqMultiError, or nil if none found.
+func (m *FormRenderPreviewReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormRenderPreviewReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_FormRenderPreviewReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := FormRenderPreviewReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := FormRenderPreviewReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) > 128 {
+		err := FormRenderPreviewReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at most 128 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetWebAnnotations()).(type) {
+		switch v := interface{}(m.GetFormData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommonRespValidationError{
-					field:  "WebAnnotations",
+				errors = append(errors, FormRenderPreviewReqValidationError{
+					field:  "FormData",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommonRespValidationError{
-					field:  "WebAnnotations",
+				errors = append(errors, FormRenderPreviewReqValidationError{
+					field:  "FormData",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFormData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommonRespValidationError{
-				field:  "WebAnnotations",
+			return FormRenderPreviewReqValidationError{
+				field:  "FormData",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return CommonRespMultiError(errors)
+		return FormRenderPreviewReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommonRespMultiError is an error wrapping multiple validation errors
-// returned by CommonResp.ValidateAll() if the designated constraints aren't met.
-type CommonRespMultiError []error
+// FormRenderPreviewReqMultiError is an error wrapping multiple validation
+// errors returned by FormRenderPreviewReq.ValidateAll() if the designated
+// constraints aren't met.
+type FormRenderPreviewReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommonRespMultiError) Error() string {
+func (m FormRenderPreviewReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5626,11 +6816,11 @@ func (m CommonRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommonRespMultiError) AllErrors() []error { return m }
+func (m FormRenderPreviewReqMultiError) AllErrors() []error { return m }
 
-// CommonRespValidationError is the validation error returned by
-// CommonResp.Validate if the designated constraints aren't met.
-type CommonRespValidationError struct {
+// FormRenderPreviewReqValidationError is the validation error returned by
+// FormRenderPreviewReq.Validate if the designated constraints aren't met.
+type FormRenderPreviewReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5638,22 +6828,24 @@ type CommonRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommonRespValidationError) Field() string { return e.field }
+func (e FormRenderPreviewReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommonRespValidationError) Reason() string { return e.reason }
+func (e FormRenderPreviewReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommonRespValidationError) Cause() error { return e.cause }
+func (e FormRenderPreviewReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommonRespValidationError) Key() bool { return e.key }
+func (e FormRenderPreviewReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommonRespValidationError) ErrorName() string { return "CommonRespValidationError" }
+func (e FormRenderPreviewReqValidationError) ErrorName() string {
+	return "FormRenderPreviewReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommonRespValidationError) Error() string {
+func (e FormRenderPreviewReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5665,14 +6857,14 @@ func (e CommonRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommonResp.%s: %s%s",
+		"invalid %sFormRenderPreviewReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommonRespValidationError{}
+var _ error = FormRenderPreviewReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5680,108 +6872,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommonRespValidationError{}
+} = FormRenderPreviewReqValidationError{}
 
-// Validate checks the field values on CommonListResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CommonListResp) Validate() error {
+var _FormRenderPreviewReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// Validate checks the field values on GetResFormSchemaReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResFormSchemaReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommonListResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommonListRespMultiError,
-// or nil if none found.
-func (m *CommonListResp) ValidateAll() error {
+// ValidateAll checks the field values on GetResFormSchemaReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResFormSchemaReqMultiError, or nil if none found.
+func (m *GetResFormSchemaReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommonListResp) validate(all bool) error {
+func (m *GetResFormSchemaReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	if !_GetResFormSchemaReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := GetResFormSchemaReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Message
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := GetResFormSchemaReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for RequestID
+	if utf8.RuneCountInString(m.GetKind()) > 128 {
+		err := GetResFormSchemaReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommonListRespValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommonListRespValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetNamespace()) > 64 {
+		err := GetResFormSchemaReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 64 runes",
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommonListRespValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetWebAnnotations()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommonListRespValidationError{
-					field:  "WebAnnotations",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommonListRespValidationError{
-					field:  "WebAnnotations",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _GetResFormSchemaReq_Action_InLookup[m.GetAction()]; !ok {
+		err := GetResFormSchemaReqValidationError{
+			field:  "Action",
+			reason: "value must be in list [ create update]",
 		}
-	} else if v, ok := interface{}(m.GetWebAnnotations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommonListRespValidationError{
-				field:  "WebAnnotations",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CommonListRespMultiError(errors)
+		return GetResFormSchemaReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommonListRespMultiError is an error wrapping multiple validation errors
-// returned by CommonListResp.ValidateAll() if the designated constraints
-// aren't met.
-type CommonListRespMultiError []error
+// GetResFormSchemaReqMultiError is an error wrapping multiple validation
+// errors returned by GetResFormSchemaReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetResFormSchemaReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommonListRespMultiError) Error() string {
+func (m GetResFormSchemaReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5790,11 +6975,11 @@ func (m CommonListRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommonListRespMultiError) AllErrors() []error { return m }
+func (m GetResFormSchemaReqMultiError) AllErrors() []error { return m }
 
-// CommonListRespValidationError is the validation error returned by
-// CommonListResp.Validate if the designated constraints aren't met.
-type CommonListRespValidationError struct {
+// GetResFormSchemaReqValidationError is the validation error returned by
+// GetResFormSchemaReq.Validate if the designated constraints aren't met.
+type GetResFormSchemaReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5802,22 +6987,24 @@ type CommonListRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommonListRespValidationError) Field() string { return e.field }
+func (e GetResFormSchemaReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommonListRespValidationError) Reason() string { return e.reason }
+func (e GetResFormSchemaReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommonListRespValidationError) Cause() error { return e.cause }
+func (e GetResFormSchemaReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommonListRespValidationError) Key() bool { return e.key }
+func (e GetResFormSchemaReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommonListRespValidationError) ErrorName() string { return "CommonListRespValidationError" }
+func (e GetResFormSchemaReqValidationError) ErrorName() string {
+	return "GetResFormSchemaReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommonListRespValidationError) Error() string {
+func (e GetResFormSchemaReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5829,14 +7016,14 @@ func (e CommonListRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommonListResp.%s: %s%s",
+		"invalid %sGetResFormSchemaReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommonListRespValidationError{}
+var _ error = GetResFormSchemaReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5844,32 +7031,40 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommonListRespValidationError{}
+} = GetResFormSchemaReqValidationError{}
 
-// Validate checks the field values on SubscribeReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SubscribeReq) Validate() error {
+var _GetResFormSchemaReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+var _GetResFormSchemaReq_Action_InLookup = map[string]struct{}{
+	"":       {},
+	"create": {},
+	"update": {},
+}
+
+// Validate checks the field values on GetFormSupportedApiVersionsReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFormSupportedApiVersionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubscribeReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SubscribeReqMultiError, or
-// nil if none found.
-func (m *SubscribeReq) ValidateAll() error {
+// ValidateAll checks the field values on GetFormSupportedApiVersionsReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetFormSupportedApiVersionsReqMultiError, or nil if none found.
+func (m *GetFormSupportedApiVersionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubscribeReq) validate(all bool) error {
+func (m *GetFormSupportedApiVersionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_SubscribeReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := SubscribeReqValidationError{
+	if !_GetFormSupportedApiVersionsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := GetFormSupportedApiVersionsReqValidationError{
 			field:  "ProjectID",
 			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
 		}
@@ -5880,7 +7075,7 @@ func (m *SubscribeReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := SubscribeReqValidationError{
+		err := GetFormSupportedApiVersionsReqValidationError{
 			field:  "ClusterID",
 			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
@@ -5890,19 +7085,8 @@ func (m *SubscribeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetResourceVersion()) > 32 {
-		err := SubscribeReqValidationError{
-			field:  "ResourceVersion",
-			reason: "value length must be at most 32 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if utf8.RuneCountInString(m.GetKind()) > 128 {
-		err := SubscribeReqValidationError{
+		err := GetFormSupportedApiVersionsReqValidationError{
 			field:  "Kind",
 			reason: "value length must be at most 128 runes",
 		}
@@ -5912,52 +7096,20 @@ func (m *SubscribeReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCRDName()) > 128 {
-		err := SubscribeReqValidationError{
-			field:  "CRDName",
-			reason: "value length must be at most 128 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetApiVersion()) > 128 {
-		err := SubscribeReqValidationError{
-			field:  "ApiVersion",
-			reason: "value length must be at most 128 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetNamespace()) > 64 {
-		err := SubscribeReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 64 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return SubscribeReqMultiError(errors)
+		return GetFormSupportedApiVersionsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubscribeReqMultiError is an error wrapping multiple validation errors
-// returned by SubscribeReq.ValidateAll() if the designated constraints aren't met.
-type SubscribeReqMultiError []error
+// GetFormSupportedApiVersionsReqMultiError is an error wrapping multiple
+// validation errors returned by GetFormSupportedApiVersionsReq.ValidateAll()
+// if the designated constraints aren't met.
+type GetFormSupportedApiVersionsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubscribeReqMultiError) Error() string {
+func (m GetFormSupportedApiVersionsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5966,11 +7118,12 @@ func (m SubscribeReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubscribeReqMultiError) AllErrors() []error { return m }
+func (m GetFormSupportedApiVersionsReqMultiError) AllErrors() []error { return m }
 
-// SubscribeReqValidationError is the validation error returned by
-// SubscribeReq.Validate if the designated constraints aren't met.
-type SubscribeReqValidationError struct {
+// GetFormSupportedApiVersionsReqValidationError is the validation error
+// returned by GetFormSupportedApiVersionsReq.Validate if the designated
+// constraints aren't met.
+type GetFormSupportedApiVersionsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5978,22 +7131,24 @@ type SubscribeReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubscribeReqValidationError) Field() string { return e.field }
+func (e GetFormSupportedApiVersionsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubscribeReqValidationError) Reason() string { return e.reason }
+func (e GetFormSupportedApiVersionsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubscribeReqValidationError) Cause() error { return e.cause }
+func (e GetFormSupportedApiVersionsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubscribeReqValidationError) Key() bool { return e.key }
+func (e GetFormSupportedApiVersionsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubscribeReqValidationError) ErrorName() string { return "SubscribeReqValidationError" }
+func (e GetFormSupportedApiVersionsReqValidationError) ErrorName() string {
+	return "GetFormSupportedApiVersionsReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SubscribeReqValidationError) Error() string {
+func (e GetFormSupportedApiVersionsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6005,14 +7160,14 @@ func (e SubscribeReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubscribeReq.%s: %s%s",
+		"invalid %sGetFormSupportedApiVersionsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubscribeReqValidationError{}
+var _ error = GetFormSupportedApiVersionsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6020,114 +7175,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubscribeReqValidationError{}
+} = GetFormSupportedApiVersionsReqValidationError{}
 
-var _SubscribeReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+var _GetFormSupportedApiVersionsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
 
-// Validate checks the field values on SubscribeResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SubscribeResp) Validate() error {
+// Validate checks the field values on GetResSelectItemsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResSelectItemsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubscribeResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SubscribeRespMultiError, or
-// nil if none found.
-func (m *SubscribeResp) ValidateAll() error {
+// ValidateAll checks the field values on GetResSelectItemsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResSelectItemsReqMultiError, or nil if none found.
+func (m *GetResSelectItemsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubscribeResp) validate(all bool) error {
+func (m *GetResSelectItemsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
-
-	// no validation rules for Message
-
-	// no validation rules for Kind
-
-	// no validation rules for Type
+	if !_GetResSelectItemsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
+		err := GetResSelectItemsReqValidationError{
+			field:  "ProjectID",
+			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Uid
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := GetResSelectItemsReqValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetManifest()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SubscribeRespValidationError{
-					field:  "Manifest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SubscribeRespValidationError{
-					field:  "Manifest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetKind()) > 128 {
+		err := GetResSelectItemsReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at most 128 runes",
 		}
-	} else if v, ok := interface{}(m.GetManifest()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SubscribeRespValidationError{
-				field:  "Manifest",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetManifestExt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SubscribeRespValidationError{
-					field:  "ManifestExt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SubscribeRespValidationError{
-					field:  "ManifestExt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 128 {
+		err := GetResSelectItemsReqValidationError{
+			field:  "Namespace",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetManifestExt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SubscribeRespValidationError{
-				field:  "ManifestExt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetScene()) > 32 {
+		err := GetResSelectItemsReqValidationError{
+			field:  "Scene",
+			reason: "value length must be at most 32 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SubscribeRespMultiError(errors)
+		return GetResSelectItemsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubscribeRespMultiError is an error wrapping multiple validation errors
-// returned by SubscribeResp.ValidateAll() if the designated constraints
-// aren't met.
-type SubscribeRespMultiError []error
+// GetResSelectItemsReqMultiError is an error wrapping multiple validation
+// errors returned by GetResSelectItemsReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetResSelectItemsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubscribeRespMultiError) Error() string {
+func (m GetResSelectItemsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6136,11 +7278,11 @@ func (m SubscribeRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubscribeRespMultiError) AllErrors() []error { return m }
+func (m GetResSelectItemsReqMultiError) AllErrors() []error { return m }
 
-// SubscribeRespValidationError is the validation error returned by
-// SubscribeResp.Validate if the designated constraints aren't met.
-type SubscribeRespValidationError struct {
+// GetResSelectItemsReqValidationError is the validation error returned by
+// GetResSelectItemsReq.Validate if the designated constraints aren't met.
+type GetResSelectItemsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6148,22 +7290,24 @@ type SubscribeRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubscribeRespValidationError) Field() string { return e.field }
+func (e GetResSelectItemsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubscribeRespValidationError) Reason() string { return e.reason }
+func (e GetResSelectItemsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubscribeRespValidationError) Cause() error { return e.cause }
+func (e GetResSelectItemsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubscribeRespValidationError) Key() bool { return e.key }
+func (e GetResSelectItemsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubscribeRespValidationError) ErrorName() string { return "SubscribeRespValidationError" }
+func (e GetResSelectItemsReqValidationError) ErrorName() string {
+	return "GetResSelectItemsReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SubscribeRespValidationError) Error() string {
+func (e GetResSelectItemsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6175,14 +7319,14 @@ func (e SubscribeRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubscribeResp.%s: %s%s",
+		"invalid %sGetResSelectItemsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubscribeRespValidationError{}
+var _ error = GetResSelectItemsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6190,45 +7334,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubscribeRespValidationError{}
+} = GetResSelectItemsReqValidationError{}
 
-// Validate checks the field values on InvalidateDiscoveryCacheReq with the
-// rules defined in the proto definition for this message. If any rules are
+var _GetResSelectItemsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// Validate checks the field values on ListViewConfigsReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InvalidateDiscoveryCacheReq) Validate() error {
+func (m *ListViewConfigsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InvalidateDiscoveryCacheReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListViewConfigsReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InvalidateDiscoveryCacheReqMultiError, or nil if none found.
-func (m *InvalidateDiscoveryCacheReq) ValidateAll() error {
+// ListViewConfigsReqMultiError, or nil if none found.
+func (m *ListViewConfigsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InvalidateDiscoveryCacheReq) validate(all bool) error {
+func (m *ListViewConfigsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_InvalidateDiscoveryCacheReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := InvalidateDiscoveryCacheReqValidationError{
-			field:  "ProjectID",
-			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := InvalidateDiscoveryCacheReqValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := ListViewConfigsReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6236,22 +7371,20 @@ func (m *InvalidateDiscoveryCacheReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for AuthToken
-
 	if len(errors) > 0 {
-		return InvalidateDiscoveryCacheReqMultiError(errors)
+		return ListViewConfigsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvalidateDiscoveryCacheReqMultiError is an error wrapping multiple
-// validation errors returned by InvalidateDiscoveryCacheReq.ValidateAll() if
-// the designated constraints aren't met.
-type InvalidateDiscoveryCacheReqMultiError []error
+// ListViewConfigsReqMultiError is an error wrapping multiple validation errors
+// returned by ListViewConfigsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListViewConfigsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvalidateDiscoveryCacheReqMultiError) Error() string {
+func (m ListViewConfigsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6260,12 +7393,11 @@ func (m InvalidateDiscoveryCacheReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvalidateDiscoveryCacheReqMultiError) AllErrors() []error { return m }
+func (m ListViewConfigsReqMultiError) AllErrors() []error { return m }
 
-// InvalidateDiscoveryCacheReqValidationError is the validation error returned
-// by InvalidateDiscoveryCacheReq.Validate if the designated constraints
-// aren't met.
-type InvalidateDiscoveryCacheReqValidationError struct {
+// ListViewConfigsReqValidationError is the validation error returned by
+// ListViewConfigsReq.Validate if the designated constraints aren't met.
+type ListViewConfigsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6273,24 +7405,24 @@ type InvalidateDiscoveryCacheReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e InvalidateDiscoveryCacheReqValidationError) Field() string { return e.field }
+func (e ListViewConfigsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvalidateDiscoveryCacheReqValidationError) Reason() string { return e.reason }
+func (e ListViewConfigsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvalidateDiscoveryCacheReqValidationError) Cause() error { return e.cause }
+func (e ListViewConfigsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvalidateDiscoveryCacheReqValidationError) Key() bool { return e.key }
+func (e ListViewConfigsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvalidateDiscoveryCacheReqValidationError) ErrorName() string {
-	return "InvalidateDiscoveryCacheReqValidationError"
+func (e ListViewConfigsReqValidationError) ErrorName() string {
+	return "ListViewConfigsReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InvalidateDiscoveryCacheReqValidationError) Error() string {
+func (e ListViewConfigsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6302,14 +7434,14 @@ func (e InvalidateDiscoveryCacheReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvalidateDiscoveryCacheReq.%s: %s%s",
+		"invalid %sListViewConfigsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvalidateDiscoveryCacheReqValidationError{}
+var _ error = ListViewConfigsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6317,58 +7449,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvalidateDiscoveryCacheReqValidationError{}
-
-var _InvalidateDiscoveryCacheReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = ListViewConfigsReqValidationError{}
 
-// Validate checks the field values on FormRenderPreviewReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FormRenderPreviewReq) Validate() error {
+// Validate checks the field values on GetViewConfigReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetViewConfigReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FormRenderPreviewReq with the rules
+// ValidateAll checks the field values on GetViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FormRenderPreviewReqMultiError, or nil if none found.
-func (m *FormRenderPreviewReq) ValidateAll() error {
+// GetViewConfigReqMultiError, or nil if none found.
+func (m *GetViewConfigReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FormRenderPreviewReq) validate(all bool) error {
+func (m *GetViewConfigReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_FormRenderPreviewReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := FormRenderPreviewReqValidationError{
-			field:  "ProjectID",
-			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := GetViewConfigReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-	}
 
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := FormRenderPreviewReqValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetKind()) > 128 {
-		err := FormRenderPreviewReqValidationError{
-			field:  "Kind",
-			reason: "value length must be at most 128 runes",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := GetViewConfigReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6376,49 +7496,20 @@ func (m *FormRenderPreviewReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetFormData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FormRenderPreviewReqValidationError{
-					field:  "FormData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, FormRenderPreviewReqValidationError{
-					field:  "FormData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFormData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return FormRenderPreviewReqValidationError{
-				field:  "FormData",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return FormRenderPreviewReqMultiError(errors)
+		return GetViewConfigReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// FormRenderPreviewReqMultiError is an error wrapping multiple validation
-// errors returned by FormRenderPreviewReq.ValidateAll() if the designated
-// constraints aren't met.
-type FormRenderPreviewReqMultiError []error
+// GetViewConfigReqMultiError is an error wrapping multiple validation errors
+// returned by GetViewConfigReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetViewConfigReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FormRenderPreviewReqMultiError) Error() string {
+func (m GetViewConfigReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6427,11 +7518,11 @@ func (m FormRenderPreviewReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FormRenderPreviewReqMultiError) AllErrors() []error { return m }
+func (m GetViewConfigReqMultiError) AllErrors() []error { return m }
 
-// FormRenderPreviewReqValidationError is the validation error returned by
-// FormRenderPreviewReq.Validate if the designated constraints aren't met.
-type FormRenderPreviewReqValidationError struct {
+// GetViewConfigReqValidationError is the validation error returned by
+// GetViewConfigReq.Validate if the designated constraints aren't met.
+type GetViewConfigReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6439,24 +7530,22 @@ type FormRenderPreviewReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e FormRenderPreviewReqValidationError) Field() string { return e.field }
+func (e GetViewConfigReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FormRenderPreviewReqValidationError) Reason() string { return e.reason }
+func (e GetViewConfigReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FormRenderPreviewReqValidationError) Cause() error { return e.cause }
+func (e GetViewConfigReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FormRenderPreviewReqValidationError) Key() bool { return e.key }
+func (e GetViewConfigReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FormRenderPreviewReqValidationError) ErrorName() string {
-	return "FormRenderPreviewReqValidationError"
-}
+func (e GetViewConfigReqValidationError) ErrorName() string { return "GetViewConfigReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FormRenderPreviewReqValidationError) Error() string {
+func (e GetViewConfigReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6468,14 +7557,14 @@ func (e FormRenderPreviewReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFormRenderPreviewReq.%s: %s%s",
+		"invalid %sGetViewConfigReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FormRenderPreviewReqValidationError{}
+var _ error = GetViewConfigReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6483,101 +7572,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FormRenderPreviewReqValidationError{}
-
-var _FormRenderPreviewReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = GetViewConfigReqValidationError{}
 
-// Validate checks the field values on GetResFormSchemaReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResFormSchemaReq) Validate() error {
+// Validate checks the field values on ViewFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ViewFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResFormSchemaReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResFormSchemaReqMultiError, or nil if none found.
-func (m *GetResFormSchemaReq) ValidateAll() error {
+// ValidateAll checks the field values on ViewFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewFilterMultiError, or
+// nil if none found.
+func (m *ViewFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResFormSchemaReq) validate(all bool) error {
+func (m *ViewFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetResFormSchemaReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := GetResFormSchemaReqValidationError{
-			field:  "ProjectID",
-			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := GetResFormSchemaReqValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
-	if utf8.RuneCountInString(m.GetKind()) > 128 {
-		err := GetResFormSchemaReqValidationError{
-			field:  "Kind",
-			reason: "value length must be at most 128 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetLabelSelector() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetNamespace()) > 64 {
-		err := GetResFormSchemaReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be at most 64 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ViewFilterValidationError{
+						field:  fmt.Sprintf("LabelSelector[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ViewFilterValidationError{
+						field:  fmt.Sprintf("LabelSelector[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ViewFilterValidationError{
+					field:  fmt.Sprintf("LabelSelector[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if _, ok := _GetResFormSchemaReq_Action_InLookup[m.GetAction()]; !ok {
-		err := GetResFormSchemaReqValidationError{
-			field:  "Action",
-			reason: "value must be in list [ create update]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetResFormSchemaReqMultiError(errors)
+		return ViewFilterMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResFormSchemaReqMultiError is an error wrapping multiple validation
-// errors returned by GetResFormSchemaReq.ValidateAll() if the designated
-// constraints aren't met.
-type GetResFormSchemaReqMultiError []error
+// ViewFilterMultiError is an error wrapping multiple validation errors
+// returned by ViewFilter.ValidateAll() if the designated constraints aren't met.
+type ViewFilterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResFormSchemaReqMultiError) Error() string {
+func (m ViewFilterMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6586,11 +7653,11 @@ func (m GetResFormSchemaReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResFormSchemaReqMultiError) AllErrors() []error { return m }
+func (m ViewFilterMultiError) AllErrors() []error { return m }
 
-// GetResFormSchemaReqValidationError is the validation error returned by
-// GetResFormSchemaReq.Validate if the designated constraints aren't met.
-type GetResFormSchemaReqValidationError struct {
+// ViewFilterValidationError is the validation error returned by
+// ViewFilter.Validate if the designated constraints aren't met.
+type ViewFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6598,24 +7665,22 @@ type GetResFormSchemaReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResFormSchemaReqValidationError) Field() string { return e.field }
+func (e ViewFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResFormSchemaReqValidationError) Reason() string { return e.reason }
+func (e ViewFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResFormSchemaReqValidationError) Cause() error { return e.cause }
+func (e ViewFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResFormSchemaReqValidationError) Key() bool { return e.key }
+func (e ViewFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResFormSchemaReqValidationError) ErrorName() string {
-	return "GetResFormSchemaReqValidationError"
-}
+func (e ViewFilterValidationError) ErrorName() string { return "ViewFilterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetResFormSchemaReqValidationError) Error() string {
+func (e ViewFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6627,14 +7692,14 @@ func (e GetResFormSchemaReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResFormSchemaReq.%s: %s%s",
+		"invalid %sViewFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResFormSchemaReqValidationError{}
+var _ error = ViewFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -6642,42 +7707,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResFormSchemaReqValidationError{}
-
-var _GetResFormSchemaReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
-
-var _GetResFormSchemaReq_Action_InLookup = map[string]struct{}{
-	"":       {},
-	"create": {},
-	"update": {},
-}
+} = ViewFilterValidationError{}
 
-// Validate checks the field values on GetFormSupportedApiVersionsReq with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateViewConfigReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFormSupportedApiVersionsReq) Validate() error {
+func (m *CreateViewConfigReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFormSupportedApiVersionsReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetFormSupportedApiVersionsReqMultiError, or nil if none found.
-func (m *GetFormSupportedApiVersionsReq) ValidateAll() error {
+// ValidateAll checks the field values on CreateViewConfigReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateViewConfigReqMultiError, or nil if none found.
+func (m *CreateViewConfigReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFormSupportedApiVersionsReq) validate(all bool) error {
+func (m *CreateViewConfigReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetFormSupportedApiVersionsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := GetFormSupportedApiVersionsReqValidationError{
-			field:  "ProjectID",
-			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := CreateViewConfigReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6685,10 +7742,10 @@ func (m *GetFormSupportedApiVersionsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := GetFormSupportedApiVersionsReqValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
+	if len(m.GetClusterNamespaces()) < 1 {
+		err := CreateViewConfigReqValidationError{
+			field:  "ClusterNamespaces",
+			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
 			return err
@@ -6696,10 +7753,44 @@ func (m *GetFormSupportedApiVersionsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetKind()) > 128 {
-		err := GetFormSupportedApiVersionsReqValidationError{
-			field:  "Kind",
-			reason: "value length must be at most 128 runes",
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateViewConfigReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateViewConfigReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateViewConfigReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateViewConfigReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6707,20 +7798,51 @@ func (m *GetFormSupportedApiVersionsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateViewConfigReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateViewConfigReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateViewConfigReqValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SaveAs
+
 	if len(errors) > 0 {
-		return GetFormSupportedApiVersionsReqMultiError(errors)
+		return CreateViewConfigReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFormSupportedApiVersionsReqMultiError is an error wrapping multiple
-// validation errors returned by GetFormSupportedApiVersionsReq.ValidateAll()
-// if the designated constraints aren't met.
-type GetFormSupportedApiVersionsReqMultiError []error
+// CreateViewConfigReqMultiError is an error wrapping multiple validation
+// errors returned by CreateViewConfigReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateViewConfigReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFormSupportedApiVersionsReqMultiError) Error() string {
+func (m CreateViewConfigReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6729,12 +7851,11 @@ func (m GetFormSupportedApiVersionsReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFormSupportedApiVersionsReqMultiError) AllErrors() []error { return m }
+func (m CreateViewConfigReqMultiError) AllErrors() []error { return m }
 
-// GetFormSupportedApiVersionsReqValidationError is the validation error
-// returned by GetFormSupportedApiVersionsReq.Validate if the designated
-// constraints aren't met.
-type GetFormSupportedApiVersionsReqValidationError struct {
+// CreateViewConfigReqValidationError is the validation error returned by
+// CreateViewConfigReq.Validate if the designated constraints aren't met.
+type CreateViewConfigReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6742,24 +7863,24 @@ type GetFormSupportedApiVersionsReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFormSupportedApiVersionsReqValidationError) Field() string { return e.field }
+func (e CreateViewConfigReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFormSupportedApiVersionsReqValidationError) Reason() string { return e.reason }
+func (e CreateViewConfigReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFormSupportedApiVersionsReqValidationError) Cause() error { return e.cause }
+func (e CreateViewConfigReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFormSupportedApiVersionsReqValidationError) Key() bool { return e.key }
+func (e CreateViewConfigReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFormSupportedApiVersionsReqValidationError) ErrorName() string {
-	return "GetFormSupportedApiVersionsReqValidationError"
+func (e CreateViewConfigReqValidationError) ErrorName() string {
+	return "CreateViewConfigReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetFormSupportedApiVersionsReqValidationError) Error() string {
+func (e CreateViewConfigReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6771,14 +7892,14 @@ func (e GetFormSupportedApiVersionsReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFormSupportedApiVersionsReq.%s: %s%s",
+		"invalid %sCreateViewConfigReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFormSupportedApiVersionsReqValidationError{}
+var _ error = CreateViewConfigReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6786,47 +7907,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFormSupportedApiVersionsReqValidationError{}
-
-var _GetFormSupportedApiVersionsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = CreateViewConfigReqValidationError{}
 
-// Validate checks the field values on GetResSelectItemsReq with the rules
+// Validate checks the field values on UpdateViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResSelectItemsReq) Validate() error {
+func (m *UpdateViewConfigReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResSelectItemsReq with the rules
+// ValidateAll checks the field values on UpdateViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResSelectItemsReqMultiError, or nil if none found.
-func (m *GetResSelectItemsReq) ValidateAll() error {
+// UpdateViewConfigReqMultiError, or nil if none found.
+func (m *UpdateViewConfigReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResSelectItemsReq) validate(all bool) error {
+func (m *UpdateViewConfigReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetResSelectItemsReq_ProjectID_Pattern.MatchString(m.GetProjectID()) {
-		err := GetResSelectItemsReqValidationError{
-			field:  "ProjectID",
-			reason: "value does not match regex pattern \"^[0-9a-f]{32}$\"",
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := UpdateViewConfigReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := GetResSelectItemsReqValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := UpdateViewConfigReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6834,10 +7954,10 @@ func (m *GetResSelectItemsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetKind()) > 128 {
-		err := GetResSelectItemsReqValidationError{
-			field:  "Kind",
-			reason: "value length must be at most 128 runes",
+	if len(m.GetClusterNamespaces()) < 1 {
+		err := UpdateViewConfigReqValidationError{
+			field:  "ClusterNamespaces",
+			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
 			return err
@@ -6845,10 +7965,44 @@ func (m *GetResSelectItemsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetNamespace()); l < 1 || l > 128 {
-		err := GetResSelectItemsReqValidationError{
-			field:  "Namespace",
-			reason: "value length must be between 1 and 128 runes, inclusive",
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateViewConfigReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateViewConfigReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateViewConfigReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := UpdateViewConfigReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -6856,31 +8010,49 @@ func (m *GetResSelectItemsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetScene()) > 32 {
-		err := GetResSelectItemsReqValidationError{
-			field:  "Scene",
-			reason: "value length must be at most 32 runes",
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateViewConfigReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateViewConfigReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateViewConfigReqValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetResSelectItemsReqMultiError(errors)
+		return UpdateViewConfigReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResSelectItemsReqMultiError is an error wrapping multiple validation
-// errors returned by GetResSelectItemsReq.ValidateAll() if the designated
+// UpdateViewConfigReqMultiError is an error wrapping multiple validation
+// errors returned by UpdateViewConfigReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetResSelectItemsReqMultiError []error
+type UpdateViewConfigReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResSelectItemsReqMultiError) Error() string {
+func (m UpdateViewConfigReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6889,11 +8061,11 @@ func (m GetResSelectItemsReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResSelectItemsReqMultiError) AllErrors() []error { return m }
+func (m UpdateViewConfigReqMultiError) AllErrors() []error { return m }
 
-// GetResSelectItemsReqValidationError is the validation error returned by
-// GetResSelectItemsReq.Validate if the designated constraints aren't met.
-type GetResSelectItemsReqValidationError struct {
+// UpdateViewConfigReqValidationError is the validation error returned by
+// UpdateViewConfigReq.Validate if the designated constraints aren't met.
+type UpdateViewConfigReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6901,24 +8073,24 @@ type GetResSelectItemsReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResSelectItemsReqValidationError) Field() string { return e.field }
+func (e UpdateViewConfigReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResSelectItemsReqValidationError) Reason() string { return e.reason }
+func (e UpdateViewConfigReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResSelectItemsReqValidationError) Cause() error { return e.cause }
+func (e UpdateViewConfigReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResSelectItemsReqValidationError) Key() bool { return e.key }
+func (e UpdateViewConfigReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResSelectItemsReqValidationError) ErrorName() string {
-	return "GetResSelectItemsReqValidationError"
+func (e UpdateViewConfigReqValidationError) ErrorName() string {
+	return "UpdateViewConfigReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResSelectItemsReqValidationError) Error() string {
+func (e UpdateViewConfigReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6930,14 +8102,14 @@ func (e GetResSelectItemsReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResSelectItemsReq.%s: %s%s",
+		"invalid %sUpdateViewConfigReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResSelectItemsReqValidationError{}
+var _ error = UpdateViewConfigReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6945,34 +8117,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResSelectItemsReqValidationError{}
-
-var _GetResSelectItemsReq_ProjectID_Pattern = regexp.MustCompile("^[0-9a-f]{32}$")
+} = UpdateViewConfigReqValidationError{}
 
-// Validate checks the field values on ListViewConfigsReq with the rules
+// Validate checks the field values on RenameViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListViewConfigsReq) Validate() error {
+func (m *RenameViewConfigReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListViewConfigsReq with the rules
+// ValidateAll checks the field values on RenameViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListViewConfigsReqMultiError, or nil if none found.
-func (m *ListViewConfigsReq) ValidateAll() error {
+// RenameViewConfigReqMultiError, or nil if none found.
+func (m *RenameViewConfigReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListViewConfigsReq) validate(all bool) error {
+func (m *RenameViewConfigReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := RenameViewConfigReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := ListViewConfigsReqValidationError{
+		err := RenameViewConfigReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
@@ -6982,20 +8164,31 @@ func (m *ListViewConfigsReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := RenameViewConfigReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListViewConfigsReqMultiError(errors)
+		return RenameViewConfigReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListViewConfigsReqMultiError is an error wrapping multiple validation errors
-// returned by ListViewConfigsReq.ValidateAll() if the designated constraints
-// aren't met.
-type ListViewConfigsReqMultiError []error
+// RenameViewConfigReqMultiError is an error wrapping multiple validation
+// errors returned by RenameViewConfigReq.ValidateAll() if the designated
+// constraints aren't met.
+type RenameViewConfigReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListViewConfigsReqMultiError) Error() string {
+func (m RenameViewConfigReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7004,11 +8197,11 @@ func (m ListViewConfigsReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListViewConfigsReqMultiError) AllErrors() []error { return m }
+func (m RenameViewConfigReqMultiError) AllErrors() []error { return m }
 
-// ListViewConfigsReqValidationError is the validation error returned by
-// ListViewConfigsReq.Validate if the designated constraints aren't met.
-type ListViewConfigsReqValidationError struct {
+// RenameViewConfigReqValidationError is the validation error returned by
+// RenameViewConfigReq.Validate if the designated constraints aren't met.
+type RenameViewConfigReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7016,24 +8209,24 @@ type ListViewConfigsReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListViewConfigsReqValidationError) Field() string { return e.field }
+func (e RenameViewConfigReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListViewConfigsReqValidationError) Reason() string { return e.reason }
+func (e RenameViewConfigReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListViewConfigsReqValidationError) Cause() error { return e.cause }
+func (e RenameViewConfigReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListViewConfigsReqValidationError) Key() bool { return e.key }
+func (e RenameViewConfigReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListViewConfigsReqValidationError) ErrorName() string {
-	return "ListViewConfigsReqValidationError"
+func (e RenameViewConfigReqValidationError) ErrorName() string {
+	return "RenameViewConfigReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListViewConfigsReqValidationError) Error() string {
+func (e RenameViewConfigReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7045,14 +8238,14 @@ func (e ListViewConfigsReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListViewConfigsReq.%s: %s%s",
+		"invalid %sRenameViewConfigReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListViewConfigsReqValidationError{}
+var _ error = RenameViewConfigReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7060,24 +8253,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListViewConfigsReqValidationError{}
-
-// Validate checks the field values on GetViewConfigReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetViewConfigReq) Validate() error {
+} = RenameViewConfigReqValidationError{}
+
+// Validate checks the field values on DeleteViewConfigReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteViewConfigReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetViewConfigReq with the rules
+// ValidateAll checks the field values on DeleteViewConfigReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetViewConfigReqMultiError, or nil if none found.
-func (m *GetViewConfigReq) ValidateAll() error {
+// DeleteViewConfigReqMultiError, or nil if none found.
+func (m *DeleteViewConfigReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetViewConfigReq) validate(all bool) error {
+func (m *DeleteViewConfigReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7085,7 +8278,7 @@ func (m *GetViewConfigReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := GetViewConfigReqValidationError{
+		err := DeleteViewConfigReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -7097,7 +8290,7 @@ func (m *GetViewConfigReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := GetViewConfigReqValidationError{
+		err := DeleteViewConfigReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
@@ -7108,19 +8301,19 @@ func (m *GetViewConfigReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetViewConfigReqMultiError(errors)
+		return DeleteViewConfigReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetViewConfigReqMultiError is an error wrapping multiple validation errors
-// returned by GetViewConfigReq.ValidateAll() if the designated constraints
-// aren't met.
-type GetViewConfigReqMultiError []error
+// DeleteViewConfigReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteViewConfigReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteViewConfigReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetViewConfigReqMultiError) Error() string {
+func (m DeleteViewConfigReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7129,11 +8322,11 @@ func (m GetViewConfigReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetViewConfigReqMultiError) AllErrors() []error { return m }
+func (m DeleteViewConfigReqMultiError) AllErrors() []error { return m }
 
-// GetViewConfigReqValidationError is the validation error returned by
-// GetViewConfigReq.Validate if the designated constraints aren't met.
-type GetViewConfigReqValidationError struct {
+// DeleteViewConfigReqValidationError is the validation error returned by
+// DeleteViewConfigReq.Validate if the designated constraints aren't met.
+type DeleteViewConfigReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7141,22 +8334,24 @@ type GetViewConfigReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetViewConfigReqValidationError) Field() string { return e.field }
+func (e DeleteViewConfigReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetViewConfigReqValidationError) Reason() string { return e.reason }
+func (e DeleteViewConfigReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetViewConfigReqValidationError) Cause() error { return e.cause }
+func (e DeleteViewConfigReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetViewConfigReqValidationError) Key() bool { return e.key }
+func (e DeleteViewConfigReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetViewConfigReqValidationError) ErrorName() string { return "GetViewConfigReqValidationError" }
+func (e DeleteViewConfigReqValidationError) ErrorName() string {
+	return "DeleteViewConfigReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetViewConfigReqValidationError) Error() string {
+func (e DeleteViewConfigReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7168,14 +8363,14 @@ func (e GetViewConfigReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetViewConfigReq.%s: %s%s",
+		"invalid %sDeleteViewConfigReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetViewConfigReqValidationError{}
+var _ error = DeleteViewConfigReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7183,49 +8378,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetViewConfigReqValidationError{}
+} = DeleteViewConfigReqValidationError{}
 
-// Validate checks the field values on ViewFilter with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ViewSuggestReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ViewFilter) Validate() error {
+func (m *ViewSuggestReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ViewFilter with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ViewFilterMultiError, or
-// nil if none found.
-func (m *ViewFilter) ValidateAll() error {
+// ValidateAll checks the field values on ViewSuggestReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewSuggestReqMultiError,
+// or nil if none found.
+func (m *ViewSuggestReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ViewFilter) validate(all bool) error {
+func (m *ViewSuggestReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		err := ViewSuggestReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	for idx, item := range m.GetLabelSelector() {
+	if len(m.GetClusterNamespaces()) < 1 {
+		err := ViewSuggestReqValidationError{
+			field:  "ClusterNamespaces",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetClusterNamespaces() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ViewFilterValidationError{
-						field:  fmt.Sprintf("LabelSelector[%v]", idx),
+					errors = append(errors, ViewSuggestReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ViewFilterValidationError{
-						field:  fmt.Sprintf("LabelSelector[%v]", idx),
+					errors = append(errors, ViewSuggestReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7233,8 +8448,8 @@ func (m *ViewFilter) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ViewFilterValidationError{
-					field:  fmt.Sprintf("LabelSelector[%v]", idx),
+				return ViewSuggestReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7243,19 +8458,22 @@ func (m *ViewFilter) validate(all bool) error {
 
 	}
 
+	// no validation rules for Label
+
 	if len(errors) > 0 {
-		return ViewFilterMultiError(errors)
+		return ViewSuggestReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ViewFilterMultiError is an error wrapping multiple validation errors
-// returned by ViewFilter.ValidateAll() if the designated constraints aren't met.
-type ViewFilterMultiError []error
+// ViewSuggestReqMultiError is an error wrapping multiple validation errors
+// returned by ViewSuggestReq.ValidateAll() if the designated constraints
+// aren't met.
+type ViewSuggestReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ViewFilterMultiError) Error() string {
+func (m ViewSuggestReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7264,11 +8482,11 @@ func (m ViewFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ViewFilterMultiError) AllErrors() []error { return m }
+func (m ViewSuggestReqMultiError) AllErrors() []error { return m }
 
-// ViewFilterValidationError is the validation error returned by
-// ViewFilter.Validate if the designated constraints aren't met.
-type ViewFilterValidationError struct {
+// ViewSuggestReqValidationError is the validation error returned by
+// ViewSuggestReq.Validate if the designated constraints aren't met.
+type ViewSuggestReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7276,22 +8494,22 @@ type ViewFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e ViewFilterValidationError) Field() string { return e.field }
+func (e ViewSuggestReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ViewFilterValidationError) Reason() string { return e.reason }
+func (e ViewSuggestReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ViewFilterValidationError) Cause() error { return e.cause }
+func (e ViewSuggestReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ViewFilterValidationError) Key() bool { return e.key }
+func (e ViewSuggestReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ViewFilterValidationError) ErrorName() string { return "ViewFilterValidationError" }
+func (e ViewSuggestReqValidationError) ErrorName() string { return "ViewSuggestReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ViewFilterValidationError) Error() string {
+func (e ViewSuggestReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7303,14 +8521,14 @@ func (e ViewFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sViewFilter.%s: %s%s",
+		"invalid %sViewSuggestReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ViewFilterValidationError{}
+var _ error = ViewSuggestReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7318,142 +8536,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ViewFilterValidationError{}
+} = ViewSuggestReqValidationError{}
 
-// Validate checks the field values on CreateViewConfigReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateViewConfigReq) Validate() error {
+// Validate checks the field values on ClusterNamespaces with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClusterNamespaces) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateViewConfigReq with the rules
+// ValidateAll checks the field values on ClusterNamespaces with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateViewConfigReqMultiError, or nil if none found.
-func (m *CreateViewConfigReq) ValidateAll() error {
+// ClusterNamespacesMultiError, or nil if none found.
+func (m *ClusterNamespaces) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateViewConfigReq) validate(all bool) error {
+func (m *ClusterNamespaces) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := CreateViewConfigReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetClusterNamespaces()) < 1 {
-		err := CreateViewConfigReqValidationError{
-			field:  "ClusterNamespaces",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetClusterNamespaces() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateViewConfigReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateViewConfigReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateViewConfigReqValidationError{
-					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := CreateViewConfigReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateViewConfigReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateViewConfigReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
+		err := ClusterNamespacesValidationError{
+			field:  "ClusterID",
+			reason: "value length must be between 13 and 14 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateViewConfigReqValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for SaveAs
-
 	if len(errors) > 0 {
-		return CreateViewConfigReqMultiError(errors)
+		return ClusterNamespacesMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateViewConfigReqMultiError is an error wrapping multiple validation
-// errors returned by CreateViewConfigReq.ValidateAll() if the designated
-// constraints aren't met.
-type CreateViewConfigReqMultiError []error
+// ClusterNamespacesMultiError is an error wrapping multiple validation errors
+// returned by ClusterNamespaces.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterNamespacesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateViewConfigReqMultiError) Error() string {
+func (m ClusterNamespacesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7462,11 +8593,11 @@ func (m CreateViewConfigReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateViewConfigReqMultiError) AllErrors() []error { return m }
+func (m ClusterNamespacesMultiError) AllErrors() []error { return m }
 
-// CreateViewConfigReqValidationError is the validation error returned by
-// CreateViewConfigReq.Validate if the designated constraints aren't met.
-type CreateViewConfigReqValidationError struct {
+// ClusterNamespacesValidationError is the validation error returned by
+// ClusterNamespaces.Validate if the designated constraints aren't met.
+type ClusterNamespacesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7474,24 +8605,24 @@ type CreateViewConfigReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateViewConfigReqValidationError) Field() string { return e.field }
+func (e ClusterNamespacesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateViewConfigReqValidationError) Reason() string { return e.reason }
+func (e ClusterNamespacesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateViewConfigReqValidationError) Cause() error { return e.cause }
+func (e ClusterNamespacesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateViewConfigReqValidationError) Key() bool { return e.key }
+func (e ClusterNamespacesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateViewConfigReqValidationError) ErrorName() string {
-	return "CreateViewConfigReqValidationError"
+func (e ClusterNamespacesValidationError) ErrorName() string {
+	return "ClusterNamespacesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateViewConfigReqValidationError) Error() string {
+func (e ClusterNamespacesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7503,14 +8634,14 @@ func (e CreateViewConfigReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateViewConfigReq.%s: %s%s",
+		"invalid %sClusterNamespaces.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateViewConfigReqValidationError{}
+var _ error = ClusterNamespacesValidationError{}
 
 var _ interface {
 	Field() string
@@ -7518,45 +8649,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateViewConfigReqValidationError{}
+} = ClusterNamespacesValidationError{}
 
-// Validate checks the field values on UpdateViewConfigReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateViewConfigReq) Validate() error {
+// Validate checks the field values on LabelSelector with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LabelSelector) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateViewConfigReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateViewConfigReqMultiError, or nil if none found.
-func (m *UpdateViewConfigReq) ValidateAll() error {
+// ValidateAll checks the field values on LabelSelector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LabelSelectorMultiError, or
+// nil if none found.
+func (m *LabelSelector) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateViewConfigReq) validate(all bool) error {
+func (m *LabelSelector) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := UpdateViewConfigReqValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := UpdateViewConfigReqValidationError{
-			field:  "ProjectCode",
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 64 {
+		err := LabelSelectorValidationError{
+			field:  "Key",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
@@ -7565,55 +8684,10 @@ func (m *UpdateViewConfigReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetClusterNamespaces()) < 1 {
-		err := UpdateViewConfigReqValidationError{
-			field:  "ClusterNamespaces",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetClusterNamespaces() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateViewConfigReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateViewConfigReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateViewConfigReqValidationError{
-					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := UpdateViewConfigReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if _, ok := _LabelSelector_Op_InLookup[m.GetOp()]; !ok {
+		err := LabelSelectorValidationError{
+			field:  "Op",
+			reason: "value must be in list [= != In NotIn Exists DoesNotExist]",
 		}
 		if !all {
 			return err
@@ -7621,49 +8695,20 @@ func (m *UpdateViewConfigReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateViewConfigReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateViewConfigReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateViewConfigReqValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UpdateViewConfigReqMultiError(errors)
+		return LabelSelectorMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateViewConfigReqMultiError is an error wrapping multiple validation
-// errors returned by UpdateViewConfigReq.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateViewConfigReqMultiError []error
+// LabelSelectorMultiError is an error wrapping multiple validation errors
+// returned by LabelSelector.ValidateAll() if the designated constraints
+// aren't met.
+type LabelSelectorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateViewConfigReqMultiError) Error() string {
+func (m LabelSelectorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7672,11 +8717,11 @@ func (m UpdateViewConfigReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateViewConfigReqMultiError) AllErrors() []error { return m }
+func (m LabelSelectorMultiError) AllErrors() []error { return m }
 
-// UpdateViewConfigReqValidationError is the validation error returned by
-// UpdateViewConfigReq.Validate if the designated constraints aren't met.
-type UpdateViewConfigReqValidationError struct {
+// LabelSelectorValidationError is the validation error returned by
+// LabelSelector.Validate if the designated constraints aren't met.
+type LabelSelectorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7684,24 +8729,22 @@ type UpdateViewConfigReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateViewConfigReqValidationError) Field() string { return e.field }
+func (e LabelSelectorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateViewConfigReqValidationError) Reason() string { return e.reason }
+func (e LabelSelectorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateViewConfigReqValidationError) Cause() error { return e.cause }
+func (e LabelSelectorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateViewConfigReqValidationError) Key() bool { return e.key }
+func (e LabelSelectorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateViewConfigReqValidationError) ErrorName() string {
-	return "UpdateViewConfigReqValidationError"
-}
+func (e LabelSelectorValidationError) ErrorName() string { return "LabelSelectorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateViewConfigReqValidationError) Error() string {
+func (e LabelSelectorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7713,14 +8756,14 @@ func (e UpdateViewConfigReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateViewConfigReq.%s: %s%s",
+		"invalid %sLabelSelector.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateViewConfigReqValidationError{}
+var _ error = LabelSelectorValidationError{}
 
 var _ interface {
 	Field() string
@@ -7728,24 +8771,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateViewConfigReqValidationError{}
+} = LabelSelectorValidationError{}
 
-// Validate checks the field values on RenameViewConfigReq with the rules
+var _LabelSelector_Op_InLookup = map[string]struct{}{
+	"=":            {},
+	"!=":           {},
+	"In":           {},
+	"NotIn":        {},
+	"Exists":       {},
+	"DoesNotExist": {},
+}
+
+// Validate checks the field values on GetTemplateSpaceReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameViewConfigReq) Validate() error {
+func (m *GetTemplateSpaceReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameViewConfigReq with the rules
+// ValidateAll checks the field values on GetTemplateSpaceReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RenameViewConfigReqMultiError, or nil if none found.
-func (m *RenameViewConfigReq) ValidateAll() error {
+// GetTemplateSpaceReqMultiError, or nil if none found.
+func (m *GetTemplateSpaceReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameViewConfigReq) validate(all bool) error {
+func (m *GetTemplateSpaceReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7753,7 +8805,7 @@ func (m *RenameViewConfigReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := RenameViewConfigReqValidationError{
+		err := GetTemplateSpaceReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -7761,24 +8813,13 @@ func (m *RenameViewConfigReq) validate(all bool) error {
 			return err
 		}
 		errors = append(errors, err)
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := RenameViewConfigReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := RenameViewConfigReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := GetTemplateSpaceReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -7787,19 +8828,19 @@ func (m *RenameViewConfigReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RenameViewConfigReqMultiError(errors)
+		return GetTemplateSpaceReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameViewConfigReqMultiError is an error wrapping multiple validation
-// errors returned by RenameViewConfigReq.ValidateAll() if the designated
+// GetTemplateSpaceReqMultiError is an error wrapping multiple validation
+// errors returned by GetTemplateSpaceReq.ValidateAll() if the designated
 // constraints aren't met.
-type RenameViewConfigReqMultiError []error
+type GetTemplateSpaceReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameViewConfigReqMultiError) Error() string {
+func (m GetTemplateSpaceReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7808,11 +8849,11 @@ func (m RenameViewConfigReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameViewConfigReqMultiError) AllErrors() []error { return m }
+func (m GetTemplateSpaceReqMultiError) AllErrors() []error { return m }
 
-// RenameViewConfigReqValidationError is the validation error returned by
-// RenameViewConfigReq.Validate if the designated constraints aren't met.
-type RenameViewConfigReqValidationError struct {
+// GetTemplateSpaceReqValidationError is the validation error returned by
+// GetTemplateSpaceReq.Validate if the designated constraints aren't met.
+type GetTemplateSpaceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7820,24 +8861,24 @@ type RenameViewConfigReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameViewConfigReqValidationError) Field() string { return e.field }
+func (e GetTemplateSpaceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameViewConfigReqValidationError) Reason() string { return e.reason }
+func (e GetTemplateSpaceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameViewConfigReqValidationError) Cause() error { return e.cause }
+func (e GetTemplateSpaceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameViewConfigReqValidationError) Key() bool { return e.key }
+func (e GetTemplateSpaceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameViewConfigReqValidationError) ErrorName() string {
-	return "RenameViewConfigReqValidationError"
+func (e GetTemplateSpaceReqValidationError) ErrorName() string {
+	return "GetTemplateSpaceReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameViewConfigReqValidationError) Error() string {
+func (e GetTemplateSpaceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7849,14 +8890,14 @@ func (e RenameViewConfigReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameViewConfigReq.%s: %s%s",
+		"invalid %sGetTemplateSpaceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameViewConfigReqValidationError{}
+var _ error = GetTemplateSpaceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7864,46 +8905,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameViewConfigReqValidationError{}
+} = GetTemplateSpaceReqValidationError{}
 
-// Validate checks the field values on DeleteViewConfigReq with the rules
+// Validate checks the field values on ListTemplateSpaceReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteViewConfigReq) Validate() error {
+func (m *ListTemplateSpaceReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteViewConfigReq with the rules
+// ValidateAll checks the field values on ListTemplateSpaceReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteViewConfigReqMultiError, or nil if none found.
-func (m *DeleteViewConfigReq) ValidateAll() error {
+// ListTemplateSpaceReqMultiError, or nil if none found.
+func (m *ListTemplateSpaceReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteViewConfigReq) validate(all bool) error {
+func (m *ListTemplateSpaceReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := DeleteViewConfigReqValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := DeleteViewConfigReqValidationError{
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := ListTemplateSpaceReqValidationError{
 			field:  "ProjectCode",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -7912,19 +8941,19 @@ func (m *DeleteViewConfigReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteViewConfigReqMultiError(errors)
+		return ListTemplateSpaceReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteViewConfigReqMultiError is an error wrapping multiple validation
-// errors returned by DeleteViewConfigReq.ValidateAll() if the designated
+// ListTemplateSpaceReqMultiError is an error wrapping multiple validation
+// errors returned by ListTemplateSpaceReq.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteViewConfigReqMultiError []error
+type ListTemplateSpaceReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteViewConfigReqMultiError) Error() string {
+func (m ListTemplateSpaceReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7933,11 +8962,11 @@ func (m DeleteViewConfigReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteViewConfigReqMultiError) AllErrors() []error { return m }
+func (m ListTemplateSpaceReqMultiError) AllErrors() []error { return m }
 
-// DeleteViewConfigReqValidationError is the validation error returned by
-// DeleteViewConfigReq.Validate if the designated constraints aren't met.
-type DeleteViewConfigReqValidationError struct {
+// ListTemplateSpaceReqValidationError is the validation error returned by
+// ListTemplateSpaceReq.Validate if the designated constraints aren't met.
+type ListTemplateSpaceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7945,24 +8974,24 @@ type DeleteViewConfigReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteViewConfigReqValidationError) Field() string { return e.field }
+func (e ListTemplateSpaceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteViewConfigReqValidationError) Reason() string { return e.reason }
+func (e ListTemplateSpaceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteViewConfigReqValidationError) Cause() error { return e.cause }
+func (e ListTemplateSpaceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteViewConfigReqValidationError) Key() bool { return e.key }
+func (e ListTemplateSpaceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteViewConfigReqValidationError) ErrorName() string {
-	return "DeleteViewConfigReqValidationError"
+func (e ListTemplateSpaceReqValidationError) ErrorName() string {
+	return "ListTemplateSpaceReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteViewConfigReqValidationError) Error() string {
+func (e ListTemplateSpaceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7974,14 +9003,14 @@ func (e DeleteViewConfigReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteViewConfigReq.%s: %s%s",
+		"invalid %sListTemplateSpaceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteViewConfigReqValidationError{}
+var _ error = ListTemplateSpaceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -7989,34 +9018,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteViewConfigReqValidationError{}
+} = ListTemplateSpaceReqValidationError{}
 
-// Validate checks the field values on ViewSuggestReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ViewSuggestReq) Validate() error {
+// Validate checks the field values on CreateTemplateSpaceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateSpaceReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ViewSuggestReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ViewSuggestReqMultiError,
-// or nil if none found.
-func (m *ViewSuggestReq) ValidateAll() error {
+// ValidateAll checks the field values on CreateTemplateSpaceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTemplateSpaceReqMultiError, or nil if none found.
+func (m *CreateTemplateSpaceReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ViewSuggestReq) validate(all bool) error {
+func (m *CreateTemplateSpaceReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
-		err := ViewSuggestReqValidationError{
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := CreateTemplateSpaceReqValidationError{
 			field:  "ProjectCode",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -8024,10 +9053,10 @@ func (m *ViewSuggestReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetClusterNamespaces()) < 1 {
-		err := ViewSuggestReqValidationError{
-			field:  "ClusterNamespaces",
-			reason: "value must contain at least 1 item(s)",
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateTemplateSpaceReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -8035,56 +9064,22 @@ func (m *ViewSuggestReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetClusterNamespaces() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ViewSuggestReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ViewSuggestReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ViewSuggestReqValidationError{
-					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Label
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return ViewSuggestReqMultiError(errors)
+		return CreateTemplateSpaceReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ViewSuggestReqMultiError is an error wrapping multiple validation errors
-// returned by ViewSuggestReq.ValidateAll() if the designated constraints
-// aren't met.
-type ViewSuggestReqMultiError []error
+// CreateTemplateSpaceReqMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateSpaceReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateSpaceReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ViewSuggestReqMultiError) Error() string {
+func (m CreateTemplateSpaceReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8093,11 +9088,11 @@ func (m ViewSuggestReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ViewSuggestReqMultiError) AllErrors() []error { return m }
+func (m CreateTemplateSpaceReqMultiError) AllErrors() []error { return m }
 
-// ViewSuggestReqValidationError is the validation error returned by
-// ViewSuggestReq.Validate if the designated constraints aren't met.
-type ViewSuggestReqValidationError struct {
+// CreateTemplateSpaceReqValidationError is the validation error returned by
+// CreateTemplateSpaceReq.Validate if the designated constraints aren't met.
+type CreateTemplateSpaceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8105,22 +9100,24 @@ type ViewSuggestReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ViewSuggestReqValidationError) Field() string { return e.field }
+func (e CreateTemplateSpaceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ViewSuggestReqValidationError) Reason() string { return e.reason }
+func (e CreateTemplateSpaceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ViewSuggestReqValidationError) Cause() error { return e.cause }
+func (e CreateTemplateSpaceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ViewSuggestReqValidationError) Key() bool { return e.key }
+func (e CreateTemplateSpaceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ViewSuggestReqValidationError) ErrorName() string { return "ViewSuggestReqValidationError" }
+func (e CreateTemplateSpaceReqValidationError) ErrorName() string {
+	return "CreateTemplateSpaceReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ViewSuggestReqValidationError) Error() string {
+func (e CreateTemplateSpaceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8132,14 +9129,14 @@ func (e ViewSuggestReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sViewSuggestReq.%s: %s%s",
+		"invalid %sCreateTemplateSpaceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ViewSuggestReqValidationError{}
+var _ error = CreateTemplateSpaceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8147,34 +9144,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ViewSuggestReqValidationError{}
+} = CreateTemplateSpaceReqValidationError{}
 
-// Validate checks the field values on ClusterNamespaces with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ClusterNamespaces) Validate() error {
+// Validate checks the field values on UpdateTemplateSpaceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTemplateSpaceReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClusterNamespaces with the rules
+// ValidateAll checks the field values on UpdateTemplateSpaceReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClusterNamespacesMultiError, or nil if none found.
-func (m *ClusterNamespaces) ValidateAll() error {
+// UpdateTemplateSpaceReqMultiError, or nil if none found.
+func (m *UpdateTemplateSpaceReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClusterNamespaces) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *UpdateTemplateSpaceReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := UpdateTemplateSpaceReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
 	}
 
-	var errors []error
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := UpdateTemplateSpaceReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if l := utf8.RuneCountInString(m.GetClusterID()); l < 13 || l > 14 {
-		err := ClusterNamespacesValidationError{
-			field:  "ClusterID",
-			reason: "value length must be between 13 and 14 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := UpdateTemplateSpaceReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -8182,20 +9202,22 @@ func (m *ClusterNamespaces) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
-		return ClusterNamespacesMultiError(errors)
+		return UpdateTemplateSpaceReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterNamespacesMultiError is an error wrapping multiple validation errors
-// returned by ClusterNamespaces.ValidateAll() if the designated constraints
-// aren't met.
-type ClusterNamespacesMultiError []error
+// UpdateTemplateSpaceReqMultiError is an error wrapping multiple validation
+// errors returned by UpdateTemplateSpaceReq.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTemplateSpaceReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterNamespacesMultiError) Error() string {
+func (m UpdateTemplateSpaceReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8204,11 +9226,11 @@ func (m ClusterNamespacesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterNamespacesMultiError) AllErrors() []error { return m }
+func (m UpdateTemplateSpaceReqMultiError) AllErrors() []error { return m }
 
-// ClusterNamespacesValidationError is the validation error returned by
-// ClusterNamespaces.Validate if the designated constraints aren't met.
-type ClusterNamespacesValidationError struct {
+// UpdateTemplateSpaceReqValidationError is the validation error returned by
+// UpdateTemplateSpaceReq.Validate if the designated constraints aren't met.
+type UpdateTemplateSpaceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8216,24 +9238,24 @@ type ClusterNamespacesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterNamespacesValidationError) Field() string { return e.field }
+func (e UpdateTemplateSpaceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterNamespacesValidationError) Reason() string { return e.reason }
+func (e UpdateTemplateSpaceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterNamespacesValidationError) Cause() error { return e.cause }
+func (e UpdateTemplateSpaceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterNamespacesValidationError) Key() bool { return e.key }
+func (e UpdateTemplateSpaceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterNamespacesValidationError) ErrorName() string {
-	return "ClusterNamespacesValidationError"
+func (e UpdateTemplateSpaceReqValidationError) ErrorName() string {
+	return "UpdateTemplateSpaceReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClusterNamespacesValidationError) Error() string {
+func (e UpdateTemplateSpaceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8245,14 +9267,14 @@ func (e ClusterNamespacesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClusterNamespaces.%s: %s%s",
+		"invalid %sUpdateTemplateSpaceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterNamespacesValidationError{}
+var _ error = UpdateTemplateSpaceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8260,45 +9282,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterNamespacesValidationError{}
+} = UpdateTemplateSpaceReqValidationError{}
 
-// Validate checks the field values on LabelSelector with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LabelSelector) Validate() error {
+// Validate checks the field values on DeleteTemplateSpaceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateSpaceReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LabelSelector with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LabelSelectorMultiError, or
-// nil if none found.
-func (m *LabelSelector) ValidateAll() error {
+// ValidateAll checks the field values on DeleteTemplateSpaceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateSpaceReqMultiError, or nil if none found.
+func (m *DeleteTemplateSpaceReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LabelSelector) validate(all bool) error {
+func (m *DeleteTemplateSpaceReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 64 {
-		err := LabelSelectorValidationError{
-			field:  "Key",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := DeleteTemplateSpaceReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
+
 	}
 
-	if _, ok := _LabelSelector_Op_InLookup[m.GetOp()]; !ok {
-		err := LabelSelectorValidationError{
-			field:  "Op",
-			reason: "value must be in list [= != In NotIn Exists DoesNotExist]",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := DeleteTemplateSpaceReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -8306,20 +9329,22 @@ func (m *LabelSelector) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IsRelateDelete
+
 	if len(errors) > 0 {
-		return LabelSelectorMultiError(errors)
+		return DeleteTemplateSpaceReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// LabelSelectorMultiError is an error wrapping multiple validation errors
-// returned by LabelSelector.ValidateAll() if the designated constraints
-// aren't met.
-type LabelSelectorMultiError []error
+// DeleteTemplateSpaceReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateSpaceReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateSpaceReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LabelSelectorMultiError) Error() string {
+func (m DeleteTemplateSpaceReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8328,11 +9353,11 @@ func (m LabelSelectorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LabelSelectorMultiError) AllErrors() []error { return m }
+func (m DeleteTemplateSpaceReqMultiError) AllErrors() []error { return m }
 
-// LabelSelectorValidationError is the validation error returned by
-// LabelSelector.Validate if the designated constraints aren't met.
-type LabelSelectorValidationError struct {
+// DeleteTemplateSpaceReqValidationError is the validation error returned by
+// DeleteTemplateSpaceReq.Validate if the designated constraints aren't met.
+type DeleteTemplateSpaceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8340,22 +9365,24 @@ type LabelSelectorValidationError struct {
 }
 
 // Field function returns field value.
-func (e LabelSelectorValidationError) Field() string { return e.field }
+func (e DeleteTemplateSpaceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LabelSelectorValidationError) Reason() string { return e.reason }
+func (e DeleteTemplateSpaceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LabelSelectorValidationError) Cause() error { return e.cause }
+func (e DeleteTemplateSpaceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LabelSelectorValidationError) Key() bool { return e.key }
+func (e DeleteTemplateSpaceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LabelSelectorValidationError) ErrorName() string { return "LabelSelectorValidationError" }
+func (e DeleteTemplateSpaceReqValidationError) ErrorName() string {
+	return "DeleteTemplateSpaceReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LabelSelectorValidationError) Error() string {
+func (e DeleteTemplateSpaceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8367,14 +9394,14 @@ func (e LabelSelectorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLabelSelector.%s: %s%s",
+		"invalid %sDeleteTemplateSpaceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LabelSelectorValidationError{}
+var _ error = DeleteTemplateSpaceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8382,33 +9409,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LabelSelectorValidationError{}
-
-var _LabelSelector_Op_InLookup = map[string]struct{}{
-	"=":            {},
-	"!=":           {},
-	"In":           {},
-	"NotIn":        {},
-	"Exists":       {},
-	"DoesNotExist": {},
-}
+} = DeleteTemplateSpaceReqValidationError{}
 
-// Validate checks the field values on GetTemplateSpaceReq with the rules
+// Validate checks the field values on GetTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTemplateSpaceReq) Validate() error {
+func (m *GetTemplateMetadataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTemplateSpaceReq with the rules
+// ValidateAll checks the field values on GetTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTemplateSpaceReqMultiError, or nil if none found.
-func (m *GetTemplateSpaceReq) ValidateAll() error {
+// GetTemplateMetadataReqMultiError, or nil if none found.
+func (m *GetTemplateMetadataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTemplateSpaceReq) validate(all bool) error {
+func (m *GetTemplateMetadataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8416,7 +9434,7 @@ func (m *GetTemplateSpaceReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := GetTemplateSpaceReqValidationError{
+		err := GetTemplateMetadataReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -8428,7 +9446,7 @@ func (m *GetTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := GetTemplateSpaceReqValidationError{
+		err := GetTemplateMetadataReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -8439,19 +9457,19 @@ func (m *GetTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetTemplateSpaceReqMultiError(errors)
+		return GetTemplateMetadataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTemplateSpaceReqMultiError is an error wrapping multiple validation
-// errors returned by GetTemplateSpaceReq.ValidateAll() if the designated
+// GetTemplateMetadataReqMultiError is an error wrapping multiple validation
+// errors returned by GetTemplateMetadataReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetTemplateSpaceReqMultiError []error
+type GetTemplateMetadataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTemplateSpaceReqMultiError) Error() string {
+func (m GetTemplateMetadataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8460,11 +9478,11 @@ func (m GetTemplateSpaceReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTemplateSpaceReqMultiError) AllErrors() []error { return m }
+func (m GetTemplateMetadataReqMultiError) AllErrors() []error { return m }
 
-// GetTemplateSpaceReqValidationError is the validation error returned by
-// GetTemplateSpaceReq.Validate if the designated constraints aren't met.
-type GetTemplateSpaceReqValidationError struct {
+// GetTemplateMetadataReqValidationError is the validation error returned by
+// GetTemplateMetadataReq.Validate if the designated constraints aren't met.
+type GetTemplateMetadataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8472,24 +9490,24 @@ type GetTemplateSpaceReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateSpaceReqValidationError) Field() string { return e.field }
+func (e GetTemplateMetadataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateSpaceReqValidationError) Reason() string { return e.reason }
+func (e GetTemplateMetadataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateSpaceReqValidationError) Cause() error { return e.cause }
+func (e GetTemplateMetadataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateSpaceReqValidationError) Key() bool { return e.key }
+func (e GetTemplateMetadataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateSpaceReqValidationError) ErrorName() string {
-	return "GetTemplateSpaceReqValidationError"
+func (e GetTemplateMetadataReqValidationError) ErrorName() string {
+	return "GetTemplateMetadataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateSpaceReqValidationError) Error() string {
+func (e GetTemplateMetadataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8501,14 +9519,14 @@ func (e GetTemplateSpaceReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateSpaceReq.%s: %s%s",
+		"invalid %sGetTemplateMetadataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateSpaceReqValidationError{}
+var _ error = GetTemplateMetadataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8516,24 +9534,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateSpaceReqValidationError{}
+} = GetTemplateMetadataReqValidationError{}
 
-// Validate checks the field values on ListTemplateSpaceReq with the rules
+// Validate checks the field values on ListTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTemplateSpaceReq) Validate() error {
+func (m *ListTemplateMetadataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTemplateSpaceReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListTemplateMetadataReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTemplateSpaceReqMultiError, or nil if none found.
-func (m *ListTemplateSpaceReq) ValidateAll() error {
+// ListTemplateMetadataReqMultiError, or nil if none found.
+func (m *ListTemplateMetadataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTemplateSpaceReq) validate(all bool) error {
+func (m *ListTemplateMetadataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8541,7 +9559,7 @@ func (m *ListTemplateSpaceReq) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := ListTemplateSpaceReqValidationError{
+		err := ListTemplateMetadataReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -8551,20 +9569,31 @@ func (m *ListTemplateSpaceReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
+		err := ListTemplateMetadataReqValidationError{
+			field:  "TemplateSpace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListTemplateSpaceReqMultiError(errors)
+		return ListTemplateMetadataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTemplateSpaceReqMultiError is an error wrapping multiple validation
-// errors returned by ListTemplateSpaceReq.ValidateAll() if the designated
+// ListTemplateMetadataReqMultiError is an error wrapping multiple validation
+// errors returned by ListTemplateMetadataReq.ValidateAll() if the designated
 // constraints aren't met.
-type ListTemplateSpaceReqMultiError []error
+type ListTemplateMetadataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTemplateSpaceReqMultiError) Error() string {
+func (m ListTemplateMetadataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8573,11 +9602,11 @@ func (m ListTemplateSpaceReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTemplateSpaceReqMultiError) AllErrors() []error { return m }
+func (m ListTemplateMetadataReqMultiError) AllErrors() []error { return m }
 
-// ListTemplateSpaceReqValidationError is the validation error returned by
-// ListTemplateSpaceReq.Validate if the designated constraints aren't met.
-type ListTemplateSpaceReqValidationError struct {
+// ListTemplateMetadataReqValidationError is the validation error returned by
+// ListTemplateMetadataReq.Validate if the designated constraints aren't met.
+type ListTemplateMetadataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8585,24 +9614,24 @@ type ListTemplateSpaceReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplateSpaceReqValidationError) Field() string { return e.field }
+func (e ListTemplateMetadataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplateSpaceReqValidationError) Reason() string { return e.reason }
+func (e ListTemplateMetadataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplateSpaceReqValidationError) Cause() error { return e.cause }
+func (e ListTemplateMetadataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplateSpaceReqValidationError) Key() bool { return e.key }
+func (e ListTemplateMetadataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplateSpaceReqValidationError) ErrorName() string {
-	return "ListTemplateSpaceReqValidationError"
+func (e ListTemplateMetadataReqValidationError) ErrorName() string {
+	return "ListTemplateMetadataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplateSpaceReqValidationError) Error() string {
+func (e ListTemplateMetadataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8614,14 +9643,14 @@ func (e ListTemplateSpaceReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplateSpaceReq.%s: %s%s",
+		"invalid %sListTemplateMetadataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplateSpaceReqValidationError{}
+var _ error = ListTemplateMetadataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8629,24 +9658,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplateSpaceReqValidationError{}
+} = ListTemplateMetadataReqValidationError{}
 
-// Validate checks the field values on CreateTemplateSpaceReq with the rules
+// Validate checks the field values on CreateTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTemplateSpaceReq) Validate() error {
+func (m *CreateTemplateMetadataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTemplateSpaceReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateTemplateMetadataReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTemplateSpaceReqMultiError, or nil if none found.
-func (m *CreateTemplateSpaceReq) ValidateAll() error {
+// CreateTemplateMetadataReqMultiError, or nil if none found.
+func (m *CreateTemplateMetadataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTemplateSpaceReq) validate(all bool) error {
+func (m *CreateTemplateMetadataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8654,7 +9683,7 @@ func (m *CreateTemplateSpaceReq) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := CreateTemplateSpaceReqValidationError{
+		err := CreateTemplateMetadataReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -8665,7 +9694,7 @@ func (m *CreateTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := CreateTemplateSpaceReqValidationError{
+		err := CreateTemplateMetadataReqValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
@@ -8677,20 +9706,57 @@ func (m *CreateTemplateSpaceReq) validate(all bool) error {
 
 	// no validation rules for Description
 
+	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
+		err := CreateTemplateMetadataReqValidationError{
+			field:  "TemplateSpace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetResourceType()); l < 1 || l > 64 {
+		err := CreateTemplateMetadataReqValidationError{
+			field:  "ResourceType",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionDescription
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		err := CreateTemplateMetadataReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Content
+
 	if len(errors) > 0 {
-		return CreateTemplateSpaceReqMultiError(errors)
+		return CreateTemplateMetadataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTemplateSpaceReqMultiError is an error wrapping multiple validation
-// errors returned by CreateTemplateSpaceReq.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTemplateSpaceReqMultiError []error
+// CreateTemplateMetadataReqMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateMetadataReq.ValidateAll() if the
+// designated constraints aren't met.
+type CreateTemplateMetadataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTemplateSpaceReqMultiError) Error() string {
+func (m CreateTemplateMetadataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8699,11 +9765,11 @@ func (m CreateTemplateSpaceReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTemplateSpaceReqMultiError) AllErrors() []error { return m }
+func (m CreateTemplateMetadataReqMultiError) AllErrors() []error { return m }
 
-// CreateTemplateSpaceReqValidationError is the validation error returned by
-// CreateTemplateSpaceReq.Validate if the designated constraints aren't met.
-type CreateTemplateSpaceReqValidationError struct {
+// CreateTemplateMetadataReqValidationError is the validation error returned by
+// CreateTemplateMetadataReq.Validate if the designated constraints aren't met.
+type CreateTemplateMetadataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8711,24 +9777,24 @@ type CreateTemplateSpaceReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTemplateSpaceReqValidationError) Field() string { return e.field }
+func (e CreateTemplateMetadataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTemplateSpaceReqValidationError) Reason() string { return e.reason }
+func (e CreateTemplateMetadataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTemplateSpaceReqValidationError) Cause() error { return e.cause }
+func (e CreateTemplateMetadataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTemplateSpaceReqValidationError) Key() bool { return e.key }
+func (e CreateTemplateMetadataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTemplateSpaceReqValidationError) ErrorName() string {
-	return "CreateTemplateSpaceReqValidationError"
+func (e CreateTemplateMetadataReqValidationError) ErrorName() string {
+	return "CreateTemplateMetadataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTemplateSpaceReqValidationError) Error() string {
+func (e CreateTemplateMetadataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8740,14 +9806,14 @@ func (e CreateTemplateSpaceReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTemplateSpaceReq.%s: %s%s",
+		"invalid %sCreateTemplateMetadataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTemplateSpaceReqValidationError{}
+var _ error = CreateTemplateMetadataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8755,24 +9821,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTemplateSpaceReqValidationError{}
+} = CreateTemplateMetadataReqValidationError{}
 
-// Validate checks the field values on UpdateTemplateSpaceReq with the rules
+// Validate checks the field values on UpdateTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTemplateSpaceReq) Validate() error {
+func (m *UpdateTemplateMetadataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTemplateSpaceReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateTemplateMetadataReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTemplateSpaceReqMultiError, or nil if none found.
-func (m *UpdateTemplateSpaceReq) ValidateAll() error {
+// UpdateTemplateMetadataReqMultiError, or nil if none found.
+func (m *UpdateTemplateMetadataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTemplateSpaceReq) validate(all bool) error {
+func (m *UpdateTemplateMetadataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8780,7 +9846,7 @@ func (m *UpdateTemplateSpaceReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := UpdateTemplateSpaceReqValidationError{
+		err := UpdateTemplateMetadataReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -8792,7 +9858,7 @@ func (m *UpdateTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := UpdateTemplateSpaceReqValidationError{
+		err := UpdateTemplateMetadataReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -8803,7 +9869,7 @@ func (m *UpdateTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := UpdateTemplateSpaceReqValidationError{
+		err := UpdateTemplateMetadataReqValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
@@ -8815,20 +9881,44 @@ func (m *UpdateTemplateSpaceReq) validate(all bool) error {
 
 	// no validation rules for Description
 
+	if l := utf8.RuneCountInString(m.GetResourceType()); l < 1 || l > 64 {
+		err := UpdateTemplateMetadataReqValidationError{
+			field:  "ResourceType",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		err := UpdateTemplateMetadataReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionMode
+
 	if len(errors) > 0 {
-		return UpdateTemplateSpaceReqMultiError(errors)
+		return UpdateTemplateMetadataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTemplateSpaceReqMultiError is an error wrapping multiple validation
-// errors returned by UpdateTemplateSpaceReq.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTemplateSpaceReqMultiError []error
+// UpdateTemplateMetadataReqMultiError is an error wrapping multiple validation
+// errors returned by UpdateTemplateMetadataReq.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateTemplateMetadataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTemplateSpaceReqMultiError) Error() string {
+func (m UpdateTemplateMetadataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8837,11 +9927,11 @@ func (m UpdateTemplateSpaceReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTemplateSpaceReqMultiError) AllErrors() []error { return m }
+func (m UpdateTemplateMetadataReqMultiError) AllErrors() []error { return m }
 
-// UpdateTemplateSpaceReqValidationError is the validation error returned by
-// UpdateTemplateSpaceReq.Validate if the designated constraints aren't met.
-type UpdateTemplateSpaceReqValidationError struct {
+// UpdateTemplateMetadataReqValidationError is the validation error returned by
+// UpdateTemplateMetadataReq.Validate if the designated constraints aren't met.
+type UpdateTemplateMetadataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8849,24 +9939,24 @@ type UpdateTemplateSpaceReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTemplateSpaceReqValidationError) Field() string { return e.field }
+func (e UpdateTemplateMetadataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTemplateSpaceReqValidationError) Reason() string { return e.reason }
+func (e UpdateTemplateMetadataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTemplateSpaceReqValidationError) Cause() error { return e.cause }
+func (e UpdateTemplateMetadataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTemplateSpaceReqValidationError) Key() bool { return e.key }
+func (e UpdateTemplateMetadataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTemplateSpaceReqValidationError) ErrorName() string {
-	return "UpdateTemplateSpaceReqValidationError"
+func (e UpdateTemplateMetadataReqValidationError) ErrorName() string {
+	return "UpdateTemplateMetadataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTemplateSpaceReqValidationError) Error() string {
+func (e UpdateTemplateMetadataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8878,14 +9968,14 @@ func (e UpdateTemplateSpaceReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTemplateSpaceReq.%s: %s%s",
+		"invalid %sUpdateTemplateMetadataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTemplateSpaceReqValidationError{}
+var _ error = UpdateTemplateMetadataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -8893,24 +9983,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTemplateSpaceReqValidationError{}
+} = UpdateTemplateMetadataReqValidationError{}
 
-// Validate checks the field values on DeleteTemplateSpaceReq with the rules
+// Validate checks the field values on DeleteTemplateMetadataReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTemplateSpaceReq) Validate() error {
+func (m *DeleteTemplateMetadataReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTemplateSpaceReq with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteTemplateMetadataReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTemplateSpaceReqMultiError, or nil if none found.
-func (m *DeleteTemplateSpaceReq) ValidateAll() error {
+// DeleteTemplateMetadataReqMultiError, or nil if none found.
+func (m *DeleteTemplateMetadataReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTemplateSpaceReq) validate(all bool) error {
+func (m *DeleteTemplateMetadataReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8918,7 +10008,7 @@ func (m *DeleteTemplateSpaceReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := DeleteTemplateSpaceReqValidationError{
+		err := DeleteTemplateMetadataReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -8930,7 +10020,7 @@ func (m *DeleteTemplateSpaceReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := DeleteTemplateSpaceReqValidationError{
+		err := DeleteTemplateMetadataReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -8943,19 +10033,19 @@ func (m *DeleteTemplateSpaceReq) validate(all bool) error {
 	// no validation rules for IsRelateDelete
 
 	if len(errors) > 0 {
-		return DeleteTemplateSpaceReqMultiError(errors)
+		return DeleteTemplateMetadataReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTemplateSpaceReqMultiError is an error wrapping multiple validation
-// errors returned by DeleteTemplateSpaceReq.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTemplateSpaceReqMultiError []error
+// DeleteTemplateMetadataReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateMetadataReq.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteTemplateMetadataReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTemplateSpaceReqMultiError) Error() string {
+func (m DeleteTemplateMetadataReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8964,11 +10054,11 @@ func (m DeleteTemplateSpaceReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTemplateSpaceReqMultiError) AllErrors() []error { return m }
+func (m DeleteTemplateMetadataReqMultiError) AllErrors() []error { return m }
 
-// DeleteTemplateSpaceReqValidationError is the validation error returned by
-// DeleteTemplateSpaceReq.Validate if the designated constraints aren't met.
-type DeleteTemplateSpaceReqValidationError struct {
+// DeleteTemplateMetadataReqValidationError is the validation error returned by
+// DeleteTemplateMetadataReq.Validate if the designated constraints aren't met.
+type DeleteTemplateMetadataReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8976,24 +10066,24 @@ type DeleteTemplateSpaceReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateSpaceReqValidationError) Field() string { return e.field }
+func (e DeleteTemplateMetadataReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateSpaceReqValidationError) Reason() string { return e.reason }
+func (e DeleteTemplateMetadataReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateSpaceReqValidationError) Cause() error { return e.cause }
+func (e DeleteTemplateMetadataReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateSpaceReqValidationError) Key() bool { return e.key }
+func (e DeleteTemplateMetadataReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateSpaceReqValidationError) ErrorName() string {
-	return "DeleteTemplateSpaceReqValidationError"
+func (e DeleteTemplateMetadataReqValidationError) ErrorName() string {
+	return "DeleteTemplateMetadataReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateSpaceReqValidationError) Error() string {
+func (e DeleteTemplateMetadataReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9005,14 +10095,14 @@ func (e DeleteTemplateSpaceReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateSpaceReq.%s: %s%s",
+		"invalid %sDeleteTemplateMetadataReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateSpaceReqValidationError{}
+var _ error = DeleteTemplateMetadataReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9020,24 +10110,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateSpaceReqValidationError{}
+} = DeleteTemplateMetadataReqValidationError{}
 
-// Validate checks the field values on GetTemplateMetadataReq with the rules
+// Validate checks the field values on GetTemplateVersionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTemplateMetadataReq) Validate() error {
+func (m *GetTemplateVersionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTemplateMetadataReq with the rules
+// ValidateAll checks the field values on GetTemplateVersionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTemplateMetadataReqMultiError, or nil if none found.
-func (m *GetTemplateMetadataReq) ValidateAll() error {
+// GetTemplateVersionReqMultiError, or nil if none found.
+func (m *GetTemplateVersionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTemplateMetadataReq) validate(all bool) error {
+func (m *GetTemplateVersionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9045,7 +10135,7 @@ func (m *GetTemplateMetadataReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := GetTemplateMetadataReqValidationError{
+		err := GetTemplateVersionReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -9057,7 +10147,7 @@ func (m *GetTemplateMetadataReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := GetTemplateMetadataReqValidationError{
+		err := GetTemplateVersionReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -9068,19 +10158,19 @@ func (m *GetTemplateMetadataReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetTemplateMetadataReqMultiError(errors)
+		return GetTemplateVersionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTemplateMetadataReqMultiError is an error wrapping multiple validation
-// errors returned by GetTemplateMetadataReq.ValidateAll() if the designated
+// GetTemplateVersionReqMultiError is an error wrapping multiple validation
+// errors returned by GetTemplateVersionReq.ValidateAll() if the designated
 // constraints aren't met.
-type GetTemplateMetadataReqMultiError []error
+type GetTemplateVersionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTemplateMetadataReqMultiError) Error() string {
+func (m GetTemplateVersionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9089,11 +10179,11 @@ func (m GetTemplateMetadataReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTemplateMetadataReqMultiError) AllErrors() []error { return m }
+func (m GetTemplateVersionReqMultiError) AllErrors() []error { return m }
 
-// GetTemplateMetadataReqValidationError is the validation error returned by
-// GetTemplateMetadataReq.Validate if the designated constraints aren't met.
-type GetTemplateMetadataReqValidationError struct {
+// GetTemplateVersionReqValidationError is the validation error returned by
+// GetTemplateVersionReq.Validate if the designated constraints aren't met.
+type GetTemplateVersionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9101,24 +10191,24 @@ type GetTemplateMetadataReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateMetadataReqValidationError) Field() string { return e.field }
+func (e GetTemplateVersionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateMetadataReqValidationError) Reason() string { return e.reason }
+func (e GetTemplateVersionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateMetadataReqValidationError) Cause() error { return e.cause }
+func (e GetTemplateVersionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateMetadataReqValidationError) Key() bool { return e.key }
+func (e GetTemplateVersionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateMetadataReqValidationError) ErrorName() string {
-	return "GetTemplateMetadataReqValidationError"
+func (e GetTemplateVersionReqValidationError) ErrorName() string {
+	return "GetTemplateVersionReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateMetadataReqValidationError) Error() string {
+func (e GetTemplateVersionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9130,14 +10220,14 @@ func (e GetTemplateMetadataReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateMetadataReq.%s: %s%s",
+		"invalid %sGetTemplateVersionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateMetadataReqValidationError{}
+var _ error = GetTemplateVersionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9145,24 +10235,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateMetadataReqValidationError{}
+} = GetTemplateVersionReqValidationError{}
 
-// Validate checks the field values on ListTemplateMetadataReq with the rules
+// Validate checks the field values on ListTemplateVersionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTemplateMetadataReq) Validate() error {
+func (m *ListTemplateVersionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTemplateMetadataReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListTemplateVersionReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTemplateMetadataReqMultiError, or nil if none found.
-func (m *ListTemplateMetadataReq) ValidateAll() error {
+// ListTemplateVersionReqMultiError, or nil if none found.
+func (m *ListTemplateVersionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTemplateMetadataReq) validate(all bool) error {
+func (m *ListTemplateVersionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9170,7 +10260,7 @@ func (m *ListTemplateMetadataReq) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := ListTemplateMetadataReqValidationError{
+		err := ListTemplateVersionReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -9180,8 +10270,19 @@ func (m *ListTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
+		err := ListTemplateVersionReqValidationError{
+			field:  "TemplateName",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
-		err := ListTemplateMetadataReqValidationError{
+		err := ListTemplateVersionReqValidationError{
 			field:  "TemplateSpace",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
@@ -9192,19 +10293,19 @@ func (m *ListTemplateMetadataReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListTemplateMetadataReqMultiError(errors)
+		return ListTemplateVersionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTemplateMetadataReqMultiError is an error wrapping multiple validation
-// errors returned by ListTemplateMetadataReq.ValidateAll() if the designated
+// ListTemplateVersionReqMultiError is an error wrapping multiple validation
+// errors returned by ListTemplateVersionReq.ValidateAll() if the designated
 // constraints aren't met.
-type ListTemplateMetadataReqMultiError []error
+type ListTemplateVersionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTemplateMetadataReqMultiError) Error() string {
+func (m ListTemplateVersionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9213,11 +10314,11 @@ func (m ListTemplateMetadataReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTemplateMetadataReqMultiError) AllErrors() []error { return m }
+func (m ListTemplateVersionReqMultiError) AllErrors() []error { return m }
 
-// ListTemplateMetadataReqValidationError is the validation error returned by
-// ListTemplateMetadataReq.Validate if the designated constraints aren't met.
-type ListTemplateMetadataReqValidationError struct {
+// ListTemplateVersionReqValidationError is the validation error returned by
+// ListTemplateVersionReq.Validate if the designated constraints aren't met.
+type ListTemplateVersionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9225,24 +10326,24 @@ type ListTemplateMetadataReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplateMetadataReqValidationError) Field() string { return e.field }
+func (e ListTemplateVersionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplateMetadataReqValidationError) Reason() string { return e.reason }
+func (e ListTemplateVersionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplateMetadataReqValidationError) Cause() error { return e.cause }
+func (e ListTemplateVersionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplateMetadataReqValidationError) Key() bool { return e.key }
+func (e ListTemplateVersionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplateMetadataReqValidationError) ErrorName() string {
-	return "ListTemplateMetadataReqValidationError"
+func (e ListTemplateVersionReqValidationError) ErrorName() string {
+	return "ListTemplateVersionReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplateMetadataReqValidationError) Error() string {
+func (e ListTemplateVersionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9254,14 +10355,14 @@ func (e ListTemplateMetadataReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplateMetadataReq.%s: %s%s",
+		"invalid %sListTemplateVersionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplateMetadataReqValidationError{}
+var _ error = ListTemplateVersionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9269,24 +10370,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplateMetadataReqValidationError{}
+} = ListTemplateVersionReqValidationError{}
 
-// Validate checks the field values on CreateTemplateMetadataReq with the rules
+// Validate checks the field values on CreateTemplateVersionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTemplateMetadataReq) Validate() error {
+func (m *CreateTemplateVersionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTemplateMetadataReq with the
+// ValidateAll checks the field values on CreateTemplateVersionReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTemplateMetadataReqMultiError, or nil if none found.
-func (m *CreateTemplateMetadataReq) ValidateAll() error {
+// CreateTemplateVersionReqMultiError, or nil if none found.
+func (m *CreateTemplateVersionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTemplateMetadataReq) validate(all bool) error {
+func (m *CreateTemplateVersionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9294,7 +10395,7 @@ func (m *CreateTemplateMetadataReq) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := CreateTemplateMetadataReqValidationError{
+		err := CreateTemplateVersionReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -9304,22 +10405,11 @@ func (m *CreateTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := CreateTemplateMetadataReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	// no validation rules for Description
 
-	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
-		err := CreateTemplateMetadataReqValidationError{
-			field:  "TemplateSpace",
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		err := CreateTemplateVersionReqValidationError{
+			field:  "Version",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
@@ -9328,9 +10418,11 @@ func (m *CreateTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetResourceType()); l < 1 || l > 64 {
-		err := CreateTemplateMetadataReqValidationError{
-			field:  "ResourceType",
+	// no validation rules for Content
+
+	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
+		err := CreateTemplateVersionReqValidationError{
+			field:  "TemplateName",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
@@ -9339,11 +10431,9 @@ func (m *CreateTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for VersionDescription
-
-	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
-		err := CreateTemplateMetadataReqValidationError{
-			field:  "Version",
+	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
+		err := CreateTemplateVersionReqValidationError{
+			field:  "TemplateSpace",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
@@ -9352,22 +10442,22 @@ func (m *CreateTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Content
+	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return CreateTemplateMetadataReqMultiError(errors)
+		return CreateTemplateVersionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTemplateMetadataReqMultiError is an error wrapping multiple validation
-// errors returned by CreateTemplateMetadataReq.ValidateAll() if the
-// designated constraints aren't met.
-type CreateTemplateMetadataReqMultiError []error
+// CreateTemplateVersionReqMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateVersionReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateVersionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTemplateMetadataReqMultiError) Error() string {
+func (m CreateTemplateVersionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9376,11 +10466,11 @@ func (m CreateTemplateMetadataReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTemplateMetadataReqMultiError) AllErrors() []error { return m }
+func (m CreateTemplateVersionReqMultiError) AllErrors() []error { return m }
 
-// CreateTemplateMetadataReqValidationError is the validation error returned by
-// CreateTemplateMetadataReq.Validate if the designated constraints aren't met.
-type CreateTemplateMetadataReqValidationError struct {
+// CreateTemplateVersionReqValidationError is the validation error returned by
+// CreateTemplateVersionReq.Validate if the designated constraints aren't met.
+type CreateTemplateVersionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9388,24 +10478,24 @@ type CreateTemplateMetadataReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTemplateMetadataReqValidationError) Field() string { return e.field }
+func (e CreateTemplateVersionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTemplateMetadataReqValidationError) Reason() string { return e.reason }
+func (e CreateTemplateVersionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTemplateMetadataReqValidationError) Cause() error { return e.cause }
+func (e CreateTemplateVersionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTemplateMetadataReqValidationError) Key() bool { return e.key }
+func (e CreateTemplateVersionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTemplateMetadataReqValidationError) ErrorName() string {
-	return "CreateTemplateMetadataReqValidationError"
+func (e CreateTemplateVersionReqValidationError) ErrorName() string {
+	return "CreateTemplateVersionReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTemplateMetadataReqValidationError) Error() string {
+func (e CreateTemplateVersionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9417,14 +10507,14 @@ func (e CreateTemplateMetadataReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTemplateMetadataReq.%s: %s%s",
+		"invalid %sCreateTemplateVersionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTemplateMetadataReqValidationError{}
+var _ error = CreateTemplateVersionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9432,24 +10522,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTemplateMetadataReqValidationError{}
+} = CreateTemplateVersionReqValidationError{}
 
-// Validate checks the field values on UpdateTemplateMetadataReq with the rules
+// Validate checks the field values on DeleteTemplateVersionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTemplateMetadataReq) Validate() error {
+func (m *DeleteTemplateVersionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTemplateMetadataReq with the
+// ValidateAll checks the field values on DeleteTemplateVersionReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTemplateMetadataReqMultiError, or nil if none found.
-func (m *UpdateTemplateMetadataReq) ValidateAll() error {
+// DeleteTemplateVersionReqMultiError, or nil if none found.
+func (m *DeleteTemplateVersionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTemplateMetadataReq) validate(all bool) error {
+func (m *DeleteTemplateVersionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9457,7 +10547,7 @@ func (m *UpdateTemplateMetadataReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := UpdateTemplateMetadataReqValidationError{
+		err := DeleteTemplateVersionReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -9465,48 +10555,13 @@ func (m *UpdateTemplateMetadataReq) validate(all bool) error {
 			return err
 		}
 		errors = append(errors, err)
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := UpdateTemplateMetadataReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 32 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := UpdateTemplateMetadataReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Description
-
-	if l := utf8.RuneCountInString(m.GetResourceType()); l < 1 || l > 64 {
-		err := UpdateTemplateMetadataReqValidationError{
-			field:  "ResourceType",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
-		err := UpdateTemplateMetadataReqValidationError{
-			field:  "Version",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := DeleteTemplateVersionReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -9514,22 +10569,20 @@ func (m *UpdateTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for VersionMode
-
 	if len(errors) > 0 {
-		return UpdateTemplateMetadataReqMultiError(errors)
+		return DeleteTemplateVersionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTemplateMetadataReqMultiError is an error wrapping multiple validation
-// errors returned by UpdateTemplateMetadataReq.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateTemplateMetadataReqMultiError []error
+// DeleteTemplateVersionReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateVersionReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateVersionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTemplateMetadataReqMultiError) Error() string {
+func (m DeleteTemplateVersionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9538,11 +10591,11 @@ func (m UpdateTemplateMetadataReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTemplateMetadataReqMultiError) AllErrors() []error { return m }
+func (m DeleteTemplateVersionReqMultiError) AllErrors() []error { return m }
 
-// UpdateTemplateMetadataReqValidationError is the validation error returned by
-// UpdateTemplateMetadataReq.Validate if the designated constraints aren't met.
-type UpdateTemplateMetadataReqValidationError struct {
+// DeleteTemplateVersionReqValidationError is the validation error returned by
+// DeleteTemplateVersionReq.Validate if the designated constraints aren't met.
+type DeleteTemplateVersionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9550,24 +10603,24 @@ type UpdateTemplateMetadataReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTemplateMetadataReqValidationError) Field() string { return e.field }
+func (e DeleteTemplateVersionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTemplateMetadataReqValidationError) Reason() string { return e.reason }
+func (e DeleteTemplateVersionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTemplateMetadataReqValidationError) Cause() error { return e.cause }
+func (e DeleteTemplateVersionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTemplateMetadataReqValidationError) Key() bool { return e.key }
+func (e DeleteTemplateVersionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTemplateMetadataReqValidationError) ErrorName() string {
-	return "UpdateTemplateMetadataReqValidationError"
+func (e DeleteTemplateVersionReqValidationError) ErrorName() string {
+	return "DeleteTemplateVersionReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTemplateMetadataReqValidationError) Error() string {
+func (e DeleteTemplateVersionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9579,14 +10632,14 @@ func (e UpdateTemplateMetadataReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTemplateMetadataReq.%s: %s%s",
+		"invalid %sDeleteTemplateVersionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTemplateMetadataReqValidationError{}
+var _ error = DeleteTemplateVersionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9594,44 +10647,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTemplateMetadataReqValidationError{}
+} = DeleteTemplateVersionReqValidationError{}
 
-// Validate checks the field values on DeleteTemplateMetadataReq with the rules
+// Validate checks the field values on CreateTemplateSetReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTemplateMetadataReq) Validate() error {
+func (m *CreateTemplateSetReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTemplateMetadataReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateTemplateSetReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTemplateMetadataReqMultiError, or nil if none found.
-func (m *DeleteTemplateMetadataReq) ValidateAll() error {
+// CreateTemplateSetReqMultiError, or nil if none found.
+func (m *CreateTemplateSetReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTemplateMetadataReq) validate(all bool) error {
+func (m *CreateTemplateSetReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := DeleteTemplateMetadataReqValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateTemplateSetReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-
 	}
 
+	// no validation rules for Description
+
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := DeleteTemplateMetadataReqValidationError{
+		err := CreateTemplateSetReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -9641,22 +10695,82 @@ func (m *DeleteTemplateMetadataReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for IsRelateDelete
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		err := CreateTemplateSetReqValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCategory()); l < 1 || l > 64 {
+		err := CreateTemplateSetReqValidationError{
+			field:  "Category",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Readme
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTemplateSetReqValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTemplateSetReqValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTemplateSetReqValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Values
+
+	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return DeleteTemplateMetadataReqMultiError(errors)
+		return CreateTemplateSetReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTemplateMetadataReqMultiError is an error wrapping multiple validation
-// errors returned by DeleteTemplateMetadataReq.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteTemplateMetadataReqMultiError []error
+// CreateTemplateSetReqMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateSetReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateSetReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTemplateMetadataReqMultiError) Error() string {
+func (m CreateTemplateSetReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9665,11 +10779,11 @@ func (m DeleteTemplateMetadataReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTemplateMetadataReqMultiError) AllErrors() []error { return m }
+func (m CreateTemplateSetReqMultiError) AllErrors() []error { return m }
 
-// DeleteTemplateMetadataReqValidationError is the validation error returned by
-// DeleteTemplateMetadataReq.Validate if the designated constraints aren't met.
-type DeleteTemplateMetadataReqValidationError struct {
+// CreateTemplateSetReqValidationError is the validation error returned by
+// CreateTemplateSetReq.Validate if the designated constraints aren't met.
+type CreateTemplateSetReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9677,24 +10791,24 @@ type DeleteTemplateMetadataReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateMetadataReqValidationError) Field() string { return e.field }
+func (e CreateTemplateSetReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateMetadataReqValidationError) Reason() string { return e.reason }
+func (e CreateTemplateSetReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateMetadataReqValidationError) Cause() error { return e.cause }
+func (e CreateTemplateSetReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateMetadataReqValidationError) Key() bool { return e.key }
+func (e CreateTemplateSetReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateMetadataReqValidationError) ErrorName() string {
-	return "DeleteTemplateMetadataReqValidationError"
+func (e CreateTemplateSetReqValidationError) ErrorName() string {
+	return "CreateTemplateSetReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateMetadataReqValidationError) Error() string {
+func (e CreateTemplateSetReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9706,14 +10820,14 @@ func (e DeleteTemplateMetadataReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateMetadataReq.%s: %s%s",
+		"invalid %sCreateTemplateSetReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateMetadataReqValidationError{}
+var _ error = CreateTemplateSetReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9721,46 +10835,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateMetadataReqValidationError{}
+} = CreateTemplateSetReqValidationError{}
 
-// Validate checks the field values on GetTemplateVersionReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTemplateVersionReq) Validate() error {
+// Validate checks the field values on TemplateID with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TemplateID) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTemplateVersionReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetTemplateVersionReqMultiError, or nil if none found.
-func (m *GetTemplateVersionReq) ValidateAll() error {
+// ValidateAll checks the field values on TemplateID with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateIDMultiError, or
+// nil if none found.
+func (m *TemplateID) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTemplateVersionReq) validate(all bool) error {
+func (m *TemplateID) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := GetTemplateVersionReqValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
+	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
+		err := TemplateIDValidationError{
+			field:  "TemplateSpace",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
+	}
 
+	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
+		err := TemplateIDValidationError{
+			field:  "TemplateName",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := GetTemplateVersionReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 32 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
+		err := TemplateIDValidationError{
+			field:  "Version",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -9769,19 +10893,18 @@ func (m *GetTemplateVersionReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetTemplateVersionReqMultiError(errors)
+		return TemplateIDMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTemplateVersionReqMultiError is an error wrapping multiple validation
-// errors returned by GetTemplateVersionReq.ValidateAll() if the designated
-// constraints aren't met.
-type GetTemplateVersionReqMultiError []error
+// TemplateIDMultiError is an error wrapping multiple validation errors
+// returned by TemplateID.ValidateAll() if the designated constraints aren't met.
+type TemplateIDMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTemplateVersionReqMultiError) Error() string {
+func (m TemplateIDMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9790,11 +10913,11 @@ func (m GetTemplateVersionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTemplateVersionReqMultiError) AllErrors() []error { return m }
+func (m TemplateIDMultiError) AllErrors() []error { return m }
 
-// GetTemplateVersionReqValidationError is the validation error returned by
-// GetTemplateVersionReq.Validate if the designated constraints aren't met.
-type GetTemplateVersionReqValidationError struct {
+// TemplateIDValidationError is the validation error returned by
+// TemplateID.Validate if the designated constraints aren't met.
+type TemplateIDValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9802,24 +10925,22 @@ type GetTemplateVersionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateVersionReqValidationError) Field() string { return e.field }
+func (e TemplateIDValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateVersionReqValidationError) Reason() string { return e.reason }
+func (e TemplateIDValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateVersionReqValidationError) Cause() error { return e.cause }
+func (e TemplateIDValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateVersionReqValidationError) Key() bool { return e.key }
+func (e TemplateIDValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateVersionReqValidationError) ErrorName() string {
-	return "GetTemplateVersionReqValidationError"
-}
+func (e TemplateIDValidationError) ErrorName() string { return "TemplateIDValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateVersionReqValidationError) Error() string {
+func (e TemplateIDValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9831,14 +10952,14 @@ func (e GetTemplateVersionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateVersionReq.%s: %s%s",
+		"invalid %sTemplateID.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateVersionReqValidationError{}
+var _ error = TemplateIDValidationError{}
 
 var _ interface {
 	Field() string
@@ -9846,56 +10967,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateVersionReqValidationError{}
+} = TemplateIDValidationError{}
 
-// Validate checks the field values on ListTemplateVersionReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTemplateVersionReq) Validate() error {
+// Validate checks the field values on GetEnvManageReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetEnvManageReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTemplateVersionReq with the rules
+// ValidateAll checks the field values on GetEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTemplateVersionReqMultiError, or nil if none found.
-func (m *ListTemplateVersionReq) ValidateAll() error {
+// GetEnvManageReqMultiError, or nil if none found.
+func (m *GetEnvManageReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTemplateVersionReq) validate(all bool) error {
+func (m *GetEnvManageReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := ListTemplateVersionReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 32 runes, inclusive",
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := GetEnvManageReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-	}
 
-	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
-		err := ListTemplateVersionReqValidationError{
-			field:  "TemplateName",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
-		err := ListTemplateVersionReqValidationError{
-			field:  "TemplateSpace",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := GetEnvManageReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -9904,19 +11015,19 @@ func (m *ListTemplateVersionReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListTemplateVersionReqMultiError(errors)
+		return GetEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTemplateVersionReqMultiError is an error wrapping multiple validation
-// errors returned by ListTemplateVersionReq.ValidateAll() if the designated
-// constraints aren't met.
-type ListTemplateVersionReqMultiError []error
+// GetEnvManageReqMultiError is an error wrapping multiple validation errors
+// returned by GetEnvManageReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetEnvManageReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTemplateVersionReqMultiError) Error() string {
+func (m GetEnvManageReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9925,11 +11036,11 @@ func (m ListTemplateVersionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTemplateVersionReqMultiError) AllErrors() []error { return m }
+func (m GetEnvManageReqMultiError) AllErrors() []error { return m }
 
-// ListTemplateVersionReqValidationError is the validation error returned by
-// ListTemplateVersionReq.Validate if the designated constraints aren't met.
-type ListTemplateVersionReqValidationError struct {
+// GetEnvManageReqValidationError is the validation error returned by
+// GetEnvManageReq.Validate if the designated constraints aren't met.
+type GetEnvManageReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9937,24 +11048,22 @@ type ListTemplateVersionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplateVersionReqValidationError) Field() string { return e.field }
+func (e GetEnvManageReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplateVersionReqValidationError) Reason() string { return e.reason }
+func (e GetEnvManageReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplateVersionReqValidationError) Cause() error { return e.cause }
+func (e GetEnvManageReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplateVersionReqValidationError) Key() bool { return e.key }
+func (e GetEnvManageReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplateVersionReqValidationError) ErrorName() string {
-	return "ListTemplateVersionReqValidationError"
-}
+func (e GetEnvManageReqValidationError) ErrorName() string { return "GetEnvManageReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListTemplateVersionReqValidationError) Error() string {
+func (e GetEnvManageReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9966,14 +11075,14 @@ func (e ListTemplateVersionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplateVersionReq.%s: %s%s",
+		"invalid %sGetEnvManageReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplateVersionReqValidationError{}
+var _ error = GetEnvManageReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -9981,24 +11090,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplateVersionReqValidationError{}
+} = GetEnvManageReqValidationError{}
 
-// Validate checks the field values on CreateTemplateVersionReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTemplateVersionReq) Validate() error {
+// Validate checks the field values on ListEnvManagesReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEnvManagesReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTemplateVersionReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListEnvManagesReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTemplateVersionReqMultiError, or nil if none found.
-func (m *CreateTemplateVersionReq) ValidateAll() error {
+// ListEnvManagesReqMultiError, or nil if none found.
+func (m *ListEnvManagesReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTemplateVersionReq) validate(all bool) error {
+func (m *ListEnvManagesReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10006,7 +11115,7 @@ func (m *CreateTemplateVersionReq) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := CreateTemplateVersionReqValidationError{
+		err := ListEnvManagesReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -10016,59 +11125,20 @@ func (m *CreateTemplateVersionReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
-		err := CreateTemplateVersionReqValidationError{
-			field:  "Version",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Content
-
-	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
-		err := CreateTemplateVersionReqValidationError{
-			field:  "TemplateName",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
-		err := CreateTemplateVersionReqValidationError{
-			field:  "TemplateSpace",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Force
-
 	if len(errors) > 0 {
-		return CreateTemplateVersionReqMultiError(errors)
+		return ListEnvManagesReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTemplateVersionReqMultiError is an error wrapping multiple validation
-// errors returned by CreateTemplateVersionReq.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTemplateVersionReqMultiError []error
+// ListEnvManagesReqMultiError is an error wrapping multiple validation errors
+// returned by ListEnvManagesReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListEnvManagesReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTemplateVersionReqMultiError) Error() string {
+func (m ListEnvManagesReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10077,11 +11147,11 @@ func (m CreateTemplateVersionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTemplateVersionReqMultiError) AllErrors() []error { return m }
+func (m ListEnvManagesReqMultiError) AllErrors() []error { return m }
 
-// CreateTemplateVersionReqValidationError is the validation error returned by
-// CreateTemplateVersionReq.Validate if the designated constraints aren't met.
-type CreateTemplateVersionReqValidationError struct {
+// ListEnvManagesReqValidationError is the validation error returned by
+// ListEnvManagesReq.Validate if the designated constraints aren't met.
+type ListEnvManagesReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10089,24 +11159,24 @@ type CreateTemplateVersionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTemplateVersionReqValidationError) Field() string { return e.field }
+func (e ListEnvManagesReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTemplateVersionReqValidationError) Reason() string { return e.reason }
+func (e ListEnvManagesReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTemplateVersionReqValidationError) Cause() error { return e.cause }
+func (e ListEnvManagesReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTemplateVersionReqValidationError) Key() bool { return e.key }
+func (e ListEnvManagesReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTemplateVersionReqValidationError) ErrorName() string {
-	return "CreateTemplateVersionReqValidationError"
+func (e ListEnvManagesReqValidationError) ErrorName() string {
+	return "ListEnvManagesReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTemplateVersionReqValidationError) Error() string {
+func (e ListEnvManagesReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10118,14 +11188,14 @@ func (e CreateTemplateVersionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTemplateVersionReq.%s: %s%s",
+		"invalid %sListEnvManagesReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTemplateVersionReqValidationError{}
+var _ error = ListEnvManagesReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10133,67 +11203,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTemplateVersionReqValidationError{}
+} = ListEnvManagesReqValidationError{}
 
-// Validate checks the field values on DeleteTemplateVersionReq with the rules
+// Validate checks the field values on CreateEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTemplateVersionReq) Validate() error {
+func (m *CreateEnvManageReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTemplateVersionReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateEnvManageReq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTemplateVersionReqMultiError, or nil if none found.
-func (m *DeleteTemplateVersionReq) ValidateAll() error {
+// CreateEnvManageReqMultiError, or nil if none found.
+func (m *CreateEnvManageReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTemplateVersionReq) validate(all bool) error {
+func (m *CreateEnvManageReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := DeleteTemplateVersionReqValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		err := CreateEnvManageReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
+		err := CreateEnvManageReqValidationError{
+			field:  "Env",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateEnvManageReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateEnvManageReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateEnvManageReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
 	}
 
-	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := DeleteTemplateVersionReqValidationError{
-			field:  "ProjectCode",
-			reason: "value length must be between 1 and 32 runes, inclusive",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateEnvManageReqValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateEnvManageReqValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateEnvManageReqValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return DeleteTemplateVersionReqMultiError(errors)
+		return CreateEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTemplateVersionReqMultiError is an error wrapping multiple validation
-// errors returned by DeleteTemplateVersionReq.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTemplateVersionReqMultiError []error
+// CreateEnvManageReqMultiError is an error wrapping multiple validation errors
+// returned by CreateEnvManageReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateEnvManageReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTemplateVersionReqMultiError) Error() string {
+func (m CreateEnvManageReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10202,11 +11339,11 @@ func (m DeleteTemplateVersionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTemplateVersionReqMultiError) AllErrors() []error { return m }
+func (m CreateEnvManageReqMultiError) AllErrors() []error { return m }
 
-// DeleteTemplateVersionReqValidationError is the validation error returned by
-// DeleteTemplateVersionReq.Validate if the designated constraints aren't met.
-type DeleteTemplateVersionReqValidationError struct {
+// CreateEnvManageReqValidationError is the validation error returned by
+// CreateEnvManageReq.Validate if the designated constraints aren't met.
+type CreateEnvManageReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10214,24 +11351,24 @@ type DeleteTemplateVersionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateVersionReqValidationError) Field() string { return e.field }
+func (e CreateEnvManageReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateVersionReqValidationError) Reason() string { return e.reason }
+func (e CreateEnvManageReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateVersionReqValidationError) Cause() error { return e.cause }
+func (e CreateEnvManageReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateVersionReqValidationError) Key() bool { return e.key }
+func (e CreateEnvManageReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateVersionReqValidationError) ErrorName() string {
-	return "DeleteTemplateVersionReqValidationError"
+func (e CreateEnvManageReqValidationError) ErrorName() string {
+	return "CreateEnvManageReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateVersionReqValidationError) Error() string {
+func (e CreateEnvManageReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10243,14 +11380,14 @@ func (e DeleteTemplateVersionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateVersionReq.%s: %s%s",
+		"invalid %sCreateEnvManageReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateVersionReqValidationError{}
+var _ error = CreateEnvManageReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10258,45 +11395,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateVersionReqValidationError{}
+} = CreateEnvManageReqValidationError{}
 
-// Validate checks the field values on CreateTemplateSetReq with the rules
+// Validate checks the field values on UpdateEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTemplateSetReq) Validate() error {
+func (m *UpdateEnvManageReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTemplateSetReq with the rules
+// ValidateAll checks the field values on UpdateEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTemplateSetReqMultiError, or nil if none found.
-func (m *CreateTemplateSetReq) ValidateAll() error {
+// UpdateEnvManageReqMultiError, or nil if none found.
+func (m *UpdateEnvManageReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTemplateSetReq) validate(all bool) error {
+func (m *UpdateEnvManageReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
-		err := CreateTemplateSetReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := UpdateEnvManageReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-	}
 
-	// no validation rules for Description
+	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := CreateTemplateSetReqValidationError{
+		err := UpdateEnvManageReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -10306,20 +11442,43 @@ func (m *CreateTemplateSetReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
-		err := CreateTemplateSetReqValidationError{
-			field:  "Version",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateEnvManageReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateEnvManageReqValidationError{
+						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateEnvManageReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetCategory()); l < 1 || l > 64 {
-		err := CreateTemplateSetReqValidationError{
-			field:  "Category",
+	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
+		err := UpdateEnvManageReqValidationError{
+			field:  "Env",
 			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
@@ -10328,25 +11487,23 @@ func (m *CreateTemplateSetReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Readme
-
-	for idx, item := range m.GetTemplates() {
+	for idx, item := range m.GetVariables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTemplateSetReqValidationError{
-						field:  fmt.Sprintf("Templates[%v]", idx),
+					errors = append(errors, UpdateEnvManageReqValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTemplateSetReqValidationError{
-						field:  fmt.Sprintf("Templates[%v]", idx),
+					errors = append(errors, UpdateEnvManageReqValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10354,8 +11511,8 @@ func (m *CreateTemplateSetReq) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateTemplateSetReqValidationError{
-					field:  fmt.Sprintf("Templates[%v]", idx),
+				return UpdateEnvManageReqValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10364,24 +11521,20 @@ func (m *CreateTemplateSetReq) validate(all bool) error {
 
 	}
 
-	// no validation rules for Values
-
-	// no validation rules for Force
-
 	if len(errors) > 0 {
-		return CreateTemplateSetReqMultiError(errors)
+		return UpdateEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTemplateSetReqMultiError is an error wrapping multiple validation
-// errors returned by CreateTemplateSetReq.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTemplateSetReqMultiError []error
+// UpdateEnvManageReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateEnvManageReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateEnvManageReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTemplateSetReqMultiError) Error() string {
+func (m UpdateEnvManageReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10390,11 +11543,11 @@ func (m CreateTemplateSetReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTemplateSetReqMultiError) AllErrors() []error { return m }
+func (m UpdateEnvManageReqMultiError) AllErrors() []error { return m }
 
-// CreateTemplateSetReqValidationError is the validation error returned by
-// CreateTemplateSetReq.Validate if the designated constraints aren't met.
-type CreateTemplateSetReqValidationError struct {
+// UpdateEnvManageReqValidationError is the validation error returned by
+// UpdateEnvManageReq.Validate if the designated constraints aren't met.
+type UpdateEnvManageReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10402,24 +11555,24 @@ type CreateTemplateSetReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTemplateSetReqValidationError) Field() string { return e.field }
+func (e UpdateEnvManageReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTemplateSetReqValidationError) Reason() string { return e.reason }
+func (e UpdateEnvManageReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTemplateSetReqValidationError) Cause() error { return e.cause }
+func (e UpdateEnvManageReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTemplateSetReqValidationError) Key() bool { return e.key }
+func (e UpdateEnvManageReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTemplateSetReqValidationError) ErrorName() string {
-	return "CreateTemplateSetReqValidationError"
+func (e UpdateEnvManageReqValidationError) ErrorName() string {
+	return "UpdateEnvManageReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTemplateSetReqValidationError) Error() string {
+func (e UpdateEnvManageReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10431,14 +11584,14 @@ func (e CreateTemplateSetReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTemplateSetReq.%s: %s%s",
+		"invalid %sUpdateEnvManageReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTemplateSetReqValidationError{}
+var _ error = UpdateEnvManageReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10446,89 +11599,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTemplateSetReqValidationError{}
-
-// Validate checks the field values on TemplateID with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TemplateID) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on TemplateID with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TemplateIDMultiError, or
-// nil if none found.
-func (m *TemplateID) ValidateAll() error {
-	return m.validate(true)
-}
+} = UpdateEnvManageReqValidationError{}
 
-func (m *TemplateID) validate(all bool) error {
+// Validate checks the field values on EnvVariable with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *EnvVariable) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if l := utf8.RuneCountInString(m.GetTemplateSpace()); l < 1 || l > 64 {
-		err := TemplateIDValidationError{
-			field:  "TemplateSpace",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetTemplateName()); l < 1 || l > 64 {
-		err := TemplateIDValidationError{
-			field:  "TemplateName",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetVersion()); l < 1 || l > 64 {
-		err := TemplateIDValidationError{
-			field:  "Version",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return TemplateIDMultiError(errors)
-	}
-
-	return nil
-}
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 256 {
+		return EnvVariableValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+	}
 
-// TemplateIDMultiError is an error wrapping multiple validation errors
-// returned by TemplateID.ValidateAll() if the designated constraints aren't met.
-type TemplateIDMultiError []error
+	// no validation rules for Value
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m TemplateIDMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
+	return nil
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m TemplateIDMultiError) AllErrors() []error { return m }
-
-// TemplateIDValidationError is the validation error returned by
-// TemplateID.Validate if the designated constraints aren't met.
-type TemplateIDValidationError struct {
+// EnvVariableValidationError is the validation error returned by
+// EnvVariable.Validate if the designated constraints aren't met.
+type EnvVariableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10536,22 +11631,22 @@ type TemplateIDValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateIDValidationError) Field() string { return e.field }
+func (e EnvVariableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateIDValidationError) Reason() string { return e.reason }
+func (e EnvVariableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateIDValidationError) Cause() error { return e.cause }
+func (e EnvVariableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateIDValidationError) Key() bool { return e.key }
+func (e EnvVariableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateIDValidationError) ErrorName() string { return "TemplateIDValidationError" }
+func (e EnvVariableValidationError) ErrorName() string { return "EnvVariableValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TemplateIDValidationError) Error() string {
+func (e EnvVariableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10563,14 +11658,14 @@ func (e TemplateIDValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplateID.%s: %s%s",
+		"invalid %sEnvVariable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateIDValidationError{}
+var _ error = EnvVariableValidationError{}
 
 var _ interface {
 	Field() string
@@ -10578,24 +11673,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateIDValidationError{}
+} = EnvVariableValidationError{}
 
-// Validate checks the field values on GetEnvManageReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetEnvManageReq) Validate() error {
+// Validate checks the field values on RenameEnvManageReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameEnvManageReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetEnvManageReq with the rules
+// ValidateAll checks the field values on RenameEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetEnvManageReqMultiError, or nil if none found.
-func (m *GetEnvManageReq) ValidateAll() error {
+// RenameEnvManageReqMultiError, or nil if none found.
+func (m *RenameEnvManageReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetEnvManageReq) validate(all bool) error {
+func (m *RenameEnvManageReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10603,7 +11698,7 @@ func (m *GetEnvManageReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := GetEnvManageReqValidationError{
+		err := RenameEnvManageReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
@@ -10615,7 +11710,7 @@ func (m *GetEnvManageReq) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := GetEnvManageReqValidationError{
+		err := RenameEnvManageReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -10625,20 +11720,31 @@ func (m *GetEnvManageReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
+		err := RenameEnvManageReqValidationError{
+			field:  "Env",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetEnvManageReqMultiError(errors)
+		return RenameEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetEnvManageReqMultiError is an error wrapping multiple validation errors
-// returned by GetEnvManageReq.ValidateAll() if the designated constraints
+// RenameEnvManageReqMultiError is an error wrapping multiple validation errors
+// returned by RenameEnvManageReq.ValidateAll() if the designated constraints
 // aren't met.
-type GetEnvManageReqMultiError []error
+type RenameEnvManageReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetEnvManageReqMultiError) Error() string {
+func (m RenameEnvManageReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10647,11 +11753,11 @@ func (m GetEnvManageReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetEnvManageReqMultiError) AllErrors() []error { return m }
+func (m RenameEnvManageReqMultiError) AllErrors() []error { return m }
 
-// GetEnvManageReqValidationError is the validation error returned by
-// GetEnvManageReq.Validate if the designated constraints aren't met.
-type GetEnvManageReqValidationError struct {
+// RenameEnvManageReqValidationError is the validation error returned by
+// RenameEnvManageReq.Validate if the designated constraints aren't met.
+type RenameEnvManageReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10659,22 +11765,24 @@ type GetEnvManageReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetEnvManageReqValidationError) Field() string { return e.field }
+func (e RenameEnvManageReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetEnvManageReqValidationError) Reason() string { return e.reason }
+func (e RenameEnvManageReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetEnvManageReqValidationError) Cause() error { return e.cause }
+func (e RenameEnvManageReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetEnvManageReqValidationError) Key() bool { return e.key }
+func (e RenameEnvManageReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetEnvManageReqValidationError) ErrorName() string { return "GetEnvManageReqValidationError" }
+func (e RenameEnvManageReqValidationError) ErrorName() string {
+	return "RenameEnvManageReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetEnvManageReqValidationError) Error() string {
+func (e RenameEnvManageReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10686,14 +11794,14 @@ func (e GetEnvManageReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetEnvManageReq.%s: %s%s",
+		"invalid %sRenameEnvManageReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetEnvManageReqValidationError{}
+var _ error = RenameEnvManageReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10701,32 +11809,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetEnvManageReqValidationError{}
+} = RenameEnvManageReqValidationError{}
 
-// Validate checks the field values on ListEnvManagesReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListEnvManagesReq) Validate() error {
+// Validate checks the field values on DeleteEnvManageReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEnvManageReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEnvManagesReq with the rules
+// ValidateAll checks the field values on DeleteEnvManageReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEnvManagesReqMultiError, or nil if none found.
-func (m *ListEnvManagesReq) ValidateAll() error {
+// DeleteEnvManageReqMultiError, or nil if none found.
+func (m *DeleteEnvManageReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEnvManagesReq) validate(all bool) error {
+func (m *DeleteEnvManageReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := DeleteEnvManageReqValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := ListEnvManagesReqValidationError{
+		err := DeleteEnvManageReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
@@ -10737,19 +11857,19 @@ func (m *ListEnvManagesReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListEnvManagesReqMultiError(errors)
+		return DeleteEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEnvManagesReqMultiError is an error wrapping multiple validation errors
-// returned by ListEnvManagesReq.ValidateAll() if the designated constraints
+// DeleteEnvManageReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteEnvManageReq.ValidateAll() if the designated constraints
 // aren't met.
-type ListEnvManagesReqMultiError []error
+type DeleteEnvManageReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEnvManagesReqMultiError) Error() string {
+func (m DeleteEnvManageReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10758,11 +11878,11 @@ func (m ListEnvManagesReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEnvManagesReqMultiError) AllErrors() []error { return m }
+func (m DeleteEnvManageReqMultiError) AllErrors() []error { return m }
 
-// ListEnvManagesReqValidationError is the validation error returned by
-// ListEnvManagesReq.Validate if the designated constraints aren't met.
-type ListEnvManagesReqValidationError struct {
+// DeleteEnvManageReqValidationError is the validation error returned by
+// DeleteEnvManageReq.Validate if the designated constraints aren't met.
+type DeleteEnvManageReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10770,24 +11890,24 @@ type ListEnvManagesReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEnvManagesReqValidationError) Field() string { return e.field }
+func (e DeleteEnvManageReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEnvManagesReqValidationError) Reason() string { return e.reason }
+func (e DeleteEnvManageReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEnvManagesReqValidationError) Cause() error { return e.cause }
+func (e DeleteEnvManageReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEnvManagesReqValidationError) Key() bool { return e.key }
+func (e DeleteEnvManageReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEnvManagesReqValidationError) ErrorName() string {
-	return "ListEnvManagesReqValidationError"
+func (e DeleteEnvManageReqValidationError) ErrorName() string {
+	return "DeleteEnvManageReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEnvManagesReqValidationError) Error() string {
+func (e DeleteEnvManageReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10799,14 +11919,14 @@ func (e ListEnvManagesReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEnvManagesReq.%s: %s%s",
+		"invalid %sDeleteEnvManageReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEnvManagesReqValidationError{}
+var _ error = DeleteEnvManageReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10814,78 +11934,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEnvManagesReqValidationError{}
-
-// Validate checks the field values on CreateEnvManageReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateEnvManageReq) Validate() error {
-	return m.validate(false)
-}
+} = DeleteEnvManageReqValidationError{}
 
-// ValidateAll checks the field values on CreateEnvManageReq with the rules
+// Validate checks the field values on DeployTemplateSetReq with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateEnvManageReqMultiError, or nil if none found.
-func (m *CreateEnvManageReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *CreateEnvManageReq) validate(all bool) error {
+// violated, an error is returned.
+func (m *DeployTemplateSetReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := CreateEnvManageReqValidationError{
+		return DeployTemplateSetReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
-		if !all {
-			return err
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 53 {
+		return DeployTemplateSetReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 53 runes, inclusive",
 		}
-		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
-		err := CreateEnvManageReqValidationError{
-			field:  "Env",
-			reason: "value length must be between 1 and 64 runes, inclusive",
+	if !_DeployTemplateSetReq_Name_Pattern.MatchString(m.GetName()) {
+		return DeployTemplateSetReqValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
 		}
-		if !all {
-			return err
+	}
+
+	if len(m.GetTemplates()) < 1 {
+		return DeployTemplateSetReqValidationError{
+			field:  "Templates",
+			reason: "value must contain at least 1 item(s)",
 		}
-		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetClusterNamespaces() {
+	for idx, item := range m.GetTemplates() {
 		_, _ = idx, item
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateEnvManageReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateEnvManageReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateEnvManageReqValidationError{
-					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+				return DeployTemplateSetReqValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10894,33 +11987,102 @@ func (m *CreateEnvManageReq) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return CreateEnvManageReqMultiError(errors)
+	// no validation rules for EnvManageID
+
+	// no validation rules for ClusterID
+
+	// no validation rules for Namespace
+
+	// no validation rules for Values
+
+	return nil
+}
+
+// DeployTemplateSetReqValidationError is the validation error returned by
+// DeployTemplateSetReq.Validate if the designated constraints aren't met.
+type DeployTemplateSetReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeployTemplateSetReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeployTemplateSetReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeployTemplateSetReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeployTemplateSetReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeployTemplateSetReqValidationError) ErrorName() string {
+	return "DeployTemplateSetReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeployTemplateSetReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	return nil
+	return fmt.Sprintf(
+		"invalid %sDeployTemplateSetReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-// CreateEnvManageReqMultiError is an error wrapping multiple validation errors
-// returned by CreateEnvManageReq.ValidateAll() if the designated constraints
-// aren't met.
-type CreateEnvManageReqMultiError []error
+var _ error = DeployTemplateSetReqValidationError{}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m CreateEnvManageReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeployTemplateSetReqValidationError{}
+
+var _DeployTemplateSetReq_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on ListTemplateReleasesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTemplateReleasesReq) Validate() error {
+	if m == nil {
+		return nil
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m CreateEnvManageReqMultiError) AllErrors() []error { return m }
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
+		return ListTemplateReleasesReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+	}
 
-// CreateEnvManageReqValidationError is the validation error returned by
-// CreateEnvManageReq.Validate if the designated constraints aren't met.
-type CreateEnvManageReqValidationError struct {
+	// no validation rules for ClusterID
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// ListTemplateReleasesReqValidationError is the validation error returned by
+// ListTemplateReleasesReq.Validate if the designated constraints aren't met.
+type ListTemplateReleasesReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10928,24 +12090,24 @@ type CreateEnvManageReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateEnvManageReqValidationError) Field() string { return e.field }
+func (e ListTemplateReleasesReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateEnvManageReqValidationError) Reason() string { return e.reason }
+func (e ListTemplateReleasesReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateEnvManageReqValidationError) Cause() error { return e.cause }
+func (e ListTemplateReleasesReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateEnvManageReqValidationError) Key() bool { return e.key }
+func (e ListTemplateReleasesReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateEnvManageReqValidationError) ErrorName() string {
-	return "CreateEnvManageReqValidationError"
+func (e ListTemplateReleasesReqValidationError) ErrorName() string {
+	return "ListTemplateReleasesReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateEnvManageReqValidationError) Error() string {
+func (e ListTemplateReleasesReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10957,14 +12119,14 @@ func (e CreateEnvManageReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateEnvManageReq.%s: %s%s",
+		"invalid %sListTemplateReleasesReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateEnvManageReqValidationError{}
+var _ error = ListTemplateReleasesReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -10972,125 +12134,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateEnvManageReqValidationError{}
+} = ListTemplateReleasesReqValidationError{}
 
-// Validate checks the field values on UpdateEnvManageReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateEnvManageReq) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UpdateEnvManageReq with the rules
+// Validate checks the field values on GetTemplateReleaseReq with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateEnvManageReqMultiError, or nil if none found.
-func (m *UpdateEnvManageReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UpdateEnvManageReq) validate(all bool) error {
+// violated, an error is returned.
+func (m *GetTemplateReleaseReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := UpdateEnvManageReqValidationError{
+		return GetTemplateReleaseReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := UpdateEnvManageReqValidationError{
+		return GetTemplateReleaseReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetClusterNamespaces() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateEnvManageReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateEnvManageReqValidationError{
-						field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateEnvManageReqValidationError{
-					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
-		err := UpdateEnvManageReqValidationError{
-			field:  "Env",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return UpdateEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateEnvManageReqMultiError is an error wrapping multiple validation errors
-// returned by UpdateEnvManageReq.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateEnvManageReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateEnvManageReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m UpdateEnvManageReqMultiError) AllErrors() []error { return m }
-
-// UpdateEnvManageReqValidationError is the validation error returned by
-// UpdateEnvManageReq.Validate if the designated constraints aren't met.
-type UpdateEnvManageReqValidationError struct {
+// GetTemplateReleaseReqValidationError is the validation error returned by
+// GetTemplateReleaseReq.Validate if the designated constraints aren't met.
+type GetTemplateReleaseReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11098,24 +12172,24 @@ type UpdateEnvManageReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateEnvManageReqValidationError) Field() string { return e.field }
+func (e GetTemplateReleaseReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateEnvManageReqValidationError) Reason() string { return e.reason }
+func (e GetTemplateReleaseReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateEnvManageReqValidationError) Cause() error { return e.cause }
+func (e GetTemplateReleaseReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateEnvManageReqValidationError) Key() bool { return e.key }
+func (e GetTemplateReleaseReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateEnvManageReqValidationError) ErrorName() string {
-	return "UpdateEnvManageReqValidationError"
+func (e GetTemplateReleaseReqValidationError) ErrorName() string {
+	return "GetTemplateReleaseReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateEnvManageReqValidationError) Error() string {
+func (e GetTemplateReleaseReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11127,14 +12201,14 @@ func (e UpdateEnvManageReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateEnvManageReq.%s: %s%s",
+		"invalid %sGetTemplateReleaseReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateEnvManageReqValidationError{}
+var _ error = GetTemplateReleaseReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -11142,91 +12216,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateEnvManageReqValidationError{}
-
-// Validate checks the field values on RenameEnvManageReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameEnvManageReq) Validate() error {
-	return m.validate(false)
-}
+} = GetTemplateReleaseReqValidationError{}
 
-// ValidateAll checks the field values on RenameEnvManageReq with the rules
+// Validate checks the field values on DiffTemplateReleaseReq with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenameEnvManageReqMultiError, or nil if none found.
-func (m *RenameEnvManageReq) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *RenameEnvManageReq) validate(all bool) error {
+// violated, an error is returned.
+func (m *DiffTemplateReleaseReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := RenameEnvManageReqValidationError{
+		return DiffTemplateReleaseReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := RenameEnvManageReqValidationError{
+		return DiffTemplateReleaseReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetEnv()); l < 1 || l > 64 {
-		err := RenameEnvManageReqValidationError{
-			field:  "Env",
-			reason: "value length must be between 1 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return RenameEnvManageReqMultiError(errors)
-	}
+	// no validation rules for CompareID
 
 	return nil
 }
 
-// RenameEnvManageReqMultiError is an error wrapping multiple validation errors
-// returned by RenameEnvManageReq.ValidateAll() if the designated constraints
-// aren't met.
-type RenameEnvManageReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RenameEnvManageReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m RenameEnvManageReqMultiError) AllErrors() []error { return m }
-
-// RenameEnvManageReqValidationError is the validation error returned by
-// RenameEnvManageReq.Validate if the designated constraints aren't met.
-type RenameEnvManageReqValidationError struct {
+// DiffTemplateReleaseReqValidationError is the validation error returned by
+// DiffTemplateReleaseReq.Validate if the designated constraints aren't met.
+type DiffTemplateReleaseReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11234,24 +12256,24 @@ type RenameEnvManageReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameEnvManageReqValidationError) Field() string { return e.field }
+func (e DiffTemplateReleaseReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameEnvManageReqValidationError) Reason() string { return e.reason }
+func (e DiffTemplateReleaseReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameEnvManageReqValidationError) Cause() error { return e.cause }
+func (e DiffTemplateReleaseReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameEnvManageReqValidationError) Key() bool { return e.key }
+func (e DiffTemplateReleaseReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameEnvManageReqValidationError) ErrorName() string {
-	return "RenameEnvManageReqValidationError"
+func (e DiffTemplateReleaseReqValidationError) ErrorName() string {
+	return "DiffTemplateReleaseReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameEnvManageReqValidationError) Error() string {
+func (e DiffTemplateReleaseReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11263,95 +12285,52 @@ func (e RenameEnvManageReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameEnvManageReq.%s: %s%s",
+		"invalid %sDiffTemplateReleaseReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameEnvManageReqValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = RenameEnvManageReqValidationError{}
-
-// Validate checks the field values on DeleteEnvManageReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteEnvManageReq) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on DeleteEnvManageReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteEnvManageReqMultiError, or nil if none found.
-func (m *DeleteEnvManageReq) ValidateAll() error {
-	return m.validate(true)
-}
+var _ error = DiffTemplateReleaseReqValidationError{}
 
-func (m *DeleteEnvManageReq) validate(all bool) error {
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffTemplateReleaseReqValidationError{}
+
+// Validate checks the field values on RollbackTemplateReleaseReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackTemplateReleaseReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
 	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := DeleteEnvManageReqValidationError{
+		return RollbackTemplateReleaseReqValidationError{
 			field:  "Id",
 			reason: "value length must be 24 runes",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 
 	}
 
 	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 32 {
-		err := DeleteEnvManageReqValidationError{
+		return RollbackTemplateReleaseReqValidationError{
 			field:  "ProjectCode",
 			reason: "value length must be between 1 and 32 runes, inclusive",
 		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return DeleteEnvManageReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteEnvManageReqMultiError is an error wrapping multiple validation errors
-// returned by DeleteEnvManageReq.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteEnvManageReqMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m DeleteEnvManageReqMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m DeleteEnvManageReqMultiError) AllErrors() []error { return m }
-
-// DeleteEnvManageReqValidationError is the validation error returned by
-// DeleteEnvManageReq.Validate if the designated constraints aren't met.
-type DeleteEnvManageReqValidationError struct {
+// RollbackTemplateReleaseReqValidationError is the validation error returned
+// by RollbackTemplateReleaseReq.Validate if the designated constraints aren't met.
+type RollbackTemplateReleaseReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11359,24 +12338,24 @@ type DeleteEnvManageReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteEnvManageReqValidationError) Field() string { return e.field }
+func (e RollbackTemplateReleaseReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteEnvManageReqValidationError) Reason() string { return e.reason }
+func (e RollbackTemplateReleaseReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteEnvManageReqValidationError) Cause() error { return e.cause }
+func (e RollbackTemplateReleaseReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteEnvManageReqValidationError) Key() bool { return e.key }
+func (e RollbackTemplateReleaseReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteEnvManageReqValidationError) ErrorName() string {
-	return "DeleteEnvManageReqValidationError"
+func (e RollbackTemplateReleaseReqValidationError) ErrorName() string {
+	return "RollbackTemplateReleaseReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteEnvManageReqValidationError) Error() string {
+func (e RollbackTemplateReleaseReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11388,14 +12367,14 @@ func (e DeleteEnvManageReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteEnvManageReq.%s: %s%s",
+		"invalid %sRollbackTemplateReleaseReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteEnvManageReqValidationError{}
+var _ error = RollbackTemplateReleaseReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -11403,7 +12382,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteEnvManageReqValidationError{}
+} = RollbackTemplateReleaseReqValidationError{}
 
 // Validate checks the field values on FetchMultiClusterResourceReq with the
 // rules defined in the proto definition for this message. If any rules are
@@ -12137,3 +13116,270 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MultiClusterResourceCountReqValidationError{}
+
+// Validate checks the field values on BatchOperateTarget with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchOperateTarget) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetClusterID()) < 1 {
+		return BatchOperateTargetValidationError{
+			field:  "ClusterID",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Namespace
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return BatchOperateTargetValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// BatchOperateTargetValidationError is the validation error returned by
+// BatchOperateTarget.Validate if the designated constraints aren't met.
+type BatchOperateTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchOperateTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchOperateTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchOperateTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchOperateTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchOperateTargetValidationError) ErrorName() string {
+	return "BatchOperateTargetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchOperateTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchOperateTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchOperateTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchOperateTargetValidationError{}
+
+// Validate checks the field values on MultiClusterBatchOperateReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiClusterBatchOperateReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetProjectCode()); l < 1 || l > 64 {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "ProjectCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if len(m.GetClusterNamespaces()) < 1 {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "ClusterNamespaces",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetClusterNamespaces() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiClusterBatchOperateReqValidationError{
+					field:  fmt.Sprintf("ClusterNamespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if _, ok := _MultiClusterBatchOperateReq_Action_InLookup[m.GetAction()]; !ok {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "Action",
+			reason: "value must be in list [scale restart pause resume label annotate delete]",
+		}
+	}
+
+	// no validation rules for ViewID
+
+	for idx, item := range m.GetLabelSelector() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiClusterBatchOperateReqValidationError{
+					field:  fmt.Sprintf("LabelSelector[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Ip
+
+	if l := len(m.GetTargets()); l < 1 || l > 500 {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "Targets",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiClusterBatchOperateReqValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if wrapper := m.GetReplicas(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val < 0 || val > 8192 {
+			return MultiClusterBatchOperateReqValidationError{
+				field:  "Replicas",
+				reason: "value must be inside range [0, 8192]",
+			}
+		}
+
+	}
+
+	// no validation rules for Metadata
+
+	if m.GetConcurrency() > 50 {
+		return MultiClusterBatchOperateReqValidationError{
+			field:  "Concurrency",
+			reason: "value must be less than or equal to 50",
+		}
+	}
+
+	return nil
+}
+
+// MultiClusterBatchOperateReqValidationError is the validation error returned
+// by MultiClusterBatchOperateReq.Validate if the designated constraints
+// aren't met.
+type MultiClusterBatchOperateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiClusterBatchOperateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiClusterBatchOperateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiClusterBatchOperateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiClusterBatchOperateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiClusterBatchOperateReqValidationError) ErrorName() string {
+	return "MultiClusterBatchOperateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiClusterBatchOperateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiClusterBatchOperateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiClusterBatchOperateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiClusterBatchOperateReqValidationError{}
+
+var _MultiClusterBatchOperateReq_Action_InLookup = map[string]struct{}{
+	"scale":    {},
+	"restart":  {},
+	"pause":    {},
+	"resume":   {},
+	"label":    {},
+	"annotate": {},
+	"delete":   {},
+}